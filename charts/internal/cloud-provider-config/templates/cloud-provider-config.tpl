@@ -1,5 +1,8 @@
 {{- define "cloud-provider-config-base" -}}
 cloud: "{{ .Values.cloud }}"
+{{- if hasKey .Values "resourceManagerEndpoint" }}
+resourceManagerEndpoint: "{{ .Values.resourceManagerEndpoint }}"
+{{- end }}
 location: "{{ .Values.region }}"
 resourceGroup: "{{ .Values.resourceGroup }}"
 routeTableName: "{{ .Values.routeTableName }}"
@@ -11,10 +14,23 @@ vnetResourceGroup: "{{ .Values.vnetResourceGroup }}"
 {{- end }}
 {{- if hasKey .Values "availabilitySetName" }}
 primaryAvailabilitySetName: "{{ .Values.availabilitySetName }}"
+{{- end }}
+{{- if hasKey .Values "loadBalancerSku" }}
+loadBalancerSku: "{{ .Values.loadBalancerSku }}"
+{{- else if hasKey .Values "availabilitySetName" }}
 loadBalancerSku: "basic"
 {{- else }}
 loadBalancerSku: "standard"
 {{- end }}
+{{- if hasKey .Values "disableOutboundSNAT" }}
+disableOutboundSNAT: {{ .Values.disableOutboundSNAT }}
+{{- end }}
+{{- if hasKey .Values "outboundRuleAllocatedOutboundPorts" }}
+outboundRuleAllocatedOutboundPorts: {{ .Values.outboundRuleAllocatedOutboundPorts }}
+{{- end }}
+{{- if hasKey .Values "outboundRuleIdleTimeoutInMinutes" }}
+outboundRuleIdleTimeoutInMinutes: {{ .Values.outboundRuleIdleTimeoutInMinutes }}
+{{- end }}
 {{- if hasKey .Values "vmType" }}
 vmType: "{{ .Values.vmType }}"
 {{- end }}
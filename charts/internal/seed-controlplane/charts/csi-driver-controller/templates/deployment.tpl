@@ -1,3 +1,15 @@
+{{- define "csi-driver-controller.leaderElection" -}}
+{{- if .Values.leaderElection.leaseDuration }}
+- --leader-election-lease-duration={{ .Values.leaderElection.leaseDuration }}
+{{- end }}
+{{- if .Values.leaderElection.renewDeadline }}
+- --leader-election-renew-deadline={{ .Values.leaderElection.renewDeadline }}
+{{- end }}
+{{- if .Values.leaderElection.retryPeriod }}
+- --leader-election-retry-period={{ .Values.leaderElection.retryPeriod }}
+{{- end }}
+{{- end -}}
+
 {{- define "csi-driver-controller.deployment" -}}
 ---
 apiVersion: apps/v1
@@ -56,7 +68,7 @@ spec:
         - --nodeid=dummy
         - --kubeconfig=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig
         {{- end }}
-        - --v=5
+        - --v={{ .Values.verbosity }}
         env:
         - name: CSI_ENDPOINT
           value: unix://{{ .Values.socketPath }}/csi.sock
@@ -112,6 +124,7 @@ spec:
         - --feature-gates=Topology=true
         - --leader-election=true
         - --leader-election-namespace=kube-system
+        {{- include "csi-driver-controller.leaderElection" . | nindent 8 }}
         - --kubeconfig=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig
         - --timeout=120s
         - --volume-name-prefix=pv-{{ .Release.Namespace }}
@@ -120,7 +133,7 @@ spec:
         {{- if ((.Values.csiProvisioner).featureGates) }}
         - --feature-gates={{ range $feature, $enabled := .Values.csiProvisioner.featureGates }}{{ $feature }}={{ $enabled }},{{ end }}
         {{- end }}
-        - --v=5
+        - --v={{ .Values.verbosity }}
         env:
         - name: ADDRESS
           value: {{ .Values.socketPath }}/csi.sock
@@ -143,7 +156,8 @@ spec:
         - --kubeconfig=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig
         - --leader-election
         - --leader-election-namespace=kube-system
-        - --v=5
+        {{- include "csi-driver-controller.leaderElection" . | nindent 8 }}
+        - --v={{ .Values.verbosity }}
         - --timeout=1200s
         - --worker-threads=500
         - --kube-api-qps=50
@@ -170,6 +184,7 @@ spec:
         - --kubeconfig=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig
         - --leader-election
         - --leader-election-namespace=kube-system
+        {{- include "csi-driver-controller.leaderElection" . | nindent 8 }}
         - --snapshot-name-prefix={{ .Release.Namespace }}
         env:
         - name: CSI_ENDPOINT
@@ -193,7 +208,8 @@ spec:
         - --kubeconfig=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig
         - --leader-election=true
         - --leader-election-namespace=kube-system
-        - --v=5
+        {{- include "csi-driver-controller.leaderElection" . | nindent 8 }}
+        - --v={{ .Values.verbosity }}
         - --handle-volume-inuse-error=false
         {{- if ((.Values.csiResizer).featureGates) }}
         - --feature-gates={{ range $feature, $enabled := .Values.csiResizer.featureGates }}{{ $feature }}={{ $enabled }},{{ end }}
@@ -0,0 +1,9 @@
+{{- define "disk-allowed-topologies" -}}
+{{- if .Values.zones }}
+allowedTopologies:
+- matchLabelExpressions:
+  - key: topology.disk.csi.azure.com/zone
+    values:
+{{ toYaml .Values.zones | indent 4 }}
+{{- end }}
+{{- end -}}
@@ -12,22 +12,26 @@
 29615
 {{- end -}}
 
+{{- define "csi-driver-node.daemonset.name" -}}
+{{ .role }}{{ with .attachLimit }}-limit-{{ . }}{{ end }}
+{{- end -}}
+
 {{- define "csi-driver-node.daemonset" -}}
 ---
 apiVersion: apps/v1
 kind: DaemonSet
 metadata:
-  name: csi-driver-node-{{ .role }}
+  name: csi-driver-node-{{ include "csi-driver-node.daemonset.name" . }}
   namespace: {{ .Release.Namespace }}
   labels:
     node.gardener.cloud/critical-component: "true"
     app: csi
-    role: driver-{{ .role }}
+    role: driver-{{ include "csi-driver-node.daemonset.name" . }}
 spec:
   selector:
     matchLabels:
       app: csi
-      role: driver-{{ .role }}
+      role: driver-{{ include "csi-driver-node.daemonset.name" . }}
   template:
     metadata:
       annotations:
@@ -38,7 +42,7 @@ spec:
       labels:
         node.gardener.cloud/critical-component: "true"
         app: csi
-        role: driver-{{ .role }}
+        role: driver-{{ include "csi-driver-node.daemonset.name" . }}
     spec:
       hostNetwork: true
       priorityClassName: system-node-critical
@@ -50,6 +54,29 @@ spec:
         operator: Exists
       - effect: NoExecute
         operator: Exists
+{{- if .attachLimit }}
+      affinity:
+        nodeAffinity:
+          requiredDuringSchedulingIgnoredDuringExecution:
+            nodeSelectorTerms:
+            - matchExpressions:
+              - key: worker.gardener.cloud/pool
+                operator: In
+                values:
+{{ toYaml .pools | indent 16 }}
+{{- else if and (eq .role "disk") .Values.attachLimits }}
+      affinity:
+        nodeAffinity:
+          requiredDuringSchedulingIgnoredDuringExecution:
+            nodeSelectorTerms:
+            - matchExpressions:
+              - key: worker.gardener.cloud/pool
+                operator: NotIn
+                values:
+{{- range .Values.attachLimits }}
+{{ toYaml .pools | indent 16 }}
+{{- end }}
+{{- end }}
       securityContext:
         seccompProfile:
           type: RuntimeDefault
@@ -60,6 +87,9 @@ spec:
         - --endpoint=$(CSI_ENDPOINT)
         - --nodeid=$(KUBE_NODE_NAME)
         - --metrics-address=0.0.0.0:{{ include (print "csi-driver-node.daemonset.ports.metrics." .role) . }}
+        {{- with .attachLimit }}
+        - --volume-attach-limit={{ . }}
+        {{- end }}
         - --v=5
         env:
         - name: CSI_ENDPOINT
@@ -28,6 +28,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	admissioncmd "github.com/gardener/gardener-extension-provider-azure/pkg/admission/cmd"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/mutator"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/validator"
 	azureinstall "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/install"
 	providerazure "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
@@ -55,6 +57,7 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
 		}
 
+		configFileOpts  = &admissioncmd.ConfigOptions{}
 		webhookSwitches = admissioncmd.GardenWebhookSwitchOptions()
 		webhookOptions  = webhookcmd.NewAddToManagerOptions(
 			AdmissionName,
@@ -67,6 +70,7 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 		aggOption = controllercmd.NewOptionAggregator(
 			restOpts,
 			mgrOpts,
+			configFileOpts,
 			webhookOptions,
 		)
 	)
@@ -136,6 +140,9 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 				}
 			}
 
+			configFileOpts.Completed().ApplyPolicy(&validator.DefaultAddOptions.Policy)
+			configFileOpts.Completed().ApplyShootDefaults(&mutator.DefaultAddOptions.ShootDefaults)
+
 			log.Info("Setting up webhook server")
 			if _, err := webhookOptions.Completed().AddToManager(ctx, mgr, sourceCluster); err != nil {
 				return err
@@ -17,6 +17,7 @@ import (
 	heartbeatcmd "github.com/gardener/gardener/extensions/pkg/controller/heartbeat/cmd"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
@@ -37,11 +38,15 @@ import (
 	azurebackupentry "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupentry"
 	azurebastion "github.com/gardener/gardener-extension-provider-azure/pkg/controller/bastion"
 	azurecontrolplane "github.com/gardener/gardener-extension-provider-azure/pkg/controller/controlplane"
+	azurecontrolplaneexposure "github.com/gardener/gardener-extension-provider-azure/pkg/controller/controlplaneexposure"
 	azurednsrecord "github.com/gardener/gardener-extension-provider-azure/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/healthcheck"
 	azureinfrastructure "github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure"
+	azureorphandetection "github.com/gardener/gardener-extension-provider-azure/pkg/controller/orphandetection"
 	azureworker "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
+	azurecontrolplanewebhook "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/controlplane"
+	azurecontrolplaneexposurewebhook "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/controlplaneexposure"
 	haNamespace "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/highavailability/namespace"
 	azureseedprovider "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/seedprovider"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/webhook/topology"
@@ -116,6 +121,16 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			MaxConcurrentReconciles: 5,
 		}
 
+		// options for the orphan detection controller
+		orphanDetectionCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 1,
+		}
+
+		// options for the controlplane exposure controller
+		controlPlaneExposureCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
 		// options for the webhook server
 		webhookServerOptions = &webhookcmd.ServerOptions{
 			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
@@ -145,6 +160,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			controllercmd.PrefixOption("dnsrecord-", dnsRecordCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
 			controllercmd.PrefixOption("worker-", workerCtrlOpts),
+			controllercmd.PrefixOption("orphandetection-", orphanDetectionCtrlOpts),
+			controllercmd.PrefixOption("controlplaneexposure-", controlPlaneExposureCtrlOpts),
 			controllercmd.PrefixOption("healthcheck-", healthCheckCtrlOpts),
 			controllercmd.PrefixOption("heartbeat-", heartbeatCtrlOpts),
 			configFileOpts,
@@ -175,7 +192,12 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 
 			util.ApplyClientConnectionConfigurationToRESTConfig(configFileOpts.Completed().Config.ClientConnection, restOpts.Completed().Config)
 
-			mgr, err := manager.New(restOpts.Completed().Config, mgrOpts.Completed().Options())
+			mgrOptions := mgrOpts.Completed().Options()
+			if err := configFileOpts.Completed().ApplyLeaderElectionConfig(&mgrOptions); err != nil {
+				return err
+			}
+
+			mgr, err := manager.New(restOpts.Completed().Config, mgrOptions)
 			if err != nil {
 				return fmt.Errorf("could not instantiate manager: %w", err)
 			}
@@ -216,6 +238,17 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			log.Info("Adding controllers to manager")
 			configFileOpts.Completed().ApplyETCDStorage(&azureseedprovider.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
+			configFileOpts.Completed().ApplyRemedyControllerConfig(&azurecontrolplane.DefaultAddOptions.RemedyController)
+			configFileOpts.Completed().ApplyOrphanDetectionConfig(&azureorphandetection.DefaultAddOptions.Config)
+			configFileOpts.Completed().ApplyControlPlaneExposureConfig(&azurecontrolplaneexposure.DefaultAddOptions.Config)
+			configFileOpts.Completed().ApplyControlPlaneExposureConfig(&azurecontrolplaneexposurewebhook.DefaultAddOptions.Config)
+			configFileOpts.Completed().ApplyManagementLocksConfig(&azureinfrastructure.DefaultAddOptions.ManagementLocks)
+			configFileOpts.Completed().ApplyDriftDetectionConfig(&azureinfrastructure.DefaultAddOptions.DriftDetection)
+			configFileOpts.Completed().ApplyDisableProjectedTokenMount(&azureinfrastructure.DefaultAddOptions.DisableProjectedTokenMount)
+			configFileOpts.Completed().ApplyDNSRecordConfig(&azurednsrecord.DefaultAddOptions.Config)
+			configFileOpts.Completed().ApplyMandatoryVMTags(&azureworker.DefaultAddOptions.MandatoryVMTags)
+			configFileOpts.Completed().ApplyImageVectorOverrides(&azurecontrolplane.DefaultAddOptions.ImageVectorOverrides)
+			configFileOpts.Completed().ApplyImageVectorOverrides(&azurecontrolplanewebhook.DefaultAddOptions.ImageVectorOverrides)
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 			backupBucketCtrlOpts.Completed().Apply(&azurebackupbucket.DefaultAddOptions.Controller)
@@ -228,13 +261,24 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			reconcileOpts.Completed().Apply(&azurecontrolplane.DefaultAddOptions.IgnoreOperationAnnotation, &azurecontrolplane.DefaultAddOptions.ExtensionClass)
 			reconcileOpts.Completed().Apply(&azureworker.DefaultAddOptions.IgnoreOperationAnnotation, &azureworker.DefaultAddOptions.ExtensionClass)
 			reconcileOpts.Completed().Apply(&azurebastion.DefaultAddOptions.IgnoreOperationAnnotation, &azurebastion.DefaultAddOptions.ExtensionClass)
+			reconcileOpts.Completed().Apply(nil, &azureorphandetection.DefaultAddOptions.ExtensionClass)
 			workerCtrlOpts.Completed().Apply(&azureworker.DefaultAddOptions.Controller)
+			orphanDetectionCtrlOpts.Completed().Apply(&azureorphandetection.DefaultAddOptions.Controller)
+			controlPlaneExposureCtrlOpts.Completed().Apply(&azurecontrolplaneexposure.DefaultAddOptions.Controller)
+			controllersConfig := configFileOpts.Completed().ControllersConfig()
+			azurecmd.ApplyControllerConfig(controllersConfig.BackupBucket, &azurebackupbucket.DefaultAddOptions.Controller, mgr.GetClient(), &extensionsv1alpha1.BackupBucketList{})
+			azurecmd.ApplyControllerConfig(controllersConfig.Bastion, &azurebastion.DefaultAddOptions.Controller, mgr.GetClient(), &extensionsv1alpha1.BastionList{})
+			azurecmd.ApplyControllerConfig(controllersConfig.ControlPlane, &azurecontrolplane.DefaultAddOptions.Controller, mgr.GetClient(), &extensionsv1alpha1.ControlPlaneList{})
+			azurecmd.ApplyControllerConfig(controllersConfig.DNSRecord, &azurednsrecord.DefaultAddOptions.Controller, mgr.GetClient(), &extensionsv1alpha1.DNSRecordList{})
+			azurecmd.ApplyControllerConfig(controllersConfig.Infrastructure, &azureinfrastructure.DefaultAddOptions.Controller, mgr.GetClient(), &extensionsv1alpha1.InfrastructureList{})
+			azurecmd.ApplyControllerConfig(controllersConfig.Worker, &azureworker.DefaultAddOptions.Controller, mgr.GetClient(), &extensionsv1alpha1.WorkerList{})
 			azureworker.DefaultAddOptions.GardenCluster = gardenCluster
 
 			topology.SeedRegion = seedOptions.Completed().Region
 			topology.SeedProvider = seedOptions.Completed().Provider
 			haNamespace.SeedRegion = seedOptions.Completed().Region
 			haNamespace.SeedProvider = seedOptions.Completed().Provider
+			azurecontrolplaneexposure.DefaultAddOptions.SeedRegion = seedOptions.Completed().Region
 
 			shootWebhookConfig, err := webhookOptions.Completed().AddToManager(ctx, mgr, nil)
 			if err != nil {
@@ -270,6 +314,8 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 	verflag.AddFlags(cmd.Flags())
 	aggOption.AddFlags(cmd.Flags())
 
+	cmd.AddCommand(newDiagnoseEgressCommand(ctx))
+
 	return cmd
 }
 
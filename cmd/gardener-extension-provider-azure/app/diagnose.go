@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/diagnostics"
+)
+
+type diagnoseEgressOptions struct {
+	kubeconfig       string
+	namespace        string
+	machine          string
+	networkInterface string
+	output           string
+}
+
+// newDiagnoseEgressCommand creates a command which reports why the egress traffic of a node might be broken.
+func newDiagnoseEgressCommand(ctx context.Context) *cobra.Command {
+	opts := &diagnoseEgressOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diagnose-egress",
+		Short: "Diagnose the egress traffic of a node",
+		Long: "Queries the subnet, the effective routes and the effective security rules of the network interface of a node " +
+			"and reports why its egress traffic might be broken, e.g. because of a missing NAT gateway association or a blackhole route. " +
+			"The command must be run against the seed which hosts the control plane of the shoot.",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			report, err := opts.run(ctx)
+			if err != nil {
+				return err
+			}
+
+			var out []byte
+			switch opts.output {
+			case "json":
+				out, err = json.MarshalIndent(report, "", "  ")
+			default:
+				out, err = yaml.Marshal(report)
+			}
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(cmd.OutOrStdout(), string(out)); err != nil {
+				return err
+			}
+
+			if report.HasErrors() {
+				return errors.New("the egress traffic of the node is likely broken, see the findings of the report")
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.kubeconfig, "kubeconfig", "", "Path to the kubeconfig of the seed. Defaults to the in-cluster configuration or $KUBECONFIG.")
+	flags.StringVar(&opts.namespace, "namespace", "", "The namespace of the shoot control plane in the seed.")
+	flags.StringVar(&opts.machine, "machine", "", "The name of the machine of the node.")
+	flags.StringVar(&opts.networkInterface, "network-interface", "", "The name of the network interface of the node. Defaults to <machine>-nic.")
+	flags.StringVarP(&opts.output, "output", "o", "yaml", "The output format of the report, one of yaml or json.")
+
+	return cmd
+}
+
+func (o *diagnoseEgressOptions) validate() error {
+	if o.namespace == "" {
+		return errors.New("--namespace is required")
+	}
+	if o.machine == "" && o.networkInterface == "" {
+		return errors.New("either --machine or --network-interface is required")
+	}
+	if o.output != "yaml" && o.output != "json" {
+		return fmt.Errorf("unsupported output format %q", o.output)
+	}
+	return nil
+}
+
+func (o *diagnoseEgressOptions) run(ctx context.Context) (*diagnostics.EgressReport, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = o.kubeconfig
+	restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, nil).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not load kubeconfig: %w", err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := extensionscontroller.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	infraList := &extensionsv1alpha1.InfrastructureList{}
+	if err := c.List(ctx, infraList, client.InNamespace(o.namespace)); err != nil {
+		return nil, err
+	}
+	if len(infraList.Items) != 1 {
+		return nil, fmt.Errorf("expected exactly one infrastructure in namespace %s, found %d", o.namespace, len(infraList.Items))
+	}
+	infra := &infraList.Items[0]
+
+	status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the infrastructure status: %w", err)
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, c, o.namespace)
+	if err != nil {
+		return nil, err
+	}
+	cloudProfile, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	var cloudConfiguration *azure.CloudConfiguration
+	if cloudProfile != nil {
+		cloudConfiguration = cloudProfile.CloudConfiguration
+	}
+	azCloudConfiguration, err := azureclient.AzureCloudConfiguration(cloudConfiguration, &cluster.Shoot.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	secretRef, err := helper.InfrastructureCredentialsSecretRef(infra)
+	if err != nil {
+		return nil, err
+	}
+	factory, err := azureclient.NewAzureClientFactoryFromSecret(ctx, c, secretRef, false, azureclient.WithCloudConfiguration(azCloudConfiguration))
+	if err != nil {
+		return nil, err
+	}
+
+	networkInterface := o.networkInterface
+	if networkInterface == "" {
+		networkInterface = o.machine + "-nic"
+	}
+	return diagnostics.DiagnoseEgress(ctx, factory, status, networkInterface)
+}
@@ -6,10 +6,12 @@ package imagevector
 
 import (
 	_ "embed"
+	"strings"
 
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"k8s.io/apimachinery/pkg/util/runtime"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -40,3 +42,14 @@ func TerraformerImage() string {
 	runtime.Must(err)
 	return image.String()
 }
+
+// OverridesForCloud returns the images which are overridden for shoots of the given cloud instance, keyed by the names
+// of the images in the image vector.
+func OverridesForCloud(overrides []config.ImageVectorOverride, cloud string) map[string]string {
+	for _, override := range overrides {
+		if strings.EqualFold(override.Cloud, cloud) {
+			return override.Images
+		}
+	}
+	return nil
+}
@@ -6,9 +6,12 @@ package cmd
 
 import (
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
+	"github.com/spf13/pflag"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/mutator"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/validator"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	configloader "github.com/gardener/gardener-extension-provider-azure/pkg/apis/config/loader"
 )
 
 // GardenWebhookSwitchOptions are the webhookcmd.SwitchOptions for the admission webhooks.
@@ -19,3 +22,55 @@ func GardenWebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(mutator.Name, mutator.New),
 	)
 }
+
+// ConfigOptions are command line options for the optional admission configuration file.
+type ConfigOptions struct {
+	// ConfigFilePath is the path to the configuration file.
+	ConfigFilePath string
+
+	config *Config
+}
+
+// Config is a completed admission configuration.
+type Config struct {
+	// Config is the admission configuration.
+	Config *config.ControllerConfiguration
+}
+
+// Complete implements RESTCompleter.Complete.
+func (c *ConfigOptions) Complete() error {
+	cfg := &config.ControllerConfiguration{}
+	if len(c.ConfigFilePath) > 0 {
+		var err error
+		if cfg, err = configloader.LoadFromFile(c.ConfigFilePath); err != nil {
+			return err
+		}
+	}
+
+	c.config = &Config{cfg}
+	return nil
+}
+
+// Completed returns the completed Config. Only call this if `Complete` was successful.
+func (c *ConfigOptions) Completed() *Config {
+	return c.config
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (c *ConfigOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.ConfigFilePath, "config-file", "", "path to the admission configuration file (optional)")
+}
+
+// ApplyPolicy sets the given policy configuration to that of this Config.
+func (c *Config) ApplyPolicy(policy *config.Policy) {
+	if c.Config.Policy != nil {
+		*policy = *c.Config.Policy
+	}
+}
+
+// ApplyShootDefaults sets the given shoot defaults to those of this Config.
+func (c *Config) ApplyShootDefaults(shootDefaults *config.ShootDefaults) {
+	if c.Config.ShootDefaults != nil {
+		*shootDefaults = *c.Config.ShootDefaults
+	}
+}
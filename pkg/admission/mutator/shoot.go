@@ -15,20 +15,26 @@ import (
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 )
 
 // NewShootMutator returns a new instance of a shoot mutator.
-func NewShootMutator(mgr manager.Manager) extensionswebhook.Mutator {
+func NewShootMutator(mgr manager.Manager, shootDefaults config.ShootDefaults) extensionswebhook.Mutator {
 	return &shoot{
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		decoder:       serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		shootDefaults: shootDefaults,
 	}
 }
 
 type shoot struct {
-	decoder runtime.Decoder
+	decoder       runtime.Decoder
+	shootDefaults config.ShootDefaults
 }
 
 const (
@@ -49,10 +55,6 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 		return nil
 	}
 
-	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Type != nil && *shoot.Spec.Networking.Type != "cilium" {
-		return nil
-	}
-
 	// Skip if shoot is in restore or migration phase
 	if wasShootRescheduledToNewSeed(shoot) {
 		return nil
@@ -80,6 +82,21 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 		return nil
 	}
 
+	if oldShoot != nil {
+		if err := s.spreadNatGatewayOnZoneMigration(shoot, oldShoot); err != nil {
+			return err
+		}
+	}
+
+	if err := s.applyInfrastructureDefaults(shoot, oldShoot); err != nil {
+		return err
+	}
+	s.applyWorkerDefaults(shoot, oldShoot)
+
+	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Type != nil && *shoot.Spec.Networking.Type != "cilium" {
+		return nil
+	}
+
 	if shoot.Spec.Networking != nil {
 		networkConfig, err := s.decodeNetworkConfig(shoot.Spec.Networking.ProviderConfig)
 		if err != nil {
@@ -124,6 +141,173 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 	return nil
 }
 
+// spreadNatGatewayOnZoneMigration expands the NAT gateway of a zonal shoot which migrates from the single subnet layout
+// to the multi subnet layout into one NAT gateway per zone, unless a NAT gateway is explicitly configured for any zone.
+// Otherwise, the shoot would silently fall back to the default outbound access of the load balancer.
+func (s *shoot) spreadNatGatewayOnZoneMigration(shoot, oldShoot *gardencorev1beta1.Shoot) error {
+	if shoot.Spec.Provider.InfrastructureConfig == nil || oldShoot.Spec.Provider.InfrastructureConfig == nil {
+		return nil
+	}
+
+	infraConfig := &v1alpha1.InfrastructureConfig{}
+	if _, _, err := s.decoder.Decode(shoot.Spec.Provider.InfrastructureConfig.Raw, nil, infraConfig); err != nil {
+		return fmt.Errorf("could not decode infrastructureConfig of shoot '%s': %w", shoot.Name, err)
+	}
+	oldInfraConfig := &v1alpha1.InfrastructureConfig{}
+	if _, _, err := s.decoder.Decode(oldShoot.Spec.Provider.InfrastructureConfig.Raw, nil, oldInfraConfig); err != nil {
+		return fmt.Errorf("could not decode infrastructureConfig of shoot '%s': %w", oldShoot.Name, err)
+	}
+
+	if !infraConfig.Zoned || len(infraConfig.Networks.Zones) == 0 || len(oldInfraConfig.Networks.Zones) > 0 {
+		return nil
+	}
+	natGateway := oldInfraConfig.Networks.NatGateway
+	if natGateway == nil || !natGateway.Enabled {
+		return nil
+	}
+	for _, zone := range infraConfig.Networks.Zones {
+		if zone.NatGateway != nil {
+			return nil
+		}
+	}
+
+	for i, zone := range infraConfig.Networks.Zones {
+		zonedNatGateway := &v1alpha1.ZonedNatGatewayConfig{
+			Enabled:                      true,
+			IdleConnectionTimeoutMinutes: natGateway.IdleConnectionTimeoutMinutes,
+		}
+		// Public IP addresses are zonal resources and can only be attached to the NAT gateway of their zone.
+		for _, ip := range natGateway.IPAddresses {
+			if ip.Zone == zone.Name {
+				zonedNatGateway.IPAddresses = append(zonedNatGateway.IPAddresses, v1alpha1.ZonedPublicIPReference{
+					Name:          ip.Name,
+					ResourceGroup: ip.ResourceGroup,
+				})
+			}
+		}
+		infraConfig.Networks.Zones[i].NatGateway = zonedNatGateway
+	}
+
+	modifiedInfraConfig, err := json.Marshal(infraConfig)
+	if err != nil {
+		return err
+	}
+	shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: modifiedInfraConfig}
+
+	return nil
+}
+
+// applyInfrastructureDefaults applies the landscape-wide defaults to the InfrastructureConfig of the shoot. The outbound
+// access type is only defaulted for new shoots and the idle connection timeout only for NAT gateways which are enabled
+// by the change, so that the defaults never modify the existing infrastructure of shoots.
+func (s *shoot) applyInfrastructureDefaults(shoot, oldShoot *gardencorev1beta1.Shoot) error {
+	if s.shootDefaults.OutboundAccessType == nil && s.shootDefaults.NatGatewayIdleConnectionTimeoutMinutes == nil {
+		return nil
+	}
+	if shoot.Spec.Provider.InfrastructureConfig == nil {
+		return nil
+	}
+
+	infraConfig := &v1alpha1.InfrastructureConfig{}
+	if _, _, err := s.decoder.Decode(shoot.Spec.Provider.InfrastructureConfig.Raw, nil, infraConfig); err != nil {
+		return fmt.Errorf("could not decode infrastructureConfig of shoot '%s': %w", shoot.Name, err)
+	}
+
+	var oldInfraConfig *v1alpha1.InfrastructureConfig
+	if oldShoot != nil && oldShoot.Spec.Provider.InfrastructureConfig != nil {
+		oldInfraConfig = &v1alpha1.InfrastructureConfig{}
+		if _, _, err := s.decoder.Decode(oldShoot.Spec.Provider.InfrastructureConfig.Raw, nil, oldInfraConfig); err != nil {
+			return fmt.Errorf("could not decode infrastructureConfig of shoot '%s': %w", oldShoot.Name, err)
+		}
+	}
+
+	var (
+		originalInfraConfig = infraConfig.DeepCopy()
+		networks            = &infraConfig.Networks
+	)
+	if oldShoot == nil && s.shootDefaults.OutboundAccessType != nil && networks.OutboundAccessType == nil && networks.OutboundAccess == nil && networks.EgressFirewall == nil && !configuresNatGateway(networks) {
+		switch outboundAccessType := v1alpha1.OutboundAccessType(*s.shootDefaults.OutboundAccessType); outboundAccessType {
+		case v1alpha1.OutboundAccessTypeNatGateway:
+			if len(networks.Zones) == 0 {
+				networks.NatGateway = &v1alpha1.NatGatewayConfig{Enabled: true}
+			}
+			for i := range networks.Zones {
+				networks.Zones[i].NatGateway = &v1alpha1.ZonedNatGatewayConfig{Enabled: true}
+			}
+			networks.OutboundAccessType = &outboundAccessType
+		case v1alpha1.OutboundAccessTypeLoadBalancer:
+			networks.OutboundAccessType = &outboundAccessType
+		}
+	}
+
+	if timeout := s.shootDefaults.NatGatewayIdleConnectionTimeoutMinutes; timeout != nil {
+		if natGateway := networks.NatGateway; natGateway != nil && natGateway.Enabled && natGateway.IdleConnectionTimeoutMinutes == nil &&
+			(oldInfraConfig == nil || oldInfraConfig.Networks.NatGateway == nil || !oldInfraConfig.Networks.NatGateway.Enabled) {
+			natGateway.IdleConnectionTimeoutMinutes = ptr.To(*timeout)
+		}
+		for _, zone := range networks.Zones {
+			if natGateway := zone.NatGateway; natGateway != nil && natGateway.Enabled && natGateway.IdleConnectionTimeoutMinutes == nil &&
+				(oldInfraConfig == nil || !zoneHasNatGateway(oldInfraConfig.Networks.Zones, zone.Name)) {
+				natGateway.IdleConnectionTimeoutMinutes = ptr.To(*timeout)
+			}
+		}
+	}
+
+	if reflect.DeepEqual(infraConfig, originalInfraConfig) {
+		return nil
+	}
+	modifiedInfraConfig, err := json.Marshal(infraConfig)
+	if err != nil {
+		return err
+	}
+	shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: modifiedInfraConfig}
+
+	return nil
+}
+
+// applyWorkerDefaults applies the landscape-wide defaults to the worker pools which are added to the shoot.
+func (s *shoot) applyWorkerDefaults(shoot, oldShoot *gardencorev1beta1.Shoot) {
+	if s.shootDefaults.OSDiskType == nil {
+		return
+	}
+
+	oldWorkers := sets.New[string]()
+	if oldShoot != nil {
+		for _, worker := range oldShoot.Spec.Provider.Workers {
+			oldWorkers.Insert(worker.Name)
+		}
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.Volume != nil && worker.Volume.Type == nil && !oldWorkers.Has(worker.Name) {
+			shoot.Spec.Provider.Workers[i].Volume.Type = ptr.To(*s.shootDefaults.OSDiskType)
+		}
+	}
+}
+
+// configuresNatGateway returns true if a NAT gateway is configured for any worker subnet, regardless of whether it is
+// enabled.
+func configuresNatGateway(networks *v1alpha1.NetworkConfig) bool {
+	if networks.NatGateway != nil {
+		return true
+	}
+	for _, zone := range networks.Zones {
+		if zone.NatGateway != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func zoneHasNatGateway(zones []v1alpha1.Zone, name int32) bool {
+	for _, zone := range zones {
+		if zone.Name == name {
+			return zone.NatGateway != nil && zone.NatGateway.Enabled
+		}
+	}
+	return false
+}
+
 func (s *shoot) decodeNetworkConfig(network *runtime.RawExtension) (map[string]interface{}, error) {
 	var networkConfig map[string]interface{}
 	if network == nil || network.Raw == nil {
@@ -6,6 +6,7 @@ package mutator_test
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
@@ -14,12 +15,16 @@ import (
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/mutator"
+	azureinstall "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/install"
+	azurev1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -41,13 +46,14 @@ var _ = Describe("Shoot mutator", func() {
 		BeforeEach(func() {
 			scheme := runtime.NewScheme()
 			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+			Expect(azureinstall.AddToScheme(scheme)).To(Succeed())
 
 			ctrl = gomock.NewController(GinkgoT())
 			mgr = mockmanager.NewMockManager(ctrl)
 
-			mgr.EXPECT().GetScheme().Return(scheme)
+			mgr.EXPECT().GetScheme().Return(scheme).AnyTimes()
 
-			shootMutator = mutator.NewShootMutator(mgr)
+			shootMutator = mutator.NewShootMutator(mgr, config.ShootDefaults{})
 
 			shoot = &gardencorev1beta1.Shoot{
 				ObjectMeta: metav1.ObjectMeta{
@@ -217,5 +223,233 @@ var _ = Describe("Shoot mutator", func() {
 				Expect(*shoot.Spec.SystemComponents.NodeLocalDNS.ForceTCPToUpstreamDNS).To(BeFalse())
 			})
 		})
+
+		Context("Spread NAT gateway on zone layout migration", func() {
+			encode := func(networks azurev1alpha1.NetworkConfig) *runtime.RawExtension {
+				raw, err := json.Marshal(&azurev1alpha1.InfrastructureConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: azurev1alpha1.SchemeGroupVersion.String(),
+						Kind:       "InfrastructureConfig",
+					},
+					Networks: networks,
+					Zoned:    true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				return &runtime.RawExtension{Raw: raw}
+			}
+
+			decode := func(raw *runtime.RawExtension) *azurev1alpha1.InfrastructureConfig {
+				infraConfig := &azurev1alpha1.InfrastructureConfig{}
+				Expect(json.Unmarshal(raw.Raw, infraConfig)).To(Succeed())
+				return infraConfig
+			}
+
+			zones := []azurev1alpha1.Zone{
+				{Name: 1, CIDR: "10.250.0.0/16"},
+				{Name: 2, CIDR: "10.251.0.0/16"},
+			}
+
+			BeforeEach(func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{
+					Workers: ptr.To("10.250.0.0/16"),
+					NatGateway: &azurev1alpha1.NatGatewayConfig{
+						Enabled:                      true,
+						IdleConnectionTimeoutMinutes: ptr.To[int32](10),
+						Zone:                         ptr.To[int32](1),
+						IPAddresses: []azurev1alpha1.PublicIPReference{
+							{Name: "ip-1", ResourceGroup: "rg", Zone: 1},
+						},
+					},
+				})
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Zones: zones})
+			})
+
+			It("should expand the NAT gateway into one NAT gateway per zone", func() {
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+
+				infraConfig := decode(shoot.Spec.Provider.InfrastructureConfig)
+				Expect(infraConfig.APIVersion).To(Equal(azurev1alpha1.SchemeGroupVersion.String()))
+				Expect(infraConfig.Networks.Zones).To(Equal([]azurev1alpha1.Zone{
+					{
+						Name: 1,
+						CIDR: "10.250.0.0/16",
+						NatGateway: &azurev1alpha1.ZonedNatGatewayConfig{
+							Enabled:                      true,
+							IdleConnectionTimeoutMinutes: ptr.To[int32](10),
+							IPAddresses:                  []azurev1alpha1.ZonedPublicIPReference{{Name: "ip-1", ResourceGroup: "rg"}},
+						},
+					},
+					{
+						Name: 2,
+						CIDR: "10.251.0.0/16",
+						NatGateway: &azurev1alpha1.ZonedNatGatewayConfig{
+							Enabled:                      true,
+							IdleConnectionTimeoutMinutes: ptr.To[int32](10),
+						},
+					},
+				}))
+			})
+
+			It("should not touch the zones if a NAT gateway is configured for any zone", func() {
+				zonesWithNatGateway := append([]azurev1alpha1.Zone{}, zones...)
+				zonesWithNatGateway[1].NatGateway = &azurev1alpha1.ZonedNatGatewayConfig{Enabled: false}
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Zones: zonesWithNatGateway})
+				expected := shoot.Spec.Provider.InfrastructureConfig.DeepCopy()
+
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(expected))
+			})
+
+			It("should not touch the zones if the old shoot did not use a NAT gateway", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Workers: ptr.To("10.250.0.0/16")})
+				expected := shoot.Spec.Provider.InfrastructureConfig.DeepCopy()
+
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(expected))
+			})
+
+			It("should not touch the zones if the old shoot already used the multi subnet layout", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Zones: zones})
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Zones: zones[:1]})
+				expected := shoot.Spec.Provider.InfrastructureConfig.DeepCopy()
+
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(expected))
+			})
+		})
+
+		Context("Landscape-wide shoot defaults", func() {
+			encode := func(networks azurev1alpha1.NetworkConfig) *runtime.RawExtension {
+				raw, err := json.Marshal(&azurev1alpha1.InfrastructureConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: azurev1alpha1.SchemeGroupVersion.String(),
+						Kind:       "InfrastructureConfig",
+					},
+					Networks: networks,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				return &runtime.RawExtension{Raw: raw}
+			}
+
+			decode := func(raw *runtime.RawExtension) *azurev1alpha1.InfrastructureConfig {
+				infraConfig := &azurev1alpha1.InfrastructureConfig{}
+				Expect(json.Unmarshal(raw.Raw, infraConfig)).To(Succeed())
+				return infraConfig
+			}
+
+			BeforeEach(func() {
+				shootMutator = mutator.NewShootMutator(mgr, config.ShootDefaults{
+					NatGatewayIdleConnectionTimeoutMinutes: ptr.To[int32](15),
+					OSDiskType:                             ptr.To("Premium_LRS"),
+					OutboundAccessType:                     ptr.To("NATGateway"),
+				})
+
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Workers: ptr.To("10.250.0.0/16")})
+				shoot.Spec.Provider.Workers[0].Volume = &gardencorev1beta1.Volume{VolumeSize: "50Gi"}
+			})
+
+			It("should enable a NAT gateway with the default idle timeout for new shoots", func() {
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+
+				infraConfig := decode(shoot.Spec.Provider.InfrastructureConfig)
+				Expect(infraConfig.Networks.OutboundAccessType).To(PointTo(Equal(azurev1alpha1.OutboundAccessTypeNatGateway)))
+				Expect(infraConfig.Networks.NatGateway).To(Equal(&azurev1alpha1.NatGatewayConfig{
+					Enabled:                      true,
+					IdleConnectionTimeoutMinutes: ptr.To[int32](15),
+				}))
+				Expect(shoot.Spec.Provider.Workers[0].Volume.Type).To(PointTo(Equal("Premium_LRS")))
+			})
+
+			It("should enable a NAT gateway for all zones of new shoots", func() {
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Zones: []azurev1alpha1.Zone{
+					{Name: 1, CIDR: "10.250.0.0/24"},
+					{Name: 2, CIDR: "10.250.1.0/24"},
+				}})
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+
+				infraConfig := decode(shoot.Spec.Provider.InfrastructureConfig)
+				Expect(infraConfig.Networks.NatGateway).To(BeNil())
+				for _, zone := range infraConfig.Networks.Zones {
+					Expect(zone.NatGateway).To(Equal(&azurev1alpha1.ZonedNatGatewayConfig{
+						Enabled:                      true,
+						IdleConnectionTimeoutMinutes: ptr.To[int32](15),
+					}))
+				}
+			})
+
+			It("should only set the outbound access type if the load balancer is the default", func() {
+				shootMutator = mutator.NewShootMutator(mgr, config.ShootDefaults{OutboundAccessType: ptr.To("LoadBalancer")})
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+
+				infraConfig := decode(shoot.Spec.Provider.InfrastructureConfig)
+				Expect(infraConfig.Networks.OutboundAccessType).To(PointTo(Equal(azurev1alpha1.OutboundAccessTypeLoadBalancer)))
+				Expect(infraConfig.Networks.NatGateway).To(BeNil())
+			})
+
+			It("should not override settings of the shoot owner", func() {
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{
+					Workers: ptr.To("10.250.0.0/16"),
+					NatGateway: &azurev1alpha1.NatGatewayConfig{
+						Enabled:                      true,
+						IdleConnectionTimeoutMinutes: ptr.To[int32](30),
+					},
+				})
+				shoot.Spec.Provider.Workers[0].Volume.Type = ptr.To("StandardSSD_LRS")
+				expected := shoot.Spec.Provider.DeepCopy()
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(&shoot.Spec.Provider).To(Equal(expected))
+			})
+
+			It("should not enable a NAT gateway if the shoot owner disabled it", func() {
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{
+					Workers:    ptr.To("10.250.0.0/16"),
+					NatGateway: &azurev1alpha1.NatGatewayConfig{Enabled: false},
+				})
+				expected := shoot.Spec.Provider.InfrastructureConfig.DeepCopy()
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(expected))
+			})
+
+			It("should not default the outbound access of shoots with an egress firewall", func() {
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{
+					Workers:        ptr.To("10.250.0.0/16"),
+					EgressFirewall: &azurev1alpha1.EgressFirewallConfig{CIDR: "10.251.0.0/26", ManagementCIDR: "10.251.0.64/26"},
+				})
+				expected := shoot.Spec.Provider.InfrastructureConfig.DeepCopy()
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(expected))
+			})
+
+			It("should not change the existing infrastructure and worker pools of shoots", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Workers: ptr.To("10.250.0.0/16")})
+				oldShoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: "test", Volume: &gardencorev1beta1.Volume{VolumeSize: "50Gi"}}}
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "new", Volume: &gardencorev1beta1.Volume{VolumeSize: "50Gi"}})
+				expectedInfraConfig := shoot.Spec.Provider.InfrastructureConfig.DeepCopy()
+
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(expectedInfraConfig))
+				Expect(shoot.Spec.Provider.Workers[0].Volume.Type).To(BeNil())
+				Expect(shoot.Spec.Provider.Workers[1].Volume.Type).To(PointTo(Equal("Premium_LRS")))
+			})
+
+			It("should apply the default idle timeout to NAT gateways enabled later", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{Workers: ptr.To("10.250.0.0/16")})
+				shoot.Spec.Provider.InfrastructureConfig = encode(azurev1alpha1.NetworkConfig{
+					Workers:    ptr.To("10.250.0.0/16"),
+					NatGateway: &azurev1alpha1.NatGatewayConfig{Enabled: true},
+				})
+
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+
+				infraConfig := decode(shoot.Spec.Provider.InfrastructureConfig)
+				Expect(infraConfig.Networks.NatGateway.IdleConnectionTimeoutMinutes).To(PointTo(Equal(int32(15))))
+				Expect(infraConfig.Networks.OutboundAccessType).To(BeNil())
+			})
+		})
 	})
 })
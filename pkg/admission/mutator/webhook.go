@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -20,7 +21,18 @@ const (
 	Name = "mutator"
 )
 
-var logger = log.Log.WithName("azure-mutator-webhook")
+var (
+	logger = log.Log.WithName("azure-mutator-webhook")
+
+	// DefaultAddOptions are the default AddOptions for New.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when creating the mutation webhook.
+type AddOptions struct {
+	// ShootDefaults contains the landscape-wide defaults applied to shoots.
+	ShootDefaults config.ShootDefaults
+}
 
 // New creates a new webhook that mutates Shoot and NamespacedCloudProfile resources.
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
@@ -31,8 +43,8 @@ func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 		Name:     Name,
 		Path:     "/webhooks/mutate",
 		Mutators: map[extensionswebhook.Mutator][]extensionswebhook.Type{
-			NewShootMutator(mgr):                  {{Obj: &gardencorev1beta1.Shoot{}}},
-			NewNamespacedCloudProfileMutator(mgr): {{Obj: &gardencorev1beta1.NamespacedCloudProfile{}, Subresource: ptr.To("status")}},
+			NewShootMutator(mgr, DefaultAddOptions.ShootDefaults): {{Obj: &gardencorev1beta1.Shoot{}}},
+			NewNamespacedCloudProfileMutator(mgr):                 {{Obj: &gardencorev1beta1.NamespacedCloudProfile{}, Subresource: ptr.To("status")}},
 		},
 		Target: extensionswebhook.TargetSeed,
 		ObjectSelector: &metav1.LabelSelector{
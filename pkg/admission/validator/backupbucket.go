@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	azurevalidation "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+)
+
+// NewBackupBucketValidator returns a new instance of a backup bucket validator.
+func NewBackupBucketValidator(mgr manager.Manager) extensionswebhook.Validator {
+	return &backupBucket{
+		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+	}
+}
+
+type backupBucket struct {
+	decoder runtime.Decoder
+}
+
+// Validate validates the given BackupBucket objects.
+func (b *backupBucket) Validate(_ context.Context, newObj, oldObj client.Object) error {
+	backupBucket, ok := newObj.(*core.BackupBucket)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+
+	providerConfigPath := field.NewPath("spec", "providerConfig")
+	config, err := decodeBackupBucketConfig(b.decoder, backupBucket.Spec.ProviderConfig)
+	if err != nil {
+		return err
+	}
+	allErrs := azurevalidation.ValidateBackupBucketConfig(config, providerConfigPath)
+
+	if oldObj != nil {
+		oldBackupBucket, ok := oldObj.(*core.BackupBucket)
+		if !ok {
+			return fmt.Errorf("wrong object type %T for old object", oldObj)
+		}
+		oldConfig, err := decodeBackupBucketConfig(b.decoder, oldBackupBucket.Spec.ProviderConfig)
+		if err != nil {
+			return err
+		}
+		allErrs = append(allErrs, azurevalidation.ValidateBackupBucketConfigUpdate(oldConfig, config, backupBucket.Spec.Provider.Region, providerConfigPath)...)
+	}
+
+	return allErrs.ToAggregate()
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/validator"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/install"
+)
+
+var _ = Describe("BackupBucket Validator", func() {
+	var (
+		ctx = context.Background()
+
+		backupBucketValidator extensionswebhook.Validator
+		backupBucket          *core.BackupBucket
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(install.AddToScheme(scheme))
+
+		backupBucketValidator = validator.NewBackupBucketValidator(&test.FakeManager{Scheme: scheme})
+		backupBucket = &core.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+			Spec: core.BackupBucketSpec{
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","requireInfrastructureEncryption":true}`)},
+			},
+		}
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed for a BackupBucket without provider config", func() {
+			backupBucket.Spec.ProviderConfig = nil
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(Succeed())
+		})
+
+		It("should succeed for a valid provider config", func() {
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(Succeed())
+		})
+
+		It("should fail for an invalid provider config", func() {
+			backupBucket.Spec.ProviderConfig.Raw = []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","minimumTlsVersion":"TLS1_0"}`)
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(MatchError(ContainSubstring("spec.providerConfig.minimumTlsVersion")))
+		})
+
+		It("should succeed for an update which keeps the infrastructure encryption", func() {
+			oldBackupBucket := backupBucket.DeepCopy()
+			backupBucket.Spec.ProviderConfig.Raw = []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","requireInfrastructureEncryption":true,"minimumTlsVersion":"TLS1_3"}`)
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, oldBackupBucket)).To(Succeed())
+		})
+
+		It("should fail for an update which changes the infrastructure encryption", func() {
+			oldBackupBucket := backupBucket.DeepCopy()
+			backupBucket.Spec.ProviderConfig = nil
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, oldBackupBucket)).To(MatchError(ContainSubstring("spec.providerConfig.requireInfrastructureEncryption: Invalid value: false: field is immutable")))
+		})
+	})
+})
@@ -62,3 +62,16 @@ func decodeCloudProfileConfig(decoder runtime.Decoder, config *runtime.RawExtens
 	}
 	return cloudProfileConfig, nil
 }
+
+func decodeBackupBucketConfig(decoder runtime.Decoder, config *runtime.RawExtension) (*azure.BackupBucketConfig, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	backupBucketConfig := &azure.BackupBucketConfig{}
+	if err := util.Decode(decoder, config.Raw, backupBucketConfig); err != nil {
+		return nil, err
+	}
+
+	return backupBucketConfig, nil
+}
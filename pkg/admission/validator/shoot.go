@@ -8,20 +8,28 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/utils/gardener"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 	azurevalidation "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 var (
@@ -37,16 +45,20 @@ var (
 // shoot validates shoots
 type shoot struct {
 	client         client.Client
+	apiReader      client.Reader
 	decoder        runtime.Decoder
 	lenientDecoder runtime.Decoder
+	policy         config.Policy
 }
 
 // NewShootValidator returns a new instance of a shoot validator.
-func NewShootValidator(mgr manager.Manager) extensionswebhook.Validator {
+func NewShootValidator(mgr manager.Manager, policy config.Policy) extensionswebhook.Validator {
 	return &shoot{
 		client:         mgr.GetClient(),
+		apiReader:      mgr.GetAPIReader(),
 		decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		lenientDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		policy:         policy,
 	}
 }
 
@@ -86,7 +98,7 @@ func (s *shoot) Validate(ctx context.Context, newObj, oldObj client.Object) erro
 	return s.validateCreation(ctx, shoot, &cloudProfile.Spec)
 }
 
-func (s *shoot) validateCreation(_ context.Context, shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) error {
+func (s *shoot) validateCreation(ctx context.Context, shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) error {
 	infraConfig, err := checkAndDecodeInfrastructureConfig(s.decoder, shoot.Spec.Provider.InfrastructureConfig, infraConfigPath)
 	if err != nil {
 		return err
@@ -100,7 +112,18 @@ func (s *shoot) validateCreation(_ context.Context, shoot *core.Shoot, cloudProf
 		}
 	}
 
-	return s.validateShoot(shoot, nil, infraConfig, cloudProfileSpec, cpConfig).ToAggregate()
+	allErrs := s.validateShoot(shoot, nil, infraConfig, cloudProfileSpec, cpConfig)
+	allErrs = append(allErrs, s.validateNatGatewayPolicy(shoot, infraConfig)...)
+	allErrs = append(allErrs, s.validateZoneRedundantNatGatewayPolicy(shoot, infraConfig)...)
+	allErrs = append(allErrs, s.validateZoneConsistency(shoot, infraConfig)...)
+	allErrs = append(allErrs, s.validateWorkerMachines(nil, shoot, cloudProfileSpec)...)
+
+	// The credentials are only checked if the shoot is valid otherwise, as the checks require calls to Azure.
+	if len(allErrs) == 0 {
+		allErrs = append(allErrs, s.validateCredentials(ctx, shoot, infraConfig, cloudProfileSpec)...)
+	}
+
+	return allErrs.ToAggregate()
 }
 
 func (s *shoot) validateShoot(shoot *core.Shoot, oldInfraConfig, infraConfig *api.InfrastructureConfig, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, cpConfig *api.ControlPlaneConfig) field.ErrorList {
@@ -114,21 +137,38 @@ func (s *shoot) validateShoot(shoot *core.Shoot, oldInfraConfig, infraConfig *ap
 		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigAgainstCloudProfile(oldInfraConfig, infraConfig, shoot.Spec.Region, cloudProfileSpec, infraConfigPath)...)
 		// Provider validation
 		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfig(infraConfig, shoot, infraConfigPath)...)
+		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigServiceEndpoints(oldInfraConfig, infraConfig, infraConfigPath)...)
+		allErrs = append(allErrs, s.validateDeletionProtection(shoot, infraConfig)...)
 	}
 	if cpConfig != nil {
-		allErrs = append(allErrs, azurevalidation.ValidateControlPlaneConfig(cpConfig, shoot.Spec.Kubernetes.Version, cpConfigPath)...)
+		allErrs = append(allErrs, azurevalidation.ValidateControlPlaneConfig(cpConfig, infraConfig, shoot.Spec.Kubernetes.Version, cpConfigPath)...)
 	}
 
 	// Shoot workers
 	allErrs = append(allErrs, azurevalidation.ValidateWorkers(shoot.Spec.Provider.Workers, infraConfig, workersPath)...)
 
+	var cloudProfileConfig *api.CloudProfileConfig
 	for i, worker := range shoot.Spec.Provider.Workers {
 		workerFldPath := workersPath.Index(i)
 		workerConfig, err := decodeWorkerConfig(s.decoder, worker.ProviderConfig)
 		if err != nil {
 			allErrs = append(allErrs, field.Invalid(workerFldPath.Child("providerConfig"), err, "invalid providerConfig"))
-		} else {
-			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfig(workerConfig, worker.DataVolumes, workerFldPath.Child("providerConfig"))...)
+			continue
+		}
+		allErrs = append(allErrs, azurevalidation.ValidateWorkerConfig(workerConfig, &worker, workerFldPath.Child("providerConfig"))...)
+		allErrs = append(allErrs, azurevalidation.ValidateWorkerConfigAgainstInfrastructureConfig(workerConfig, infraConfig, workerFldPath.Child("providerConfig"))...)
+
+		// The CloudProfileConfig is only required to validate a custom fault domain count of the worker pool VMO and a
+		// custom cloud configuration of the worker pool.
+		if workerConfig != nil && ((workerConfig.Vmo != nil && workerConfig.Vmo.FaultDomainCount != nil) || workerConfig.CloudConfiguration != nil) {
+			if cloudProfileConfig == nil && cloudProfileSpec.ProviderConfig != nil {
+				if cloudProfileConfig, err = decodeCloudProfileConfig(s.lenientDecoder, cloudProfileSpec.ProviderConfig); err != nil {
+					allErrs = append(allErrs, field.InternalError(workerFldPath.Child("providerConfig"), fmt.Errorf("could not decode CloudProfileConfig: %w", err)))
+					continue
+				}
+			}
+			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfigAgainstCloudProfile(workerConfig, shoot.Spec.Region, cloudProfileConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, validateWorkerCloudConfiguration(workerConfig, shoot.Spec.Region, cloudProfileConfig, workerFldPath.Child("providerConfig", "cloudConfiguration"))...)
 		}
 	}
 
@@ -163,14 +203,264 @@ func (s *shoot) validateUpdate(oldShoot, shoot *core.Shoot, cloudProfileSpec *ga
 		}
 	}
 
+	// Decode the old controlplane config
+	var oldCpConfig *api.ControlPlaneConfig
+	if oldShoot.Spec.Provider.ControlPlaneConfig != nil {
+		oldCpConfig, err = decodeControlPlaneConfig(s.lenientDecoder, oldShoot.Spec.Provider.ControlPlaneConfig)
+		if err != nil {
+			return err
+		}
+	}
+
 	var allErrs = field.ErrorList{}
 	if !reflect.DeepEqual(oldInfraConfig, infraConfig) {
 		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigUpdate(oldInfraConfig, infraConfig, metaDataPath)...)
+		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigZonalMigration(oldInfraConfig, infraConfig, shoot, cloudProfileSpec, infraConfigPath)...)
 	}
 
+	allErrs = append(allErrs, azurevalidation.ValidateControlPlaneConfigUpdate(oldCpConfig, cpConfig, cpConfigPath)...)
+
 	allErrs = append(allErrs, azurevalidation.ValidateWorkersUpdate(oldShoot.Spec.Provider.Workers, shoot.Spec.Provider.Workers, workersPath)...)
 
 	allErrs = append(allErrs, s.validateShoot(shoot, oldInfraConfig, infraConfig, cloudProfileSpec, cpConfig)...)
+	allErrs = append(allErrs, s.validateWorkerMachines(oldShoot, shoot, cloudProfileSpec)...)
+
+	// Shoots which already use a NAT gateway must not fall back to the default outbound access of the load balancer.
+	if usesNatGateway(oldInfraConfig) {
+		allErrs = append(allErrs, s.validateNatGatewayPolicy(shoot, infraConfig)...)
+	}
+
+	// Highly available shoots which are not zone-redundant yet can still be updated, but must not lose their zone redundancy once they have it.
+	if len(s.validateZoneRedundantNatGatewayPolicy(oldShoot, oldInfraConfig)) == 0 {
+		allErrs = append(allErrs, s.validateZoneRedundantNatGatewayPolicy(shoot, infraConfig)...)
+	}
+
+	// Existing shoots which are already inconsistent can still be updated, but must not become inconsistent once they are not.
+	if len(s.validateZoneConsistency(oldShoot, oldInfraConfig)) == 0 {
+		allErrs = append(allErrs, s.validateZoneConsistency(shoot, infraConfig)...)
+	}
 
 	return allErrs.ToAggregate()
 }
+
+// validateWorkerMachines validates the machines of the worker pools against the machine types and images of the
+// CloudProfileConfig. Worker pools whose machine is unchanged are skipped, so that shoots can still be updated if the
+// CloudProfileConfig is changed.
+func (s *shoot) validateWorkerMachines(oldShoot, shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cloudProfileSpec.ProviderConfig == nil {
+		return allErrs
+	}
+
+	var cloudProfileConfig *api.CloudProfileConfig
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if oldShoot != nil && slices.ContainsFunc(oldShoot.Spec.Provider.Workers, func(oldWorker core.Worker) bool {
+			return oldWorker.Name == worker.Name && reflect.DeepEqual(oldWorker.Machine, worker.Machine)
+		}) {
+			continue
+		}
+
+		if cloudProfileConfig == nil {
+			var err error
+			if cloudProfileConfig, err = decodeCloudProfileConfig(s.lenientDecoder, cloudProfileSpec.ProviderConfig); err != nil {
+				allErrs = append(allErrs, field.InternalError(workersPath, fmt.Errorf("could not decode CloudProfileConfig: %w", err)))
+				return allErrs
+			}
+		}
+		allErrs = append(allErrs, azurevalidation.ValidateWorkerMachineAgainstCloudProfile(worker, cloudProfileConfig, workersPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+// validateWorkerCloudConfiguration validates that the cloud configuration of a worker pool matches the cloud instance
+// of the shoot, i.e. the one of the CloudProfile resp. the one derived from the region, as the machines are created with
+// the credentials of the shoot, which are only valid in this cloud instance.
+func validateWorkerCloudConfiguration(workerConfig *api.WorkerConfig, region string, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig.CloudConfiguration == nil {
+		return allErrs
+	}
+
+	var shootCloudConfiguration *api.CloudConfiguration
+	if cloudProfileConfig != nil {
+		shootCloudConfiguration = cloudProfileConfig.CloudConfiguration
+	}
+	shootCloudConfiguration, err := azureclient.CloudConfiguration(shootCloudConfiguration, &region)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, err))
+		return allErrs
+	}
+
+	if !strings.EqualFold(workerConfig.CloudConfiguration.Name, shootCloudConfiguration.Name) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), workerConfig.CloudConfiguration.Name, fmt.Sprintf("must match the cloud instance %q of the shoot's credentials", shootCloudConfiguration.Name)))
+	}
+	return allErrs
+}
+
+// validateDeletionProtection validates that the deletion protection of the resource group is only enabled for shoots
+// reconciled by the flow, as the Terraform reconciler does not implement it and would fail every reconciliation.
+func (s *shoot) validateDeletionProtection(shoot *core.Shoot, infraConfig *api.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !helper.IsResourceGroupDeletionProtected(infraConfig) {
+		return allErrs
+	}
+
+	for _, key := range azure.ValidFlowAnnotations {
+		if kutil.HasMetaDataAnnotation(shoot, key, "true") {
+			return allErrs
+		}
+	}
+
+	allErrs = append(allErrs, field.Forbidden(infraConfigPath.Child("resourceGroup", "deletionProtection"), fmt.Sprintf("the deletion protection of the resource group is only supported with the flow reconciler, enable it via annotation %q", azure.AnnotationKeyUseFlow)))
+	return allErrs
+}
+
+func (s *shoot) validateNatGatewayPolicy(shoot *core.Shoot, infraConfig *api.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !s.policy.RequireNatGateway || s.isExemptFromNatGatewayPolicies(shoot) {
+		return allErrs
+	}
+
+	if !usesNatGateway(infraConfig) {
+		allErrs = append(allErrs, field.Forbidden(infraConfigPath.Child("networks"), "a NAT gateway is required for outbound access in this landscape, the default outbound access of the load balancer must not be used"))
+	}
+
+	return allErrs
+}
+
+func (s *shoot) validateZoneRedundantNatGatewayPolicy(shoot *core.Shoot, infraConfig *api.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !s.policy.RequireZoneRedundantNatGateway || s.isExemptFromNatGatewayPolicies(shoot) {
+		return allErrs
+	}
+
+	// Non-zonal clusters are placed in an availability set and cannot spread their egress across zones.
+	if infraConfig == nil || !infraConfig.Zoned || !isHighlyAvailable(shoot) {
+		return allErrs
+	}
+
+	for _, zone := range sets.List(workerZones(shoot)) {
+		if !hasZonalNatGateway(infraConfig, zone) {
+			allErrs = append(allErrs, field.Forbidden(infraConfigPath.Child("networks"), fmt.Sprintf("highly available shoots must use a dedicated NAT gateway in every zone of their workers, but zone %q has none", zone)))
+		}
+	}
+
+	return allErrs
+}
+
+// isExemptFromNatGatewayPolicies checks whether the operators exempted the project of the given shoot from the NAT
+// gateway policies.
+func (s *shoot) isExemptFromNatGatewayPolicies(shoot *core.Shoot) bool {
+	return slices.Contains(s.policy.NatGatewayExemptNamespaces, shoot.Namespace)
+}
+
+// validateZoneConsistency validates the zones and the VMO settings of the worker pools against the InfrastructureConfig
+// across the shoot, as the infrastructure and the worker controller would otherwise only fail one after the other.
+func (s *shoot) validateZoneConsistency(shoot *core.Shoot, infraConfig *api.InfrastructureConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if infraConfig == nil {
+		return allErrs
+	}
+
+	// In the multiple subnet layout every zone egresses via its own subnet, hence the workers of a zone without NAT
+	// gateway would fall back to the default outbound access while the workers of the other zones use a NAT gateway.
+	if hasZonalNatGateways(infraConfig) {
+		zones := workerZones(shoot)
+		for i, zone := range infraConfig.Networks.Zones {
+			if zones.Has(helper.InfrastructureZoneToString(zone.Name)) && (zone.NatGateway == nil || !zone.NatGateway.Enabled) {
+				allErrs = append(allErrs, field.Required(infraConfigPath.Child("networks", "zones").Index(i).Child("natGateway"), fmt.Sprintf("a NAT gateway must be enabled for zone %d as it contains workers and the other zones use a NAT gateway", zone.Name)))
+			}
+		}
+	}
+
+	// The machines of zoned shoots are placed in their zone and never in a VirtualMachineScaleSet Orchestration Mode VM.
+	if !infraConfig.Zoned {
+		return allErrs
+	}
+	for _, annotation := range []string{azure.ShootVmoUsageAnnotation, azure.ShootVmoMigrationAnnotation} {
+		if kutil.HasMetaDataAnnotation(shoot, annotation, "true") {
+			allErrs = append(allErrs, field.Forbidden(metaDataPath.Child("annotations").Key(annotation), "VMOs can only be used for non-zoned shoots"))
+		}
+	}
+	for i, worker := range shoot.Spec.Provider.Workers {
+		// Invalid worker configurations are already reported by the validation of the workers.
+		workerConfig, err := decodeWorkerConfig(s.lenientDecoder, worker.ProviderConfig)
+		if err != nil || workerConfig == nil {
+			continue
+		}
+		if workerConfig.Vmo != nil {
+			allErrs = append(allErrs, field.Forbidden(workersPath.Index(i).Child("providerConfig", "vmo"), "VMOs can only be used for non-zoned shoots"))
+		}
+	}
+
+	return allErrs
+}
+
+// isHighlyAvailable checks whether the shoot has a zone failure tolerant control plane or spreads its workers across multiple zones.
+func isHighlyAvailable(shoot *core.Shoot) bool {
+	if shoot.Spec.ControlPlane != nil &&
+		shoot.Spec.ControlPlane.HighAvailability != nil &&
+		shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type == core.FailureToleranceTypeZone {
+		return true
+	}
+	return workerZones(shoot).Len() > 1
+}
+
+func workerZones(shoot *core.Shoot) sets.Set[string] {
+	zones := sets.New[string]()
+	for _, worker := range shoot.Spec.Provider.Workers {
+		zones.Insert(worker.Zones...)
+	}
+	return zones
+}
+
+// hasZonalNatGateway checks whether the given zone has a dedicated NAT gateway. In the single subnet layout, the NAT
+// gateway only serves the zone it is deployed to.
+func hasZonalNatGateway(infraConfig *api.InfrastructureConfig, zone string) bool {
+	if len(infraConfig.Networks.Zones) > 0 {
+		for _, z := range infraConfig.Networks.Zones {
+			if helper.InfrastructureZoneToString(z.Name) == zone {
+				return z.NatGateway != nil && z.NatGateway.Enabled
+			}
+		}
+		return false
+	}
+
+	natGateway := infraConfig.Networks.NatGateway
+	return natGateway != nil && natGateway.Enabled && natGateway.Zone != nil && helper.InfrastructureZoneToString(*natGateway.Zone) == zone
+}
+
+// hasZonalNatGateways checks whether a NAT gateway is enabled for any zone of the multiple subnet layout.
+func hasZonalNatGateways(infraConfig *api.InfrastructureConfig) bool {
+	for _, zone := range infraConfig.Networks.Zones {
+		if zone.NatGateway != nil && zone.NatGateway.Enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// usesNatGateway checks whether all worker subnets of the given infrastructure configuration use a NAT gateway for outbound access.
+func usesNatGateway(infraConfig *api.InfrastructureConfig) bool {
+	if infraConfig == nil {
+		return false
+	}
+
+	if len(infraConfig.Networks.Zones) > 0 {
+		for _, zone := range infraConfig.Networks.Zones {
+			if zone.NatGateway == nil || !zone.NatGateway.Enabled {
+				return false
+			}
+		}
+		return true
+	}
+
+	return infraConfig.Networks.NatGateway != nil && infraConfig.Networks.NatGateway.Enabled
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+// credentialsPreflightTimeout is the maximum duration of the Azure calls of the credentials preflight. It is shorter
+// than the timeout of the admission webhook, so that a slow response of Azure does not block the shoot creation.
+const credentialsPreflightTimeout = 5 * time.Second
+
+var (
+	secretBindingNamePath      = specPath.Child("secretBindingName")
+	credentialsBindingNamePath = specPath.Child("credentialsBindingName")
+)
+
+// NewAzureClientFactoryFunc is a hook to monkeypatch the factory ctor during tests.
+var NewAzureClientFactoryFunc = azureclient.NewAzureClientFactory
+
+// validateCredentials performs cheap, read-only Azure calls with the credentials of the given shoot to reject it early
+// if the credentials cannot be authenticated or lack the permissions to read the resources the infrastructure
+// reconciliation depends on. The calls are limited to the credentialsPreflightTimeout. Errors which do not indicate a
+// problem with the credentials, e.g. timeouts, are ignored to not block the shoot creation because of temporary issues.
+func (s *shoot) validateCredentials(ctx context.Context, shoot *core.Shoot, infraConfig *api.InfrastructureConfig, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !s.policy.CredentialsPreflight || infraConfig == nil {
+		return allErrs
+	}
+
+	secret, fldPath, err := s.getCredentialsSecret(ctx, shoot)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not read the credentials of the shoot: %w", err)))
+	}
+	if secret == nil {
+		return allErrs
+	}
+
+	auth, _, err := internal.NewClientAuthDataFromSecret(secret, false)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, secret.Name, err.Error()))
+	}
+
+	var cloudConfiguration *api.CloudConfiguration
+	if cloudProfileSpec.ProviderConfig != nil {
+		cloudProfileConfig, err := decodeCloudProfileConfig(s.lenientDecoder, cloudProfileSpec.ProviderConfig)
+		if err != nil {
+			return append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not decode CloudProfileConfig: %w", err)))
+		}
+		cloudConfiguration = cloudProfileConfig.CloudConfiguration
+	}
+	azCloudConfiguration, err := azureclient.AzureCloudConfiguration(cloudConfiguration, &shoot.Spec.Region)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+
+	factory, err := NewAzureClientFactoryFunc(auth, azureclient.WithCloudConfiguration(azCloudConfiguration))
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, fmt.Errorf("could not create Azure client factory: %w", err)))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, credentialsPreflightTimeout)
+	defer cancel()
+
+	// The project name is not known here, hence the name of the shoot's resource group is only approximated. The read
+	// of a resource group which does not exist yet is authorized against the subscription, i.e. the scope in which the
+	// resource group is created later on.
+	resourceGroupName := fmt.Sprintf("%s-%s--%s", v1beta1constants.TechnicalIDPrefix, strings.TrimPrefix(shoot.Namespace, gardenerutils.ProjectNamespacePrefix), shoot.Name)
+	if err := checkResourceGroup(ctx, factory, resourceGroupName); err != nil {
+		// Further checks are pointless if the credentials cannot be authenticated.
+		return append(allErrs, credentialsError(fldPath, fmt.Sprintf("resource groups in subscription %q", auth.SubscriptionID), err)...)
+	}
+
+	if vnet := infraConfig.Networks.VNet; vnet.Name != nil && vnet.ResourceGroup != nil {
+		vnetPath := infraConfigPath.Child("networks", "vnet")
+		found, err := checkVirtualNetwork(ctx, factory, *vnet.ResourceGroup, *vnet.Name)
+		if err != nil {
+			allErrs = append(allErrs, credentialsError(vnetPath, fmt.Sprintf("virtual network %s/%s", *vnet.ResourceGroup, *vnet.Name), err)...)
+		} else if !found {
+			allErrs = append(allErrs, field.NotFound(vnetPath.Child("name"), *vnet.Name))
+		}
+	}
+
+	if identity := infraConfig.Identity; identity != nil {
+		identityPath := infraConfigPath.Child("identity")
+		found, err := checkManagedUserIdentity(ctx, factory, identity)
+		if err != nil {
+			allErrs = append(allErrs, credentialsError(identityPath, fmt.Sprintf("managed identity %s/%s", identity.ResourceGroup, identity.Name), err)...)
+		} else if !found {
+			allErrs = append(allErrs, field.NotFound(identityPath.Child("name"), identity.Name))
+		}
+	}
+
+	return allErrs
+}
+
+// getCredentialsSecret returns the secret referenced by the binding of the given shoot together with the path of the
+// binding name in the shoot. It returns no secret if the shoot does not use secret-based credentials.
+func (s *shoot) getCredentialsSecret(ctx context.Context, shoot *core.Shoot) (*corev1.Secret, *field.Path, error) {
+	var (
+		secretRef corev1.SecretReference
+		fldPath   *field.Path
+	)
+
+	switch {
+	case shoot.Spec.SecretBindingName != nil:
+		fldPath = secretBindingNamePath
+		secretBinding := &gardencorev1beta1.SecretBinding{}
+		if err := s.apiReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+			return nil, fldPath, err
+		}
+		secretRef = secretBinding.SecretRef
+
+	case shoot.Spec.CredentialsBindingName != nil:
+		fldPath = credentialsBindingNamePath
+		credentialsBinding := &securityv1alpha1.CredentialsBinding{}
+		if err := s.apiReader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.CredentialsBindingName}, credentialsBinding); err != nil {
+			return nil, fldPath, err
+		}
+		if credentialsBinding.CredentialsRef.APIVersion != corev1.SchemeGroupVersion.String() || credentialsBinding.CredentialsRef.Kind != "Secret" {
+			return nil, fldPath, nil
+		}
+		secretRef = corev1.SecretReference{Namespace: credentialsBinding.CredentialsRef.Namespace, Name: credentialsBinding.CredentialsRef.Name}
+
+	default:
+		return nil, nil, nil
+	}
+
+	// Explicitly use the client.Reader to prevent controller-runtime to start Informer for Secrets
+	// under the hood. The latter increases the memory usage of the component.
+	secret := &corev1.Secret{}
+	if err := s.apiReader.Get(ctx, client.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, secret); err != nil {
+		return nil, fldPath, err
+	}
+	return secret, fldPath, nil
+}
+
+func checkResourceGroup(ctx context.Context, factory azureclient.Factory, name string) error {
+	groupClient, err := factory.Group()
+	if err != nil {
+		return err
+	}
+	_, err = groupClient.Get(ctx, name)
+	return err
+}
+
+func checkVirtualNetwork(ctx context.Context, factory azureclient.Factory, resourceGroup, name string) (bool, error) {
+	vnetClient, err := factory.Vnet()
+	if err != nil {
+		return false, err
+	}
+	vnet, err := vnetClient.Get(ctx, resourceGroup, name)
+	return vnet != nil, err
+}
+
+func checkManagedUserIdentity(ctx context.Context, factory azureclient.Factory, identity *api.IdentityConfig) (bool, error) {
+	identityClient, err := factory.ManagedUserIdentity(azureclient.ManagedUserIdentityOptions(identity)...)
+	if err != nil {
+		return false, err
+	}
+	res, err := identityClient.Get(ctx, identity.ResourceGroup, identity.Name)
+	return res != nil, err
+}
+
+// credentialsError converts the error of a preflight check into an actionable validation error. Errors which do not
+// indicate a problem with the credentials are only logged.
+func credentialsError(fldPath *field.Path, resource string, err error) field.ErrorList {
+	switch {
+	case azureclient.IsAzureAPIUnauthorized(err):
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("the credentials could not be authenticated with Azure, please check the tenant ID, client ID and client secret: %v", err))}
+	case azureclient.IsAzureAPIForbidden(err):
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("the credentials are not permitted to read the %s, please check the role assignments of the service principal: %v", resource, err))}
+	default:
+		logger.Error(err, "Credentials preflight check failed, skipping it", "resource", resource)
+		return nil
+	}
+}
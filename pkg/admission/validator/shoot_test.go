@@ -7,7 +7,11 @@ package validator_test
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -27,7 +31,11 @@ import (
 	"github.com/gardener/gardener-extension-provider-azure/pkg/admission/validator"
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	apisazurev1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
 )
 
 var _ = Describe("Shoot validator", func() {
@@ -38,6 +46,7 @@ var _ = Describe("Shoot validator", func() {
 			shootValidator extensionswebhook.Validator
 
 			ctrl                   *gomock.Controller
+			scheme                 *runtime.Scheme
 			mgr                    *mockmanager.MockManager
 			c                      *mockclient.MockClient
 			cloudProfile           *gardencorev1beta1.CloudProfile
@@ -57,7 +66,7 @@ var _ = Describe("Shoot validator", func() {
 		BeforeEach(func() {
 			ctrl = gomock.NewController(GinkgoT())
 
-			scheme := runtime.NewScheme()
+			scheme = runtime.NewScheme()
 			Expect(apisazure.AddToScheme(scheme)).To(Succeed())
 			Expect(apisazurev1alpha1.AddToScheme(scheme)).To(Succeed())
 			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
@@ -67,8 +76,9 @@ var _ = Describe("Shoot validator", func() {
 
 			mgr.EXPECT().GetScheme().Return(scheme).Times(2)
 			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetAPIReader().Return(c)
 
-			shootValidator = validator.NewShootValidator(mgr)
+			shootValidator = validator.NewShootValidator(mgr, config.Policy{})
 
 			cloudProfile = &gardencorev1beta1.CloudProfile{
 				ObjectMeta: metav1.ObjectMeta{
@@ -275,6 +285,531 @@ var _ = Describe("Shoot validator", func() {
 			})
 		})
 
+		Context("Deletion protection", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureConfig",
+						},
+						Networks: apisazurev1alpha1.NetworkConfig{
+							Workers: ptr.To("10.250.0.0/16"),
+						},
+						ResourceGroup: &apisazurev1alpha1.ResourceGroup{DeletionProtection: ptr.To(true)},
+						Zoned:         true,
+					}),
+				}
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+			})
+
+			It("should forbid the deletion protection for shoots reconciled by Terraform", func() {
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.infrastructureConfig.resourceGroup.deletionProtection"),
+				}))))
+			})
+
+			It("should allow the deletion protection for shoots reconciled by the flow", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, azure.AnnotationKeyUseFlow, "true")
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			})
+		})
+
+		Context("worker pool cloud configuration", func() {
+			encodeWorkerConfig := func(cloudName string) *runtime.RawExtension {
+				return &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						CloudConfiguration: &apisazurev1alpha1.CloudConfiguration{Name: cloudName},
+					}),
+				}
+			}
+
+			BeforeEach(func() {
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+			})
+
+			It("should allow the cloud instance of the shoot", func() {
+				shoot.Spec.Provider.Workers[0].ProviderConfig = encodeWorkerConfig("azurepublic")
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			})
+
+			It("should forbid a cloud instance which differs from the one of the shoot", func() {
+				shoot.Spec.Provider.Workers[0].ProviderConfig = encodeWorkerConfig("AzureChina")
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.provider.workers[0].providerConfig.cloudConfiguration.name"),
+					"Detail": ContainSubstring(`"AzurePublic"`),
+				}))))
+			})
+		})
+
+		Context("worker pool machines", func() {
+			const machineType = "Standard_D4as_v6"
+
+			setNVMeCloudProfile := func(imageSupportsNVMe bool) {
+				cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.CloudProfileConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "CloudProfileConfig",
+						},
+						MachineTypes: []apisazurev1alpha1.MachineType{
+							{Name: machineType, DiskControllerType: ptr.To(apisazurev1alpha1.DiskControllerTypeNVMe)},
+						},
+						MachineImages: []apisazurev1alpha1.MachineImages{
+							{
+								Name: imageName,
+								Versions: []apisazurev1alpha1.MachineImageVersion{
+									{Version: imageVersion, Architecture: architecture, NVMe: ptr.To(imageSupportsNVMe)},
+								},
+							},
+						},
+					}),
+				}
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+			}
+
+			BeforeEach(func() {
+				shoot.Spec.Provider.Workers[0].Machine.Type = machineType
+			})
+
+			It("should allow a machine image supporting the NVMe disk controller of the machine type", func() {
+				setNVMeCloudProfile(true)
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			})
+
+			It("should forbid a machine image not supporting the NVMe disk controller of the machine type", func() {
+				setNVMeCloudProfile(false)
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.provider.workers[0].machine.image"),
+					"Detail": ContainSubstring(`does not support the NVMe disk controller which is required by machine type "` + machineType + `"`),
+				}))))
+			})
+
+			It("should allow to update a shoot if the machine of the worker pool is unchanged", func() {
+				setNVMeCloudProfile(false)
+
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum = 5
+
+				Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+			})
+
+			It("should forbid to update a worker pool to a machine type requiring the NVMe disk controller", func() {
+				setNVMeCloudProfile(false)
+
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.Provider.Workers[0].Machine.Type = "Standard_D4as_v5"
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.workers[0].machine.image"),
+				}))))
+			})
+		})
+
+		Context("NAT gateway policy", func() {
+			var oldShoot *core.Shoot
+
+			encodeInfrastructureConfig := func(natGateway *apisazurev1alpha1.NatGatewayConfig) *runtime.RawExtension {
+				return &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureConfig",
+						},
+						Networks: apisazurev1alpha1.NetworkConfig{
+							Workers:    ptr.To("10.250.0.0/16"),
+							NatGateway: natGateway,
+						},
+						Zoned: true,
+					}),
+				}
+			}
+
+			BeforeEach(func() {
+				mgr.EXPECT().GetScheme().Return(scheme).Times(2)
+				mgr.EXPECT().GetClient().Return(c)
+				mgr.EXPECT().GetAPIReader().Return(c)
+				shootValidator = validator.NewShootValidator(mgr, config.Policy{RequireNatGateway: true, NatGatewayExemptNamespaces: []string{"garden-exempt"}})
+
+				oldShoot = shoot.DeepCopy()
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+			})
+
+			It("should forbid the creation of a shoot without NAT gateway", func() {
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.infrastructureConfig.networks"),
+				}))))
+			})
+
+			It("should allow the creation of a shoot with NAT gateway", func() {
+				shoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(&apisazurev1alpha1.NatGatewayConfig{Enabled: true})
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow the creation of an exempted shoot without NAT gateway", func() {
+				shoot.Namespace = "garden-exempt"
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow updates of legacy shoots without NAT gateway", func() {
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should forbid disabling the NAT gateway of an existing shoot", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(&apisazurev1alpha1.NatGatewayConfig{Enabled: true})
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.infrastructureConfig.networks"),
+				}))))
+			})
+		})
+
+		Context("zone-redundant NAT gateway policy", func() {
+			var oldShoot *core.Shoot
+
+			encodeInfrastructureConfig := func(networks apisazurev1alpha1.NetworkConfig) *runtime.RawExtension {
+				return &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureConfig",
+						},
+						Networks: networks,
+						Zoned:    true,
+					}),
+				}
+			}
+
+			BeforeEach(func() {
+				mgr.EXPECT().GetScheme().Return(scheme).Times(2)
+				mgr.EXPECT().GetClient().Return(c)
+				mgr.EXPECT().GetAPIReader().Return(c)
+				shootValidator = validator.NewShootValidator(mgr, config.Policy{RequireZoneRedundantNatGateway: true, NatGatewayExemptNamespaces: []string{"garden-exempt"}})
+
+				shoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(apisazurev1alpha1.NetworkConfig{
+					Workers:    ptr.To("10.250.0.0/16"),
+					NatGateway: &apisazurev1alpha1.NatGatewayConfig{Enabled: true, Zone: ptr.To[int32](1)},
+				})
+				oldShoot = shoot.DeepCopy()
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+			})
+
+			It("should allow the creation of a shoot which is not highly available", func() {
+				shoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(apisazurev1alpha1.NetworkConfig{Workers: ptr.To("10.250.0.0/16")})
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should forbid the creation of a shoot with zone failure tolerant control plane without NAT gateway", func() {
+				shoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(apisazurev1alpha1.NetworkConfig{Workers: ptr.To("10.250.0.0/16")})
+				shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.provider.infrastructureConfig.networks"),
+					"Detail": ContainSubstring(`zone "1" has none`),
+				}))))
+			})
+
+			It("should allow the creation of a shoot with zone failure tolerant control plane and a NAT gateway in the zone of its workers", func() {
+				shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should forbid the creation of a shoot with multi-zone workers sharing a single NAT gateway", func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"1", "2"}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.provider.infrastructureConfig.networks"),
+					"Detail": ContainSubstring(`zone "2" has none`),
+				}))))
+			})
+
+			It("should allow the creation of a shoot with multi-zone workers and a NAT gateway per zone", func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"1", "2"}
+				shoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(apisazurev1alpha1.NetworkConfig{
+					VNet: apisazurev1alpha1.VNet{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []apisazurev1alpha1.Zone{
+						{Name: 1, CIDR: "10.250.0.0/19", NatGateway: &apisazurev1alpha1.ZonedNatGatewayConfig{Enabled: true}},
+						{Name: 2, CIDR: "10.250.32.0/19", NatGateway: &apisazurev1alpha1.ZonedNatGatewayConfig{Enabled: true}},
+					},
+				})
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow the creation of an exempted shoot with multi-zone workers sharing a single NAT gateway", func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"1", "2"}
+				shoot.Namespace = "garden-exempt"
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow updates of legacy shoots which are not zone-redundant", func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"1", "2"}
+				oldShoot = shoot.DeepCopy()
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should forbid adding a worker zone without NAT gateway to a zone-redundant shoot", func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"1", "2"}
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.provider.infrastructureConfig.networks"),
+				}))))
+			})
+		})
+
+		Context("zone consistency", func() {
+			var oldShoot *core.Shoot
+
+			encodeInfrastructureConfig := func(zones ...apisazurev1alpha1.Zone) *runtime.RawExtension {
+				return &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureConfig",
+						},
+						Networks: apisazurev1alpha1.NetworkConfig{
+							VNet:  apisazurev1alpha1.VNet{CIDR: ptr.To("10.250.0.0/16")},
+							Zones: zones,
+						},
+						Zoned: true,
+					}),
+				}
+			}
+
+			BeforeEach(func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"1", "2"}
+				shoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(
+					apisazurev1alpha1.Zone{Name: 1, CIDR: "10.250.0.0/19", NatGateway: &apisazurev1alpha1.ZonedNatGatewayConfig{Enabled: true}},
+					apisazurev1alpha1.Zone{Name: 2, CIDR: "10.250.32.0/19", NatGateway: &apisazurev1alpha1.ZonedNatGatewayConfig{Enabled: true}},
+				)
+				oldShoot = shoot.DeepCopy()
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+			})
+
+			It("should allow the creation of a shoot with a NAT gateway in every zone of its workers", func() {
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return all violations at once", func() {
+				shoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(
+					apisazurev1alpha1.Zone{Name: 1, CIDR: "10.250.0.0/19", NatGateway: &apisazurev1alpha1.ZonedNatGatewayConfig{Enabled: true}},
+					apisazurev1alpha1.Zone{Name: 2, CIDR: "10.250.32.0/19"},
+				)
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						Vmo: &apisazurev1alpha1.VmoConfig{},
+					}),
+				}
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, azure.ShootVmoUsageAnnotation, "true")
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.provider.infrastructureConfig.networks.zones[1].natGateway"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("metadata.annotations[" + azure.ShootVmoUsageAnnotation + "]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.provider.workers[0].providerConfig.vmo"),
+					})),
+				))
+			})
+
+			It("should allow zones without workers and without NAT gateway", func() {
+				shoot.Spec.Provider.Workers[0].Zones = []string{"1"}
+				shoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(
+					apisazurev1alpha1.Zone{Name: 1, CIDR: "10.250.0.0/19", NatGateway: &apisazurev1alpha1.ZonedNatGatewayConfig{Enabled: true}},
+					apisazurev1alpha1.Zone{Name: 2, CIDR: "10.250.32.0/19"},
+				)
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should allow updates of legacy shoots which are inconsistent", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, azure.ShootVmoUsageAnnotation, "true")
+				oldShoot = shoot.DeepCopy()
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should forbid adding a worker zone without NAT gateway to a consistent shoot", func() {
+				oldShoot.Spec.Provider.Workers[0].Zones = []string{"1"}
+				oldShoot.Spec.Provider.InfrastructureConfig = encodeInfrastructureConfig(
+					apisazurev1alpha1.Zone{Name: 1, CIDR: "10.250.0.0/19", NatGateway: &apisazurev1alpha1.ZonedNatGatewayConfig{Enabled: true}},
+					apisazurev1alpha1.Zone{Name: 2, CIDR: "10.250.32.0/19"},
+				)
+				shoot = oldShoot.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, *shoot.Spec.Provider.Workers[0].DeepCopy())
+				shoot.Spec.Provider.Workers[1].Name = "worker-2"
+				shoot.Spec.Provider.Workers[1].Zones = []string{"2"}
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.provider.infrastructureConfig.networks.zones[1].natGateway"),
+				}))))
+			})
+		})
+
+		Context("credentials preflight", func() {
+			var (
+				factory       *mockazureclient.MockFactory
+				resourceGroup *mockazureclient.MockResourceGroup
+				vnet          *mockazureclient.MockVirtualNetwork
+				auth          *internal.ClientAuth
+
+				oldFactoryFunc = validator.NewAzureClientFactoryFunc
+			)
+
+			BeforeEach(func() {
+				mgr.EXPECT().GetScheme().Return(scheme).Times(2)
+				mgr.EXPECT().GetClient().Return(c)
+				mgr.EXPECT().GetAPIReader().Return(c)
+				shootValidator = validator.NewShootValidator(mgr, config.Policy{CredentialsPreflight: true})
+
+				factory = mockazureclient.NewMockFactory(ctrl)
+				resourceGroup = mockazureclient.NewMockResourceGroup(ctrl)
+				vnet = mockazureclient.NewMockVirtualNetwork(ctrl)
+				factory.EXPECT().Group().Return(resourceGroup, nil).AnyTimes()
+				factory.EXPECT().Vnet().Return(vnet, nil).AnyTimes()
+				validator.NewAzureClientFactoryFunc = func(a *internal.ClientAuth, _ ...azureclient.AzureFactoryOption) (azureclient.Factory, error) {
+					auth = a
+					return factory, nil
+				}
+				DeferCleanup(func() { validator.NewAzureClientFactoryFunc = oldFactoryFunc })
+
+				shoot.Spec.SecretBindingName = ptr.To("secret-binding")
+				c.EXPECT().Get(ctx, cloudProfileKey, &gardencorev1beta1.CloudProfile{}).SetArg(2, *cloudProfile)
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret-binding"}, &gardencorev1beta1.SecretBinding{}).SetArg(2, gardencorev1beta1.SecretBinding{
+					SecretRef: corev1.SecretReference{Namespace: namespace, Name: "secret"},
+				})
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "secret"}, &corev1.Secret{}).SetArg(2, corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "secret"},
+					Data: map[string][]byte{
+						azure.SubscriptionIDKey: []byte("subscription"),
+						azure.TenantIDKey:       []byte("tenant"),
+						azure.ClientIDKey:       []byte("client"),
+						azure.ClientSecretKey:   []byte("secret"),
+					},
+				})
+			})
+
+			It("should allow the creation of a shoot whose credentials can read the resource groups", func() {
+				resourceGroup.EXPECT().Get(gomock.Any(), "shoot--dev--foo").Return(nil, nil)
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(auth.ClientID).To(Equal("client"))
+			})
+
+			It("should forbid the creation of a shoot whose credentials cannot be authenticated", func() {
+				resourceGroup.EXPECT().Get(gomock.Any(), "shoot--dev--foo").Return(nil, &azcore.ResponseError{StatusCode: http.StatusUnauthorized})
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.secretBindingName"),
+					"Detail": ContainSubstring("could not be authenticated"),
+				}))))
+			})
+
+			It("should forbid the creation of a shoot whose credentials cannot read the existing virtual network", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: encode(&apisazurev1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apisazurev1alpha1.SchemeGroupVersion.String(),
+							Kind:       "InfrastructureConfig",
+						},
+						Networks: apisazurev1alpha1.NetworkConfig{
+							VNet:    apisazurev1alpha1.VNet{Name: ptr.To("vnet"), ResourceGroup: ptr.To("vnet-rg")},
+							Workers: ptr.To("10.250.0.0/16"),
+						},
+						Zoned: true,
+					}),
+				}
+				resourceGroup.EXPECT().Get(gomock.Any(), "shoot--dev--foo").Return(nil, nil)
+				vnet.EXPECT().Get(gomock.Any(), "vnet-rg", "vnet").Return(nil, &azcore.ResponseError{StatusCode: http.StatusForbidden})
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.provider.infrastructureConfig.networks.vnet"),
+					"Detail": ContainSubstring("not permitted to read the virtual network vnet-rg/vnet"),
+				}))))
+			})
+
+			It("should not block the creation of a shoot because of other errors", func() {
+				resourceGroup.EXPECT().Get(gomock.Any(), "shoot--dev--foo").Return(nil, &azcore.ResponseError{StatusCode: http.StatusServiceUnavailable})
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should limit the duration of the Azure calls and not block the creation of a shoot if they time out", func() {
+				resourceGroup.EXPECT().Get(gomock.Any(), "shoot--dev--foo").DoAndReturn(func(ctx context.Context, _ string) (*armresources.ResourceGroup, error) {
+					deadline, ok := ctx.Deadline()
+					Expect(ok).To(BeTrue())
+					Expect(deadline).To(BeTemporally("<=", time.Now().Add(5*time.Second)))
+					return nil, context.DeadlineExceeded
+				})
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("Workerless Shoot", func() {
 			BeforeEach(func() {
 				shoot.Spec.Provider.Workers = nil
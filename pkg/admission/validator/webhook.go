@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -23,9 +24,21 @@ const (
 	SecretsValidatorName = "secrets." + Name
 )
 
-var logger = log.Log.WithName("azure-validator-webhook")
+var (
+	logger = log.Log.WithName("azure-validator-webhook")
 
-// New creates a new webhook that validates Shoot, CloudProfile, NamespacedCloudProfile, SecretBinding and CredentialsBinding resources.
+	// DefaultAddOptions are the default AddOptions for New.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when creating the validation webhook.
+type AddOptions struct {
+	// Policy contains the landscape-wide policies enforced for shoots.
+	Policy config.Policy
+}
+
+// New creates a new webhook that validates Shoot, CloudProfile, NamespacedCloudProfile, SecretBinding, CredentialsBinding and
+// BackupBucket resources.
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	logger.Info("Setting up webhook", "name", Name)
 
@@ -34,11 +47,12 @@ func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 		Name:     Name,
 		Path:     "/webhooks/validate",
 		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
-			NewShootValidator(mgr):                  {{Obj: &core.Shoot{}}},
-			NewCloudProfileValidator(mgr):           {{Obj: &core.CloudProfile{}}},
-			NewNamespacedCloudProfileValidator(mgr): {{Obj: &core.NamespacedCloudProfile{}}},
-			NewSecretBindingValidator(mgr):          {{Obj: &core.SecretBinding{}}},
-			NewCredentialsBindingValidator(mgr):     {{Obj: &security.CredentialsBinding{}}},
+			NewShootValidator(mgr, DefaultAddOptions.Policy): {{Obj: &core.Shoot{}}},
+			NewCloudProfileValidator(mgr):                    {{Obj: &core.CloudProfile{}}},
+			NewNamespacedCloudProfileValidator(mgr):          {{Obj: &core.NamespacedCloudProfile{}}},
+			NewSecretBindingValidator(mgr):                   {{Obj: &core.SecretBinding{}}},
+			NewCredentialsBindingValidator(mgr):              {{Obj: &security.CredentialsBinding{}}},
+			NewBackupBucketValidator(mgr):                    {{Obj: &core.BackupBucket{}}},
 		},
 		Target: extensionswebhook.TargetSeed,
 		ObjectSelector: &metav1.LabelSelector{
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fuzzer
+
+import (
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	fuzz "github.com/google/gofuzz"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// Funcs returns the fuzzer functions for the azure provider API group. They mirror the defaulting of the external API
+// version so that fuzzed objects survive a round-trip through the defaulting decoder.
+var Funcs = func(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(obj *azure.MachineImageVersion, c fuzz.Continue) {
+			c.FuzzNoCustom(obj)
+
+			if obj.Architecture == nil {
+				obj.Architecture = ptr.To(v1beta1constants.ArchitectureAMD64)
+			}
+		},
+		func(obj *azure.Storage, c fuzz.Continue) {
+			c.FuzzNoCustom(obj)
+
+			if obj.ManagedDefaultStorageClass == nil {
+				obj.ManagedDefaultStorageClass = ptr.To(true)
+			}
+			if obj.ManagedDefaultVolumeSnapshotClass == nil {
+				obj.ManagedDefaultVolumeSnapshotClass = ptr.To(true)
+			}
+		},
+		func(obj *azure.NetworkStatus, c fuzz.Continue) {
+			c.FuzzNoCustom(obj)
+
+			if obj.OutboundAccessType == "" {
+				obj.OutboundAccessType = azure.OutboundAccessTypeLoadBalancer
+			}
+		},
+	}
+}
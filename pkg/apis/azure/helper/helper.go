@@ -6,8 +6,11 @@ package helper
 
 import (
 	"fmt"
+	"slices"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
@@ -32,6 +35,17 @@ func FindSubnetByPurposeAndZone(subnets []api.Subnet, purpose api.Purpose, zone
 	return 0, nil, fmt.Errorf("%s", errMsg)
 }
 
+// FindSubnetByPurposeAndName takes a list of subnets and tries to find the first entry whose purpose and name match
+// with the given purpose and name. If no such entry is found then an error will be returned.
+func FindSubnetByPurposeAndName(subnets []api.Subnet, purpose api.Purpose, name string) (*api.Subnet, error) {
+	for _, subnet := range subnets {
+		if subnet.Purpose == purpose && subnet.Name == name {
+			return &subnet, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find subnet with purpose %q and name %q", purpose, name)
+}
+
 // FindSecurityGroupByPurpose takes a list of security groups and tries to find the first entry
 // whose purpose matches with the given purpose. If no such entry is found then an error will be
 // returned.
@@ -108,8 +122,10 @@ func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, image
 						Name:                     imageName,
 						Version:                  version.Version,
 						AcceleratedNetworking:    version.AcceleratedNetworking,
+						NVMe:                     version.NVMe,
 						Architecture:             version.Architecture,
 						SkipMarketplaceAgreement: version.SkipMarketplaceAgreement,
+						MinimumOSDiskSizeGB:      version.MinimumOSDiskSizeGB,
 						Image: api.Image{
 							URN:                     version.URN,
 							ID:                      version.ID,
@@ -125,6 +141,23 @@ func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, image
 	return nil, fmt.Errorf("no machine image found with name %q, architecture %q and version %q", imageName, *architecture, imageVersion)
 }
 
+// DiskAttachLimit returns the maximum number of data disks which can be attached to a machine of the given type as
+// configured for the Azure Disk CSI driver in the cloud profile config. The second return value is false if no limit
+// is configured for the machine type, in which case the limits built into the driver apply.
+func DiskAttachLimit(cloudProfileConfig *api.CloudProfileConfig, machineTypeName string) (int32, bool) {
+	if cloudProfileConfig == nil {
+		return 0, false
+	}
+
+	for _, machineType := range cloudProfileConfig.MachineTypes {
+		if machineType.Name == machineTypeName {
+			limit, ok := machineType.AttachLimits[api.CSIDriverDisk]
+			return limit, ok
+		}
+	}
+	return 0, false
+}
+
 // IsVmoRequired determines if VMO is required. It is different from the condition in the infrastructure as this one depends on whether the infra controller
 // has finished migrating the Availability sets.
 func IsVmoRequired(infrastructureStatus *api.InfrastructureStatus) bool {
@@ -140,6 +173,41 @@ func HasShootVmoMigrationAnnotation(shootAnnotations map[string]string) bool {
 	return false
 }
 
+// HasShootStagedVmoMigrationAnnotation determines if the passed Shoot annotations request a staged migration to VMO.
+func HasShootStagedVmoMigrationAnnotation(shootAnnotations map[string]string) bool {
+	return shootAnnotations[azure.ShootVmoMigrationStrategyAnnotation] == azure.VmoMigrationStrategyStaged
+}
+
+// HasShootAdoptResourcesAnnotation determines if the passed Shoot annotations request the adoption of existing
+// infrastructure resources.
+func HasShootAdoptResourcesAnnotation(shootAnnotations map[string]string) bool {
+	return shootAnnotations[azure.ShootAdoptResourcesAnnotation] == "true"
+}
+
+// IsWorkerPoolVmoRequired determines if VMO is required for the given worker pool. During a staged migration from the
+// availability set only the worker pools which were released by the infrastructure controller are rolled to VMOs.
+func IsWorkerPoolVmoRequired(infrastructureStatus *api.InfrastructureStatus, poolName string) bool {
+	if !IsVmoRequired(infrastructureStatus) {
+		return false
+	}
+	if len(infrastructureStatus.AvailabilitySets) == 0 || infrastructureStatus.AvailabilitySetMigration == nil {
+		return true
+	}
+	return slices.Contains(infrastructureStatus.AvailabilitySetMigration.MigratedWorkerPools, poolName)
+}
+
+// HasShootZonalMigrationAnnotation determines if the passed Shoot annotations allow the conversion to a zoned shoot.
+func HasShootZonalMigrationAnnotation(shootAnnotations map[string]string) bool {
+	value, exists := shootAnnotations[azure.ShootZonalMigrationAnnotation]
+	return exists && value == "true"
+}
+
+// IsZonalMigrationRequested returns true if the infrastructure configuration requests a zoned shoot while the current
+// infrastructure status is still non-zoned.
+func IsZonalMigrationRequested(config *api.InfrastructureConfig, status *api.InfrastructureStatus) bool {
+	return config != nil && config.Zoned && status != nil && !status.Zoned && status.ResourceGroup.Name != ""
+}
+
 // HasShootVmoAlphaAnnotation determines if the passed Shoot annotations contain instruction to use VMO.
 func HasShootVmoAlphaAnnotation(shootAnnotations map[string]string) bool {
 	value, exists := shootAnnotations[azure.ShootVmoUsageAnnotation]
@@ -158,3 +226,50 @@ func InfrastructureZoneToString(zone int32) string {
 func IsUsingSingleSubnetLayout(config *api.InfrastructureConfig) bool {
 	return len(config.Networks.Zones) == 0
 }
+
+// IsUsingExistingResourceGroup determines if the infrastructure is deployed into an existing resource group instead
+// of a resource group managed by the extension.
+func IsUsingExistingResourceGroup(config *api.InfrastructureConfig) bool {
+	return config.ResourceGroup != nil && len(config.ResourceGroup.Name) > 0
+}
+
+// IsResourceGroupDeletionProtected determines if the resource group managed by the extension is protected against
+// deletion.
+func IsResourceGroupDeletionProtected(config *api.InfrastructureConfig) bool {
+	return config.ResourceGroup != nil && len(config.ResourceGroup.Name) == 0 && ptr.Deref(config.ResourceGroup.DeletionProtection, false)
+}
+
+// ACRAccessMode returns the mode used to configure the worker nodes for pulling from an Azure Container Registry or
+// nil if the ACR access is not enabled.
+func ACRAccessMode(identity *api.IdentityStatus) *api.ACRAccessMode {
+	if identity == nil || !identity.ACRAccess {
+		return nil
+	}
+	if identity.ACRAccessMode == nil {
+		return ptr.To(api.ACRAccessModeConfigMap)
+	}
+	return identity.ACRAccessMode
+}
+
+// CredentialsSecretRef returns the reference to the secret containing the credentials which are used for an extension
+// resource in the given namespace. If credentialsRef is set, the copy of the referenced Shoot resource is used,
+// otherwise the given default secret reference.
+func CredentialsSecretRef(defaultSecretRef corev1.SecretReference, namespace string, credentialsRef *string) corev1.SecretReference {
+	if credentialsRef == nil {
+		return defaultSecretRef
+	}
+	return corev1.SecretReference{
+		Name:      v1beta1constants.ReferencedResourcesPrefix + *credentialsRef,
+		Namespace: namespace,
+	}
+}
+
+// InfrastructureCredentialsSecretRef returns the reference to the secret containing the credentials which are used to
+// manage the given infrastructure.
+func InfrastructureCredentialsSecretRef(infra *extensionsv1alpha1.Infrastructure) (corev1.SecretReference, error) {
+	config, err := InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return corev1.SecretReference{}, err
+	}
+	return CredentialsSecretRef(infra.Spec.SecretRef, infra.Namespace, config.CredentialsRef), nil
+}
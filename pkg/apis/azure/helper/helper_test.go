@@ -47,6 +47,18 @@ var _ = Describe("Helper", func() {
 		Entry("entry with zone not found", []api.Subnet{{Name: "bar", Purpose: purpose, Zone: &zone}}, purpose, ptr.To("badzone"), nil, true),
 	)
 
+	DescribeTable("#FindSubnetByPurposeAndName",
+		func(subnets []api.Subnet, purpose api.Purpose, name string, expectedSubnet *api.Subnet, expectErr bool) {
+			subnet, err := FindSubnetByPurposeAndName(subnets, purpose, name)
+			expectResults(subnet, expectedSubnet, err, expectErr)
+		},
+
+		Entry("list is nil", nil, purpose, "bar", nil, true),
+		Entry("entry with wrong purpose", []api.Subnet{{Name: "bar", Purpose: purposeWrong}}, purpose, "bar", nil, true),
+		Entry("entry with wrong name", []api.Subnet{{Name: "foo", Purpose: purpose}}, purpose, "bar", nil, true),
+		Entry("entry exists", []api.Subnet{{Name: "foo", Purpose: purpose, Zone: &zone}, {Name: "bar", Purpose: purpose}}, purpose, "bar", &api.Subnet{Name: "bar", Purpose: purpose}, false),
+	)
+
 	DescribeTable("#FindSecurityGroupByPurpose",
 		func(securityGroups []api.SecurityGroup, purpose api.Purpose, expectedSecurityGroup *api.SecurityGroup, expectErr bool) {
 			securityGroup, err := FindSecurityGroupByPurpose(securityGroups, purpose)
@@ -115,6 +127,19 @@ var _ = Describe("Helper", func() {
 		Entry("entry exists", []api.DomainCount{{Region: "bar", Count: int32(1)}}, "bar", 1, false),
 	)
 
+	DescribeTable("#DiskAttachLimit",
+		func(machineTypes []api.MachineType, machineTypeName string, expectedLimit int32, expectedFound bool) {
+			limit, found := DiskAttachLimit(&api.CloudProfileConfig{MachineTypes: machineTypes}, machineTypeName)
+			Expect(found).To(Equal(expectedFound))
+			Expect(limit).To(Equal(expectedLimit))
+		},
+
+		Entry("machine type not in cloud profile", nil, "Standard_D4s_v5", int32(0), false),
+		Entry("explicit attach limit", []api.MachineType{{Name: "Standard_D4s_v5", AttachLimits: map[string]int32{api.CSIDriverDisk: 6}}}, "Standard_D4s_v5", int32(6), true),
+		Entry("no attach limit", []api.MachineType{{Name: "Standard_E104ids_v5"}}, "Standard_E104ids_v5", int32(0), false),
+		Entry("attach limit of another CSI driver", []api.MachineType{{Name: "Standard_D4s_v5", AttachLimits: map[string]int32{"file.csi.azure.com": 6}}}, "Standard_D4s_v5", int32(0), false),
+	)
+
 	DescribeTable("#FindImage",
 		func(profileImages []api.MachineImages, imageName, version string, architecture *string, expectedImage *api.MachineImage) {
 			cfg := &api.CloudProfileConfig{}
@@ -175,6 +200,41 @@ var _ = Describe("Helper", func() {
 			Purpose: api.PurposeNodes,
 		}, true, true),
 	)
+
+	DescribeTable("#IsWorkerPoolVmoRequired",
+		func(status *api.InfrastructureStatus, expected bool) {
+			Expect(IsWorkerPoolVmoRequired(status, "pool")).To(Equal(expected))
+		},
+		Entry("should require a VMO for a non-zoned cluster without availability set", &api.InfrastructureStatus{}, true),
+		Entry("should not require a VMO for a zoned cluster", &api.InfrastructureStatus{Zoned: true}, false),
+		Entry("should not require a VMO for an availability set cluster", &api.InfrastructureStatus{
+			AvailabilitySets: []api.AvailabilitySet{{Purpose: api.PurposeNodes}},
+		}, false),
+		Entry("should require a VMO for all worker pools during a migration", &api.InfrastructureStatus{
+			AvailabilitySets: []api.AvailabilitySet{{Purpose: api.PurposeNodes}},
+			MigratingToVMO:   true,
+		}, true),
+		Entry("should require a VMO for a migrated worker pool during a staged migration", &api.InfrastructureStatus{
+			AvailabilitySets:         []api.AvailabilitySet{{Purpose: api.PurposeNodes}},
+			MigratingToVMO:           true,
+			AvailabilitySetMigration: &api.AvailabilitySetMigrationStatus{MigratedWorkerPools: []string{"other", "pool"}},
+		}, true),
+		Entry("should not require a VMO for a pending worker pool during a staged migration", &api.InfrastructureStatus{
+			AvailabilitySets:         []api.AvailabilitySet{{Purpose: api.PurposeNodes}},
+			MigratingToVMO:           true,
+			AvailabilitySetMigration: &api.AvailabilitySetMigrationStatus{MigratedWorkerPools: []string{"other"}},
+		}, false),
+	)
+
+	DescribeTable("#IsZonalMigrationRequested",
+		func(config *api.InfrastructureConfig, status *api.InfrastructureStatus, expected bool) {
+			Expect(IsZonalMigrationRequested(config, status)).To(Equal(expected))
+		},
+		Entry("should be requested for a zoned config and a non-zoned status", &api.InfrastructureConfig{Zoned: true}, &api.InfrastructureStatus{ResourceGroup: api.ResourceGroup{Name: "rg"}}, true),
+		Entry("should not be requested for a non-zoned config", &api.InfrastructureConfig{}, &api.InfrastructureStatus{ResourceGroup: api.ResourceGroup{Name: "rg"}}, false),
+		Entry("should not be requested for a zoned status", &api.InfrastructureConfig{Zoned: true}, &api.InfrastructureStatus{Zoned: true, ResourceGroup: api.ResourceGroup{Name: "rg"}}, false),
+		Entry("should not be requested for a new infrastructure", &api.InfrastructureConfig{Zoned: true}, &api.InfrastructureStatus{}, false),
+	)
 })
 
 func makeProfileMachineImages(name, urnVersion, idVersion, communityGalleryImageIdVersion string, sharedGalleryImageIdVersion string, architecture *string) []api.MachineImages {
@@ -102,6 +102,40 @@ func BackupConfigFromBackupBucket(backupBucket *extensionsv1alpha1.BackupBucket)
 	return backupConfig, nil
 }
 
+// BackupBucketStatusFromBackupBucket decodes the provider specific status from a given BackupBucket object.
+func BackupBucketStatusFromBackupBucket(backupBucket *extensionsv1alpha1.BackupBucket) (*api.BackupBucketStatus, error) {
+	status := &api.BackupBucketStatus{}
+	if backupBucket.Status.ProviderStatus != nil && backupBucket.Status.ProviderStatus.Raw != nil {
+		if _, _, err := lenientDecoder.Decode(backupBucket.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, fmt.Errorf("could not decode providerStatus of BackupBucket '%s': %w", k8sclient.ObjectKeyFromObject(backupBucket), err)
+		}
+	}
+	return status, nil
+}
+
+// DNSRecordConfigFromDNSRecord decodes the provider specific config from a given DNSRecord object.
+func DNSRecordConfigFromDNSRecord(dnsRecord *extensionsv1alpha1.DNSRecord) (*api.DNSRecordConfig, error) {
+	dnsRecordConfig := &api.DNSRecordConfig{}
+	if dnsRecord != nil && dnsRecord.Spec.ProviderConfig != nil && dnsRecord.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(dnsRecord.Spec.ProviderConfig.Raw, nil, dnsRecordConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of DNSRecord '%s': %w", k8sclient.ObjectKeyFromObject(dnsRecord), err)
+		}
+	}
+	return dnsRecordConfig, nil
+}
+
+// WorkerConfigFromRaw decodes the provider specific config of a worker pool. If no config is set, an empty config is
+// returned. Unknown fields are ignored, so that webhooks do not fail for configs of newer extension versions.
+func WorkerConfigFromRaw(raw *runtime.RawExtension) (*api.WorkerConfig, error) {
+	workerConfig := &api.WorkerConfig{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := lenientDecoder.Decode(raw.Raw, nil, workerConfig); err != nil {
+			return nil, fmt.Errorf("could not decode provider config of worker pool: %w", err)
+		}
+	}
+	return workerConfig, nil
+}
+
 // InfrastructureStateFromRaw extracts the state from the Infrastructure. If no state was available, it returns a "zero" value InfrastructureState object.
 func InfrastructureStateFromRaw(raw *runtime.RawExtension) (*api.InfrastructureState, error) {
 	state := &api.InfrastructureState{}
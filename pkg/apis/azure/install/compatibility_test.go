@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package install_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/install"
+)
+
+const updateFixturesEnvVar = "UPDATE_COMPATIBILITY_FIXTURE_DATA"
+
+var (
+	fixturesDir         = "testdata"
+	fixturesDirCurrent  = filepath.Join(fixturesDir, "HEAD")
+	fixturesDirPrevious = filepath.Join(fixturesDir, "v*")
+
+	fillFuncs = map[reflect.Type]roundtrip.FillFunc{
+		// zero timestamps are serialized as null, which is decoded as nil for pointers
+		reflect.TypeOf(&metav1.Time{}): func(_ string, i int, obj interface{}) {
+			obj.(*metav1.Time).Time = time.Date(2000+i, 1, 1, 1, 1, 1, 0, time.UTC)
+		},
+	}
+)
+
+// TestCompatibility protects the serialized form of the external API types, which is persisted e.g. as provider status
+// or state of extension resources. The fixtures in testdata/HEAD must match the serialization of fully populated objects,
+// hence renaming or removing a field fails this test. Fixtures of previous releases (testdata/v*) must still be
+// decodable with strict field validation.
+func TestCompatibility(t *testing.T) {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+
+	var (
+		serializer       = json.NewSerializerWithOptions(json.DefaultMetaFactory, scheme, scheme, json.SerializerOptions{Pretty: true})
+		strictSerializer = json.NewSerializerWithOptions(json.DefaultMetaFactory, scheme, scheme, json.SerializerOptions{Strict: true})
+		usedFixtures     = sets.New[string]()
+	)
+
+	for _, gvk := range externalKinds(scheme) {
+		fixture := fmt.Sprintf("%s.%s.%s.json", gvk.Group, gvk.Version, gvk.Kind)
+		usedFixtures.Insert(fixture)
+
+		t.Run(fixture, func(t *testing.T) {
+			expectedObject, err := roundtrip.CompatibilityTestObject(scheme, gvk, fillFuncs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := &bytes.Buffer{}
+			if err := serializer.Encode(expectedObject, expected); err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join(fixturesDirCurrent, fixture)
+			actual, err := os.ReadFile(path) // #nosec G304 -- test fixture
+			if err != nil && !os.IsNotExist(err) {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(expected.Bytes(), actual) {
+				if os.Getenv(updateFixturesEnvVar) == "true" {
+					if err := os.MkdirAll(fixturesDirCurrent, 0750); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(path, expected.Bytes(), 0600); err != nil {
+						t.Fatal(err)
+					}
+					t.Logf("wrote compatibility fixture %s, verify and commit it", path)
+					return
+				}
+				t.Fatalf("serialized form of %s differs from %s, if the change is intended re-run with %s=true to update the fixture:\n%s",
+					gvk, path, updateFixturesEnvVar, cmp.Diff(string(actual), expected.String()))
+			}
+
+			decodedObject, err := scheme.New(gvk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := strictSerializer.Decode(actual, &gvk, decodedObject); err != nil {
+				t.Fatal(err)
+			}
+			if !apiequality.Semantic.DeepEqual(expectedObject, decodedObject) {
+				t.Fatalf("decoded fixture %s differs from expected object:\n%s", path, cmp.Diff(expectedObject, decodedObject))
+			}
+		})
+	}
+
+	t.Run("unused fixtures", func(t *testing.T) {
+		entries, err := os.ReadDir(fixturesDirCurrent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, entry := range entries {
+			if !usedFixtures.Has(entry.Name()) {
+				t.Errorf("remove unused fixture %s", filepath.Join(fixturesDirCurrent, entry.Name()))
+			}
+		}
+	})
+
+	previousFixtures, err := filepath.Glob(filepath.Join(fixturesDirPrevious, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range previousFixtures {
+		t.Run(path, func(t *testing.T) {
+			data, err := os.ReadFile(path) // #nosec G304 -- test fixture
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := strictSerializer.Decode(data, nil, nil); err != nil {
+				t.Fatalf("fixture of a previous release can no longer be decoded: %v", err)
+			}
+		})
+	}
+}
+
+func externalKinds(scheme *runtime.Scheme) []schema.GroupVersionKind {
+	var kinds []schema.GroupVersionKind
+	for gvk := range scheme.AllKnownTypes() {
+		if gvk.Version == runtime.APIVersionInternal {
+			continue
+		}
+		kinds = append(kinds, gvk)
+	}
+
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].String() < kinds[j].String()
+	})
+	return kinds
+}
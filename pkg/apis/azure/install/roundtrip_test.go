@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package install_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	azurefuzzer "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/fuzzer"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/install"
+)
+
+func TestRoundTripTypes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+
+	codecFactory := serializer.NewCodecFactory(scheme)
+	f := fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, azurefuzzer.Funcs), rand.NewSource(rand.Int63()), codecFactory) // #nosec G404 -- no cryptographic randomness needed
+
+	roundtrip.RoundTripTypesWithoutProtobuf(t, scheme, codecFactory, f, nil)
+	roundtrip.RoundTripExternalTypesWithoutProtobuf(t, scheme, codecFactory, f, nil)
+}
@@ -34,6 +34,6 @@ var (
 
 // Adds the list of known types to api.Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
-	scheme.AddKnownTypes(SchemeGroupVersion, &CloudProfileConfig{}, &InfrastructureConfig{}, &InfrastructureStatus{}, &InfrastructureState{}, &ControlPlaneConfig{}, &WorkerStatus{}, &WorkerConfig{}, &BackupBucketConfig{})
+	scheme.AddKnownTypes(SchemeGroupVersion, &CloudProfileConfig{}, &InfrastructureConfig{}, &InfrastructureStatus{}, &InfrastructureState{}, &ControlPlaneConfig{}, &WorkerStatus{}, &WorkerConfig{}, &BackupBucketConfig{}, &BackupBucketStatus{}, &DNSRecordConfig{})
 	return nil
 }
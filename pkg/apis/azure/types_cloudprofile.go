@@ -5,6 +5,7 @@
 package azure
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,11 +28,23 @@ type CloudProfileConfig struct {
 	CloudConfiguration *CloudConfiguration
 }
 
-// CloudConfiguration contains detailed config for the cloud to connect to. Currently we only support selection of well-
-// known Azure-instances by name, but this could be extended in future to support private clouds.
+// CloudConfiguration contains detailed config for the cloud to connect to. Well-known Azure-instances are selected by
+// name, private clouds (e.g. Azure Stack Hub) require their endpoints to be configured explicitly.
 type CloudConfiguration struct {
-	// Name is the name of the cloud to connect to, e.g. "AzurePublic" or "AzureChina".
+	// Name is the name of the cloud to connect to, e.g. "AzurePublic", "AzureChina" or "AzureStackCloud".
 	Name string
+	// ActiveDirectoryAuthorityHost is the base URL of the Azure Active Directory authority, e.g. "https://login.microsoftonline.com/".
+	// Only allowed (and required) for the "AzureStackCloud".
+	ActiveDirectoryAuthorityHost *string
+	// ResourceManagerEndpoint is the URL of the Azure Resource Manager, e.g. "https://management.local.azurestack.external/".
+	// Only allowed (and required) for the "AzureStackCloud".
+	ResourceManagerEndpoint *string
+	// ResourceManagerAudience is the audience of the tokens requested for the Azure Resource Manager.
+	// Defaults to the ResourceManagerEndpoint. Only allowed for the "AzureStackCloud".
+	ResourceManagerAudience *string
+	// StorageEndpointSuffix is the domain suffix of the storage services, e.g. "local.azurestack.external".
+	// Only allowed (and required) for the "AzureStackCloud".
+	StorageEndpointSuffix *string
 }
 
 // DomainCount defines the region and the count for this domain count value.
@@ -60,14 +73,22 @@ type MachineImageVersion struct {
 	SkipMarketplaceAgreement *bool
 	// ID is the Shared Image Gallery image id.
 	ID *string
-	// CommunityGalleryImageID is the Community Image Gallery image id, it has the format '/CommunityGalleries/myGallery/Images/myImage/Versions/myVersion'
+	// CommunityGalleryImageID is the Community Image Gallery image id, it has the format '/CommunityGalleries/myGallery/Images/myImage/Versions/myVersion'.
+	// The version 'latest' is resolved to the latest version of the image in the shoot's region when machines are created.
 	CommunityGalleryImageID *string
-	// SharedGalleryImageID is the Shared Image Gallery image id, it has the format '/SharedGalleries/sharedGalleryName/Images/sharedGalleryImageName/Versions/sharedGalleryImageVersionName'
+	// SharedGalleryImageID is the Shared Image Gallery image id, it has the format '/SharedGalleries/sharedGalleryName/Images/sharedGalleryImageName/Versions/sharedGalleryImageVersionName'.
+	// The version 'latest' is resolved to the latest version of the image in the shoot's region when machines are created.
 	SharedGalleryImageID *string
 	// AcceleratedNetworking is an indicator if the image supports Azure accelerated networking.
 	AcceleratedNetworking *bool
+	// NVMe is an indicator if the image supports the NVMe disk controller. It is required for machine types whose
+	// DiskControllerType is NVMe.
+	NVMe *bool
 	// Architecture is the CPU architecture of the machine image.
 	Architecture *string
+	// MinimumOSDiskSizeGB is the minimum size of the OS disk in GiB which is required by the image, e.g. for images with
+	// preloaded GPU drivers. The OS disks of worker pools with smaller volumes are enlarged to this size.
+	MinimumOSDiskSizeGB *int32
 }
 
 // MachineType contains provider specific information to a machine type.
@@ -76,13 +97,41 @@ type MachineType struct {
 	Name string
 	// AcceleratedNetworking is an indicator if the machine type supports Azure accelerated networking.
 	AcceleratedNetworking *bool
+	// DiskControllerType is the disk controller type which is used for machines of this type. Machine types which
+	// require or prefer NVMe (e.g. Dasv6 or Ebsv5) should set it to NVMe, those machines are then also configured
+	// to use Azure accelerated networking. Machine types with the NVMe disk controller can only be used with machine
+	// images supporting NVMe.
+	DiskControllerType *DiskControllerType
+	// Capacity contains additional resources of the machine type, e.g. extended resources like `nvidia.com/gpu` or
+	// `ephemeral-storage`. They are added to the node templates of the worker pools, so that the cluster-autoscaler
+	// can scale them up from zero for pods requesting these resources.
+	Capacity corev1.ResourceList
+	// AttachLimits is the maximum number of volumes of a CSI driver which can be attached to a machine of this type,
+	// keyed by the name of the CSI driver, e.g. the maximum number of data disks for `disk.csi.azure.com`. If not set,
+	// the limits built into the CSI driver apply.
+	AttachLimits map[string]int32
 }
 
+// CSIDriverDisk is the name of the Azure Disk CSI driver, which is used as key of the attach limits of machine types.
+const CSIDriverDisk = "disk.csi.azure.com"
+
+// DiskControllerType is the type of the disk controller of a machine.
+type DiskControllerType string
+
+const (
+	// DiskControllerTypeSCSI is the SCSI disk controller type.
+	DiskControllerTypeSCSI DiskControllerType = "SCSI"
+	// DiskControllerTypeNVMe is the NVMe disk controller type.
+	DiskControllerTypeNVMe DiskControllerType = "NVMe"
+)
+
 // The (currently) supported values for the names of clouds to use in the CloudConfiguration.
 const (
 	AzureChinaCloudName  string = "AzureChina"
 	AzureGovCloudName    string = "AzureGovernment"
 	AzurePublicCloudName string = "AzurePublic"
+	// AzureStackCloudName is the name of private Azure Stack Hub instances whose endpoints are configured explicitly.
+	AzureStackCloudName string = "AzureStackCloud"
 )
 
 // The known prefixes in of region names for the various instances.
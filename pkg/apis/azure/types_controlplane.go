@@ -5,6 +5,7 @@
 package azure
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -18,15 +19,79 @@ type ControlPlaneConfig struct {
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig
 
+	// CSIDriverController contains configuration settings for the controllers of the CSI drivers.
+	// +optional
+	CSIDriverController *CSIDriverControllerConfig
+
 	// Storage contains configuration for storage in the cluster.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
+
+	// Remedy contains configuration settings for the remedy controller.
+	// +optional
+	Remedy *RemedyConfig
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
+	// LoadBalancer contains configuration for the load balancers managed by the cloud-controller-manager.
+	LoadBalancer *LoadBalancerConfig
+	// Resources are the resource requirements of the cloud-controller-manager.
+	Resources *corev1.ResourceRequirements
+	// LeaderElection contains configuration for the leader election of the cloud-controller-manager.
+	LeaderElection *LeaderElectionConfig
+	// Verbosity is the log verbosity of the cloud-controller-manager.
+	Verbosity *int32
+}
+
+// CSIDriverControllerConfig contains configuration settings for the controllers of the CSI drivers.
+type CSIDriverControllerConfig struct {
+	// Resources are the resource requirements of the CSI drivers of the disk and file controllers.
+	Resources *corev1.ResourceRequirements
+	// LeaderElection contains configuration for the leader election of the CSI sidecars.
+	LeaderElection *LeaderElectionConfig
+	// Verbosity is the log verbosity of the CSI drivers and sidecars.
+	Verbosity *int32
+}
+
+// LeaderElectionConfig contains configuration for the leader election of a control plane component.
+type LeaderElectionConfig struct {
+	// LeaseDuration is the duration non-leader candidates wait before they try to acquire the leadership.
+	LeaseDuration *metav1.Duration
+	// RenewDeadline is the duration the leader tries to renew the leadership before it gives it up.
+	RenewDeadline *metav1.Duration
+	// RetryPeriod is the duration the candidates wait between attempts to acquire or renew the leadership.
+	RetryPeriod *metav1.Duration
+}
+
+// LoadBalancerConfig contains configuration for the load balancers managed by the cloud-controller-manager.
+type LoadBalancerConfig struct {
+	// SKU is the SKU of the load balancers.
+	SKU *LoadBalancerSKU
+	// OutboundRule contains configuration for the outbound rule of the load balancers.
+	OutboundRule *OutboundRuleConfig
+	// DisableOutboundSNAT indicates whether the outbound source NAT of the load balancing rules is disabled.
+	DisableOutboundSNAT *bool
+}
+
+// LoadBalancerSKU is the SKU of a load balancer.
+type LoadBalancerSKU string
+
+const (
+	// LoadBalancerSKUBasic is the basic load balancer SKU.
+	LoadBalancerSKUBasic LoadBalancerSKU = "Basic"
+	// LoadBalancerSKUStandard is the standard load balancer SKU.
+	LoadBalancerSKUStandard LoadBalancerSKU = "Standard"
+)
+
+// OutboundRuleConfig contains configuration for the outbound rule of a load balancer.
+type OutboundRuleConfig struct {
+	// AllocatedOutboundPorts is the number of SNAT ports allocated per backend instance.
+	AllocatedOutboundPorts *int32
+	// IdleTimeoutInMinutes is the idle timeout of outbound connections in minutes.
+	IdleTimeoutInMinutes *int32
 }
 
 // Storage contains configuration for storage in the cluster.
@@ -41,4 +106,61 @@ type Storage struct {
 	// Defaults to true.
 	// +optional
 	ManagedDefaultVolumeSnapshotClass *bool
+	// VolumeSnapshotClass contains configuration for the parameters of the 'default' VolumeSnapshotClass.
+	// +optional
+	VolumeSnapshotClass *VolumeSnapshotClassConfig
+	// SnapshotValidationWebhook controls if the CSI snapshot validation webhook is deployed.
+	// Defaults to true.
+	// +optional
+	SnapshotValidationWebhook *bool
+}
+
+// VolumeSnapshotClassConfig contains configuration for the parameters of a VolumeSnapshotClass.
+type VolumeSnapshotClassConfig struct {
+	// Incremental controls if incremental snapshots are taken. Defaults to the default of the CSI driver.
+	// +optional
+	Incremental *bool
+	// ResourceGroup is the name of the resource group in which the snapshots are stored. Defaults to the resource group
+	// of the source disk.
+	// +optional
+	ResourceGroup *string
+	// Tags are the tags which are added to the snapshots.
+	// +optional
+	Tags map[string]string
+}
+
+// RemedyConfig contains configuration settings for the remedy controller.
+type RemedyConfig struct {
+	// Enabled indicates whether the remedy controller is deployed for the shoot. Defaults to `true`.
+	Enabled *bool
+	// OrphanedPublicIPRemedy contains configuration for the remedy of orphaned public IP addresses.
+	OrphanedPublicIPRemedy *OrphanedPublicIPRemedyConfig
+	// FailedVMRemedy contains configuration for the remedy of virtual machines in a failed state.
+	FailedVMRemedy *FailedVMRemedyConfig
+}
+
+// OrphanedPublicIPRemedyConfig contains configuration for the remedy of orphaned public IP addresses.
+type OrphanedPublicIPRemedyConfig struct {
+	// RequeueInterval is the interval in which a public IP address is requeued while it is being remedied.
+	RequeueInterval *metav1.Duration
+	// SyncPeriod is the period in which public IP addresses are resynced.
+	SyncPeriod *metav1.Duration
+	// DeletionGracePeriod is the period after which an orphaned public IP address is deleted.
+	DeletionGracePeriod *metav1.Duration
+	// MaxGetAttempts is the maximum number of attempts to get a public IP address.
+	MaxGetAttempts *int32
+	// MaxCleanAttempts is the maximum number of attempts to clean up an orphaned public IP address.
+	MaxCleanAttempts *int32
+}
+
+// FailedVMRemedyConfig contains configuration for the remedy of virtual machines in a failed state.
+type FailedVMRemedyConfig struct {
+	// RequeueInterval is the interval in which a virtual machine is requeued while it is being remedied.
+	RequeueInterval *metav1.Duration
+	// SyncPeriod is the period in which virtual machines are resynced.
+	SyncPeriod *metav1.Duration
+	// MaxGetAttempts is the maximum number of attempts to get a virtual machine.
+	MaxGetAttempts *int32
+	// MaxReapplyAttempts is the maximum number of attempts to reapply a virtual machine in a failed state.
+	MaxReapplyAttempts *int32
 }
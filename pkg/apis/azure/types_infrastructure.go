@@ -5,6 +5,9 @@
 package azure
 
 import (
+	"slices"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -21,12 +24,45 @@ type InfrastructureConfig struct {
 	Identity *IdentityConfig
 	// Zoned indicates whether the cluster uses zones
 	Zoned bool
+	// AuxiliaryResources contains configuration for auxiliary resources created by the extension.
+	AuxiliaryResources *AuxiliaryResourcesConfig
+	// CredentialsRef is the name of a secret referenced in the Shoot's `.spec.resources[].resourceRef.name` which
+	// contains the Azure credentials used to manage the infrastructure instead of the Shoot's cloud provider credentials,
+	// e.g. a service principal which is only permitted to manage network resources.
+	CredentialsRef *string
+	// Observability contains configuration for the monitoring of the infrastructure resources.
+	Observability *ObservabilityConfig
+}
+
+// ObservabilityConfig contains configuration for the monitoring of the infrastructure resources.
+type ObservabilityConfig struct {
+	// LogAnalyticsWorkspaceID is the resource ID of a Log Analytics workspace. If set, Azure Monitor diagnostic settings
+	// sending the logs and metrics of the NAT gateways, the security group and the outbound load balancer to the
+	// workspace are created.
+	LogAnalyticsWorkspaceID string
+}
+
+// AuxiliaryResourcesConfig contains configuration for auxiliary resources created by the extension.
+type AuxiliaryResourcesConfig struct {
+	// BootDiagnostics indicates whether a storage account for the boot diagnostics of the worker nodes is created.
+	BootDiagnostics bool
+	// ManagedIdentity indicates whether a user-assigned managed identity is created for the worker nodes instead of
+	// referencing an existing one via Identity.
+	ManagedIdentity bool
+	// Region is the region in which the auxiliary resources are created, e.g. the paired region of the shoot's region
+	// for disaster recovery. Defaults to the shoot's region.
+	Region *string
 }
 
 // ResourceGroup is azure resource group
 type ResourceGroup struct {
-	// Name is the name of the resource group
+	// Name is the name of an existing resource group to deploy the shoot into. If it is empty, the resource group of
+	// the shoot is created and managed by the extension.
 	Name string
+	// DeletionProtection protects the resource group managed by the extension against deletion with a `CanNotDelete`
+	// management lock on the security group of the shoot, which blocks the deletion of the resource group but not of the
+	// other resources in it. The lock is removed as the first step of the deletion of the infrastructure.
+	DeletionProtection *bool
 }
 
 // NetworkConfig holds information about the Kubernetes and infrastructure networks.
@@ -41,6 +77,80 @@ type NetworkConfig struct {
 	ServiceEndpoints []string
 	// Zones is a list of zones with their respective configuration.
 	Zones []Zone
+	// PodSubnet contains the configuration for a dedicated subnet for pods, e.g. to run Azure CNI with dynamic IP allocation.
+	PodSubnet *PodSubnetConfig
+	// OutboundAccess contains the configuration for the egress traffic of the worker subnets.
+	OutboundAccess *OutboundAccessConfig
+	// OutboundAccessType is the type of outbound access of the worker subnets. If not set, it is derived from the NAT
+	// gateway and outbound access configuration.
+	OutboundAccessType *OutboundAccessType
+	// OutboundLoadBalancer contains the configuration for the outbound rule of the shoot's load balancer. It can only be
+	// configured if the outbound access type is LoadBalancer.
+	OutboundLoadBalancer *OutboundLoadBalancerConfig
+	// FlowLogs contains the configuration for the Network Watcher virtual network flow logs of the worker subnets.
+	FlowLogs *FlowLogsConfig
+	// EgressFirewall contains the configuration for an Azure Firewall through which the egress traffic of the worker
+	// subnets is routed.
+	EgressFirewall *EgressFirewallConfig
+}
+
+// EgressFirewallConfig contains the configuration for an Azure Firewall of the Basic SKU, which is created in the
+// shoot's vnet and through which the egress traffic of the worker subnets is routed.
+type EgressFirewallConfig struct {
+	// CIDR is the CIDR range of the subnet of the firewall. It must be at least a /26 within the vnet.
+	CIDR string
+	// ManagementCIDR is the CIDR range of the subnet for the management traffic of the firewall, which the Basic SKU
+	// requires. It must be at least a /26 within the vnet.
+	ManagementCIDR string
+	// PolicyID is the resource ID of an existing firewall policy of the Basic tier which controls the egress traffic. If
+	// not set, a policy which allows all egress traffic of the worker subnets is created.
+	PolicyID *string
+}
+
+// FlowLogsConfig contains the configuration for the Network Watcher virtual network flow logs of the worker subnets.
+type FlowLogsConfig struct {
+	// StorageAccountID is the resource ID of the storage account to which the flow logs are written. It must be located
+	// in the shoot's region.
+	StorageAccountID string
+	// RetentionDays is the number of days the flow logs are retained in the storage account. If not set or 0, the flow
+	// logs are retained indefinitely.
+	RetentionDays *int32
+	// TrafficAnalytics contains the configuration for Traffic Analytics of the flow logs.
+	TrafficAnalytics *TrafficAnalyticsConfig
+}
+
+// TrafficAnalyticsConfig contains the configuration for Traffic Analytics of the flow logs.
+type TrafficAnalyticsConfig struct {
+	// WorkspaceID is the resource ID of the Log Analytics workspace to which the analysed flow logs are sent.
+	WorkspaceID string
+	// IntervalInMinutes is the interval in which the flow logs are analysed. Must be 10 or 60, defaults to 60.
+	IntervalInMinutes *int32
+}
+
+// OutboundLoadBalancerConfig contains the configuration for the outbound rule of the shoot's load balancer.
+type OutboundLoadBalancerConfig struct {
+	// AllocatedOutboundPorts is the number of SNAT ports allocated per node. It must be a multiple of 8. If not set, the
+	// ports are allocated by Azure based on the number of nodes.
+	AllocatedOutboundPorts *int32
+	// PublicIPCount is the number of managed public IPs used for the egress traffic. Defaults to 1.
+	PublicIPCount *int32
+}
+
+// OutboundAccessConfig contains the configuration for the egress traffic of the worker subnets.
+type OutboundAccessConfig struct {
+	// NextHopIPAddress is the IP address of a virtual appliance, e.g. an Azure Firewall, to which all egress traffic is
+	// routed via a default route in the worker route table.
+	NextHopIPAddress string
+}
+
+// PodSubnetConfig contains the configuration for a dedicated subnet for pods.
+type PodSubnetConfig struct {
+	// CIDR is the CIDR range of the pod subnet that is created in the shoot's VNet.
+	// Either CIDR or Name must be specified.
+	CIDR *string
+	// Name is the name of an existing subnet in the shoot's VNet that is used as pod subnet.
+	// Either CIDR or Name must be specified.
+	Name *string
 }
 
 // NatGatewayConfig contains configuration for the NAT gateway and the attached resources.
@@ -53,6 +163,16 @@ type NatGatewayConfig struct {
 	Zone *int32
 	// IPAddresses is a list of ip addresses which should be assigned to the NAT gateway.
 	IPAddresses []PublicIPReference
+	// DDoSProtection is the DDoS protection of the public IP which is created for the NAT gateway. It can only be
+	// configured if no IP addresses are specified, as only the public IPs created by Gardener are known to be of the
+	// Standard SKU.
+	DDoSProtection *PublicIPDDoSProtection
+	// DNSSettings are the DNS settings of the public IP which is created for the NAT gateway. They can only be configured
+	// if no IP addresses are specified.
+	DNSSettings *PublicIPDNSSettings
+	// AutoScaleIPs configures the automatic scaling of the public IPs which are created for the NAT gateway with the
+	// number of nodes behind it. It can only be configured if no IP addresses are specified.
+	AutoScaleIPs *NatGatewayIPAutoScaling
 }
 
 // PublicIPReference contains information about a public ip.
@@ -75,6 +195,15 @@ type Zone struct {
 	ServiceEndpoints []string
 	// NatGateway contains the configuration for the NatGateway associated with this subnet.
 	NatGateway *ZonedNatGatewayConfig
+	// SecurityGroup contains the configuration for the network security group associated with the zone's subnet.
+	SecurityGroup *ZoneSecurityGroupConfig
+}
+
+// ZoneSecurityGroupConfig contains configuration for the network security group of a zone's subnet.
+type ZoneSecurityGroupConfig struct {
+	// ExternalID is the ID of a centrally managed network security group that is associated with the zone's subnet.
+	// If set, the worker security group is not associated with the subnet.
+	ExternalID string
 }
 
 // ZonedNatGatewayConfig contains configuration for the NAT gateway and the attached resources.
@@ -85,8 +214,68 @@ type ZonedNatGatewayConfig struct {
 	IdleConnectionTimeoutMinutes *int32
 	// IPAddresses is a list of ip addresses which should be assigned to the NAT gateway.
 	IPAddresses []ZonedPublicIPReference
+	// DDoSProtection is the DDoS protection of the public IP which is created for the NAT gateway. It can only be
+	// configured if no IP addresses are specified, as only the public IPs created by Gardener are known to be of the
+	// Standard SKU.
+	DDoSProtection *PublicIPDDoSProtection
+	// DNSSettings are the DNS settings of the public IP which is created for the NAT gateway. They can only be configured
+	// if no IP addresses are specified.
+	DNSSettings *PublicIPDNSSettings
+	// AutoScaleIPs configures the automatic scaling of the public IPs which are created for the NAT gateway with the
+	// number of nodes behind it. It can only be configured if no IP addresses are specified.
+	AutoScaleIPs *NatGatewayIPAutoScaling
+	// Existing references an existing NAT gateway which is managed outside of Gardener and associated with the zone's
+	// subnet. If set, no NAT gateway is created for the zone and the other fields must not be configured.
+	Existing *NatGatewayReference
+}
+
+// NatGatewayReference contains information about an existing NAT gateway.
+type NatGatewayReference struct {
+	// Name is the name of the NAT gateway.
+	Name string
+	// ResourceGroup is the name of the resource group of the NAT gateway.
+	ResourceGroup string
+}
+
+// PublicIPDDoSProtection contains the DDoS protection configuration of a public IP.
+type PublicIPDDoSProtection struct {
+	// Mode is the DDoS protection mode of the public IP. The mode Enabled activates the DDoS IP protection for the
+	// single public IP, VirtualNetworkInherited protects the public IP with the DDoS protection plan of the virtual network.
+	Mode PublicIPDDoSProtectionMode
+}
+
+// NatGatewayIPAutoScaling contains the configuration for the automatic scaling of the public IPs of a NAT gateway.
+type NatGatewayIPAutoScaling struct {
+	// NodesPerIP is the number of nodes for which one public IP is attached to the NAT gateway. The nodes are counted
+	// based on the maximum sizes of the worker pools in the zone of the NAT gateway.
+	NodesPerIP int32
+	// MaxIPCount is the maximum number of public IPs which are attached to the NAT gateway.
+	MaxIPCount int32
+}
+
+// PublicIPDNSSettings contains the DNS settings of a public IP.
+type PublicIPDNSSettings struct {
+	// DomainNameLabel is the label of the public IP in the regional Azure DNS zone, i.e. the public IP is resolvable by
+	// the name <domainNameLabel>.<region>.cloudapp.azure.com. The label must be unique within the region.
+	DomainNameLabel string
+	// ReverseFQDN is the fully qualified domain name which the reverse DNS lookup of the public IP resolves to. It must
+	// resolve to the public IP or to the regional name of the public IP.
+	ReverseFQDN *string
 }
 
+// PublicIPDDoSProtectionMode is the DDoS protection mode of a public IP.
+type PublicIPDDoSProtectionMode string
+
+const (
+	// PublicIPDDoSProtectionModeEnabled enables the DDoS IP protection for the public IP.
+	PublicIPDDoSProtectionModeEnabled PublicIPDDoSProtectionMode = "Enabled"
+	// PublicIPDDoSProtectionModeDisabled disables the DDoS protection for the public IP.
+	PublicIPDDoSProtectionModeDisabled PublicIPDDoSProtectionMode = "Disabled"
+	// PublicIPDDoSProtectionModeVirtualNetworkInherited protects the public IP with the DDoS protection plan of the
+	// virtual network.
+	PublicIPDDoSProtectionModeVirtualNetworkInherited PublicIPDDoSProtectionMode = "VirtualNetworkInherited"
+)
+
 // ZonedPublicIPReference contains information about a public ip.
 type ZonedPublicIPReference struct {
 	// Name is the name of the public ip.
@@ -110,6 +299,8 @@ type InfrastructureStatus struct {
 	// MigratingToVMO indicates whether the infrastructure controller has prepared the migration from Availability set.
 	// Deprecated: Will be removed in future versions.
 	MigratingToVMO bool
+	// AvailabilitySetMigration is the progress of a staged migration from the availability set to VMOs.
+	AvailabilitySetMigration *AvailabilitySetMigrationStatus
 	// RouteTables is a list of created route tables
 	RouteTables []RouteTable
 	// SecurityGroups is a list of created security groups
@@ -118,6 +309,14 @@ type InfrastructureStatus struct {
 	Identity *IdentityStatus
 	// Zoned indicates whether the cluster uses zones
 	Zoned bool
+	// BootDiagnostics is the status of the storage account created for boot diagnostics.
+	BootDiagnostics *BootDiagnosticsStatus
+	// EgressCIDRsHistory is a capped list of the egress CIDRs observed for the infrastructure, most recent last.
+	// A new entry is added whenever the egress CIDRs change, e.g. when the NAT gateway public IPs are rotated.
+	EgressCIDRsHistory []EgressCIDRsHistoryEntry
+	// ZoneMappings is the mapping of the logical availability zones of the subscription to the physical availability
+	// zones of the region, e.g. to align the zones with the ones of other subscriptions.
+	ZoneMappings []ZoneMapping
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
@@ -130,6 +329,39 @@ type NetworkStatus struct {
 	Layout NetworkLayout
 	// OutboundAccessType is the type of outbound access configured for the shoot. It indicates how egress traffic flows outside the shoot.
 	OutboundAccessType OutboundAccessType
+	// NatGateways are the NAT gateways that have been created.
+	// +optional
+	NatGateways []NatGatewayStatus
+	// OutboundLoadBalancer contains information about the outbound rule of the shoot's load balancer.
+	// +optional
+	OutboundLoadBalancer *OutboundLoadBalancerStatus
+	// EgressFirewall contains information about the Azure Firewall through which the egress traffic is routed.
+	// +optional
+	EgressFirewall *EgressFirewallStatus
+}
+
+// EgressFirewallStatus contains information about the Azure Firewall through which the egress traffic is routed.
+type EgressFirewallStatus struct {
+	// Name is the name of the firewall.
+	Name string
+	// ID is the ID of the firewall.
+	ID string
+	// PrivateIPAddress is the private IP address of the firewall, to which the default route of the worker route table
+	// points.
+	PrivateIPAddress string
+	// PublicIPAddress is the public IP address used for the egress traffic.
+	PublicIPAddress PublicIPAddressStatus
+}
+
+// OutboundLoadBalancerStatus contains information about the outbound rule of the shoot's load balancer.
+type OutboundLoadBalancerStatus struct {
+	// Name is the name of the load balancer.
+	Name string
+	// OutboundRuleName is the name of the outbound rule.
+	OutboundRuleName string
+	// PublicIPAddresses are the public IP addresses used by the outbound rule.
+	// +optional
+	PublicIPAddresses []PublicIPAddressStatus
 }
 
 // Purpose is a purpose of a subnet.
@@ -140,6 +372,8 @@ const (
 	PurposeNodes Purpose = "nodes"
 	// PurposeInternal is a Purpose for internal use.
 	PurposeInternal Purpose = "internal"
+	// PurposePods is a Purpose for pods.
+	PurposePods Purpose = "pods"
 )
 
 // NetworkLayout is the network layout type for the cluster.
@@ -162,8 +396,57 @@ const (
 	OutboundAccessTypeNatGateway = "NATGateway"
 	// OutboundAccessTypeLoadBalancer indicates that the outbound access happens through configured FrontendIPs of a LoadBalancer.
 	OutboundAccessTypeLoadBalancer = "LoadBalancer"
+	// OutboundAccessTypeUserDefinedRouting indicates that the outbound access happens through a user-defined default route to a virtual appliance.
+	OutboundAccessTypeUserDefinedRouting = "UserDefinedRouting"
+)
+
+const (
+	// ServiceEndpointStorage is the service endpoint for the storage accounts in the region of the VNet and its paired
+	// region.
+	ServiceEndpointStorage = "Microsoft.Storage"
+	// ServiceEndpointStorageGlobal is the service endpoint for the storage accounts in all regions. It cannot be combined
+	// with ServiceEndpointStorage on the same subnet.
+	ServiceEndpointStorageGlobal = "Microsoft.Storage.Global"
 )
 
+// knownServiceEndpoints are the service endpoints which Azure supports for subnets.
+// See https://learn.microsoft.com/en-us/azure/virtual-network/virtual-network-service-endpoints-overview
+var knownServiceEndpoints = []string{
+	"Microsoft.AzureActiveDirectory",
+	"Microsoft.AzureCosmosDB",
+	"Microsoft.CognitiveServices",
+	"Microsoft.ContainerRegistry",
+	"Microsoft.EventHub",
+	"Microsoft.KeyVault",
+	"Microsoft.ServiceBus",
+	"Microsoft.Sql",
+	ServiceEndpointStorage,
+	ServiceEndpointStorageGlobal,
+	"Microsoft.Web",
+}
+
+// KnownServiceEndpoints returns the service endpoints which Azure supports for subnets.
+func KnownServiceEndpoints() []string {
+	return slices.Clone(knownServiceEndpoints)
+}
+
+// IsKnownServiceEndpoint returns whether Azure supports the given service endpoint for subnets. Service endpoint names
+// are case-insensitive.
+func IsKnownServiceEndpoint(serviceEndpoint string) bool {
+	_, ok := NormalizeServiceEndpoint(serviceEndpoint)
+	return ok
+}
+
+// NormalizeServiceEndpoint returns the given service endpoint spelled like Azure does and whether it is known.
+func NormalizeServiceEndpoint(serviceEndpoint string) (string, bool) {
+	for _, known := range knownServiceEndpoints {
+		if strings.EqualFold(serviceEndpoint, known) {
+			return known, true
+		}
+	}
+	return serviceEndpoint, false
+}
+
 // Subnet is a subnet that was created.
 type Subnet struct {
 	// Name is the name of the subnet.
@@ -178,6 +461,39 @@ type Subnet struct {
 	// NatGatewayID is the ID of the NATGateway associated with the subnet.
 	// +optional
 	NatGatewayID *string
+	// SecurityGroupID is the ID of the network security group associated with the subnet.
+	// +optional
+	SecurityGroupID *string
+	// ID is the ID of the subnet.
+	// +optional
+	ID *string
+}
+
+// NatGatewayStatus contains information about a NAT gateway that was created.
+type NatGatewayStatus struct {
+	// Name is the name of the NAT gateway.
+	Name string
+	// ID is the ID of the NAT gateway.
+	ID string
+	// Zone is the zone of the NAT gateway.
+	// +optional
+	Zone *string
+	// PublicIPAddresses are the public IP addresses attached to the NAT gateway.
+	// +optional
+	PublicIPAddresses []PublicIPAddressStatus
+}
+
+// PublicIPAddressStatus contains information about a public IP address attached to a NAT gateway or load balancer.
+type PublicIPAddressStatus struct {
+	// Name is the name of the public IP address.
+	Name string
+	// ResourceGroup is the resource group of the public IP address.
+	ResourceGroup string
+	// ID is the ID of the public IP address.
+	ID string
+	// IPAddress is the allocated IP address.
+	// +optional
+	IPAddress string
 }
 
 // AvailabilitySet contains information about the azure availability set
@@ -200,6 +516,10 @@ type RouteTable struct {
 	Purpose Purpose
 	// Name is the name of the route table
 	Name string
+	// ID is the ID of the route table.
+	ID string
+	// Managed indicates whether the route table is managed by Gardener.
+	Managed bool
 }
 
 // SecurityGroup contains information about the security group
@@ -208,6 +528,11 @@ type SecurityGroup struct {
 	Purpose Purpose
 	// Name is the name of the security group
 	Name string
+	// ID is the ID of the security group.
+	ID string
+	// Managed indicates whether the security group is managed by Gardener. Security groups which are managed outside of
+	// Gardener, e.g. the external security groups of zones, are not managed.
+	Managed bool
 }
 
 // VNet contains information about the VNet and some related resources.
@@ -218,6 +543,8 @@ type VNet struct {
 	ResourceGroup *string
 	// CIDR is the VNet CIDR
 	CIDR *string
+	// AdditionalCIDRs are further address prefixes of the VNet, which extend its address space without replacing it.
+	AdditionalCIDRs []string
 	// DDosProtectionPlanID is the id of a ddos protection plan assigned to the vnet.
 	DDosProtectionPlanID *string
 }
@@ -238,6 +565,15 @@ type IdentityConfig struct {
 	ResourceGroup string
 	// ACRAccess indicated if the identity should be used by the Shoot worker nodes to pull from an Azure Container Registry.
 	ACRAccess *bool
+	// ACRAccessMode is the mode used to configure the worker nodes for pulling from an Azure Container Registry with the
+	// identity. Defaults to ConfigMap.
+	ACRAccessMode *ACRAccessMode
+	// SubscriptionID is the subscription in which the identity resides. Defaults to the subscription of the shoot's
+	// credentials.
+	SubscriptionID *string
+	// TenantID is the tenant of the subscription in which the identity resides, e.g. when the subscription is delegated
+	// to the tenant of the shoot's credentials via Azure Lighthouse. Defaults to the tenant of the shoot's credentials.
+	TenantID *string
 }
 
 // IdentityStatus contains the status information of the created managed identity.
@@ -248,6 +584,41 @@ type IdentityStatus struct {
 	ClientID string
 	// ACRAccess specifies if the identity should be used by the Shoot worker nodes to pull from an Azure Container Registry.
 	ACRAccess bool
+	// ACRAccessMode is the mode used to configure the worker nodes for pulling from an Azure Container Registry with the
+	// identity.
+	ACRAccessMode *ACRAccessMode
+}
+
+// ACRAccessMode is the mode used to configure the worker nodes for pulling from an Azure Container Registry.
+type ACRAccessMode string
+
+const (
+	// ACRAccessModeConfigMap configures the legacy in-tree credential provider of the kubelet with the
+	// --azure-container-registry-config flag. The flag is not supported by kubelets as of Kubernetes 1.30.
+	ACRAccessModeConfigMap ACRAccessMode = "ConfigMap"
+	// ACRAccessModeCredentialProvider configures the kubelet to use the acr-credential-provider binary as image
+	// credential provider plugin.
+	ACRAccessModeCredentialProvider ACRAccessMode = "CredentialProvider"
+)
+
+// ZoneMapping is the mapping of a logical availability zone of the subscription to a physical availability zone.
+type ZoneMapping struct {
+	// LogicalZone is the logical zone of the subscription, e.g. "1".
+	LogicalZone string
+	// PhysicalZone is the physical zone the logical zone is mapped to, e.g. "westeurope-az2".
+	PhysicalZone string
+}
+
+// BootDiagnosticsStatus contains the status information of the storage account created for boot diagnostics.
+type BootDiagnosticsStatus struct {
+	// StorageAccountName is the name of the storage account.
+	StorageAccountName string
+	// StorageURI is the blob endpoint of the storage account.
+	StorageURI string
+	// Region is the region of the storage account.
+	Region string
+	// ZoneRedundant indicates whether the storage account is zone-redundant.
+	ZoneRedundant bool
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -261,6 +632,26 @@ type InfrastructureState struct {
 	// ManagedItems is a list of resources that were created during the infrastructure reconciliation.
 	// +optional
 	ManagedItems []AzureResource
+	// Steps contains the execution state of the steps of the infrastructure flow.
+	// +optional
+	Steps []FlowStepState
+}
+
+// FlowStepState contains the execution state of a step of the infrastructure flow.
+type FlowStepState struct {
+	// Name is the name of the step.
+	Name string
+	// LastSucceededGeneration is the generation of the Infrastructure for which the step succeeded the last time.
+	LastSucceededGeneration *int64
+	// LastSucceededTime is the time at which the step succeeded for the LastSucceededGeneration the first time, or the
+	// first time after it failed.
+	LastSucceededTime *metav1.Time
+	// LastFailedTime is the time at which the step failed the last time.
+	LastFailedTime *metav1.Time
+	// LastError is the error of the last execution of the step if it failed.
+	LastError *string
+	// ConsecutiveFailures is the number of failed executions of the step since it succeeded the last time.
+	ConsecutiveFailures int32
 }
 
 // AzureResource represents metadata information about created infrastructure resources.
@@ -270,3 +661,19 @@ type AzureResource struct {
 	// ID is the ID of the resource.
 	ID string
 }
+
+// AvailabilitySetMigrationStatus contains the progress of a staged migration from the availability set to VMOs.
+type AvailabilitySetMigrationStatus struct {
+	// MigratedWorkerPools are the names of the worker pools whose machines are rolled to VMOs.
+	MigratedWorkerPools []string
+	// RemainingVirtualMachines is the number of virtual machines which are still part of the availability set.
+	RemainingVirtualMachines int32
+}
+
+// EgressCIDRsHistoryEntry records the egress CIDRs of the infrastructure at a point in time.
+type EgressCIDRsHistoryEntry struct {
+	// CIDRs are the egress CIDRs.
+	CIDRs []string
+	// Timestamp is the time at which the egress CIDRs were first observed.
+	Timestamp metav1.Time
+}
@@ -5,9 +5,13 @@
 package v1alpha1
 
 import (
+	"strings"
+
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -31,10 +35,41 @@ func SetDefaults_Storage(obj *Storage) {
 	}
 }
 
-// SetDefaults_OutboundAccessType sets the default outbound access type.
-func SetDefaults_OutboundAccessType(obj *OutboundAccessType) {
-	*obj = ptr.Deref(obj, OutboundAccessTypeLoadBalancer)
-	if *obj == "" {
-		*obj = OutboundAccessTypeLoadBalancer
+// SetDefaults_NetworkStatus sets the default outbound access type of the network status.
+func SetDefaults_NetworkStatus(obj *NetworkStatus) {
+	if obj.OutboundAccessType == "" {
+		obj.OutboundAccessType = OutboundAccessTypeLoadBalancer
+	}
+}
+
+// SetDefaults_NetworkConfig normalizes the service endpoints of the worker subnet.
+func SetDefaults_NetworkConfig(obj *NetworkConfig) {
+	obj.ServiceEndpoints = normalizeServiceEndpoints(obj.ServiceEndpoints)
+}
+
+// SetDefaults_Zone normalizes the service endpoints of the zone's subnet.
+func SetDefaults_Zone(obj *Zone) {
+	obj.ServiceEndpoints = normalizeServiceEndpoints(obj.ServiceEndpoints)
+}
+
+// normalizeServiceEndpoints spells the known service endpoints like Azure does and removes duplicates, which Azure
+// rejects. Service endpoint names are case-insensitive.
+func normalizeServiceEndpoints(serviceEndpoints []string) []string {
+	if len(serviceEndpoints) == 0 {
+		return serviceEndpoints
+	}
+
+	var (
+		normalized []string
+		seen       = map[string]struct{}{}
+	)
+	for _, serviceEndpoint := range serviceEndpoints {
+		serviceEndpoint, _ = azure.NormalizeServiceEndpoint(serviceEndpoint)
+		if _, ok := seen[strings.ToLower(serviceEndpoint)]; ok {
+			continue
+		}
+		seen[strings.ToLower(serviceEndpoint)] = struct{}{}
+		normalized = append(normalized, serviceEndpoint)
 	}
+	return normalized
 }
@@ -34,4 +34,32 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.ManagedDefaultVolumeSnapshotClass).To(gstruct.PointTo(Equal(true)))
 		})
 	})
+
+	Describe("#SetDefaults_NetworkConfig", func() {
+		It("should normalize the service endpoints", func() {
+			obj := &NetworkConfig{ServiceEndpoints: []string{"microsoft.storage", "Microsoft.Sql", "Microsoft.Storage", "Custom.Endpoint", "custom.endpoint"}}
+
+			SetDefaults_NetworkConfig(obj)
+
+			Expect(obj.ServiceEndpoints).To(Equal([]string{"Microsoft.Storage", "Microsoft.Sql", "Custom.Endpoint"}))
+		})
+
+		It("should not set service endpoints", func() {
+			obj := &NetworkConfig{}
+
+			SetDefaults_NetworkConfig(obj)
+
+			Expect(obj.ServiceEndpoints).To(BeNil())
+		})
+	})
+
+	Describe("#SetDefaults_Zone", func() {
+		It("should normalize the service endpoints", func() {
+			obj := &Zone{ServiceEndpoints: []string{"Microsoft.KeyVault", "MICROSOFT.KEYVAULT"}}
+
+			SetDefaults_Zone(obj)
+
+			Expect(obj.ServiceEndpoints).To(Equal([]string{"Microsoft.KeyVault"}))
+		})
+	})
 })
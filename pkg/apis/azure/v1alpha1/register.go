@@ -37,6 +37,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&WorkerConfig{},
 		&WorkerStatus{},
 		&BackupBucketConfig{},
+		&BackupBucketStatus{},
+		&DNSRecordConfig{},
 	)
 	return nil
 }
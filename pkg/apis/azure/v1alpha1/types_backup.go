@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -17,4 +18,130 @@ type BackupBucketConfig struct {
 	// CloudConfiguration contains config that controls which cloud to connect to.
 	// +optional
 	CloudConfiguration *CloudConfiguration `json:"cloudConfiguration,omitempty"`
+	// ResourceGroupRegion is the region in which the resource group hosting the backup storage account is created.
+	// The storage account itself is always created in the region of the backup bucket.
+	// Defaults to the region of the backup bucket. It cannot be changed after the backup bucket was created.
+	// +optional
+	ResourceGroupRegion *string `json:"resourceGroupRegion,omitempty"`
+	// CredentialsSecretRef is a reference to a secret in the seed which contains the Azure credentials used to manage
+	// the backup storage account instead of the credentials of the BackupBucket.
+	// +optional
+	CredentialsSecretRef *corev1.SecretReference `json:"credentialsSecretRef,omitempty"`
+	// NetworkACLs contains the network rules of the backup storage account, e.g. to restrict the access to the egress
+	// IPs of the seed.
+	// +optional
+	NetworkACLs *BackupBucketNetworkACLs `json:"networkAcls,omitempty"`
+	// Rotation contains the configuration of the credentials in the generated backup secret.
+	// +optional
+	Rotation *RotationConfig `json:"rotation,omitempty"`
+	// Inventory enables a daily blob inventory report of the backup container.
+	// +optional
+	Inventory *BackupBucketInventory `json:"inventory,omitempty"`
+	// RequireInfrastructureEncryption enables a second layer of encryption of the backups at rest. It can only be set
+	// when the backup storage account is created.
+	// +optional
+	RequireInfrastructureEncryption *bool `json:"requireInfrastructureEncryption,omitempty"`
+	// MinimumTLSVersion is the minimum TLS version of requests to the backup storage account. Defaults to TLS1_2.
+	// +optional
+	MinimumTLSVersion *MinimumTLSVersion `json:"minimumTlsVersion,omitempty"`
+	// Lifecycle contains the lifecycle management rules of the backups, e.g. to move older backups to a cheaper tier.
+	// +optional
+	Lifecycle *BackupBucketLifecycle `json:"lifecycle,omitempty"`
+	// LegalHold contains the legal hold of the backup container which prevents the backups from being modified or
+	// deleted, e.g. due to litigation.
+	// +optional
+	LegalHold *BackupBucketLegalHold `json:"legalHold,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketStatus contains information about the resources of a backup bucket managed by the extension.
+type BackupBucketStatus struct {
+	metav1.TypeMeta `json:",inline"`
+	// LegalHoldTags are the tags of the legal hold of the backup container which were set by the extension. Tags set
+	// by others are never removed by the extension.
+	// +optional
+	LegalHoldTags []string `json:"legalHoldTags,omitempty"`
 }
+
+// BackupBucketLegalHold contains the legal hold of the backup container.
+type BackupBucketLegalHold struct {
+	// Tags are the tags of the legal hold, e.g. case numbers. The legal hold is lifted once all tags are removed.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// BackupBucketLifecycle contains the lifecycle management rules of the backups in the backup container.
+type BackupBucketLifecycle struct {
+	// TierToColdAfterDays is the number of days after the last modification after which a backup is moved to the cold
+	// tier.
+	// +optional
+	TierToColdAfterDays *int32 `json:"tierToColdAfterDays,omitempty"`
+	// DeleteAfterDays is the number of days after the last modification after which a backup is deleted. Backups of
+	// hibernated shoots are not renewed, hence their last full snapshot is deleted as well.
+	// +optional
+	DeleteAfterDays *int32 `json:"deleteAfterDays,omitempty"`
+}
+
+// MinimumTLSVersion is the minimum TLS version of requests to a storage account.
+type MinimumTLSVersion string
+
+const (
+	// MinimumTLSVersionTLS12 permits requests with TLS 1.2 or higher.
+	MinimumTLSVersionTLS12 MinimumTLSVersion = "TLS1_2"
+	// MinimumTLSVersionTLS13 permits requests with TLS 1.3 only.
+	MinimumTLSVersionTLS13 MinimumTLSVersion = "TLS1_3"
+)
+
+// BackupBucketInventory contains the configuration of the blob inventory report of the backup container.
+type BackupBucketInventory struct {
+	// Container is the name of the container in the backup storage account to which the inventory reports are written.
+	// Defaults to gardener-inventory.
+	// +optional
+	Container *string `json:"container,omitempty"`
+}
+
+// RotationConfig contains the configuration of the credentials in the generated backup secret.
+type RotationConfig struct {
+	// Mode is the kind of credentials in the generated backup secret. Defaults to key.
+	// +optional
+	Mode RotationMode `json:"mode,omitempty"`
+	// SASTokenValidity is the validity of the SAS tokens in mode sas. The token is renewed once two thirds of its validity
+	// have elapsed. Defaults to 168h.
+	// +optional
+	SASTokenValidity *metav1.Duration `json:"sasTokenValidity,omitempty"`
+}
+
+// RotationMode is the kind of credentials in the generated backup secret.
+type RotationMode string
+
+const (
+	// RotationModeKey puts the key of the storage account into the generated backup secret.
+	RotationModeKey RotationMode = "key"
+	// RotationModeSAS puts a time-limited SAS token for the container of the backup bucket into the generated backup
+	// secret.
+	RotationModeSAS RotationMode = "sas"
+)
+
+// BackupBucketNetworkACLs contains the network rules of the backup storage account.
+type BackupBucketNetworkACLs struct {
+	// DefaultAction is the action for requests which match none of the rules.
+	DefaultAction NetworkACLDefaultAction `json:"defaultAction"`
+	// IPRules are the public IPv4 addresses or CIDR ranges which are allowed to access the storage account.
+	// +optional
+	IPRules []string `json:"ipRules,omitempty"`
+	// VirtualNetworkRules are the IDs of the subnets which are allowed to access the storage account.
+	// +optional
+	VirtualNetworkRules []string `json:"virtualNetworkRules,omitempty"`
+}
+
+// NetworkACLDefaultAction is the action for requests which match none of the network rules.
+type NetworkACLDefaultAction string
+
+const (
+	// NetworkACLDefaultActionAllow allows requests which match none of the network rules.
+	NetworkACLDefaultActionAllow NetworkACLDefaultAction = "Allow"
+	// NetworkACLDefaultActionDeny denies requests which match none of the network rules.
+	NetworkACLDefaultActionDeny NetworkACLDefaultAction = "Deny"
+)
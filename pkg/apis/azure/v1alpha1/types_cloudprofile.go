@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -30,11 +31,27 @@ type CloudProfileConfig struct {
 	CloudConfiguration *CloudConfiguration `json:"cloudConfiguration,omitempty"`
 }
 
-// CloudConfiguration contains detailed config for the cloud to connect to. Currently we only support selection of well-
-// known Azure-instances by name, but this could be extended in future to support private clouds.
+// CloudConfiguration contains detailed config for the cloud to connect to. Well-known Azure-instances are selected by
+// name, private clouds (e.g. Azure Stack Hub) require their endpoints to be configured explicitly.
 type CloudConfiguration struct {
-	// Name is the name of the cloud to connect to, e.g. "AzurePublic" or "AzureChina".
+	// Name is the name of the cloud to connect to, e.g. "AzurePublic", "AzureChina" or "AzureStackCloud".
 	Name string `json:"name,omitempty"`
+	// ActiveDirectoryAuthorityHost is the base URL of the Azure Active Directory authority, e.g. "https://login.microsoftonline.com/".
+	// Only allowed (and required) for the "AzureStackCloud".
+	// +optional
+	ActiveDirectoryAuthorityHost *string `json:"activeDirectoryAuthorityHost,omitempty"`
+	// ResourceManagerEndpoint is the URL of the Azure Resource Manager, e.g. "https://management.local.azurestack.external/".
+	// Only allowed (and required) for the "AzureStackCloud".
+	// +optional
+	ResourceManagerEndpoint *string `json:"resourceManagerEndpoint,omitempty"`
+	// ResourceManagerAudience is the audience of the tokens requested for the Azure Resource Manager.
+	// Defaults to the ResourceManagerEndpoint. Only allowed for the "AzureStackCloud".
+	// +optional
+	ResourceManagerAudience *string `json:"resourceManagerAudience,omitempty"`
+	// StorageEndpointSuffix is the domain suffix of the storage services, e.g. "local.azurestack.external".
+	// Only allowed (and required) for the "AzureStackCloud".
+	// +optional
+	StorageEndpointSuffix *string `json:"storageEndpointSuffix,omitempty"`
 }
 
 // DomainCount defines the region and the count for this domain count value.
@@ -66,18 +83,28 @@ type MachineImageVersion struct {
 	// ID is the Shared Image Gallery image id.
 	// +optional
 	ID *string `json:"id,omitempty"`
-	// CommunityGalleryImageID is the Community Image Gallery image id, it has the format '/CommunityGalleries/myGallery/Images/myImage/Versions/myVersion'
+	// CommunityGalleryImageID is the Community Image Gallery image id, it has the format '/CommunityGalleries/myGallery/Images/myImage/Versions/myVersion'.
+	// The version 'latest' is resolved to the latest version of the image in the shoot's region when machines are created.
 	// +optional
 	CommunityGalleryImageID *string `json:"communityGalleryImageID,omitempty"`
-	// SharedGalleryImageID is the Shared Image Gallery image id, it has the format '/SharedGalleries/sharedGalleryName/Images/sharedGalleryImageName/Versions/sharedGalleryImageVersionName'
+	// SharedGalleryImageID is the Shared Image Gallery image id, it has the format '/SharedGalleries/sharedGalleryName/Images/sharedGalleryImageName/Versions/sharedGalleryImageVersionName'.
+	// The version 'latest' is resolved to the latest version of the image in the shoot's region when machines are created.
 	// +optional
 	SharedGalleryImageID *string `json:"sharedGalleryImageID,omitempty"`
 	// AcceleratedNetworking is an indicator if the image supports Azure accelerated networking.
 	// +optional
 	AcceleratedNetworking *bool `json:"acceleratedNetworking,omitempty"`
+	// NVMe is an indicator if the image supports the NVMe disk controller. It is required for machine types whose
+	// DiskControllerType is NVMe.
+	// +optional
+	NVMe *bool `json:"nvme,omitempty"`
 	// Architecture is the CPU architecture of the machine image.
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
+	// MinimumOSDiskSizeGB is the minimum size of the OS disk in GiB which is required by the image, e.g. for images with
+	// preloaded GPU drivers. The OS disks of worker pools with smaller volumes are enlarged to this size.
+	// +optional
+	MinimumOSDiskSizeGB *int32 `json:"minimumOSDiskSizeGB,omitempty"`
 }
 
 // MachineType contains provider specific information to a machine type.
@@ -87,4 +114,30 @@ type MachineType struct {
 	// AcceleratedNetworking is an indicator if the machine type supports Azure accelerated networking.
 	// +optional
 	AcceleratedNetworking *bool `json:"acceleratedNetworking,omitempty"`
+	// DiskControllerType is the disk controller type which is used for machines of this type. Machine types which
+	// require or prefer NVMe (e.g. Dasv6 or Ebsv5) should set it to NVMe, those machines are then also configured
+	// to use Azure accelerated networking. Machine types with the NVMe disk controller can only be used with machine
+	// images supporting NVMe.
+	// +optional
+	DiskControllerType *DiskControllerType `json:"diskControllerType,omitempty"`
+	// Capacity contains additional resources of the machine type, e.g. extended resources like `nvidia.com/gpu` or
+	// `ephemeral-storage`. They are added to the node templates of the worker pools, so that the cluster-autoscaler
+	// can scale them up from zero for pods requesting these resources.
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+	// AttachLimits is the maximum number of volumes of a CSI driver which can be attached to a machine of this type,
+	// keyed by the name of the CSI driver, e.g. the maximum number of data disks for `disk.csi.azure.com`. If not set,
+	// the limits built into the CSI driver apply.
+	// +optional
+	AttachLimits map[string]int32 `json:"attachLimits,omitempty"`
 }
+
+// DiskControllerType is the type of the disk controller of a machine.
+type DiskControllerType string
+
+const (
+	// DiskControllerTypeSCSI is the SCSI disk controller type.
+	DiskControllerTypeSCSI DiskControllerType = "SCSI"
+	// DiskControllerTypeNVMe is the NVMe disk controller type.
+	DiskControllerTypeNVMe DiskControllerType = "NVMe"
+)
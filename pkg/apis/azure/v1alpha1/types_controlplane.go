@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -19,8 +20,16 @@ type ControlPlaneConfig struct {
 	// +optional
 	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
 
+	// CSIDriverController contains configuration settings for the controllers of the CSI drivers.
+	// +optional
+	CSIDriverController *CSIDriverControllerConfig `json:"csiDriverController,omitempty"`
+
 	// Storage contains configuration for storage in the cluster.
 	Storage *Storage `json:"storage,omitempty"`
+
+	// Remedy contains configuration settings for the remedy controller.
+	// +optional
+	Remedy *RemedyConfig `json:"remedy,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -28,6 +37,83 @@ type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// LoadBalancer contains configuration for the load balancers managed by the cloud-controller-manager.
+	// +optional
+	LoadBalancer *LoadBalancerConfig `json:"loadBalancer,omitempty"`
+	// Resources are the resource requirements of the cloud-controller-manager. Requests which are not configured
+	// default to the requests of the chart, the requests are adjusted by the VerticalPodAutoscaler later on.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// LeaderElection contains configuration for the leader election of the cloud-controller-manager.
+	// +optional
+	LeaderElection *LeaderElectionConfig `json:"leaderElection,omitempty"`
+	// Verbosity is the log verbosity of the cloud-controller-manager. Defaults to 2.
+	// +optional
+	Verbosity *int32 `json:"verbosity,omitempty"`
+}
+
+// CSIDriverControllerConfig contains configuration settings for the controllers of the CSI drivers.
+type CSIDriverControllerConfig struct {
+	// Resources are the resource requirements of the CSI drivers of the disk and file controllers. Requests which are
+	// not configured default to the requests of the chart, the requests are adjusted by the VerticalPodAutoscaler later on.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// LeaderElection contains configuration for the leader election of the CSI sidecars.
+	// +optional
+	LeaderElection *LeaderElectionConfig `json:"leaderElection,omitempty"`
+	// Verbosity is the log verbosity of the CSI drivers and sidecars. Defaults to 5.
+	// +optional
+	Verbosity *int32 `json:"verbosity,omitempty"`
+}
+
+// LeaderElectionConfig contains configuration for the leader election of a control plane component. Durations which
+// are not configured default to the defaults of the component.
+type LeaderElectionConfig struct {
+	// LeaseDuration is the duration non-leader candidates wait before they try to acquire the leadership.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+	// RenewDeadline is the duration the leader tries to renew the leadership before it gives it up. Must be less than
+	// the lease duration.
+	// +optional
+	RenewDeadline *metav1.Duration `json:"renewDeadline,omitempty"`
+	// RetryPeriod is the duration the candidates wait between attempts to acquire or renew the leadership. Must be less
+	// than the renew deadline.
+	// +optional
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
+}
+
+// LoadBalancerConfig contains configuration for the load balancers managed by the cloud-controller-manager.
+type LoadBalancerConfig struct {
+	// SKU is the SKU of the load balancers. Can be `Standard` or `Basic`, the latter is only supported for non-zoned clusters.
+	// Defaults to `Standard` (or `Basic` for clusters still using an availability set).
+	// +optional
+	SKU *LoadBalancerSKU `json:"sku,omitempty"`
+	// OutboundRule contains configuration for the outbound rule of the load balancers.
+	// +optional
+	OutboundRule *OutboundRuleConfig `json:"outboundRule,omitempty"`
+	// DisableOutboundSNAT indicates whether the outbound source NAT of the load balancing rules is disabled.
+	// +optional
+	DisableOutboundSNAT *bool `json:"disableOutboundSNAT,omitempty"`
+}
+
+// LoadBalancerSKU is the SKU of a load balancer.
+type LoadBalancerSKU string
+
+const (
+	// LoadBalancerSKUBasic is the basic load balancer SKU.
+	LoadBalancerSKUBasic LoadBalancerSKU = "Basic"
+	// LoadBalancerSKUStandard is the standard load balancer SKU.
+	LoadBalancerSKUStandard LoadBalancerSKU = "Standard"
+)
+
+// OutboundRuleConfig contains configuration for the outbound rule of a load balancer.
+type OutboundRuleConfig struct {
+	// AllocatedOutboundPorts is the number of SNAT ports allocated per backend instance. Must be a multiple of 8.
+	// +optional
+	AllocatedOutboundPorts *int32 `json:"allocatedOutboundPorts,omitempty"`
+	// IdleTimeoutInMinutes is the idle timeout of outbound connections in minutes. Must be between 4 and 100.
+	// +optional
+	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
 }
 
 // Storage contains configuration for storage in the cluster.
@@ -42,4 +128,73 @@ type Storage struct {
 	// Defaults to true.
 	// +optional
 	ManagedDefaultVolumeSnapshotClass *bool `json:"managedDefaultVolumeSnapshotClass,omitempty"`
+	// VolumeSnapshotClass contains configuration for the parameters of the 'default' VolumeSnapshotClass.
+	// +optional
+	VolumeSnapshotClass *VolumeSnapshotClassConfig `json:"volumeSnapshotClass,omitempty"`
+	// SnapshotValidationWebhook controls if the CSI snapshot validation webhook is deployed.
+	// Defaults to true.
+	// +optional
+	SnapshotValidationWebhook *bool `json:"snapshotValidationWebhook,omitempty"`
+}
+
+// VolumeSnapshotClassConfig contains configuration for the parameters of a VolumeSnapshotClass.
+type VolumeSnapshotClassConfig struct {
+	// Incremental controls if incremental snapshots are taken. Defaults to the default of the CSI driver.
+	// +optional
+	Incremental *bool `json:"incremental,omitempty"`
+	// ResourceGroup is the name of the resource group in which the snapshots are stored. Defaults to the resource group
+	// of the source disk.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
+	// Tags are the tags which are added to the snapshots.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// RemedyConfig contains configuration settings for the remedy controller.
+type RemedyConfig struct {
+	// Enabled indicates whether the remedy controller is deployed for the shoot. Defaults to `true`.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// OrphanedPublicIPRemedy contains configuration for the remedy of orphaned public IP addresses.
+	// +optional
+	OrphanedPublicIPRemedy *OrphanedPublicIPRemedyConfig `json:"orphanedPublicIPRemedy,omitempty"`
+	// FailedVMRemedy contains configuration for the remedy of virtual machines in a failed state.
+	// +optional
+	FailedVMRemedy *FailedVMRemedyConfig `json:"failedVMRemedy,omitempty"`
+}
+
+// OrphanedPublicIPRemedyConfig contains configuration for the remedy of orphaned public IP addresses.
+type OrphanedPublicIPRemedyConfig struct {
+	// RequeueInterval is the interval in which a public IP address is requeued while it is being remedied.
+	// +optional
+	RequeueInterval *metav1.Duration `json:"requeueInterval,omitempty"`
+	// SyncPeriod is the period in which public IP addresses are resynced.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// DeletionGracePeriod is the period after which an orphaned public IP address is deleted.
+	// +optional
+	DeletionGracePeriod *metav1.Duration `json:"deletionGracePeriod,omitempty"`
+	// MaxGetAttempts is the maximum number of attempts to get a public IP address.
+	// +optional
+	MaxGetAttempts *int32 `json:"maxGetAttempts,omitempty"`
+	// MaxCleanAttempts is the maximum number of attempts to clean up an orphaned public IP address.
+	// +optional
+	MaxCleanAttempts *int32 `json:"maxCleanAttempts,omitempty"`
+}
+
+// FailedVMRemedyConfig contains configuration for the remedy of virtual machines in a failed state.
+type FailedVMRemedyConfig struct {
+	// RequeueInterval is the interval in which a virtual machine is requeued while it is being remedied.
+	// +optional
+	RequeueInterval *metav1.Duration `json:"requeueInterval,omitempty"`
+	// SyncPeriod is the period in which virtual machines are resynced.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxGetAttempts is the maximum number of attempts to get a virtual machine.
+	// +optional
+	MaxGetAttempts *int32 `json:"maxGetAttempts,omitempty"`
+	// MaxReapplyAttempts is the maximum number of attempts to reapply a virtual machine in a failed state.
+	// +optional
+	MaxReapplyAttempts *int32 `json:"maxReapplyAttempts,omitempty"`
 }
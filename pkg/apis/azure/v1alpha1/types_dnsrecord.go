@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordConfig is the provider-specific configuration for DNS records.
+type DNSRecordConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	// SubscriptionID is the ID of the subscription the DNS zone belongs to. If not set, the subscription of the
+	// DNS credentials is used.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+	// ResourceGroup is the name of the resource group the DNS zone belongs to. If not set, the DNS zone is discovered
+	// in all resource groups of the subscription.
+	// +optional
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
+	// CredentialsRef is the name of a secret referenced in the Shoot's `.spec.resources[].resourceRef.name` which
+	// contains the Azure credentials used to manage the DNS records instead of the DNS credentials of the DNSRecord,
+	// e.g. a DNS-only service principal in another subscription.
+	// +optional
+	CredentialsRef *string `json:"credentialsRef,omitempty"`
+	// AliasTarget is the ID of an Azure resource, e.g. a public IP address, a Traffic Manager profile or a CDN endpoint,
+	// the recordset points to as an alias recordset. It can only be set for records of type A, AAAA or CNAME, whose
+	// values are ignored in this case.
+	// +optional
+	AliasTarget *string `json:"aliasTarget,omitempty"`
+}
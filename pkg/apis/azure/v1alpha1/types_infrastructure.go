@@ -24,12 +24,53 @@ type InfrastructureConfig struct {
 	// Zoned indicates whether the cluster uses availability zones.
 	// +optional
 	Zoned bool `json:"zoned,omitempty"`
+	// AuxiliaryResources contains configuration for auxiliary resources created by the extension.
+	// +optional
+	AuxiliaryResources *AuxiliaryResourcesConfig `json:"auxiliaryResources,omitempty"`
+	// CredentialsRef is the name of a secret referenced in the Shoot's `.spec.resources[].resourceRef.name` which
+	// contains the Azure credentials used to manage the infrastructure instead of the Shoot's cloud provider credentials,
+	// e.g. a service principal which is only permitted to manage network resources.
+	// +optional
+	CredentialsRef *string `json:"credentialsRef,omitempty"`
+	// Observability contains configuration for the monitoring of the infrastructure resources.
+	// +optional
+	Observability *ObservabilityConfig `json:"observability,omitempty"`
+}
+
+// ObservabilityConfig contains configuration for the monitoring of the infrastructure resources.
+type ObservabilityConfig struct {
+	// LogAnalyticsWorkspaceID is the resource ID of a Log Analytics workspace. If set, Azure Monitor diagnostic settings
+	// sending the logs and metrics of the NAT gateways, the security group and the outbound load balancer to the
+	// workspace are created.
+	LogAnalyticsWorkspaceID string `json:"logAnalyticsWorkspaceID"`
+}
+
+// AuxiliaryResourcesConfig contains configuration for auxiliary resources created by the extension.
+type AuxiliaryResourcesConfig struct {
+	// BootDiagnostics indicates whether a storage account for the boot diagnostics of the worker nodes is created.
+	// +optional
+	BootDiagnostics bool `json:"bootDiagnostics,omitempty"`
+	// ManagedIdentity indicates whether a user-assigned managed identity is created for the worker nodes instead of
+	// referencing an existing one via Identity.
+	// +optional
+	ManagedIdentity bool `json:"managedIdentity,omitempty"`
+	// Region is the region in which the auxiliary resources are created, e.g. the paired region of the shoot's region
+	// for disaster recovery. Defaults to the shoot's region.
+	// +optional
+	Region *string `json:"region,omitempty"`
 }
 
 // ResourceGroup is azure resource group
 type ResourceGroup struct {
-	// Name is the name of the resource group
-	Name string `json:"name"`
+	// Name is the name of an existing resource group to deploy the shoot into. If it is empty, the resource group of
+	// the shoot is created and managed by the extension.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// DeletionProtection protects the resource group managed by the extension against deletion with a `CanNotDelete`
+	// management lock on the security group of the shoot, which blocks the deletion of the resource group but not of the
+	// other resources in it. The lock is removed as the first step of the deletion of the infrastructure.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
 }
 
 // NetworkConfig holds information about the Kubernetes and infrastructure networks.
@@ -47,6 +88,94 @@ type NetworkConfig struct {
 	ServiceEndpoints []string `json:"serviceEndpoints,omitempty"`
 	// Zones is a list of zones with their respective configuration.
 	Zones []Zone `json:"zones,omitempty"`
+	// PodSubnet contains the configuration for a dedicated subnet for pods, e.g. to run Azure CNI with dynamic IP allocation.
+	// +optional
+	PodSubnet *PodSubnetConfig `json:"podSubnet,omitempty"`
+	// OutboundAccess contains the configuration for the egress traffic of the worker subnets.
+	// +optional
+	OutboundAccess *OutboundAccessConfig `json:"outboundAccess,omitempty"`
+	// OutboundAccessType is the type of outbound access of the worker subnets. If not set, it is derived from the NAT
+	// gateway and outbound access configuration.
+	// +optional
+	OutboundAccessType *OutboundAccessType `json:"outboundAccessType,omitempty"`
+	// OutboundLoadBalancer contains the configuration for the outbound rule of the shoot's load balancer. It can only be
+	// configured if the outbound access type is LoadBalancer.
+	// +optional
+	OutboundLoadBalancer *OutboundLoadBalancerConfig `json:"outboundLoadBalancer,omitempty"`
+	// FlowLogs contains the configuration for the Network Watcher virtual network flow logs of the worker subnets.
+	// +optional
+	FlowLogs *FlowLogsConfig `json:"flowLogs,omitempty"`
+	// EgressFirewall contains the configuration for an Azure Firewall through which the egress traffic of the worker
+	// subnets is routed.
+	// +optional
+	EgressFirewall *EgressFirewallConfig `json:"egressFirewall,omitempty"`
+}
+
+// EgressFirewallConfig contains the configuration for an Azure Firewall of the Basic SKU, which is created in the
+// shoot's vnet and through which the egress traffic of the worker subnets is routed.
+type EgressFirewallConfig struct {
+	// CIDR is the CIDR range of the subnet of the firewall. It must be at least a /26 within the vnet.
+	CIDR string `json:"cidr"`
+	// ManagementCIDR is the CIDR range of the subnet for the management traffic of the firewall, which the Basic SKU
+	// requires. It must be at least a /26 within the vnet.
+	ManagementCIDR string `json:"managementCIDR"`
+	// PolicyID is the resource ID of an existing firewall policy of the Basic tier which controls the egress traffic. If
+	// not set, a policy which allows all egress traffic of the worker subnets is created.
+	// +optional
+	PolicyID *string `json:"policyID,omitempty"`
+}
+
+// FlowLogsConfig contains the configuration for the Network Watcher virtual network flow logs of the worker subnets.
+type FlowLogsConfig struct {
+	// StorageAccountID is the resource ID of the storage account to which the flow logs are written. It must be located
+	// in the shoot's region.
+	StorageAccountID string `json:"storageAccountID"`
+	// RetentionDays is the number of days the flow logs are retained in the storage account. If not set or 0, the flow
+	// logs are retained indefinitely.
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+	// TrafficAnalytics contains the configuration for Traffic Analytics of the flow logs.
+	// +optional
+	TrafficAnalytics *TrafficAnalyticsConfig `json:"trafficAnalytics,omitempty"`
+}
+
+// TrafficAnalyticsConfig contains the configuration for Traffic Analytics of the flow logs.
+type TrafficAnalyticsConfig struct {
+	// WorkspaceID is the resource ID of the Log Analytics workspace to which the analysed flow logs are sent.
+	WorkspaceID string `json:"workspaceID"`
+	// IntervalInMinutes is the interval in which the flow logs are analysed. Must be 10 or 60, defaults to 60.
+	// +optional
+	IntervalInMinutes *int32 `json:"intervalInMinutes,omitempty"`
+}
+
+// OutboundLoadBalancerConfig contains the configuration for the outbound rule of the shoot's load balancer.
+type OutboundLoadBalancerConfig struct {
+	// AllocatedOutboundPorts is the number of SNAT ports allocated per node. It must be a multiple of 8. If not set, the
+	// ports are allocated by Azure based on the number of nodes.
+	// +optional
+	AllocatedOutboundPorts *int32 `json:"allocatedOutboundPorts,omitempty"`
+	// PublicIPCount is the number of managed public IPs used for the egress traffic. Defaults to 1.
+	// +optional
+	PublicIPCount *int32 `json:"publicIPCount,omitempty"`
+}
+
+// OutboundAccessConfig contains the configuration for the egress traffic of the worker subnets.
+type OutboundAccessConfig struct {
+	// NextHopIPAddress is the IP address of a virtual appliance, e.g. an Azure Firewall, to which all egress traffic is
+	// routed via a default route in the worker route table.
+	NextHopIPAddress string `json:"nextHopIPAddress"`
+}
+
+// PodSubnetConfig contains the configuration for a dedicated subnet for pods.
+type PodSubnetConfig struct {
+	// CIDR is the CIDR range of the pod subnet that is created in the shoot's VNet.
+	// Either CIDR or Name must be specified.
+	// +optional
+	CIDR *string `json:"cidr,omitempty"`
+	// Name is the name of an existing subnet in the shoot's VNet that is used as pod subnet.
+	// Either CIDR or Name must be specified.
+	// +optional
+	Name *string `json:"name,omitempty"`
 }
 
 // NatGatewayConfig contains configuration for the NAT gateway and the attached resources.
@@ -62,6 +191,19 @@ type NatGatewayConfig struct {
 	// IPAddresses is a list of ip addresses which should be assigned to the NAT gateway.
 	// +optional
 	IPAddresses []PublicIPReference `json:"ipAddresses,omitempty"`
+	// DDoSProtection is the DDoS protection of the public IP which is created for the NAT gateway. It can only be
+	// configured if no IP addresses are specified, as only the public IPs created by Gardener are known to be of the
+	// Standard SKU.
+	// +optional
+	DDoSProtection *PublicIPDDoSProtection `json:"ddosProtection,omitempty"`
+	// DNSSettings are the DNS settings of the public IP which is created for the NAT gateway. They can only be configured
+	// if no IP addresses are specified.
+	// +optional
+	DNSSettings *PublicIPDNSSettings `json:"dnsSettings,omitempty"`
+	// AutoScaleIPs configures the automatic scaling of the public IPs which are created for the NAT gateway with the
+	// number of nodes behind it. It can only be configured if no IP addresses are specified.
+	// +optional
+	AutoScaleIPs *NatGatewayIPAutoScaling `json:"autoScaleIPs,omitempty"`
 }
 
 // PublicIPReference contains information about a public ip.
@@ -86,6 +228,16 @@ type Zone struct {
 	// NatGateway contains the configuration for the NatGateway associated with this subnet.
 	// +optional
 	NatGateway *ZonedNatGatewayConfig `json:"natGateway,omitempty"`
+	// SecurityGroup contains the configuration for the network security group associated with the zone's subnet.
+	// +optional
+	SecurityGroup *ZoneSecurityGroupConfig `json:"securityGroup,omitempty"`
+}
+
+// ZoneSecurityGroupConfig contains configuration for the network security group of a zone's subnet.
+type ZoneSecurityGroupConfig struct {
+	// ExternalID is the ID of a centrally managed network security group that is associated with the zone's subnet.
+	// If set, the worker security group is not associated with the subnet.
+	ExternalID string `json:"externalID"`
 }
 
 // ZonedNatGatewayConfig contains configuration for NAT gateway and the attached resources.
@@ -98,8 +250,73 @@ type ZonedNatGatewayConfig struct {
 	// IPAddresses is a list of ip addresses which should be assigned to the NAT gateway.
 	// +optional
 	IPAddresses []ZonedPublicIPReference `json:"ipAddresses,omitempty"`
+	// DDoSProtection is the DDoS protection of the public IP which is created for the NAT gateway. It can only be
+	// configured if no IP addresses are specified, as only the public IPs created by Gardener are known to be of the
+	// Standard SKU.
+	// +optional
+	DDoSProtection *PublicIPDDoSProtection `json:"ddosProtection,omitempty"`
+	// DNSSettings are the DNS settings of the public IP which is created for the NAT gateway. They can only be configured
+	// if no IP addresses are specified.
+	// +optional
+	DNSSettings *PublicIPDNSSettings `json:"dnsSettings,omitempty"`
+	// AutoScaleIPs configures the automatic scaling of the public IPs which are created for the NAT gateway with the
+	// number of nodes behind it. It can only be configured if no IP addresses are specified.
+	// +optional
+	AutoScaleIPs *NatGatewayIPAutoScaling `json:"autoScaleIPs,omitempty"`
+	// Existing references an existing NAT gateway which is managed outside of Gardener and associated with the zone's
+	// subnet. If set, no NAT gateway is created for the zone and the other fields must not be configured.
+	// +optional
+	Existing *NatGatewayReference `json:"existing,omitempty"`
+}
+
+// NatGatewayReference contains information about an existing NAT gateway.
+type NatGatewayReference struct {
+	// Name is the name of the NAT gateway.
+	Name string `json:"name"`
+	// ResourceGroup is the name of the resource group of the NAT gateway.
+	ResourceGroup string `json:"resourceGroup"`
+}
+
+// PublicIPDDoSProtection contains the DDoS protection configuration of a public IP.
+type PublicIPDDoSProtection struct {
+	// Mode is the DDoS protection mode of the public IP. The mode Enabled activates the DDoS IP protection for the
+	// single public IP, VirtualNetworkInherited protects the public IP with the DDoS protection plan of the virtual network.
+	Mode PublicIPDDoSProtectionMode `json:"mode"`
+}
+
+// NatGatewayIPAutoScaling contains the configuration for the automatic scaling of the public IPs of a NAT gateway.
+type NatGatewayIPAutoScaling struct {
+	// NodesPerIP is the number of nodes for which one public IP is attached to the NAT gateway. The nodes are counted
+	// based on the maximum sizes of the worker pools in the zone of the NAT gateway.
+	NodesPerIP int32 `json:"nodesPerIP"`
+	// MaxIPCount is the maximum number of public IPs which are attached to the NAT gateway.
+	MaxIPCount int32 `json:"maxIPCount"`
+}
+
+// PublicIPDNSSettings contains the DNS settings of a public IP.
+type PublicIPDNSSettings struct {
+	// DomainNameLabel is the label of the public IP in the regional Azure DNS zone, i.e. the public IP is resolvable by
+	// the name <domainNameLabel>.<region>.cloudapp.azure.com. The label must be unique within the region.
+	DomainNameLabel string `json:"domainNameLabel"`
+	// ReverseFQDN is the fully qualified domain name which the reverse DNS lookup of the public IP resolves to. It must
+	// resolve to the public IP or to the regional name of the public IP.
+	// +optional
+	ReverseFQDN *string `json:"reverseFqdn,omitempty"`
 }
 
+// PublicIPDDoSProtectionMode is the DDoS protection mode of a public IP.
+type PublicIPDDoSProtectionMode string
+
+const (
+	// PublicIPDDoSProtectionModeEnabled enables the DDoS IP protection for the public IP.
+	PublicIPDDoSProtectionModeEnabled PublicIPDDoSProtectionMode = "Enabled"
+	// PublicIPDDoSProtectionModeDisabled disables the DDoS protection for the public IP.
+	PublicIPDDoSProtectionModeDisabled PublicIPDDoSProtectionMode = "Disabled"
+	// PublicIPDDoSProtectionModeVirtualNetworkInherited protects the public IP with the DDoS protection plan of the
+	// virtual network.
+	PublicIPDDoSProtectionModeVirtualNetworkInherited PublicIPDDoSProtectionMode = "VirtualNetworkInherited"
+)
+
 // ZonedPublicIPReference contains information about a public ip.
 type ZonedPublicIPReference struct {
 	// Name is the name of the public ip.
@@ -123,6 +340,9 @@ type InfrastructureStatus struct {
 	// MigratingToVMO indicates whether the infrastructure controller has prepared the migration from Availability set.
 	// Deprecated: Will be removed in future versions.
 	MigratingToVMO bool `json:"migratingToVMO,omitempty"`
+	// AvailabilitySetMigration is the progress of a staged migration from the availability set to VMOs.
+	// +optional
+	AvailabilitySetMigration *AvailabilitySetMigrationStatus `json:"availabilitySetMigration,omitempty"`
 	// RouteTables is a list of created route tables
 	RouteTables []RouteTable `json:"routeTables"`
 	// SecurityGroups is a list of created security groups
@@ -133,6 +353,17 @@ type InfrastructureStatus struct {
 	// Zoned indicates whether the cluster uses zones
 	// +optional
 	Zoned bool `json:"zoned,omitempty"`
+	// BootDiagnostics is the status of the storage account created for boot diagnostics.
+	// +optional
+	BootDiagnostics *BootDiagnosticsStatus `json:"bootDiagnostics,omitempty"`
+	// EgressCIDRsHistory is a capped list of the egress CIDRs observed for the infrastructure, most recent last.
+	// A new entry is added whenever the egress CIDRs change, e.g. when the NAT gateway public IPs are rotated.
+	// +optional
+	EgressCIDRsHistory []EgressCIDRsHistoryEntry `json:"egressCIDRsHistory,omitempty"`
+	// ZoneMappings is the mapping of the logical availability zones of the subscription to the physical availability
+	// zones of the region, e.g. to align the zones with the ones of other subscriptions.
+	// +optional
+	ZoneMappings []ZoneMapping `json:"zoneMappings,omitempty"`
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
@@ -148,6 +379,40 @@ type NetworkStatus struct {
 
 	// OutboundAccessType is the type of outbound access configured for the shoot. It indicates how egress traffic flows outside the shoot.
 	OutboundAccessType OutboundAccessType `json:"outboundAccessType"`
+
+	// NatGateways are the NAT gateways that have been created.
+	// +optional
+	NatGateways []NatGatewayStatus `json:"natGateways,omitempty"`
+	// OutboundLoadBalancer contains information about the outbound rule of the shoot's load balancer.
+	// +optional
+	OutboundLoadBalancer *OutboundLoadBalancerStatus `json:"outboundLoadBalancer,omitempty"`
+	// EgressFirewall contains information about the Azure Firewall through which the egress traffic is routed.
+	// +optional
+	EgressFirewall *EgressFirewallStatus `json:"egressFirewall,omitempty"`
+}
+
+// EgressFirewallStatus contains information about the Azure Firewall through which the egress traffic is routed.
+type EgressFirewallStatus struct {
+	// Name is the name of the firewall.
+	Name string `json:"name"`
+	// ID is the ID of the firewall.
+	ID string `json:"id"`
+	// PrivateIPAddress is the private IP address of the firewall, to which the default route of the worker route table
+	// points.
+	PrivateIPAddress string `json:"privateIPAddress"`
+	// PublicIPAddress is the public IP address used for the egress traffic.
+	PublicIPAddress PublicIPAddressStatus `json:"publicIPAddress"`
+}
+
+// OutboundLoadBalancerStatus contains information about the outbound rule of the shoot's load balancer.
+type OutboundLoadBalancerStatus struct {
+	// Name is the name of the load balancer.
+	Name string `json:"name"`
+	// OutboundRuleName is the name of the outbound rule.
+	OutboundRuleName string `json:"outboundRuleName"`
+	// PublicIPAddresses are the public IP addresses used by the outbound rule.
+	// +optional
+	PublicIPAddresses []PublicIPAddressStatus `json:"publicIPAddresses,omitempty"`
 }
 
 // Purpose is a purpose of a subnet.
@@ -158,6 +423,8 @@ const (
 	PurposeNodes Purpose = "nodes"
 	// PurposeInternal is a Purpose for internal use.
 	PurposeInternal Purpose = "internal"
+	// PurposePods is a Purpose for pods.
+	PurposePods Purpose = "pods"
 )
 
 // NetworkLayout is the network layout type for the cluster.
@@ -180,6 +447,8 @@ const (
 	OutboundAccessTypeNatGateway OutboundAccessType = "NATGateway"
 	// OutboundAccessTypeLoadBalancer indicates that the outbound access happens through configured FrontendIPs of a LoadBalancer.
 	OutboundAccessTypeLoadBalancer OutboundAccessType = "LoadBalancer"
+	// OutboundAccessTypeUserDefinedRouting indicates that the outbound access happens through a user-defined default route to a virtual appliance.
+	OutboundAccessTypeUserDefinedRouting OutboundAccessType = "UserDefinedRouting"
 )
 
 // Subnet is a subnet that was created.
@@ -197,6 +466,39 @@ type Subnet struct {
 	// NatGatewayID is the ID of the NATGateway associated with the subnet.
 	// +optional
 	NatGatewayID *string `json:"natGatewayId,omitempty"`
+	// SecurityGroupID is the ID of the network security group associated with the subnet.
+	// +optional
+	SecurityGroupID *string `json:"securityGroupId,omitempty"`
+	// ID is the ID of the subnet.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// NatGatewayStatus contains information about a NAT gateway that was created.
+type NatGatewayStatus struct {
+	// Name is the name of the NAT gateway.
+	Name string `json:"name"`
+	// ID is the ID of the NAT gateway.
+	ID string `json:"id"`
+	// Zone is the zone of the NAT gateway.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+	// PublicIPAddresses are the public IP addresses attached to the NAT gateway.
+	// +optional
+	PublicIPAddresses []PublicIPAddressStatus `json:"publicIPAddresses,omitempty"`
+}
+
+// PublicIPAddressStatus contains information about a public IP address attached to a NAT gateway or load balancer.
+type PublicIPAddressStatus struct {
+	// Name is the name of the public IP address.
+	Name string `json:"name"`
+	// ResourceGroup is the resource group of the public IP address.
+	ResourceGroup string `json:"resourceGroup"`
+	// ID is the ID of the public IP address.
+	ID string `json:"id"`
+	// IPAddress is the allocated IP address.
+	// +optional
+	IPAddress string `json:"ipAddress,omitempty"`
 }
 
 // AvailabilitySet contains information about the azure availability set
@@ -221,6 +523,12 @@ type RouteTable struct {
 	Purpose Purpose `json:"purpose"`
 	// Name is the name of the route table
 	Name string `json:"name"`
+	// ID is the ID of the route table.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// Managed indicates whether the route table is managed by Gardener.
+	// +optional
+	Managed bool `json:"managed,omitempty"`
 }
 
 // SecurityGroup contains information about the security group
@@ -229,6 +537,13 @@ type SecurityGroup struct {
 	Purpose Purpose `json:"purpose"`
 	// Name is the name of the security group
 	Name string `json:"name"`
+	// ID is the ID of the security group.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// Managed indicates whether the security group is managed by Gardener. Security groups which are managed outside of
+	// Gardener, e.g. the external security groups of zones, are not managed.
+	// +optional
+	Managed bool `json:"managed,omitempty"`
 }
 
 // VNet contains information about the VNet and some related resources.
@@ -242,6 +557,10 @@ type VNet struct {
 	// CIDR is the VNet CIDR
 	// +optional
 	CIDR *string `json:"cidr,omitempty"`
+	// AdditionalCIDRs are further address prefixes of the VNet, which extend its address space without replacing it.
+	// They can only be specified for VNets managed by Gardener and can only be appended.
+	// +optional
+	AdditionalCIDRs []string `json:"additionalCidrs,omitempty"`
 	// DDosProtectionPlanID is the id of a ddos protection plan assigned to the vnet.
 	// +optional
 	DDosProtectionPlanID *string `json:"ddosProtectionPlanID,omitempty"`
@@ -265,6 +584,18 @@ type IdentityConfig struct {
 	// ACRAccess indicated if the identity should be used by the Shoot worker nodes to pull from an Azure Container Registry.
 	// +optional
 	ACRAccess *bool `json:"acrAccess,omitempty"`
+	// ACRAccessMode is the mode used to configure the worker nodes for pulling from an Azure Container Registry with the
+	// identity. Defaults to ConfigMap.
+	// +optional
+	ACRAccessMode *ACRAccessMode `json:"acrAccessMode,omitempty"`
+	// SubscriptionID is the subscription in which the identity resides. Defaults to the subscription of the shoot's
+	// credentials.
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+	// TenantID is the tenant of the subscription in which the identity resides, e.g. when the subscription is delegated
+	// to the tenant of the shoot's credentials via Azure Lighthouse. Defaults to the tenant of the shoot's credentials.
+	// +optional
+	TenantID *string `json:"tenantID,omitempty"`
 }
 
 // IdentityStatus contains the status information of the created managed identity.
@@ -275,6 +606,43 @@ type IdentityStatus struct {
 	ClientID string `json:"clientID"`
 	// ACRAccess specifies if the identity should be used by the Shoot worker nodes to pull from an Azure Container Registry.
 	ACRAccess bool `json:"acrAccess"`
+	// ACRAccessMode is the mode used to configure the worker nodes for pulling from an Azure Container Registry with the
+	// identity.
+	// +optional
+	ACRAccessMode *ACRAccessMode `json:"acrAccessMode,omitempty"`
+}
+
+// ACRAccessMode is the mode used to configure the worker nodes for pulling from an Azure Container Registry.
+type ACRAccessMode string
+
+const (
+	// ACRAccessModeConfigMap configures the legacy in-tree credential provider of the kubelet with the
+	// --azure-container-registry-config flag. The flag is not supported by kubelets as of Kubernetes 1.30.
+	ACRAccessModeConfigMap ACRAccessMode = "ConfigMap"
+	// ACRAccessModeCredentialProvider configures the kubelet to use the acr-credential-provider binary as image
+	// credential provider plugin.
+	ACRAccessModeCredentialProvider ACRAccessMode = "CredentialProvider"
+)
+
+// ZoneMapping is the mapping of a logical availability zone of the subscription to a physical availability zone.
+type ZoneMapping struct {
+	// LogicalZone is the logical zone of the subscription, e.g. "1".
+	LogicalZone string `json:"logicalZone"`
+	// PhysicalZone is the physical zone the logical zone is mapped to, e.g. "westeurope-az2".
+	PhysicalZone string `json:"physicalZone"`
+}
+
+// BootDiagnosticsStatus contains the status information of the storage account created for boot diagnostics.
+type BootDiagnosticsStatus struct {
+	// StorageAccountName is the name of the storage account.
+	StorageAccountName string `json:"storageAccountName"`
+	// StorageURI is the blob endpoint of the storage account.
+	StorageURI string `json:"storageURI"`
+	// Region is the region of the storage account.
+	Region string `json:"region"`
+	// ZoneRedundant indicates whether the storage account is zone-redundant.
+	// +optional
+	ZoneRedundant bool `json:"zoneRedundant,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -288,6 +656,31 @@ type InfrastructureState struct {
 	// ManagedItems is a list of resources that were created during the infrastructure reconciliation.
 	// +optional
 	ManagedItems []AzureResource `json:"managedItems,omitempty"`
+	// Steps contains the execution state of the steps of the infrastructure flow.
+	// +optional
+	Steps []FlowStepState `json:"steps,omitempty"`
+}
+
+// FlowStepState contains the execution state of a step of the infrastructure flow.
+type FlowStepState struct {
+	// Name is the name of the step.
+	Name string `json:"name"`
+	// LastSucceededGeneration is the generation of the Infrastructure for which the step succeeded the last time.
+	// +optional
+	LastSucceededGeneration *int64 `json:"lastSucceededGeneration,omitempty"`
+	// LastSucceededTime is the time at which the step succeeded for the LastSucceededGeneration the first time, or the
+	// first time after it failed.
+	// +optional
+	LastSucceededTime *metav1.Time `json:"lastSucceededTime,omitempty"`
+	// LastFailedTime is the time at which the step failed the last time.
+	// +optional
+	LastFailedTime *metav1.Time `json:"lastFailedTime,omitempty"`
+	// LastError is the error of the last execution of the step if it failed.
+	// +optional
+	LastError *string `json:"lastError,omitempty"`
+	// ConsecutiveFailures is the number of failed executions of the step since it succeeded the last time.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
 }
 
 // AzureResource represents metadata information about created infrastructure resources.
@@ -297,3 +690,20 @@ type AzureResource struct {
 	// ID is the ID of the resource.
 	ID string `json:"id"`
 }
+
+// AvailabilitySetMigrationStatus contains the progress of a staged migration from the availability set to VMOs.
+type AvailabilitySetMigrationStatus struct {
+	// MigratedWorkerPools are the names of the worker pools whose machines are rolled to VMOs.
+	// +optional
+	MigratedWorkerPools []string `json:"migratedWorkerPools,omitempty"`
+	// RemainingVirtualMachines is the number of virtual machines which are still part of the availability set.
+	RemainingVirtualMachines int32 `json:"remainingVirtualMachines"`
+}
+
+// EgressCIDRsHistoryEntry records the egress CIDRs of the infrastructure at a point in time.
+type EgressCIDRsHistoryEntry struct {
+	// CIDRs are the egress CIDRs.
+	CIDRs []string `json:"cidrs"`
+	// Timestamp is the time at which the egress CIDRs were first observed.
+	Timestamp metav1.Time `json:"timestamp"`
+}
@@ -27,6 +27,102 @@ type WorkerConfig struct {
 	// DataVolumes contains configuration for the additional disks attached to VMs.
 	// +optional
 	DataVolumes []DataVolume `json:"dataVolumes,omitempty"`
+
+	// WarmPool contains configuration for pre-provisioned standby nodes of the worker pool.
+	// +optional
+	WarmPool *WarmPool `json:"warmPool,omitempty"`
+
+	// Vmo contains configuration for the VirtualMachineScaleSet Orchestration Mode VM (VMO) of the worker pool.
+	// +optional
+	Vmo *VmoConfig `json:"vmo,omitempty"`
+
+	// VMTags contains configuration for the tags of the virtual machines of the worker pool.
+	// +optional
+	VMTags *VMTagsConfig `json:"vmTags,omitempty"`
+
+	// Kubelet contains Azure-specific settings of the kubelet of the worker pool.
+	// +optional
+	Kubelet *KubeletConfig `json:"kubelet,omitempty"`
+
+	// CloudConfiguration overrides the cloud instance of the CloudProfile for the machines of the worker pool. The
+	// credentials of the shoot must belong to the same cloud instance.
+	// +optional
+	CloudConfiguration *CloudConfiguration `json:"cloudConfiguration,omitempty"`
+
+	// MaintenanceConfiguration is the resource ID of an existing maintenance configuration which is assigned to the VMs
+	// of the worker pool, so that planned maintenance of the platform is only applied in its maintenance window.
+	// +optional
+	MaintenanceConfiguration *string `json:"maintenanceConfiguration,omitempty"`
+
+	// NodesSubnet is the name of a nodes subnet of the multiple subnet network layout in which the machines of all zones
+	// of the worker pool are placed, e.g. to egress via the NAT gateway of a particular zone. If not set, the machines
+	// are placed in the nodes subnet of their zone.
+	// +optional
+	NodesSubnet *string `json:"nodesSubnet,omitempty"`
+
+	// ScheduledEvents contains configuration for the handling of Azure Scheduled Events, i.e. planned maintenance of
+	// the platform, by the machines of the worker pool.
+	// +optional
+	ScheduledEvents *ScheduledEvents `json:"scheduledEvents,omitempty"`
+
+	// LocalDisks contains configuration for the local disks of the VMs of the worker pool, i.e. the NVMe disks of
+	// storage optimized machine types or the temporary disk.
+	// +optional
+	LocalDisks *LocalDisks `json:"localDisks,omitempty"`
+}
+
+// KubeletConfig contains Azure-specific settings of the kubelet of a worker pool.
+type KubeletConfig struct {
+	// NodeStatusUpdateFrequency is the frequency in which the kubelet computes the node status and posts it to the API
+	// server if it changed. Lower values, e.g. for pools of short-lived VMs, let the control plane detect removed VMs
+	// earlier at the cost of more API requests. Defaults to the kubelet default of 10s.
+	// +optional
+	NodeStatusUpdateFrequency *metav1.Duration `json:"nodeStatusUpdateFrequency,omitempty"`
+	// NodeStatusReportFrequency is the frequency in which the kubelet posts the node status to the API server if it
+	// did not change. Defaults to the kubelet default of 5m.
+	// +optional
+	NodeStatusReportFrequency *metav1.Duration `json:"nodeStatusReportFrequency,omitempty"`
+}
+
+// VMTagsConfig contains configuration for the tags of the virtual machines of a worker pool. The tags are merged from
+// the tags maintained by the extension for the infrastructure, the labels of the worker pool and, optionally, the labels
+// of the shoot.
+type VMTagsConfig struct {
+	// IncludeShootLabels adds the labels of the shoot as tags to the virtual machines.
+	// +optional
+	IncludeShootLabels *bool `json:"includeShootLabels,omitempty"`
+	// MergePolicy defines which source takes precedence if tags of different sources have the same name or if the tag
+	// limit of Azure is exceeded. Defaults to PoolLabels.
+	// +optional
+	MergePolicy *VMTagMergePolicy `json:"mergePolicy,omitempty"`
+}
+
+// VMTagMergePolicy is the policy for merging the tags of the virtual machines from different sources.
+type VMTagMergePolicy string
+
+const (
+	// VMTagMergePolicyPoolLabels gives the labels of the worker pool precedence over the labels of the shoot and the
+	// labels of the shoot precedence over the infrastructure tags.
+	VMTagMergePolicyPoolLabels VMTagMergePolicy = "PoolLabels"
+	// VMTagMergePolicyShootLabels gives the labels of the shoot precedence over the labels of the worker pool and the
+	// labels of the worker pool precedence over the infrastructure tags.
+	VMTagMergePolicyShootLabels VMTagMergePolicy = "ShootLabels"
+	// VMTagMergePolicyInfrastructureTags gives the infrastructure tags precedence over the labels of the worker pool and
+	// the labels of the worker pool precedence over the labels of the shoot.
+	VMTagMergePolicyInfrastructureTags VMTagMergePolicy = "InfrastructureTags"
+)
+
+// VmoConfig contains configuration for the VirtualMachineScaleSet Orchestration Mode VM (VMO) of a worker pool.
+type VmoConfig struct {
+	// FaultDomainCount is the platform fault domain count of the VMO. Defaults to the fault domain count of the region
+	// in the CloudProfileConfig. Changing it replaces the VMO of the worker pool.
+	// +optional
+	FaultDomainCount *int32 `json:"faultDomainCount,omitempty"`
+	// ZoneBalance configures whether the machines of the VMO are strictly evenly distributed across zones. Azure only
+	// supports zone balancing for VMOs spanning multiple zones, hence it can only be disabled, as VMOs are solely used by
+	// non-zoned shoots. Changing it replaces the VMO of the worker pool.
+	// +optional
+	ZoneBalance *bool `json:"zoneBalance,omitempty"`
 }
 
 // +genclient
@@ -58,12 +154,18 @@ type MachineImage struct {
 	// AcceleratedNetworking is an indicator if the image supports Azure accelerated networking.
 	// +optional
 	AcceleratedNetworking *bool `json:"acceleratedNetworking,omitempty"`
+	// NVMe is an indicator if the image supports the NVMe disk controller.
+	// +optional
+	NVMe *bool `json:"nvme,omitempty"`
 	// Architecture is the CPU architecture of the machine image.
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
 	// SkipMarketplaceAgreement skips the marketplace agreement check when enabled.
 	// +optional
 	SkipMarketplaceAgreement *bool `json:"skipMarketplaceAgreement,omitempty"`
+	// MinimumOSDiskSizeGB is the minimum size of the OS disk in GiB which is required by the image.
+	// +optional
+	MinimumOSDiskSizeGB *int32 `json:"minimumOSDiskSizeGB,omitempty"`
 	// Image identifies the azure image.
 	Image `json:",inline"`
 }
@@ -110,4 +212,44 @@ type DataVolume struct {
 	// ImageRef defines the dataVolume source image.
 	// +optional
 	ImageRef *Image `json:"imageRef,omitempty"`
+	// SourceResourceID is the resource id of a snapshot or disk restore point the data volume is created from.
+	// +optional
+	SourceResourceID *string `json:"sourceResourceID,omitempty"`
+	// IOPS is the number of read/write operations per second of the data volume.
+	// It can only be configured for data volumes of type UltraSSD_LRS or PremiumV2_LRS.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+	// Throughput is the read/write bandwidth of the data volume in MB per second.
+	// It can only be configured for data volumes of type UltraSSD_LRS or PremiumV2_LRS.
+	// +optional
+	Throughput *int64 `json:"throughput,omitempty"`
+}
+
+// ScheduledEvents contains configuration for the handling of Azure Scheduled Events by the machines of a worker pool.
+type ScheduledEvents struct {
+	// Enabled deploys an agent to the machines of the worker pool which watches the Scheduled Events of the VM. If a
+	// Redeploy, Preempt or Terminate event is pending, the agent sets the AzureScheduledEvent condition of the node,
+	// which lets the machine-controller-manager drain and replace the machine after the machine health timeout.
+	Enabled bool `json:"enabled"`
+}
+
+// LocalDisks contains configuration for the local disks of the VMs of a worker pool.
+type LocalDisks struct {
+	// EphemeralStorage formats the local NVMe disks of the VMs, or the temporary disk if the machine type has no NVMe
+	// disks, and mounts the directories of the pods and their logs from them when the machines boot. Hence, the local
+	// disks provide the ephemeral storage of the pods instead of the OS disk. The data on the local disks is lost when
+	// the VM is deallocated or moved to another host.
+	EphemeralStorage bool `json:"ephemeralStorage"`
+}
+
+// WarmPool contains configuration for pre-provisioned standby nodes of a worker pool.
+type WarmPool struct {
+	// Count is the number of standby nodes which are kept on top of the pool minimum to absorb bursts without waiting
+	// for VM provisioning. The warm pool is capped by the pool maximum.
+	Count int32 `json:"count"`
+	// MaxAge is the duration after which nodes which exceed the warm pool and are no longer needed are released again.
+	// It is handed over to the cluster-autoscaler as scale-down-unneeded-time of the pool, unless the pool already
+	// configures one explicitly.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
 }
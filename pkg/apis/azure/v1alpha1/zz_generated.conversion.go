@@ -14,6 +14,8 @@ import (
 
 	azure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -25,6 +27,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AuxiliaryResourcesConfig)(nil), (*azure.AuxiliaryResourcesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuxiliaryResourcesConfig_To_azure_AuxiliaryResourcesConfig(a.(*AuxiliaryResourcesConfig), b.(*azure.AuxiliaryResourcesConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.AuxiliaryResourcesConfig)(nil), (*AuxiliaryResourcesConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_AuxiliaryResourcesConfig_To_v1alpha1_AuxiliaryResourcesConfig(a.(*azure.AuxiliaryResourcesConfig), b.(*AuxiliaryResourcesConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AvailabilitySet)(nil), (*azure.AvailabilitySet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AvailabilitySet_To_azure_AvailabilitySet(a.(*AvailabilitySet), b.(*azure.AvailabilitySet), scope)
 	}); err != nil {
@@ -35,6 +47,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AvailabilitySetMigrationStatus)(nil), (*azure.AvailabilitySetMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AvailabilitySetMigrationStatus_To_azure_AvailabilitySetMigrationStatus(a.(*AvailabilitySetMigrationStatus), b.(*azure.AvailabilitySetMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.AvailabilitySetMigrationStatus)(nil), (*AvailabilitySetMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_AvailabilitySetMigrationStatus_To_v1alpha1_AvailabilitySetMigrationStatus(a.(*azure.AvailabilitySetMigrationStatus), b.(*AvailabilitySetMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AzureResource)(nil), (*azure.AzureResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AzureResource_To_azure_AzureResource(a.(*AzureResource), b.(*azure.AzureResource), scope)
 	}); err != nil {
@@ -55,6 +77,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketInventory)(nil), (*azure.BackupBucketInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketInventory_To_azure_BackupBucketInventory(a.(*BackupBucketInventory), b.(*azure.BackupBucketInventory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BackupBucketInventory)(nil), (*BackupBucketInventory)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BackupBucketInventory_To_v1alpha1_BackupBucketInventory(a.(*azure.BackupBucketInventory), b.(*BackupBucketInventory), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketLegalHold)(nil), (*azure.BackupBucketLegalHold)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketLegalHold_To_azure_BackupBucketLegalHold(a.(*BackupBucketLegalHold), b.(*azure.BackupBucketLegalHold), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BackupBucketLegalHold)(nil), (*BackupBucketLegalHold)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BackupBucketLegalHold_To_v1alpha1_BackupBucketLegalHold(a.(*azure.BackupBucketLegalHold), b.(*BackupBucketLegalHold), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketLifecycle)(nil), (*azure.BackupBucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketLifecycle_To_azure_BackupBucketLifecycle(a.(*BackupBucketLifecycle), b.(*azure.BackupBucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BackupBucketLifecycle)(nil), (*BackupBucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle(a.(*azure.BackupBucketLifecycle), b.(*BackupBucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketNetworkACLs)(nil), (*azure.BackupBucketNetworkACLs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketNetworkACLs_To_azure_BackupBucketNetworkACLs(a.(*BackupBucketNetworkACLs), b.(*azure.BackupBucketNetworkACLs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BackupBucketNetworkACLs)(nil), (*BackupBucketNetworkACLs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BackupBucketNetworkACLs_To_v1alpha1_BackupBucketNetworkACLs(a.(*azure.BackupBucketNetworkACLs), b.(*BackupBucketNetworkACLs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketStatus)(nil), (*azure.BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus(a.(*BackupBucketStatus), b.(*azure.BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BackupBucketStatus)(nil), (*BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(a.(*azure.BackupBucketStatus), b.(*BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BootDiagnosticsStatus)(nil), (*azure.BootDiagnosticsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BootDiagnosticsStatus_To_azure_BootDiagnosticsStatus(a.(*BootDiagnosticsStatus), b.(*azure.BootDiagnosticsStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BootDiagnosticsStatus)(nil), (*BootDiagnosticsStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BootDiagnosticsStatus_To_v1alpha1_BootDiagnosticsStatus(a.(*azure.BootDiagnosticsStatus), b.(*BootDiagnosticsStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSIDriverControllerConfig)(nil), (*azure.CSIDriverControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIDriverControllerConfig_To_azure_CSIDriverControllerConfig(a.(*CSIDriverControllerConfig), b.(*azure.CSIDriverControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.CSIDriverControllerConfig)(nil), (*CSIDriverControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig(a.(*azure.CSIDriverControllerConfig), b.(*CSIDriverControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudConfiguration)(nil), (*azure.CloudConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration(a.(*CloudConfiguration), b.(*azure.CloudConfiguration), scope)
 	}); err != nil {
@@ -95,6 +187,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordConfig)(nil), (*azure.DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig(a.(*DNSRecordConfig), b.(*azure.DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.DNSRecordConfig)(nil), (*DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(a.(*azure.DNSRecordConfig), b.(*DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*azure.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataVolume_To_azure_DataVolume(a.(*DataVolume), b.(*azure.DataVolume), scope)
 	}); err != nil {
@@ -125,6 +227,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EgressCIDRsHistoryEntry)(nil), (*azure.EgressCIDRsHistoryEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressCIDRsHistoryEntry_To_azure_EgressCIDRsHistoryEntry(a.(*EgressCIDRsHistoryEntry), b.(*azure.EgressCIDRsHistoryEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.EgressCIDRsHistoryEntry)(nil), (*EgressCIDRsHistoryEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_EgressCIDRsHistoryEntry_To_v1alpha1_EgressCIDRsHistoryEntry(a.(*azure.EgressCIDRsHistoryEntry), b.(*EgressCIDRsHistoryEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressFirewallConfig)(nil), (*azure.EgressFirewallConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressFirewallConfig_To_azure_EgressFirewallConfig(a.(*EgressFirewallConfig), b.(*azure.EgressFirewallConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.EgressFirewallConfig)(nil), (*EgressFirewallConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_EgressFirewallConfig_To_v1alpha1_EgressFirewallConfig(a.(*azure.EgressFirewallConfig), b.(*EgressFirewallConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressFirewallStatus)(nil), (*azure.EgressFirewallStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressFirewallStatus_To_azure_EgressFirewallStatus(a.(*EgressFirewallStatus), b.(*azure.EgressFirewallStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.EgressFirewallStatus)(nil), (*EgressFirewallStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_EgressFirewallStatus_To_v1alpha1_EgressFirewallStatus(a.(*azure.EgressFirewallStatus), b.(*EgressFirewallStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FailedVMRemedyConfig)(nil), (*azure.FailedVMRemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailedVMRemedyConfig_To_azure_FailedVMRemedyConfig(a.(*FailedVMRemedyConfig), b.(*azure.FailedVMRemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.FailedVMRemedyConfig)(nil), (*FailedVMRemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig(a.(*azure.FailedVMRemedyConfig), b.(*FailedVMRemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlowLogsConfig)(nil), (*azure.FlowLogsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig(a.(*FlowLogsConfig), b.(*azure.FlowLogsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.FlowLogsConfig)(nil), (*FlowLogsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig(a.(*azure.FlowLogsConfig), b.(*FlowLogsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlowStepState)(nil), (*azure.FlowStepState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlowStepState_To_azure_FlowStepState(a.(*FlowStepState), b.(*azure.FlowStepState), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.FlowStepState)(nil), (*FlowStepState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_FlowStepState_To_v1alpha1_FlowStepState(a.(*azure.FlowStepState), b.(*FlowStepState), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IdentityConfig)(nil), (*azure.IdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(a.(*IdentityConfig), b.(*azure.IdentityConfig), scope)
 	}); err != nil {
@@ -185,6 +347,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeletConfig)(nil), (*azure.KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeletConfig_To_azure_KubeletConfig(a.(*KubeletConfig), b.(*azure.KubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.KubeletConfig)(nil), (*KubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_KubeletConfig_To_v1alpha1_KubeletConfig(a.(*azure.KubeletConfig), b.(*KubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LeaderElectionConfig)(nil), (*azure.LeaderElectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LeaderElectionConfig_To_azure_LeaderElectionConfig(a.(*LeaderElectionConfig), b.(*azure.LeaderElectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.LeaderElectionConfig)(nil), (*LeaderElectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(a.(*azure.LeaderElectionConfig), b.(*LeaderElectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*azure.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*azure.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*azure.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LocalDisks)(nil), (*azure.LocalDisks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LocalDisks_To_azure_LocalDisks(a.(*LocalDisks), b.(*azure.LocalDisks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.LocalDisks)(nil), (*LocalDisks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_LocalDisks_To_v1alpha1_LocalDisks(a.(*azure.LocalDisks), b.(*LocalDisks), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*azure.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_azure_MachineImage(a.(*MachineImage), b.(*azure.MachineImage), scope)
 	}); err != nil {
@@ -235,6 +437,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NatGatewayIPAutoScaling)(nil), (*azure.NatGatewayIPAutoScaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatGatewayIPAutoScaling_To_azure_NatGatewayIPAutoScaling(a.(*NatGatewayIPAutoScaling), b.(*azure.NatGatewayIPAutoScaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.NatGatewayIPAutoScaling)(nil), (*NatGatewayIPAutoScaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NatGatewayIPAutoScaling_To_v1alpha1_NatGatewayIPAutoScaling(a.(*azure.NatGatewayIPAutoScaling), b.(*NatGatewayIPAutoScaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatGatewayReference)(nil), (*azure.NatGatewayReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatGatewayReference_To_azure_NatGatewayReference(a.(*NatGatewayReference), b.(*azure.NatGatewayReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.NatGatewayReference)(nil), (*NatGatewayReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NatGatewayReference_To_v1alpha1_NatGatewayReference(a.(*azure.NatGatewayReference), b.(*NatGatewayReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatGatewayStatus)(nil), (*azure.NatGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(a.(*NatGatewayStatus), b.(*azure.NatGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.NatGatewayStatus)(nil), (*NatGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(a.(*azure.NatGatewayStatus), b.(*NatGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*azure.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(a.(*NetworkConfig), b.(*azure.NetworkConfig), scope)
 	}); err != nil {
@@ -255,6 +487,106 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ObservabilityConfig)(nil), (*azure.ObservabilityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObservabilityConfig_To_azure_ObservabilityConfig(a.(*ObservabilityConfig), b.(*azure.ObservabilityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ObservabilityConfig)(nil), (*ObservabilityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ObservabilityConfig_To_v1alpha1_ObservabilityConfig(a.(*azure.ObservabilityConfig), b.(*ObservabilityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OrphanedPublicIPRemedyConfig)(nil), (*azure.OrphanedPublicIPRemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphanedPublicIPRemedyConfig_To_azure_OrphanedPublicIPRemedyConfig(a.(*OrphanedPublicIPRemedyConfig), b.(*azure.OrphanedPublicIPRemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.OrphanedPublicIPRemedyConfig)(nil), (*OrphanedPublicIPRemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig(a.(*azure.OrphanedPublicIPRemedyConfig), b.(*OrphanedPublicIPRemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OutboundAccessConfig)(nil), (*azure.OutboundAccessConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundAccessConfig_To_azure_OutboundAccessConfig(a.(*OutboundAccessConfig), b.(*azure.OutboundAccessConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundAccessConfig)(nil), (*OutboundAccessConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundAccessConfig_To_v1alpha1_OutboundAccessConfig(a.(*azure.OutboundAccessConfig), b.(*OutboundAccessConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OutboundLoadBalancerConfig)(nil), (*azure.OutboundLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig(a.(*OutboundLoadBalancerConfig), b.(*azure.OutboundLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundLoadBalancerConfig)(nil), (*OutboundLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig(a.(*azure.OutboundLoadBalancerConfig), b.(*OutboundLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OutboundLoadBalancerStatus)(nil), (*azure.OutboundLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus(a.(*OutboundLoadBalancerStatus), b.(*azure.OutboundLoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundLoadBalancerStatus)(nil), (*OutboundLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus(a.(*azure.OutboundLoadBalancerStatus), b.(*OutboundLoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OutboundRuleConfig)(nil), (*azure.OutboundRuleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutboundRuleConfig_To_azure_OutboundRuleConfig(a.(*OutboundRuleConfig), b.(*azure.OutboundRuleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.OutboundRuleConfig)(nil), (*OutboundRuleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OutboundRuleConfig_To_v1alpha1_OutboundRuleConfig(a.(*azure.OutboundRuleConfig), b.(*OutboundRuleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodSubnetConfig)(nil), (*azure.PodSubnetConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig(a.(*PodSubnetConfig), b.(*azure.PodSubnetConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.PodSubnetConfig)(nil), (*PodSubnetConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig(a.(*azure.PodSubnetConfig), b.(*PodSubnetConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPAddressStatus)(nil), (*azure.PublicIPAddressStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPAddressStatus_To_azure_PublicIPAddressStatus(a.(*PublicIPAddressStatus), b.(*azure.PublicIPAddressStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.PublicIPAddressStatus)(nil), (*PublicIPAddressStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PublicIPAddressStatus_To_v1alpha1_PublicIPAddressStatus(a.(*azure.PublicIPAddressStatus), b.(*PublicIPAddressStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPDDoSProtection)(nil), (*azure.PublicIPDDoSProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPDDoSProtection_To_azure_PublicIPDDoSProtection(a.(*PublicIPDDoSProtection), b.(*azure.PublicIPDDoSProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.PublicIPDDoSProtection)(nil), (*PublicIPDDoSProtection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PublicIPDDoSProtection_To_v1alpha1_PublicIPDDoSProtection(a.(*azure.PublicIPDDoSProtection), b.(*PublicIPDDoSProtection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PublicIPDNSSettings)(nil), (*azure.PublicIPDNSSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PublicIPDNSSettings_To_azure_PublicIPDNSSettings(a.(*PublicIPDNSSettings), b.(*azure.PublicIPDNSSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.PublicIPDNSSettings)(nil), (*PublicIPDNSSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_PublicIPDNSSettings_To_v1alpha1_PublicIPDNSSettings(a.(*azure.PublicIPDNSSettings), b.(*PublicIPDNSSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PublicIPReference)(nil), (*azure.PublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(a.(*PublicIPReference), b.(*azure.PublicIPReference), scope)
 	}); err != nil {
@@ -265,6 +597,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RemedyConfig)(nil), (*azure.RemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RemedyConfig_To_azure_RemedyConfig(a.(*RemedyConfig), b.(*azure.RemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.RemedyConfig)(nil), (*RemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_RemedyConfig_To_v1alpha1_RemedyConfig(a.(*azure.RemedyConfig), b.(*RemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceGroup)(nil), (*azure.ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(a.(*ResourceGroup), b.(*azure.ResourceGroup), scope)
 	}); err != nil {
@@ -275,6 +617,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RotationConfig)(nil), (*azure.RotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RotationConfig_To_azure_RotationConfig(a.(*RotationConfig), b.(*azure.RotationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.RotationConfig)(nil), (*RotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_RotationConfig_To_v1alpha1_RotationConfig(a.(*azure.RotationConfig), b.(*RotationConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RouteTable)(nil), (*azure.RouteTable)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RouteTable_To_azure_RouteTable(a.(*RouteTable), b.(*azure.RouteTable), scope)
 	}); err != nil {
@@ -285,6 +637,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ScheduledEvents)(nil), (*azure.ScheduledEvents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScheduledEvents_To_azure_ScheduledEvents(a.(*ScheduledEvents), b.(*azure.ScheduledEvents), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ScheduledEvents)(nil), (*ScheduledEvents)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ScheduledEvents_To_v1alpha1_ScheduledEvents(a.(*azure.ScheduledEvents), b.(*ScheduledEvents), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecurityGroup)(nil), (*azure.SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(a.(*SecurityGroup), b.(*azure.SecurityGroup), scope)
 	}); err != nil {
@@ -315,6 +677,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TrafficAnalyticsConfig)(nil), (*azure.TrafficAnalyticsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig(a.(*TrafficAnalyticsConfig), b.(*azure.TrafficAnalyticsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.TrafficAnalyticsConfig)(nil), (*TrafficAnalyticsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig(a.(*azure.TrafficAnalyticsConfig), b.(*TrafficAnalyticsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMTagsConfig)(nil), (*azure.VMTagsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMTagsConfig_To_azure_VMTagsConfig(a.(*VMTagsConfig), b.(*azure.VMTagsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.VMTagsConfig)(nil), (*VMTagsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_VMTagsConfig_To_v1alpha1_VMTagsConfig(a.(*azure.VMTagsConfig), b.(*VMTagsConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VNet)(nil), (*azure.VNet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VNet_To_azure_VNet(a.(*VNet), b.(*azure.VNet), scope)
 	}); err != nil {
@@ -335,6 +717,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VmoConfig)(nil), (*azure.VmoConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VmoConfig_To_azure_VmoConfig(a.(*VmoConfig), b.(*azure.VmoConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.VmoConfig)(nil), (*VmoConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_VmoConfig_To_v1alpha1_VmoConfig(a.(*azure.VmoConfig), b.(*VmoConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VmoDependency)(nil), (*azure.VmoDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VmoDependency_To_azure_VmoDependency(a.(*VmoDependency), b.(*azure.VmoDependency), scope)
 	}); err != nil {
@@ -345,6 +737,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshotClassConfig)(nil), (*azure.VolumeSnapshotClassConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotClassConfig_To_azure_VolumeSnapshotClassConfig(a.(*VolumeSnapshotClassConfig), b.(*azure.VolumeSnapshotClassConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.VolumeSnapshotClassConfig)(nil), (*VolumeSnapshotClassConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig(a.(*azure.VolumeSnapshotClassConfig), b.(*VolumeSnapshotClassConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WarmPool)(nil), (*azure.WarmPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WarmPool_To_azure_WarmPool(a.(*WarmPool), b.(*azure.WarmPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.WarmPool)(nil), (*WarmPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_WarmPool_To_v1alpha1_WarmPool(a.(*azure.WarmPool), b.(*WarmPool), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*azure.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(a.(*WorkerConfig), b.(*azure.WorkerConfig), scope)
 	}); err != nil {
@@ -375,28 +787,72 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ZonedNatGatewayConfig)(nil), (*azure.ZonedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ZonedNatGatewayConfig_To_azure_ZonedNatGatewayConfig(a.(*ZonedNatGatewayConfig), b.(*azure.ZonedNatGatewayConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*ZoneMapping)(nil), (*azure.ZoneMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneMapping_To_azure_ZoneMapping(a.(*ZoneMapping), b.(*azure.ZoneMapping), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.ZonedNatGatewayConfig)(nil), (*ZonedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_ZonedNatGatewayConfig_To_v1alpha1_ZonedNatGatewayConfig(a.(*azure.ZonedNatGatewayConfig), b.(*ZonedNatGatewayConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.ZoneMapping)(nil), (*ZoneMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ZoneMapping_To_v1alpha1_ZoneMapping(a.(*azure.ZoneMapping), b.(*ZoneMapping), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ZonedPublicIPReference)(nil), (*azure.ZonedPublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ZonedPublicIPReference_To_azure_ZonedPublicIPReference(a.(*ZonedPublicIPReference), b.(*azure.ZonedPublicIPReference), scope)
+	if err := s.AddGeneratedConversionFunc((*ZoneSecurityGroupConfig)(nil), (*azure.ZoneSecurityGroupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneSecurityGroupConfig_To_azure_ZoneSecurityGroupConfig(a.(*ZoneSecurityGroupConfig), b.(*azure.ZoneSecurityGroupConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*azure.ZonedPublicIPReference)(nil), (*ZonedPublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_azure_ZonedPublicIPReference_To_v1alpha1_ZonedPublicIPReference(a.(*azure.ZonedPublicIPReference), b.(*ZonedPublicIPReference), scope)
+	if err := s.AddGeneratedConversionFunc((*azure.ZoneSecurityGroupConfig)(nil), (*ZoneSecurityGroupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ZoneSecurityGroupConfig_To_v1alpha1_ZoneSecurityGroupConfig(a.(*azure.ZoneSecurityGroupConfig), b.(*ZoneSecurityGroupConfig), scope)
 	}); err != nil {
 		return err
 	}
-	return nil
-}
+	if err := s.AddGeneratedConversionFunc((*ZonedNatGatewayConfig)(nil), (*azure.ZonedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZonedNatGatewayConfig_To_azure_ZonedNatGatewayConfig(a.(*ZonedNatGatewayConfig), b.(*azure.ZonedNatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ZonedNatGatewayConfig)(nil), (*ZonedNatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ZonedNatGatewayConfig_To_v1alpha1_ZonedNatGatewayConfig(a.(*azure.ZonedNatGatewayConfig), b.(*ZonedNatGatewayConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZonedPublicIPReference)(nil), (*azure.ZonedPublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZonedPublicIPReference_To_azure_ZonedPublicIPReference(a.(*ZonedPublicIPReference), b.(*azure.ZonedPublicIPReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ZonedPublicIPReference)(nil), (*ZonedPublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ZonedPublicIPReference_To_v1alpha1_ZonedPublicIPReference(a.(*azure.ZonedPublicIPReference), b.(*ZonedPublicIPReference), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AuxiliaryResourcesConfig_To_azure_AuxiliaryResourcesConfig(in *AuxiliaryResourcesConfig, out *azure.AuxiliaryResourcesConfig, s conversion.Scope) error {
+	out.BootDiagnostics = in.BootDiagnostics
+	out.ManagedIdentity = in.ManagedIdentity
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	return nil
+}
+
+// Convert_v1alpha1_AuxiliaryResourcesConfig_To_azure_AuxiliaryResourcesConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AuxiliaryResourcesConfig_To_azure_AuxiliaryResourcesConfig(in *AuxiliaryResourcesConfig, out *azure.AuxiliaryResourcesConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuxiliaryResourcesConfig_To_azure_AuxiliaryResourcesConfig(in, out, s)
+}
+
+func autoConvert_azure_AuxiliaryResourcesConfig_To_v1alpha1_AuxiliaryResourcesConfig(in *azure.AuxiliaryResourcesConfig, out *AuxiliaryResourcesConfig, s conversion.Scope) error {
+	out.BootDiagnostics = in.BootDiagnostics
+	out.ManagedIdentity = in.ManagedIdentity
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	return nil
+}
+
+// Convert_azure_AuxiliaryResourcesConfig_To_v1alpha1_AuxiliaryResourcesConfig is an autogenerated conversion function.
+func Convert_azure_AuxiliaryResourcesConfig_To_v1alpha1_AuxiliaryResourcesConfig(in *azure.AuxiliaryResourcesConfig, out *AuxiliaryResourcesConfig, s conversion.Scope) error {
+	return autoConvert_azure_AuxiliaryResourcesConfig_To_v1alpha1_AuxiliaryResourcesConfig(in, out, s)
+}
 
 func autoConvert_v1alpha1_AvailabilitySet_To_azure_AvailabilitySet(in *AvailabilitySet, out *azure.AvailabilitySet, s conversion.Scope) error {
 	out.Purpose = azure.Purpose(in.Purpose)
@@ -426,6 +882,28 @@ func Convert_azure_AvailabilitySet_To_v1alpha1_AvailabilitySet(in *azure.Availab
 	return autoConvert_azure_AvailabilitySet_To_v1alpha1_AvailabilitySet(in, out, s)
 }
 
+func autoConvert_v1alpha1_AvailabilitySetMigrationStatus_To_azure_AvailabilitySetMigrationStatus(in *AvailabilitySetMigrationStatus, out *azure.AvailabilitySetMigrationStatus, s conversion.Scope) error {
+	out.MigratedWorkerPools = *(*[]string)(unsafe.Pointer(&in.MigratedWorkerPools))
+	out.RemainingVirtualMachines = in.RemainingVirtualMachines
+	return nil
+}
+
+// Convert_v1alpha1_AvailabilitySetMigrationStatus_To_azure_AvailabilitySetMigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_AvailabilitySetMigrationStatus_To_azure_AvailabilitySetMigrationStatus(in *AvailabilitySetMigrationStatus, out *azure.AvailabilitySetMigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AvailabilitySetMigrationStatus_To_azure_AvailabilitySetMigrationStatus(in, out, s)
+}
+
+func autoConvert_azure_AvailabilitySetMigrationStatus_To_v1alpha1_AvailabilitySetMigrationStatus(in *azure.AvailabilitySetMigrationStatus, out *AvailabilitySetMigrationStatus, s conversion.Scope) error {
+	out.MigratedWorkerPools = *(*[]string)(unsafe.Pointer(&in.MigratedWorkerPools))
+	out.RemainingVirtualMachines = in.RemainingVirtualMachines
+	return nil
+}
+
+// Convert_azure_AvailabilitySetMigrationStatus_To_v1alpha1_AvailabilitySetMigrationStatus is an autogenerated conversion function.
+func Convert_azure_AvailabilitySetMigrationStatus_To_v1alpha1_AvailabilitySetMigrationStatus(in *azure.AvailabilitySetMigrationStatus, out *AvailabilitySetMigrationStatus, s conversion.Scope) error {
+	return autoConvert_azure_AvailabilitySetMigrationStatus_To_v1alpha1_AvailabilitySetMigrationStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_AzureResource_To_azure_AzureResource(in *AzureResource, out *azure.AzureResource, s conversion.Scope) error {
 	out.Kind = in.Kind
 	out.ID = in.ID
@@ -450,6 +928,15 @@ func Convert_azure_AzureResource_To_v1alpha1_AzureResource(in *azure.AzureResour
 
 func autoConvert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupBucketConfig, out *azure.BackupBucketConfig, s conversion.Scope) error {
 	out.CloudConfiguration = (*azure.CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+	out.ResourceGroupRegion = (*string)(unsafe.Pointer(in.ResourceGroupRegion))
+	out.CredentialsSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.CredentialsSecretRef))
+	out.NetworkACLs = (*azure.BackupBucketNetworkACLs)(unsafe.Pointer(in.NetworkACLs))
+	out.Rotation = (*azure.RotationConfig)(unsafe.Pointer(in.Rotation))
+	out.Inventory = (*azure.BackupBucketInventory)(unsafe.Pointer(in.Inventory))
+	out.RequireInfrastructureEncryption = (*bool)(unsafe.Pointer(in.RequireInfrastructureEncryption))
+	out.MinimumTLSVersion = (*azure.MinimumTLSVersion)(unsafe.Pointer(in.MinimumTLSVersion))
+	out.Lifecycle = (*azure.BackupBucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	out.LegalHold = (*azure.BackupBucketLegalHold)(unsafe.Pointer(in.LegalHold))
 	return nil
 }
 
@@ -460,6 +947,15 @@ func Convert_v1alpha1_BackupBucketConfig_To_azure_BackupBucketConfig(in *BackupB
 
 func autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
 	out.CloudConfiguration = (*CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+	out.ResourceGroupRegion = (*string)(unsafe.Pointer(in.ResourceGroupRegion))
+	out.CredentialsSecretRef = (*v1.SecretReference)(unsafe.Pointer(in.CredentialsSecretRef))
+	out.NetworkACLs = (*BackupBucketNetworkACLs)(unsafe.Pointer(in.NetworkACLs))
+	out.Rotation = (*RotationConfig)(unsafe.Pointer(in.Rotation))
+	out.Inventory = (*BackupBucketInventory)(unsafe.Pointer(in.Inventory))
+	out.RequireInfrastructureEncryption = (*bool)(unsafe.Pointer(in.RequireInfrastructureEncryption))
+	out.MinimumTLSVersion = (*MinimumTLSVersion)(unsafe.Pointer(in.MinimumTLSVersion))
+	out.Lifecycle = (*BackupBucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	out.LegalHold = (*BackupBucketLegalHold)(unsafe.Pointer(in.LegalHold))
 	return nil
 }
 
@@ -468,8 +964,168 @@ func Convert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *azure.B
 	return autoConvert_azure_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_BackupBucketInventory_To_azure_BackupBucketInventory(in *BackupBucketInventory, out *azure.BackupBucketInventory, s conversion.Scope) error {
+	out.Container = (*string)(unsafe.Pointer(in.Container))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketInventory_To_azure_BackupBucketInventory is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketInventory_To_azure_BackupBucketInventory(in *BackupBucketInventory, out *azure.BackupBucketInventory, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketInventory_To_azure_BackupBucketInventory(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketInventory_To_v1alpha1_BackupBucketInventory(in *azure.BackupBucketInventory, out *BackupBucketInventory, s conversion.Scope) error {
+	out.Container = (*string)(unsafe.Pointer(in.Container))
+	return nil
+}
+
+// Convert_azure_BackupBucketInventory_To_v1alpha1_BackupBucketInventory is an autogenerated conversion function.
+func Convert_azure_BackupBucketInventory_To_v1alpha1_BackupBucketInventory(in *azure.BackupBucketInventory, out *BackupBucketInventory, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketInventory_To_v1alpha1_BackupBucketInventory(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketLegalHold_To_azure_BackupBucketLegalHold(in *BackupBucketLegalHold, out *azure.BackupBucketLegalHold, s conversion.Scope) error {
+	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketLegalHold_To_azure_BackupBucketLegalHold is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketLegalHold_To_azure_BackupBucketLegalHold(in *BackupBucketLegalHold, out *azure.BackupBucketLegalHold, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketLegalHold_To_azure_BackupBucketLegalHold(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketLegalHold_To_v1alpha1_BackupBucketLegalHold(in *azure.BackupBucketLegalHold, out *BackupBucketLegalHold, s conversion.Scope) error {
+	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
+	return nil
+}
+
+// Convert_azure_BackupBucketLegalHold_To_v1alpha1_BackupBucketLegalHold is an autogenerated conversion function.
+func Convert_azure_BackupBucketLegalHold_To_v1alpha1_BackupBucketLegalHold(in *azure.BackupBucketLegalHold, out *BackupBucketLegalHold, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketLegalHold_To_v1alpha1_BackupBucketLegalHold(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketLifecycle_To_azure_BackupBucketLifecycle(in *BackupBucketLifecycle, out *azure.BackupBucketLifecycle, s conversion.Scope) error {
+	out.TierToColdAfterDays = (*int32)(unsafe.Pointer(in.TierToColdAfterDays))
+	out.DeleteAfterDays = (*int32)(unsafe.Pointer(in.DeleteAfterDays))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketLifecycle_To_azure_BackupBucketLifecycle is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketLifecycle_To_azure_BackupBucketLifecycle(in *BackupBucketLifecycle, out *azure.BackupBucketLifecycle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketLifecycle_To_azure_BackupBucketLifecycle(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle(in *azure.BackupBucketLifecycle, out *BackupBucketLifecycle, s conversion.Scope) error {
+	out.TierToColdAfterDays = (*int32)(unsafe.Pointer(in.TierToColdAfterDays))
+	out.DeleteAfterDays = (*int32)(unsafe.Pointer(in.DeleteAfterDays))
+	return nil
+}
+
+// Convert_azure_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle is an autogenerated conversion function.
+func Convert_azure_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle(in *azure.BackupBucketLifecycle, out *BackupBucketLifecycle, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketNetworkACLs_To_azure_BackupBucketNetworkACLs(in *BackupBucketNetworkACLs, out *azure.BackupBucketNetworkACLs, s conversion.Scope) error {
+	out.DefaultAction = azure.NetworkACLDefaultAction(in.DefaultAction)
+	out.IPRules = *(*[]string)(unsafe.Pointer(&in.IPRules))
+	out.VirtualNetworkRules = *(*[]string)(unsafe.Pointer(&in.VirtualNetworkRules))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketNetworkACLs_To_azure_BackupBucketNetworkACLs is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketNetworkACLs_To_azure_BackupBucketNetworkACLs(in *BackupBucketNetworkACLs, out *azure.BackupBucketNetworkACLs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketNetworkACLs_To_azure_BackupBucketNetworkACLs(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketNetworkACLs_To_v1alpha1_BackupBucketNetworkACLs(in *azure.BackupBucketNetworkACLs, out *BackupBucketNetworkACLs, s conversion.Scope) error {
+	out.DefaultAction = NetworkACLDefaultAction(in.DefaultAction)
+	out.IPRules = *(*[]string)(unsafe.Pointer(&in.IPRules))
+	out.VirtualNetworkRules = *(*[]string)(unsafe.Pointer(&in.VirtualNetworkRules))
+	return nil
+}
+
+// Convert_azure_BackupBucketNetworkACLs_To_v1alpha1_BackupBucketNetworkACLs is an autogenerated conversion function.
+func Convert_azure_BackupBucketNetworkACLs_To_v1alpha1_BackupBucketNetworkACLs(in *azure.BackupBucketNetworkACLs, out *BackupBucketNetworkACLs, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketNetworkACLs_To_v1alpha1_BackupBucketNetworkACLs(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus(in *BackupBucketStatus, out *azure.BackupBucketStatus, s conversion.Scope) error {
+	out.LegalHoldTags = *(*[]string)(unsafe.Pointer(&in.LegalHoldTags))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus(in *BackupBucketStatus, out *azure.BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketStatus_To_azure_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *azure.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	out.LegalHoldTags = *(*[]string)(unsafe.Pointer(&in.LegalHoldTags))
+	return nil
+}
+
+// Convert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus is an autogenerated conversion function.
+func Convert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *azure.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_azure_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BootDiagnosticsStatus_To_azure_BootDiagnosticsStatus(in *BootDiagnosticsStatus, out *azure.BootDiagnosticsStatus, s conversion.Scope) error {
+	out.StorageAccountName = in.StorageAccountName
+	out.StorageURI = in.StorageURI
+	out.Region = in.Region
+	out.ZoneRedundant = in.ZoneRedundant
+	return nil
+}
+
+// Convert_v1alpha1_BootDiagnosticsStatus_To_azure_BootDiagnosticsStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BootDiagnosticsStatus_To_azure_BootDiagnosticsStatus(in *BootDiagnosticsStatus, out *azure.BootDiagnosticsStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BootDiagnosticsStatus_To_azure_BootDiagnosticsStatus(in, out, s)
+}
+
+func autoConvert_azure_BootDiagnosticsStatus_To_v1alpha1_BootDiagnosticsStatus(in *azure.BootDiagnosticsStatus, out *BootDiagnosticsStatus, s conversion.Scope) error {
+	out.StorageAccountName = in.StorageAccountName
+	out.StorageURI = in.StorageURI
+	out.Region = in.Region
+	out.ZoneRedundant = in.ZoneRedundant
+	return nil
+}
+
+// Convert_azure_BootDiagnosticsStatus_To_v1alpha1_BootDiagnosticsStatus is an autogenerated conversion function.
+func Convert_azure_BootDiagnosticsStatus_To_v1alpha1_BootDiagnosticsStatus(in *azure.BootDiagnosticsStatus, out *BootDiagnosticsStatus, s conversion.Scope) error {
+	return autoConvert_azure_BootDiagnosticsStatus_To_v1alpha1_BootDiagnosticsStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSIDriverControllerConfig_To_azure_CSIDriverControllerConfig(in *CSIDriverControllerConfig, out *azure.CSIDriverControllerConfig, s conversion.Scope) error {
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.LeaderElection = (*azure.LeaderElectionConfig)(unsafe.Pointer(in.LeaderElection))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	return nil
+}
+
+// Convert_v1alpha1_CSIDriverControllerConfig_To_azure_CSIDriverControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSIDriverControllerConfig_To_azure_CSIDriverControllerConfig(in *CSIDriverControllerConfig, out *azure.CSIDriverControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIDriverControllerConfig_To_azure_CSIDriverControllerConfig(in, out, s)
+}
+
+func autoConvert_azure_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig(in *azure.CSIDriverControllerConfig, out *CSIDriverControllerConfig, s conversion.Scope) error {
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.LeaderElection = (*LeaderElectionConfig)(unsafe.Pointer(in.LeaderElection))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
+	return nil
+}
+
+// Convert_azure_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig is an autogenerated conversion function.
+func Convert_azure_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig(in *azure.CSIDriverControllerConfig, out *CSIDriverControllerConfig, s conversion.Scope) error {
+	return autoConvert_azure_CSIDriverControllerConfig_To_v1alpha1_CSIDriverControllerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration(in *CloudConfiguration, out *azure.CloudConfiguration, s conversion.Scope) error {
 	out.Name = in.Name
+	out.ActiveDirectoryAuthorityHost = (*string)(unsafe.Pointer(in.ActiveDirectoryAuthorityHost))
+	out.ResourceManagerEndpoint = (*string)(unsafe.Pointer(in.ResourceManagerEndpoint))
+	out.ResourceManagerAudience = (*string)(unsafe.Pointer(in.ResourceManagerAudience))
+	out.StorageEndpointSuffix = (*string)(unsafe.Pointer(in.StorageEndpointSuffix))
 	return nil
 }
 
@@ -480,6 +1136,10 @@ func Convert_v1alpha1_CloudConfiguration_To_azure_CloudConfiguration(in *CloudCo
 
 func autoConvert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration(in *azure.CloudConfiguration, out *CloudConfiguration, s conversion.Scope) error {
 	out.Name = in.Name
+	out.ActiveDirectoryAuthorityHost = (*string)(unsafe.Pointer(in.ActiveDirectoryAuthorityHost))
+	out.ResourceManagerEndpoint = (*string)(unsafe.Pointer(in.ResourceManagerEndpoint))
+	out.ResourceManagerAudience = (*string)(unsafe.Pointer(in.ResourceManagerAudience))
+	out.StorageEndpointSuffix = (*string)(unsafe.Pointer(in.StorageEndpointSuffix))
 	return nil
 }
 
@@ -490,6 +1150,10 @@ func Convert_azure_CloudConfiguration_To_v1alpha1_CloudConfiguration(in *azure.C
 
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.LoadBalancer = (*azure.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.LeaderElection = (*azure.LeaderElectionConfig)(unsafe.Pointer(in.LeaderElection))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -500,6 +1164,10 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManag
 
 func autoConvert_azure_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *azure.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.LeaderElection = (*LeaderElectionConfig)(unsafe.Pointer(in.LeaderElection))
+	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	return nil
 }
 
@@ -538,7 +1206,9 @@ func Convert_azure_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *azure.C
 
 func autoConvert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *ControlPlaneConfig, out *azure.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*azure.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CSIDriverController = (*azure.CSIDriverControllerConfig)(unsafe.Pointer(in.CSIDriverController))
 	out.Storage = (*azure.Storage)(unsafe.Pointer(in.Storage))
+	out.Remedy = (*azure.RemedyConfig)(unsafe.Pointer(in.Remedy))
 	return nil
 }
 
@@ -549,7 +1219,9 @@ func Convert_v1alpha1_ControlPlaneConfig_To_azure_ControlPlaneConfig(in *Control
 
 func autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.CSIDriverController = (*CSIDriverControllerConfig)(unsafe.Pointer(in.CSIDriverController))
 	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.Remedy = (*RemedyConfig)(unsafe.Pointer(in.Remedy))
 	return nil
 }
 
@@ -558,9 +1230,38 @@ func Convert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.C
 	return autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig(in *DNSRecordConfig, out *azure.DNSRecordConfig, s conversion.Scope) error {
+	out.SubscriptionID = (*string)(unsafe.Pointer(in.SubscriptionID))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.CredentialsRef = (*string)(unsafe.Pointer(in.CredentialsRef))
+	out.AliasTarget = (*string)(unsafe.Pointer(in.AliasTarget))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig(in *DNSRecordConfig, out *azure.DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordConfig_To_azure_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *azure.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	out.SubscriptionID = (*string)(unsafe.Pointer(in.SubscriptionID))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.CredentialsRef = (*string)(unsafe.Pointer(in.CredentialsRef))
+	out.AliasTarget = (*string)(unsafe.Pointer(in.AliasTarget))
+	return nil
+}
+
+// Convert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig is an autogenerated conversion function.
+func Convert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *azure.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_azure_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_DataVolume_To_azure_DataVolume(in *DataVolume, out *azure.DataVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ImageRef = (*azure.Image)(unsafe.Pointer(in.ImageRef))
+	out.SourceResourceID = (*string)(unsafe.Pointer(in.SourceResourceID))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
 	return nil
 }
 
@@ -572,6 +1273,9 @@ func Convert_v1alpha1_DataVolume_To_azure_DataVolume(in *DataVolume, out *azure.
 func autoConvert_azure_DataVolume_To_v1alpha1_DataVolume(in *azure.DataVolume, out *DataVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ImageRef = (*Image)(unsafe.Pointer(in.ImageRef))
+	out.SourceResourceID = (*string)(unsafe.Pointer(in.SourceResourceID))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
 	return nil
 }
 
@@ -624,10 +1328,169 @@ func Convert_azure_DomainCount_To_v1alpha1_DomainCount(in *azure.DomainCount, ou
 	return autoConvert_azure_DomainCount_To_v1alpha1_DomainCount(in, out, s)
 }
 
+func autoConvert_v1alpha1_EgressCIDRsHistoryEntry_To_azure_EgressCIDRsHistoryEntry(in *EgressCIDRsHistoryEntry, out *azure.EgressCIDRsHistoryEntry, s conversion.Scope) error {
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	out.Timestamp = in.Timestamp
+	return nil
+}
+
+// Convert_v1alpha1_EgressCIDRsHistoryEntry_To_azure_EgressCIDRsHistoryEntry is an autogenerated conversion function.
+func Convert_v1alpha1_EgressCIDRsHistoryEntry_To_azure_EgressCIDRsHistoryEntry(in *EgressCIDRsHistoryEntry, out *azure.EgressCIDRsHistoryEntry, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressCIDRsHistoryEntry_To_azure_EgressCIDRsHistoryEntry(in, out, s)
+}
+
+func autoConvert_azure_EgressCIDRsHistoryEntry_To_v1alpha1_EgressCIDRsHistoryEntry(in *azure.EgressCIDRsHistoryEntry, out *EgressCIDRsHistoryEntry, s conversion.Scope) error {
+	out.CIDRs = *(*[]string)(unsafe.Pointer(&in.CIDRs))
+	out.Timestamp = in.Timestamp
+	return nil
+}
+
+// Convert_azure_EgressCIDRsHistoryEntry_To_v1alpha1_EgressCIDRsHistoryEntry is an autogenerated conversion function.
+func Convert_azure_EgressCIDRsHistoryEntry_To_v1alpha1_EgressCIDRsHistoryEntry(in *azure.EgressCIDRsHistoryEntry, out *EgressCIDRsHistoryEntry, s conversion.Scope) error {
+	return autoConvert_azure_EgressCIDRsHistoryEntry_To_v1alpha1_EgressCIDRsHistoryEntry(in, out, s)
+}
+
+func autoConvert_v1alpha1_EgressFirewallConfig_To_azure_EgressFirewallConfig(in *EgressFirewallConfig, out *azure.EgressFirewallConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.ManagementCIDR = in.ManagementCIDR
+	out.PolicyID = (*string)(unsafe.Pointer(in.PolicyID))
+	return nil
+}
+
+// Convert_v1alpha1_EgressFirewallConfig_To_azure_EgressFirewallConfig is an autogenerated conversion function.
+func Convert_v1alpha1_EgressFirewallConfig_To_azure_EgressFirewallConfig(in *EgressFirewallConfig, out *azure.EgressFirewallConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressFirewallConfig_To_azure_EgressFirewallConfig(in, out, s)
+}
+
+func autoConvert_azure_EgressFirewallConfig_To_v1alpha1_EgressFirewallConfig(in *azure.EgressFirewallConfig, out *EgressFirewallConfig, s conversion.Scope) error {
+	out.CIDR = in.CIDR
+	out.ManagementCIDR = in.ManagementCIDR
+	out.PolicyID = (*string)(unsafe.Pointer(in.PolicyID))
+	return nil
+}
+
+// Convert_azure_EgressFirewallConfig_To_v1alpha1_EgressFirewallConfig is an autogenerated conversion function.
+func Convert_azure_EgressFirewallConfig_To_v1alpha1_EgressFirewallConfig(in *azure.EgressFirewallConfig, out *EgressFirewallConfig, s conversion.Scope) error {
+	return autoConvert_azure_EgressFirewallConfig_To_v1alpha1_EgressFirewallConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_EgressFirewallStatus_To_azure_EgressFirewallStatus(in *EgressFirewallStatus, out *azure.EgressFirewallStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = in.ID
+	out.PrivateIPAddress = in.PrivateIPAddress
+	if err := Convert_v1alpha1_PublicIPAddressStatus_To_azure_PublicIPAddressStatus(&in.PublicIPAddress, &out.PublicIPAddress, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_EgressFirewallStatus_To_azure_EgressFirewallStatus is an autogenerated conversion function.
+func Convert_v1alpha1_EgressFirewallStatus_To_azure_EgressFirewallStatus(in *EgressFirewallStatus, out *azure.EgressFirewallStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressFirewallStatus_To_azure_EgressFirewallStatus(in, out, s)
+}
+
+func autoConvert_azure_EgressFirewallStatus_To_v1alpha1_EgressFirewallStatus(in *azure.EgressFirewallStatus, out *EgressFirewallStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = in.ID
+	out.PrivateIPAddress = in.PrivateIPAddress
+	if err := Convert_azure_PublicIPAddressStatus_To_v1alpha1_PublicIPAddressStatus(&in.PublicIPAddress, &out.PublicIPAddress, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_azure_EgressFirewallStatus_To_v1alpha1_EgressFirewallStatus is an autogenerated conversion function.
+func Convert_azure_EgressFirewallStatus_To_v1alpha1_EgressFirewallStatus(in *azure.EgressFirewallStatus, out *EgressFirewallStatus, s conversion.Scope) error {
+	return autoConvert_azure_EgressFirewallStatus_To_v1alpha1_EgressFirewallStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_FailedVMRemedyConfig_To_azure_FailedVMRemedyConfig(in *FailedVMRemedyConfig, out *azure.FailedVMRemedyConfig, s conversion.Scope) error {
+	out.RequeueInterval = (*metav1.Duration)(unsafe.Pointer(in.RequeueInterval))
+	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.MaxGetAttempts = (*int32)(unsafe.Pointer(in.MaxGetAttempts))
+	out.MaxReapplyAttempts = (*int32)(unsafe.Pointer(in.MaxReapplyAttempts))
+	return nil
+}
+
+// Convert_v1alpha1_FailedVMRemedyConfig_To_azure_FailedVMRemedyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FailedVMRemedyConfig_To_azure_FailedVMRemedyConfig(in *FailedVMRemedyConfig, out *azure.FailedVMRemedyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailedVMRemedyConfig_To_azure_FailedVMRemedyConfig(in, out, s)
+}
+
+func autoConvert_azure_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig(in *azure.FailedVMRemedyConfig, out *FailedVMRemedyConfig, s conversion.Scope) error {
+	out.RequeueInterval = (*metav1.Duration)(unsafe.Pointer(in.RequeueInterval))
+	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.MaxGetAttempts = (*int32)(unsafe.Pointer(in.MaxGetAttempts))
+	out.MaxReapplyAttempts = (*int32)(unsafe.Pointer(in.MaxReapplyAttempts))
+	return nil
+}
+
+// Convert_azure_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig is an autogenerated conversion function.
+func Convert_azure_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig(in *azure.FailedVMRemedyConfig, out *FailedVMRemedyConfig, s conversion.Scope) error {
+	return autoConvert_azure_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig(in *FlowLogsConfig, out *azure.FlowLogsConfig, s conversion.Scope) error {
+	out.StorageAccountID = in.StorageAccountID
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	out.TrafficAnalytics = (*azure.TrafficAnalyticsConfig)(unsafe.Pointer(in.TrafficAnalytics))
+	return nil
+}
+
+// Convert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig(in *FlowLogsConfig, out *azure.FlowLogsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlowLogsConfig_To_azure_FlowLogsConfig(in, out, s)
+}
+
+func autoConvert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig(in *azure.FlowLogsConfig, out *FlowLogsConfig, s conversion.Scope) error {
+	out.StorageAccountID = in.StorageAccountID
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	out.TrafficAnalytics = (*TrafficAnalyticsConfig)(unsafe.Pointer(in.TrafficAnalytics))
+	return nil
+}
+
+// Convert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig is an autogenerated conversion function.
+func Convert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig(in *azure.FlowLogsConfig, out *FlowLogsConfig, s conversion.Scope) error {
+	return autoConvert_azure_FlowLogsConfig_To_v1alpha1_FlowLogsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FlowStepState_To_azure_FlowStepState(in *FlowStepState, out *azure.FlowStepState, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastSucceededGeneration = (*int64)(unsafe.Pointer(in.LastSucceededGeneration))
+	out.LastSucceededTime = (*metav1.Time)(unsafe.Pointer(in.LastSucceededTime))
+	out.LastFailedTime = (*metav1.Time)(unsafe.Pointer(in.LastFailedTime))
+	out.LastError = (*string)(unsafe.Pointer(in.LastError))
+	out.ConsecutiveFailures = in.ConsecutiveFailures
+	return nil
+}
+
+// Convert_v1alpha1_FlowStepState_To_azure_FlowStepState is an autogenerated conversion function.
+func Convert_v1alpha1_FlowStepState_To_azure_FlowStepState(in *FlowStepState, out *azure.FlowStepState, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlowStepState_To_azure_FlowStepState(in, out, s)
+}
+
+func autoConvert_azure_FlowStepState_To_v1alpha1_FlowStepState(in *azure.FlowStepState, out *FlowStepState, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastSucceededGeneration = (*int64)(unsafe.Pointer(in.LastSucceededGeneration))
+	out.LastSucceededTime = (*metav1.Time)(unsafe.Pointer(in.LastSucceededTime))
+	out.LastFailedTime = (*metav1.Time)(unsafe.Pointer(in.LastFailedTime))
+	out.LastError = (*string)(unsafe.Pointer(in.LastError))
+	out.ConsecutiveFailures = in.ConsecutiveFailures
+	return nil
+}
+
+// Convert_azure_FlowStepState_To_v1alpha1_FlowStepState is an autogenerated conversion function.
+func Convert_azure_FlowStepState_To_v1alpha1_FlowStepState(in *azure.FlowStepState, out *FlowStepState, s conversion.Scope) error {
+	return autoConvert_azure_FlowStepState_To_v1alpha1_FlowStepState(in, out, s)
+}
+
 func autoConvert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in *IdentityConfig, out *azure.IdentityConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ResourceGroup = in.ResourceGroup
 	out.ACRAccess = (*bool)(unsafe.Pointer(in.ACRAccess))
+	out.ACRAccessMode = (*azure.ACRAccessMode)(unsafe.Pointer(in.ACRAccessMode))
+	out.SubscriptionID = (*string)(unsafe.Pointer(in.SubscriptionID))
+	out.TenantID = (*string)(unsafe.Pointer(in.TenantID))
 	return nil
 }
 
@@ -640,6 +1503,9 @@ func autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.Ident
 	out.Name = in.Name
 	out.ResourceGroup = in.ResourceGroup
 	out.ACRAccess = (*bool)(unsafe.Pointer(in.ACRAccess))
+	out.ACRAccessMode = (*ACRAccessMode)(unsafe.Pointer(in.ACRAccessMode))
+	out.SubscriptionID = (*string)(unsafe.Pointer(in.SubscriptionID))
+	out.TenantID = (*string)(unsafe.Pointer(in.TenantID))
 	return nil
 }
 
@@ -652,6 +1518,7 @@ func autoConvert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in *IdentitySta
 	out.ID = in.ID
 	out.ClientID = in.ClientID
 	out.ACRAccess = in.ACRAccess
+	out.ACRAccessMode = (*azure.ACRAccessMode)(unsafe.Pointer(in.ACRAccessMode))
 	return nil
 }
 
@@ -664,6 +1531,7 @@ func autoConvert_azure_IdentityStatus_To_v1alpha1_IdentityStatus(in *azure.Ident
 	out.ID = in.ID
 	out.ClientID = in.ClientID
 	out.ACRAccess = in.ACRAccess
+	out.ACRAccessMode = (*ACRAccessMode)(unsafe.Pointer(in.ACRAccessMode))
 	return nil
 }
 
@@ -705,6 +1573,9 @@ func autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in
 	}
 	out.Identity = (*azure.IdentityConfig)(unsafe.Pointer(in.Identity))
 	out.Zoned = in.Zoned
+	out.AuxiliaryResources = (*azure.AuxiliaryResourcesConfig)(unsafe.Pointer(in.AuxiliaryResources))
+	out.CredentialsRef = (*string)(unsafe.Pointer(in.CredentialsRef))
+	out.Observability = (*azure.ObservabilityConfig)(unsafe.Pointer(in.Observability))
 	return nil
 }
 
@@ -720,6 +1591,9 @@ func autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in
 	}
 	out.Identity = (*IdentityConfig)(unsafe.Pointer(in.Identity))
 	out.Zoned = in.Zoned
+	out.AuxiliaryResources = (*AuxiliaryResourcesConfig)(unsafe.Pointer(in.AuxiliaryResources))
+	out.CredentialsRef = (*string)(unsafe.Pointer(in.CredentialsRef))
+	out.Observability = (*ObservabilityConfig)(unsafe.Pointer(in.Observability))
 	return nil
 }
 
@@ -731,6 +1605,7 @@ func Convert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *azu
 func autoConvert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in *InfrastructureState, out *azure.InfrastructureState, s conversion.Scope) error {
 	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
 	out.ManagedItems = *(*[]azure.AzureResource)(unsafe.Pointer(&in.ManagedItems))
+	out.Steps = *(*[]azure.FlowStepState)(unsafe.Pointer(&in.Steps))
 	return nil
 }
 
@@ -742,6 +1617,7 @@ func Convert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in *Infra
 func autoConvert_azure_InfrastructureState_To_v1alpha1_InfrastructureState(in *azure.InfrastructureState, out *InfrastructureState, s conversion.Scope) error {
 	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
 	out.ManagedItems = *(*[]AzureResource)(unsafe.Pointer(&in.ManagedItems))
+	out.Steps = *(*[]FlowStepState)(unsafe.Pointer(&in.Steps))
 	return nil
 }
 
@@ -759,10 +1635,14 @@ func autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in
 	}
 	out.AvailabilitySets = *(*[]azure.AvailabilitySet)(unsafe.Pointer(&in.AvailabilitySets))
 	out.MigratingToVMO = in.MigratingToVMO
+	out.AvailabilitySetMigration = (*azure.AvailabilitySetMigrationStatus)(unsafe.Pointer(in.AvailabilitySetMigration))
 	out.RouteTables = *(*[]azure.RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]azure.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
 	out.Identity = (*azure.IdentityStatus)(unsafe.Pointer(in.Identity))
 	out.Zoned = in.Zoned
+	out.BootDiagnostics = (*azure.BootDiagnosticsStatus)(unsafe.Pointer(in.BootDiagnostics))
+	out.EgressCIDRsHistory = *(*[]azure.EgressCIDRsHistoryEntry)(unsafe.Pointer(&in.EgressCIDRsHistory))
+	out.ZoneMappings = *(*[]azure.ZoneMapping)(unsafe.Pointer(&in.ZoneMappings))
 	return nil
 }
 
@@ -780,10 +1660,14 @@ func autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	}
 	out.AvailabilitySets = *(*[]AvailabilitySet)(unsafe.Pointer(&in.AvailabilitySets))
 	out.MigratingToVMO = in.MigratingToVMO
+	out.AvailabilitySetMigration = (*AvailabilitySetMigrationStatus)(unsafe.Pointer(in.AvailabilitySetMigration))
 	out.RouteTables = *(*[]RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
 	out.Identity = (*IdentityStatus)(unsafe.Pointer(in.Identity))
 	out.Zoned = in.Zoned
+	out.BootDiagnostics = (*BootDiagnosticsStatus)(unsafe.Pointer(in.BootDiagnostics))
+	out.EgressCIDRsHistory = *(*[]EgressCIDRsHistoryEntry)(unsafe.Pointer(&in.EgressCIDRsHistory))
+	out.ZoneMappings = *(*[]ZoneMapping)(unsafe.Pointer(&in.ZoneMappings))
 	return nil
 }
 
@@ -792,12 +1676,104 @@ func Convert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *azu
 	return autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_KubeletConfig_To_azure_KubeletConfig(in *KubeletConfig, out *azure.KubeletConfig, s conversion.Scope) error {
+	out.NodeStatusUpdateFrequency = (*metav1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.NodeStatusReportFrequency = (*metav1.Duration)(unsafe.Pointer(in.NodeStatusReportFrequency))
+	return nil
+}
+
+// Convert_v1alpha1_KubeletConfig_To_azure_KubeletConfig is an autogenerated conversion function.
+func Convert_v1alpha1_KubeletConfig_To_azure_KubeletConfig(in *KubeletConfig, out *azure.KubeletConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KubeletConfig_To_azure_KubeletConfig(in, out, s)
+}
+
+func autoConvert_azure_KubeletConfig_To_v1alpha1_KubeletConfig(in *azure.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
+	out.NodeStatusUpdateFrequency = (*metav1.Duration)(unsafe.Pointer(in.NodeStatusUpdateFrequency))
+	out.NodeStatusReportFrequency = (*metav1.Duration)(unsafe.Pointer(in.NodeStatusReportFrequency))
+	return nil
+}
+
+// Convert_azure_KubeletConfig_To_v1alpha1_KubeletConfig is an autogenerated conversion function.
+func Convert_azure_KubeletConfig_To_v1alpha1_KubeletConfig(in *azure.KubeletConfig, out *KubeletConfig, s conversion.Scope) error {
+	return autoConvert_azure_KubeletConfig_To_v1alpha1_KubeletConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LeaderElectionConfig_To_azure_LeaderElectionConfig(in *LeaderElectionConfig, out *azure.LeaderElectionConfig, s conversion.Scope) error {
+	out.LeaseDuration = (*metav1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.RenewDeadline = (*metav1.Duration)(unsafe.Pointer(in.RenewDeadline))
+	out.RetryPeriod = (*metav1.Duration)(unsafe.Pointer(in.RetryPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_LeaderElectionConfig_To_azure_LeaderElectionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LeaderElectionConfig_To_azure_LeaderElectionConfig(in *LeaderElectionConfig, out *azure.LeaderElectionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LeaderElectionConfig_To_azure_LeaderElectionConfig(in, out, s)
+}
+
+func autoConvert_azure_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in *azure.LeaderElectionConfig, out *LeaderElectionConfig, s conversion.Scope) error {
+	out.LeaseDuration = (*metav1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.RenewDeadline = (*metav1.Duration)(unsafe.Pointer(in.RenewDeadline))
+	out.RetryPeriod = (*metav1.Duration)(unsafe.Pointer(in.RetryPeriod))
+	return nil
+}
+
+// Convert_azure_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig is an autogenerated conversion function.
+func Convert_azure_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in *azure.LeaderElectionConfig, out *LeaderElectionConfig, s conversion.Scope) error {
+	return autoConvert_azure_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in *LoadBalancerConfig, out *azure.LoadBalancerConfig, s conversion.Scope) error {
+	out.SKU = (*azure.LoadBalancerSKU)(unsafe.Pointer(in.SKU))
+	out.OutboundRule = (*azure.OutboundRuleConfig)(unsafe.Pointer(in.OutboundRule))
+	out.DisableOutboundSNAT = (*bool)(unsafe.Pointer(in.DisableOutboundSNAT))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in *LoadBalancerConfig, out *azure.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_azure_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *azure.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.SKU = (*LoadBalancerSKU)(unsafe.Pointer(in.SKU))
+	out.OutboundRule = (*OutboundRuleConfig)(unsafe.Pointer(in.OutboundRule))
+	out.DisableOutboundSNAT = (*bool)(unsafe.Pointer(in.DisableOutboundSNAT))
+	return nil
+}
+
+// Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *azure.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_azure_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_LocalDisks_To_azure_LocalDisks(in *LocalDisks, out *azure.LocalDisks, s conversion.Scope) error {
+	out.EphemeralStorage = in.EphemeralStorage
+	return nil
+}
+
+// Convert_v1alpha1_LocalDisks_To_azure_LocalDisks is an autogenerated conversion function.
+func Convert_v1alpha1_LocalDisks_To_azure_LocalDisks(in *LocalDisks, out *azure.LocalDisks, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LocalDisks_To_azure_LocalDisks(in, out, s)
+}
+
+func autoConvert_azure_LocalDisks_To_v1alpha1_LocalDisks(in *azure.LocalDisks, out *LocalDisks, s conversion.Scope) error {
+	out.EphemeralStorage = in.EphemeralStorage
+	return nil
+}
+
+// Convert_azure_LocalDisks_To_v1alpha1_LocalDisks is an autogenerated conversion function.
+func Convert_azure_LocalDisks_To_v1alpha1_LocalDisks(in *azure.LocalDisks, out *LocalDisks, s conversion.Scope) error {
+	return autoConvert_azure_LocalDisks_To_v1alpha1_LocalDisks(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_azure_MachineImage(in *MachineImage, out *azure.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.NVMe = (*bool)(unsafe.Pointer(in.NVMe))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
+	out.MinimumOSDiskSizeGB = (*int32)(unsafe.Pointer(in.MinimumOSDiskSizeGB))
 	if err := Convert_v1alpha1_Image_To_azure_Image(&in.Image, &out.Image, s); err != nil {
 		return err
 	}
@@ -813,8 +1789,10 @@ func autoConvert_azure_MachineImage_To_v1alpha1_MachineImage(in *azure.MachineIm
 	out.Name = in.Name
 	out.Version = in.Version
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.NVMe = (*bool)(unsafe.Pointer(in.NVMe))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	out.SkipMarketplaceAgreement = (*bool)(unsafe.Pointer(in.SkipMarketplaceAgreement))
+	out.MinimumOSDiskSizeGB = (*int32)(unsafe.Pointer(in.MinimumOSDiskSizeGB))
 	if err := Convert_azure_Image_To_v1alpha1_Image(&in.Image, &out.Image, s); err != nil {
 		return err
 	}
@@ -834,7 +1812,9 @@ func autoConvert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in *M
 	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
 	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.NVMe = (*bool)(unsafe.Pointer(in.NVMe))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.MinimumOSDiskSizeGB = (*int32)(unsafe.Pointer(in.MinimumOSDiskSizeGB))
 	return nil
 }
 
@@ -851,7 +1831,9 @@ func autoConvert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *a
 	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
 	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.NVMe = (*bool)(unsafe.Pointer(in.NVMe))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.MinimumOSDiskSizeGB = (*int32)(unsafe.Pointer(in.MinimumOSDiskSizeGB))
 	return nil
 }
 
@@ -885,6 +1867,9 @@ func Convert_azure_MachineImages_To_v1alpha1_MachineImages(in *azure.MachineImag
 func autoConvert_v1alpha1_MachineType_To_azure_MachineType(in *MachineType, out *azure.MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.DiskControllerType = (*azure.DiskControllerType)(unsafe.Pointer(in.DiskControllerType))
+	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.AttachLimits = *(*map[string]int32)(unsafe.Pointer(&in.AttachLimits))
 	return nil
 }
 
@@ -896,6 +1881,9 @@ func Convert_v1alpha1_MachineType_To_azure_MachineType(in *MachineType, out *azu
 func autoConvert_azure_MachineType_To_v1alpha1_MachineType(in *azure.MachineType, out *MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.DiskControllerType = (*DiskControllerType)(unsafe.Pointer(in.DiskControllerType))
+	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.AttachLimits = *(*map[string]int32)(unsafe.Pointer(&in.AttachLimits))
 	return nil
 }
 
@@ -909,6 +1897,9 @@ func autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGate
 	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
 	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
 	out.IPAddresses = *(*[]azure.PublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	out.DDoSProtection = (*azure.PublicIPDDoSProtection)(unsafe.Pointer(in.DDoSProtection))
+	out.DNSSettings = (*azure.PublicIPDNSSettings)(unsafe.Pointer(in.DNSSettings))
+	out.AutoScaleIPs = (*azure.NatGatewayIPAutoScaling)(unsafe.Pointer(in.AutoScaleIPs))
 	return nil
 }
 
@@ -922,6 +1913,9 @@ func autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.N
 	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
 	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
 	out.IPAddresses = *(*[]PublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	out.DDoSProtection = (*PublicIPDDoSProtection)(unsafe.Pointer(in.DDoSProtection))
+	out.DNSSettings = (*PublicIPDNSSettings)(unsafe.Pointer(in.DNSSettings))
+	out.AutoScaleIPs = (*NatGatewayIPAutoScaling)(unsafe.Pointer(in.AutoScaleIPs))
 	return nil
 }
 
@@ -930,6 +1924,76 @@ func Convert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *azure.NatGa
 	return autoConvert_azure_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_NatGatewayIPAutoScaling_To_azure_NatGatewayIPAutoScaling(in *NatGatewayIPAutoScaling, out *azure.NatGatewayIPAutoScaling, s conversion.Scope) error {
+	out.NodesPerIP = in.NodesPerIP
+	out.MaxIPCount = in.MaxIPCount
+	return nil
+}
+
+// Convert_v1alpha1_NatGatewayIPAutoScaling_To_azure_NatGatewayIPAutoScaling is an autogenerated conversion function.
+func Convert_v1alpha1_NatGatewayIPAutoScaling_To_azure_NatGatewayIPAutoScaling(in *NatGatewayIPAutoScaling, out *azure.NatGatewayIPAutoScaling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatGatewayIPAutoScaling_To_azure_NatGatewayIPAutoScaling(in, out, s)
+}
+
+func autoConvert_azure_NatGatewayIPAutoScaling_To_v1alpha1_NatGatewayIPAutoScaling(in *azure.NatGatewayIPAutoScaling, out *NatGatewayIPAutoScaling, s conversion.Scope) error {
+	out.NodesPerIP = in.NodesPerIP
+	out.MaxIPCount = in.MaxIPCount
+	return nil
+}
+
+// Convert_azure_NatGatewayIPAutoScaling_To_v1alpha1_NatGatewayIPAutoScaling is an autogenerated conversion function.
+func Convert_azure_NatGatewayIPAutoScaling_To_v1alpha1_NatGatewayIPAutoScaling(in *azure.NatGatewayIPAutoScaling, out *NatGatewayIPAutoScaling, s conversion.Scope) error {
+	return autoConvert_azure_NatGatewayIPAutoScaling_To_v1alpha1_NatGatewayIPAutoScaling(in, out, s)
+}
+
+func autoConvert_v1alpha1_NatGatewayReference_To_azure_NatGatewayReference(in *NatGatewayReference, out *azure.NatGatewayReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_v1alpha1_NatGatewayReference_To_azure_NatGatewayReference is an autogenerated conversion function.
+func Convert_v1alpha1_NatGatewayReference_To_azure_NatGatewayReference(in *NatGatewayReference, out *azure.NatGatewayReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatGatewayReference_To_azure_NatGatewayReference(in, out, s)
+}
+
+func autoConvert_azure_NatGatewayReference_To_v1alpha1_NatGatewayReference(in *azure.NatGatewayReference, out *NatGatewayReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	return nil
+}
+
+// Convert_azure_NatGatewayReference_To_v1alpha1_NatGatewayReference is an autogenerated conversion function.
+func Convert_azure_NatGatewayReference_To_v1alpha1_NatGatewayReference(in *azure.NatGatewayReference, out *NatGatewayReference, s conversion.Scope) error {
+	return autoConvert_azure_NatGatewayReference_To_v1alpha1_NatGatewayReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in *NatGatewayStatus, out *azure.NatGatewayStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = in.ID
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.PublicIPAddresses = *(*[]azure.PublicIPAddressStatus)(unsafe.Pointer(&in.PublicIPAddresses))
+	return nil
+}
+
+// Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in *NatGatewayStatus, out *azure.NatGatewayStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatGatewayStatus_To_azure_NatGatewayStatus(in, out, s)
+}
+
+func autoConvert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in *azure.NatGatewayStatus, out *NatGatewayStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = in.ID
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.PublicIPAddresses = *(*[]PublicIPAddressStatus)(unsafe.Pointer(&in.PublicIPAddresses))
+	return nil
+}
+
+// Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus is an autogenerated conversion function.
+func Convert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in *azure.NatGatewayStatus, out *NatGatewayStatus, s conversion.Scope) error {
+	return autoConvert_azure_NatGatewayStatus_To_v1alpha1_NatGatewayStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig, out *azure.NetworkConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VNet_To_azure_VNet(&in.VNet, &out.VNet, s); err != nil {
 		return err
@@ -938,6 +2002,12 @@ func autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig
 	out.NatGateway = (*azure.NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
 	out.Zones = *(*[]azure.Zone)(unsafe.Pointer(&in.Zones))
+	out.PodSubnet = (*azure.PodSubnetConfig)(unsafe.Pointer(in.PodSubnet))
+	out.OutboundAccess = (*azure.OutboundAccessConfig)(unsafe.Pointer(in.OutboundAccess))
+	out.OutboundAccessType = (*azure.OutboundAccessType)(unsafe.Pointer(in.OutboundAccessType))
+	out.OutboundLoadBalancer = (*azure.OutboundLoadBalancerConfig)(unsafe.Pointer(in.OutboundLoadBalancer))
+	out.FlowLogs = (*azure.FlowLogsConfig)(unsafe.Pointer(in.FlowLogs))
+	out.EgressFirewall = (*azure.EgressFirewallConfig)(unsafe.Pointer(in.EgressFirewall))
 	return nil
 }
 
@@ -954,6 +2024,12 @@ func autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.Network
 	out.NatGateway = (*NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
 	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.PodSubnet = (*PodSubnetConfig)(unsafe.Pointer(in.PodSubnet))
+	out.OutboundAccess = (*OutboundAccessConfig)(unsafe.Pointer(in.OutboundAccess))
+	out.OutboundAccessType = (*OutboundAccessType)(unsafe.Pointer(in.OutboundAccessType))
+	out.OutboundLoadBalancer = (*OutboundLoadBalancerConfig)(unsafe.Pointer(in.OutboundLoadBalancer))
+	out.FlowLogs = (*FlowLogsConfig)(unsafe.Pointer(in.FlowLogs))
+	out.EgressFirewall = (*EgressFirewallConfig)(unsafe.Pointer(in.EgressFirewall))
 	return nil
 }
 
@@ -969,6 +2045,9 @@ func autoConvert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in *NetworkStatus
 	out.Subnets = *(*[]azure.Subnet)(unsafe.Pointer(&in.Subnets))
 	out.Layout = azure.NetworkLayout(in.Layout)
 	out.OutboundAccessType = azure.OutboundAccessType(in.OutboundAccessType)
+	out.NatGateways = *(*[]azure.NatGatewayStatus)(unsafe.Pointer(&in.NatGateways))
+	out.OutboundLoadBalancer = (*azure.OutboundLoadBalancerStatus)(unsafe.Pointer(in.OutboundLoadBalancer))
+	out.EgressFirewall = (*azure.EgressFirewallStatus)(unsafe.Pointer(in.EgressFirewall))
 	return nil
 }
 
@@ -984,6 +2063,9 @@ func autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.Network
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
 	out.Layout = NetworkLayout(in.Layout)
 	out.OutboundAccessType = OutboundAccessType(in.OutboundAccessType)
+	out.NatGateways = *(*[]NatGatewayStatus)(unsafe.Pointer(&in.NatGateways))
+	out.OutboundLoadBalancer = (*OutboundLoadBalancerStatus)(unsafe.Pointer(in.OutboundLoadBalancer))
+	out.EgressFirewall = (*EgressFirewallStatus)(unsafe.Pointer(in.EgressFirewall))
 	return nil
 }
 
@@ -992,6 +2074,232 @@ func Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.NetworkStat
 	return autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ObservabilityConfig_To_azure_ObservabilityConfig(in *ObservabilityConfig, out *azure.ObservabilityConfig, s conversion.Scope) error {
+	out.LogAnalyticsWorkspaceID = in.LogAnalyticsWorkspaceID
+	return nil
+}
+
+// Convert_v1alpha1_ObservabilityConfig_To_azure_ObservabilityConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ObservabilityConfig_To_azure_ObservabilityConfig(in *ObservabilityConfig, out *azure.ObservabilityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObservabilityConfig_To_azure_ObservabilityConfig(in, out, s)
+}
+
+func autoConvert_azure_ObservabilityConfig_To_v1alpha1_ObservabilityConfig(in *azure.ObservabilityConfig, out *ObservabilityConfig, s conversion.Scope) error {
+	out.LogAnalyticsWorkspaceID = in.LogAnalyticsWorkspaceID
+	return nil
+}
+
+// Convert_azure_ObservabilityConfig_To_v1alpha1_ObservabilityConfig is an autogenerated conversion function.
+func Convert_azure_ObservabilityConfig_To_v1alpha1_ObservabilityConfig(in *azure.ObservabilityConfig, out *ObservabilityConfig, s conversion.Scope) error {
+	return autoConvert_azure_ObservabilityConfig_To_v1alpha1_ObservabilityConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OrphanedPublicIPRemedyConfig_To_azure_OrphanedPublicIPRemedyConfig(in *OrphanedPublicIPRemedyConfig, out *azure.OrphanedPublicIPRemedyConfig, s conversion.Scope) error {
+	out.RequeueInterval = (*metav1.Duration)(unsafe.Pointer(in.RequeueInterval))
+	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.DeletionGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.DeletionGracePeriod))
+	out.MaxGetAttempts = (*int32)(unsafe.Pointer(in.MaxGetAttempts))
+	out.MaxCleanAttempts = (*int32)(unsafe.Pointer(in.MaxCleanAttempts))
+	return nil
+}
+
+// Convert_v1alpha1_OrphanedPublicIPRemedyConfig_To_azure_OrphanedPublicIPRemedyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OrphanedPublicIPRemedyConfig_To_azure_OrphanedPublicIPRemedyConfig(in *OrphanedPublicIPRemedyConfig, out *azure.OrphanedPublicIPRemedyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphanedPublicIPRemedyConfig_To_azure_OrphanedPublicIPRemedyConfig(in, out, s)
+}
+
+func autoConvert_azure_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig(in *azure.OrphanedPublicIPRemedyConfig, out *OrphanedPublicIPRemedyConfig, s conversion.Scope) error {
+	out.RequeueInterval = (*metav1.Duration)(unsafe.Pointer(in.RequeueInterval))
+	out.SyncPeriod = (*metav1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.DeletionGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.DeletionGracePeriod))
+	out.MaxGetAttempts = (*int32)(unsafe.Pointer(in.MaxGetAttempts))
+	out.MaxCleanAttempts = (*int32)(unsafe.Pointer(in.MaxCleanAttempts))
+	return nil
+}
+
+// Convert_azure_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig is an autogenerated conversion function.
+func Convert_azure_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig(in *azure.OrphanedPublicIPRemedyConfig, out *OrphanedPublicIPRemedyConfig, s conversion.Scope) error {
+	return autoConvert_azure_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OutboundAccessConfig_To_azure_OutboundAccessConfig(in *OutboundAccessConfig, out *azure.OutboundAccessConfig, s conversion.Scope) error {
+	out.NextHopIPAddress = in.NextHopIPAddress
+	return nil
+}
+
+// Convert_v1alpha1_OutboundAccessConfig_To_azure_OutboundAccessConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundAccessConfig_To_azure_OutboundAccessConfig(in *OutboundAccessConfig, out *azure.OutboundAccessConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundAccessConfig_To_azure_OutboundAccessConfig(in, out, s)
+}
+
+func autoConvert_azure_OutboundAccessConfig_To_v1alpha1_OutboundAccessConfig(in *azure.OutboundAccessConfig, out *OutboundAccessConfig, s conversion.Scope) error {
+	out.NextHopIPAddress = in.NextHopIPAddress
+	return nil
+}
+
+// Convert_azure_OutboundAccessConfig_To_v1alpha1_OutboundAccessConfig is an autogenerated conversion function.
+func Convert_azure_OutboundAccessConfig_To_v1alpha1_OutboundAccessConfig(in *azure.OutboundAccessConfig, out *OutboundAccessConfig, s conversion.Scope) error {
+	return autoConvert_azure_OutboundAccessConfig_To_v1alpha1_OutboundAccessConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig(in *OutboundLoadBalancerConfig, out *azure.OutboundLoadBalancerConfig, s conversion.Scope) error {
+	out.AllocatedOutboundPorts = (*int32)(unsafe.Pointer(in.AllocatedOutboundPorts))
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	return nil
+}
+
+// Convert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig(in *OutboundLoadBalancerConfig, out *azure.OutboundLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundLoadBalancerConfig_To_azure_OutboundLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig(in *azure.OutboundLoadBalancerConfig, out *OutboundLoadBalancerConfig, s conversion.Scope) error {
+	out.AllocatedOutboundPorts = (*int32)(unsafe.Pointer(in.AllocatedOutboundPorts))
+	out.PublicIPCount = (*int32)(unsafe.Pointer(in.PublicIPCount))
+	return nil
+}
+
+// Convert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig is an autogenerated conversion function.
+func Convert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig(in *azure.OutboundLoadBalancerConfig, out *OutboundLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_azure_OutboundLoadBalancerConfig_To_v1alpha1_OutboundLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus(in *OutboundLoadBalancerStatus, out *azure.OutboundLoadBalancerStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.OutboundRuleName = in.OutboundRuleName
+	out.PublicIPAddresses = *(*[]azure.PublicIPAddressStatus)(unsafe.Pointer(&in.PublicIPAddresses))
+	return nil
+}
+
+// Convert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus(in *OutboundLoadBalancerStatus, out *azure.OutboundLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundLoadBalancerStatus_To_azure_OutboundLoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus(in *azure.OutboundLoadBalancerStatus, out *OutboundLoadBalancerStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.OutboundRuleName = in.OutboundRuleName
+	out.PublicIPAddresses = *(*[]PublicIPAddressStatus)(unsafe.Pointer(&in.PublicIPAddresses))
+	return nil
+}
+
+// Convert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus is an autogenerated conversion function.
+func Convert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus(in *azure.OutboundLoadBalancerStatus, out *OutboundLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_azure_OutboundLoadBalancerStatus_To_v1alpha1_OutboundLoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_OutboundRuleConfig_To_azure_OutboundRuleConfig(in *OutboundRuleConfig, out *azure.OutboundRuleConfig, s conversion.Scope) error {
+	out.AllocatedOutboundPorts = (*int32)(unsafe.Pointer(in.AllocatedOutboundPorts))
+	out.IdleTimeoutInMinutes = (*int32)(unsafe.Pointer(in.IdleTimeoutInMinutes))
+	return nil
+}
+
+// Convert_v1alpha1_OutboundRuleConfig_To_azure_OutboundRuleConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OutboundRuleConfig_To_azure_OutboundRuleConfig(in *OutboundRuleConfig, out *azure.OutboundRuleConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutboundRuleConfig_To_azure_OutboundRuleConfig(in, out, s)
+}
+
+func autoConvert_azure_OutboundRuleConfig_To_v1alpha1_OutboundRuleConfig(in *azure.OutboundRuleConfig, out *OutboundRuleConfig, s conversion.Scope) error {
+	out.AllocatedOutboundPorts = (*int32)(unsafe.Pointer(in.AllocatedOutboundPorts))
+	out.IdleTimeoutInMinutes = (*int32)(unsafe.Pointer(in.IdleTimeoutInMinutes))
+	return nil
+}
+
+// Convert_azure_OutboundRuleConfig_To_v1alpha1_OutboundRuleConfig is an autogenerated conversion function.
+func Convert_azure_OutboundRuleConfig_To_v1alpha1_OutboundRuleConfig(in *azure.OutboundRuleConfig, out *OutboundRuleConfig, s conversion.Scope) error {
+	return autoConvert_azure_OutboundRuleConfig_To_v1alpha1_OutboundRuleConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig(in *PodSubnetConfig, out *azure.PodSubnetConfig, s conversion.Scope) error {
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	return nil
+}
+
+// Convert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig(in *PodSubnetConfig, out *azure.PodSubnetConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodSubnetConfig_To_azure_PodSubnetConfig(in, out, s)
+}
+
+func autoConvert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig(in *azure.PodSubnetConfig, out *PodSubnetConfig, s conversion.Scope) error {
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	return nil
+}
+
+// Convert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig is an autogenerated conversion function.
+func Convert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig(in *azure.PodSubnetConfig, out *PodSubnetConfig, s conversion.Scope) error {
+	return autoConvert_azure_PodSubnetConfig_To_v1alpha1_PodSubnetConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPAddressStatus_To_azure_PublicIPAddressStatus(in *PublicIPAddressStatus, out *azure.PublicIPAddressStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.ID = in.ID
+	out.IPAddress = in.IPAddress
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPAddressStatus_To_azure_PublicIPAddressStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPAddressStatus_To_azure_PublicIPAddressStatus(in *PublicIPAddressStatus, out *azure.PublicIPAddressStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPAddressStatus_To_azure_PublicIPAddressStatus(in, out, s)
+}
+
+func autoConvert_azure_PublicIPAddressStatus_To_v1alpha1_PublicIPAddressStatus(in *azure.PublicIPAddressStatus, out *PublicIPAddressStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.ID = in.ID
+	out.IPAddress = in.IPAddress
+	return nil
+}
+
+// Convert_azure_PublicIPAddressStatus_To_v1alpha1_PublicIPAddressStatus is an autogenerated conversion function.
+func Convert_azure_PublicIPAddressStatus_To_v1alpha1_PublicIPAddressStatus(in *azure.PublicIPAddressStatus, out *PublicIPAddressStatus, s conversion.Scope) error {
+	return autoConvert_azure_PublicIPAddressStatus_To_v1alpha1_PublicIPAddressStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPDDoSProtection_To_azure_PublicIPDDoSProtection(in *PublicIPDDoSProtection, out *azure.PublicIPDDoSProtection, s conversion.Scope) error {
+	out.Mode = azure.PublicIPDDoSProtectionMode(in.Mode)
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPDDoSProtection_To_azure_PublicIPDDoSProtection is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPDDoSProtection_To_azure_PublicIPDDoSProtection(in *PublicIPDDoSProtection, out *azure.PublicIPDDoSProtection, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPDDoSProtection_To_azure_PublicIPDDoSProtection(in, out, s)
+}
+
+func autoConvert_azure_PublicIPDDoSProtection_To_v1alpha1_PublicIPDDoSProtection(in *azure.PublicIPDDoSProtection, out *PublicIPDDoSProtection, s conversion.Scope) error {
+	out.Mode = PublicIPDDoSProtectionMode(in.Mode)
+	return nil
+}
+
+// Convert_azure_PublicIPDDoSProtection_To_v1alpha1_PublicIPDDoSProtection is an autogenerated conversion function.
+func Convert_azure_PublicIPDDoSProtection_To_v1alpha1_PublicIPDDoSProtection(in *azure.PublicIPDDoSProtection, out *PublicIPDDoSProtection, s conversion.Scope) error {
+	return autoConvert_azure_PublicIPDDoSProtection_To_v1alpha1_PublicIPDDoSProtection(in, out, s)
+}
+
+func autoConvert_v1alpha1_PublicIPDNSSettings_To_azure_PublicIPDNSSettings(in *PublicIPDNSSettings, out *azure.PublicIPDNSSettings, s conversion.Scope) error {
+	out.DomainNameLabel = in.DomainNameLabel
+	out.ReverseFQDN = (*string)(unsafe.Pointer(in.ReverseFQDN))
+	return nil
+}
+
+// Convert_v1alpha1_PublicIPDNSSettings_To_azure_PublicIPDNSSettings is an autogenerated conversion function.
+func Convert_v1alpha1_PublicIPDNSSettings_To_azure_PublicIPDNSSettings(in *PublicIPDNSSettings, out *azure.PublicIPDNSSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PublicIPDNSSettings_To_azure_PublicIPDNSSettings(in, out, s)
+}
+
+func autoConvert_azure_PublicIPDNSSettings_To_v1alpha1_PublicIPDNSSettings(in *azure.PublicIPDNSSettings, out *PublicIPDNSSettings, s conversion.Scope) error {
+	out.DomainNameLabel = in.DomainNameLabel
+	out.ReverseFQDN = (*string)(unsafe.Pointer(in.ReverseFQDN))
+	return nil
+}
+
+// Convert_azure_PublicIPDNSSettings_To_v1alpha1_PublicIPDNSSettings is an autogenerated conversion function.
+func Convert_azure_PublicIPDNSSettings_To_v1alpha1_PublicIPDNSSettings(in *azure.PublicIPDNSSettings, out *PublicIPDNSSettings, s conversion.Scope) error {
+	return autoConvert_azure_PublicIPDNSSettings_To_v1alpha1_PublicIPDNSSettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(in *PublicIPReference, out *azure.PublicIPReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ResourceGroup = in.ResourceGroup
@@ -1016,8 +2324,33 @@ func Convert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(in *azure.Pub
 	return autoConvert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RemedyConfig_To_azure_RemedyConfig(in *RemedyConfig, out *azure.RemedyConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.OrphanedPublicIPRemedy = (*azure.OrphanedPublicIPRemedyConfig)(unsafe.Pointer(in.OrphanedPublicIPRemedy))
+	out.FailedVMRemedy = (*azure.FailedVMRemedyConfig)(unsafe.Pointer(in.FailedVMRemedy))
+	return nil
+}
+
+// Convert_v1alpha1_RemedyConfig_To_azure_RemedyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RemedyConfig_To_azure_RemedyConfig(in *RemedyConfig, out *azure.RemedyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RemedyConfig_To_azure_RemedyConfig(in, out, s)
+}
+
+func autoConvert_azure_RemedyConfig_To_v1alpha1_RemedyConfig(in *azure.RemedyConfig, out *RemedyConfig, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.OrphanedPublicIPRemedy = (*OrphanedPublicIPRemedyConfig)(unsafe.Pointer(in.OrphanedPublicIPRemedy))
+	out.FailedVMRemedy = (*FailedVMRemedyConfig)(unsafe.Pointer(in.FailedVMRemedy))
+	return nil
+}
+
+// Convert_azure_RemedyConfig_To_v1alpha1_RemedyConfig is an autogenerated conversion function.
+func Convert_azure_RemedyConfig_To_v1alpha1_RemedyConfig(in *azure.RemedyConfig, out *RemedyConfig, s conversion.Scope) error {
+	return autoConvert_azure_RemedyConfig_To_v1alpha1_RemedyConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(in *ResourceGroup, out *azure.ResourceGroup, s conversion.Scope) error {
 	out.Name = in.Name
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
 	return nil
 }
 
@@ -1028,6 +2361,7 @@ func Convert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(in *ResourceGroup, ou
 
 func autoConvert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(in *azure.ResourceGroup, out *ResourceGroup, s conversion.Scope) error {
 	out.Name = in.Name
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
 	return nil
 }
 
@@ -1036,9 +2370,33 @@ func Convert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(in *azure.ResourceGro
 	return autoConvert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_RotationConfig_To_azure_RotationConfig(in *RotationConfig, out *azure.RotationConfig, s conversion.Scope) error {
+	out.Mode = azure.RotationMode(in.Mode)
+	out.SASTokenValidity = (*metav1.Duration)(unsafe.Pointer(in.SASTokenValidity))
+	return nil
+}
+
+// Convert_v1alpha1_RotationConfig_To_azure_RotationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RotationConfig_To_azure_RotationConfig(in *RotationConfig, out *azure.RotationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RotationConfig_To_azure_RotationConfig(in, out, s)
+}
+
+func autoConvert_azure_RotationConfig_To_v1alpha1_RotationConfig(in *azure.RotationConfig, out *RotationConfig, s conversion.Scope) error {
+	out.Mode = RotationMode(in.Mode)
+	out.SASTokenValidity = (*metav1.Duration)(unsafe.Pointer(in.SASTokenValidity))
+	return nil
+}
+
+// Convert_azure_RotationConfig_To_v1alpha1_RotationConfig is an autogenerated conversion function.
+func Convert_azure_RotationConfig_To_v1alpha1_RotationConfig(in *azure.RotationConfig, out *RotationConfig, s conversion.Scope) error {
+	return autoConvert_azure_RotationConfig_To_v1alpha1_RotationConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_RouteTable_To_azure_RouteTable(in *RouteTable, out *azure.RouteTable, s conversion.Scope) error {
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.Name = in.Name
+	out.ID = in.ID
+	out.Managed = in.Managed
 	return nil
 }
 
@@ -1050,6 +2408,8 @@ func Convert_v1alpha1_RouteTable_To_azure_RouteTable(in *RouteTable, out *azure.
 func autoConvert_azure_RouteTable_To_v1alpha1_RouteTable(in *azure.RouteTable, out *RouteTable, s conversion.Scope) error {
 	out.Purpose = Purpose(in.Purpose)
 	out.Name = in.Name
+	out.ID = in.ID
+	out.Managed = in.Managed
 	return nil
 }
 
@@ -1058,9 +2418,31 @@ func Convert_azure_RouteTable_To_v1alpha1_RouteTable(in *azure.RouteTable, out *
 	return autoConvert_azure_RouteTable_To_v1alpha1_RouteTable(in, out, s)
 }
 
+func autoConvert_v1alpha1_ScheduledEvents_To_azure_ScheduledEvents(in *ScheduledEvents, out *azure.ScheduledEvents, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_ScheduledEvents_To_azure_ScheduledEvents is an autogenerated conversion function.
+func Convert_v1alpha1_ScheduledEvents_To_azure_ScheduledEvents(in *ScheduledEvents, out *azure.ScheduledEvents, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScheduledEvents_To_azure_ScheduledEvents(in, out, s)
+}
+
+func autoConvert_azure_ScheduledEvents_To_v1alpha1_ScheduledEvents(in *azure.ScheduledEvents, out *ScheduledEvents, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_azure_ScheduledEvents_To_v1alpha1_ScheduledEvents is an autogenerated conversion function.
+func Convert_azure_ScheduledEvents_To_v1alpha1_ScheduledEvents(in *azure.ScheduledEvents, out *ScheduledEvents, s conversion.Scope) error {
+	return autoConvert_azure_ScheduledEvents_To_v1alpha1_ScheduledEvents(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(in *SecurityGroup, out *azure.SecurityGroup, s conversion.Scope) error {
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.Name = in.Name
+	out.ID = in.ID
+	out.Managed = in.Managed
 	return nil
 }
 
@@ -1072,6 +2454,8 @@ func Convert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(in *SecurityGroup, ou
 func autoConvert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in *azure.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
 	out.Purpose = Purpose(in.Purpose)
 	out.Name = in.Name
+	out.ID = in.ID
+	out.Managed = in.Managed
 	return nil
 }
 
@@ -1083,6 +2467,8 @@ func Convert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in *azure.SecurityGro
 func autoConvert_v1alpha1_Storage_To_azure_Storage(in *Storage, out *azure.Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.VolumeSnapshotClass = (*azure.VolumeSnapshotClassConfig)(unsafe.Pointer(in.VolumeSnapshotClass))
+	out.SnapshotValidationWebhook = (*bool)(unsafe.Pointer(in.SnapshotValidationWebhook))
 	return nil
 }
 
@@ -1094,6 +2480,8 @@ func Convert_v1alpha1_Storage_To_azure_Storage(in *Storage, out *azure.Storage,
 func autoConvert_azure_Storage_To_v1alpha1_Storage(in *azure.Storage, out *Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.VolumeSnapshotClass = (*VolumeSnapshotClassConfig)(unsafe.Pointer(in.VolumeSnapshotClass))
+	out.SnapshotValidationWebhook = (*bool)(unsafe.Pointer(in.SnapshotValidationWebhook))
 	return nil
 }
 
@@ -1108,6 +2496,8 @@ func autoConvert_v1alpha1_Subnet_To_azure_Subnet(in *Subnet, out *azure.Subnet,
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Migrated = in.Migrated
 	out.NatGatewayID = (*string)(unsafe.Pointer(in.NatGatewayID))
+	out.SecurityGroupID = (*string)(unsafe.Pointer(in.SecurityGroupID))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
 	return nil
 }
 
@@ -1122,6 +2512,8 @@ func autoConvert_azure_Subnet_To_v1alpha1_Subnet(in *azure.Subnet, out *Subnet,
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Migrated = in.Migrated
 	out.NatGatewayID = (*string)(unsafe.Pointer(in.NatGatewayID))
+	out.SecurityGroupID = (*string)(unsafe.Pointer(in.SecurityGroupID))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
 	return nil
 }
 
@@ -1130,10 +2522,55 @@ func Convert_azure_Subnet_To_v1alpha1_Subnet(in *azure.Subnet, out *Subnet, s co
 	return autoConvert_azure_Subnet_To_v1alpha1_Subnet(in, out, s)
 }
 
+func autoConvert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig(in *TrafficAnalyticsConfig, out *azure.TrafficAnalyticsConfig, s conversion.Scope) error {
+	out.WorkspaceID = in.WorkspaceID
+	out.IntervalInMinutes = (*int32)(unsafe.Pointer(in.IntervalInMinutes))
+	return nil
+}
+
+// Convert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig(in *TrafficAnalyticsConfig, out *azure.TrafficAnalyticsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrafficAnalyticsConfig_To_azure_TrafficAnalyticsConfig(in, out, s)
+}
+
+func autoConvert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig(in *azure.TrafficAnalyticsConfig, out *TrafficAnalyticsConfig, s conversion.Scope) error {
+	out.WorkspaceID = in.WorkspaceID
+	out.IntervalInMinutes = (*int32)(unsafe.Pointer(in.IntervalInMinutes))
+	return nil
+}
+
+// Convert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig is an autogenerated conversion function.
+func Convert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig(in *azure.TrafficAnalyticsConfig, out *TrafficAnalyticsConfig, s conversion.Scope) error {
+	return autoConvert_azure_TrafficAnalyticsConfig_To_v1alpha1_TrafficAnalyticsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMTagsConfig_To_azure_VMTagsConfig(in *VMTagsConfig, out *azure.VMTagsConfig, s conversion.Scope) error {
+	out.IncludeShootLabels = (*bool)(unsafe.Pointer(in.IncludeShootLabels))
+	out.MergePolicy = (*azure.VMTagMergePolicy)(unsafe.Pointer(in.MergePolicy))
+	return nil
+}
+
+// Convert_v1alpha1_VMTagsConfig_To_azure_VMTagsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VMTagsConfig_To_azure_VMTagsConfig(in *VMTagsConfig, out *azure.VMTagsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMTagsConfig_To_azure_VMTagsConfig(in, out, s)
+}
+
+func autoConvert_azure_VMTagsConfig_To_v1alpha1_VMTagsConfig(in *azure.VMTagsConfig, out *VMTagsConfig, s conversion.Scope) error {
+	out.IncludeShootLabels = (*bool)(unsafe.Pointer(in.IncludeShootLabels))
+	out.MergePolicy = (*VMTagMergePolicy)(unsafe.Pointer(in.MergePolicy))
+	return nil
+}
+
+// Convert_azure_VMTagsConfig_To_v1alpha1_VMTagsConfig is an autogenerated conversion function.
+func Convert_azure_VMTagsConfig_To_v1alpha1_VMTagsConfig(in *azure.VMTagsConfig, out *VMTagsConfig, s conversion.Scope) error {
+	return autoConvert_azure_VMTagsConfig_To_v1alpha1_VMTagsConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_VNet_To_azure_VNet(in *VNet, out *azure.VNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.AdditionalCIDRs = *(*[]string)(unsafe.Pointer(&in.AdditionalCIDRs))
 	out.DDosProtectionPlanID = (*string)(unsafe.Pointer(in.DDosProtectionPlanID))
 	return nil
 }
@@ -1147,6 +2584,7 @@ func autoConvert_azure_VNet_To_v1alpha1_VNet(in *azure.VNet, out *VNet, s conver
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.AdditionalCIDRs = *(*[]string)(unsafe.Pointer(&in.AdditionalCIDRs))
 	out.DDosProtectionPlanID = (*string)(unsafe.Pointer(in.DDosProtectionPlanID))
 	return nil
 }
@@ -1178,6 +2616,28 @@ func Convert_azure_VNetStatus_To_v1alpha1_VNetStatus(in *azure.VNetStatus, out *
 	return autoConvert_azure_VNetStatus_To_v1alpha1_VNetStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_VmoConfig_To_azure_VmoConfig(in *VmoConfig, out *azure.VmoConfig, s conversion.Scope) error {
+	out.FaultDomainCount = (*int32)(unsafe.Pointer(in.FaultDomainCount))
+	out.ZoneBalance = (*bool)(unsafe.Pointer(in.ZoneBalance))
+	return nil
+}
+
+// Convert_v1alpha1_VmoConfig_To_azure_VmoConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VmoConfig_To_azure_VmoConfig(in *VmoConfig, out *azure.VmoConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VmoConfig_To_azure_VmoConfig(in, out, s)
+}
+
+func autoConvert_azure_VmoConfig_To_v1alpha1_VmoConfig(in *azure.VmoConfig, out *VmoConfig, s conversion.Scope) error {
+	out.FaultDomainCount = (*int32)(unsafe.Pointer(in.FaultDomainCount))
+	out.ZoneBalance = (*bool)(unsafe.Pointer(in.ZoneBalance))
+	return nil
+}
+
+// Convert_azure_VmoConfig_To_v1alpha1_VmoConfig is an autogenerated conversion function.
+func Convert_azure_VmoConfig_To_v1alpha1_VmoConfig(in *azure.VmoConfig, out *VmoConfig, s conversion.Scope) error {
+	return autoConvert_azure_VmoConfig_To_v1alpha1_VmoConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_VmoDependency_To_azure_VmoDependency(in *VmoDependency, out *azure.VmoDependency, s conversion.Scope) error {
 	out.PoolName = in.PoolName
 	out.ID = in.ID
@@ -1202,10 +2662,65 @@ func Convert_azure_VmoDependency_To_v1alpha1_VmoDependency(in *azure.VmoDependen
 	return autoConvert_azure_VmoDependency_To_v1alpha1_VmoDependency(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeSnapshotClassConfig_To_azure_VolumeSnapshotClassConfig(in *VolumeSnapshotClassConfig, out *azure.VolumeSnapshotClassConfig, s conversion.Scope) error {
+	out.Incremental = (*bool)(unsafe.Pointer(in.Incremental))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotClassConfig_To_azure_VolumeSnapshotClassConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotClassConfig_To_azure_VolumeSnapshotClassConfig(in *VolumeSnapshotClassConfig, out *azure.VolumeSnapshotClassConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotClassConfig_To_azure_VolumeSnapshotClassConfig(in, out, s)
+}
+
+func autoConvert_azure_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig(in *azure.VolumeSnapshotClassConfig, out *VolumeSnapshotClassConfig, s conversion.Scope) error {
+	out.Incremental = (*bool)(unsafe.Pointer(in.Incremental))
+	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	return nil
+}
+
+// Convert_azure_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig is an autogenerated conversion function.
+func Convert_azure_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig(in *azure.VolumeSnapshotClassConfig, out *VolumeSnapshotClassConfig, s conversion.Scope) error {
+	return autoConvert_azure_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_WarmPool_To_azure_WarmPool(in *WarmPool, out *azure.WarmPool, s conversion.Scope) error {
+	out.Count = in.Count
+	out.MaxAge = (*metav1.Duration)(unsafe.Pointer(in.MaxAge))
+	return nil
+}
+
+// Convert_v1alpha1_WarmPool_To_azure_WarmPool is an autogenerated conversion function.
+func Convert_v1alpha1_WarmPool_To_azure_WarmPool(in *WarmPool, out *azure.WarmPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WarmPool_To_azure_WarmPool(in, out, s)
+}
+
+func autoConvert_azure_WarmPool_To_v1alpha1_WarmPool(in *azure.WarmPool, out *WarmPool, s conversion.Scope) error {
+	out.Count = in.Count
+	out.MaxAge = (*metav1.Duration)(unsafe.Pointer(in.MaxAge))
+	return nil
+}
+
+// Convert_azure_WarmPool_To_v1alpha1_WarmPool is an autogenerated conversion function.
+func Convert_azure_WarmPool_To_v1alpha1_WarmPool(in *azure.WarmPool, out *WarmPool, s conversion.Scope) error {
+	return autoConvert_azure_WarmPool_To_v1alpha1_WarmPool(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
 	out.DiagnosticsProfile = (*azure.DiagnosticsProfile)(unsafe.Pointer(in.DiagnosticsProfile))
 	out.DataVolumes = *(*[]azure.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.WarmPool = (*azure.WarmPool)(unsafe.Pointer(in.WarmPool))
+	out.Vmo = (*azure.VmoConfig)(unsafe.Pointer(in.Vmo))
+	out.VMTags = (*azure.VMTagsConfig)(unsafe.Pointer(in.VMTags))
+	out.Kubelet = (*azure.KubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.CloudConfiguration = (*azure.CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+	out.MaintenanceConfiguration = (*string)(unsafe.Pointer(in.MaintenanceConfiguration))
+	out.NodesSubnet = (*string)(unsafe.Pointer(in.NodesSubnet))
+	out.ScheduledEvents = (*azure.ScheduledEvents)(unsafe.Pointer(in.ScheduledEvents))
+	out.LocalDisks = (*azure.LocalDisks)(unsafe.Pointer(in.LocalDisks))
 	return nil
 }
 
@@ -1218,6 +2733,15 @@ func autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerCon
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
 	out.DiagnosticsProfile = (*DiagnosticsProfile)(unsafe.Pointer(in.DiagnosticsProfile))
 	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.WarmPool = (*WarmPool)(unsafe.Pointer(in.WarmPool))
+	out.Vmo = (*VmoConfig)(unsafe.Pointer(in.Vmo))
+	out.VMTags = (*VMTagsConfig)(unsafe.Pointer(in.VMTags))
+	out.Kubelet = (*KubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.CloudConfiguration = (*CloudConfiguration)(unsafe.Pointer(in.CloudConfiguration))
+	out.MaintenanceConfiguration = (*string)(unsafe.Pointer(in.MaintenanceConfiguration))
+	out.NodesSubnet = (*string)(unsafe.Pointer(in.NodesSubnet))
+	out.ScheduledEvents = (*ScheduledEvents)(unsafe.Pointer(in.ScheduledEvents))
+	out.LocalDisks = (*LocalDisks)(unsafe.Pointer(in.LocalDisks))
 	return nil
 }
 
@@ -1253,6 +2777,7 @@ func autoConvert_v1alpha1_Zone_To_azure_Zone(in *Zone, out *azure.Zone, s conver
 	out.CIDR = in.CIDR
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
 	out.NatGateway = (*azure.ZonedNatGatewayConfig)(unsafe.Pointer(in.NatGateway))
+	out.SecurityGroup = (*azure.ZoneSecurityGroupConfig)(unsafe.Pointer(in.SecurityGroup))
 	return nil
 }
 
@@ -1266,6 +2791,7 @@ func autoConvert_azure_Zone_To_v1alpha1_Zone(in *azure.Zone, out *Zone, s conver
 	out.CIDR = in.CIDR
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
 	out.NatGateway = (*ZonedNatGatewayConfig)(unsafe.Pointer(in.NatGateway))
+	out.SecurityGroup = (*ZoneSecurityGroupConfig)(unsafe.Pointer(in.SecurityGroup))
 	return nil
 }
 
@@ -1274,10 +2800,56 @@ func Convert_azure_Zone_To_v1alpha1_Zone(in *azure.Zone, out *Zone, s conversion
 	return autoConvert_azure_Zone_To_v1alpha1_Zone(in, out, s)
 }
 
+func autoConvert_v1alpha1_ZoneMapping_To_azure_ZoneMapping(in *ZoneMapping, out *azure.ZoneMapping, s conversion.Scope) error {
+	out.LogicalZone = in.LogicalZone
+	out.PhysicalZone = in.PhysicalZone
+	return nil
+}
+
+// Convert_v1alpha1_ZoneMapping_To_azure_ZoneMapping is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneMapping_To_azure_ZoneMapping(in *ZoneMapping, out *azure.ZoneMapping, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneMapping_To_azure_ZoneMapping(in, out, s)
+}
+
+func autoConvert_azure_ZoneMapping_To_v1alpha1_ZoneMapping(in *azure.ZoneMapping, out *ZoneMapping, s conversion.Scope) error {
+	out.LogicalZone = in.LogicalZone
+	out.PhysicalZone = in.PhysicalZone
+	return nil
+}
+
+// Convert_azure_ZoneMapping_To_v1alpha1_ZoneMapping is an autogenerated conversion function.
+func Convert_azure_ZoneMapping_To_v1alpha1_ZoneMapping(in *azure.ZoneMapping, out *ZoneMapping, s conversion.Scope) error {
+	return autoConvert_azure_ZoneMapping_To_v1alpha1_ZoneMapping(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneSecurityGroupConfig_To_azure_ZoneSecurityGroupConfig(in *ZoneSecurityGroupConfig, out *azure.ZoneSecurityGroupConfig, s conversion.Scope) error {
+	out.ExternalID = in.ExternalID
+	return nil
+}
+
+// Convert_v1alpha1_ZoneSecurityGroupConfig_To_azure_ZoneSecurityGroupConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneSecurityGroupConfig_To_azure_ZoneSecurityGroupConfig(in *ZoneSecurityGroupConfig, out *azure.ZoneSecurityGroupConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneSecurityGroupConfig_To_azure_ZoneSecurityGroupConfig(in, out, s)
+}
+
+func autoConvert_azure_ZoneSecurityGroupConfig_To_v1alpha1_ZoneSecurityGroupConfig(in *azure.ZoneSecurityGroupConfig, out *ZoneSecurityGroupConfig, s conversion.Scope) error {
+	out.ExternalID = in.ExternalID
+	return nil
+}
+
+// Convert_azure_ZoneSecurityGroupConfig_To_v1alpha1_ZoneSecurityGroupConfig is an autogenerated conversion function.
+func Convert_azure_ZoneSecurityGroupConfig_To_v1alpha1_ZoneSecurityGroupConfig(in *azure.ZoneSecurityGroupConfig, out *ZoneSecurityGroupConfig, s conversion.Scope) error {
+	return autoConvert_azure_ZoneSecurityGroupConfig_To_v1alpha1_ZoneSecurityGroupConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ZonedNatGatewayConfig_To_azure_ZonedNatGatewayConfig(in *ZonedNatGatewayConfig, out *azure.ZonedNatGatewayConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
 	out.IPAddresses = *(*[]azure.ZonedPublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	out.DDoSProtection = (*azure.PublicIPDDoSProtection)(unsafe.Pointer(in.DDoSProtection))
+	out.DNSSettings = (*azure.PublicIPDNSSettings)(unsafe.Pointer(in.DNSSettings))
+	out.AutoScaleIPs = (*azure.NatGatewayIPAutoScaling)(unsafe.Pointer(in.AutoScaleIPs))
+	out.Existing = (*azure.NatGatewayReference)(unsafe.Pointer(in.Existing))
 	return nil
 }
 
@@ -1290,6 +2862,10 @@ func autoConvert_azure_ZonedNatGatewayConfig_To_v1alpha1_ZonedNatGatewayConfig(i
 	out.Enabled = in.Enabled
 	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
 	out.IPAddresses = *(*[]ZonedPublicIPReference)(unsafe.Pointer(&in.IPAddresses))
+	out.DDoSProtection = (*PublicIPDDoSProtection)(unsafe.Pointer(in.DDoSProtection))
+	out.DNSSettings = (*PublicIPDNSSettings)(unsafe.Pointer(in.DNSSettings))
+	out.AutoScaleIPs = (*NatGatewayIPAutoScaling)(unsafe.Pointer(in.AutoScaleIPs))
+	out.Existing = (*NatGatewayReference)(unsafe.Pointer(in.Existing))
 	return nil
 }
 
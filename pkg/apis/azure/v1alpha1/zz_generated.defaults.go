@@ -19,6 +19,7 @@ import (
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&CloudProfileConfig{}, func(obj interface{}) { SetObjectDefaults_CloudProfileConfig(obj.(*CloudProfileConfig)) })
 	scheme.AddTypeDefaultingFunc(&ControlPlaneConfig{}, func(obj interface{}) { SetObjectDefaults_ControlPlaneConfig(obj.(*ControlPlaneConfig)) })
+	scheme.AddTypeDefaultingFunc(&InfrastructureConfig{}, func(obj interface{}) { SetObjectDefaults_InfrastructureConfig(obj.(*InfrastructureConfig)) })
 	scheme.AddTypeDefaultingFunc(&InfrastructureStatus{}, func(obj interface{}) { SetObjectDefaults_InfrastructureStatus(obj.(*InfrastructureStatus)) })
 	return nil
 }
@@ -39,6 +40,14 @@ func SetObjectDefaults_ControlPlaneConfig(in *ControlPlaneConfig) {
 	}
 }
 
+func SetObjectDefaults_InfrastructureConfig(in *InfrastructureConfig) {
+	SetDefaults_NetworkConfig(&in.Networks)
+	for i := range in.Networks.Zones {
+		a := &in.Networks.Zones[i]
+		SetDefaults_Zone(a)
+	}
+}
+
 func SetObjectDefaults_InfrastructureStatus(in *InfrastructureStatus) {
-	SetDefaults_OutboundAccessType(&in.Networks.OutboundAccessType)
+	SetDefaults_NetworkStatus(&in.Networks)
 }
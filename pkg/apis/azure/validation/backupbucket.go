@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(config *apisazure.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.ResourceGroupRegion != nil && *config.ResourceGroupRegion == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceGroupRegion"), "the resource group region must not be empty"))
+	}
+	if ref := config.CredentialsSecretRef; ref != nil {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("credentialsSecretRef", "name"), "the name of the credentials secret must not be empty"))
+		}
+		if ref.Namespace == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("credentialsSecretRef", "namespace"), "the namespace of the credentials secret must not be empty"))
+		}
+	}
+	if config.CloudConfiguration != nil {
+		allErrs = append(allErrs, validateCloudConfiguration(config.CloudConfiguration, fldPath.Child("cloudConfiguration"))...)
+	}
+	if config.NetworkACLs != nil {
+		allErrs = append(allErrs, validateBackupBucketNetworkACLs(config.NetworkACLs, fldPath.Child("networkAcls"))...)
+	}
+	if config.Rotation != nil {
+		allErrs = append(allErrs, validateRotationConfig(config.Rotation, fldPath.Child("rotation"))...)
+	}
+	if config.Inventory != nil && config.Inventory.Container != nil {
+		allErrs = append(allErrs, validateContainerName(*config.Inventory.Container, fldPath.Child("inventory", "container"))...)
+	}
+	if config.MinimumTLSVersion != nil && !supportedMinimumTLSVersions.Has(string(*config.MinimumTLSVersion)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("minimumTlsVersion"), *config.MinimumTLSVersion, sets.List(supportedMinimumTLSVersions)))
+	}
+	if config.Lifecycle != nil {
+		allErrs = append(allErrs, validateBackupBucketLifecycle(config.Lifecycle, fldPath.Child("lifecycle"))...)
+	}
+	if config.LegalHold != nil {
+		allErrs = append(allErrs, validateBackupBucketLegalHold(config.LegalHold, fldPath.Child("legalHold"))...)
+		// backups under legal hold cannot be deleted, the lifecycle management policy would fail to delete them.
+		if len(config.LegalHold.Tags) > 0 && config.Lifecycle != nil && config.Lifecycle.DeleteAfterDays != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("lifecycle", "deleteAfterDays"), "must not be set if the backup container is under legal hold"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateBackupBucketConfigUpdate validates a BackupBucketConfig object of a backup bucket in the given region before
+// an update.
+func ValidateBackupBucketConfigUpdate(oldConfig, newConfig *apisazure.BackupBucketConfig, region string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// The infrastructure encryption can only be configured when the storage account is created.
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(requireInfrastructureEncryption(newConfig), requireInfrastructureEncryption(oldConfig), fldPath.Child("requireInfrastructureEncryption"))...)
+	// Azure cannot move a resource group to another region.
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(resourceGroupRegion(newConfig, region), resourceGroupRegion(oldConfig, region), fldPath.Child("resourceGroupRegion"))...)
+
+	return allErrs
+}
+
+func requireInfrastructureEncryption(config *apisazure.BackupBucketConfig) bool {
+	return config != nil && ptr.Deref(config.RequireInfrastructureEncryption, false)
+}
+
+func resourceGroupRegion(config *apisazure.BackupBucketConfig, region string) string {
+	if config == nil {
+		return region
+	}
+	return ptr.Deref(config.ResourceGroupRegion, region)
+}
+
+// legalHoldTagRegex matches the tags of legal holds. They consist of 3 to 23 alphanumeric characters.
+var legalHoldTagRegex = regexp.MustCompile(`^[a-zA-Z0-9]{3,23}$`)
+
+func validateBackupBucketLegalHold(legalHold *apisazure.BackupBucketLegalHold, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	tags := sets.New[string]()
+	for i, tag := range legalHold.Tags {
+		idxPath := fldPath.Child("tags").Index(i)
+		if !legalHoldTagRegex.MatchString(tag) {
+			allErrs = append(allErrs, field.Invalid(idxPath, tag, "must consist of 3 to 23 alphanumeric characters"))
+			continue
+		}
+		// Azure normalizes the tags to lower case.
+		if tags.Has(strings.ToLower(tag)) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, tag))
+		}
+		tags.Insert(strings.ToLower(tag))
+	}
+
+	return allErrs
+}
+
+var supportedMinimumTLSVersions = sets.New(
+	string(apisazure.MinimumTLSVersionTLS12),
+	string(apisazure.MinimumTLSVersionTLS13),
+)
+
+func validateBackupBucketLifecycle(lifecycle *apisazure.BackupBucketLifecycle, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if days := lifecycle.TierToColdAfterDays; days != nil && *days < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tierToColdAfterDays"), *days, "must be at least 1"))
+	}
+	if days := lifecycle.DeleteAfterDays; days != nil {
+		if *days < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deleteAfterDays"), *days, "must be at least 1"))
+		} else if coldDays := lifecycle.TierToColdAfterDays; coldDays != nil && *days <= *coldDays {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deleteAfterDays"), *days, "must be greater than tierToColdAfterDays"))
+		}
+	}
+
+	return allErrs
+}
+
+// containerNameRegex matches the names of blob containers. They consist of lower case letters, digits and single
+// hyphens and must start and end with a letter or digit.
+var containerNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9]|-[a-z0-9])*$`)
+
+func validateContainerName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(name) < 3 || len(name) > 63 {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "must be between 3 and 63 characters long"))
+	}
+	if !containerNameRegex.MatchString(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "must only consist of lower case letters, digits and single hyphens and must start and end with a letter or digit"))
+	}
+
+	return allErrs
+}
+
+// minSASTokenValidity is the minimum validity of the SAS tokens in the generated backup secret. It leaves enough time
+// to renew the token, even if the clocks of the seed and the storage service are out of sync.
+const minSASTokenValidity = time.Hour
+
+var supportedRotationModes = sets.New(
+	string(apisazure.RotationModeKey),
+	string(apisazure.RotationModeSAS),
+)
+
+func validateRotationConfig(rotation *apisazure.RotationConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rotation.Mode != "" && !supportedRotationModes.Has(string(rotation.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), rotation.Mode, sets.List(supportedRotationModes)))
+	}
+	if validity := rotation.SASTokenValidity; validity != nil {
+		validityPath := fldPath.Child("sasTokenValidity")
+		if rotation.Mode != apisazure.RotationModeSAS {
+			allErrs = append(allErrs, field.Forbidden(validityPath, fmt.Sprintf("can only be configured in mode %s", apisazure.RotationModeSAS)))
+		} else if validity.Duration < minSASTokenValidity {
+			allErrs = append(allErrs, field.Invalid(validityPath, validity.Duration.String(), fmt.Sprintf("must be at least %s", minSASTokenValidity)))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedNetworkACLDefaultActions = sets.New(
+	string(apisazure.NetworkACLDefaultActionAllow),
+	string(apisazure.NetworkACLDefaultActionDeny),
+)
+
+func validateBackupBucketNetworkACLs(acls *apisazure.BackupBucketNetworkACLs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedNetworkACLDefaultActions.Has(string(acls.DefaultAction)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("defaultAction"), acls.DefaultAction, sets.List(supportedNetworkACLDefaultActions)))
+	}
+
+	ipRules := sets.New[string]()
+	for i, rule := range acls.IPRules {
+		rulePath := fldPath.Child("ipRules").Index(i)
+		if ipRules.Has(rule) {
+			allErrs = append(allErrs, field.Duplicate(rulePath, rule))
+			continue
+		}
+		ipRules.Insert(rule)
+		allErrs = append(allErrs, validateStorageAccountIPRule(rule, rulePath)...)
+	}
+
+	subnetIDs := sets.New[string]()
+	for i, subnetID := range acls.VirtualNetworkRules {
+		rulePath := fldPath.Child("virtualNetworkRules").Index(i)
+		if subnetIDs.Has(strings.ToLower(subnetID)) {
+			allErrs = append(allErrs, field.Duplicate(rulePath, subnetID))
+			continue
+		}
+		subnetIDs.Insert(strings.ToLower(subnetID))
+		if resourceID, err := arm.ParseResourceID(subnetID); err != nil || !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/virtualNetworks/subnets") {
+			allErrs = append(allErrs, field.Invalid(rulePath, subnetID, "must be the resource ID of a subnet"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateStorageAccountIPRule validates an IP rule of a storage account. Azure only supports public IPv4 addresses and
+// CIDR ranges with a prefix of at most 30 bits, smaller ranges must be configured as individual addresses.
+func validateStorageAccountIPRule(rule string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var ip net.IP
+	if strings.Contains(rule, "/") {
+		cidrIP, ipNet, err := net.ParseCIDR(rule)
+		if err != nil || cidrIP.To4() == nil {
+			return append(allErrs, field.Invalid(fldPath, rule, "must be an IPv4 address or CIDR range"))
+		}
+		if ones, _ := ipNet.Mask.Size(); ones > 30 {
+			allErrs = append(allErrs, field.Invalid(fldPath, rule, "CIDR ranges with a prefix larger than /30 are not supported, use individual IP addresses instead"))
+		}
+		ip = cidrIP
+	} else {
+		ip = net.ParseIP(rule)
+		if ip == nil || ip.To4() == nil {
+			return append(allErrs, field.Invalid(fldPath, rule, "must be an IPv4 address or CIDR range"))
+		}
+	}
+
+	if ip.IsPrivate() || ip.IsLoopback() {
+		allErrs = append(allErrs, field.Invalid(fldPath, rule, "must be a public IP address or CIDR range"))
+	}
+
+	return allErrs
+}
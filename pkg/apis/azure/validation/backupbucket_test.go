@@ -0,0 +1,349 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var (
+		config  *apisazure.BackupBucketConfig
+		fldPath = field.NewPath("providerConfig")
+	)
+
+	BeforeEach(func() {
+		config = &apisazure.BackupBucketConfig{
+			ResourceGroupRegion: ptr.To("westeurope"),
+		}
+	})
+
+	It("should allow a valid configuration", func() {
+		Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow an empty configuration", func() {
+		Expect(ValidateBackupBucketConfig(&apisazure.BackupBucketConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an empty resource group region", func() {
+		config.ResourceGroupRegion = ptr.To("")
+
+		Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.resourceGroupRegion"),
+		}))))
+	})
+
+	It("should validate the cloud configuration", func() {
+		config.CloudConfiguration = &apisazure.CloudConfiguration{Name: apisazure.AzureStackCloudName}
+
+		Expect(ValidateBackupBucketConfig(config, fldPath)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.cloudConfiguration.storageEndpointSuffix"),
+		}))))
+	})
+
+	It("should forbid an incomplete credentials secret reference", func() {
+		config.CredentialsSecretRef = &corev1.SecretReference{}
+
+		Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.credentialsSecretRef.name"),
+		})), PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.credentialsSecretRef.namespace"),
+		}))))
+	})
+
+	Context("networkAcls", func() {
+		BeforeEach(func() {
+			config.NetworkACLs = &apisazure.BackupBucketNetworkACLs{
+				DefaultAction:       apisazure.NetworkACLDefaultActionDeny,
+				IPRules:             []string{"20.30.40.50", "20.30.41.0/24"},
+				VirtualNetworkRules: []string{"/subscriptions/sub/resourceGroups/group/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet"},
+			}
+		})
+
+		It("should allow valid network rules", func() {
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an unsupported default action", func() {
+			config.NetworkACLs.DefaultAction = "Foo"
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.networkAcls.defaultAction"),
+			}))))
+		})
+
+		It("should forbid invalid IP rules", func() {
+			config.NetworkACLs.IPRules = []string{"foo", "2001:db8::1", "20.30.40.50/31", "10.0.0.0/8", "20.30.40.50", "20.30.40.50"}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.networkAcls.ipRules[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.networkAcls.ipRules[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.networkAcls.ipRules[2]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.networkAcls.ipRules[3]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.networkAcls.ipRules[5]"),
+			}))))
+		})
+
+		It("should forbid invalid virtual network rules", func() {
+			config.NetworkACLs.VirtualNetworkRules = []string{
+				"/subscriptions/sub/resourceGroups/group/providers/Microsoft.Network/virtualNetworks/vnet",
+				"/subscriptions/sub/resourceGroups/group/providers/Microsoft.Network/virtualNetworks/vnet/subnets/subnet",
+				"/subscriptions/sub/resourceGroups/group/providers/Microsoft.Network/virtualNetworks/VNet/subnets/subnet",
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.networkAcls.virtualNetworkRules[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.networkAcls.virtualNetworkRules[2]"),
+			}))))
+		})
+	})
+
+	Context("rotation", func() {
+		It("should allow the sas mode with a validity", func() {
+			config.Rotation = &apisazure.RotationConfig{
+				Mode:             apisazure.RotationModeSAS,
+				SASTokenValidity: &metav1.Duration{Duration: 24 * time.Hour},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an unsupported mode", func() {
+			config.Rotation = &apisazure.RotationConfig{Mode: "foo"}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.rotation.mode"),
+			}))))
+		})
+
+		It("should forbid a validity in mode key", func() {
+			config.Rotation = &apisazure.RotationConfig{
+				Mode:             apisazure.RotationModeKey,
+				SASTokenValidity: &metav1.Duration{Duration: 24 * time.Hour},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.rotation.sasTokenValidity"),
+			}))))
+		})
+
+		It("should forbid a too short validity", func() {
+			config.Rotation = &apisazure.RotationConfig{
+				Mode:             apisazure.RotationModeSAS,
+				SASTokenValidity: &metav1.Duration{Duration: 30 * time.Minute},
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.rotation.sasTokenValidity"),
+			}))))
+		})
+	})
+
+	Context("inventory", func() {
+		It("should allow an inventory without container", func() {
+			config.Inventory = &apisazure.BackupBucketInventory{}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a valid container name", func() {
+			config.Inventory = &apisazure.BackupBucketInventory{Container: ptr.To("backup-inventory")}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		DescribeTable("should forbid invalid container names",
+			func(name string) {
+				config.Inventory = &apisazure.BackupBucketInventory{Container: ptr.To(name)}
+
+				Expect(ValidateBackupBucketConfig(config, fldPath)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.inventory.container"),
+				}))))
+			},
+			Entry("too short", "ab"),
+			Entry("upper case", "Inventory"),
+			Entry("consecutive hyphens", "backup--inventory"),
+			Entry("trailing hyphen", "inventory-"),
+		)
+	})
+
+	Context("minimumTlsVersion", func() {
+		It("should allow a supported minimum TLS version", func() {
+			config.MinimumTLSVersion = ptr.To(apisazure.MinimumTLSVersionTLS13)
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an unsupported minimum TLS version", func() {
+			config.MinimumTLSVersion = ptr.To(apisazure.MinimumTLSVersion("TLS1_0"))
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.minimumTlsVersion"),
+			}))))
+		})
+	})
+
+	Context("lifecycle", func() {
+		It("should allow tiering and deletion", func() {
+			config.Lifecycle = &apisazure.BackupBucketLifecycle{
+				TierToColdAfterDays: ptr.To[int32](30),
+				DeleteAfterDays:     ptr.To[int32](365),
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a lifecycle without rules", func() {
+			config.Lifecycle = &apisazure.BackupBucketLifecycle{}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid non-positive days", func() {
+			config.Lifecycle = &apisazure.BackupBucketLifecycle{
+				TierToColdAfterDays: ptr.To[int32](0),
+				DeleteAfterDays:     ptr.To[int32](-1),
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.lifecycle.tierToColdAfterDays"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.lifecycle.deleteAfterDays"),
+				})),
+			))
+		})
+
+		It("should forbid deleting backups before they are moved to the cold tier", func() {
+			config.Lifecycle = &apisazure.BackupBucketLifecycle{
+				TierToColdAfterDays: ptr.To[int32](30),
+				DeleteAfterDays:     ptr.To[int32](30),
+			}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("providerConfig.lifecycle.deleteAfterDays"),
+				"Detail": Equal("must be greater than tierToColdAfterDays"),
+			}))))
+		})
+	})
+
+	Context("legal hold", func() {
+		It("should allow a legal hold with tiering", func() {
+			config.LegalHold = &apisazure.BackupBucketLegalHold{Tags: []string{"case123", "Audit2024"}}
+			config.Lifecycle = &apisazure.BackupBucketLifecycle{TierToColdAfterDays: ptr.To[int32](30)}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a legal hold without tags with deletion", func() {
+			config.LegalHold = &apisazure.BackupBucketLegalHold{}
+			config.Lifecycle = &apisazure.BackupBucketLifecycle{DeleteAfterDays: ptr.To[int32](30)}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid and duplicate tags", func() {
+			config.LegalHold = &apisazure.BackupBucketLegalHold{Tags: []string{"ab", "case-123", "case123", "CASE123"}}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.legalHold.tags[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.legalHold.tags[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("providerConfig.legalHold.tags[3]"),
+				})),
+			))
+		})
+
+		It("should forbid deleting backups under legal hold", func() {
+			config.LegalHold = &apisazure.BackupBucketLegalHold{Tags: []string{"case123"}}
+			config.Lifecycle = &apisazure.BackupBucketLifecycle{DeleteAfterDays: ptr.To[int32](30)}
+
+			Expect(ValidateBackupBucketConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.lifecycle.deleteAfterDays"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateBackupBucketConfigUpdate", func() {
+		It("should allow updates which keep the infrastructure encryption", func() {
+			oldConfig := &apisazure.BackupBucketConfig{RequireInfrastructureEncryption: ptr.To(false)}
+			newConfig := &apisazure.BackupBucketConfig{MinimumTLSVersion: ptr.To(apisazure.MinimumTLSVersionTLS13)}
+
+			Expect(ValidateBackupBucketConfigUpdate(oldConfig, newConfig, "westeurope", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the infrastructure encryption", func() {
+			oldConfig := &apisazure.BackupBucketConfig{}
+			newConfig := &apisazure.BackupBucketConfig{RequireInfrastructureEncryption: ptr.To(true)}
+
+			Expect(ValidateBackupBucketConfigUpdate(oldConfig, newConfig, "westeurope", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.requireInfrastructureEncryption"),
+			}))))
+		})
+
+		It("should allow setting the resource group region to the region of the bucket", func() {
+			oldConfig := &apisazure.BackupBucketConfig{}
+			newConfig := &apisazure.BackupBucketConfig{ResourceGroupRegion: ptr.To("westeurope")}
+
+			Expect(ValidateBackupBucketConfigUpdate(oldConfig, newConfig, "westeurope", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the resource group region", func() {
+			oldConfig := &apisazure.BackupBucketConfig{ResourceGroupRegion: ptr.To("northeurope")}
+
+			Expect(ValidateBackupBucketConfigUpdate(oldConfig, &apisazure.BackupBucketConfig{}, "westeurope", fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.resourceGroupRegion"),
+			}))))
+		})
+	})
+})
@@ -6,10 +6,12 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"k8s.io/utils/strings/slices"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
@@ -31,6 +33,92 @@ func ValidateCloudProfileConfig(cloudProfile *apisazure.CloudProfileConfig, fldP
 		allErrs = append(allErrs, ValidateMachineImage(idxPath, machineImage)...)
 	}
 
+	for i, machineType := range cloudProfile.MachineTypes {
+		allErrs = append(allErrs, validateMachineType(machineType, fldPath.Child("machineTypes").Index(i))...)
+	}
+
+	if cloudProfile.CloudConfiguration != nil {
+		allErrs = append(allErrs, validateCloudConfiguration(cloudProfile.CloudConfiguration, fldPath.Child("cloudConfiguration"))...)
+	}
+
+	return allErrs
+}
+
+func validateCloudConfiguration(cloudConfiguration *apisazure.CloudConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Cloud names are matched case-insensitively when the Azure clients are configured.
+	supportedNames := []string{apisazure.AzurePublicCloudName, apisazure.AzureGovCloudName, apisazure.AzureChinaCloudName, apisazure.AzureStackCloudName}
+	supported := false
+	for _, name := range supportedNames {
+		supported = supported || strings.EqualFold(name, cloudConfiguration.Name)
+	}
+	if !supported {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("name"), cloudConfiguration.Name, supportedNames))
+	}
+	isAzureStack := strings.EqualFold(cloudConfiguration.Name, apisazure.AzureStackCloudName)
+
+	for _, endpoint := range []struct {
+		name     string
+		value    *string
+		required bool
+		isURL    bool
+	}{
+		{name: "activeDirectoryAuthorityHost", value: cloudConfiguration.ActiveDirectoryAuthorityHost, required: true, isURL: true},
+		{name: "resourceManagerEndpoint", value: cloudConfiguration.ResourceManagerEndpoint, required: true, isURL: true},
+		{name: "resourceManagerAudience", value: cloudConfiguration.ResourceManagerAudience},
+		{name: "storageEndpointSuffix", value: cloudConfiguration.StorageEndpointSuffix, required: true},
+	} {
+		switch {
+		case !isAzureStack && endpoint.value != nil:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(endpoint.name), fmt.Sprintf("endpoints can only be configured for %q", apisazure.AzureStackCloudName)))
+		case isAzureStack && endpoint.required && len(ptr.Deref(endpoint.value, "")) == 0:
+			allErrs = append(allErrs, field.Required(fldPath.Child(endpoint.name), fmt.Sprintf("must be configured for %q", apisazure.AzureStackCloudName)))
+		case endpoint.isURL && endpoint.value != nil:
+			allErrs = append(allErrs, validateHTTPSURL(*endpoint.value, fldPath.Child(endpoint.name))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateHTTPSURL(value string, fldPath *field.Path) field.ErrorList {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be a valid https URL")}
+	}
+	return nil
+}
+
+func validateMachineType(machineType apisazure.MachineType, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(machineType.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must provide a name"))
+	}
+
+	if machineType.DiskControllerType != nil {
+		supportedDiskControllerTypes := []string{string(apisazure.DiskControllerTypeSCSI), string(apisazure.DiskControllerTypeNVMe)}
+		if !slices.Contains(supportedDiskControllerTypes, string(*machineType.DiskControllerType)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("diskControllerType"), *machineType.DiskControllerType, supportedDiskControllerTypes))
+		}
+	}
+
+	for name, value := range machineType.Capacity {
+		allErrs = append(allErrs, validateResourceQuantityValue(name, value, fldPath.Child("capacity", string(name)))...)
+	}
+
+	supportedCSIDrivers := []string{apisazure.CSIDriverDisk}
+	for driver, limit := range machineType.AttachLimits {
+		attachLimitPath := fldPath.Child("attachLimits").Key(driver)
+		if !slices.Contains(supportedCSIDrivers, driver) {
+			allErrs = append(allErrs, field.NotSupported(attachLimitPath, driver, supportedCSIDrivers))
+		}
+		if limit <= 0 {
+			allErrs = append(allErrs, field.Invalid(attachLimitPath, limit, "must be greater than 0"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -88,6 +176,10 @@ func ValidateMachineImage(validationPath *field.Path, machineImage apisazure.Mac
 			}
 		}
 
+		if size := version.MinimumOSDiskSizeGB; size != nil && *size < 1 {
+			allErrs = append(allErrs, field.Invalid(jdxPath.Child("minimumOSDiskSizeGB"), *size, "must be at least 1"))
+		}
+
 		if !slices.Contains(v1beta1constants.ValidArchitectures, *version.Architecture) {
 			allErrs = append(allErrs, field.NotSupported(jdxPath.Child("architecture"), *version.Architecture, v1beta1constants.ValidArchitectures))
 		}
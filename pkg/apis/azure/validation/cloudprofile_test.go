@@ -9,6 +9,8 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -294,6 +296,17 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					"Field": Equal("root.machineImages[0].versions[0]"),
 				}))))
 			})
+
+			It("should forbid non-positive minimum OS disk sizes", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].MinimumOSDiskSizeGB = ptr.To[int32](0)
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineImages[0].versions[0].minimumOSDiskSizeGB"),
+				}))))
+			})
 		})
 
 		Context("fault domain count validation", func() {
@@ -359,5 +372,125 @@ var _ = Describe("CloudProfileConfig validation", func() {
 				}))))
 			})
 		})
+
+		Context("machine type validation", func() {
+			It("should allow machine types with supported disk controller types", func() {
+				cloudProfileConfig.MachineTypes = []apisazure.MachineType{
+					{Name: "Standard_D2as_v6", DiskControllerType: ptr.To(apisazure.DiskControllerTypeNVMe)},
+					{Name: "Standard_D2as_v5", DiskControllerType: ptr.To(apisazure.DiskControllerTypeSCSI)},
+					{Name: "Standard_D2as_v4"},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, root)).To(BeEmpty())
+			})
+
+			It("should forbid machine types without name or with unsupported disk controller type", func() {
+				cloudProfileConfig.MachineTypes = []apisazure.MachineType{
+					{DiskControllerType: ptr.To(apisazure.DiskControllerType("IDE"))},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.machineTypes[0].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineTypes[0].diskControllerType"),
+				}))))
+			})
+
+			It("should forbid negative capacities", func() {
+				cloudProfileConfig.MachineTypes = []apisazure.MachineType{
+					{Name: "Standard_NC4as_T4_v3", Capacity: corev1.ResourceList{
+						"nvidia.com/gpu":                resource.MustParse("1"),
+						corev1.ResourceEphemeralStorage: resource.MustParse("-1Gi"),
+					}},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, root)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineTypes[0].capacity.ephemeral-storage"),
+				}))))
+			})
+
+			It("should allow attach limits for the disk CSI driver", func() {
+				cloudProfileConfig.MachineTypes = []apisazure.MachineType{
+					{Name: "Standard_D4s_v5", AttachLimits: map[string]int32{apisazure.CSIDriverDisk: 8}},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, root)).To(BeEmpty())
+			})
+
+			It("should forbid attach limits for unknown CSI drivers or with non-positive values", func() {
+				cloudProfileConfig.MachineTypes = []apisazure.MachineType{
+					{Name: "Standard_D4s_v5", AttachLimits: map[string]int32{
+						apisazure.CSIDriverDisk: 0,
+						"file.csi.azure.com":    4,
+					}},
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, root)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineTypes[0].attachLimits[disk.csi.azure.com]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineTypes[0].attachLimits[file.csi.azure.com]"),
+				}))))
+			})
+		})
+
+		Context("cloud configuration validation", func() {
+			It("should allow well-known clouds", func() {
+				cloudProfileConfig.CloudConfiguration = &apisazure.CloudConfiguration{Name: "AzureChina"}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, root)).To(BeEmpty())
+			})
+
+			It("should allow Azure Stack Hub with custom endpoints", func() {
+				cloudProfileConfig.CloudConfiguration = &apisazure.CloudConfiguration{
+					Name:                         apisazure.AzureStackCloudName,
+					ActiveDirectoryAuthorityHost: ptr.To("https://adfs.local.azurestack.external/"),
+					ResourceManagerEndpoint:      ptr.To("https://management.local.azurestack.external/"),
+					ResourceManagerAudience:      ptr.To("https://management.adfs.azurestack.local/00000000-0000-0000-0000-000000000000"),
+					StorageEndpointSuffix:        ptr.To("local.azurestack.external"),
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, root)).To(BeEmpty())
+			})
+
+			It("should forbid unknown clouds and endpoints for well-known clouds", func() {
+				cloudProfileConfig.CloudConfiguration = &apisazure.CloudConfiguration{
+					Name:                    "AzureMoon",
+					ResourceManagerEndpoint: ptr.To("https://management.local.azurestack.external/"),
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, root)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.cloudConfiguration.name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("root.cloudConfiguration.resourceManagerEndpoint"),
+				}))))
+			})
+
+			It("should require valid endpoints for Azure Stack Hub", func() {
+				cloudProfileConfig.CloudConfiguration = &apisazure.CloudConfiguration{
+					Name:                         apisazure.AzureStackCloudName,
+					ActiveDirectoryAuthorityHost: ptr.To("http://adfs.local.azurestack.external/"),
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, root)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.cloudConfiguration.activeDirectoryAuthorityHost"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.cloudConfiguration.resourceManagerEndpoint"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.cloudConfiguration.storageEndpointSuffix"),
+				}))))
+			})
+		})
 	})
 })
@@ -5,18 +5,213 @@
 package validation
 
 import (
+	"fmt"
+	"strings"
+
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 )
 
+const (
+	outboundRuleMaxAllocatedOutboundPorts int32 = 64000
+	outboundRuleMinIdleTimeoutInMinutes   int32 = 4
+	outboundRuleMaxIdleTimeoutInMinutes   int32 = 100
+)
+
+var supportedLoadBalancerSKUs = []string{
+	string(apisazure.LoadBalancerSKUBasic),
+	string(apisazure.LoadBalancerSKUStandard),
+}
+
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
-func ValidateControlPlaneConfig(controlPlaneConfig *apisazure.ControlPlaneConfig, version string, fldPath *field.Path) field.ErrorList {
+func ValidateControlPlaneConfig(controlPlaneConfig *apisazure.ControlPlaneConfig, infraConfig *apisazure.InfrastructureConfig, version string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if controlPlaneConfig.CloudControllerManager != nil {
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+
+		if lb := controlPlaneConfig.CloudControllerManager.LoadBalancer; lb != nil {
+			allErrs = append(allErrs, validateLoadBalancerConfig(lb, infraConfig, fldPath.Child("cloudControllerManager", "loadBalancer"))...)
+		}
+
+		ccm := controlPlaneConfig.CloudControllerManager
+		allErrs = append(allErrs, validateComponentSettings(ccm.Resources, ccm.LeaderElection, ccm.Verbosity, fldPath.Child("cloudControllerManager"))...)
+	}
+
+	if csi := controlPlaneConfig.CSIDriverController; csi != nil {
+		allErrs = append(allErrs, validateComponentSettings(csi.Resources, csi.LeaderElection, csi.Verbosity, fldPath.Child("csiDriverController"))...)
+	}
+
+	if controlPlaneConfig.Storage != nil && controlPlaneConfig.Storage.VolumeSnapshotClass != nil {
+		allErrs = append(allErrs, validateVolumeSnapshotClassConfig(controlPlaneConfig.Storage.VolumeSnapshotClass, fldPath.Child("storage", "volumeSnapshotClass"))...)
+	}
+
+	if controlPlaneConfig.Remedy != nil {
+		allErrs = append(allErrs, validateRemedyConfig(controlPlaneConfig.Remedy, fldPath.Child("remedy"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateControlPlaneConfigUpdate validates a ControlPlaneConfig object before an update.
+func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *apisazure.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// The SKU of existing load balancers cannot be changed, the cloud-controller-manager would fail to reconcile them.
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(loadBalancerSKU(newConfig), loadBalancerSKU(oldConfig), fldPath.Child("cloudControllerManager", "loadBalancer", "sku"))...)
+
+	return allErrs
+}
+
+func loadBalancerSKU(config *apisazure.ControlPlaneConfig) *apisazure.LoadBalancerSKU {
+	if config == nil || config.CloudControllerManager == nil || config.CloudControllerManager.LoadBalancer == nil {
+		return nil
+	}
+	return config.CloudControllerManager.LoadBalancer.SKU
+}
+
+func validateVolumeSnapshotClassConfig(config *apisazure.VolumeSnapshotClassConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.ResourceGroup != nil && *config.ResourceGroup == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceGroup"), *config.ResourceGroup, "resource group must not be empty"))
+	}
+
+	// tags are passed to the CSI driver as a comma separated list of key=value pairs.
+	tagsPath := fldPath.Child("tags")
+	for key, value := range config.Tags {
+		if key == "" || strings.ContainsAny(key, ",=") {
+			allErrs = append(allErrs, field.Invalid(tagsPath, key, "tag key must not be empty and must not contain ',' or '='"))
+		}
+		if strings.ContainsAny(value, ",=") {
+			allErrs = append(allErrs, field.Invalid(tagsPath.Key(key), value, "tag value must not contain ',' or '='"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateRemedyConfig(remedy *apisazure.RemedyConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ip := remedy.OrphanedPublicIPRemedy; ip != nil {
+		ipPath := fldPath.Child("orphanedPublicIPRemedy")
+		allErrs = append(allErrs, validatePositiveDuration(ip.RequeueInterval, ipPath.Child("requeueInterval"))...)
+		allErrs = append(allErrs, validatePositiveDuration(ip.SyncPeriod, ipPath.Child("syncPeriod"))...)
+		allErrs = append(allErrs, validatePositiveDuration(ip.DeletionGracePeriod, ipPath.Child("deletionGracePeriod"))...)
+		allErrs = append(allErrs, validatePositiveAttempts(ip.MaxGetAttempts, ipPath.Child("maxGetAttempts"))...)
+		allErrs = append(allErrs, validatePositiveAttempts(ip.MaxCleanAttempts, ipPath.Child("maxCleanAttempts"))...)
+	}
+
+	if vm := remedy.FailedVMRemedy; vm != nil {
+		vmPath := fldPath.Child("failedVMRemedy")
+		allErrs = append(allErrs, validatePositiveDuration(vm.RequeueInterval, vmPath.Child("requeueInterval"))...)
+		allErrs = append(allErrs, validatePositiveDuration(vm.SyncPeriod, vmPath.Child("syncPeriod"))...)
+		allErrs = append(allErrs, validatePositiveAttempts(vm.MaxGetAttempts, vmPath.Child("maxGetAttempts"))...)
+		allErrs = append(allErrs, validatePositiveAttempts(vm.MaxReapplyAttempts, vmPath.Child("maxReapplyAttempts"))...)
+	}
+
+	return allErrs
+}
+
+// validateComponentSettings validates the resources, the leader election and the verbosity of a control plane component.
+func validateComponentSettings(resources *corev1.ResourceRequirements, leaderElection *apisazure.LeaderElectionConfig, verbosity *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if resources != nil {
+		allErrs = append(allErrs, validateResourceRequirements(resources, fldPath.Child("resources"))...)
+	}
+
+	if leaderElection != nil {
+		allErrs = append(allErrs, validateLeaderElectionConfig(leaderElection, fldPath.Child("leaderElection"))...)
+	}
+
+	if verbosity != nil && *verbosity < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("verbosity"), *verbosity, "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateResourceRequirements(resources *corev1.ResourceRequirements, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, value := range resources.Limits {
+		allErrs = append(allErrs, validateResourceQuantityValue(name, value, fldPath.Child("limits").Key(string(name)))...)
+	}
+	for name, value := range resources.Requests {
+		requestPath := fldPath.Child("requests").Key(string(name))
+		allErrs = append(allErrs, validateResourceQuantityValue(name, value, requestPath)...)
+		if limit, ok := resources.Limits[name]; ok && value.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(requestPath, value.String(), fmt.Sprintf("must be less than or equal to %s limit of %s", name, limit.String())))
+		}
+	}
+
+	return allErrs
+}
+
+func validateLeaderElectionConfig(leaderElection *apisazure.LeaderElectionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validatePositiveDuration(leaderElection.LeaseDuration, fldPath.Child("leaseDuration"))...)
+	allErrs = append(allErrs, validatePositiveDuration(leaderElection.RenewDeadline, fldPath.Child("renewDeadline"))...)
+	allErrs = append(allErrs, validatePositiveDuration(leaderElection.RetryPeriod, fldPath.Child("retryPeriod"))...)
+
+	if leaderElection.LeaseDuration != nil && leaderElection.RenewDeadline != nil && leaderElection.RenewDeadline.Duration >= leaderElection.LeaseDuration.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("renewDeadline"), leaderElection.RenewDeadline.Duration.String(), "must be less than the lease duration"))
+	}
+	if leaderElection.RenewDeadline != nil && leaderElection.RetryPeriod != nil && leaderElection.RetryPeriod.Duration >= leaderElection.RenewDeadline.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retryPeriod"), leaderElection.RetryPeriod.Duration.String(), "must be less than the renew deadline"))
+	}
+
+	return allErrs
+}
+
+func validatePositiveDuration(duration *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if duration != nil && duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration.Duration.String(), "must be a positive duration"))
+	}
+	return allErrs
+}
+
+func validatePositiveAttempts(attempts *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if attempts != nil && *attempts < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *attempts, "must be at least 1"))
+	}
+	return allErrs
+}
+
+func validateLoadBalancerConfig(lb *apisazure.LoadBalancerConfig, infraConfig *apisazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if lb.SKU != nil {
+		skuPath := fldPath.Child("sku")
+		switch *lb.SKU {
+		case apisazure.LoadBalancerSKUStandard:
+		case apisazure.LoadBalancerSKUBasic:
+			if infraConfig != nil && infraConfig.Zoned {
+				allErrs = append(allErrs, field.Forbidden(skuPath, "basic load balancers are not supported for zoned clusters"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(skuPath, *lb.SKU, supportedLoadBalancerSKUs))
+		}
+	}
+
+	if rule := lb.OutboundRule; rule != nil {
+		rulePath := fldPath.Child("outboundRule")
+		if ports := rule.AllocatedOutboundPorts; ports != nil && (*ports < 0 || *ports > outboundRuleMaxAllocatedOutboundPorts || *ports%8 != 0) {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("allocatedOutboundPorts"), *ports, "allocated outbound ports must be a multiple of 8 between 0 and 64000"))
+		}
+		if timeout := rule.IdleTimeoutInMinutes; timeout != nil && (*timeout < outboundRuleMinIdleTimeoutInMinutes || *timeout > outboundRuleMaxIdleTimeoutInMinutes) {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("idleTimeoutInMinutes"), *timeout, "idle timeout must be between 4 and 100 minutes"))
+		}
 	}
 
 	return allErrs
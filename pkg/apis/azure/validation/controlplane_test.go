@@ -5,10 +5,16 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
@@ -17,16 +23,18 @@ import (
 var _ = Describe("ControlPlaneConfig validation", func() {
 	var (
 		controlPlane *apisazure.ControlPlaneConfig
+		infraConfig  *apisazure.InfrastructureConfig
 		fldPath      *field.Path
 	)
 
 	BeforeEach(func() {
 		controlPlane = &apisazure.ControlPlaneConfig{}
+		infraConfig = &apisazure.InfrastructureConfig{Zoned: true}
 	})
 
 	Describe("#ValidateControlPlaneConfig", func() {
 		It("should return no errors for a valid configuration", func() {
-			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+			Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", fldPath)).To(BeEmpty())
 		})
 
 		It("should fail with invalid CCM feature gates", func() {
@@ -37,7 +45,7 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				},
 			}
 
-			errorList := ValidateControlPlaneConfig(controlPlane, "1.28.2", fldPath)
+			errorList := ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)
 
 			Expect(errorList).To(ConsistOf(
 				PointTo(MatchFields(IgnoreExtras, Fields{
@@ -46,5 +54,272 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				})),
 			))
 		})
+
+		Context("load balancer", func() {
+			It("should allow a valid load balancer configuration", func() {
+				controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+					LoadBalancer: &apisazure.LoadBalancerConfig{
+						SKU: ptr.To(apisazure.LoadBalancerSKUStandard),
+						OutboundRule: &apisazure.OutboundRuleConfig{
+							AllocatedOutboundPorts: ptr.To[int32](1024),
+							IdleTimeoutInMinutes:   ptr.To[int32](30),
+						},
+						DisableOutboundSNAT: ptr.To(true),
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(BeEmpty())
+			})
+
+			It("should allow the basic SKU for non-zoned clusters", func() {
+				infraConfig.Zoned = false
+				controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+					LoadBalancer: &apisazure.LoadBalancerConfig{SKU: ptr.To(apisazure.LoadBalancerSKUBasic)},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid the basic SKU for zoned clusters", func() {
+				controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+					LoadBalancer: &apisazure.LoadBalancerConfig{SKU: ptr.To(apisazure.LoadBalancerSKUBasic)},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("cloudControllerManager.loadBalancer.sku"),
+					})),
+				))
+			})
+
+			It("should forbid unknown SKUs and invalid outbound rules", func() {
+				controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+					LoadBalancer: &apisazure.LoadBalancerConfig{
+						SKU: ptr.To(apisazure.LoadBalancerSKU("Gateway")),
+						OutboundRule: &apisazure.OutboundRuleConfig{
+							AllocatedOutboundPorts: ptr.To[int32](1001),
+							IdleTimeoutInMinutes:   ptr.To[int32](120),
+						},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("cloudControllerManager.loadBalancer.sku"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cloudControllerManager.loadBalancer.outboundRule.allocatedOutboundPorts"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cloudControllerManager.loadBalancer.outboundRule.idleTimeoutInMinutes"),
+					})),
+				))
+			})
+		})
+
+		Context("volume snapshot class", func() {
+			It("should allow a valid volume snapshot class configuration", func() {
+				controlPlane.Storage = &apisazure.Storage{
+					VolumeSnapshotClass: &apisazure.VolumeSnapshotClassConfig{
+						Incremental:   ptr.To(true),
+						ResourceGroup: ptr.To("snapshots"),
+						Tags:          map[string]string{"team": "storage"},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid an empty resource group and invalid tags", func() {
+				controlPlane.Storage = &apisazure.Storage{
+					VolumeSnapshotClass: &apisazure.VolumeSnapshotClassConfig{
+						ResourceGroup: ptr.To(""),
+						Tags:          map[string]string{"a=b": "c", "d": "e,f"},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("storage.volumeSnapshotClass.resourceGroup"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("storage.volumeSnapshotClass.tags"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("storage.volumeSnapshotClass.tags[d]"),
+					})),
+				))
+			})
+		})
+
+		Context("remedy", func() {
+			It("should allow a valid remedy configuration", func() {
+				controlPlane.Remedy = &apisazure.RemedyConfig{
+					Enabled: ptr.To(true),
+					OrphanedPublicIPRemedy: &apisazure.OrphanedPublicIPRemedyConfig{
+						RequeueInterval:     &metav1.Duration{Duration: time.Minute},
+						DeletionGracePeriod: &metav1.Duration{Duration: 10 * time.Minute},
+						MaxCleanAttempts:    ptr.To[int32](3),
+					},
+					FailedVMRemedy: &apisazure.FailedVMRemedyConfig{
+						SyncPeriod:         &metav1.Duration{Duration: time.Hour},
+						MaxReapplyAttempts: ptr.To[int32](10),
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid non-positive durations and attempts", func() {
+				controlPlane.Remedy = &apisazure.RemedyConfig{
+					OrphanedPublicIPRemedy: &apisazure.OrphanedPublicIPRemedyConfig{
+						DeletionGracePeriod: &metav1.Duration{},
+						MaxGetAttempts:      ptr.To[int32](0),
+					},
+					FailedVMRemedy: &apisazure.FailedVMRemedyConfig{
+						RequeueInterval:    &metav1.Duration{Duration: -time.Minute},
+						MaxReapplyAttempts: ptr.To[int32](-1),
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("remedy.orphanedPublicIPRemedy.deletionGracePeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("remedy.orphanedPublicIPRemedy.maxGetAttempts"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("remedy.failedVMRemedy.requeueInterval"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("remedy.failedVMRemedy.maxReapplyAttempts"),
+					})),
+				))
+			})
+		})
+
+		Context("component settings", func() {
+			It("should allow valid resources, leader election and verbosity settings", func() {
+				controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+					LeaderElection: &apisazure.LeaderElectionConfig{
+						LeaseDuration: &metav1.Duration{Duration: 30 * time.Second},
+						RenewDeadline: &metav1.Duration{Duration: 20 * time.Second},
+						RetryPeriod:   &metav1.Duration{Duration: 5 * time.Second},
+					},
+					Verbosity: ptr.To[int32](4),
+				}
+				controlPlane.CSIDriverController = &apisazure.CSIDriverControllerConfig{
+					Verbosity: ptr.To[int32](2),
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid resources, leader election and verbosity settings", func() {
+				controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+						Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+					LeaderElection: &apisazure.LeaderElectionConfig{
+						LeaseDuration: &metav1.Duration{Duration: 15 * time.Second},
+						RenewDeadline: &metav1.Duration{Duration: 15 * time.Second},
+					},
+				}
+				controlPlane.CSIDriverController = &apisazure.CSIDriverControllerConfig{
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("-1")},
+					},
+					LeaderElection: &apisazure.LeaderElectionConfig{
+						RenewDeadline: &metav1.Duration{Duration: 10 * time.Second},
+						RetryPeriod:   &metav1.Duration{Duration: 10 * time.Second},
+					},
+					Verbosity: ptr.To[int32](-1),
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "1.28.2", fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cloudControllerManager.resources.requests[memory]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("cloudControllerManager.leaderElection.renewDeadline"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("csiDriverController.resources.limits[cpu]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("csiDriverController.leaderElection.retryPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("csiDriverController.verbosity"),
+					})),
+				))
+			})
+		})
+	})
+	Describe("#ValidateControlPlaneConfigUpdate", func() {
+		It("should allow updates which keep the load balancer SKU", func() {
+			oldControlPlane := &apisazure.ControlPlaneConfig{
+				CloudControllerManager: &apisazure.CloudControllerManagerConfig{
+					LoadBalancer: &apisazure.LoadBalancerConfig{SKU: ptr.To(apisazure.LoadBalancerSKUStandard)},
+				},
+			}
+			controlPlane = oldControlPlane.DeepCopy()
+			controlPlane.CloudControllerManager.LoadBalancer.DisableOutboundSNAT = ptr.To(true)
+
+			Expect(ValidateControlPlaneConfigUpdate(oldControlPlane, controlPlane, fldPath)).To(BeEmpty())
+			Expect(ValidateControlPlaneConfigUpdate(nil, &apisazure.ControlPlaneConfig{}, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the load balancer SKU", func() {
+			oldControlPlane := &apisazure.ControlPlaneConfig{
+				CloudControllerManager: &apisazure.CloudControllerManagerConfig{
+					LoadBalancer: &apisazure.LoadBalancerConfig{SKU: ptr.To(apisazure.LoadBalancerSKUBasic)},
+				},
+			}
+			controlPlane = oldControlPlane.DeepCopy()
+			controlPlane.CloudControllerManager.LoadBalancer.SKU = ptr.To(apisazure.LoadBalancerSKUStandard)
+
+			Expect(ValidateControlPlaneConfigUpdate(oldControlPlane, controlPlane, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.loadBalancer.sku"),
+				})),
+			))
+		})
+
+		It("should forbid setting the load balancer SKU after the creation", func() {
+			controlPlane.CloudControllerManager = &apisazure.CloudControllerManagerConfig{
+				LoadBalancer: &apisazure.LoadBalancerConfig{SKU: ptr.To(apisazure.LoadBalancerSKUStandard)},
+			}
+
+			Expect(ValidateControlPlaneConfigUpdate(&apisazure.ControlPlaneConfig{}, controlPlane, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.loadBalancer.sku"),
+				})),
+			))
+		})
 	})
 })
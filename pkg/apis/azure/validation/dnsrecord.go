@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// ValidateDNSRecordConfig validates a DNSRecordConfig object.
+func ValidateDNSRecordConfig(config *apisazure.DNSRecordConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.SubscriptionID != nil && !guidRegex.MatchString(*config.SubscriptionID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("subscriptionID"), *config.SubscriptionID, "must be a valid GUID"))
+	}
+	if config.ResourceGroup != nil && *config.ResourceGroup == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceGroup"), "the resource group must not be empty"))
+	}
+	if config.CredentialsRef != nil && *config.CredentialsRef == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("credentialsRef"), "the credentials reference must not be empty"))
+	}
+	if config.AliasTarget != nil {
+		if _, err := arm.ParseResourceID(*config.AliasTarget); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("aliasTarget"), *config.AliasTarget, fmt.Sprintf("must be an Azure resource ID: %v", err)))
+		}
+	}
+
+	return allErrs
+}
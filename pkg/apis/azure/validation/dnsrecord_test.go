@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+)
+
+var _ = Describe("DNSRecordConfig validation", func() {
+	var (
+		config  *apisazure.DNSRecordConfig
+		fldPath = field.NewPath("providerConfig")
+	)
+
+	BeforeEach(func() {
+		config = &apisazure.DNSRecordConfig{
+			SubscriptionID: ptr.To("00000000-0000-0000-0000-000000000000"),
+			ResourceGroup:  ptr.To("dns"),
+		}
+	})
+
+	It("should allow a valid configuration", func() {
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow an empty configuration", func() {
+		Expect(ValidateDNSRecordConfig(&apisazure.DNSRecordConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an invalid subscription ID", func() {
+		config.SubscriptionID = ptr.To("foo")
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.subscriptionID"),
+		}))))
+	})
+
+	It("should forbid an empty resource group", func() {
+		config.ResourceGroup = ptr.To("")
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.resourceGroup"),
+		}))))
+	})
+
+	It("should forbid an empty credentials reference", func() {
+		config.CredentialsRef = ptr.To("")
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("providerConfig.credentialsRef"),
+		}))))
+	})
+
+	It("should allow an Azure resource ID as alias target", func() {
+		config.AliasTarget = ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/ip")
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an alias target which is not an Azure resource ID", func() {
+		config.AliasTarget = ptr.To("1.2.3.4")
+
+		Expect(ValidateDNSRecordConfig(config, fldPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("providerConfig.aliasTarget"),
+		}))))
+	})
+})
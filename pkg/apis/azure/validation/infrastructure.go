@@ -6,13 +6,21 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
@@ -22,12 +30,37 @@ import (
 const (
 	natGatewayMinTimeoutInMinutes int32 = 4
 	natGatewayMaxTimeoutInMinutes int32 = 120
+	// natGatewayMaxPublicIPCount is the maximum number of public IPs which Azure allows to attach to a NAT gateway.
+	natGatewayMaxPublicIPCount int32 = 16
+	maxFlowLogsRetentionDays   int32 = 365
+
+	logAnalyticsWorkspaceResourceType = "Microsoft.OperationalInsights/workspaces"
 )
 
+var supportedTrafficAnalyticsIntervals = []int32{10, 60}
+
+// publicIPDomainNameLabelRegex matches the domain name labels which Azure accepts for public IPs.
+var publicIPDomainNameLabelRegex = regexp.MustCompile(`^[a-z][a-z0-9-]{1,61}[a-z0-9]$`)
+
+var supportedACRAccessModes = []apisazure.ACRAccessMode{
+	apisazure.ACRAccessModeConfigMap,
+	apisazure.ACRAccessModeCredentialProvider,
+}
+
+var supportedPublicIPDDoSProtectionModes = []apisazure.PublicIPDDoSProtectionMode{
+	apisazure.PublicIPDDoSProtectionModeEnabled,
+	apisazure.PublicIPDDoSProtectionModeDisabled,
+	apisazure.PublicIPDDoSProtectionModeVirtualNetworkInherited,
+}
+
 // ValidateInfrastructureConfigAgainstCloudProfile validates the InfrastructureConfig against the CloudProfile.
 func ValidateInfrastructureConfigAgainstCloudProfile(oldInfra, infra *apisazure.InfrastructureConfig, shootRegion string, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, fld *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	if infra.AuxiliaryResources != nil && infra.AuxiliaryResources.Region != nil {
+		allErrs = append(allErrs, validateAuxiliaryResourcesRegion(*infra.AuxiliaryResources.Region, cloudProfileSpec.Regions, fld.Child("auxiliaryResources", "region"))...)
+	}
+
 	if helper.IsUsingSingleSubnetLayout(infra) {
 		return allErrs
 	}
@@ -74,6 +107,21 @@ func validateInfrastructureConfigZones(oldInfra, infra *apisazure.Infrastructure
 	return allErrs
 }
 
+func validateAuxiliaryResourcesRegion(auxiliaryRegion string, regions []gardencorev1beta1.Region, fld *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	regionNames := sets.NewString()
+	for _, region := range regions {
+		regionNames.Insert(region.Name)
+	}
+
+	if !regionNames.Has(auxiliaryRegion) {
+		allErrs = append(allErrs, field.NotSupported(fld, auxiliaryRegion, regionNames.List()))
+	}
+
+	return allErrs
+}
+
 // ValidateInfrastructureConfig validates a InfrastructureConfig object.
 func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -102,7 +150,7 @@ func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, shoot *
 	// This resources would be orphaned when the cluster will be deleted. We block these cases thereby that the Azure shoot
 	// validation here will fail for those cases.
 	// TODO: remove the following block and uncomment below blocks once deployment into existing resource groups works properly.
-	if infra.ResourceGroup != nil {
+	if infra.ResourceGroup != nil && len(infra.ResourceGroup.Name) > 0 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceGroup"), infra.ResourceGroup, "specifying an existing resource group is not supported yet"))
 	}
 
@@ -111,10 +159,121 @@ func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, shoot *
 	if infra.Identity != nil && (infra.Identity.Name == "" || infra.Identity.ResourceGroup == "") {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("identity"), infra.Identity, "specifying an identity requires the name of the identity and the resource group which hosts the identity"))
 	}
+	if infra.Identity != nil && infra.Identity.ACRAccessMode != nil {
+		allErrs = append(allErrs, validateACRAccessMode(infra.Identity, fldPath.Child("identity", "acrAccessMode"))...)
+	}
+	if infra.Identity != nil {
+		allErrs = append(allErrs, validateIdentityLocation(infra.Identity, fldPath.Child("identity"))...)
+	}
+
+	if infra.AuxiliaryResources != nil && infra.AuxiliaryResources.Region != nil && len(*infra.AuxiliaryResources.Region) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("auxiliaryResources", "region"), "region must not be empty if specified"))
+	}
+	if infra.AuxiliaryResources != nil && infra.AuxiliaryResources.ManagedIdentity && infra.Identity != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("auxiliaryResources", "managedIdentity"), "a managed identity cannot be created if an existing identity is referenced"))
+	}
+
+	if infra.CredentialsRef != nil {
+		allErrs = append(allErrs, validateCredentialsRef(*infra.CredentialsRef, shoot, fldPath.Child("credentialsRef"))...)
+	}
+
+	if infra.Observability != nil {
+		allErrs = append(allErrs, validateObservabilityConfig(infra.Observability, fldPath.Child("observability"))...)
+	}
 
 	return allErrs
 }
 
+func validateObservabilityConfig(observability *apisazure.ObservabilityConfig, fldPath *field.Path) field.ErrorList {
+	return validateResourceID(observability.LogAnalyticsWorkspaceID, logAnalyticsWorkspaceResourceType, "log analytics workspace", fldPath.Child("logAnalyticsWorkspaceID"))
+}
+
+func validateFlowLogsConfig(flowLogs *apisazure.FlowLogsConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateResourceID(flowLogs.StorageAccountID, "Microsoft.Storage/storageAccounts", "storage account", fldPath.Child("storageAccountID"))...)
+	if days := flowLogs.RetentionDays; days != nil && (*days < 0 || *days > maxFlowLogsRetentionDays) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionDays"), *days, fmt.Sprintf("must be between 0 and %d", maxFlowLogsRetentionDays)))
+	}
+
+	if trafficAnalytics := flowLogs.TrafficAnalytics; trafficAnalytics != nil {
+		trafficAnalyticsPath := fldPath.Child("trafficAnalytics")
+		allErrs = append(allErrs, validateResourceID(trafficAnalytics.WorkspaceID, logAnalyticsWorkspaceResourceType, "log analytics workspace", trafficAnalyticsPath.Child("workspaceID"))...)
+		if interval := trafficAnalytics.IntervalInMinutes; interval != nil && !slices.Contains(supportedTrafficAnalyticsIntervals, *interval) {
+			allErrs = append(allErrs, field.Invalid(trafficAnalyticsPath.Child("intervalInMinutes"), *interval, fmt.Sprintf("must be one of %v", supportedTrafficAnalyticsIntervals)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateResourceID validates that the given id is the resource ID of a resource of the given type.
+func validateResourceID(id, resourceType, description string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if id == "" {
+		return append(allErrs, field.Required(fldPath, fmt.Sprintf("a %s id must be specified", description)))
+	}
+
+	resourceID, err := arm.ParseResourceID(id)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, id, fmt.Sprintf("invalid resource id: %v", err)))
+	}
+	if !strings.EqualFold(resourceID.ResourceType.String(), resourceType) {
+		allErrs = append(allErrs, field.Invalid(fldPath, id, fmt.Sprintf("resource id must reference a %s", description)))
+	}
+
+	return allErrs
+}
+
+func validateIdentityLocation(identity *apisazure.IdentityConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if identity.SubscriptionID != nil && !guidRegex.MatchString(*identity.SubscriptionID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("subscriptionID"), *identity.SubscriptionID, "must be a valid GUID"))
+	}
+	// the tenant of another subscription cannot be derived from the credentials, e.g. if the subscription is delegated
+	// via Azure Lighthouse, hence it must be set explicitly.
+	if identity.SubscriptionID != nil && identity.TenantID == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("tenantID"), "must be set if the identity resides in another subscription"))
+	}
+	if identity.TenantID != nil && !guidRegex.MatchString(*identity.TenantID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tenantID"), *identity.TenantID, "must be a valid GUID"))
+	}
+
+	return allErrs
+}
+
+func validateACRAccessMode(identity *apisazure.IdentityConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if identity.ACRAccess == nil || !*identity.ACRAccess {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "acrAccessMode can only be specified if acrAccess is enabled"))
+	}
+	if !slices.Contains(supportedACRAccessModes, *identity.ACRAccessMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *identity.ACRAccessMode, supportedACRAccessModes))
+	}
+	return allErrs
+}
+
+// validateCredentialsRef validates that the given credentials reference names a secret referenced in the Shoot's
+// `.spec.resources`, which is copied to the Shoot namespace in the seed.
+func validateCredentialsRef(credentialsRef string, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if credentialsRef == "" {
+		return append(allErrs, field.Required(fldPath, "must not be empty if specified"))
+	}
+
+	for _, resource := range shoot.Spec.Resources {
+		if resource.ResourceRef.Kind == "Secret" && resource.ResourceRef.Name == credentialsRef {
+			return allErrs
+		}
+	}
+
+	return append(allErrs, field.Invalid(fldPath, credentialsRef, "must be the name of a secret referenced in the shoot's resources"))
+}
+
 func validateNetworkConfig(
 	shoot *core.Shoot,
 	infra *apisazure.InfrastructureConfig,
@@ -151,6 +310,15 @@ func validateNetworkConfig(
 	}
 
 	allErrs = append(allErrs, validateVnetConfig(&config, infra.ResourceGroup, workerCIDR, nodes, pods, services, zonesPath, vNetPath)...)
+	allErrs = append(allErrs, validatePodSubnetConfig(&config, workerCIDR, nodes, services, networksPath)...)
+	allErrs = append(allErrs, validateOutboundAccessConfig(&config, networksPath.Child("outboundAccess"))...)
+	allErrs = append(allErrs, validateOutboundAccessType(&config, maxWorkerNodes(shoot), networksPath)...)
+	if config.FlowLogs != nil {
+		allErrs = append(allErrs, validateFlowLogsConfig(config.FlowLogs, networksPath.Child("flowLogs"))...)
+	}
+	if config.EgressFirewall != nil {
+		allErrs = append(allErrs, validateEgressFirewallConfig(&config, shoot.Spec.Kubernetes.Version, workerCIDR, pods, services, networksPath)...)
+	}
 
 	// handle single subnet layout validation.
 	if helper.IsUsingSingleSubnetLayout(infra) {
@@ -211,10 +379,16 @@ func validateVnetConfig(networkConfig *apisazure.NetworkConfig, resourceGroupCon
 		if networkConfig.VNet.DDosProtectionPlanID != nil {
 			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("ddosProtectionPlanID"), "cannot assign a ddos protection plan to a vnet not managed by Gardener"))
 		}
+		if len(networkConfig.VNet.AdditionalCIDRs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("additionalCidrs"), "cannot extend the address space of a vnet not managed by Gardener"))
+		}
 		return allErrs
 	}
 
 	if isDefaultVnetConfig(&networkConfig.VNet) {
+		if len(networkConfig.VNet.AdditionalCIDRs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("additionalCidrs"), "additional cidrs can only be specified together with a vnet cidr"))
+		}
 		if workers == nil {
 			allErrs = append(allErrs, field.Forbidden(vNetPath.Child("cidr"), "a vnet cidr or vnet reference must be specified when the workers field is not set"))
 			return allErrs
@@ -228,24 +402,323 @@ func validateVnetConfig(networkConfig *apisazure.NetworkConfig, resourceGroupCon
 	vnetCIDR := cidrvalidation.NewCIDR(*networkConfig.VNet.CIDR, vNetPath.Child("cidr"))
 	allErrs = append(allErrs, vnetCIDR.ValidateParse()...)
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(vNetPath.Child("cidr"), *vnetConfig.CIDR)...)
-	allErrs = append(allErrs, vnetCIDR.ValidateSubset(nodes)...)
 	allErrs = append(allErrs, vnetCIDR.ValidateNotOverlap(pods, services)...)
+
+	addressSpace := vnetAddressSpace(vnetConfig, vNetPath)
+	for _, additionalCIDR := range addressSpace[1:] {
+		allErrs = append(allErrs, additionalCIDR.ValidateParse()...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(additionalCIDR.GetFieldPath(), additionalCIDR.GetCIDR())...)
+		allErrs = append(allErrs, additionalCIDR.ValidateNotOverlap(pods, services)...)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(addressSpace, false)...)
+
+	allErrs = append(allErrs, validateInAddressSpace(addressSpace, nodes)...)
 	if workers != nil {
-		allErrs = append(allErrs, vnetCIDR.ValidateSubset(workers)...)
+		allErrs = append(allErrs, validateInAddressSpace(addressSpace, workers)...)
 	}
 	for index, zone := range networkConfig.Zones {
 		zoneCIDR := cidrvalidation.NewCIDR(zone.CIDR, zonesPath.Index(index).Child("cidr"))
-		allErrs = append(allErrs, vnetCIDR.ValidateSubset(zoneCIDR)...)
+		allErrs = append(allErrs, validateInAddressSpace(addressSpace, zoneCIDR)...)
+	}
+
+	return allErrs
+}
+
+// validateInAddressSpace validates that the given CIDR is contained in one of the address prefixes of the vnet. The
+// errors are reported against the primary address prefix.
+func validateInAddressSpace(addressSpace []cidrvalidation.CIDR, cidr cidrvalidation.CIDR) field.ErrorList {
+	for _, prefix := range addressSpace[1:] {
+		if prefix.Parse() && len(prefix.ValidateSubset(cidr)) == 0 {
+			return nil
+		}
+	}
+	return addressSpace[0].ValidateSubset(cidr)
+}
+
+// vnetAddressSpace returns the address prefixes of a vnet managed by Gardener, starting with the primary one.
+func vnetAddressSpace(vnet apisazure.VNet, vNetPath *field.Path) []cidrvalidation.CIDR {
+	addressSpace := []cidrvalidation.CIDR{cidrvalidation.NewCIDR(*vnet.CIDR, vNetPath.Child("cidr"))}
+	for index, cidr := range vnet.AdditionalCIDRs {
+		addressSpace = append(addressSpace, cidrvalidation.NewCIDR(cidr, vNetPath.Child("additionalCidrs").Index(index)))
+	}
+	return addressSpace
+}
+
+func validatePodSubnetConfig(networkConfig *apisazure.NetworkConfig, workers, nodes, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		allErrs         = field.ErrorList{}
+		podSubnetConfig = networkConfig.PodSubnet
+		fldPath         = networksPath.Child("podSubnet")
+	)
+
+	if podSubnetConfig == nil {
+		return allErrs
+	}
+
+	if (podSubnetConfig.CIDR == nil) == (podSubnetConfig.Name == nil) {
+		return append(allErrs, field.Invalid(fldPath, podSubnetConfig, "either a cidr or the name of an existing subnet must be specified for the pod subnet"))
+	}
+
+	if podSubnetConfig.Name != nil {
+		if !isExternalVnetUsed(&networkConfig.VNet) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "an existing pod subnet can only be used together with an existing vnet"))
+		}
+		if *podSubnetConfig.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("name"), "the pod subnet name must not be empty"))
+		}
+		return allErrs
+	}
+
+	cidrPath := fldPath.Child("cidr")
+	podSubnetCIDR := cidrvalidation.NewCIDR(*podSubnetConfig.CIDR, cidrPath)
+	if errs := podSubnetCIDR.ValidateParse(); len(errs) > 0 {
+		return append(allErrs, errs...)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidrPath, *podSubnetConfig.CIDR)...)
+
+	// the pod subnet is created next to the node subnets, hence it must fit into the vnet. This can only be checked for vnets
+	// managed by Gardener, for existing vnets it is up to the user.
+	if !isExternalVnetUsed(&networkConfig.VNet) {
+		if networkConfig.VNet.CIDR == nil {
+			allErrs = append(allErrs, field.Forbidden(cidrPath, "a vnet cidr must be specified to create a pod subnet"))
+		} else {
+			allErrs = append(allErrs, validateInAddressSpace(vnetAddressSpace(networkConfig.VNet, networksPath.Child("vnet")), podSubnetCIDR)...)
+		}
+	}
+
+	otherCIDRs := []cidrvalidation.CIDR{workers, nodes, services}
+	for index, zone := range networkConfig.Zones {
+		otherCIDRs = append(otherCIDRs, cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(index).Child("cidr")))
+	}
+	for _, other := range otherCIDRs {
+		if other != nil {
+			allErrs = append(allErrs, other.ValidateNotOverlap(podSubnetCIDR)...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateOutboundAccessConfig(networkConfig *apisazure.NetworkConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if networkConfig.OutboundAccess == nil {
+		return allErrs
+	}
+
+	nextHopPath := fldPath.Child("nextHopIPAddress")
+	if nextHop := networkConfig.OutboundAccess.NextHopIPAddress; nextHop == "" {
+		allErrs = append(allErrs, field.Required(nextHopPath, "the ip address of the next hop must be specified"))
+	} else if ip := net.ParseIP(nextHop); ip == nil || ip.To4() == nil {
+		allErrs = append(allErrs, field.Invalid(nextHopPath, nextHop, "must be a valid IPv4 address"))
+	}
+
+	// egress traffic is routed to the next hop, hence a NAT gateway attached to the worker subnets would never be used.
+	if hasNatGateway(networkConfig) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "outbound access via a next hop cannot be configured together with a NAT gateway"))
+	}
+
+	return allErrs
+}
+
+// egressFirewallMinSubnetPrefixLength is the minimum size of the subnets which Azure requires for a firewall.
+const egressFirewallMinSubnetPrefixLength = 26
+
+func validateEgressFirewallConfig(networkConfig *apisazure.NetworkConfig, kubernetesVersion string, workers, pods, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		firewall = networkConfig.EgressFirewall
+		fldPath  = networksPath.Child("egressFirewall")
+	)
+
+	if isExternalVnetUsed(&networkConfig.VNet) || networkConfig.VNet.CIDR == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "an egress firewall can only be created in a vnet managed by Gardener with a vnet cidr"))
+	}
+	// egress traffic is routed to the firewall, hence neither a NAT gateway nor another next hop would ever be used.
+	if hasNatGateway(networkConfig) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "an egress firewall cannot be configured together with a NAT gateway"))
+	}
+	if networkConfig.OutboundAccess != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "an egress firewall cannot be configured together with a next hop"))
+	}
+	if outboundAccessType := networkConfig.OutboundAccessType; outboundAccessType != nil && string(*outboundAccessType) != apisazure.OutboundAccessTypeUserDefinedRouting {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("an egress firewall can only be configured if the outbound access type is %s", apisazure.OutboundAccessTypeUserDefinedRouting)))
+	}
+	// the responses of public load balancers would be routed through the firewall, hence only internal load balancers
+	// are allowed. This is enforced with a ValidatingAdmissionPolicy which is only available as of Kubernetes 1.30.
+	if lessThan130, err := versionutils.CheckVersionMeetsConstraint(kubernetesVersion, "< 1.30"); err != nil || lessThan130 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "an egress firewall can only be configured for shoots with Kubernetes version 1.30 or higher"))
+	}
+	if firewall.PolicyID != nil {
+		allErrs = append(allErrs, validateResourceID(*firewall.PolicyID, "Microsoft.Network/firewallPolicies", "firewall policy", fldPath.Child("policyID"))...)
+	}
+
+	// the nodes cidr usually spans the whole vnet, hence the firewall subnets are only checked against the other subnets.
+	otherCIDRs := []cidrvalidation.CIDR{workers, pods, services}
+	for index, zone := range networkConfig.Zones {
+		otherCIDRs = append(otherCIDRs, cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(index).Child("cidr")))
+	}
+	if podSubnet := networkConfig.PodSubnet; podSubnet != nil && podSubnet.CIDR != nil {
+		otherCIDRs = append(otherCIDRs, cidrvalidation.NewCIDR(*podSubnet.CIDR, networksPath.Child("podSubnet", "cidr")))
+	}
+
+	var firewallCIDRs []cidrvalidation.CIDR
+	for _, subnet := range []struct {
+		cidr string
+		path *field.Path
+	}{
+		{firewall.CIDR, fldPath.Child("cidr")},
+		{firewall.ManagementCIDR, fldPath.Child("managementCIDR")},
+	} {
+		if subnet.cidr == "" {
+			allErrs = append(allErrs, field.Required(subnet.path, "a cidr must be specified"))
+			continue
+		}
+		cidr := cidrvalidation.NewCIDR(subnet.cidr, subnet.path)
+		if errs := cidr.ValidateParse(); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(subnet.path, subnet.cidr)...)
+		if _, ipNet, _ := net.ParseCIDR(subnet.cidr); ipNet != nil {
+			if ones, _ := ipNet.Mask.Size(); ones > egressFirewallMinSubnetPrefixLength {
+				allErrs = append(allErrs, field.Invalid(subnet.path, subnet.cidr, fmt.Sprintf("must be at least a /%d", egressFirewallMinSubnetPrefixLength)))
+			}
+		}
+		if !isExternalVnetUsed(&networkConfig.VNet) && networkConfig.VNet.CIDR != nil {
+			allErrs = append(allErrs, validateInAddressSpace(vnetAddressSpace(networkConfig.VNet, networksPath.Child("vnet")), cidr)...)
+		}
+		for _, other := range otherCIDRs {
+			if other != nil {
+				allErrs = append(allErrs, other.ValidateNotOverlap(cidr)...)
+			}
+		}
+		firewallCIDRs = append(firewallCIDRs, cidr)
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(firewallCIDRs, false)...)
+
+	return allErrs
+}
+
+const (
+	// maxSNATPortsPerPublicIP is the number of SNAT ports provided by a public IP of a load balancer frontend.
+	maxSNATPortsPerPublicIP = 64000
+	// maxOutboundPublicIPs is the maximum number of managed public IPs used by the outbound rule of the load balancer.
+	maxOutboundPublicIPs = 16
+)
+
+var supportedOutboundAccessTypes = sets.New[string](
+	apisazure.OutboundAccessTypeNatGateway,
+	apisazure.OutboundAccessTypeLoadBalancer,
+	apisazure.OutboundAccessTypeUserDefinedRouting,
+)
+
+func validateOutboundAccessType(networkConfig *apisazure.NetworkConfig, maxNodes int32, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs                  = field.ErrorList{}
+		outboundAccessTypePath   = fldPath.Child("outboundAccessType")
+		outboundLoadBalancerPath = fldPath.Child("outboundLoadBalancer")
+	)
+
+	if networkConfig.OutboundAccessType == nil {
+		if networkConfig.OutboundLoadBalancer != nil {
+			allErrs = append(allErrs, field.Forbidden(outboundLoadBalancerPath, fmt.Sprintf("can only be configured if the outbound access type is %s", apisazure.OutboundAccessTypeLoadBalancer)))
+		}
+		return allErrs
+	}
+
+	switch outboundAccessType := string(*networkConfig.OutboundAccessType); outboundAccessType {
+	case apisazure.OutboundAccessTypeNatGateway:
+		if !usesNatGatewayForAllSubnets(networkConfig) {
+			allErrs = append(allErrs, field.Invalid(outboundAccessTypePath, outboundAccessType, "requires a NAT gateway for all worker subnets"))
+		}
+	case apisazure.OutboundAccessTypeLoadBalancer:
+		if hasNatGateway(networkConfig) {
+			allErrs = append(allErrs, field.Forbidden(outboundAccessTypePath, "outbound access via the load balancer cannot be configured together with a NAT gateway"))
+		}
+		if networkConfig.OutboundAccess != nil {
+			allErrs = append(allErrs, field.Forbidden(outboundAccessTypePath, "outbound access via the load balancer cannot be configured together with a next hop"))
+		}
+	case apisazure.OutboundAccessTypeUserDefinedRouting:
+		if networkConfig.OutboundAccess == nil && networkConfig.EgressFirewall == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("outboundAccess"), "the next hop or an egress firewall must be configured for user-defined routing"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(outboundAccessTypePath, outboundAccessType, sets.List(supportedOutboundAccessTypes)))
+	}
+
+	if lb := networkConfig.OutboundLoadBalancer; lb != nil {
+		if string(*networkConfig.OutboundAccessType) != apisazure.OutboundAccessTypeLoadBalancer {
+			allErrs = append(allErrs, field.Forbidden(outboundLoadBalancerPath, fmt.Sprintf("can only be configured if the outbound access type is %s", apisazure.OutboundAccessTypeLoadBalancer)))
+		}
+		allErrs = append(allErrs, validateOutboundLoadBalancerConfig(lb, maxNodes, outboundLoadBalancerPath)...)
+	}
+
+	return allErrs
+}
+
+func validateOutboundLoadBalancerConfig(lb *apisazure.OutboundLoadBalancerConfig, maxNodes int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	publicIPCount := ptr.Deref(lb.PublicIPCount, 1)
+	if publicIPCount < 1 || publicIPCount > maxOutboundPublicIPs {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("publicIPCount"), publicIPCount, fmt.Sprintf("must be between 1 and %d", maxOutboundPublicIPs)))
+	}
+
+	if ports := lb.AllocatedOutboundPorts; ports != nil {
+		portsPath := fldPath.Child("allocatedOutboundPorts")
+		if *ports < 0 || *ports > maxSNATPortsPerPublicIP || *ports%8 != 0 {
+			allErrs = append(allErrs, field.Invalid(portsPath, *ports, fmt.Sprintf("must be a multiple of 8 between 0 and %d", maxSNATPortsPerPublicIP)))
+		} else if int64(*ports)*int64(maxNodes) > int64(publicIPCount)*maxSNATPortsPerPublicIP {
+			allErrs = append(allErrs, field.Invalid(portsPath, *ports, fmt.Sprintf("the %d public IPs provide %d SNAT ports, which is not sufficient for the maximum number of %d nodes of the shoot", publicIPCount, int64(publicIPCount)*maxSNATPortsPerPublicIP, maxNodes)))
+		}
 	}
 
 	return allErrs
 }
 
+// hasNatGateway checks whether a NAT gateway is enabled for any worker subnet.
+func hasNatGateway(networkConfig *apisazure.NetworkConfig) bool {
+	if networkConfig.NatGateway != nil && networkConfig.NatGateway.Enabled {
+		return true
+	}
+	for _, zone := range networkConfig.Zones {
+		if zone.NatGateway != nil && zone.NatGateway.Enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// usesNatGatewayForAllSubnets checks whether a NAT gateway is enabled for all worker subnets.
+func usesNatGatewayForAllSubnets(networkConfig *apisazure.NetworkConfig) bool {
+	if len(networkConfig.Zones) == 0 {
+		return networkConfig.NatGateway != nil && networkConfig.NatGateway.Enabled
+	}
+	for _, zone := range networkConfig.Zones {
+		if zone.NatGateway == nil || !zone.NatGateway.Enabled {
+			return false
+		}
+	}
+	return true
+}
+
+// maxWorkerNodes returns the maximum number of nodes of all worker pools of the given shoot.
+func maxWorkerNodes(shoot *core.Shoot) int32 {
+	var maxNodes int32
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maxNodes += worker.Maximum
+	}
+	return maxNodes
+}
+
 func validateZones(zones []apisazure.Zone, nodes, pods, services cidrvalidation.CIDR, fld *field.Path) field.ErrorList {
 	var (
 		allErrs   = field.ErrorList{}
 		zoneNames = sets.NewInt32()
 		zoneCIDRs []cidrvalidation.CIDR
+		// the domain name labels of public IPs must be unique within the region
+		domainNameLabels = sets.New[string]()
 	)
 
 	for index, zone := range zones {
@@ -262,6 +735,16 @@ func validateZones(zones []apisazure.Zone, nodes, pods, services cidrvalidation.
 
 		// NAT validation
 		allErrs = append(allErrs, validateZonedNatGatewayConfig(zone.NatGateway, zonePath.Child("natGateway"))...)
+		if zone.NatGateway != nil && zone.NatGateway.DNSSettings != nil {
+			label := zone.NatGateway.DNSSettings.DomainNameLabel
+			if domainNameLabels.Has(label) {
+				allErrs = append(allErrs, field.Duplicate(zonePath.Child("natGateway", "dnsSettings", "domainNameLabel"), label))
+			}
+			domainNameLabels.Insert(label)
+		}
+
+		// Security group validation
+		allErrs = append(allErrs, validateZoneSecurityGroupConfig(zone.SecurityGroup, zonePath.Child("securityGroup"))...)
 	}
 
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(zoneCIDRs...)...)
@@ -279,6 +762,103 @@ func validateZones(zones []apisazure.Zone, nodes, pods, services cidrvalidation.
 	return allErrs
 }
 
+// ValidateInfrastructureConfigServiceEndpoints validates the service endpoints of the subnets. Azure only rejects
+// unknown or conflicting service endpoints when the subnet is updated, i.e. late in the reconciliation of the
+// infrastructure. Service endpoints which a subnet already had in the old InfrastructureConfig are not validated
+// again, so that existing shoots can still be updated.
+func ValidateInfrastructureConfigServiceEndpoints(oldInfra, infra *apisazure.InfrastructureConfig, fld *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if helper.IsUsingSingleSubnetLayout(infra) {
+		var oldServiceEndpoints []string
+		if oldInfra != nil && helper.IsUsingSingleSubnetLayout(oldInfra) {
+			oldServiceEndpoints = oldInfra.Networks.ServiceEndpoints
+		}
+		return validateServiceEndpoints(oldServiceEndpoints, infra.Networks.ServiceEndpoints, fld.Child("networks", "serviceEndpoints"))
+	}
+
+	for i, zone := range infra.Networks.Zones {
+		allErrs = append(allErrs, validateServiceEndpoints(oldZoneServiceEndpoints(oldInfra, zone), zone.ServiceEndpoints, fld.Child("networks", "zones").Index(i).Child("serviceEndpoints"))...)
+	}
+	return allErrs
+}
+
+// oldZoneServiceEndpoints returns the service endpoints the subnet of the given zone had in the old
+// InfrastructureConfig. When the old InfrastructureConfig uses the single subnet layout, the zone which took over its
+// CIDR took over the worker subnet as well.
+func oldZoneServiceEndpoints(oldInfra *apisazure.InfrastructureConfig, zone apisazure.Zone) []string {
+	if oldInfra == nil {
+		return nil
+	}
+
+	if helper.IsUsingSingleSubnetLayout(oldInfra) {
+		if oldInfra.Networks.Workers != nil && *oldInfra.Networks.Workers == zone.CIDR {
+			return oldInfra.Networks.ServiceEndpoints
+		}
+		return nil
+	}
+
+	for _, oldZone := range oldInfra.Networks.Zones {
+		if oldZone.Name == zone.Name {
+			return oldZone.ServiceEndpoints
+		}
+	}
+	return nil
+}
+
+func validateServiceEndpoints(oldServiceEndpoints, serviceEndpoints []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		seen    = sets.New[string]()
+		old     = sets.New[string]()
+	)
+
+	for _, serviceEndpoint := range oldServiceEndpoints {
+		old.Insert(strings.ToLower(serviceEndpoint))
+	}
+
+	for i, serviceEndpoint := range serviceEndpoints {
+		if !old.Has(strings.ToLower(serviceEndpoint)) && !apisazure.IsKnownServiceEndpoint(serviceEndpoint) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), serviceEndpoint, apisazure.KnownServiceEndpoints()))
+			continue
+		}
+		if seen.Has(strings.ToLower(serviceEndpoint)) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), serviceEndpoint))
+			continue
+		}
+		seen.Insert(strings.ToLower(serviceEndpoint))
+	}
+
+	storage := []string{strings.ToLower(apisazure.ServiceEndpointStorage), strings.ToLower(apisazure.ServiceEndpointStorageGlobal)}
+	if seen.HasAll(storage...) && !old.HasAll(storage...) {
+		allErrs = append(allErrs, field.Invalid(fldPath, serviceEndpoints, fmt.Sprintf("%s and %s cannot be used together", apisazure.ServiceEndpointStorage, apisazure.ServiceEndpointStorageGlobal)))
+	}
+	return allErrs
+}
+
+func validateZoneSecurityGroupConfig(securityGroupConfig *apisazure.ZoneSecurityGroupConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if securityGroupConfig == nil {
+		return allErrs
+	}
+
+	// The worker security group is not associated with the subnet in this case, hence an external one must always be present.
+	externalIDPath := fldPath.Child("externalID")
+	if securityGroupConfig.ExternalID == "" {
+		return append(allErrs, field.Required(externalIDPath, "an external security group id must be specified"))
+	}
+
+	resourceID, err := arm.ParseResourceID(securityGroupConfig.ExternalID)
+	if err != nil {
+		return append(allErrs, field.Invalid(externalIDPath, securityGroupConfig.ExternalID, fmt.Sprintf("invalid resource id: %v", err)))
+	}
+	if !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/networkSecurityGroups") {
+		allErrs = append(allErrs, field.Invalid(externalIDPath, securityGroupConfig.ExternalID, "resource id must reference a network security group"))
+	}
+
+	return allErrs
+}
+
 func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, hasShootVmoMigrationAnnotation bool, natGatewayPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -287,7 +867,7 @@ func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, hasS
 	}
 
 	if !natGatewayConfig.Enabled {
-		if natGatewayConfig.Zone != nil || natGatewayConfig.IdleConnectionTimeoutMinutes != nil || natGatewayConfig.IPAddresses != nil {
+		if natGatewayConfig.Zone != nil || natGatewayConfig.IdleConnectionTimeoutMinutes != nil || natGatewayConfig.IPAddresses != nil || natGatewayConfig.DDoSProtection != nil || natGatewayConfig.DNSSettings != nil || natGatewayConfig.AutoScaleIPs != nil {
 			return append(allErrs, field.Invalid(natGatewayPath, natGatewayConfig, "NatGateway is disabled but additional NatGateway config is passed"))
 		}
 		return nil
@@ -301,6 +881,10 @@ func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, hasS
 		allErrs = append(allErrs, field.Invalid(natGatewayPath.Child("idleConnectionTimeoutMinutes"), *natGatewayConfig.IdleConnectionTimeoutMinutes, "idleConnectionTimeoutMinutes values must range between 4 and 120"))
 	}
 
+	allErrs = append(allErrs, validatePublicIPDDoSProtection(natGatewayConfig.DDoSProtection, len(natGatewayConfig.IPAddresses) > 0, natGatewayPath.Child("ddosProtection"))...)
+	allErrs = append(allErrs, validatePublicIPDNSSettings(natGatewayConfig.DNSSettings, len(natGatewayConfig.IPAddresses) > 0, natGatewayPath.Child("dnsSettings"))...)
+	allErrs = append(allErrs, validateNatGatewayIPAutoScaling(natGatewayConfig.AutoScaleIPs, len(natGatewayConfig.IPAddresses) > 0, natGatewayPath.Child("autoScaleIPs"))...)
+
 	if natGatewayConfig.Zone == nil {
 		if len(natGatewayConfig.IPAddresses) > 0 {
 			allErrs = append(allErrs, field.Invalid(natGatewayPath.Child("zone"), *natGatewayConfig, "Public IPs can only be selected for zonal NatGateways"))
@@ -335,16 +919,113 @@ func validateZonedNatGatewayConfig(natGatewayConfig *apisazure.ZonedNatGatewayCo
 	}
 
 	if !natGatewayConfig.Enabled {
-		if natGatewayConfig.IdleConnectionTimeoutMinutes != nil || natGatewayConfig.IPAddresses != nil {
+		if natGatewayConfig.IdleConnectionTimeoutMinutes != nil || natGatewayConfig.IPAddresses != nil || natGatewayConfig.DDoSProtection != nil || natGatewayConfig.DNSSettings != nil || natGatewayConfig.AutoScaleIPs != nil || natGatewayConfig.Existing != nil {
 			return append(allErrs, field.Invalid(natGatewayPath, natGatewayConfig, "NatGateway is disabled but additional NatGateway config is passed"))
 		}
 		return nil
 	}
 
+	if natGatewayConfig.Existing != nil {
+		return validateExistingNatGateway(natGatewayConfig, natGatewayPath)
+	}
+
+	allErrs = append(allErrs, validatePublicIPDDoSProtection(natGatewayConfig.DDoSProtection, len(natGatewayConfig.IPAddresses) > 0, natGatewayPath.Child("ddosProtection"))...)
+	allErrs = append(allErrs, validatePublicIPDNSSettings(natGatewayConfig.DNSSettings, len(natGatewayConfig.IPAddresses) > 0, natGatewayPath.Child("dnsSettings"))...)
+	allErrs = append(allErrs, validateNatGatewayIPAutoScaling(natGatewayConfig.AutoScaleIPs, len(natGatewayConfig.IPAddresses) > 0, natGatewayPath.Child("autoScaleIPs"))...)
 	allErrs = append(allErrs, validateZonedPublicIPReference(natGatewayConfig.IPAddresses, natGatewayPath.Child("ipAddresses"))...)
 	return allErrs
 }
 
+// validateExistingNatGateway validates the reference to an existing NAT gateway. The NAT gateway is not managed by
+// Gardener, hence none of its properties can be configured.
+func validateExistingNatGateway(natGatewayConfig *apisazure.ZonedNatGatewayConfig, natGatewayPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	existingPath := natGatewayPath.Child("existing")
+
+	if natGatewayConfig.Existing.Name == "" {
+		allErrs = append(allErrs, field.Required(existingPath.Child("name"), "name of the existing NAT gateway is required"))
+	}
+	if natGatewayConfig.Existing.ResourceGroup == "" {
+		allErrs = append(allErrs, field.Required(existingPath.Child("resourceGroup"), "resource group of the existing NAT gateway is required"))
+	}
+
+	if natGatewayConfig.IdleConnectionTimeoutMinutes != nil {
+		allErrs = append(allErrs, field.Forbidden(natGatewayPath.Child("idleConnectionTimeoutMinutes"), "idleConnectionTimeoutMinutes cannot be configured for an existing NAT gateway"))
+	}
+	if natGatewayConfig.IPAddresses != nil {
+		allErrs = append(allErrs, field.Forbidden(natGatewayPath.Child("ipAddresses"), "ipAddresses cannot be configured for an existing NAT gateway"))
+	}
+	if natGatewayConfig.DDoSProtection != nil {
+		allErrs = append(allErrs, field.Forbidden(natGatewayPath.Child("ddosProtection"), "ddosProtection cannot be configured for an existing NAT gateway"))
+	}
+	if natGatewayConfig.DNSSettings != nil {
+		allErrs = append(allErrs, field.Forbidden(natGatewayPath.Child("dnsSettings"), "dnsSettings cannot be configured for an existing NAT gateway"))
+	}
+	if natGatewayConfig.AutoScaleIPs != nil {
+		allErrs = append(allErrs, field.Forbidden(natGatewayPath.Child("autoScaleIPs"), "autoScaleIPs cannot be configured for an existing NAT gateway"))
+	}
+	return allErrs
+}
+
+// validatePublicIPDDoSProtection validates the DDoS protection of the public IP created for a NAT gateway. The DDoS
+// protection of a public IP requires the Standard SKU, which is only guaranteed for the public IPs created by Gardener.
+func validatePublicIPDDoSProtection(ddosProtection *apisazure.PublicIPDDoSProtection, hasIPAddresses bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if ddosProtection == nil {
+		return allErrs
+	}
+
+	if hasIPAddresses {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "ddosProtection can only be configured for the Standard SKU public IP created for the NAT gateway and not together with ipAddresses"))
+	}
+	if !slices.Contains(supportedPublicIPDDoSProtectionModes, ddosProtection.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), ddosProtection.Mode, supportedPublicIPDDoSProtectionModes))
+	}
+	return allErrs
+}
+
+// validatePublicIPDNSSettings validates the DNS settings of the public IP created for a NAT gateway. Public IPs which
+// are referenced by the InfrastructureConfig are not managed by Gardener, hence their DNS settings cannot be configured.
+func validatePublicIPDNSSettings(dnsSettings *apisazure.PublicIPDNSSettings, hasIPAddresses bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if dnsSettings == nil {
+		return allErrs
+	}
+
+	if hasIPAddresses {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "dnsSettings can only be configured for the public IP created for the NAT gateway and not together with ipAddresses"))
+	}
+	if !publicIPDomainNameLabelRegex.MatchString(dnsSettings.DomainNameLabel) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("domainNameLabel"), dnsSettings.DomainNameLabel,
+			fmt.Sprintf("domain name label must match the regex %s", publicIPDomainNameLabelRegex)))
+	}
+	if dnsSettings.ReverseFQDN != nil {
+		// Azure stores the reverse FQDN with a trailing dot, hence it is accepted but not required.
+		allErrs = append(allErrs, validation.IsFullyQualifiedDomainName(fldPath.Child("reverseFqdn"), strings.TrimSuffix(*dnsSettings.ReverseFQDN, "."))...)
+	}
+	return allErrs
+}
+
+// validateNatGatewayIPAutoScaling validates the automatic scaling of the public IPs of a NAT gateway. Only the public
+// IPs created by Gardener can be scaled.
+func validateNatGatewayIPAutoScaling(autoScaling *apisazure.NatGatewayIPAutoScaling, hasIPAddresses bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if autoScaling == nil {
+		return allErrs
+	}
+
+	if hasIPAddresses {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "autoScaleIPs can only be configured for the public IPs created for the NAT gateway and not together with ipAddresses"))
+	}
+	if autoScaling.NodesPerIP < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodesPerIP"), autoScaling.NodesPerIP, "nodesPerIP must be at least 1"))
+	}
+	if autoScaling.MaxIPCount < 1 || autoScaling.MaxIPCount > natGatewayMaxPublicIPCount {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxIPCount"), autoScaling.MaxIPCount, fmt.Sprintf("maxIPCount must range between 1 and %d", natGatewayMaxPublicIPCount)))
+	}
+	return allErrs
+}
+
 func validateZonedPublicIPReference(publicIPReferences []apisazure.ZonedPublicIPReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, publicIPRef := range publicIPReferences {
@@ -368,6 +1049,10 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisazure.Infrastr
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Workers, oldConfig.Networks.Workers, providerPath.Child("networks").Child("workers"))...)
 	}
 
+	if oldConfig.Networks.PodSubnet != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.PodSubnet, oldConfig.Networks.PodSubnet, providerPath.Child("networks").Child("podSubnet"))...)
+	}
+
 	// validate state transitions for the network layouts
 	switch {
 	// if both new and old InfrastructureConfigs use multiple-subnet layout, validate the zones
@@ -383,12 +1068,59 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisazure.Infrastr
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Workers, oldConfig.Networks.Workers, providerPath.Child("networks").Child("workers"))...)
 	}
 
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldConfig.Zoned, newConfig.Zoned, providerPath.Child("zoned"))...)
+	// The conversion of a non-zoned to a zoned shoot is validated by ValidateInfrastructureConfigZonalMigration.
+	if oldConfig.Zoned || !newConfig.Zoned {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldConfig.Zoned, newConfig.Zoned, providerPath.Child("zoned"))...)
+	}
+	allErrs = append(allErrs, validateAuxiliaryResourcesUpdate(oldConfig.AuxiliaryResources, newConfig.AuxiliaryResources, providerPath.Child("auxiliaryResources"))...)
 	allErrs = append(allErrs, validateVnetConfigUpdate(&oldConfig.Networks, &newConfig.Networks, providerPath.Child("networks"))...)
 
 	return allErrs
 }
 
+// ValidateInfrastructureConfigZonalMigration validates the conversion of a non-zoned to a zoned InfrastructureConfig.
+// The conversion must be explicitly requested via the ShootZonalMigrationAnnotation and the region of the shoot must
+// offer availability zones.
+func ValidateInfrastructureConfigZonalMigration(oldConfig, newConfig *apisazure.InfrastructureConfig, shoot *core.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, providerPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldConfig.Zoned || !newConfig.Zoned {
+		return allErrs
+	}
+
+	zonedPath := providerPath.Child("zoned")
+	if !helper.HasShootZonalMigrationAnnotation(shoot.GetAnnotations()) {
+		allErrs = append(allErrs, field.Forbidden(zonedPath, fmt.Sprintf("non-zoned shoots can only be converted to zoned shoots with the annotation %s=true", azuretypes.ShootZonalMigrationAnnotation)))
+		return allErrs
+	}
+
+	for _, region := range cloudProfileSpec.Regions {
+		if region.Name == shoot.Spec.Region {
+			if len(region.Zones) == 0 {
+				allErrs = append(allErrs, field.Forbidden(zonedPath, fmt.Sprintf("region %q does not offer availability zones", shoot.Spec.Region)))
+			}
+			return allErrs
+		}
+	}
+
+	allErrs = append(allErrs, field.Forbidden(zonedPath, fmt.Sprintf("region %q is not configured in the cloud profile", shoot.Spec.Region)))
+	return allErrs
+}
+
+func validateAuxiliaryResourcesUpdate(oldConfig, newConfig *apisazure.AuxiliaryResourcesConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Already created auxiliary resources cannot be moved to another region.
+	if oldConfig == nil || newConfig == nil ||
+		!(oldConfig.BootDiagnostics && newConfig.BootDiagnostics || oldConfig.ManagedIdentity && newConfig.ManagedIdentity) {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Region, oldConfig.Region, fldPath.Child("region"))...)
+
+	return allErrs
+}
+
 func validateVnetConfigUpdate(oldNeworkConfig, newNetworkConfig *apisazure.NetworkConfig, networkConfigPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	vnetPath := networkConfigPath.Child("vnet")
@@ -411,6 +1143,12 @@ func validateVnetConfigUpdate(oldNeworkConfig, newNetworkConfig *apisazure.Netwo
 		}
 	}
 
+	// address prefixes which are in use by subnets cannot be removed from the vnet, hence they can only be appended.
+	oldAdditionalCIDRs, newAdditionalCIDRs := oldNeworkConfig.VNet.AdditionalCIDRs, newNetworkConfig.VNet.AdditionalCIDRs
+	if len(newAdditionalCIDRs) < len(oldAdditionalCIDRs) || !slices.Equal(newAdditionalCIDRs[:len(oldAdditionalCIDRs)], oldAdditionalCIDRs) {
+		allErrs = append(allErrs, field.Forbidden(vnetPath.Child("additionalCidrs"), "additional cidrs can only be appended"))
+	}
+
 	return allErrs
 }
 
@@ -11,11 +11,14 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 var _ = Describe("InfrastructureConfig validation", func() {
@@ -57,8 +60,8 @@ var _ = Describe("InfrastructureConfig validation", func() {
 	})
 
 	Describe("#ValidateInfrastructureConfig", func() {
-		It("should forbid specifying a resource group configuration", func() {
-			infrastructureConfig.ResourceGroup = &apisazure.ResourceGroup{}
+		It("should forbid specifying an existing resource group", func() {
+			infrastructureConfig.ResourceGroup = &apisazure.ResourceGroup{Name: resourceGroup}
 
 			errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
 
@@ -68,6 +71,12 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))
 		})
 
+		It("should allow to enable the deletion protection of the resource group", func() {
+			infrastructureConfig.ResourceGroup = &apisazure.ResourceGroup{DeletionProtection: ptr.To(true)}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+		})
+
 		Context("vnet", func() {
 			It("should forbid specifying a vnet name without resource group", func() {
 				vnetName := "existing-vnet"
@@ -195,6 +204,54 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			})
 		})
 
+		Context("additional vnet CIDRs", func() {
+			It("should allow subnets in the additional address space", func() {
+				infrastructureConfig.Networks.VNet.AdditionalCIDRs = []string{"172.16.0.0/16"}
+				infrastructureConfig.Networks.Workers = ptr.To("172.16.0.0/24")
+				networking.Nodes = ptr.To("172.16.0.0/24")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid and overlapping additional CIDRs", func() {
+				infrastructureConfig.Networks.VNet.AdditionalCIDRs = []string{"invalid-cidr", "10.1.0.0/16", "172.16.0.1/16"}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vnet.additionalCidrs[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vnet.additionalCidrs[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vnet.additionalCidrs[2]"),
+				}))
+			})
+
+			It("should forbid additional CIDRs overlapping with the pods", func() {
+				infrastructureConfig.Networks.VNet.AdditionalCIDRs = []string{"100.96.0.0/16"}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networking.pods"),
+					"Detail": ContainSubstring("networks.vnet.additionalCidrs[0]"),
+				}))
+			})
+
+			It("should forbid additional CIDRs for an existing vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{
+					Name:            ptr.To("existing-vnet"),
+					ResourceGroup:   &resourceGroup,
+					AdditionalCIDRs: []string{"172.16.0.0/16"},
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vnet.additionalCidrs"),
+				}))
+			})
+		})
+
 		Context("CIDR", func() {
 			It("should forbid invalid VNet CIDRs", func() {
 				infrastructureConfig.Networks.VNet.CIDR = &invalidCIDR
@@ -330,6 +387,583 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Field": Equal("identity"),
 				}))
 			})
+
+			It("should allow the credential provider mode for the ACR access", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:          "test-identiy",
+					ResourceGroup: "identity-resource-group",
+					ACRAccess:     ptr.To(true),
+					ACRAccessMode: ptr.To(apisazure.ACRAccessModeCredentialProvider),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid an ACR access mode without ACR access", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:          "test-identiy",
+					ResourceGroup: "identity-resource-group",
+					ACRAccessMode: ptr.To(apisazure.ACRAccessModeCredentialProvider),
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("identity.acrAccessMode"),
+				}))
+			})
+
+			It("should forbid an unsupported ACR access mode", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:          "test-identiy",
+					ResourceGroup: "identity-resource-group",
+					ACRAccess:     ptr.To(true),
+					ACRAccessMode: ptr.To[apisazure.ACRAccessMode]("Secret"),
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("identity.acrAccessMode"),
+				}))
+			})
+
+			It("should allow an identity of another subscription and tenant", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:           "test-identiy",
+					ResourceGroup:  "identity-resource-group",
+					SubscriptionID: ptr.To("00000000-0000-0000-0000-000000000001"),
+					TenantID:       ptr.To("00000000-0000-0000-0000-000000000002"),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should require the tenant of an identity of another subscription", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:           "test-identiy",
+					ResourceGroup:  "identity-resource-group",
+					SubscriptionID: ptr.To("00000000-0000-0000-0000-000000000001"),
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("identity.tenantID"),
+				}))
+			})
+
+			It("should forbid an invalid subscription and tenant of the identity", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:           "test-identiy",
+					ResourceGroup:  "identity-resource-group",
+					SubscriptionID: ptr.To("foo"),
+					TenantID:       ptr.To("bar"),
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.subscriptionID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.tenantID"),
+				}))
+			})
+		})
+
+		Context("AuxiliaryResources", func() {
+			It("should return no errors for pinning the auxiliary resources to a region", func() {
+				infrastructureConfig.AuxiliaryResources = &apisazure.AuxiliaryResourcesConfig{
+					BootDiagnostics: true,
+					Region:          ptr.To("northeurope"),
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid an empty region", func() {
+				infrastructureConfig.AuxiliaryResources = &apisazure.AuxiliaryResourcesConfig{
+					BootDiagnostics: true,
+					Region:          ptr.To(""),
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("auxiliaryResources.region"),
+				}))
+			})
+
+			It("should forbid creating a managed identity if an existing identity is referenced", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{Name: "identity", ResourceGroup: "rg"}
+				infrastructureConfig.AuxiliaryResources = &apisazure.AuxiliaryResourcesConfig{ManagedIdentity: true}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("auxiliaryResources.managedIdentity"),
+				}))
+			})
+		})
+
+		Context("CredentialsRef", func() {
+			var shootWithResources *core.Shoot
+
+			BeforeEach(func() {
+				shootWithResources = shoot.DeepCopy()
+				shootWithResources.Spec.Resources = []core.NamedResourceReference{
+					{
+						Name:        "network-credentials",
+						ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "network-spn", APIVersion: "v1"},
+					},
+				}
+			})
+
+			It("should allow referencing a secret of the shoot's resources", func() {
+				infrastructureConfig.CredentialsRef = ptr.To("network-spn")
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, shootWithResources, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid referencing a secret which is not part of the shoot's resources", func() {
+				infrastructureConfig.CredentialsRef = ptr.To("other-spn")
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, shootWithResources, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("credentialsRef"),
+				}))
+			})
+
+			It("should forbid an empty credentials reference", func() {
+				infrastructureConfig.CredentialsRef = ptr.To("")
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, shootWithResources, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("credentialsRef"),
+				}))
+			})
+		})
+
+		Context("Observability", func() {
+			It("should allow referencing a log analytics workspace", func() {
+				infrastructureConfig.Observability = &apisazure.ObservabilityConfig{
+					LogAnalyticsWorkspaceID: "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.OperationalInsights/workspaces/workspace",
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid an empty workspace id", func() {
+				infrastructureConfig.Observability = &apisazure.ObservabilityConfig{}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("observability.logAnalyticsWorkspaceID"),
+				}))
+			})
+
+			It("should forbid resource ids of other resource types", func() {
+				infrastructureConfig.Observability = &apisazure.ObservabilityConfig{
+					LogAnalyticsWorkspaceID: "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.Storage/storageAccounts/account",
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("observability.logAnalyticsWorkspaceID"),
+				}))
+			})
+		})
+
+		Context("FlowLogs", func() {
+			const (
+				storageAccountID = "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.Storage/storageAccounts/flowlogs"
+				workspaceID      = "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.OperationalInsights/workspaces/workspace"
+			)
+
+			It("should allow a valid flow logs configuration", func() {
+				infrastructureConfig.Networks.FlowLogs = &apisazure.FlowLogsConfig{
+					StorageAccountID: storageAccountID,
+					RetentionDays:    ptr.To[int32](30),
+					TrafficAnalytics: &apisazure.TrafficAnalyticsConfig{WorkspaceID: workspaceID, IntervalInMinutes: ptr.To[int32](10)},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid a missing storage account id", func() {
+				infrastructureConfig.Networks.FlowLogs = &apisazure.FlowLogsConfig{}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.flowLogs.storageAccountID"),
+				}))
+			})
+
+			It("should forbid invalid retention days, workspace ids and intervals", func() {
+				infrastructureConfig.Networks.FlowLogs = &apisazure.FlowLogsConfig{
+					StorageAccountID: storageAccountID,
+					RetentionDays:    ptr.To[int32](366),
+					TrafficAnalytics: &apisazure.TrafficAnalyticsConfig{WorkspaceID: storageAccountID, IntervalInMinutes: ptr.To[int32](30)},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.flowLogs.retentionDays"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.flowLogs.trafficAnalytics.workspaceID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.flowLogs.trafficAnalytics.intervalInMinutes"),
+					})),
+				))
+			})
+		})
+
+		Context("PodSubnet", func() {
+			It("should return no errors for a pod subnet within the vnet", func() {
+				infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{CIDR: ptr.To("10.251.0.0/16")}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid specifying neither a cidr nor a name", func() {
+				infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.podSubnet"),
+				}))
+			})
+
+			It("should forbid specifying both a cidr and a name", func() {
+				infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{CIDR: ptr.To("10.251.0.0/16"), Name: ptr.To("pods")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.podSubnet"),
+				}))
+			})
+
+			It("should forbid a pod subnet outside of the vnet", func() {
+				infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{CIDR: ptr.To("192.168.0.0/16")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.podSubnet.cidr"),
+					"Detail": Equal(`must be a subset of "networks.vnet.cidr" ("10.0.0.0/8")`),
+				}))
+			})
+
+			It("should forbid a pod subnet overlapping with the worker subnet", func() {
+				infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{CIDR: ptr.To("10.250.3.0/25")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.podSubnet.cidr"),
+					"Detail": Equal(`must not overlap with "networks.workers" ("10.250.3.0/24")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.podSubnet.cidr"),
+					"Detail": Equal(`must not overlap with "networking.nodes" ("10.250.0.0/16")`),
+				}))
+			})
+
+			It("should forbid a pod subnet cidr without a vnet cidr", func() {
+				nodes = workers
+				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+				infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{CIDR: ptr.To("10.251.0.0/16")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.podSubnet.cidr"),
+				}))
+			})
+
+			It("should allow an existing pod subnet in an existing vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{
+					Name:          ptr.To("existing-vnet"),
+					ResourceGroup: ptr.To("existing-vnet-rg"),
+				}
+				infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{Name: ptr.To("existing-pod-subnet")}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid an existing pod subnet in a vnet managed by Gardener", func() {
+				infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{Name: ptr.To("existing-pod-subnet")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.podSubnet.name"),
+				}))
+			})
+		})
+
+		Context("OutboundAccess", func() {
+			It("should return no errors for a valid next hop", func() {
+				infrastructureConfig.Networks.OutboundAccess = &apisazure.OutboundAccessConfig{NextHopIPAddress: "10.1.0.4"}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid next hop", func() {
+				infrastructureConfig.Networks.OutboundAccess = &apisazure.OutboundAccessConfig{NextHopIPAddress: "10.1.0"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.outboundAccess.nextHopIPAddress"),
+				}))
+			})
+
+			It("should forbid an empty next hop", func() {
+				infrastructureConfig.Networks.OutboundAccess = &apisazure.OutboundAccessConfig{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.outboundAccess.nextHopIPAddress"),
+				}))
+			})
+
+			It("should forbid a next hop together with a NAT gateway", func() {
+				infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+				infrastructureConfig.Networks.OutboundAccess = &apisazure.OutboundAccessConfig{NextHopIPAddress: "10.1.0.4"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.outboundAccess"),
+				}))
+			})
+		})
+
+		Context("EgressFirewall", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.EgressFirewall = &apisazure.EgressFirewallConfig{CIDR: "10.251.0.0/26", ManagementCIDR: "10.251.0.64/26"}
+				shoot.Spec.Kubernetes.Version = "1.30.0"
+			})
+
+			AfterEach(func() {
+				shoot.Spec.Kubernetes.Version = ""
+			})
+
+			It("should return no errors for a valid egress firewall", func() {
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should allow the user-defined routing type without a next hop", func() {
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeUserDefinedRouting)
+				infrastructureConfig.Networks.EgressFirewall.PolicyID = ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/firewallPolicies/policy")
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid subnets which are too small, overlapping or outside of the vnet", func() {
+				infrastructureConfig.Networks.EgressFirewall = &apisazure.EgressFirewallConfig{CIDR: "10.250.3.0/27", ManagementCIDR: "192.168.0.0/26"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.egressFirewall.cidr"),
+					"Detail": Equal("must be at least a /26"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.egressFirewall.cidr"),
+					"Detail": Equal(`must not overlap with "networks.workers" ("10.250.3.0/24")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.egressFirewall.managementCIDR"),
+					"Detail": Equal(`must be a subset of "networks.vnet.cidr" ("10.0.0.0/8")`),
+				}))
+			})
+
+			It("should require both cidrs", func() {
+				infrastructureConfig.Networks.EgressFirewall = &apisazure.EgressFirewallConfig{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.egressFirewall.cidr"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.egressFirewall.managementCIDR"),
+				}))
+			})
+
+			It("should forbid an egress firewall together with a NAT gateway or a next hop", func() {
+				infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+				infrastructureConfig.Networks.OutboundAccess = &apisazure.OutboundAccessConfig{NextHopIPAddress: "10.1.0.4"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.outboundAccess"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.egressFirewall"),
+					"Detail": ContainSubstring("NAT gateway"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.egressFirewall"),
+					"Detail": ContainSubstring("next hop"),
+				}))
+			})
+
+			It("should forbid an egress firewall with the load balancer type", func() {
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeLoadBalancer)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.egressFirewall"),
+				}))
+			})
+
+			It("should forbid an egress firewall in an existing vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{
+					Name:          ptr.To("existing-vnet"),
+					ResourceGroup: ptr.To("existing-vnet-rg"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.egressFirewall"),
+				}))
+			})
+
+			It("should forbid an egress firewall for shoots with Kubernetes version lower than 1.30", func() {
+				shoot.Spec.Kubernetes.Version = "1.29.5"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.egressFirewall"),
+					"Detail": ContainSubstring("1.30"),
+				}))
+			})
+
+			It("should forbid a policy id which does not reference a firewall policy", func() {
+				infrastructureConfig.Networks.EgressFirewall.PolicyID = ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/natGateways/nat")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.egressFirewall.policyID"),
+				}))
+			})
+		})
+
+		Context("OutboundAccessType", func() {
+			It("should allow the NAT gateway type if a NAT gateway is configured", func() {
+				infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeNatGateway)
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid the NAT gateway type if no NAT gateway is configured", func() {
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeNatGateway)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.outboundAccessType"),
+				}))
+			})
+
+			It("should allow the load balancer type with an outbound load balancer configuration", func() {
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeLoadBalancer)
+				infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{
+					AllocatedOutboundPorts: ptr.To[int32](1024),
+					PublicIPCount:          ptr.To[int32](2),
+				}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid the load balancer type together with a NAT gateway or a next hop", func() {
+				infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true}
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeLoadBalancer)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.outboundAccessType"),
+				}))
+
+				infrastructureConfig.Networks.NatGateway = nil
+				infrastructureConfig.Networks.OutboundAccess = &apisazure.OutboundAccessConfig{NextHopIPAddress: "10.1.0.4"}
+
+				errorList = ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.outboundAccessType"),
+				}))
+			})
+
+			It("should require a next hop for the user-defined routing type", func() {
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeUserDefinedRouting)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.outboundAccess"),
+				}))
+
+				infrastructureConfig.Networks.OutboundAccess = &apisazure.OutboundAccessConfig{NextHopIPAddress: "10.1.0.4"}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid an unknown type", func() {
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType]("Foo")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.outboundAccessType"),
+				}))
+			})
+
+			It("should forbid an outbound load balancer configuration for other types", func() {
+				infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.outboundLoadBalancer"),
+				}))
+			})
+
+			It("should forbid invalid outbound load balancer settings", func() {
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeLoadBalancer)
+				infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{
+					AllocatedOutboundPorts: ptr.To[int32](1001),
+					PublicIPCount:          ptr.To[int32](17),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.outboundLoadBalancer.publicIPCount"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.outboundLoadBalancer.allocatedOutboundPorts"),
+				}))
+			})
+
+			It("should forbid allocated outbound ports exceeding the SNAT ports of the public IPs", func() {
+				shoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "worker", Maximum: 100}}
+				infrastructureConfig.Networks.OutboundAccessType = ptr.To[apisazure.OutboundAccessType](apisazure.OutboundAccessTypeLoadBalancer)
+				infrastructureConfig.Networks.OutboundLoadBalancer = &apisazure.OutboundLoadBalancerConfig{
+					AllocatedOutboundPorts: ptr.To[int32](1024),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.outboundLoadBalancer.allocatedOutboundPorts"),
+					"Detail": ContainSubstring("not sufficient"),
+				}))
+
+				infrastructureConfig.Networks.OutboundLoadBalancer.PublicIPCount = ptr.To[int32](2)
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, shoot, providerPath)).To(BeEmpty())
+			})
 		})
 
 		Context("NatGateway", func() {
@@ -399,55 +1033,168 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					infrastructureConfig.Networks.NatGateway.IPAddresses[0].Name = ""
 					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
 					Expect(errorList).To(ConsistOfFields(Fields{
-						"Type":   Equal(field.ErrorTypeRequired),
-						"Field":  Equal("networks.natGateway.ipAddresses[0].name"),
-						"Detail": Equal("Name for NatGateway public ip resource is required"),
+						"Type":   Equal(field.ErrorTypeRequired),
+						"Field":  Equal("networks.natGateway.ipAddresses[0].name"),
+						"Detail": Equal("Name for NatGateway public ip resource is required"),
+					}))
+				})
+
+				It("should fail as resource group is empty", func() {
+					infrastructureConfig.Networks.NatGateway.IPAddresses[0].ResourceGroup = ""
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeRequired),
+						"Field":  Equal("networks.natGateway.ipAddresses[0].resourceGroup"),
+						"Detail": Equal("ResourceGroup for NatGateway public ip resouce is required"),
+					}))
+				})
+			})
+
+			Context("IdleConnectionTimeoutMinutes", func() {
+				It("should return an error when specifying lower than minimum values", func() {
+					var timeoutValue int32 = 0
+					infrastructureConfig.Zoned = true
+					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true, IdleConnectionTimeoutMinutes: &timeoutValue}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(HaveLen(1))
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.natGateway.idleConnectionTimeoutMinutes"),
+						"Detail": Equal("idleConnectionTimeoutMinutes values must range between 4 and 120"),
+					}))
+				})
+
+				It("should return an error when specifying greater than maximum values", func() {
+					var timeoutValue int32 = 121
+					infrastructureConfig.Zoned = true
+					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true, IdleConnectionTimeoutMinutes: &timeoutValue}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(HaveLen(1))
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.natGateway.idleConnectionTimeoutMinutes"),
+						"Detail": Equal("idleConnectionTimeoutMinutes values must range between 4 and 120"),
+					}))
+				})
+
+				It("should succeed for valid values", func() {
+					var timeoutValue int32 = 120
+					infrastructureConfig.Zoned = true
+					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true, IdleConnectionTimeoutMinutes: &timeoutValue}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
+			})
+
+			Context("DDoSProtection", func() {
+				It("should succeed for the public IP created for the NatGateway", func() {
+					infrastructureConfig.Networks.NatGateway.DDoSProtection = &apisazure.PublicIPDDoSProtection{Mode: apisazure.PublicIPDDoSProtectionModeEnabled}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
+
+				It("should fail for an unsupported mode", func() {
+					infrastructureConfig.Networks.NatGateway.DDoSProtection = &apisazure.PublicIPDDoSProtection{Mode: "Basic"}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("networks.natGateway.ddosProtection.mode"),
+					}))
+				})
+
+				It("should fail together with user provided public IPs", func() {
+					infrastructureConfig.Networks.NatGateway.Zone = ptr.To[int32](1)
+					infrastructureConfig.Networks.NatGateway.IPAddresses = []apisazure.PublicIPReference{{Name: "public-ip-name", ResourceGroup: "public-ip-resource-group", Zone: 1}}
+					infrastructureConfig.Networks.NatGateway.DDoSProtection = &apisazure.PublicIPDDoSProtection{Mode: apisazure.PublicIPDDoSProtectionModeEnabled}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.ddosProtection"),
+					}))
+				})
+
+				It("should fail if the NatGateway is disabled", func() {
+					infrastructureConfig.Networks.NatGateway.Enabled = false
+					infrastructureConfig.Networks.NatGateway.DDoSProtection = &apisazure.PublicIPDDoSProtection{Mode: apisazure.PublicIPDDoSProtectionModeEnabled}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway"),
+					}))
+				})
+			})
+
+			Context("AutoScaleIPs", func() {
+				It("should succeed for the public IPs created for the NatGateway", func() {
+					infrastructureConfig.Networks.NatGateway.AutoScaleIPs = &apisazure.NatGatewayIPAutoScaling{NodesPerIP: 50, MaxIPCount: 4}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
+
+				It("should fail for invalid values", func() {
+					infrastructureConfig.Networks.NatGateway.AutoScaleIPs = &apisazure.NatGatewayIPAutoScaling{NodesPerIP: 0, MaxIPCount: 17}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway.autoScaleIPs.nodesPerIP"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway.autoScaleIPs.maxIPCount"),
 					}))
 				})
 
-				It("should fail as resource group is empty", func() {
-					infrastructureConfig.Networks.NatGateway.IPAddresses[0].ResourceGroup = ""
+				It("should fail together with user provided public IPs", func() {
+					infrastructureConfig.Networks.NatGateway.Zone = ptr.To[int32](1)
+					infrastructureConfig.Networks.NatGateway.IPAddresses = []apisazure.PublicIPReference{{Name: "public-ip-name", ResourceGroup: "public-ip-resource-group", Zone: 1}}
+					infrastructureConfig.Networks.NatGateway.AutoScaleIPs = &apisazure.NatGatewayIPAutoScaling{NodesPerIP: 50, MaxIPCount: 4}
 					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
 					Expect(errorList).To(ConsistOfFields(Fields{
-						"Type":   Equal(field.ErrorTypeRequired),
-						"Field":  Equal("networks.natGateway.ipAddresses[0].resourceGroup"),
-						"Detail": Equal("ResourceGroup for NatGateway public ip resouce is required"),
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.autoScaleIPs"),
 					}))
 				})
 			})
 
-			Context("IdleConnectionTimeoutMinutes", func() {
-				It("should return an error when specifying lower than minimum values", func() {
-					var timeoutValue int32 = 0
-					infrastructureConfig.Zoned = true
-					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true, IdleConnectionTimeoutMinutes: &timeoutValue}
+			Context("DNSSettings", func() {
+				It("should succeed for the public IP created for the NatGateway", func() {
+					infrastructureConfig.Networks.NatGateway.DNSSettings = &apisazure.PublicIPDNSSettings{
+						DomainNameLabel: "shoot-egress",
+						ReverseFQDN:     ptr.To("egress.example.com."),
+					}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
+
+				It("should fail for an invalid domain name label and reverse FQDN", func() {
+					infrastructureConfig.Networks.NatGateway.DNSSettings = &apisazure.PublicIPDNSSettings{
+						DomainNameLabel: "Shoot_Egress",
+						ReverseFQDN:     ptr.To("egress"),
+					}
 					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
-					Expect(errorList).To(HaveLen(1))
 					Expect(errorList).To(ConsistOfFields(Fields{
-						"Type":   Equal(field.ErrorTypeInvalid),
-						"Field":  Equal("networks.natGateway.idleConnectionTimeoutMinutes"),
-						"Detail": Equal("idleConnectionTimeoutMinutes values must range between 4 and 120"),
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway.dnsSettings.domainNameLabel"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway.dnsSettings.reverseFqdn"),
 					}))
 				})
 
-				It("should return an error when specifying greater than maximum values", func() {
-					var timeoutValue int32 = 121
-					infrastructureConfig.Zoned = true
-					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true, IdleConnectionTimeoutMinutes: &timeoutValue}
+				It("should fail together with user provided public IPs", func() {
+					infrastructureConfig.Networks.NatGateway.Zone = ptr.To[int32](1)
+					infrastructureConfig.Networks.NatGateway.IPAddresses = []apisazure.PublicIPReference{{Name: "public-ip-name", ResourceGroup: "public-ip-resource-group", Zone: 1}}
+					infrastructureConfig.Networks.NatGateway.DNSSettings = &apisazure.PublicIPDNSSettings{DomainNameLabel: "shoot-egress"}
 					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
-					Expect(errorList).To(HaveLen(1))
 					Expect(errorList).To(ConsistOfFields(Fields{
-						"Type":   Equal(field.ErrorTypeInvalid),
-						"Field":  Equal("networks.natGateway.idleConnectionTimeoutMinutes"),
-						"Detail": Equal("idleConnectionTimeoutMinutes values must range between 4 and 120"),
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.natGateway.dnsSettings"),
 					}))
 				})
 
-				It("should succeed for valid values", func() {
-					var timeoutValue int32 = 120
-					infrastructureConfig.Zoned = true
-					infrastructureConfig.Networks.NatGateway = &apisazure.NatGatewayConfig{Enabled: true, IdleConnectionTimeoutMinutes: &timeoutValue}
-					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				It("should fail if the NatGateway is disabled", func() {
+					infrastructureConfig.Networks.NatGateway.Enabled = false
+					infrastructureConfig.Networks.NatGateway.DNSSettings = &apisazure.PublicIPDNSSettings{DomainNameLabel: "shoot-egress"}
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.natGateway"),
+					}))
 				})
 			})
 		})
@@ -514,6 +1261,119 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
 			})
 
+			It("should succeed with NAT Gateway and DDoS protection", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:        true,
+					DDoSProtection: &apisazure.PublicIPDDoSProtection{Mode: apisazure.PublicIPDDoSProtectionModeVirtualNetworkInherited},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid DDoS protection together with public IPs", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:        true,
+					IPAddresses:    []apisazure.ZonedPublicIPReference{{Name: "public-ip-name", ResourceGroup: "public-ip-resource-group"}},
+					DDoSProtection: &apisazure.PublicIPDDoSProtection{Mode: apisazure.PublicIPDDoSProtectionModeEnabled},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.ddosProtection"),
+				}))
+			})
+
+			It("should succeed with NAT Gateways and distinct DNS settings", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:     true,
+					DNSSettings: &apisazure.PublicIPDNSSettings{DomainNameLabel: "shoot-egress-1"},
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:     true,
+					DNSSettings: &apisazure.PublicIPDNSSettings{DomainNameLabel: "shoot-egress-2"},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid the automatic scaling of public IPs together with public IPs", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:      true,
+					IPAddresses:  []apisazure.ZonedPublicIPReference{{Name: "public-ip-name", ResourceGroup: "public-ip-resource-group"}},
+					AutoScaleIPs: &apisazure.NatGatewayIPAutoScaling{NodesPerIP: 50, MaxIPCount: 4},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.autoScaleIPs"),
+				}))
+			})
+
+			It("should forbid the same domain name label in multiple zones", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:     true,
+					DNSSettings: &apisazure.PublicIPDNSSettings{DomainNameLabel: "shoot-egress"},
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:     true,
+					DNSSettings: &apisazure.PublicIPDNSSettings{DomainNameLabel: "shoot-egress"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[1].natGateway.dnsSettings.domainNameLabel"),
+				}))
+			})
+
+			It("should succeed with an existing NAT Gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:  true,
+					Existing: &apisazure.NatGatewayReference{Name: "nat-gateway", ResourceGroup: "nat-gateway-resource-group"},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid an incomplete reference to an existing NAT Gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:  true,
+					Existing: &apisazure.NatGatewayReference{},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[0].natGateway.existing.name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[0].natGateway.existing.resourceGroup"),
+				}))
+			})
+
+			It("should forbid configuring an existing NAT Gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Enabled:                      true,
+					Existing:                     &apisazure.NatGatewayReference{Name: "nat-gateway", ResourceGroup: "nat-gateway-resource-group"},
+					IdleConnectionTimeoutMinutes: ptr.To[int32](10),
+					IPAddresses:                  []apisazure.ZonedPublicIPReference{{Name: "public-ip-name", ResourceGroup: "public-ip-resource-group"}},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.idleConnectionTimeoutMinutes"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.ipAddresses"),
+				}))
+			})
+
+			It("should forbid referencing an existing NAT Gateway if the NAT Gateway is disabled", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisazure.ZonedNatGatewayConfig{
+					Existing: &apisazure.NatGatewayReference{Name: "nat-gateway", ResourceGroup: "nat-gateway-resource-group"},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].natGateway"),
+				}))
+			})
+
 			It("should forbid non canonical CIDRs", func() {
 				infrastructureConfig.Networks.Zones[0].CIDR = "10.250.0.1/24"
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
@@ -581,6 +1441,138 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Detail": Equal("the same zone cannot be specified multiple times"),
 				}))
 			})
+
+			Context("SecurityGroup", func() {
+				const nsgID = "/subscriptions/sub/resourceGroups/central-rg/providers/Microsoft.Network/networkSecurityGroups/central-nsg"
+
+				It("should succeed with an external security group", func() {
+					infrastructureConfig.Networks.Zones[0].SecurityGroup = &apisazure.ZoneSecurityGroupConfig{ExternalID: nsgID}
+					Expect(ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)).To(BeEmpty())
+				})
+
+				It("should forbid an empty external security group id", func() {
+					infrastructureConfig.Networks.Zones[0].SecurityGroup = &apisazure.ZoneSecurityGroupConfig{}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("networks.zones[0].securityGroup.externalID"),
+					}))
+				})
+
+				It("should forbid an invalid resource id", func() {
+					infrastructureConfig.Networks.Zones[0].SecurityGroup = &apisazure.ZoneSecurityGroupConfig{ExternalID: "central-nsg"}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.zones[0].securityGroup.externalID"),
+					}))
+				})
+
+				It("should forbid a resource id not referencing a network security group", func() {
+					infrastructureConfig.Networks.Zones[1].SecurityGroup = &apisazure.ZoneSecurityGroupConfig{
+						ExternalID: "/subscriptions/sub/resourceGroups/central-rg/providers/Microsoft.Network/routeTables/central-rt",
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &shoot, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.zones[1].securityGroup.externalID"),
+						"Detail": Equal("resource id must reference a network security group"),
+					}))
+				})
+			})
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigServiceEndpoints", func() {
+		It("should succeed with known service endpoints", func() {
+			infrastructureConfig.Networks.ServiceEndpoints = []string{"Microsoft.Storage.Global", "microsoft.sql"}
+			Expect(ValidateInfrastructureConfigServiceEndpoints(nil, infrastructureConfig, providerPath)).To(BeEmpty())
+		})
+
+		It("should forbid unknown and duplicate service endpoints", func() {
+			infrastructureConfig.Networks.ServiceEndpoints = []string{"Microsoft.Sql", "Microsoft.Storage.Europe", "microsoft.SQL"}
+			errorList := ValidateInfrastructureConfigServiceEndpoints(nil, infrastructureConfig, providerPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("networks.serviceEndpoints[1]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("networks.serviceEndpoints[2]"),
+			}))
+		})
+
+		It("should forbid the regional and the global storage service endpoint together", func() {
+			infrastructureConfig.Networks.ServiceEndpoints = []string{"Microsoft.Storage", "Microsoft.Storage.Global"}
+			errorList := ValidateInfrastructureConfigServiceEndpoints(nil, infrastructureConfig, providerPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.serviceEndpoints"),
+			}))
+		})
+
+		It("should allow keeping service endpoints of the old config", func() {
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			oldInfrastructureConfig.Networks.ServiceEndpoints = []string{"Microsoft.Storage", "Microsoft.Storage.Global", "Microsoft.Unknown"}
+			infrastructureConfig.Networks.ServiceEndpoints = []string{"Microsoft.Storage", "Microsoft.Storage.Global", "Microsoft.Unknown", "Microsoft.Sql"}
+			Expect(ValidateInfrastructureConfigServiceEndpoints(oldInfrastructureConfig, infrastructureConfig, providerPath)).To(BeEmpty())
+		})
+
+		It("should forbid adding unknown or conflicting service endpoints", func() {
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			oldInfrastructureConfig.Networks.ServiceEndpoints = []string{"Microsoft.Storage"}
+			infrastructureConfig.Networks.ServiceEndpoints = []string{"Microsoft.Storage", "Microsoft.Storage.Global", "Microsoft.Unknown"}
+			errorList := ValidateInfrastructureConfigServiceEndpoints(oldInfrastructureConfig, infrastructureConfig, providerPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("networks.serviceEndpoints[2]"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.serviceEndpoints"),
+			}))
+		})
+
+		Context("Zones", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Zoned = true
+				infrastructureConfig.Networks.Workers = nil
+				infrastructureConfig.Networks.Zones = []apisazure.Zone{
+					{Name: 1, CIDR: "10.250.0.0/24"},
+					{Name: 2, CIDR: "10.250.1.0/24"},
+				}
+			})
+
+			It("should forbid unknown service endpoints of a zone", func() {
+				infrastructureConfig.Networks.Zones[1].ServiceEndpoints = []string{"Microsoft.Storage", "Microsoft.Unknown"}
+				errorList := ValidateInfrastructureConfigServiceEndpoints(nil, infrastructureConfig, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.zones[1].serviceEndpoints[1]"),
+				}))
+			})
+
+			It("should allow keeping service endpoints of the same zone in the old config", func() {
+				oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+				oldInfrastructureConfig.Networks.Zones[1].ServiceEndpoints = []string{"Microsoft.Unknown"}
+				infrastructureConfig.Networks.Zones[1].ServiceEndpoints = []string{"Microsoft.Unknown"}
+				infrastructureConfig.Networks.Zones[0].ServiceEndpoints = []string{"Microsoft.Unknown"}
+				errorList := ValidateInfrastructureConfigServiceEndpoints(oldInfrastructureConfig, infrastructureConfig, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.zones[0].serviceEndpoints[0]"),
+				}))
+			})
+
+			It("should allow keeping service endpoints of the worker subnet when migrating to dedicated subnets per zone", func() {
+				oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+				oldInfrastructureConfig.Networks.Zones = nil
+				oldInfrastructureConfig.Networks.Workers = ptr.To("10.250.1.0/24")
+				oldInfrastructureConfig.Networks.ServiceEndpoints = []string{"Microsoft.Unknown"}
+				infrastructureConfig.Networks.Zones[1].ServiceEndpoints = []string{"Microsoft.Unknown"}
+				Expect(ValidateInfrastructureConfigServiceEndpoints(oldInfrastructureConfig, infrastructureConfig, providerPath)).To(BeEmpty())
+			})
 		})
 	})
 
@@ -643,6 +1635,22 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			errorList := ValidateInfrastructureConfigAgainstCloudProfile(infrastructureConfig, infrastructureConfig, region, &cp.Spec, providerPath)
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should allow pinning the auxiliary resources to a region present in cloudprofile", func() {
+			cp.Spec.Regions = append(cp.Spec.Regions, v1beta1.Region{Name: "paired-region"})
+			infrastructureConfig.AuxiliaryResources = &apisazure.AuxiliaryResourcesConfig{Region: ptr.To("paired-region")}
+			errorList := ValidateInfrastructureConfigAgainstCloudProfile(nil, infrastructureConfig, region, &cp.Spec, providerPath)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should deny pinning the auxiliary resources to a region not present in cloudprofile", func() {
+			infrastructureConfig.AuxiliaryResources = &apisazure.AuxiliaryResourcesConfig{Region: ptr.To("unknown-region")}
+			errorList := ValidateInfrastructureConfigAgainstCloudProfile(nil, infrastructureConfig, region, &cp.Spec, providerPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("auxiliaryResources.region"),
+			}))
+		})
 	})
 
 	Describe("#ValidateInfrastructureConfigUpdate", func() {
@@ -667,6 +1675,57 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))))
 		})
 
+		It("should forbid changing the region of the boot diagnostics storage account", func() {
+			infrastructureConfig.AuxiliaryResources = &apisazure.AuxiliaryResourcesConfig{BootDiagnostics: true}
+			newInfrastructureConfig = infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.AuxiliaryResources.Region = ptr.To("paired-region")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, providerPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("auxiliaryResources.region"),
+			}))
+		})
+
+		It("should forbid changing the region of the created managed identity", func() {
+			infrastructureConfig.AuxiliaryResources = &apisazure.AuxiliaryResourcesConfig{ManagedIdentity: true}
+			newInfrastructureConfig = infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.AuxiliaryResources.Region = ptr.To("paired-region")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, providerPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("auxiliaryResources.region"),
+			}))
+		})
+
+		It("should allow setting the region when enabling the boot diagnostics storage account", func() {
+			newInfrastructureConfig.AuxiliaryResources = &apisazure.AuxiliaryResourcesConfig{
+				BootDiagnostics: true,
+				Region:          ptr.To("paired-region"),
+			}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, providerPath)).To(BeEmpty())
+		})
+
+		It("should allow adding a pod subnet", func() {
+			newInfrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{CIDR: ptr.To("10.251.0.0/16")}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, providerPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the pod subnet", func() {
+			infrastructureConfig.Networks.PodSubnet = &apisazure.PodSubnetConfig{CIDR: ptr.To("10.251.0.0/16")}
+			newInfrastructureConfig = infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.PodSubnet.CIDR = ptr.To("10.252.0.0/16")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, providerPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.podSubnet"),
+			}))
+		})
+
 		Context("vnet config update", func() {
 			It("should allow to resize the vnet cidr", func() {
 				newInfrastructureConfig := infrastructureConfig.DeepCopy()
@@ -688,6 +1747,32 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}))
 			})
 
+			It("should allow to append additional cidrs", func() {
+				infrastructureConfig.Networks.VNet.AdditionalCIDRs = []string{"172.16.0.0/16"}
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.VNet.AdditionalCIDRs = append(newInfrastructureConfig.Networks.VNet.AdditionalCIDRs, "172.17.0.0/16")
+
+				errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, providerPath)
+				Expect(errorList).Should(BeEmpty())
+			})
+
+			DescribeTable("should forbid to change or remove additional cidrs",
+				func(additionalCIDRs []string) {
+					infrastructureConfig.Networks.VNet.AdditionalCIDRs = []string{"172.16.0.0/16", "172.17.0.0/16"}
+					newInfrastructureConfig := infrastructureConfig.DeepCopy()
+					newInfrastructureConfig.Networks.VNet.AdditionalCIDRs = additionalCIDRs
+
+					errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, providerPath)
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("networks.vnet.additionalCidrs"),
+					}))
+				},
+				Entry("removed", []string{"172.16.0.0/16"}),
+				Entry("changed", []string{"172.16.0.0/16", "172.18.0.0/16"}),
+				Entry("reordered", []string{"172.17.0.0/16", "172.16.0.0/16"}),
+			)
+
 			It("should forbid to modify the external vnet config", func() {
 				infrastructureConfig.Networks.VNet.Name = ptr.To("external-vnet-name")
 				infrastructureConfig.Networks.VNet.ResourceGroup = ptr.To("external-vnet-rg")
@@ -749,10 +1834,63 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			},
 			Entry("should pass as old and new cluster are zoned", true, true, false),
 			Entry("should pass as old and new cluster are non-zoned", false, false, false),
-			Entry("should forbid moving a zoned cluster to a non-zoned cluster", false, true, true),
-			Entry("should forbid moving a non-zoned cluster to a zoned cluster", true, false, true),
+			Entry("should leave moving a non-zoned cluster to a zoned cluster to the zonal migration validation", false, true, false),
+			Entry("should forbid moving a zoned cluster to a non-zoned cluster", true, false, true),
 		)
 
+		Describe("#ValidateInfrastructureConfigZonalMigration", func() {
+			var (
+				zonalShoot       *core.Shoot
+				cloudProfileSpec *v1beta1.CloudProfileSpec
+			)
+
+			BeforeEach(func() {
+				newInfrastructureConfig.Zoned = true
+				zonalShoot = &core.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{azuretypes.ShootZonalMigrationAnnotation: "true"},
+					},
+					Spec: core.ShootSpec{Region: "westeurope"},
+				}
+				cloudProfileSpec = &v1beta1.CloudProfileSpec{
+					Regions: []v1beta1.Region{
+						{Name: "westeurope", Zones: []v1beta1.AvailabilityZone{{Name: "1"}, {Name: "2"}}},
+						{Name: "germanynorth"},
+					},
+				}
+			})
+
+			It("should allow converting a non-zoned to a zoned cluster with the annotation", func() {
+				Expect(ValidateInfrastructureConfigZonalMigration(infrastructureConfig, newInfrastructureConfig, zonalShoot, cloudProfileSpec, providerPath)).To(BeEmpty())
+			})
+
+			It("should ignore configs which do not convert the cluster", func() {
+				delete(zonalShoot.Annotations, azuretypes.ShootZonalMigrationAnnotation)
+				infrastructureConfig.Zoned = true
+
+				Expect(ValidateInfrastructureConfigZonalMigration(infrastructureConfig, newInfrastructureConfig, zonalShoot, cloudProfileSpec, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid the conversion without the annotation", func() {
+				zonalShoot.Annotations[azuretypes.ShootZonalMigrationAnnotation] = "false"
+
+				Expect(ValidateInfrastructureConfigZonalMigration(infrastructureConfig, newInfrastructureConfig, zonalShoot, cloudProfileSpec, providerPath)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("zoned"),
+				}))
+			})
+
+			It("should forbid the conversion in regions without availability zones", func() {
+				zonalShoot.Spec.Region = "germanynorth"
+
+				Expect(ValidateInfrastructureConfigZonalMigration(infrastructureConfig, newInfrastructureConfig, zonalShoot, cloudProfileSpec, providerPath)).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("zoned"),
+					"Detail": Equal(`region "germanynorth" does not offer availability zones`),
+				}))
+			})
+		})
+
 		Context("Infrastructure Zones", func() {
 			BeforeEach(func() {
 				infrastructureConfig.Zoned = true
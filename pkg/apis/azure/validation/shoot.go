@@ -6,6 +6,7 @@ package validation
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -17,6 +18,7 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 const maxDataVolumeCount = 64
@@ -79,6 +81,14 @@ func ValidateWorkers(workers []core.Worker, infra *api.InfrastructureConfig, fld
 			allErrs = append(allErrs, validateVolume(worker.Volume, path.Child("volume"))...)
 		}
 
+		// The kubelet registers the nodes with this taint itself and the cloud-controller-manager removes it once the node
+		// is initialized, hence it must not be maintained as a taint of the worker pool.
+		for j, taint := range worker.Taints {
+			if taint.Key == azure.TaintExternalCloudProvider {
+				allErrs = append(allErrs, field.Forbidden(path.Child("taints").Index(j).Child("key"), fmt.Sprintf("taint %q is managed by the kubelet and the cloud-controller-manager", azure.TaintExternalCloudProvider)))
+			}
+		}
+
 		if length := len(worker.DataVolumes); length > maxDataVolumeCount {
 			allErrs = append(allErrs, field.TooMany(path.Child("dataVolumes"), length, maxDataVolumeCount))
 		}
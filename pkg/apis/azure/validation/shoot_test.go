@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -130,6 +131,21 @@ var _ = Describe("Shoot validation", func() {
 						})),
 					))
 				})
+
+				It("should forbid the taint of the external cloud provider", func() {
+					workers[1].Taints = []corev1.Taint{
+						{Key: "foo", Effect: corev1.TaintEffectNoSchedule},
+						{Key: "node.cloudprovider.kubernetes.io/uninitialized", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+					}
+					errorList := ValidateWorkers(workers, infraConfig, field.NewPath("workers"))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("workers[1].taints[1].key"),
+						})),
+					))
+				})
 			})
 
 			Context("Zoned cluster", func() {
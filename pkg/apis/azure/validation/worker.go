@@ -6,24 +6,138 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
 	"slices"
+	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apiazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+)
+
+// performanceDataVolumeTypes are the disk types whose performance can be configured independently of the disk size.
+var performanceDataVolumeTypes = []string{
+	string(armcompute.DiskStorageAccountTypesUltraSSDLRS),
+	string(armcompute.DiskStorageAccountTypesPremiumV2LRS),
+}
+
+// dataVolumeSourceResourceTypes are the resource types data volumes can be created from.
+var dataVolumeSourceResourceTypes = []string{
+	"Microsoft.Compute/snapshots",
+	"Microsoft.Compute/restorePointCollections/restorePoints/diskRestorePoints",
+}
+
+const (
+	// minNodeStatusUpdateFrequency is the lowest node status update frequency of the kubelet which is accepted, lower
+	// values put too much load on the API server.
+	minNodeStatusUpdateFrequency = time.Second
+	// maxNodeStatusUpdateFrequency is the default node monitor grace period of the kube-controller-manager. Nodes which
+	// do not update their status within it are considered unhealthy.
+	maxNodeStatusUpdateFrequency = 40 * time.Second
+	// defaultNodeStatusUpdateFrequency is the default node status update frequency of the kubelet.
+	defaultNodeStatusUpdateFrequency = 10 * time.Second
 )
 
 // ValidateWorkerConfig validates a WorkerConfig object.
-func ValidateWorkerConfig(workerConfig *apiazure.WorkerConfig, dataVolumes []core.DataVolume, fldPath *field.Path) field.ErrorList {
+func ValidateWorkerConfig(workerConfig *apiazure.WorkerConfig, worker *core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if workerConfig != nil {
 		allErrs = append(allErrs, validateNodeTemplate(workerConfig.NodeTemplate, fldPath)...)
-		allErrs = append(allErrs, validateDataVolumeConf(workerConfig.DataVolumes, dataVolumes, fldPath)...)
+		allErrs = append(allErrs, validateDataVolumeConf(workerConfig.DataVolumes, worker.DataVolumes, fldPath)...)
+		allErrs = append(allErrs, validateWarmPool(workerConfig.WarmPool, worker.Minimum, worker.Maximum, fldPath.Child("warmPool"))...)
+		allErrs = append(allErrs, validateVmoConfig(workerConfig.Vmo, fldPath.Child("vmo"))...)
+		allErrs = append(allErrs, validateVMTagsConfig(workerConfig.VMTags, fldPath.Child("vmTags"))...)
+		allErrs = append(allErrs, validateKubeletConfig(workerConfig.Kubelet, fldPath.Child("kubelet"))...)
+		if workerConfig.DiagnosticsProfile != nil && workerConfig.DiagnosticsProfile.StorageURI != nil {
+			allErrs = append(allErrs, validateDiagnosticsStorageURI(*workerConfig.DiagnosticsProfile.StorageURI, fldPath.Child("diagnosticsProfile", "storageURI"))...)
+		}
+		if workerConfig.MaintenanceConfiguration != nil {
+			allErrs = append(allErrs, validateMaintenanceConfiguration(*workerConfig.MaintenanceConfiguration, fldPath.Child("maintenanceConfiguration"))...)
+		}
+		if workerConfig.CloudConfiguration != nil {
+			allErrs = append(allErrs, validateCloudConfiguration(workerConfig.CloudConfiguration, fldPath.Child("cloudConfiguration"))...)
+		}
+		if workerConfig.NodesSubnet != nil && len(*workerConfig.NodesSubnet) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("nodesSubnet"), "must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigAgainstInfrastructureConfig validates the given WorkerConfig against the network layout of the
+// InfrastructureConfig.
+func ValidateWorkerConfigAgainstInfrastructureConfig(workerConfig *apiazure.WorkerConfig, infraConfig *apiazure.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || infraConfig == nil {
+		return allErrs
+	}
+
+	// only the multiple subnet network layout provides a nodes subnet per zone the worker pool can be pinned to.
+	if workerConfig.NodesSubnet != nil && (!infraConfig.Zoned || len(infraConfig.Networks.Zones) == 0) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodesSubnet"), "can only be used together with the multiple subnet network layout"))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigAgainstCloudProfile validates the given WorkerConfig against the CloudProfileConfig.
+func ValidateWorkerConfigAgainstCloudProfile(workerConfig *apiazure.WorkerConfig, region string, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.Vmo == nil || workerConfig.Vmo.FaultDomainCount == nil || cloudProfileConfig == nil {
+		return allErrs
+	}
+
+	faultDomainCountPath := fldPath.Child("vmo", "faultDomainCount")
+	maxFaultDomainCount, err := helper.FindDomainCountByRegion(cloudProfileConfig.CountFaultDomains, region)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(faultDomainCountPath, *workerConfig.Vmo.FaultDomainCount, err.Error()))
+	} else if *workerConfig.Vmo.FaultDomainCount > maxFaultDomainCount {
+		allErrs = append(allErrs, field.Invalid(faultDomainCountPath, *workerConfig.Vmo.FaultDomainCount, fmt.Sprintf("must not exceed the fault domain count of region %s (%d)", region, maxFaultDomainCount)))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerMachineAgainstCloudProfile validates that the machine image of the given worker supports the disk
+// controller type of its machine type in the CloudProfileConfig. Machine images which are not contained in the
+// CloudProfileConfig are skipped, as they are already rejected by the validation of the CloudProfile.
+func ValidateWorkerMachineAgainstCloudProfile(worker core.Worker, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cloudProfileConfig == nil || worker.Machine.Image == nil {
+		return allErrs
+	}
+
+	idx := slices.IndexFunc(cloudProfileConfig.MachineTypes, func(machineType apiazure.MachineType) bool {
+		return machineType.Name == worker.Machine.Type
+	})
+	if idx < 0 || ptr.Deref(cloudProfileConfig.MachineTypes[idx].DiskControllerType, "") != apiazure.DiskControllerTypeNVMe {
+		return allErrs
+	}
+
+	architecture := ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64)
+	machineImage, err := helper.FindImageFromCloudProfile(cloudProfileConfig, worker.Machine.Image.Name, worker.Machine.Image.Version, &architecture)
+	if err != nil {
+		return allErrs
+	}
+	if !ptr.Deref(machineImage.NVMe, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machine", "image"), fmt.Sprintf("%s/%s", machineImage.Name, machineImage.Version),
+			fmt.Sprintf("does not support the NVMe disk controller which is required by machine type %q", worker.Machine.Type)))
 	}
 
 	return allErrs
@@ -51,19 +165,192 @@ func validateDataVolumeConf(dataVolumeConfigs []apiazure.DataVolume, dataVolumes
 	allErrs := field.ErrorList{}
 	imageRefPath := fldPath.Child("dataVolumes").Child("ImageRef")
 	namePath := fldPath.Child("dataVolumes").Child("Name")
-	var dataVolumeNames []string
+	dataVolumeTypes := map[string]string{}
 
 	for _, dataVolume := range dataVolumes {
-		dataVolumeNames = append(dataVolumeNames, dataVolume.Name)
+		dataVolumeTypes[dataVolume.Name] = ptr.Deref(dataVolume.Type, "")
 	}
 
-	for _, dataVolumeConf := range dataVolumeConfigs {
+	for i, dataVolumeConf := range dataVolumeConfigs {
+		idxPath := fldPath.Child("dataVolumes").Index(i)
 		if dataVolumeConf.ImageRef != nil && *dataVolumeConf.ImageRef == (apiazure.Image{}) {
 			allErrs = append(allErrs, field.Invalid(imageRefPath, dataVolumeConf.ImageRef, "imageRef is defined but empty"))
+		} else if dataVolumeConf.ImageRef != nil && countImageReferences(*dataVolumeConf.ImageRef) > 1 {
+			allErrs = append(allErrs, field.Invalid(imageRefPath, dataVolumeConf.ImageRef, "only one of urn, id, communityGalleryImageID or sharedGalleryImageID can be set"))
 		}
-		if !slices.Contains(dataVolumeNames, dataVolumeConf.Name) {
+		if dataVolumeConf.SourceResourceID != nil {
+			if dataVolumeConf.ImageRef != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("sourceResourceID"), "sourceResourceID and imageRef cannot be set at the same time"))
+			}
+			allErrs = append(allErrs, validateDataVolumeSourceResourceID(*dataVolumeConf.SourceResourceID, idxPath.Child("sourceResourceID"))...)
+		}
+		volumeType, ok := dataVolumeTypes[dataVolumeConf.Name]
+		if !ok {
 			allErrs = append(allErrs, field.Invalid(namePath, dataVolumeConf.Name, "no dataVolume with this name exists"))
 		}
+
+		allErrs = append(allErrs, validateDataVolumePerformance(dataVolumeConf.IOPS, volumeType, ok, idxPath.Child("iops"))...)
+		allErrs = append(allErrs, validateDataVolumePerformance(dataVolumeConf.Throughput, volumeType, ok, idxPath.Child("throughput"))...)
+	}
+
+	return allErrs
+}
+
+func countImageReferences(image apiazure.Image) int {
+	count := 0
+	for _, ref := range []*string{image.URN, image.ID, image.CommunityGalleryImageID, image.SharedGalleryImageID} {
+		if ref != nil {
+			count++
+		}
+	}
+	return count
+}
+
+func validateDataVolumeSourceResourceID(sourceResourceID string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	resourceID, err := arm.ParseResourceID(sourceResourceID)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, sourceResourceID, fmt.Sprintf("invalid resource id: %v", err)))
+	}
+	if !slices.ContainsFunc(dataVolumeSourceResourceTypes, func(resourceType string) bool {
+		return strings.EqualFold(resourceID.ResourceType.String(), resourceType)
+	}) {
+		allErrs = append(allErrs, field.Invalid(fldPath, sourceResourceID, "resource id must reference a snapshot or a disk restore point"))
+	}
+
+	return allErrs
+}
+
+func validateDataVolumePerformance(value *int64, volumeType string, volumeExists bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value == nil {
+		return allErrs
+	}
+
+	if *value <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *value, "must be greater than 0"))
+	}
+	if volumeExists && !slices.Contains(performanceDataVolumeTypes, volumeType) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("can only be configured for data volumes of type %s", strings.Join(performanceDataVolumeTypes, " or "))))
+	}
+
+	return allErrs
+}
+
+func validateWarmPool(warmPool *apiazure.WarmPool, minimum, maximum int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if warmPool == nil {
+		return allErrs
+	}
+
+	if warmPool.Count <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("count"), warmPool.Count, "must be greater than 0"))
+	} else if warmPool.Count > maximum-minimum {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("count"), warmPool.Count, fmt.Sprintf("must not exceed the difference between the pool maximum and minimum (%d)", maximum-minimum)))
+	}
+
+	if warmPool.MaxAge != nil && warmPool.MaxAge.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAge"), warmPool.MaxAge.Duration.String(), "must be a positive duration"))
+	}
+
+	return allErrs
+}
+
+func validateVmoConfig(vmo *apiazure.VmoConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if vmo == nil {
+		return allErrs
+	}
+
+	if vmo.FaultDomainCount != nil && *vmo.FaultDomainCount < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("faultDomainCount"), *vmo.FaultDomainCount, "must be greater than 0"))
+	}
+	if ptr.Deref(vmo.ZoneBalance, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("zoneBalance"), "zone balancing requires a VMO spanning multiple zones, but VMOs are only used by non-zoned shoots"))
+	}
+
+	return allErrs
+}
+
+func validateVMTagsConfig(vmTags *apiazure.VMTagsConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if vmTags == nil || vmTags.MergePolicy == nil {
+		return allErrs
+	}
+
+	supportedMergePolicies := []string{
+		string(apiazure.VMTagMergePolicyPoolLabels),
+		string(apiazure.VMTagMergePolicyShootLabels),
+		string(apiazure.VMTagMergePolicyInfrastructureTags),
+	}
+	if !slices.Contains(supportedMergePolicies, string(*vmTags.MergePolicy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mergePolicy"), *vmTags.MergePolicy, supportedMergePolicies))
+	}
+
+	return allErrs
+}
+
+func validateKubeletConfig(kubelet *apiazure.KubeletConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kubelet == nil {
+		return allErrs
+	}
+
+	updateFrequency := defaultNodeStatusUpdateFrequency
+	if kubelet.NodeStatusUpdateFrequency != nil {
+		updateFrequency = kubelet.NodeStatusUpdateFrequency.Duration
+		if updateFrequency < minNodeStatusUpdateFrequency || updateFrequency >= maxNodeStatusUpdateFrequency {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeStatusUpdateFrequency"), updateFrequency.String(), fmt.Sprintf("must be at least %s and lower than the node monitor grace period of %s", minNodeStatusUpdateFrequency, maxNodeStatusUpdateFrequency)))
+		}
+	}
+	if kubelet.NodeStatusReportFrequency != nil && kubelet.NodeStatusReportFrequency.Duration < updateFrequency {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeStatusReportFrequency"), kubelet.NodeStatusReportFrequency.Duration.String(), fmt.Sprintf("must not be lower than the node status update frequency of %s", updateFrequency)))
+	}
+
+	return allErrs
+}
+
+// diagnosticsStorageHostRegex matches the host of the blob endpoint of a storage account, e.g.
+// "foo.blob.core.windows.net". Storage account names consist of 3 to 24 lower case letters and digits.
+var diagnosticsStorageHostRegex = regexp.MustCompile(`^[a-z0-9]{3,24}\.blob\.[a-z0-9.-]+[a-z0-9]$`)
+
+// validateDiagnosticsStorageURI validates the storage URI of the boot diagnostics. Azure expects the blob endpoint of
+// a storage account, which is accessed with the identity of the platform, hence SAS tokens are not supported.
+func validateDiagnosticsStorageURI(storageURI string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	u, err := url.Parse(storageURI)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, storageURI, fmt.Sprintf("invalid URI: %v", err)))
+	}
+	if u.Scheme != "https" {
+		allErrs = append(allErrs, field.Invalid(fldPath, storageURI, "must use the https scheme"))
+	}
+	if !diagnosticsStorageHostRegex.MatchString(u.Host) {
+		allErrs = append(allErrs, field.Invalid(fldPath, storageURI, "must be the blob endpoint of a storage account, e.g. https://<storage-account>.blob.core.windows.net/"))
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, storageURI, "must not contain a path, query, fragment or user information"))
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceConfiguration(id string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	resourceID, err := arm.ParseResourceID(id)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, id, fmt.Sprintf("invalid resource id: %v", err)))
+	}
+	if !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Maintenance/maintenanceConfigurations") {
+		allErrs = append(allErrs, field.Invalid(fldPath, id, "resource id must reference a maintenance configuration"))
 	}
 
 	return allErrs
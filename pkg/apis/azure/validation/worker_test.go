@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"time"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -12,6 +14,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -121,7 +124,462 @@ var _ = Describe("ValidateWorkerConfig", func() {
 					})),
 				))
 			})
+
+			It("should forbid multiple references in the DataVolume ImageRef", func() {
+				dataVolumes := []core.DataVolume{{
+					Name: "test-disk",
+				}}
+				dataVolumeConfigs := []apisazure.DataVolume{{
+					Name: "test-disk",
+					ImageRef: &apisazure.Image{
+						URN:                     ptr.To("sap:gardenlinux:greatest:1312.0.0"),
+						CommunityGalleryImageID: ptr.To("/CommunityGalleries/gallery/Images/image/Versions/1312.0.0"),
+					},
+				}}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dataVolumes.ImageRef"),
+					})),
+				))
+			})
+
+			It("should allow creating a DataVolume from a snapshot or disk restore point", func() {
+				dataVolumes := []core.DataVolume{{Name: "snapshot"}, {Name: "restore-point"}}
+				dataVolumeConfigs := []apisazure.DataVolume{
+					{
+						Name:             "snapshot",
+						SourceResourceID: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/snapshot"),
+					},
+					{
+						Name:             "restore-point",
+						SourceResourceID: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/restorePointCollections/collection/restorePoints/point/diskRestorePoints/disk"),
+					},
+				}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid DataVolume source resource", func() {
+				dataVolumes := []core.DataVolume{{Name: "invalid"}, {Name: "disk"}}
+				dataVolumeConfigs := []apisazure.DataVolume{
+					{
+						Name:             "invalid",
+						SourceResourceID: ptr.To("invalid"),
+					},
+					{
+						Name:             "disk",
+						SourceResourceID: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/disks/disk"),
+					},
+				}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dataVolumes[0].sourceResourceID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dataVolumes[1].sourceResourceID"),
+					})),
+				))
+			})
+
+			It("should forbid setting a DataVolume source resource and ImageRef at the same time", func() {
+				dataVolumes := []core.DataVolume{{Name: "test-disk"}}
+				dataVolumeConfigs := []apisazure.DataVolume{{
+					Name:             "test-disk",
+					ImageRef:         &apisazure.Image{URN: ptr.To("sap:gardenlinux:greatest:1312.0.0")},
+					SourceResourceID: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/snapshot"),
+				}}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.dataVolumes[0].sourceResourceID"),
+					})),
+				))
+			})
+
+			It("should allow performance settings for ultra and premium v2 disks", func() {
+				dataVolumes := []core.DataVolume{
+					{Name: "ultra", Type: ptr.To("UltraSSD_LRS")},
+					{Name: "premium-v2", Type: ptr.To("PremiumV2_LRS")},
+				}
+				dataVolumeConfigs := []apisazure.DataVolume{
+					{Name: "ultra", IOPS: ptr.To[int64](5000), Throughput: ptr.To[int64](200)},
+					{Name: "premium-v2", IOPS: ptr.To[int64](3000)},
+				}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid performance settings for other disk types", func() {
+				dataVolumes := []core.DataVolume{{Name: "test-disk", Type: ptr.To("Premium_LRS")}}
+				dataVolumeConfigs := []apisazure.DataVolume{{Name: "test-disk", IOPS: ptr.To[int64](5000), Throughput: ptr.To[int64](200)}}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.dataVolumes[0].iops"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.dataVolumes[0].throughput"),
+					})),
+				))
+			})
+
+			It("should forbid non-positive performance settings", func() {
+				dataVolumes := []core.DataVolume{{Name: "test-disk", Type: ptr.To("UltraSSD_LRS")}}
+				dataVolumeConfigs := []apisazure.DataVolume{{Name: "test-disk", IOPS: ptr.To[int64](0)}}
+
+				Expect(validateDataVolumeConf(dataVolumeConfigs, dataVolumes, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dataVolumes[0].iops"),
+					})),
+				))
+			})
+		})
+
+		Describe("WarmPool", func() {
+			It("should allow a warm pool which fits into the pool range", func() {
+				warmPool := &apisazure.WarmPool{
+					Count:  2,
+					MaxAge: &metav1.Duration{Duration: 10 * time.Minute},
+				}
+
+				Expect(validateWarmPool(warmPool, 1, 3, fldPath.Child("warmPool"))).To(BeEmpty())
+			})
+
+			It("should forbid a non-positive count", func() {
+				Expect(validateWarmPool(&apisazure.WarmPool{}, 1, 3, fldPath.Child("warmPool"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.warmPool.count"),
+					})),
+				))
+			})
+
+			It("should forbid a warm pool exceeding the pool maximum", func() {
+				Expect(validateWarmPool(&apisazure.WarmPool{Count: 3}, 1, 3, fldPath.Child("warmPool"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.warmPool.count"),
+					})),
+				))
+			})
+
+			It("should forbid a non-positive max age", func() {
+				warmPool := &apisazure.WarmPool{
+					Count:  1,
+					MaxAge: &metav1.Duration{},
+				}
+
+				Expect(validateWarmPool(warmPool, 1, 3, fldPath.Child("warmPool"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.warmPool.maxAge"),
+					})),
+				))
+			})
+		})
+
+		Describe("Vmo", func() {
+			It("should allow a positive fault domain count", func() {
+				Expect(validateVmoConfig(&apisazure.VmoConfig{FaultDomainCount: ptr.To[int32](2)}, fldPath.Child("vmo"))).To(BeEmpty())
+			})
+
+			It("should forbid a non-positive fault domain count", func() {
+				Expect(validateVmoConfig(&apisazure.VmoConfig{FaultDomainCount: ptr.To[int32](0)}, fldPath.Child("vmo"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.vmo.faultDomainCount"),
+					})),
+				))
+			})
+
+			It("should allow to disable zone balancing", func() {
+				Expect(validateVmoConfig(&apisazure.VmoConfig{ZoneBalance: ptr.To(false)}, fldPath.Child("vmo"))).To(BeEmpty())
+			})
+
+			It("should forbid to enable zone balancing", func() {
+				Expect(validateVmoConfig(&apisazure.VmoConfig{ZoneBalance: ptr.To(true)}, fldPath.Child("vmo"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.vmo.zoneBalance"),
+					})),
+				))
+			})
+		})
+
+		Describe("VMTags", func() {
+			It("should allow a supported merge policy", func() {
+				mergePolicy := apisazure.VMTagMergePolicyShootLabels
+				Expect(validateVMTagsConfig(&apisazure.VMTagsConfig{MergePolicy: &mergePolicy}, fldPath.Child("vmTags"))).To(BeEmpty())
+			})
+
+			It("should forbid an unsupported merge policy", func() {
+				mergePolicy := apisazure.VMTagMergePolicy("Random")
+				Expect(validateVMTagsConfig(&apisazure.VMTagsConfig{MergePolicy: &mergePolicy}, fldPath.Child("vmTags"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("config.vmTags.mergePolicy"),
+					})),
+				))
+			})
+		})
+
+		Describe("Kubelet", func() {
+			It("should allow custom node status frequencies", func() {
+				Expect(validateKubeletConfig(&apisazure.KubeletConfig{
+					NodeStatusUpdateFrequency: &metav1.Duration{Duration: 5 * time.Second},
+					NodeStatusReportFrequency: &metav1.Duration{Duration: time.Minute},
+				}, fldPath.Child("kubelet"))).To(BeEmpty())
+			})
+
+			It("should forbid a node status update frequency exceeding the node monitor grace period", func() {
+				Expect(validateKubeletConfig(&apisazure.KubeletConfig{
+					NodeStatusUpdateFrequency: &metav1.Duration{Duration: time.Minute},
+				}, fldPath.Child("kubelet"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.kubelet.nodeStatusUpdateFrequency"),
+					})),
+				))
+			})
+
+			It("should forbid a node status report frequency lower than the update frequency", func() {
+				Expect(validateKubeletConfig(&apisazure.KubeletConfig{
+					NodeStatusReportFrequency: &metav1.Duration{Duration: 5 * time.Second},
+				}, fldPath.Child("kubelet"))).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.kubelet.nodeStatusReportFrequency"),
+					})),
+				))
+			})
+		})
+
+		Describe("CloudConfiguration", func() {
+			It("should allow a well-known cloud instance", func() {
+				Expect(ValidateWorkerConfig(&apisazure.WorkerConfig{
+					CloudConfiguration: &apisazure.CloudConfiguration{Name: apisazure.AzureChinaCloudName},
+				}, &core.Worker{}, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid unknown cloud instances", func() {
+				Expect(ValidateWorkerConfig(&apisazure.WorkerConfig{
+					CloudConfiguration: &apisazure.CloudConfiguration{Name: "foo"},
+				}, &core.Worker{}, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("config.cloudConfiguration.name"),
+					})),
+				))
+			})
+		})
+
+		Describe("MaintenanceConfiguration", func() {
+			It("should allow maintenance configurations", func() {
+				Expect(ValidateWorkerConfig(&apisazure.WorkerConfig{
+					MaintenanceConfiguration: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Maintenance/maintenanceConfigurations/weekend"),
+				}, &core.Worker{}, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid ids which do not reference a maintenance configuration", func() {
+				Expect(ValidateWorkerConfig(&apisazure.WorkerConfig{
+					MaintenanceConfiguration: ptr.To("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet"),
+				}, &core.Worker{}, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.maintenanceConfiguration"),
+					})),
+				))
+			})
+		})
+
+		Describe("NodesSubnet", func() {
+			It("should allow a nodes subnet", func() {
+				Expect(ValidateWorkerConfig(&apisazure.WorkerConfig{
+					NodesSubnet: ptr.To("shoot--foo--bar-nodes-z2"),
+				}, &core.Worker{}, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid an empty nodes subnet", func() {
+				Expect(ValidateWorkerConfig(&apisazure.WorkerConfig{
+					NodesSubnet: ptr.To(""),
+				}, &core.Worker{}, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("config.nodesSubnet"),
+					})),
+				))
+			})
+		})
+
+		Describe("DiagnosticsProfile", func() {
+			DescribeTable("should allow the blob endpoints of storage accounts",
+				func(storageURI string) {
+					Expect(ValidateWorkerConfig(&apisazure.WorkerConfig{
+						DiagnosticsProfile: &apisazure.DiagnosticsProfile{Enabled: true, StorageURI: ptr.To(storageURI)},
+					}, &core.Worker{}, fldPath)).To(BeEmpty())
+				},
+				Entry("public cloud", "https://diagnostics01.blob.core.windows.net/"),
+				Entry("without trailing slash", "https://diagnostics01.blob.core.windows.net"),
+				Entry("sovereign cloud", "https://diagnostics01.blob.core.chinacloudapi.cn/"),
+			)
+
+			DescribeTable("should forbid invalid storage URIs",
+				func(storageURI string) {
+					Expect(ValidateWorkerConfig(&apisazure.WorkerConfig{
+						DiagnosticsProfile: &apisazure.DiagnosticsProfile{Enabled: true, StorageURI: ptr.To(storageURI)},
+					}, &core.Worker{}, fldPath)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.diagnosticsProfile.storageURI"),
+					}))))
+				},
+				Entry("http scheme", "http://diagnostics01.blob.core.windows.net/"),
+				Entry("no blob endpoint", "https://diagnostics01.file.core.windows.net/"),
+				Entry("invalid storage account name", "https://Diagnostics_01.blob.core.windows.net/"),
+				Entry("container path", "https://diagnostics01.blob.core.windows.net/boot"),
+				Entry("SAS token", "https://diagnostics01.blob.core.windows.net/?sv=2022-11-02&sig=foo"),
+				Entry("no URI", "diagnostics01"),
+			)
+		})
+	})
+
+	Describe("#ValidateWorkerConfigAgainstInfrastructureConfig", func() {
+		var (
+			fldPath      = field.NewPath("config")
+			workerConfig *apisazure.WorkerConfig
+			infraConfig  *apisazure.InfrastructureConfig
+		)
+
+		BeforeEach(func() {
+			workerConfig = &apisazure.WorkerConfig{
+				NodesSubnet: ptr.To("shoot--foo--bar-nodes-z2"),
+			}
+			infraConfig = &apisazure.InfrastructureConfig{
+				Zoned: true,
+			}
+		})
+
+		It("should allow a nodes subnet in the multiple subnet network layout", func() {
+			infraConfig.Networks.Zones = []apisazure.Zone{{Name: 1, CIDR: "10.250.0.0/24"}, {Name: 2, CIDR: "10.250.1.0/24"}}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructureConfig(workerConfig, infraConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a nodes subnet outside of the multiple subnet network layout", func() {
+			Expect(ValidateWorkerConfigAgainstInfrastructureConfig(workerConfig, infraConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.nodesSubnet"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerConfigAgainstCloudProfile", func() {
+		var (
+			fldPath            = field.NewPath("config")
+			cloudProfileConfig *apisazure.CloudProfileConfig
+		)
+
+		BeforeEach(func() {
+			cloudProfileConfig = &apisazure.CloudProfileConfig{
+				CountFaultDomains: []apisazure.DomainCount{{Region: "westeurope", Count: 2}},
+			}
+		})
+
+		It("should allow a fault domain count within the region's count", func() {
+			workerConfig := &apisazure.WorkerConfig{Vmo: &apisazure.VmoConfig{FaultDomainCount: ptr.To[int32](2)}}
+
+			Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, "westeurope", cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a fault domain count exceeding the region's count", func() {
+			workerConfig := &apisazure.WorkerConfig{Vmo: &apisazure.VmoConfig{FaultDomainCount: ptr.To[int32](3)}}
+
+			Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, "westeurope", cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.vmo.faultDomainCount"),
+				})),
+			))
+		})
+
+		It("should forbid a fault domain count if the region has no fault domain count", func() {
+			workerConfig := &apisazure.WorkerConfig{Vmo: &apisazure.VmoConfig{FaultDomainCount: ptr.To[int32](1)}}
+
+			Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, "eastus", cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.vmo.faultDomainCount"),
+				})),
+			))
 		})
 	})
 
+	Describe("#ValidateWorkerMachineAgainstCloudProfile", func() {
+		var (
+			fldPath            = field.NewPath("workers").Index(0)
+			cloudProfileConfig *apisazure.CloudProfileConfig
+			worker             core.Worker
+		)
+
+		BeforeEach(func() {
+			cloudProfileConfig = &apisazure.CloudProfileConfig{
+				MachineTypes: []apisazure.MachineType{
+					{Name: "Standard_D4as_v6", DiskControllerType: ptr.To(apisazure.DiskControllerTypeNVMe)},
+					{Name: "Standard_D4as_v5"},
+				},
+				MachineImages: []apisazure.MachineImages{{
+					Name: "gardenlinux",
+					Versions: []apisazure.MachineImageVersion{
+						{Version: "1.0.0", Architecture: ptr.To("amd64"), NVMe: ptr.To(true)},
+						{Version: "0.9.0", Architecture: ptr.To("amd64")},
+					},
+				}},
+			}
+			worker = core.Worker{
+				Machine: core.Machine{
+					Type:  "Standard_D4as_v6",
+					Image: &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+				},
+			}
+		})
+
+		It("should allow a machine image supporting the NVMe disk controller of the machine type", func() {
+			Expect(ValidateWorkerMachineAgainstCloudProfile(worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a machine image without NVMe support for machine types without the NVMe disk controller", func() {
+			worker.Machine.Type = "Standard_D4as_v5"
+			worker.Machine.Image.Version = "0.9.0"
+
+			Expect(ValidateWorkerMachineAgainstCloudProfile(worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid a machine image without NVMe support for machine types with the NVMe disk controller", func() {
+			worker.Machine.Image.Version = "0.9.0"
+
+			Expect(ValidateWorkerMachineAgainstCloudProfile(worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("workers[0].machine.image"),
+					"BadValue": Equal("gardenlinux/0.9.0"),
+				})),
+			))
+		})
+
+		It("should skip machine images which are not contained in the CloudProfileConfig", func() {
+			worker.Machine.Image.Version = "2.0.0"
+
+			Expect(ValidateWorkerMachineAgainstCloudProfile(worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+	})
 })
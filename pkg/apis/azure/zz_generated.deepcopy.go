@@ -11,9 +11,32 @@ package azure
 
 import (
 	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuxiliaryResourcesConfig) DeepCopyInto(out *AuxiliaryResourcesConfig) {
+	*out = *in
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuxiliaryResourcesConfig.
+func (in *AuxiliaryResourcesConfig) DeepCopy() *AuxiliaryResourcesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuxiliaryResourcesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilitySet) DeepCopyInto(out *AvailabilitySet) {
 	*out = *in
@@ -40,6 +63,27 @@ func (in *AvailabilitySet) DeepCopy() *AvailabilitySet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilitySetMigrationStatus) DeepCopyInto(out *AvailabilitySetMigrationStatus) {
+	*out = *in
+	if in.MigratedWorkerPools != nil {
+		in, out := &in.MigratedWorkerPools, &out.MigratedWorkerPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilitySetMigrationStatus.
+func (in *AvailabilitySetMigrationStatus) DeepCopy() *AvailabilitySetMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilitySetMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureResource) DeepCopyInto(out *AzureResource) {
 	*out = *in
@@ -63,8 +107,53 @@ func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
 	if in.CloudConfiguration != nil {
 		in, out := &in.CloudConfiguration, &out.CloudConfiguration
 		*out = new(CloudConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroupRegion != nil {
+		in, out := &in.ResourceGroupRegion, &out.ResourceGroupRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	if in.NetworkACLs != nil {
+		in, out := &in.NetworkACLs, &out.NetworkACLs
+		*out = new(BackupBucketNetworkACLs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(RotationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(BackupBucketInventory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequireInfrastructureEncryption != nil {
+		in, out := &in.RequireInfrastructureEncryption, &out.RequireInfrastructureEncryption
+		*out = new(bool)
 		**out = **in
 	}
+	if in.MinimumTLSVersion != nil {
+		in, out := &in.MinimumTLSVersion, &out.MinimumTLSVersion
+		*out = new(MinimumTLSVersion)
+		**out = **in
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(BackupBucketLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LegalHold != nil {
+		in, out := &in.LegalHold, &out.LegalHold
+		*out = new(BackupBucketLegalHold)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -86,9 +175,200 @@ func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketInventory) DeepCopyInto(out *BackupBucketInventory) {
+	*out = *in
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketInventory.
+func (in *BackupBucketInventory) DeepCopy() *BackupBucketInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketLegalHold) DeepCopyInto(out *BackupBucketLegalHold) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketLegalHold.
+func (in *BackupBucketLegalHold) DeepCopy() *BackupBucketLegalHold {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketLegalHold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketLifecycle) DeepCopyInto(out *BackupBucketLifecycle) {
+	*out = *in
+	if in.TierToColdAfterDays != nil {
+		in, out := &in.TierToColdAfterDays, &out.TierToColdAfterDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeleteAfterDays != nil {
+		in, out := &in.DeleteAfterDays, &out.DeleteAfterDays
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketLifecycle.
+func (in *BackupBucketLifecycle) DeepCopy() *BackupBucketLifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketLifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketNetworkACLs) DeepCopyInto(out *BackupBucketNetworkACLs) {
+	*out = *in
+	if in.IPRules != nil {
+		in, out := &in.IPRules, &out.IPRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VirtualNetworkRules != nil {
+		in, out := &in.VirtualNetworkRules, &out.VirtualNetworkRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketNetworkACLs.
+func (in *BackupBucketNetworkACLs) DeepCopy() *BackupBucketNetworkACLs {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketNetworkACLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketStatus) DeepCopyInto(out *BackupBucketStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.LegalHoldTags != nil {
+		in, out := &in.LegalHoldTags, &out.LegalHoldTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketStatus.
+func (in *BackupBucketStatus) DeepCopy() *BackupBucketStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupBucketStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootDiagnosticsStatus) DeepCopyInto(out *BootDiagnosticsStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootDiagnosticsStatus.
+func (in *BootDiagnosticsStatus) DeepCopy() *BootDiagnosticsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BootDiagnosticsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSIDriverControllerConfig) DeepCopyInto(out *CSIDriverControllerConfig) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LeaderElection != nil {
+		in, out := &in.LeaderElection, &out.LeaderElection
+		*out = new(LeaderElectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSIDriverControllerConfig.
+func (in *CSIDriverControllerConfig) DeepCopy() *CSIDriverControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CSIDriverControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudConfiguration) DeepCopyInto(out *CloudConfiguration) {
 	*out = *in
+	if in.ActiveDirectoryAuthorityHost != nil {
+		in, out := &in.ActiveDirectoryAuthorityHost, &out.ActiveDirectoryAuthorityHost
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceManagerEndpoint != nil {
+		in, out := &in.ResourceManagerEndpoint, &out.ResourceManagerEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceManagerAudience != nil {
+		in, out := &in.ResourceManagerAudience, &out.ResourceManagerAudience
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageEndpointSuffix != nil {
+		in, out := &in.StorageEndpointSuffix, &out.StorageEndpointSuffix
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -112,6 +392,26 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 			(*out)[key] = val
 		}
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LeaderElection != nil {
+		in, out := &in.LeaderElection, &out.LeaderElection
+		*out = new(LeaderElectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -156,7 +456,7 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 	if in.CloudConfiguration != nil {
 		in, out := &in.CloudConfiguration, &out.CloudConfiguration
 		*out = new(CloudConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -188,11 +488,21 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(CloudControllerManagerConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CSIDriverController != nil {
+		in, out := &in.CSIDriverController, &out.CSIDriverController
+		*out = new(CSIDriverControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Remedy != nil {
+		in, out := &in.Remedy, &out.Remedy
+		*out = new(RemedyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -214,6 +524,51 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordConfig) DeepCopyInto(out *DNSRecordConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.AliasTarget != nil {
+		in, out := &in.AliasTarget, &out.AliasTarget
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordConfig.
+func (in *DNSRecordConfig) DeepCopy() *DNSRecordConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecordConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 	*out = *in
@@ -222,6 +577,21 @@ func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 		*out = new(Image)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SourceResourceID != nil {
+		in, out := &in.SourceResourceID, &out.SourceResourceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -273,44 +643,220 @@ func (in *DomainCount) DeepCopy() *DomainCount {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityConfig) DeepCopyInto(out *IdentityConfig) {
+func (in *EgressCIDRsHistoryEntry) DeepCopyInto(out *EgressCIDRsHistoryEntry) {
 	*out = *in
-	if in.ACRAccess != nil {
-		in, out := &in.ACRAccess, &out.ACRAccess
-		*out = new(bool)
-		**out = **in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityConfig.
-func (in *IdentityConfig) DeepCopy() *IdentityConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressCIDRsHistoryEntry.
+func (in *EgressCIDRsHistoryEntry) DeepCopy() *EgressCIDRsHistoryEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(IdentityConfig)
+	out := new(EgressCIDRsHistoryEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityStatus) DeepCopyInto(out *IdentityStatus) {
+func (in *EgressFirewallConfig) DeepCopyInto(out *EgressFirewallConfig) {
 	*out = *in
+	if in.PolicyID != nil {
+		in, out := &in.PolicyID, &out.PolicyID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityStatus.
-func (in *IdentityStatus) DeepCopy() *IdentityStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressFirewallConfig.
+func (in *EgressFirewallConfig) DeepCopy() *EgressFirewallConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(IdentityStatus)
+	out := new(EgressFirewallConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *EgressFirewallStatus) DeepCopyInto(out *EgressFirewallStatus) {
+	*out = *in
+	out.PublicIPAddress = in.PublicIPAddress
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressFirewallStatus.
+func (in *EgressFirewallStatus) DeepCopy() *EgressFirewallStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressFirewallStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedVMRemedyConfig) DeepCopyInto(out *FailedVMRemedyConfig) {
+	*out = *in
+	if in.RequeueInterval != nil {
+		in, out := &in.RequeueInterval, &out.RequeueInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxGetAttempts != nil {
+		in, out := &in.MaxGetAttempts, &out.MaxGetAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReapplyAttempts != nil {
+		in, out := &in.MaxReapplyAttempts, &out.MaxReapplyAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedVMRemedyConfig.
+func (in *FailedVMRemedyConfig) DeepCopy() *FailedVMRemedyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedVMRemedyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLogsConfig) DeepCopyInto(out *FlowLogsConfig) {
+	*out = *in
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TrafficAnalytics != nil {
+		in, out := &in.TrafficAnalytics, &out.TrafficAnalytics
+		*out = new(TrafficAnalyticsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogsConfig.
+func (in *FlowLogsConfig) DeepCopy() *FlowLogsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLogsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowStepState) DeepCopyInto(out *FlowStepState) {
+	*out = *in
+	if in.LastSucceededGeneration != nil {
+		in, out := &in.LastSucceededGeneration, &out.LastSucceededGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastSucceededTime != nil {
+		in, out := &in.LastSucceededTime, &out.LastSucceededTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailedTime != nil {
+		in, out := &in.LastFailedTime, &out.LastFailedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowStepState.
+func (in *FlowStepState) DeepCopy() *FlowStepState {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowStepState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityConfig) DeepCopyInto(out *IdentityConfig) {
+	*out = *in
+	if in.ACRAccess != nil {
+		in, out := &in.ACRAccess, &out.ACRAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ACRAccessMode != nil {
+		in, out := &in.ACRAccessMode, &out.ACRAccessMode
+		*out = new(ACRAccessMode)
+		**out = **in
+	}
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantID != nil {
+		in, out := &in.TenantID, &out.TenantID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityConfig.
+func (in *IdentityConfig) DeepCopy() *IdentityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityStatus) DeepCopyInto(out *IdentityStatus) {
+	*out = *in
+	if in.ACRAccessMode != nil {
+		in, out := &in.ACRAccessMode, &out.ACRAccessMode
+		*out = new(ACRAccessMode)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityStatus.
+func (in *IdentityStatus) DeepCopy() *IdentityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
 	if in.URN != nil {
 		in, out := &in.URN, &out.URN
@@ -352,7 +898,7 @@ func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
 	if in.ResourceGroup != nil {
 		in, out := &in.ResourceGroup, &out.ResourceGroup
 		*out = new(ResourceGroup)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	in.Networks.DeepCopyInto(&out.Networks)
 	if in.Identity != nil {
@@ -360,6 +906,21 @@ func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
 		*out = new(IdentityConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AuxiliaryResources != nil {
+		in, out := &in.AuxiliaryResources, &out.AuxiliaryResources
+		*out = new(AuxiliaryResourcesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(ObservabilityConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -397,6 +958,13 @@ func (in *InfrastructureState) DeepCopyInto(out *InfrastructureState) {
 		*out = make([]AzureResource, len(*in))
 		copy(*out, *in)
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]FlowStepState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -423,7 +991,7 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.Networks.DeepCopyInto(&out.Networks)
-	out.ResourceGroup = in.ResourceGroup
+	in.ResourceGroup.DeepCopyInto(&out.ResourceGroup)
 	if in.AvailabilitySets != nil {
 		in, out := &in.AvailabilitySets, &out.AvailabilitySets
 		*out = make([]AvailabilitySet, len(*in))
@@ -431,6 +999,11 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AvailabilitySetMigration != nil {
+		in, out := &in.AvailabilitySetMigration, &out.AvailabilitySetMigration
+		*out = new(AvailabilitySetMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RouteTables != nil {
 		in, out := &in.RouteTables, &out.RouteTables
 		*out = make([]RouteTable, len(*in))
@@ -444,8 +1017,25 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 	if in.Identity != nil {
 		in, out := &in.Identity, &out.Identity
 		*out = new(IdentityStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootDiagnostics != nil {
+		in, out := &in.BootDiagnostics, &out.BootDiagnostics
+		*out = new(BootDiagnosticsStatus)
 		**out = **in
 	}
+	if in.EgressCIDRsHistory != nil {
+		in, out := &in.EgressCIDRsHistory, &out.EgressCIDRsHistory
+		*out = make([]EgressCIDRsHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ZoneMappings != nil {
+		in, out := &in.ZoneMappings, &out.ZoneMappings
+		*out = make([]ZoneMapping, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -467,6 +1057,110 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	if in.NodeStatusUpdateFrequency != nil {
+		in, out := &in.NodeStatusUpdateFrequency, &out.NodeStatusUpdateFrequency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NodeStatusReportFrequency != nil {
+		in, out := &in.NodeStatusReportFrequency, &out.NodeStatusReportFrequency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeaderElectionConfig) DeepCopyInto(out *LeaderElectionConfig) {
+	*out = *in
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewDeadline != nil {
+		in, out := &in.RenewDeadline, &out.RenewDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryPeriod != nil {
+		in, out := &in.RetryPeriod, &out.RetryPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderElectionConfig.
+func (in *LeaderElectionConfig) DeepCopy() *LeaderElectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LeaderElectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	if in.SKU != nil {
+		in, out := &in.SKU, &out.SKU
+		*out = new(LoadBalancerSKU)
+		**out = **in
+	}
+	if in.OutboundRule != nil {
+		in, out := &in.OutboundRule, &out.OutboundRule
+		*out = new(OutboundRuleConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableOutboundSNAT != nil {
+		in, out := &in.DisableOutboundSNAT, &out.DisableOutboundSNAT
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalDisks) DeepCopyInto(out *LocalDisks) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDisks.
+func (in *LocalDisks) DeepCopy() *LocalDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 	*out = *in
@@ -475,6 +1169,11 @@ func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NVMe != nil {
+		in, out := &in.NVMe, &out.NVMe
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Architecture != nil {
 		in, out := &in.Architecture, &out.Architecture
 		*out = new(string)
@@ -485,6 +1184,11 @@ func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MinimumOSDiskSizeGB != nil {
+		in, out := &in.MinimumOSDiskSizeGB, &out.MinimumOSDiskSizeGB
+		*out = new(int32)
+		**out = **in
+	}
 	in.Image.DeepCopyInto(&out.Image)
 	return
 }
@@ -532,11 +1236,21 @@ func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NVMe != nil {
+		in, out := &in.NVMe, &out.NVMe
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Architecture != nil {
 		in, out := &in.Architecture, &out.Architecture
 		*out = new(string)
 		**out = **in
 	}
+	if in.MinimumOSDiskSizeGB != nil {
+		in, out := &in.MinimumOSDiskSizeGB, &out.MinimumOSDiskSizeGB
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -563,175 +1277,612 @@ func (in *MachineImages) DeepCopyInto(out *MachineImages) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
-func (in *MachineImages) DeepCopy() *MachineImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
+func (in *MachineImages) DeepCopy() *MachineImages {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineType) DeepCopyInto(out *MachineType) {
+	*out = *in
+	if in.AcceleratedNetworking != nil {
+		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DiskControllerType != nil {
+		in, out := &in.DiskControllerType, &out.DiskControllerType
+		*out = new(DiskControllerType)
+		**out = **in
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.AttachLimits != nil {
+		in, out := &in.AttachLimits, &out.AttachLimits
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineType.
+func (in *MachineType) DeepCopy() *MachineType {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
+	*out = *in
+	if in.IdleConnectionTimeoutMinutes != nil {
+		in, out := &in.IdleConnectionTimeoutMinutes, &out.IdleConnectionTimeoutMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]PublicIPReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DDoSProtection != nil {
+		in, out := &in.DDoSProtection, &out.DDoSProtection
+		*out = new(PublicIPDDoSProtection)
+		**out = **in
+	}
+	if in.DNSSettings != nil {
+		in, out := &in.DNSSettings, &out.DNSSettings
+		*out = new(PublicIPDNSSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoScaleIPs != nil {
+		in, out := &in.AutoScaleIPs, &out.AutoScaleIPs
+		*out = new(NatGatewayIPAutoScaling)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayConfig.
+func (in *NatGatewayConfig) DeepCopy() *NatGatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayIPAutoScaling) DeepCopyInto(out *NatGatewayIPAutoScaling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayIPAutoScaling.
+func (in *NatGatewayIPAutoScaling) DeepCopy() *NatGatewayIPAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayIPAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayReference) DeepCopyInto(out *NatGatewayReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayReference.
+func (in *NatGatewayReference) DeepCopy() *NatGatewayReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayStatus) DeepCopyInto(out *NatGatewayStatus) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPAddresses != nil {
+		in, out := &in.PublicIPAddresses, &out.PublicIPAddresses
+		*out = make([]PublicIPAddressStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayStatus.
+func (in *NatGatewayStatus) DeepCopy() *NatGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+	*out = *in
+	in.VNet.DeepCopyInto(&out.VNet)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(string)
+		**out = **in
+	}
+	if in.NatGateway != nil {
+		in, out := &in.NatGateway, &out.NatGateway
+		*out = new(NatGatewayConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceEndpoints != nil {
+		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]Zone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodSubnet != nil {
+		in, out := &in.PodSubnet, &out.PodSubnet
+		*out = new(PodSubnetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutboundAccess != nil {
+		in, out := &in.OutboundAccess, &out.OutboundAccess
+		*out = new(OutboundAccessConfig)
+		**out = **in
+	}
+	if in.OutboundAccessType != nil {
+		in, out := &in.OutboundAccessType, &out.OutboundAccessType
+		*out = new(OutboundAccessType)
+		**out = **in
+	}
+	if in.OutboundLoadBalancer != nil {
+		in, out := &in.OutboundLoadBalancer, &out.OutboundLoadBalancer
+		*out = new(OutboundLoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(FlowLogsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressFirewall != nil {
+		in, out := &in.EgressFirewall, &out.EgressFirewall
+		*out = new(EgressFirewallConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
+func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	in.VNet.DeepCopyInto(&out.VNet)
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NatGateways != nil {
+		in, out := &in.NatGateways, &out.NatGateways
+		*out = make([]NatGatewayStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OutboundLoadBalancer != nil {
+		in, out := &in.OutboundLoadBalancer, &out.OutboundLoadBalancer
+		*out = new(OutboundLoadBalancerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressFirewall != nil {
+		in, out := &in.EgressFirewall, &out.EgressFirewall
+		*out = new(EgressFirewallStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityConfig.
+func (in *ObservabilityConfig) DeepCopy() *ObservabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedPublicIPRemedyConfig) DeepCopyInto(out *OrphanedPublicIPRemedyConfig) {
+	*out = *in
+	if in.RequeueInterval != nil {
+		in, out := &in.RequeueInterval, &out.RequeueInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DeletionGracePeriod != nil {
+		in, out := &in.DeletionGracePeriod, &out.DeletionGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxGetAttempts != nil {
+		in, out := &in.MaxGetAttempts, &out.MaxGetAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCleanAttempts != nil {
+		in, out := &in.MaxCleanAttempts, &out.MaxCleanAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedPublicIPRemedyConfig.
+func (in *OrphanedPublicIPRemedyConfig) DeepCopy() *OrphanedPublicIPRemedyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedPublicIPRemedyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundAccessConfig) DeepCopyInto(out *OutboundAccessConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundAccessConfig.
+func (in *OutboundAccessConfig) DeepCopy() *OutboundAccessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundAccessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundLoadBalancerConfig) DeepCopyInto(out *OutboundLoadBalancerConfig) {
+	*out = *in
+	if in.AllocatedOutboundPorts != nil {
+		in, out := &in.AllocatedOutboundPorts, &out.AllocatedOutboundPorts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PublicIPCount != nil {
+		in, out := &in.PublicIPCount, &out.PublicIPCount
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundLoadBalancerConfig.
+func (in *OutboundLoadBalancerConfig) DeepCopy() *OutboundLoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundLoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundLoadBalancerStatus) DeepCopyInto(out *OutboundLoadBalancerStatus) {
+	*out = *in
+	if in.PublicIPAddresses != nil {
+		in, out := &in.PublicIPAddresses, &out.PublicIPAddresses
+		*out = make([]PublicIPAddressStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundLoadBalancerStatus.
+func (in *OutboundLoadBalancerStatus) DeepCopy() *OutboundLoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundLoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundRuleConfig) DeepCopyInto(out *OutboundRuleConfig) {
+	*out = *in
+	if in.AllocatedOutboundPorts != nil {
+		in, out := &in.AllocatedOutboundPorts, &out.AllocatedOutboundPorts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IdleTimeoutInMinutes != nil {
+		in, out := &in.IdleTimeoutInMinutes, &out.IdleTimeoutInMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundRuleConfig.
+func (in *OutboundRuleConfig) DeepCopy() *OutboundRuleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundRuleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSubnetConfig) DeepCopyInto(out *PodSubnetConfig) {
+	*out = *in
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSubnetConfig.
+func (in *PodSubnetConfig) DeepCopy() *PodSubnetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSubnetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPAddressStatus) DeepCopyInto(out *PublicIPAddressStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPAddressStatus.
+func (in *PublicIPAddressStatus) DeepCopy() *PublicIPAddressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPAddressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPDDoSProtection) DeepCopyInto(out *PublicIPDDoSProtection) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPDDoSProtection.
+func (in *PublicIPDDoSProtection) DeepCopy() *PublicIPDDoSProtection {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImages)
+	out := new(PublicIPDDoSProtection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineType) DeepCopyInto(out *MachineType) {
+func (in *PublicIPDNSSettings) DeepCopyInto(out *PublicIPDNSSettings) {
 	*out = *in
-	if in.AcceleratedNetworking != nil {
-		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
-		*out = new(bool)
+	if in.ReverseFQDN != nil {
+		in, out := &in.ReverseFQDN, &out.ReverseFQDN
+		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineType.
-func (in *MachineType) DeepCopy() *MachineType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPDNSSettings.
+func (in *PublicIPDNSSettings) DeepCopy() *PublicIPDNSSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineType)
+	out := new(PublicIPDNSSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
+func (in *PublicIPReference) DeepCopyInto(out *PublicIPReference) {
 	*out = *in
-	if in.IdleConnectionTimeoutMinutes != nil {
-		in, out := &in.IdleConnectionTimeoutMinutes, &out.IdleConnectionTimeoutMinutes
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Zone != nil {
-		in, out := &in.Zone, &out.Zone
-		*out = new(int32)
-		**out = **in
-	}
-	if in.IPAddresses != nil {
-		in, out := &in.IPAddresses, &out.IPAddresses
-		*out = make([]PublicIPReference, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayConfig.
-func (in *NatGatewayConfig) DeepCopy() *NatGatewayConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPReference.
+func (in *PublicIPReference) DeepCopy() *PublicIPReference {
 	if in == nil {
 		return nil
 	}
-	out := new(NatGatewayConfig)
+	out := new(PublicIPReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+func (in *RemedyConfig) DeepCopyInto(out *RemedyConfig) {
 	*out = *in
-	in.VNet.DeepCopyInto(&out.VNet)
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
-		*out = new(string)
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.NatGateway != nil {
-		in, out := &in.NatGateway, &out.NatGateway
-		*out = new(NatGatewayConfig)
+	if in.OrphanedPublicIPRemedy != nil {
+		in, out := &in.OrphanedPublicIPRemedy, &out.OrphanedPublicIPRemedy
+		*out = new(OrphanedPublicIPRemedyConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ServiceEndpoints != nil {
-		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Zones != nil {
-		in, out := &in.Zones, &out.Zones
-		*out = make([]Zone, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.FailedVMRemedy != nil {
+		in, out := &in.FailedVMRemedy, &out.FailedVMRemedy
+		*out = new(FailedVMRemedyConfig)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
-func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemedyConfig.
+func (in *RemedyConfig) DeepCopy() *RemedyConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkConfig)
+	out := new(RemedyConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+func (in *ResourceGroup) DeepCopyInto(out *ResourceGroup) {
 	*out = *in
-	in.VNet.DeepCopyInto(&out.VNet)
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]Subnet, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
-func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroup.
+func (in *ResourceGroup) DeepCopy() *ResourceGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkStatus)
+	out := new(ResourceGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PublicIPReference) DeepCopyInto(out *PublicIPReference) {
+func (in *RotationConfig) DeepCopyInto(out *RotationConfig) {
 	*out = *in
+	if in.SASTokenValidity != nil {
+		in, out := &in.SASTokenValidity, &out.SASTokenValidity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPReference.
-func (in *PublicIPReference) DeepCopy() *PublicIPReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationConfig.
+func (in *RotationConfig) DeepCopy() *RotationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(PublicIPReference)
+	out := new(RotationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceGroup) DeepCopyInto(out *ResourceGroup) {
+func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroup.
-func (in *ResourceGroup) DeepCopy() *ResourceGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
+func (in *RouteTable) DeepCopy() *RouteTable {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceGroup)
+	out := new(RouteTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+func (in *ScheduledEvents) DeepCopyInto(out *ScheduledEvents) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
-func (in *RouteTable) DeepCopy() *RouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledEvents.
+func (in *ScheduledEvents) DeepCopy() *ScheduledEvents {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTable)
+	out := new(ScheduledEvents)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -765,6 +1916,16 @@ func (in *Storage) DeepCopyInto(out *Storage) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.VolumeSnapshotClass != nil {
+		in, out := &in.VolumeSnapshotClass, &out.VolumeSnapshotClass
+		*out = new(VolumeSnapshotClassConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotValidationWebhook != nil {
+		in, out := &in.SnapshotValidationWebhook, &out.SnapshotValidationWebhook
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -791,6 +1952,16 @@ func (in *Subnet) DeepCopyInto(out *Subnet) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SecurityGroupID != nil {
+		in, out := &in.SecurityGroupID, &out.SecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -804,6 +1975,53 @@ func (in *Subnet) DeepCopy() *Subnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficAnalyticsConfig) DeepCopyInto(out *TrafficAnalyticsConfig) {
+	*out = *in
+	if in.IntervalInMinutes != nil {
+		in, out := &in.IntervalInMinutes, &out.IntervalInMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficAnalyticsConfig.
+func (in *TrafficAnalyticsConfig) DeepCopy() *TrafficAnalyticsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficAnalyticsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMTagsConfig) DeepCopyInto(out *VMTagsConfig) {
+	*out = *in
+	if in.IncludeShootLabels != nil {
+		in, out := &in.IncludeShootLabels, &out.IncludeShootLabels
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MergePolicy != nil {
+		in, out := &in.MergePolicy, &out.MergePolicy
+		*out = new(VMTagMergePolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMTagsConfig.
+func (in *VMTagsConfig) DeepCopy() *VMTagsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VMTagsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VNet) DeepCopyInto(out *VNet) {
 	*out = *in
@@ -822,6 +2040,11 @@ func (in *VNet) DeepCopyInto(out *VNet) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdditionalCIDRs != nil {
+		in, out := &in.AdditionalCIDRs, &out.AdditionalCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.DDosProtectionPlanID != nil {
 		in, out := &in.DDosProtectionPlanID, &out.DDosProtectionPlanID
 		*out = new(string)
@@ -861,6 +2084,32 @@ func (in *VNetStatus) DeepCopy() *VNetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VmoConfig) DeepCopyInto(out *VmoConfig) {
+	*out = *in
+	if in.FaultDomainCount != nil {
+		in, out := &in.FaultDomainCount, &out.FaultDomainCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ZoneBalance != nil {
+		in, out := &in.ZoneBalance, &out.ZoneBalance
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VmoConfig.
+func (in *VmoConfig) DeepCopy() *VmoConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VmoConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VmoDependency) DeepCopyInto(out *VmoDependency) {
 	*out = *in
@@ -877,6 +2126,60 @@ func (in *VmoDependency) DeepCopy() *VmoDependency {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotClassConfig) DeepCopyInto(out *VolumeSnapshotClassConfig) {
+	*out = *in
+	if in.Incremental != nil {
+		in, out := &in.Incremental, &out.Incremental
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotClassConfig.
+func (in *VolumeSnapshotClassConfig) DeepCopy() *VolumeSnapshotClassConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotClassConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmPool) DeepCopyInto(out *WarmPool) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmPool.
+func (in *WarmPool) DeepCopy() *WarmPool {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
@@ -898,6 +2201,51 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WarmPool != nil {
+		in, out := &in.WarmPool, &out.WarmPool
+		*out = new(WarmPool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vmo != nil {
+		in, out := &in.Vmo, &out.Vmo
+		*out = new(VmoConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VMTags != nil {
+		in, out := &in.VMTags, &out.VMTags
+		*out = new(VMTagsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(KubeletConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudConfiguration != nil {
+		in, out := &in.CloudConfiguration, &out.CloudConfiguration
+		*out = new(CloudConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceConfiguration != nil {
+		in, out := &in.MaintenanceConfiguration, &out.MaintenanceConfiguration
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodesSubnet != nil {
+		in, out := &in.NodesSubnet, &out.NodesSubnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScheduledEvents != nil {
+		in, out := &in.ScheduledEvents, &out.ScheduledEvents
+		*out = new(ScheduledEvents)
+		**out = **in
+	}
+	if in.LocalDisks != nil {
+		in, out := &in.LocalDisks, &out.LocalDisks
+		*out = new(LocalDisks)
+		**out = **in
+	}
 	return
 }
 
@@ -969,6 +2317,11 @@ func (in *Zone) DeepCopyInto(out *Zone) {
 		*out = new(ZonedNatGatewayConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecurityGroup != nil {
+		in, out := &in.SecurityGroup, &out.SecurityGroup
+		*out = new(ZoneSecurityGroupConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -982,6 +2335,38 @@ func (in *Zone) DeepCopy() *Zone {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneMapping) DeepCopyInto(out *ZoneMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneMapping.
+func (in *ZoneMapping) DeepCopy() *ZoneMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSecurityGroupConfig) DeepCopyInto(out *ZoneSecurityGroupConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSecurityGroupConfig.
+func (in *ZoneSecurityGroupConfig) DeepCopy() *ZoneSecurityGroupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSecurityGroupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZonedNatGatewayConfig) DeepCopyInto(out *ZonedNatGatewayConfig) {
 	*out = *in
@@ -995,6 +2380,26 @@ func (in *ZonedNatGatewayConfig) DeepCopyInto(out *ZonedNatGatewayConfig) {
 		*out = make([]ZonedPublicIPReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.DDoSProtection != nil {
+		in, out := &in.DDoSProtection, &out.DDoSProtection
+		*out = new(PublicIPDDoSProtection)
+		**out = **in
+	}
+	if in.DNSSettings != nil {
+		in, out := &in.DNSSettings, &out.DNSSettings
+		*out = new(PublicIPDNSSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoScaleIPs != nil {
+		in, out := &in.AutoScaleIPs, &out.AutoScaleIPs
+		*out = new(NatGatewayIPAutoScaling)
+		**out = **in
+	}
+	if in.Existing != nil {
+		in, out := &in.Existing, &out.Existing
+		*out = new(NatGatewayReference)
+		**out = **in
+	}
 	return
 }
 
@@ -6,6 +6,7 @@ package config
 
 import (
 	healthcheckconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -27,6 +28,242 @@ type ControllerConfiguration struct {
 	// FeatureGates is a map of feature names to bools that enable
 	// or disable alpha/experimental features.
 	FeatureGates map[string]bool
+	// Policy contains landscape-wide policies which are enforced by the admission webhooks.
+	Policy *Policy
+	// RemedyController contains the default configuration for the remedy controller deployed for shoots.
+	RemedyController *RemedyControllerConfig
+	// OrphanDetection contains the configuration for the detection of orphaned resources in the shoot resource groups.
+	OrphanDetection *OrphanDetectionConfig
+	// ControlPlaneExposure contains the configuration for the exposure of the shoot control planes in a private DNS zone.
+	ControlPlaneExposure *ControlPlaneExposureConfig
+	// ManagementLocks contains the configuration for the handling of Azure management locks in the shoot resource groups.
+	ManagementLocks *ManagementLocksConfig
+	// DriftDetection contains the configuration for the detection of drifts of the Azure resources of the shoot
+	// infrastructures.
+	DriftDetection *DriftDetectionConfig
+	// DNSRecord contains the configuration for the DNSRecord controller.
+	DNSRecord *DNSRecordConfig
+	// ShootDefaults contains landscape-wide defaults which are applied by the admission webhooks to shoots which omit the
+	// corresponding settings.
+	ShootDefaults *ShootDefaults
+	// MandatoryVMTags are tags which are added to the virtual machines of all shoots. They take precedence over the tags
+	// derived from the labels of the worker pools and shoots.
+	MandatoryVMTags map[string]string
+	// ImageVectorOverrides are overrides of the images deployed by the extension for shoots of a cloud instance, e.g. to
+	// use images of local registries in air-gapped or sovereign clouds.
+	ImageVectorOverrides []ImageVectorOverride
+	// Controllers contains the tuning of the controllers, e.g. for large seeds.
+	Controllers *ControllersConfig
+	// LeaderElection contains the tuning of the leader election of the controller manager.
+	LeaderElection *LeaderElectionConfig
+	// DisableProjectedTokenMount specifies whether the projected token mount shall be disabled for the pods which are
+	// created by the extension to access Azure, e.g. the Terraformer pods of the infrastructure controller.
+	DisableProjectedTokenMount *bool
+}
+
+// ControllersConfig contains the tuning of the controllers.
+type ControllersConfig struct {
+	// BackupBucket is the tuning of the BackupBucket controller.
+	BackupBucket *ControllerConfig
+	// Bastion is the tuning of the Bastion controller.
+	Bastion *ControllerConfig
+	// ControlPlane is the tuning of the ControlPlane controller.
+	ControlPlane *ControllerConfig
+	// DNSRecord is the tuning of the DNSRecord controller.
+	DNSRecord *ControllerConfig
+	// Infrastructure is the tuning of the Infrastructure controller.
+	Infrastructure *ControllerConfig
+	// Worker is the tuning of the Worker controller.
+	Worker *ControllerConfig
+}
+
+// ControllerConfig contains the tuning of a controller.
+type ControllerConfig struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciliations. It takes precedence over the
+	// command line flag of the controller.
+	MaxConcurrentReconciles *int
+	// SyncPeriod is the period in which all objects of the controller are reconciled, even if they did not change.
+	// Objects are only reconciled on changes if it is not set.
+	SyncPeriod *metav1.Duration
+	// RequeueBaseDelay is the delay before an object is reconciled again after a failed reconciliation. The delay is
+	// doubled for every consecutive failure of the object.
+	RequeueBaseDelay *metav1.Duration
+	// RequeueMaxDelay is the maximum delay before an object is reconciled again after a failed reconciliation.
+	RequeueMaxDelay *metav1.Duration
+}
+
+// LeaderElectionConfig contains the tuning of the leader election.
+type LeaderElectionConfig struct {
+	// LeaseDuration is the duration that non-leader candidates will wait to force acquire leadership.
+	LeaseDuration *metav1.Duration
+	// RenewDeadline is the duration that the acting leader will retry refreshing leadership before giving up.
+	RenewDeadline *metav1.Duration
+	// RetryPeriod is the duration the clients should wait between attempting acquisition and renewal of the
+	// leadership.
+	RetryPeriod *metav1.Duration
+	// Profile is the profile of the durations of the leader election. The durations configured explicitly take
+	// precedence over the ones of the profile.
+	Profile *LeaderElectionProfile
+	// ReleaseOnCancel specifies whether the leader releases the leadership when the controller manager stops, so that
+	// another replica does not have to wait for the lease to expire.
+	ReleaseOnCancel *bool
+}
+
+// LeaderElectionProfile is a profile of the durations of the leader election.
+type LeaderElectionProfile string
+
+const (
+	// LeaderElectionProfileDefault uses the default durations of the leader election.
+	LeaderElectionProfileDefault LeaderElectionProfile = "Default"
+	// LeaderElectionProfileFastFailover uses a short lease duration and releases the leadership on shutdown, so that a
+	// standby replica takes over within a few seconds.
+	LeaderElectionProfileFastFailover LeaderElectionProfile = "FastFailover"
+)
+
+// ImageVectorOverride contains overrides of the images deployed by the extension for shoots of a cloud instance.
+type ImageVectorOverride struct {
+	// Cloud is the name of the cloud instance the overrides apply to, i.e. AzurePublic, AzureChina, AzureGovernment or
+	// AzureStackCloud.
+	Cloud string
+	// Images maps the names of images in the image vector of the extension to the image references used instead.
+	Images map[string]string
+}
+
+// DNSRecordConfig contains the configuration for the DNSRecord controller.
+type DNSRecordConfig struct {
+	// ZoneCacheTTL is the duration for which the DNS zones listed for a DNSRecord without zone are cached and shared
+	// with other DNSRecords using the same credentials. Defaults to 5m.
+	ZoneCacheTTL *metav1.Duration
+}
+
+// ManagementLocksConfig contains the configuration for the handling of Azure management locks which block the deletion
+// of the shoot infrastructures.
+type ManagementLocksConfig struct {
+	// RemoveOwnLocks removes management locks which were created by the extension itself, i.e. whose notes start with
+	// the extension's lock marker, before the infrastructure is deleted. Locks created by others are never removed.
+	RemoveOwnLocks bool
+}
+
+// DriftDetectionConfig contains the configuration for the periodic detection of drifts of the Azure resources of the
+// shoot infrastructures, i.e. deviations of their actual properties from the desired ones. Drifts are only reported,
+// they are repaired with the next reconciliation of the infrastructure.
+type DriftDetectionConfig struct {
+	// Enabled enables the drift detection.
+	Enabled bool
+	// SyncPeriod is the period in which the infrastructures are checked for drifts. Defaults to 1h.
+	SyncPeriod *metav1.Duration
+	// OutOfBandModifications contains the configuration for the detection of modifications of the Azure resources of
+	// the infrastructures which were not done with the credentials of the shoots.
+	OutOfBandModifications *OutOfBandModificationsConfig
+}
+
+// OutOfBandModificationsConfig contains the configuration for the detection of modifications of the Azure resources of
+// the infrastructures which were not done with the credentials of the shoots, e.g. manual changes via the Azure portal.
+// The modifications are taken from the activity log of the resource groups of the infrastructures.
+type OutOfBandModificationsConfig struct {
+	// Enabled enables the detection of out-of-band modifications.
+	Enabled bool
+	// AllowedCallers are the callers whose modifications are not reported, e.g. the object IDs of users or the client
+	// IDs of applications which are allowed to modify the resources.
+	AllowedCallers []string
+}
+
+// ControlPlaneExposureConfig contains the configuration for the exposure of the kube-apiservers of the shoots via
+// records in an Azure private DNS zone. It is only effective if the seed runs on Azure.
+type ControlPlaneExposureConfig struct {
+	// PrivateDNSZone is the private DNS zone in which the records for the kube-apiservers are maintained.
+	PrivateDNSZone PrivateDNSZone
+	// SecretRef references the secret in the seed containing the credentials to manage the private DNS zone.
+	SecretRef corev1.SecretReference
+	// TTL is the time to live of the records in seconds. Defaults to 300.
+	TTL *int64
+}
+
+// PrivateDNSZone identifies an Azure private DNS zone.
+type PrivateDNSZone struct {
+	// ResourceGroup is the resource group of the private DNS zone.
+	ResourceGroup string
+	// Name is the name of the private DNS zone, e.g. "seed.internal.example.com".
+	Name string
+}
+
+// OrphanDetectionConfig contains the configuration for the periodic detection of orphaned resources, e.g. network
+// interfaces, disks or public IP addresses which were leaked after node deletions.
+type OrphanDetectionConfig struct {
+	// Enabled enables the orphan detection.
+	Enabled bool
+	// SyncPeriod is the period in which the shoot resource groups are checked for orphaned resources.
+	SyncPeriod *metav1.Duration
+	// GracePeriod is the minimum age of a resource before it is considered as orphaned.
+	GracePeriod *metav1.Duration
+	// DryRun only reports orphaned resources via events and metrics instead of deleting them. Defaults to true.
+	DryRun *bool
+}
+
+// ShootDefaults contains landscape-wide defaults for shoots. The defaults are only applied to new shoots or, for
+// settings of NAT gateways and worker pools, to NAT gateways which are enabled or worker pools which are added later, so
+// that changing them does not affect the existing infrastructure of shoots.
+type ShootDefaults struct {
+	// NatGatewayIdleConnectionTimeoutMinutes is the idle connection timeout of NAT gateways in minutes.
+	NatGatewayIdleConnectionTimeoutMinutes *int32
+	// OSDiskType is the type of the OS disks of worker pools which configure a volume without type.
+	OSDiskType *string
+	// OutboundAccessType is the outbound access type of shoots which neither configure a NAT gateway nor a next hop for
+	// the egress traffic. Supported values are `NATGateway` and `LoadBalancer`. For `NATGateway`, a NAT gateway is
+	// enabled for all worker subnets.
+	OutboundAccessType *string
+}
+
+// Policy contains landscape-wide policies for shoots.
+type Policy struct {
+	// RequireNatGateway forbids the creation of shoots which rely on the default outbound SNAT of the load balancer
+	// instead of a NAT gateway. Shoots in the exempted namespaces are not affected.
+	RequireNatGateway bool
+	// RequireZoneRedundantNatGateway forbids highly available shoots, i.e. shoots with a zone failure tolerant control
+	// plane or with workers spread across multiple zones, which do not use a dedicated NAT gateway in every zone of their
+	// workers. Shoots in the exempted namespaces are not affected.
+	RequireZoneRedundantNatGateway bool
+	// NatGatewayExemptNamespaces are the namespaces of the projects whose shoots are exempted from the NAT gateway
+	// policies. Exemptions are granted by the operators, shoot owners cannot exempt their shoots themselves.
+	NatGatewayExemptNamespaces []string
+	// CredentialsPreflight performs cheap, read-only Azure calls with the credentials of new shoots to reject them early
+	// if the credentials cannot be authenticated or lack permissions. Only secret-based credentials are checked.
+	CredentialsPreflight bool
+}
+
+// RemedyControllerConfig contains the landscape-wide default configuration for the remedy controller. The values can be
+// overridden per shoot in the ControlPlaneConfig.
+type RemedyControllerConfig struct {
+	// OrphanedPublicIPRemedy contains configuration for the remedy of orphaned public IP addresses.
+	OrphanedPublicIPRemedy *OrphanedPublicIPRemedyConfig
+	// FailedVMRemedy contains configuration for the remedy of virtual machines in a failed state.
+	FailedVMRemedy *FailedVMRemedyConfig
+}
+
+// OrphanedPublicIPRemedyConfig contains configuration for the remedy of orphaned public IP addresses.
+type OrphanedPublicIPRemedyConfig struct {
+	// RequeueInterval is the interval in which a public IP address is requeued while it is being remedied.
+	RequeueInterval *metav1.Duration
+	// SyncPeriod is the period in which public IP addresses are resynced.
+	SyncPeriod *metav1.Duration
+	// DeletionGracePeriod is the period after which an orphaned public IP address is deleted.
+	DeletionGracePeriod *metav1.Duration
+	// MaxGetAttempts is the maximum number of attempts to get a public IP address.
+	MaxGetAttempts *int32
+	// MaxCleanAttempts is the maximum number of attempts to clean up an orphaned public IP address.
+	MaxCleanAttempts *int32
+}
+
+// FailedVMRemedyConfig contains configuration for the remedy of virtual machines in a failed state.
+type FailedVMRemedyConfig struct {
+	// RequeueInterval is the interval in which a virtual machine is requeued while it is being remedied.
+	RequeueInterval *metav1.Duration
+	// SyncPeriod is the period in which virtual machines are resynced.
+	SyncPeriod *metav1.Duration
+	// MaxGetAttempts is the maximum number of attempts to get a virtual machine.
+	MaxGetAttempts *int32
+	// MaxReapplyAttempts is the maximum number of attempts to reapply a virtual machine in a failed state.
+	MaxReapplyAttempts *int32
 }
 
 // ETCD is an etcd configuration.
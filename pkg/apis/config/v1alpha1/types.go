@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	healthcheckconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -32,6 +33,306 @@ type ControllerConfiguration struct {
 	// Default: nil
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Policy contains landscape-wide policies which are enforced by the admission webhooks.
+	// +optional
+	Policy *Policy `json:"policy,omitempty"`
+	// RemedyController contains the default configuration for the remedy controller deployed for shoots.
+	// +optional
+	RemedyController *RemedyControllerConfig `json:"remedyController,omitempty"`
+	// OrphanDetection contains the configuration for the detection of orphaned resources in the shoot resource groups.
+	// +optional
+	OrphanDetection *OrphanDetectionConfig `json:"orphanDetection,omitempty"`
+	// ControlPlaneExposure contains the configuration for the exposure of the shoot control planes in a private DNS zone.
+	// +optional
+	ControlPlaneExposure *ControlPlaneExposureConfig `json:"controlPlaneExposure,omitempty"`
+	// ManagementLocks contains the configuration for the handling of Azure management locks in the shoot resource groups.
+	// +optional
+	ManagementLocks *ManagementLocksConfig `json:"managementLocks,omitempty"`
+	// DriftDetection contains the configuration for the detection of drifts of the Azure resources of the shoot
+	// infrastructures.
+	// +optional
+	DriftDetection *DriftDetectionConfig `json:"driftDetection,omitempty"`
+	// DNSRecord contains the configuration for the DNSRecord controller.
+	// +optional
+	DNSRecord *DNSRecordConfig `json:"dnsRecord,omitempty"`
+	// ShootDefaults contains landscape-wide defaults which are applied by the admission webhooks to shoots which omit the
+	// corresponding settings.
+	// +optional
+	ShootDefaults *ShootDefaults `json:"shootDefaults,omitempty"`
+	// MandatoryVMTags are tags which are added to the virtual machines of all shoots. They take precedence over the tags
+	// derived from the labels of the worker pools and shoots.
+	// +optional
+	MandatoryVMTags map[string]string `json:"mandatoryVMTags,omitempty"`
+	// ImageVectorOverrides are overrides of the images deployed by the extension for shoots of a cloud instance, e.g. to
+	// use images of local registries in air-gapped or sovereign clouds.
+	// +optional
+	ImageVectorOverrides []ImageVectorOverride `json:"imageVectorOverrides,omitempty"`
+	// Controllers contains the tuning of the controllers, e.g. for large seeds.
+	// +optional
+	Controllers *ControllersConfig `json:"controllers,omitempty"`
+	// LeaderElection contains the tuning of the leader election of the controller manager.
+	// +optional
+	LeaderElection *LeaderElectionConfig `json:"leaderElection,omitempty"`
+	// DisableProjectedTokenMount specifies whether the projected token mount shall be disabled for the pods which are
+	// created by the extension to access Azure, e.g. the Terraformer pods of the infrastructure controller. It must be
+	// set to true in seeds in which the token requestor of the gardener-resource-manager is not available.
+	// Defaults to true.
+	// +optional
+	DisableProjectedTokenMount *bool `json:"disableProjectedTokenMount,omitempty"`
+}
+
+// ControllersConfig contains the tuning of the controllers.
+type ControllersConfig struct {
+	// BackupBucket is the tuning of the BackupBucket controller.
+	// +optional
+	BackupBucket *ControllerConfig `json:"backupBucket,omitempty"`
+	// Bastion is the tuning of the Bastion controller.
+	// +optional
+	Bastion *ControllerConfig `json:"bastion,omitempty"`
+	// ControlPlane is the tuning of the ControlPlane controller.
+	// +optional
+	ControlPlane *ControllerConfig `json:"controlPlane,omitempty"`
+	// DNSRecord is the tuning of the DNSRecord controller.
+	// +optional
+	DNSRecord *ControllerConfig `json:"dnsRecord,omitempty"`
+	// Infrastructure is the tuning of the Infrastructure controller.
+	// +optional
+	Infrastructure *ControllerConfig `json:"infrastructure,omitempty"`
+	// Worker is the tuning of the Worker controller.
+	// +optional
+	Worker *ControllerConfig `json:"worker,omitempty"`
+}
+
+// ControllerConfig contains the tuning of a controller.
+type ControllerConfig struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciliations. It takes precedence over the
+	// command line flag of the controller.
+	// +optional
+	MaxConcurrentReconciles *int `json:"maxConcurrentReconciles,omitempty"`
+	// SyncPeriod is the period in which all objects of the controller are reconciled, even if they did not change.
+	// Objects are only reconciled on changes if it is not set.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// RequeueBaseDelay is the delay before an object is reconciled again after a failed reconciliation. The delay is
+	// doubled for every consecutive failure of the object. Defaults to 5ms.
+	// +optional
+	RequeueBaseDelay *metav1.Duration `json:"requeueBaseDelay,omitempty"`
+	// RequeueMaxDelay is the maximum delay before an object is reconciled again after a failed reconciliation.
+	// Defaults to 1000s.
+	// +optional
+	RequeueMaxDelay *metav1.Duration `json:"requeueMaxDelay,omitempty"`
+}
+
+// LeaderElectionConfig contains the tuning of the leader election.
+type LeaderElectionConfig struct {
+	// LeaseDuration is the duration that non-leader candidates will wait to force acquire leadership. Defaults to 15s.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+	// RenewDeadline is the duration that the acting leader will retry refreshing leadership before giving up.
+	// Defaults to 10s.
+	// +optional
+	RenewDeadline *metav1.Duration `json:"renewDeadline,omitempty"`
+	// RetryPeriod is the duration the clients should wait between attempting acquisition and renewal of the
+	// leadership. Defaults to 2s.
+	// +optional
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
+	// Profile is the profile of the durations of the leader election, i.e. Default or FastFailover. The durations
+	// configured explicitly take precedence over the ones of the profile. Defaults to Default.
+	// +optional
+	Profile *LeaderElectionProfile `json:"profile,omitempty"`
+	// ReleaseOnCancel specifies whether the leader releases the leadership when the controller manager stops, so that
+	// another replica does not have to wait for the lease to expire. Defaults to true for the FastFailover profile and
+	// to false otherwise.
+	// +optional
+	ReleaseOnCancel *bool `json:"releaseOnCancel,omitempty"`
+}
+
+// LeaderElectionProfile is a profile of the durations of the leader election.
+type LeaderElectionProfile string
+
+const (
+	// LeaderElectionProfileDefault uses the default durations of the leader election, i.e. a lease duration of 15s, a
+	// renew deadline of 10s and a retry period of 2s.
+	LeaderElectionProfileDefault LeaderElectionProfile = "Default"
+	// LeaderElectionProfileFastFailover uses a lease duration of 12s, a renew deadline of 10s and a retry period of 2s
+	// and releases the leadership on shutdown, so that a standby replica takes over within a few seconds.
+	LeaderElectionProfileFastFailover LeaderElectionProfile = "FastFailover"
+)
+
+// ImageVectorOverride contains overrides of the images deployed by the extension for shoots of a cloud instance.
+type ImageVectorOverride struct {
+	// Cloud is the name of the cloud instance the overrides apply to, i.e. AzurePublic, AzureChina, AzureGovernment or
+	// AzureStackCloud.
+	Cloud string `json:"cloud"`
+	// Images maps the names of images in the image vector of the extension to the image references used instead.
+	Images map[string]string `json:"images"`
+}
+
+// DNSRecordConfig contains the configuration for the DNSRecord controller.
+type DNSRecordConfig struct {
+	// ZoneCacheTTL is the duration for which the DNS zones listed for a DNSRecord without zone are cached and shared
+	// with other DNSRecords using the same credentials. Defaults to 5m.
+	// +optional
+	ZoneCacheTTL *metav1.Duration `json:"zoneCacheTTL,omitempty"`
+}
+
+// ManagementLocksConfig contains the configuration for the handling of Azure management locks which block the deletion
+// of the shoot infrastructures.
+type ManagementLocksConfig struct {
+	// RemoveOwnLocks removes management locks which were created by the extension itself, i.e. whose notes start with
+	// the extension's lock marker, before the infrastructure is deleted. Locks created by others are never removed.
+	// +optional
+	RemoveOwnLocks bool `json:"removeOwnLocks,omitempty"`
+}
+
+// DriftDetectionConfig contains the configuration for the periodic detection of drifts of the Azure resources of the
+// shoot infrastructures, i.e. deviations of their actual properties from the desired ones. Drifts are only reported,
+// they are repaired with the next reconciliation of the infrastructure.
+type DriftDetectionConfig struct {
+	// Enabled enables the drift detection.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// SyncPeriod is the period in which the infrastructures are checked for drifts. Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// OutOfBandModifications contains the configuration for the detection of modifications of the Azure resources of
+	// the infrastructures which were not done with the credentials of the shoots.
+	// +optional
+	OutOfBandModifications *OutOfBandModificationsConfig `json:"outOfBandModifications,omitempty"`
+}
+
+// OutOfBandModificationsConfig contains the configuration for the detection of modifications of the Azure resources of
+// the infrastructures which were not done with the credentials of the shoots, e.g. manual changes via the Azure portal.
+// The modifications are taken from the activity log of the resource groups of the infrastructures.
+type OutOfBandModificationsConfig struct {
+	// Enabled enables the detection of out-of-band modifications.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// AllowedCallers are the callers whose modifications are not reported, e.g. the object IDs of users or the client
+	// IDs of applications which are allowed to modify the resources.
+	// +optional
+	AllowedCallers []string `json:"allowedCallers,omitempty"`
+}
+
+// ControlPlaneExposureConfig contains the configuration for the exposure of the kube-apiservers of the shoots via
+// records in an Azure private DNS zone. It is only effective if the seed runs on Azure.
+type ControlPlaneExposureConfig struct {
+	// PrivateDNSZone is the private DNS zone in which the records for the kube-apiservers are maintained.
+	PrivateDNSZone PrivateDNSZone `json:"privateDNSZone"`
+	// SecretRef references the secret in the seed containing the credentials to manage the private DNS zone.
+	SecretRef corev1.SecretReference `json:"secretRef"`
+	// TTL is the time to live of the records in seconds. Defaults to 300.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+}
+
+// PrivateDNSZone identifies an Azure private DNS zone.
+type PrivateDNSZone struct {
+	// ResourceGroup is the resource group of the private DNS zone.
+	ResourceGroup string `json:"resourceGroup"`
+	// Name is the name of the private DNS zone, e.g. "seed.internal.example.com".
+	Name string `json:"name"`
+}
+
+// OrphanDetectionConfig contains the configuration for the periodic detection of orphaned resources, e.g. network
+// interfaces, disks or public IP addresses which were leaked after node deletions.
+type OrphanDetectionConfig struct {
+	// Enabled enables the orphan detection.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// SyncPeriod is the period in which the shoot resource groups are checked for orphaned resources.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// GracePeriod is the minimum age of a resource before it is considered as orphaned.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+	// DryRun only reports orphaned resources via events and metrics instead of deleting them. Defaults to true.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// ShootDefaults contains landscape-wide defaults for shoots. The defaults are only applied to new shoots or, for
+// settings of NAT gateways and worker pools, to NAT gateways which are enabled or worker pools which are added later, so
+// that changing them does not affect the existing infrastructure of shoots.
+type ShootDefaults struct {
+	// NatGatewayIdleConnectionTimeoutMinutes is the idle connection timeout of NAT gateways in minutes.
+	// +optional
+	NatGatewayIdleConnectionTimeoutMinutes *int32 `json:"natGatewayIdleConnectionTimeoutMinutes,omitempty"`
+	// OSDiskType is the type of the OS disks of worker pools which configure a volume without type.
+	// +optional
+	OSDiskType *string `json:"osDiskType,omitempty"`
+	// OutboundAccessType is the outbound access type of shoots which neither configure a NAT gateway nor a next hop for
+	// the egress traffic. Supported values are `NATGateway` and `LoadBalancer`. For `NATGateway`, a NAT gateway is
+	// enabled for all worker subnets.
+	// +optional
+	OutboundAccessType *string `json:"outboundAccessType,omitempty"`
+}
+
+// Policy contains landscape-wide policies for shoots.
+type Policy struct {
+	// RequireNatGateway forbids the creation of shoots which rely on the default outbound SNAT of the load balancer
+	// instead of a NAT gateway. Shoots in the exempted namespaces are not affected.
+	// +optional
+	RequireNatGateway bool `json:"requireNatGateway,omitempty"`
+	// RequireZoneRedundantNatGateway forbids highly available shoots, i.e. shoots with a zone failure tolerant control
+	// plane or with workers spread across multiple zones, which do not use a dedicated NAT gateway in every zone of their
+	// workers. Shoots in the exempted namespaces are not affected.
+	// +optional
+	RequireZoneRedundantNatGateway bool `json:"requireZoneRedundantNatGateway,omitempty"`
+	// NatGatewayExemptNamespaces are the namespaces of the projects whose shoots are exempted from the NAT gateway
+	// policies. Exemptions are granted by the operators, shoot owners cannot exempt their shoots themselves.
+	// +optional
+	NatGatewayExemptNamespaces []string `json:"natGatewayExemptNamespaces,omitempty"`
+	// CredentialsPreflight performs cheap, read-only Azure calls with the credentials of new shoots to reject them early
+	// if the credentials cannot be authenticated or lack permissions. Only secret-based credentials are checked.
+	// +optional
+	CredentialsPreflight bool `json:"credentialsPreflight,omitempty"`
+}
+
+// RemedyControllerConfig contains the landscape-wide default configuration for the remedy controller. The values can be
+// overridden per shoot in the ControlPlaneConfig.
+type RemedyControllerConfig struct {
+	// OrphanedPublicIPRemedy contains configuration for the remedy of orphaned public IP addresses.
+	// +optional
+	OrphanedPublicIPRemedy *OrphanedPublicIPRemedyConfig `json:"orphanedPublicIPRemedy,omitempty"`
+	// FailedVMRemedy contains configuration for the remedy of virtual machines in a failed state.
+	// +optional
+	FailedVMRemedy *FailedVMRemedyConfig `json:"failedVMRemedy,omitempty"`
+}
+
+// OrphanedPublicIPRemedyConfig contains configuration for the remedy of orphaned public IP addresses.
+type OrphanedPublicIPRemedyConfig struct {
+	// RequeueInterval is the interval in which a public IP address is requeued while it is being remedied.
+	// +optional
+	RequeueInterval *metav1.Duration `json:"requeueInterval,omitempty"`
+	// SyncPeriod is the period in which public IP addresses are resynced.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// DeletionGracePeriod is the period after which an orphaned public IP address is deleted.
+	// +optional
+	DeletionGracePeriod *metav1.Duration `json:"deletionGracePeriod,omitempty"`
+	// MaxGetAttempts is the maximum number of attempts to get a public IP address.
+	// +optional
+	MaxGetAttempts *int32 `json:"maxGetAttempts,omitempty"`
+	// MaxCleanAttempts is the maximum number of attempts to clean up an orphaned public IP address.
+	// +optional
+	MaxCleanAttempts *int32 `json:"maxCleanAttempts,omitempty"`
+}
+
+// FailedVMRemedyConfig contains configuration for the remedy of virtual machines in a failed state.
+type FailedVMRemedyConfig struct {
+	// RequeueInterval is the interval in which a virtual machine is requeued while it is being remedied.
+	// +optional
+	RequeueInterval *metav1.Duration `json:"requeueInterval,omitempty"`
+	// SyncPeriod is the period in which virtual machines are resynced.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxGetAttempts is the maximum number of attempts to get a virtual machine.
+	// +optional
+	MaxGetAttempts *int32 `json:"maxGetAttempts,omitempty"`
+	// MaxReapplyAttempts is the maximum number of attempts to reapply a virtual machine in a failed state.
+	// +optional
+	MaxReapplyAttempts *int32 `json:"maxReapplyAttempts,omitempty"`
 }
 
 // ETCD is an etcd configuration.
@@ -16,6 +16,7 @@ import (
 	apisconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -29,6 +30,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneExposureConfig)(nil), (*config.ControlPlaneExposureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlaneExposureConfig_To_config_ControlPlaneExposureConfig(a.(*ControlPlaneExposureConfig), b.(*config.ControlPlaneExposureConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ControlPlaneExposureConfig)(nil), (*ControlPlaneExposureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ControlPlaneExposureConfig_To_v1alpha1_ControlPlaneExposureConfig(a.(*config.ControlPlaneExposureConfig), b.(*ControlPlaneExposureConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControllerConfig)(nil), (*config.ControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerConfig_To_config_ControllerConfig(a.(*ControllerConfig), b.(*config.ControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ControllerConfig)(nil), (*ControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ControllerConfig_To_v1alpha1_ControllerConfig(a.(*config.ControllerConfig), b.(*ControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -39,6 +60,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllersConfig)(nil), (*config.ControllersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllersConfig_To_config_ControllersConfig(a.(*ControllersConfig), b.(*config.ControllersConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ControllersConfig)(nil), (*ControllersConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ControllersConfig_To_v1alpha1_ControllersConfig(a.(*config.ControllersConfig), b.(*ControllersConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordConfig)(nil), (*config.DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordConfig_To_config_DNSRecordConfig(a.(*DNSRecordConfig), b.(*config.DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DNSRecordConfig)(nil), (*DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(a.(*config.DNSRecordConfig), b.(*DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DriftDetectionConfig)(nil), (*config.DriftDetectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig(a.(*DriftDetectionConfig), b.(*config.DriftDetectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DriftDetectionConfig)(nil), (*DriftDetectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig(a.(*config.DriftDetectionConfig), b.(*DriftDetectionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ETCD)(nil), (*config.ETCD)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ETCD_To_config_ETCD(a.(*ETCD), b.(*config.ETCD), scope)
 	}); err != nil {
@@ -69,9 +120,173 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FailedVMRemedyConfig)(nil), (*config.FailedVMRemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailedVMRemedyConfig_To_config_FailedVMRemedyConfig(a.(*FailedVMRemedyConfig), b.(*config.FailedVMRemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FailedVMRemedyConfig)(nil), (*FailedVMRemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig(a.(*config.FailedVMRemedyConfig), b.(*FailedVMRemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImageVectorOverride)(nil), (*config.ImageVectorOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageVectorOverride_To_config_ImageVectorOverride(a.(*ImageVectorOverride), b.(*config.ImageVectorOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImageVectorOverride)(nil), (*ImageVectorOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImageVectorOverride_To_v1alpha1_ImageVectorOverride(a.(*config.ImageVectorOverride), b.(*ImageVectorOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LeaderElectionConfig)(nil), (*config.LeaderElectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LeaderElectionConfig_To_config_LeaderElectionConfig(a.(*LeaderElectionConfig), b.(*config.LeaderElectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LeaderElectionConfig)(nil), (*LeaderElectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(a.(*config.LeaderElectionConfig), b.(*LeaderElectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManagementLocksConfig)(nil), (*config.ManagementLocksConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagementLocksConfig_To_config_ManagementLocksConfig(a.(*ManagementLocksConfig), b.(*config.ManagementLocksConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ManagementLocksConfig)(nil), (*ManagementLocksConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ManagementLocksConfig_To_v1alpha1_ManagementLocksConfig(a.(*config.ManagementLocksConfig), b.(*ManagementLocksConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OrphanDetectionConfig)(nil), (*config.OrphanDetectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphanDetectionConfig_To_config_OrphanDetectionConfig(a.(*OrphanDetectionConfig), b.(*config.OrphanDetectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OrphanDetectionConfig)(nil), (*OrphanDetectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OrphanDetectionConfig_To_v1alpha1_OrphanDetectionConfig(a.(*config.OrphanDetectionConfig), b.(*OrphanDetectionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OrphanedPublicIPRemedyConfig)(nil), (*config.OrphanedPublicIPRemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphanedPublicIPRemedyConfig_To_config_OrphanedPublicIPRemedyConfig(a.(*OrphanedPublicIPRemedyConfig), b.(*config.OrphanedPublicIPRemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OrphanedPublicIPRemedyConfig)(nil), (*OrphanedPublicIPRemedyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig(a.(*config.OrphanedPublicIPRemedyConfig), b.(*OrphanedPublicIPRemedyConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OutOfBandModificationsConfig)(nil), (*config.OutOfBandModificationsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OutOfBandModificationsConfig_To_config_OutOfBandModificationsConfig(a.(*OutOfBandModificationsConfig), b.(*config.OutOfBandModificationsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OutOfBandModificationsConfig)(nil), (*OutOfBandModificationsConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OutOfBandModificationsConfig_To_v1alpha1_OutOfBandModificationsConfig(a.(*config.OutOfBandModificationsConfig), b.(*OutOfBandModificationsConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Policy)(nil), (*config.Policy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Policy_To_config_Policy(a.(*Policy), b.(*config.Policy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Policy)(nil), (*Policy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Policy_To_v1alpha1_Policy(a.(*config.Policy), b.(*Policy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateDNSZone)(nil), (*config.PrivateDNSZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateDNSZone_To_config_PrivateDNSZone(a.(*PrivateDNSZone), b.(*config.PrivateDNSZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PrivateDNSZone)(nil), (*PrivateDNSZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(a.(*config.PrivateDNSZone), b.(*PrivateDNSZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RemedyControllerConfig)(nil), (*config.RemedyControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RemedyControllerConfig_To_config_RemedyControllerConfig(a.(*RemedyControllerConfig), b.(*config.RemedyControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RemedyControllerConfig)(nil), (*RemedyControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RemedyControllerConfig_To_v1alpha1_RemedyControllerConfig(a.(*config.RemedyControllerConfig), b.(*RemedyControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootDefaults)(nil), (*config.ShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootDefaults_To_config_ShootDefaults(a.(*ShootDefaults), b.(*config.ShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootDefaults)(nil), (*ShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootDefaults_To_v1alpha1_ShootDefaults(a.(*config.ShootDefaults), b.(*ShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
+func autoConvert_v1alpha1_ControlPlaneExposureConfig_To_config_ControlPlaneExposureConfig(in *ControlPlaneExposureConfig, out *config.ControlPlaneExposureConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_PrivateDNSZone_To_config_PrivateDNSZone(&in.PrivateDNSZone, &out.PrivateDNSZone, s); err != nil {
+		return err
+	}
+	out.SecretRef = in.SecretRef
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneExposureConfig_To_config_ControlPlaneExposureConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneExposureConfig_To_config_ControlPlaneExposureConfig(in *ControlPlaneExposureConfig, out *config.ControlPlaneExposureConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneExposureConfig_To_config_ControlPlaneExposureConfig(in, out, s)
+}
+
+func autoConvert_config_ControlPlaneExposureConfig_To_v1alpha1_ControlPlaneExposureConfig(in *config.ControlPlaneExposureConfig, out *ControlPlaneExposureConfig, s conversion.Scope) error {
+	if err := Convert_config_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(&in.PrivateDNSZone, &out.PrivateDNSZone, s); err != nil {
+		return err
+	}
+	out.SecretRef = in.SecretRef
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_config_ControlPlaneExposureConfig_To_v1alpha1_ControlPlaneExposureConfig is an autogenerated conversion function.
+func Convert_config_ControlPlaneExposureConfig_To_v1alpha1_ControlPlaneExposureConfig(in *config.ControlPlaneExposureConfig, out *ControlPlaneExposureConfig, s conversion.Scope) error {
+	return autoConvert_config_ControlPlaneExposureConfig_To_v1alpha1_ControlPlaneExposureConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControllerConfig_To_config_ControllerConfig(in *ControllerConfig, out *config.ControllerConfig, s conversion.Scope) error {
+	out.MaxConcurrentReconciles = (*int)(unsafe.Pointer(in.MaxConcurrentReconciles))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.RequeueBaseDelay = (*v1.Duration)(unsafe.Pointer(in.RequeueBaseDelay))
+	out.RequeueMaxDelay = (*v1.Duration)(unsafe.Pointer(in.RequeueMaxDelay))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerConfig_To_config_ControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerConfig_To_config_ControllerConfig(in *ControllerConfig, out *config.ControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerConfig_To_config_ControllerConfig(in, out, s)
+}
+
+func autoConvert_config_ControllerConfig_To_v1alpha1_ControllerConfig(in *config.ControllerConfig, out *ControllerConfig, s conversion.Scope) error {
+	out.MaxConcurrentReconciles = (*int)(unsafe.Pointer(in.MaxConcurrentReconciles))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.RequeueBaseDelay = (*v1.Duration)(unsafe.Pointer(in.RequeueBaseDelay))
+	out.RequeueMaxDelay = (*v1.Duration)(unsafe.Pointer(in.RequeueMaxDelay))
+	return nil
+}
+
+// Convert_config_ControllerConfig_To_v1alpha1_ControllerConfig is an autogenerated conversion function.
+func Convert_config_ControllerConfig_To_v1alpha1_ControllerConfig(in *config.ControllerConfig, out *ControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_ControllerConfig_To_v1alpha1_ControllerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*componentbaseconfig.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
@@ -79,6 +294,19 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfig.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Policy = (*config.Policy)(unsafe.Pointer(in.Policy))
+	out.RemedyController = (*config.RemedyControllerConfig)(unsafe.Pointer(in.RemedyController))
+	out.OrphanDetection = (*config.OrphanDetectionConfig)(unsafe.Pointer(in.OrphanDetection))
+	out.ControlPlaneExposure = (*config.ControlPlaneExposureConfig)(unsafe.Pointer(in.ControlPlaneExposure))
+	out.ManagementLocks = (*config.ManagementLocksConfig)(unsafe.Pointer(in.ManagementLocks))
+	out.DriftDetection = (*config.DriftDetectionConfig)(unsafe.Pointer(in.DriftDetection))
+	out.DNSRecord = (*config.DNSRecordConfig)(unsafe.Pointer(in.DNSRecord))
+	out.ShootDefaults = (*config.ShootDefaults)(unsafe.Pointer(in.ShootDefaults))
+	out.MandatoryVMTags = *(*map[string]string)(unsafe.Pointer(&in.MandatoryVMTags))
+	out.ImageVectorOverrides = *(*[]config.ImageVectorOverride)(unsafe.Pointer(&in.ImageVectorOverrides))
+	out.Controllers = (*config.ControllersConfig)(unsafe.Pointer(in.Controllers))
+	out.LeaderElection = (*config.LeaderElectionConfig)(unsafe.Pointer(in.LeaderElection))
+	out.DisableProjectedTokenMount = (*bool)(unsafe.Pointer(in.DisableProjectedTokenMount))
 	return nil
 }
 
@@ -94,6 +322,19 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Policy = (*Policy)(unsafe.Pointer(in.Policy))
+	out.RemedyController = (*RemedyControllerConfig)(unsafe.Pointer(in.RemedyController))
+	out.OrphanDetection = (*OrphanDetectionConfig)(unsafe.Pointer(in.OrphanDetection))
+	out.ControlPlaneExposure = (*ControlPlaneExposureConfig)(unsafe.Pointer(in.ControlPlaneExposure))
+	out.ManagementLocks = (*ManagementLocksConfig)(unsafe.Pointer(in.ManagementLocks))
+	out.DriftDetection = (*DriftDetectionConfig)(unsafe.Pointer(in.DriftDetection))
+	out.DNSRecord = (*DNSRecordConfig)(unsafe.Pointer(in.DNSRecord))
+	out.ShootDefaults = (*ShootDefaults)(unsafe.Pointer(in.ShootDefaults))
+	out.MandatoryVMTags = *(*map[string]string)(unsafe.Pointer(&in.MandatoryVMTags))
+	out.ImageVectorOverrides = *(*[]ImageVectorOverride)(unsafe.Pointer(&in.ImageVectorOverrides))
+	out.Controllers = (*ControllersConfig)(unsafe.Pointer(in.Controllers))
+	out.LeaderElection = (*LeaderElectionConfig)(unsafe.Pointer(in.LeaderElection))
+	out.DisableProjectedTokenMount = (*bool)(unsafe.Pointer(in.DisableProjectedTokenMount))
 	return nil
 }
 
@@ -102,6 +343,80 @@ func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllersConfig_To_config_ControllersConfig(in *ControllersConfig, out *config.ControllersConfig, s conversion.Scope) error {
+	out.BackupBucket = (*config.ControllerConfig)(unsafe.Pointer(in.BackupBucket))
+	out.Bastion = (*config.ControllerConfig)(unsafe.Pointer(in.Bastion))
+	out.ControlPlane = (*config.ControllerConfig)(unsafe.Pointer(in.ControlPlane))
+	out.DNSRecord = (*config.ControllerConfig)(unsafe.Pointer(in.DNSRecord))
+	out.Infrastructure = (*config.ControllerConfig)(unsafe.Pointer(in.Infrastructure))
+	out.Worker = (*config.ControllerConfig)(unsafe.Pointer(in.Worker))
+	return nil
+}
+
+// Convert_v1alpha1_ControllersConfig_To_config_ControllersConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ControllersConfig_To_config_ControllersConfig(in *ControllersConfig, out *config.ControllersConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllersConfig_To_config_ControllersConfig(in, out, s)
+}
+
+func autoConvert_config_ControllersConfig_To_v1alpha1_ControllersConfig(in *config.ControllersConfig, out *ControllersConfig, s conversion.Scope) error {
+	out.BackupBucket = (*ControllerConfig)(unsafe.Pointer(in.BackupBucket))
+	out.Bastion = (*ControllerConfig)(unsafe.Pointer(in.Bastion))
+	out.ControlPlane = (*ControllerConfig)(unsafe.Pointer(in.ControlPlane))
+	out.DNSRecord = (*ControllerConfig)(unsafe.Pointer(in.DNSRecord))
+	out.Infrastructure = (*ControllerConfig)(unsafe.Pointer(in.Infrastructure))
+	out.Worker = (*ControllerConfig)(unsafe.Pointer(in.Worker))
+	return nil
+}
+
+// Convert_config_ControllersConfig_To_v1alpha1_ControllersConfig is an autogenerated conversion function.
+func Convert_config_ControllersConfig_To_v1alpha1_ControllersConfig(in *config.ControllersConfig, out *ControllersConfig, s conversion.Scope) error {
+	return autoConvert_config_ControllersConfig_To_v1alpha1_ControllersConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordConfig_To_config_DNSRecordConfig(in *DNSRecordConfig, out *config.DNSRecordConfig, s conversion.Scope) error {
+	out.ZoneCacheTTL = (*v1.Duration)(unsafe.Pointer(in.ZoneCacheTTL))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordConfig_To_config_DNSRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordConfig_To_config_DNSRecordConfig(in *DNSRecordConfig, out *config.DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordConfig_To_config_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_config_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *config.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	out.ZoneCacheTTL = (*v1.Duration)(unsafe.Pointer(in.ZoneCacheTTL))
+	return nil
+}
+
+// Convert_config_DNSRecordConfig_To_v1alpha1_DNSRecordConfig is an autogenerated conversion function.
+func Convert_config_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *config.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_config_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig(in *DriftDetectionConfig, out *config.DriftDetectionConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.OutOfBandModifications = (*config.OutOfBandModificationsConfig)(unsafe.Pointer(in.OutOfBandModifications))
+	return nil
+}
+
+// Convert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig(in *DriftDetectionConfig, out *config.DriftDetectionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DriftDetectionConfig_To_config_DriftDetectionConfig(in, out, s)
+}
+
+func autoConvert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig(in *config.DriftDetectionConfig, out *DriftDetectionConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.OutOfBandModifications = (*OutOfBandModificationsConfig)(unsafe.Pointer(in.OutOfBandModifications))
+	return nil
+}
+
+// Convert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig is an autogenerated conversion function.
+func Convert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig(in *config.DriftDetectionConfig, out *DriftDetectionConfig, s conversion.Scope) error {
+	return autoConvert_config_DriftDetectionConfig_To_v1alpha1_DriftDetectionConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_ETCD_To_config_ETCD(in *ETCD, out *config.ETCD, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ETCDStorage_To_config_ETCDStorage(&in.Storage, &out.Storage, s); err != nil {
 		return err
@@ -173,3 +488,269 @@ func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStora
 func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
+
+func autoConvert_v1alpha1_FailedVMRemedyConfig_To_config_FailedVMRemedyConfig(in *FailedVMRemedyConfig, out *config.FailedVMRemedyConfig, s conversion.Scope) error {
+	out.RequeueInterval = (*v1.Duration)(unsafe.Pointer(in.RequeueInterval))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.MaxGetAttempts = (*int32)(unsafe.Pointer(in.MaxGetAttempts))
+	out.MaxReapplyAttempts = (*int32)(unsafe.Pointer(in.MaxReapplyAttempts))
+	return nil
+}
+
+// Convert_v1alpha1_FailedVMRemedyConfig_To_config_FailedVMRemedyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FailedVMRemedyConfig_To_config_FailedVMRemedyConfig(in *FailedVMRemedyConfig, out *config.FailedVMRemedyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailedVMRemedyConfig_To_config_FailedVMRemedyConfig(in, out, s)
+}
+
+func autoConvert_config_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig(in *config.FailedVMRemedyConfig, out *FailedVMRemedyConfig, s conversion.Scope) error {
+	out.RequeueInterval = (*v1.Duration)(unsafe.Pointer(in.RequeueInterval))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.MaxGetAttempts = (*int32)(unsafe.Pointer(in.MaxGetAttempts))
+	out.MaxReapplyAttempts = (*int32)(unsafe.Pointer(in.MaxReapplyAttempts))
+	return nil
+}
+
+// Convert_config_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig is an autogenerated conversion function.
+func Convert_config_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig(in *config.FailedVMRemedyConfig, out *FailedVMRemedyConfig, s conversion.Scope) error {
+	return autoConvert_config_FailedVMRemedyConfig_To_v1alpha1_FailedVMRemedyConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImageVectorOverride_To_config_ImageVectorOverride(in *ImageVectorOverride, out *config.ImageVectorOverride, s conversion.Scope) error {
+	out.Cloud = in.Cloud
+	out.Images = *(*map[string]string)(unsafe.Pointer(&in.Images))
+	return nil
+}
+
+// Convert_v1alpha1_ImageVectorOverride_To_config_ImageVectorOverride is an autogenerated conversion function.
+func Convert_v1alpha1_ImageVectorOverride_To_config_ImageVectorOverride(in *ImageVectorOverride, out *config.ImageVectorOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageVectorOverride_To_config_ImageVectorOverride(in, out, s)
+}
+
+func autoConvert_config_ImageVectorOverride_To_v1alpha1_ImageVectorOverride(in *config.ImageVectorOverride, out *ImageVectorOverride, s conversion.Scope) error {
+	out.Cloud = in.Cloud
+	out.Images = *(*map[string]string)(unsafe.Pointer(&in.Images))
+	return nil
+}
+
+// Convert_config_ImageVectorOverride_To_v1alpha1_ImageVectorOverride is an autogenerated conversion function.
+func Convert_config_ImageVectorOverride_To_v1alpha1_ImageVectorOverride(in *config.ImageVectorOverride, out *ImageVectorOverride, s conversion.Scope) error {
+	return autoConvert_config_ImageVectorOverride_To_v1alpha1_ImageVectorOverride(in, out, s)
+}
+
+func autoConvert_v1alpha1_LeaderElectionConfig_To_config_LeaderElectionConfig(in *LeaderElectionConfig, out *config.LeaderElectionConfig, s conversion.Scope) error {
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.RenewDeadline = (*v1.Duration)(unsafe.Pointer(in.RenewDeadline))
+	out.RetryPeriod = (*v1.Duration)(unsafe.Pointer(in.RetryPeriod))
+	out.Profile = (*config.LeaderElectionProfile)(unsafe.Pointer(in.Profile))
+	out.ReleaseOnCancel = (*bool)(unsafe.Pointer(in.ReleaseOnCancel))
+	return nil
+}
+
+// Convert_v1alpha1_LeaderElectionConfig_To_config_LeaderElectionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LeaderElectionConfig_To_config_LeaderElectionConfig(in *LeaderElectionConfig, out *config.LeaderElectionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LeaderElectionConfig_To_config_LeaderElectionConfig(in, out, s)
+}
+
+func autoConvert_config_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in *config.LeaderElectionConfig, out *LeaderElectionConfig, s conversion.Scope) error {
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.RenewDeadline = (*v1.Duration)(unsafe.Pointer(in.RenewDeadline))
+	out.RetryPeriod = (*v1.Duration)(unsafe.Pointer(in.RetryPeriod))
+	out.Profile = (*LeaderElectionProfile)(unsafe.Pointer(in.Profile))
+	out.ReleaseOnCancel = (*bool)(unsafe.Pointer(in.ReleaseOnCancel))
+	return nil
+}
+
+// Convert_config_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig is an autogenerated conversion function.
+func Convert_config_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in *config.LeaderElectionConfig, out *LeaderElectionConfig, s conversion.Scope) error {
+	return autoConvert_config_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ManagementLocksConfig_To_config_ManagementLocksConfig(in *ManagementLocksConfig, out *config.ManagementLocksConfig, s conversion.Scope) error {
+	out.RemoveOwnLocks = in.RemoveOwnLocks
+	return nil
+}
+
+// Convert_v1alpha1_ManagementLocksConfig_To_config_ManagementLocksConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ManagementLocksConfig_To_config_ManagementLocksConfig(in *ManagementLocksConfig, out *config.ManagementLocksConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagementLocksConfig_To_config_ManagementLocksConfig(in, out, s)
+}
+
+func autoConvert_config_ManagementLocksConfig_To_v1alpha1_ManagementLocksConfig(in *config.ManagementLocksConfig, out *ManagementLocksConfig, s conversion.Scope) error {
+	out.RemoveOwnLocks = in.RemoveOwnLocks
+	return nil
+}
+
+// Convert_config_ManagementLocksConfig_To_v1alpha1_ManagementLocksConfig is an autogenerated conversion function.
+func Convert_config_ManagementLocksConfig_To_v1alpha1_ManagementLocksConfig(in *config.ManagementLocksConfig, out *ManagementLocksConfig, s conversion.Scope) error {
+	return autoConvert_config_ManagementLocksConfig_To_v1alpha1_ManagementLocksConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OrphanDetectionConfig_To_config_OrphanDetectionConfig(in *OrphanDetectionConfig, out *config.OrphanDetectionConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.GracePeriod = (*v1.Duration)(unsafe.Pointer(in.GracePeriod))
+	out.DryRun = (*bool)(unsafe.Pointer(in.DryRun))
+	return nil
+}
+
+// Convert_v1alpha1_OrphanDetectionConfig_To_config_OrphanDetectionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OrphanDetectionConfig_To_config_OrphanDetectionConfig(in *OrphanDetectionConfig, out *config.OrphanDetectionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphanDetectionConfig_To_config_OrphanDetectionConfig(in, out, s)
+}
+
+func autoConvert_config_OrphanDetectionConfig_To_v1alpha1_OrphanDetectionConfig(in *config.OrphanDetectionConfig, out *OrphanDetectionConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.GracePeriod = (*v1.Duration)(unsafe.Pointer(in.GracePeriod))
+	out.DryRun = (*bool)(unsafe.Pointer(in.DryRun))
+	return nil
+}
+
+// Convert_config_OrphanDetectionConfig_To_v1alpha1_OrphanDetectionConfig is an autogenerated conversion function.
+func Convert_config_OrphanDetectionConfig_To_v1alpha1_OrphanDetectionConfig(in *config.OrphanDetectionConfig, out *OrphanDetectionConfig, s conversion.Scope) error {
+	return autoConvert_config_OrphanDetectionConfig_To_v1alpha1_OrphanDetectionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OrphanedPublicIPRemedyConfig_To_config_OrphanedPublicIPRemedyConfig(in *OrphanedPublicIPRemedyConfig, out *config.OrphanedPublicIPRemedyConfig, s conversion.Scope) error {
+	out.RequeueInterval = (*v1.Duration)(unsafe.Pointer(in.RequeueInterval))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.DeletionGracePeriod = (*v1.Duration)(unsafe.Pointer(in.DeletionGracePeriod))
+	out.MaxGetAttempts = (*int32)(unsafe.Pointer(in.MaxGetAttempts))
+	out.MaxCleanAttempts = (*int32)(unsafe.Pointer(in.MaxCleanAttempts))
+	return nil
+}
+
+// Convert_v1alpha1_OrphanedPublicIPRemedyConfig_To_config_OrphanedPublicIPRemedyConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OrphanedPublicIPRemedyConfig_To_config_OrphanedPublicIPRemedyConfig(in *OrphanedPublicIPRemedyConfig, out *config.OrphanedPublicIPRemedyConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphanedPublicIPRemedyConfig_To_config_OrphanedPublicIPRemedyConfig(in, out, s)
+}
+
+func autoConvert_config_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig(in *config.OrphanedPublicIPRemedyConfig, out *OrphanedPublicIPRemedyConfig, s conversion.Scope) error {
+	out.RequeueInterval = (*v1.Duration)(unsafe.Pointer(in.RequeueInterval))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.DeletionGracePeriod = (*v1.Duration)(unsafe.Pointer(in.DeletionGracePeriod))
+	out.MaxGetAttempts = (*int32)(unsafe.Pointer(in.MaxGetAttempts))
+	out.MaxCleanAttempts = (*int32)(unsafe.Pointer(in.MaxCleanAttempts))
+	return nil
+}
+
+// Convert_config_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig is an autogenerated conversion function.
+func Convert_config_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig(in *config.OrphanedPublicIPRemedyConfig, out *OrphanedPublicIPRemedyConfig, s conversion.Scope) error {
+	return autoConvert_config_OrphanedPublicIPRemedyConfig_To_v1alpha1_OrphanedPublicIPRemedyConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OutOfBandModificationsConfig_To_config_OutOfBandModificationsConfig(in *OutOfBandModificationsConfig, out *config.OutOfBandModificationsConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AllowedCallers = *(*[]string)(unsafe.Pointer(&in.AllowedCallers))
+	return nil
+}
+
+// Convert_v1alpha1_OutOfBandModificationsConfig_To_config_OutOfBandModificationsConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OutOfBandModificationsConfig_To_config_OutOfBandModificationsConfig(in *OutOfBandModificationsConfig, out *config.OutOfBandModificationsConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OutOfBandModificationsConfig_To_config_OutOfBandModificationsConfig(in, out, s)
+}
+
+func autoConvert_config_OutOfBandModificationsConfig_To_v1alpha1_OutOfBandModificationsConfig(in *config.OutOfBandModificationsConfig, out *OutOfBandModificationsConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AllowedCallers = *(*[]string)(unsafe.Pointer(&in.AllowedCallers))
+	return nil
+}
+
+// Convert_config_OutOfBandModificationsConfig_To_v1alpha1_OutOfBandModificationsConfig is an autogenerated conversion function.
+func Convert_config_OutOfBandModificationsConfig_To_v1alpha1_OutOfBandModificationsConfig(in *config.OutOfBandModificationsConfig, out *OutOfBandModificationsConfig, s conversion.Scope) error {
+	return autoConvert_config_OutOfBandModificationsConfig_To_v1alpha1_OutOfBandModificationsConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_Policy_To_config_Policy(in *Policy, out *config.Policy, s conversion.Scope) error {
+	out.RequireNatGateway = in.RequireNatGateway
+	out.RequireZoneRedundantNatGateway = in.RequireZoneRedundantNatGateway
+	out.NatGatewayExemptNamespaces = *(*[]string)(unsafe.Pointer(&in.NatGatewayExemptNamespaces))
+	out.CredentialsPreflight = in.CredentialsPreflight
+	return nil
+}
+
+// Convert_v1alpha1_Policy_To_config_Policy is an autogenerated conversion function.
+func Convert_v1alpha1_Policy_To_config_Policy(in *Policy, out *config.Policy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Policy_To_config_Policy(in, out, s)
+}
+
+func autoConvert_config_Policy_To_v1alpha1_Policy(in *config.Policy, out *Policy, s conversion.Scope) error {
+	out.RequireNatGateway = in.RequireNatGateway
+	out.RequireZoneRedundantNatGateway = in.RequireZoneRedundantNatGateway
+	out.NatGatewayExemptNamespaces = *(*[]string)(unsafe.Pointer(&in.NatGatewayExemptNamespaces))
+	out.CredentialsPreflight = in.CredentialsPreflight
+	return nil
+}
+
+// Convert_config_Policy_To_v1alpha1_Policy is an autogenerated conversion function.
+func Convert_config_Policy_To_v1alpha1_Policy(in *config.Policy, out *Policy, s conversion.Scope) error {
+	return autoConvert_config_Policy_To_v1alpha1_Policy(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrivateDNSZone_To_config_PrivateDNSZone(in *PrivateDNSZone, out *config.PrivateDNSZone, s conversion.Scope) error {
+	out.ResourceGroup = in.ResourceGroup
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_PrivateDNSZone_To_config_PrivateDNSZone is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateDNSZone_To_config_PrivateDNSZone(in *PrivateDNSZone, out *config.PrivateDNSZone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateDNSZone_To_config_PrivateDNSZone(in, out, s)
+}
+
+func autoConvert_config_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(in *config.PrivateDNSZone, out *PrivateDNSZone, s conversion.Scope) error {
+	out.ResourceGroup = in.ResourceGroup
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_config_PrivateDNSZone_To_v1alpha1_PrivateDNSZone is an autogenerated conversion function.
+func Convert_config_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(in *config.PrivateDNSZone, out *PrivateDNSZone, s conversion.Scope) error {
+	return autoConvert_config_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(in, out, s)
+}
+
+func autoConvert_v1alpha1_RemedyControllerConfig_To_config_RemedyControllerConfig(in *RemedyControllerConfig, out *config.RemedyControllerConfig, s conversion.Scope) error {
+	out.OrphanedPublicIPRemedy = (*config.OrphanedPublicIPRemedyConfig)(unsafe.Pointer(in.OrphanedPublicIPRemedy))
+	out.FailedVMRemedy = (*config.FailedVMRemedyConfig)(unsafe.Pointer(in.FailedVMRemedy))
+	return nil
+}
+
+// Convert_v1alpha1_RemedyControllerConfig_To_config_RemedyControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RemedyControllerConfig_To_config_RemedyControllerConfig(in *RemedyControllerConfig, out *config.RemedyControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RemedyControllerConfig_To_config_RemedyControllerConfig(in, out, s)
+}
+
+func autoConvert_config_RemedyControllerConfig_To_v1alpha1_RemedyControllerConfig(in *config.RemedyControllerConfig, out *RemedyControllerConfig, s conversion.Scope) error {
+	out.OrphanedPublicIPRemedy = (*OrphanedPublicIPRemedyConfig)(unsafe.Pointer(in.OrphanedPublicIPRemedy))
+	out.FailedVMRemedy = (*FailedVMRemedyConfig)(unsafe.Pointer(in.FailedVMRemedy))
+	return nil
+}
+
+// Convert_config_RemedyControllerConfig_To_v1alpha1_RemedyControllerConfig is an autogenerated conversion function.
+func Convert_config_RemedyControllerConfig_To_v1alpha1_RemedyControllerConfig(in *config.RemedyControllerConfig, out *RemedyControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_RemedyControllerConfig_To_v1alpha1_RemedyControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootDefaults_To_config_ShootDefaults(in *ShootDefaults, out *config.ShootDefaults, s conversion.Scope) error {
+	out.NatGatewayIdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.NatGatewayIdleConnectionTimeoutMinutes))
+	out.OSDiskType = (*string)(unsafe.Pointer(in.OSDiskType))
+	out.OutboundAccessType = (*string)(unsafe.Pointer(in.OutboundAccessType))
+	return nil
+}
+
+// Convert_v1alpha1_ShootDefaults_To_config_ShootDefaults is an autogenerated conversion function.
+func Convert_v1alpha1_ShootDefaults_To_config_ShootDefaults(in *ShootDefaults, out *config.ShootDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootDefaults_To_config_ShootDefaults(in, out, s)
+}
+
+func autoConvert_config_ShootDefaults_To_v1alpha1_ShootDefaults(in *config.ShootDefaults, out *ShootDefaults, s conversion.Scope) error {
+	out.NatGatewayIdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.NatGatewayIdleConnectionTimeoutMinutes))
+	out.OSDiskType = (*string)(unsafe.Pointer(in.OSDiskType))
+	out.OutboundAccessType = (*string)(unsafe.Pointer(in.OutboundAccessType))
+	return nil
+}
+
+// Convert_config_ShootDefaults_To_v1alpha1_ShootDefaults is an autogenerated conversion function.
+func Convert_config_ShootDefaults_To_v1alpha1_ShootDefaults(in *config.ShootDefaults, out *ShootDefaults, s conversion.Scope) error {
+	return autoConvert_config_ShootDefaults_To_v1alpha1_ShootDefaults(in, out, s)
+}
@@ -11,10 +11,70 @@ package v1alpha1
 
 import (
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneExposureConfig) DeepCopyInto(out *ControlPlaneExposureConfig) {
+	*out = *in
+	out.PrivateDNSZone = in.PrivateDNSZone
+	out.SecretRef = in.SecretRef
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneExposureConfig.
+func (in *ControlPlaneExposureConfig) DeepCopy() *ControlPlaneExposureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneExposureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerConfig) DeepCopyInto(out *ControllerConfig) {
+	*out = *in
+	if in.MaxConcurrentReconciles != nil {
+		in, out := &in.MaxConcurrentReconciles, &out.MaxConcurrentReconciles
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequeueBaseDelay != nil {
+		in, out := &in.RequeueBaseDelay, &out.RequeueBaseDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequeueMaxDelay != nil {
+		in, out := &in.RequeueMaxDelay, &out.RequeueMaxDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerConfig.
+func (in *ControllerConfig) DeepCopy() *ControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -37,6 +97,75 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 			(*out)[key] = val
 		}
 	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(Policy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemedyController != nil {
+		in, out := &in.RemedyController, &out.RemedyController
+		*out = new(RemedyControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrphanDetection != nil {
+		in, out := &in.OrphanDetection, &out.OrphanDetection
+		*out = new(OrphanDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneExposure != nil {
+		in, out := &in.ControlPlaneExposure, &out.ControlPlaneExposure
+		*out = new(ControlPlaneExposureConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagementLocks != nil {
+		in, out := &in.ManagementLocks, &out.ManagementLocks
+		*out = new(ManagementLocksConfig)
+		**out = **in
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSRecord != nil {
+		in, out := &in.DNSRecord, &out.DNSRecord
+		*out = new(DNSRecordConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootDefaults != nil {
+		in, out := &in.ShootDefaults, &out.ShootDefaults
+		*out = new(ShootDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MandatoryVMTags != nil {
+		in, out := &in.MandatoryVMTags, &out.MandatoryVMTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ImageVectorOverrides != nil {
+		in, out := &in.ImageVectorOverrides, &out.ImageVectorOverrides
+		*out = make([]ImageVectorOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = new(ControllersConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LeaderElection != nil {
+		in, out := &in.LeaderElection, &out.LeaderElection
+		*out = new(LeaderElectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableProjectedTokenMount != nil {
+		in, out := &in.DisableProjectedTokenMount, &out.DisableProjectedTokenMount
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -58,6 +187,99 @@ func (in *ControllerConfiguration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllersConfig) DeepCopyInto(out *ControllersConfig) {
+	*out = *in
+	if in.BackupBucket != nil {
+		in, out := &in.BackupBucket, &out.BackupBucket
+		*out = new(ControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(ControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(ControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSRecord != nil {
+		in, out := &in.DNSRecord, &out.DNSRecord
+		*out = new(ControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Infrastructure != nil {
+		in, out := &in.Infrastructure, &out.Infrastructure
+		*out = new(ControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Worker != nil {
+		in, out := &in.Worker, &out.Worker
+		*out = new(ControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllersConfig.
+func (in *ControllersConfig) DeepCopy() *ControllersConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllersConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordConfig) DeepCopyInto(out *DNSRecordConfig) {
+	*out = *in
+	if in.ZoneCacheTTL != nil {
+		in, out := &in.ZoneCacheTTL, &out.ZoneCacheTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordConfig.
+func (in *DNSRecordConfig) DeepCopy() *DNSRecordConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionConfig) DeepCopyInto(out *DriftDetectionConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OutOfBandModifications != nil {
+		in, out := &in.OutOfBandModifications, &out.OutOfBandModifications
+		*out = new(OutOfBandModificationsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionConfig.
+func (in *DriftDetectionConfig) DeepCopy() *DriftDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCD) DeepCopyInto(out *ETCD) {
 	*out = *in
@@ -122,3 +344,306 @@ func (in *ETCDStorage) DeepCopy() *ETCDStorage {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedVMRemedyConfig) DeepCopyInto(out *FailedVMRemedyConfig) {
+	*out = *in
+	if in.RequeueInterval != nil {
+		in, out := &in.RequeueInterval, &out.RequeueInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxGetAttempts != nil {
+		in, out := &in.MaxGetAttempts, &out.MaxGetAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReapplyAttempts != nil {
+		in, out := &in.MaxReapplyAttempts, &out.MaxReapplyAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedVMRemedyConfig.
+func (in *FailedVMRemedyConfig) DeepCopy() *FailedVMRemedyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedVMRemedyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVectorOverride) DeepCopyInto(out *ImageVectorOverride) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVectorOverride.
+func (in *ImageVectorOverride) DeepCopy() *ImageVectorOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVectorOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeaderElectionConfig) DeepCopyInto(out *LeaderElectionConfig) {
+	*out = *in
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewDeadline != nil {
+		in, out := &in.RenewDeadline, &out.RenewDeadline
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetryPeriod != nil {
+		in, out := &in.RetryPeriod, &out.RetryPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(LeaderElectionProfile)
+		**out = **in
+	}
+	if in.ReleaseOnCancel != nil {
+		in, out := &in.ReleaseOnCancel, &out.ReleaseOnCancel
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderElectionConfig.
+func (in *LeaderElectionConfig) DeepCopy() *LeaderElectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LeaderElectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementLocksConfig) DeepCopyInto(out *ManagementLocksConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementLocksConfig.
+func (in *ManagementLocksConfig) DeepCopy() *ManagementLocksConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementLocksConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanDetectionConfig) DeepCopyInto(out *OrphanDetectionConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanDetectionConfig.
+func (in *OrphanDetectionConfig) DeepCopy() *OrphanDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedPublicIPRemedyConfig) DeepCopyInto(out *OrphanedPublicIPRemedyConfig) {
+	*out = *in
+	if in.RequeueInterval != nil {
+		in, out := &in.RequeueInterval, &out.RequeueInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeletionGracePeriod != nil {
+		in, out := &in.DeletionGracePeriod, &out.DeletionGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxGetAttempts != nil {
+		in, out := &in.MaxGetAttempts, &out.MaxGetAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCleanAttempts != nil {
+		in, out := &in.MaxCleanAttempts, &out.MaxCleanAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedPublicIPRemedyConfig.
+func (in *OrphanedPublicIPRemedyConfig) DeepCopy() *OrphanedPublicIPRemedyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedPublicIPRemedyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutOfBandModificationsConfig) DeepCopyInto(out *OutOfBandModificationsConfig) {
+	*out = *in
+	if in.AllowedCallers != nil {
+		in, out := &in.AllowedCallers, &out.AllowedCallers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutOfBandModificationsConfig.
+func (in *OutOfBandModificationsConfig) DeepCopy() *OutOfBandModificationsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OutOfBandModificationsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	if in.NatGatewayExemptNamespaces != nil {
+		in, out := &in.NatGatewayExemptNamespaces, &out.NatGatewayExemptNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSZone) DeepCopyInto(out *PrivateDNSZone) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZone.
+func (in *PrivateDNSZone) DeepCopy() *PrivateDNSZone {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemedyControllerConfig) DeepCopyInto(out *RemedyControllerConfig) {
+	*out = *in
+	if in.OrphanedPublicIPRemedy != nil {
+		in, out := &in.OrphanedPublicIPRemedy, &out.OrphanedPublicIPRemedy
+		*out = new(OrphanedPublicIPRemedyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailedVMRemedy != nil {
+		in, out := &in.FailedVMRemedy, &out.FailedVMRemedy
+		*out = new(FailedVMRemedyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemedyControllerConfig.
+func (in *RemedyControllerConfig) DeepCopy() *RemedyControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RemedyControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootDefaults) DeepCopyInto(out *ShootDefaults) {
+	*out = *in
+	if in.NatGatewayIdleConnectionTimeoutMinutes != nil {
+		in, out := &in.NatGatewayIdleConnectionTimeoutMinutes, &out.NatGatewayIdleConnectionTimeoutMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OSDiskType != nil {
+		in, out := &in.OSDiskType, &out.OSDiskType
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutboundAccessType != nil {
+		in, out := &in.OutboundAccessType, &out.OutboundAccessType
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootDefaults.
+func (in *ShootDefaults) DeepCopy() *ShootDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+const (
+	activityLogsAPIVersion = "2015-04-01"
+	// activityLogsSelect are the properties of the events which are returned, to keep the responses small.
+	activityLogsSelect = "caller,claims,correlationId,eventTimestamp,operationName,resourceId,status"
+)
+
+// ActivityLogEvent is an event of the Azure activity log.
+type ActivityLogEvent struct {
+	// Caller is the identity which performed the operation, i.e. the user principal name of users and the object or
+	// client ID of applications.
+	Caller *string `json:"caller,omitempty"`
+	// Claims are the claims of the token of the caller.
+	Claims map[string]*string `json:"claims,omitempty"`
+	// CorrelationID correlates the events of an operation.
+	CorrelationID *string `json:"correlationId,omitempty"`
+	// EventTimestamp is the time at which the event occurred.
+	EventTimestamp *time.Time `json:"eventTimestamp,omitempty"`
+	// OperationName is the name of the operation, e.g. "Microsoft.Network/natGateways/write".
+	OperationName *ActivityLogLocalizableString `json:"operationName,omitempty"`
+	// ResourceID is the resource ID of the resource the operation was performed on.
+	ResourceID *string `json:"resourceId,omitempty"`
+	// Status is the status of the operation, e.g. "Started" or "Succeeded".
+	Status *ActivityLogLocalizableString `json:"status,omitempty"`
+}
+
+// ActivityLogLocalizableString is a string of the activity log with its localized representation.
+type ActivityLogLocalizableString struct {
+	// Value is the invariant value of the string.
+	Value *string `json:"value,omitempty"`
+	// LocalizedValue is the localized value of the string.
+	LocalizedValue *string `json:"localizedValue,omitempty"`
+}
+
+var _ ActivityLogs = &ActivityLogsClient{}
+
+// ActivityLogsClient is a client for the Azure activity log.
+type ActivityLogsClient struct {
+	client         *restClient
+	subscriptionID string
+}
+
+// NewActivityLogsClient creates a new ActivityLogsClient.
+func NewActivityLogsClient(auth *internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*ActivityLogsClient, error) {
+	client, err := newRESTClient(activityLogsAPIVersion, tc, opts)
+	return &ActivityLogsClient{client: client, subscriptionID: auth.SubscriptionID}, err
+}
+
+// ListResourceGroupEvents lists the events of the activity log which occurred in the given resource group between from
+// and to.
+func (c *ActivityLogsClient) ListResourceGroupEvents(ctx context.Context, resourceGroupName string, from, to time.Time) ([]*ActivityLogEvent, error) {
+	query := url.Values{}
+	query.Set("$filter", fmt.Sprintf("eventTimestamp ge '%s' and eventTimestamp le '%s' and resourceGroupName eq '%s'",
+		from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339), strings.ReplaceAll(resourceGroupName, "'", "''")))
+	query.Set("$select", activityLogsSelect)
+
+	return listAll[*ActivityLogEvent](ctx, c.client, c.client.endpoint(query, "/subscriptions/", url.PathEscape(c.subscriptionID),
+		"/providers/Microsoft.Insights/eventtypes/management/values"))
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("ActivityLogsClient", func() {
+	const eventsPath = "/subscriptions/subscription/providers/Microsoft.Insights/eventtypes/management/values"
+
+	var (
+		ctx       = context.Background()
+		transport *responderTransport
+		client    *ActivityLogsClient
+
+		from = time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC)
+		to   = from.Add(time.Hour)
+	)
+
+	BeforeEach(func() {
+		transport = &responderTransport{responses: map[string]*http.Response{}}
+
+		var err error
+		client, err = NewActivityLogsClient(&internal.ClientAuth{SubscriptionID: "subscription"}, &azfake.TokenCredential{}, withTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#ListResourceGroupEvents", func() {
+		It("should list the events of the resource group of all pages", func() {
+			transport.responses["GET "+eventsPath] = jsonResponse(http.StatusOK, `{
+  "value": [{
+    "caller": "user@example.com",
+    "claims": {"appid": "portal"},
+    "correlationId": "correlation",
+    "eventTimestamp": "2024-10-01T10:30:00Z",
+    "operationName": {"value": "Microsoft.Network/natGateways/write", "localizedValue": "Create or Update NAT Gateway"},
+    "resourceId": "/subscriptions/subscription/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/nat",
+    "status": {"value": "Succeeded"}
+  }],
+  "nextLink": "https://management.azure.com/next-page"
+}`)
+			transport.responses["GET /next-page"] = jsonResponse(http.StatusOK, `{"value": [{"caller": "other", "status": {"value": "Started"}}]}`)
+
+			events, err := client.ListResourceGroupEvents(ctx, "shoot--foo--bar", from, to)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(*events[0].Caller).To(Equal("user@example.com"))
+			Expect(*events[0].Claims["appid"]).To(Equal("portal"))
+			Expect(*events[0].EventTimestamp).To(BeTemporally("==", from.Add(30*time.Minute)))
+			Expect(*events[0].OperationName.Value).To(Equal("Microsoft.Network/natGateways/write"))
+			Expect(*events[0].Status.Value).To(Equal("Succeeded"))
+			Expect(*events[1].Caller).To(Equal("other"))
+
+			query := transport.requests[0].URL.Query()
+			Expect(query.Get("api-version")).To(Equal("2015-04-01"))
+			Expect(query.Get("$filter")).To(Equal("eventTimestamp ge '2024-10-01T10:00:00Z' and eventTimestamp le '2024-10-01T11:00:00Z' and resourceGroupName eq 'shoot--foo--bar'"))
+			Expect(query.Get("$select")).To(ContainSubstring("operationName"))
+		})
+
+		It("should return the error of the request", func() {
+			_, err := client.ListResourceGroupEvents(ctx, "shoot--foo--bar", from, to)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
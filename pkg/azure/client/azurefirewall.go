@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ AzureFirewall = &AzureFirewallClient{}
+
+// AzureFirewallClient is an implementation of AzureFirewall for an Azure Firewall k8sClient.
+type AzureFirewallClient struct {
+	client *armnetwork.AzureFirewallsClient
+}
+
+// NewAzureFirewallClient creates a new AzureFirewall client.
+func NewAzureFirewallClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*AzureFirewallClient, error) {
+	client, err := armnetwork.NewAzureFirewallsClient(auth.SubscriptionID, tc, opts)
+	return &AzureFirewallClient{client}, err
+}
+
+// CreateOrUpdate creates or updates an Azure Firewall.
+func (c *AzureFirewallClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name string, parameters armnetwork.AzureFirewall) (*armnetwork.AzureFirewall, error) {
+	poller, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, name, parameters, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create azure firewall: %w", err)
+	}
+	res, err := poller.PollUntilDone(ctx, nil)
+	return &res.AzureFirewall, err
+}
+
+// Delete deletes the Azure Firewall with the given name.
+func (c *AzureFirewallClient) Delete(ctx context.Context, resourceGroupName, name string) error {
+	poller, err := c.client.BeginDelete(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
+// Get returns an Azure Firewall by name or nil if it does not exist.
+func (c *AzureFirewallClient) Get(ctx context.Context, resourceGroupName, name string) (*armnetwork.AzureFirewall, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.AzureFirewall, nil
+}
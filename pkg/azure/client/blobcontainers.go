@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ BlobContainers = &BlobContainersClient{}
+
+// BlobContainersClient is an implementation of BlobContainers for the management of blob containers via the Azure
+// Resource Manager.
+type BlobContainersClient struct {
+	client *armstorage.BlobContainersClient
+}
+
+// NewBlobContainersClient creates a new BlobContainersClient.
+func NewBlobContainersClient(auth *internal.ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*BlobContainersClient, error) {
+	client, err := armstorage.NewBlobContainersClient(auth.SubscriptionID, tc, opts)
+	return &BlobContainersClient{client}, err
+}
+
+// Get returns the given blob container. It returns nil if the container does not exist.
+func (c *BlobContainersClient) Get(ctx context.Context, resourceGroupName, storageAccountName, containerName string) (*armstorage.BlobContainer, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, storageAccountName, containerName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.BlobContainer, nil
+}
+
+// SetLegalHold adds the given tags to the legal hold of a blob container. Tags which are already set are kept.
+func (c *BlobContainersClient) SetLegalHold(ctx context.Context, resourceGroupName, storageAccountName, containerName string, tags []string) error {
+	_, err := c.client.SetLegalHold(ctx, resourceGroupName, storageAccountName, containerName, armstorage.LegalHold{Tags: to.SliceOfPtrs(tags...)}, nil)
+	return err
+}
+
+// ClearLegalHold removes the given tags from the legal hold of a blob container. The legal hold is lifted once all of its
+// tags are removed.
+func (c *BlobContainersClient) ClearLegalHold(ctx context.Context, resourceGroupName, storageAccountName, containerName string, tags []string) error {
+	_, err := c.client.ClearLegalHold(ctx, resourceGroupName, storageAccountName, containerName, armstorage.LegalHold{Tags: to.SliceOfPtrs(tags...)}, nil)
+	return err
+}
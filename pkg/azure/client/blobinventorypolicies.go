@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ BlobInventoryPolicies = &BlobInventoryPoliciesClient{}
+
+// BlobInventoryPoliciesClient is an implementation of BlobInventoryPolicies for the blob inventory policies of storage
+// accounts. A storage account has at most one blob inventory policy, which is always named "default".
+type BlobInventoryPoliciesClient struct {
+	client *armstorage.BlobInventoryPoliciesClient
+}
+
+// NewBlobInventoryPoliciesClient creates a new BlobInventoryPoliciesClient.
+func NewBlobInventoryPoliciesClient(auth *internal.ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*BlobInventoryPoliciesClient, error) {
+	client, err := armstorage.NewBlobInventoryPoliciesClient(auth.SubscriptionID, tc, opts)
+	return &BlobInventoryPoliciesClient{client}, err
+}
+
+// Get returns the blob inventory policy of a storage account. It returns nil if the storage account has no blob
+// inventory policy.
+func (c *BlobInventoryPoliciesClient) Get(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.BlobInventoryPolicy, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, storageAccountName, armstorage.BlobInventoryPolicyNameDefault, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.BlobInventoryPolicy, nil
+}
+
+// CreateOrUpdate creates or replaces the blob inventory policy of a storage account.
+func (c *BlobInventoryPoliciesClient) CreateOrUpdate(ctx context.Context, resourceGroupName, storageAccountName string, parameters armstorage.BlobInventoryPolicy) (*armstorage.BlobInventoryPolicy, error) {
+	res, err := c.client.CreateOrUpdate(ctx, resourceGroupName, storageAccountName, armstorage.BlobInventoryPolicyNameDefault, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.BlobInventoryPolicy, nil
+}
+
+// Delete deletes the blob inventory policy of a storage account if it exists.
+func (c *BlobInventoryPoliciesClient) Delete(ctx context.Context, resourceGroupName, storageAccountName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, storageAccountName, armstorage.BlobInventoryPolicyNameDefault, nil)
+	return FilterNotFoundError(err)
+}
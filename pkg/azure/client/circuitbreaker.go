@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConditionTypeRegionAvailable is the type of the condition which reports whether the Azure region of an extension
+// object is available. It is only added once an outage of the region was detected.
+const ConditionTypeRegionAvailable gardencorev1beta1.ConditionType = "AzureRegionAvailable"
+
+var (
+	// CircuitBreakerFailureThreshold is the number of consecutive requests to a region which have to fail with server
+	// errors or timeouts before the circuit breaker of the region opens.
+	CircuitBreakerFailureThreshold = 5
+	// CircuitBreakerOpenDuration is the duration for which a circuit breaker stays open before mutating requests to the
+	// region are attempted again.
+	CircuitBreakerOpenDuration = 5 * time.Minute
+
+	circuitBreakersMutex sync.Mutex
+	// circuitBreakers are keyed by subscription and region, as outages or throttling may be limited to a subscription.
+	circuitBreakers = map[string]*circuitBreaker{}
+)
+
+// RegionalOutageError is returned for mutating requests to a region whose circuit breaker is open, i.e. for which
+// sustained server errors or timeouts were observed.
+type RegionalOutageError struct {
+	// Region is the region which is considered unavailable.
+	Region string
+	// RetryAfter is the duration after which mutating requests to the region are attempted again.
+	RetryAfter time.Duration
+}
+
+func (e *RegionalOutageError) Error() string {
+	return fmt.Sprintf("RegionalOutage: the Azure region %s is considered unavailable after sustained server errors or timeouts, "+
+		"mutating requests are suspended for %s", e.Region, e.RetryAfter.Round(time.Second))
+}
+
+// WithRegion is the option that guards the clients created by the factory by the circuit breaker of the given region
+// in the subscription of the factory. While the circuit breaker is open, only read requests are sent to Azure and all
+// other requests fail with a RegionalOutageError. The circuit breaker is bound once all options are applied, hence
+// the subscription may also be overridden by a later option.
+func WithRegion(region string) AzureFactoryOption {
+	return func(f *azureFactory) {
+		f.clientOpts.PerCallPolicies = append(f.clientOpts.PerCallPolicies, &circuitBreakerPolicy{region: region})
+	}
+}
+
+// RegionalOutage returns a RegionalOutageError if the circuit breaker of the given region in the given subscription is
+// open, otherwise nil.
+func RegionalOutage(subscriptionID, region string) *RegionalOutageError {
+	if retryAfter := circuitBreakerFor(subscriptionID, region).retryAfter(); retryAfter > 0 {
+		return &RegionalOutageError{Region: region, RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// HandleRegionalOutage reports the availability of the Azure region in the given conditions of the given extension
+// object. If the given error was caused by an outage of the region, the object is requeued once the circuit breaker of
+// the region allows mutating requests again.
+func HandleRegionalOutage(ctx context.Context, log logr.Logger, c client.Client, obj client.Object, conditions *[]gardencorev1beta1.Condition, err error) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	if UpdateRegionAvailableCondition(conditions, err) {
+		if patchErr := c.Status().Patch(ctx, obj, patch); patchErr != nil {
+			log.Error(patchErr, "Could not update the condition for the availability of the region", "condition", ConditionTypeRegionAvailable)
+		}
+	}
+
+	var outageErr *RegionalOutageError
+	if errors.As(err, &outageErr) {
+		return &reconcilerutils.RequeueAfterError{Cause: err, RequeueAfter: outageErr.RetryAfter}
+	}
+	return err
+}
+
+// UpdateRegionAvailableCondition updates the condition for the availability of the Azure region in the given conditions
+// and returns whether it changed.
+func UpdateRegionAvailableCondition(conditions *[]gardencorev1beta1.Condition, err error) bool {
+	var (
+		outageErr *RegionalOutageError
+		condition = v1beta1helper.GetCondition(*conditions, ConditionTypeRegionAvailable)
+	)
+
+	switch {
+	case errors.As(err, &outageErr):
+		if condition == nil {
+			condition = ptr.To(v1beta1helper.InitConditionWithClock(clock.RealClock{}, ConditionTypeRegionAvailable))
+		}
+		*condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, *condition, gardencorev1beta1.ConditionFalse, "RegionalOutage",
+			outageErr.Error(), gardencorev1beta1.ErrorRetryableInfraDependencies)
+	// Only a successful reconciliation proves that mutating requests to the region succeed again.
+	case err == nil && condition != nil && condition.Status != gardencorev1beta1.ConditionTrue:
+		*condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, *condition, gardencorev1beta1.ConditionTrue, "RegionAvailable",
+			"The Azure region is available.")
+	default:
+		return false
+	}
+
+	*conditions = v1beta1helper.MergeConditions(*conditions, *condition)
+	return true
+}
+
+// ResetCircuitBreakers closes the circuit breakers of all regions. It is exposed for testing.
+func ResetCircuitBreakers() {
+	circuitBreakersMutex.Lock()
+	defer circuitBreakersMutex.Unlock()
+	circuitBreakers = map[string]*circuitBreaker{}
+}
+
+// bindCircuitBreakers returns the given policies with the circuit breaker policies bound to the circuit breakers of their
+// regions in the given subscription. The given policies are not modified, as they may be shared with other factories.
+func bindCircuitBreakers(policies []policy.Policy, subscriptionID string) []policy.Policy {
+	if len(policies) == 0 {
+		return policies
+	}
+
+	bound := make([]policy.Policy, 0, len(policies))
+	for _, p := range policies {
+		if breakerPolicy, ok := p.(*circuitBreakerPolicy); ok {
+			p = &circuitBreakerPolicy{region: breakerPolicy.region, breaker: circuitBreakerFor(subscriptionID, breakerPolicy.region)}
+		}
+		bound = append(bound, p)
+	}
+	return bound
+}
+
+func circuitBreakerFor(subscriptionID, region string) *circuitBreaker {
+	circuitBreakersMutex.Lock()
+	defer circuitBreakersMutex.Unlock()
+
+	key := subscriptionID + "/" + region
+	breaker, ok := circuitBreakers[key]
+	if !ok {
+		breaker = &circuitBreaker{}
+		circuitBreakers[key] = breaker
+	}
+	return breaker
+}
+
+// circuitBreaker counts the consecutive failed requests to a region. It opens once the failures reach the
+// CircuitBreakerFailureThreshold and closes again after a successful mutating request or once it was open for the
+// CircuitBreakerOpenDuration. Successful read requests do not close it, as reads often keep working during outages
+// which only affect the control plane of a region.
+type circuitBreaker struct {
+	mutex     sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func (c *circuitBreaker) retryAfter() time.Duration {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.closeIfExpired()
+	return time.Until(c.openUntil)
+}
+
+func (c *circuitBreaker) record(failed, mutating bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.closeIfExpired()
+	if !failed {
+		if mutating {
+			c.failures = 0
+		}
+		return
+	}
+
+	c.failures++
+	if c.failures >= CircuitBreakerFailureThreshold {
+		c.openUntil = time.Now().Add(CircuitBreakerOpenDuration)
+	}
+}
+
+// closeIfExpired closes the circuit breaker once it was open for the CircuitBreakerOpenDuration, hence the failures
+// have to reach the threshold again before it reopens. The caller must hold the mutex.
+func (c *circuitBreaker) closeIfExpired() {
+	if !c.openUntil.IsZero() && !time.Now().Before(c.openUntil) {
+		c.failures = 0
+		c.openUntil = time.Time{}
+	}
+}
+
+type circuitBreakerPolicy struct {
+	region  string
+	breaker *circuitBreaker
+}
+
+// Do implements policy.Policy. As a per-call policy it observes the result of a request after all retries.
+func (p *circuitBreakerPolicy) Do(req *policy.Request) (*http.Response, error) {
+	method := req.Raw().Method
+	mutating := method != http.MethodGet && method != http.MethodHead
+	if mutating {
+		if retryAfter := p.breaker.retryAfter(); retryAfter > 0 {
+			return nil, &RegionalOutageError{Region: p.region, RetryAfter: retryAfter}
+		}
+	}
+
+	resp, err := req.Next()
+	if err != nil {
+		// only timeouts count as failures, other errors, e.g. canceled requests, do not tell anything about the region.
+		var netErr net.Error
+		if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+			p.breaker.record(true, mutating)
+		}
+		return resp, err
+	}
+
+	p.breaker.record(resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode >= http.StatusInternalServerError, mutating)
+	return resp, nil
+}
@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+type statusTransport struct {
+	statusCode int
+	requests   int
+}
+
+func (t *statusTransport) Do(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return &http.Response{
+		StatusCode: t.statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"name":"foo","location":"westeurope"}`)),
+		Request:    req,
+	}, nil
+}
+
+var _ = Describe("CircuitBreaker", func() {
+	const (
+		subscriptionID = "subscription"
+		region         = "westeurope"
+	)
+
+	var (
+		ctx       = context.Background()
+		transport *statusTransport
+		groups    ResourceGroup
+
+		newGroups = func(subscriptionID string) ResourceGroup {
+			factory, err := NewAzureClientFactory(&internal.ClientAuth{SubscriptionID: subscriptionID, TenantID: "tenant", ClientID: "client", ClientSecret: "secret"},
+				WithTransport(transport), WithTokenCredential(&azfake.TokenCredential{}), WithRegion(region))
+			Expect(err).NotTo(HaveOccurred())
+			groups, err := factory.Group()
+			Expect(err).NotTo(HaveOccurred())
+			return groups
+		}
+	)
+
+	BeforeEach(func() {
+		ResetCircuitBreakers()
+		DeferCleanup(ResetCircuitBreakers)
+
+		oldThreshold := CircuitBreakerFailureThreshold
+		CircuitBreakerFailureThreshold = 2
+		DeferCleanup(func() { CircuitBreakerFailureThreshold = oldThreshold })
+
+		oldClientOpts := DefaultAzureClientOpts
+		DefaultAzureClientOpts = func() *arm.ClientOptions {
+			opts := oldClientOpts()
+			opts.Retry.MaxRetries = -1
+			return opts
+		}
+		DeferCleanup(func() { DefaultAzureClientOpts = oldClientOpts })
+
+		transport = &statusTransport{statusCode: http.StatusServiceUnavailable}
+		groups = newGroups(subscriptionID)
+	})
+
+	It("should only allow read requests after sustained server errors", func() {
+		_, err := groups.Get(ctx, "foo")
+		Expect(err).To(HaveOccurred())
+		Expect(RegionalOutage(subscriptionID, region)).To(BeNil())
+		_, err = groups.Get(ctx, "foo")
+		Expect(err).To(HaveOccurred())
+		Expect(RegionalOutage(subscriptionID, region)).NotTo(BeNil())
+
+		_, err = groups.CreateOrUpdate(ctx, "foo", armresources.ResourceGroup{Location: ptr.To(region)})
+		var outageErr *RegionalOutageError
+		Expect(err).To(BeAssignableToTypeOf(outageErr))
+		Expect(err.Error()).To(HavePrefix("RegionalOutage: the Azure region westeurope is considered unavailable"))
+		Expect(transport.requests).To(Equal(2))
+
+		_, err = groups.Get(ctx, "foo")
+		Expect(err).To(HaveOccurred())
+		Expect(transport.requests).To(Equal(3))
+	})
+
+	It("should keep the circuit breaker open after successful read requests", func() {
+		for range 2 {
+			_, err := groups.Get(ctx, "foo")
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(RegionalOutage(subscriptionID, region)).NotTo(BeNil())
+
+		transport.statusCode = http.StatusOK
+		_, err := groups.Get(ctx, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(RegionalOutage(subscriptionID, region)).NotTo(BeNil())
+
+		_, err = groups.CreateOrUpdate(ctx, "foo", armresources.ResourceGroup{Location: ptr.To(region)})
+		var outageErr *RegionalOutageError
+		Expect(err).To(BeAssignableToTypeOf(outageErr))
+	})
+
+	It("should close the circuit breaker once the open duration expired", func() {
+		oldOpenDuration := CircuitBreakerOpenDuration
+		CircuitBreakerOpenDuration = 0
+		DeferCleanup(func() { CircuitBreakerOpenDuration = oldOpenDuration })
+
+		for range 2 {
+			_, err := groups.Get(ctx, "foo")
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(RegionalOutage(subscriptionID, region)).To(BeNil())
+
+		transport.statusCode = http.StatusOK
+		_, err := groups.CreateOrUpdate(ctx, "foo", armresources.ResourceGroup{Location: ptr.To(region)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(transport.requests).To(Equal(3))
+	})
+
+	It("should reset the failures after a successful mutating request", func() {
+		_, err := groups.Get(ctx, "foo")
+		Expect(err).To(HaveOccurred())
+
+		transport.statusCode = http.StatusOK
+		_, err = groups.CreateOrUpdate(ctx, "foo", armresources.ResourceGroup{Location: ptr.To(region)})
+		Expect(err).NotTo(HaveOccurred())
+
+		transport.statusCode = http.StatusServiceUnavailable
+		_, err = groups.Get(ctx, "foo")
+		Expect(err).To(HaveOccurred())
+		Expect(RegionalOutage(subscriptionID, region)).To(BeNil())
+	})
+
+	It("should keep the circuit breakers of different subscriptions apart", func() {
+		for range 2 {
+			_, err := groups.Get(ctx, "foo")
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(RegionalOutage(subscriptionID, region)).NotTo(BeNil())
+		Expect(RegionalOutage("other-subscription", region)).To(BeNil())
+
+		transport.statusCode = http.StatusOK
+		_, err := newGroups("other-subscription").CreateOrUpdate(ctx, "foo", armresources.ResourceGroup{Location: ptr.To(region)})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should use the circuit breaker of a subscription set after the region", func() {
+		factory, err := NewAzureClientFactory(&internal.ClientAuth{SubscriptionID: subscriptionID, TenantID: "tenant", ClientID: "client", ClientSecret: "secret"},
+			WithTransport(transport), WithTokenCredential(&azfake.TokenCredential{}), WithRegion(region), WithSubscriptionID("other-subscription"))
+		Expect(err).NotTo(HaveOccurred())
+		groups, err := factory.Group()
+		Expect(err).NotTo(HaveOccurred())
+
+		for range 2 {
+			_, err := groups.Get(ctx, "foo")
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(RegionalOutage("other-subscription", region)).NotTo(BeNil())
+		Expect(RegionalOutage(subscriptionID, region)).To(BeNil())
+	})
+
+	It("should not count client errors as failures", func() {
+		transport.statusCode = http.StatusConflict
+		for range 3 {
+			_, err := groups.CreateOrUpdate(ctx, "foo", armresources.ResourceGroup{Location: ptr.To(region)})
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(RegionalOutage(subscriptionID, region)).To(BeNil())
+		Expect(RegionalOutage(subscriptionID, "northeurope")).To(BeNil())
+	})
+})
+
+var _ = Describe("RegionalOutage", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		c     client.Client
+		infra *extensionsv1alpha1.Infrastructure
+
+		outageErr = &RegionalOutageError{Region: "westeurope", RetryAfter: 3 * time.Minute}
+	)
+
+	BeforeEach(func() {
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "westeurope"},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).WithStatusSubresource(infra).Build()
+	})
+
+	regionAvailableCondition := func() *gardencorev1beta1.Condition {
+		GinkgoHelper()
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+		return v1beta1helper.GetCondition(infra.Status.Conditions, ConditionTypeRegionAvailable)
+	}
+
+	Describe("#HandleRegionalOutage", func() {
+		It("should requeue and report the outage", func() {
+			err := HandleRegionalOutage(ctx, log, c, infra, &infra.Status.Conditions, fmt.Errorf("failed to reconcile: %w", outageErr))
+
+			var requeueErr *reconcilerutils.RequeueAfterError
+			Expect(err).To(BeAssignableToTypeOf(requeueErr))
+			Expect(err.(*reconcilerutils.RequeueAfterError).RequeueAfter).To(Equal(3 * time.Minute))
+
+			condition := regionAvailableCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("RegionalOutage"))
+			Expect(condition.Codes).To(ConsistOf(gardencorev1beta1.ErrorRetryableInfraDependencies))
+		})
+
+		It("should return other errors unchanged and keep the condition", func() {
+			Expect(HandleRegionalOutage(ctx, log, c, infra, &infra.Status.Conditions, outageErr)).To(HaveOccurred())
+
+			otherErr := fmt.Errorf("other error")
+			Expect(HandleRegionalOutage(ctx, log, c, infra, &infra.Status.Conditions, otherErr)).To(BeIdenticalTo(otherErr))
+			Expect(regionAvailableCondition().Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		})
+
+		It("should report the region as available again after a successful reconciliation", func() {
+			Expect(HandleRegionalOutage(ctx, log, c, infra, &infra.Status.Conditions, outageErr)).To(HaveOccurred())
+
+			Expect(HandleRegionalOutage(ctx, log, c, infra, &infra.Status.Conditions, nil)).To(Succeed())
+			condition := regionAvailableCondition()
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("RegionAvailable"))
+		})
+
+		It("should report the outage in the conditions of a Worker", func() {
+			worker := &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "shoot--foo--bar"},
+				Spec:       extensionsv1alpha1.WorkerSpec{Region: "westeurope"},
+			}
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(worker).WithStatusSubresource(worker).Build()
+
+			Expect(HandleRegionalOutage(ctx, log, c, worker, &worker.Status.Conditions, outageErr)).To(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(worker), worker)).To(Succeed())
+			condition := v1beta1helper.GetCondition(worker.Status.Conditions, ConditionTypeRegionAvailable)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		})
+	})
+
+	Describe("#UpdateRegionAvailableCondition", func() {
+		It("should not add the condition without an outage", func() {
+			Expect(UpdateRegionAvailableCondition(&infra.Status.Conditions, nil)).To(BeFalse())
+			Expect(UpdateRegionAvailableCondition(&infra.Status.Conditions, fmt.Errorf("other error"))).To(BeFalse())
+			Expect(infra.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should not change an available region", func() {
+			Expect(UpdateRegionAvailableCondition(&infra.Status.Conditions, outageErr)).To(BeTrue())
+			Expect(UpdateRegionAvailableCondition(&infra.Status.Conditions, nil)).To(BeTrue())
+			Expect(UpdateRegionAvailableCondition(&infra.Status.Conditions, nil)).To(BeFalse())
+			Expect(infra.Status.Conditions).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// cacheIdleTimeout is the duration after which cached token credentials and clients which were not used anymore are
+// removed from the caches.
+const cacheIdleTimeout = time.Hour
+
+var (
+	clientCachesMutex sync.Mutex
+	clientCaches      = map[clientCacheKey]*clientCache{}
+)
+
+// clientCacheKey identifies the factories whose clients are interchangeable, i.e. which authenticate with the same
+// credentials against the same cloud and subscription and are guarded by the same circuit breakers.
+type clientCacheKey struct {
+	subscriptionID string
+	tenantID       string
+	clientID       string
+	secretHash     string
+	cloud          string
+	regions        string
+}
+
+// clientCache holds the clients of all factories with the same clientCacheKey. The clients only wrap the clients of
+// the Azure SDK, which are safe for concurrent use.
+type clientCache struct {
+	mutex    sync.Mutex
+	clients  map[string]any
+	lastUsed time.Time
+}
+
+// ResetClientCaches removes all cached token credentials and clients. It is exposed for testing.
+func ResetClientCaches() {
+	clientCachesMutex.Lock()
+	clientCaches = map[clientCacheKey]*clientCache{}
+	clientCachesMutex.Unlock()
+
+	tokenCredentialsMutex.Lock()
+	tokenCredentials = map[tokenCredentialKey]*cachedTokenCredential{}
+	tokenCredentialsMutex.Unlock()
+}
+
+// clientCacheFor returns the client cache shared by all factories with the same configuration as the given one. It
+// returns nil if the clients of the factory must not be shared, i.e. if the factory uses an explicit transport, token
+// credential or policy which is specific to the caller.
+func clientCacheFor(f *azureFactory) *clientCache {
+	if f.uncached {
+		return nil
+	}
+
+	var regions []string
+	for _, p := range f.clientOpts.PerCallPolicies {
+		breaker, ok := p.(*circuitBreakerPolicy)
+		if !ok {
+			return nil
+		}
+		regions = append(regions, breaker.region)
+	}
+	if len(f.clientOpts.PerRetryPolicies) > 0 {
+		return nil
+	}
+
+	var (
+		key = clientCacheKey{
+			subscriptionID: f.auth.SubscriptionID,
+			tenantID:       f.auth.TenantID,
+			clientID:       f.auth.ClientID,
+			secretHash:     utils.ComputeSHA256Hex([]byte(f.auth.ClientSecret)),
+			cloud:          fmt.Sprintf("%v", f.clientOpts.Cloud),
+			regions:        strings.Join(regions, ","),
+		}
+		now = time.Now()
+	)
+
+	clientCachesMutex.Lock()
+	defer clientCachesMutex.Unlock()
+
+	for k, cache := range clientCaches {
+		if now.Sub(cache.lastUsed) > cacheIdleTimeout {
+			delete(clientCaches, k)
+		}
+	}
+
+	cache, ok := clientCaches[key]
+	if !ok {
+		cache = &clientCache{clients: map[string]any{}}
+		clientCaches[key] = cache
+	}
+	cache.lastUsed = now
+	return cache
+}
+
+// cachedClient returns the client with the given name from the client cache of the factory. The client is created
+// with the given function if it is not cached yet or if the factory has no client cache.
+func cachedClient[T any](f azureFactory, name string, newClient func() (T, error)) (T, error) {
+	if f.clients == nil {
+		return newClient()
+	}
+
+	f.clients.mutex.Lock()
+	defer f.clients.mutex.Unlock()
+
+	if cached, ok := f.clients.clients[name]; ok {
+		return cached.(T), nil
+	}
+
+	client, err := newClient()
+	if err != nil {
+		return client, err
+	}
+	f.clients.clients[name] = client
+	return client, nil
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("ClientCache", func() {
+	var auth *internal.ClientAuth
+
+	BeforeEach(func() {
+		DeferCleanup(ResetClientCaches)
+
+		auth = &internal.ClientAuth{
+			SubscriptionID: "subscription",
+			TenantID:       "tenant",
+			ClientID:       "client",
+			ClientSecret:   "secret",
+		}
+	})
+
+	group := func(auth *internal.ClientAuth, options ...AzureFactoryOption) ResourceGroup {
+		factory, err := NewAzureClientFactory(auth, options...)
+		Expect(err).NotTo(HaveOccurred())
+		client, err := factory.Group()
+		Expect(err).NotTo(HaveOccurred())
+		return client
+	}
+
+	It("should share the clients of factories with the same configuration", func() {
+		client := group(auth, WithRegion("westeurope"))
+		Expect(group(auth, WithRegion("westeurope"))).To(BeIdenticalTo(client))
+	})
+
+	It("should not share the clients if the configuration differs", func() {
+		client := group(auth, WithRegion("westeurope"))
+
+		otherSubscription := *auth
+		otherSubscription.SubscriptionID = "other-subscription"
+		Expect(group(&otherSubscription, WithRegion("westeurope"))).NotTo(BeIdenticalTo(client))
+		Expect(group(auth, WithRegion("northeurope"))).NotTo(BeIdenticalTo(client))
+		Expect(group(auth, WithRegion("westeurope"), WithCloudConfiguration(cloud.AzureChina))).NotTo(BeIdenticalTo(client))
+	})
+
+	It("should not share the clients once the client secret changed", func() {
+		client := group(auth)
+
+		auth.ClientSecret = "rotated-secret"
+		Expect(group(auth)).NotTo(BeIdenticalTo(client))
+	})
+
+	It("should not share the clients of factories with an explicit transport, credential or request tracing", func() {
+		Expect(group(auth, WithTokenCredential(&azfake.TokenCredential{}))).NotTo(BeIdenticalTo(group(auth, WithTokenCredential(&azfake.TokenCredential{}))))
+		Expect(group(auth, WithTransport(&fakeTransport{}))).NotTo(BeIdenticalTo(group(auth, WithTransport(&fakeTransport{}))))
+		Expect(group(auth, WithRequestTracing(logr.Discard()))).NotTo(BeIdenticalTo(group(auth, WithRequestTracing(logr.Discard()))))
+	})
+})
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+)
+
+const diagnosticSettingsAPIVersion = "2021-05-01-preview"
+
+// DiagnosticSettingsResource is an Azure Monitor diagnostic setting.
+type DiagnosticSettingsResource struct {
+	// ID is the resource ID of the diagnostic setting.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the diagnostic setting.
+	Name *string `json:"name,omitempty"`
+	// Properties are the properties of the diagnostic setting.
+	Properties *DiagnosticSettingsProperties `json:"properties,omitempty"`
+}
+
+// DiagnosticSettingsProperties are the properties of an Azure Monitor diagnostic setting.
+type DiagnosticSettingsProperties struct {
+	// WorkspaceID is the resource ID of the Log Analytics workspace to which the logs and metrics are sent.
+	WorkspaceID *string `json:"workspaceId,omitempty"`
+	// Logs are the settings of the collected logs.
+	Logs []*LogSettings `json:"logs,omitempty"`
+	// Metrics are the settings of the collected metrics.
+	Metrics []*MetricSettings `json:"metrics,omitempty"`
+}
+
+// LogSettings are the settings of a log category or category group of a diagnostic setting.
+type LogSettings struct {
+	// Category is the name of the log category.
+	Category *string `json:"category,omitempty"`
+	// CategoryGroup is the name of the log category group.
+	CategoryGroup *string `json:"categoryGroup,omitempty"`
+	// Enabled indicates whether the logs are collected.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// MetricSettings are the settings of a metric category of a diagnostic setting.
+type MetricSettings struct {
+	// Category is the name of the metric category.
+	Category *string `json:"category,omitempty"`
+	// Enabled indicates whether the metrics are collected.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+var _ DiagnosticSettings = &DiagnosticSettingsClient{}
+
+// DiagnosticSettingsClient is a client for Azure Monitor diagnostic settings.
+type DiagnosticSettingsClient struct {
+	client *restClient
+}
+
+// NewDiagnosticSettingsClient creates a new DiagnosticSettingsClient.
+func NewDiagnosticSettingsClient(tc azcore.TokenCredential, opts *arm.ClientOptions) (*DiagnosticSettingsClient, error) {
+	client, err := newRESTClient(diagnosticSettingsAPIVersion, tc, opts)
+	return &DiagnosticSettingsClient{client: client}, err
+}
+
+// Get returns the diagnostic setting with the given name of the resource with the given ID. It returns nil if the
+// diagnostic setting does not exist.
+func (c *DiagnosticSettingsClient) Get(ctx context.Context, resourceID, name string) (*DiagnosticSettingsResource, error) {
+	var result DiagnosticSettingsResource
+	if found, err := c.client.get(ctx, c.endpoint(resourceID, name), &result); err != nil || !found {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateOrUpdate creates or updates the diagnostic setting with the given name of the resource with the given ID.
+func (c *DiagnosticSettingsClient) CreateOrUpdate(ctx context.Context, resourceID, name string, parameters DiagnosticSettingsResource) (*DiagnosticSettingsResource, error) {
+	var result DiagnosticSettingsResource
+	if err := c.client.put(ctx, c.endpoint(resourceID, name), parameters, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Delete deletes the diagnostic setting with the given name of the resource with the given ID if it exists.
+func (c *DiagnosticSettingsClient) Delete(ctx context.Context, resourceID, name string) error {
+	return c.client.delete(ctx, c.endpoint(resourceID, name))
+}
+
+func (c *DiagnosticSettingsClient) endpoint(resourceID, name string) string {
+	return c.client.endpoint(nil, resourceID, "/providers/Microsoft.Insights/diagnosticSettings/", url.PathEscape(name))
+}
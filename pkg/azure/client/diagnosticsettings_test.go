@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("DiagnosticSettingsClient", func() {
+	const (
+		resourceID    = "/subscriptions/subscription/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/nat"
+		settingPath   = resourceID + "/providers/Microsoft.Insights/diagnosticSettings/gardener"
+		workspaceID   = "/subscriptions/subscription/resourceGroups/monitoring/providers/Microsoft.OperationalInsights/workspaces/workspace"
+		settingResult = `{"id": "` + settingPath + `", "name": "gardener", "properties": {"workspaceId": "` + workspaceID + `", "metrics": [{"category": "AllMetrics", "enabled": true}]}}`
+	)
+
+	var (
+		ctx       = context.Background()
+		transport *responderTransport
+		client    *DiagnosticSettingsClient
+	)
+
+	BeforeEach(func() {
+		transport = &responderTransport{responses: map[string]*http.Response{}}
+
+		var err error
+		client, err = NewDiagnosticSettingsClient(&azfake.TokenCredential{}, withTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#Get", func() {
+		It("should return the diagnostic setting", func() {
+			transport.responses["GET "+settingPath] = jsonResponse(http.StatusOK, settingResult)
+
+			setting, err := client.Get(ctx, resourceID, "gardener")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*setting.Properties.WorkspaceID).To(Equal(workspaceID))
+			Expect(*setting.Properties.Metrics[0].Category).To(Equal("AllMetrics"))
+
+			Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2021-05-01-preview"))
+		})
+
+		It("should return nil if the diagnostic setting does not exist", func() {
+			setting, err := client.Get(ctx, resourceID, "gardener")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setting).To(BeNil())
+		})
+	})
+
+	Describe("#CreateOrUpdate", func() {
+		It("should send the diagnostic setting", func() {
+			transport.responses["PUT "+settingPath] = jsonResponse(http.StatusOK, settingResult)
+
+			setting, err := client.CreateOrUpdate(ctx, resourceID, "gardener", DiagnosticSettingsResource{
+				Properties: &DiagnosticSettingsProperties{
+					WorkspaceID: ptr.To(workspaceID),
+					Metrics:     []*MetricSettings{{Category: ptr.To("AllMetrics"), Enabled: ptr.To(true)}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*setting.ID).To(Equal(settingPath))
+
+			body, err := io.ReadAll(transport.requests[0].Body)
+			Expect(err).NotTo(HaveOccurred())
+			var sent DiagnosticSettingsResource
+			Expect(json.Unmarshal(body, &sent)).To(Succeed())
+			Expect(*sent.Properties.WorkspaceID).To(Equal(workspaceID))
+		})
+
+		It("should return errors", func() {
+			transport.responses["PUT "+settingPath] = jsonResponse(http.StatusForbidden, `{"error":{"code":"AuthorizationFailed","message":"forbidden"}}`)
+
+			_, err := client.CreateOrUpdate(ctx, resourceID, "gardener", DiagnosticSettingsResource{})
+			Expect(err).To(MatchError(ContainSubstring("AuthorizationFailed")))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the diagnostic setting", func() {
+			transport.responses["DELETE "+settingPath] = jsonResponse(http.StatusOK, ``)
+
+			Expect(client.Delete(ctx, resourceID, "gardener")).To(Succeed())
+			Expect(transport.requests).To(HaveLen(1))
+		})
+
+		It("should ignore diagnostic settings which do not exist", func() {
+			Expect(client.Delete(ctx, resourceID, "gardener")).To(Succeed())
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
 )
@@ -46,6 +47,39 @@ func (c *DNSRecordSetClient) CreateOrUpdate(ctx context.Context, zoneID string,
 	return err
 }
 
+// CreateOrUpdateAlias creates or updates an alias recordset with the given name and record type in the zone with the given zone ID,
+// which points to the Azure resource with the given ID (e.g. a public IP address or a Traffic Manager profile).
+func (c *DNSRecordSetClient) CreateOrUpdateAlias(ctx context.Context, zoneID string, name string, recordType string, targetResourceID string, ttl int64) error {
+	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
+	relativeRecordSetName, err := getRelativeRecordSetName(name, zoneName)
+	if err != nil {
+		return err
+	}
+	params := armdns.RecordSet{
+		Properties: &armdns.RecordSetProperties{
+			TTL:            to.Int64Ptr(ttl),
+			TargetResource: &armdns.SubResource{ID: to.StringPtr(targetResourceID)},
+		},
+	}
+	_, err = c.client.CreateOrUpdate(ctx, resourceGroupName, zoneName, relativeRecordSetName, armdns.RecordType(recordType), params, nil)
+	return err
+}
+
+// Get returns the recordset with the given name and record type in the zone with the given zone ID. If the recordset
+// does not exist, nil is returned.
+func (c *DNSRecordSetClient) Get(ctx context.Context, zoneID string, name string, recordType string) (*armdns.RecordSet, error) {
+	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
+	relativeRecordSetName, err := getRelativeRecordSetName(name, zoneName)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.client.Get(ctx, resourceGroupName, zoneName, relativeRecordSetName, armdns.RecordType(recordType), nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.RecordSet, nil
+}
+
 // Delete deletes the recordset with the given name and record type in the zone with the given zone ID.
 func (c *DNSRecordSetClient) Delete(ctx context.Context, zoneID string, name string, recordType string) error {
 	resourceGroupName, zoneName := resourceGroupAndZoneNames(zoneID)
@@ -81,6 +115,14 @@ func newRecordSetProperties(recordType armdns.RecordType, values []string, ttl i
 			})
 		}
 		rrp.ARecords = aRecords
+	case armdns.RecordTypeAAAA:
+		var aaaaRecords []*armdns.AaaaRecord
+		for _, value := range values {
+			aaaaRecords = append(aaaaRecords, &armdns.AaaaRecord{
+				IPv6Address: to.StringPtr(value),
+			})
+		}
+		rrp.AaaaRecords = aaaaRecords
 	case armdns.RecordTypeCNAME:
 		rrp.CnameRecord = &armdns.CnameRecord{
 			Cname: to.StringPtr(values[0]),
@@ -97,6 +139,51 @@ func newRecordSetProperties(recordType armdns.RecordType, values []string, ttl i
 	return rrp
 }
 
+// IsRecordSetUpToDate checks whether the given recordset matches the desired record type, values and TTL. If targetResourceID
+// is set, the recordset is expected to be an alias recordset pointing to that resource.
+func IsRecordSetUpToDate(recordSet *armdns.RecordSet, recordType string, values []string, targetResourceID *string, ttl int64) bool {
+	if recordSet == nil || recordSet.Properties == nil {
+		return false
+	}
+	props := recordSet.Properties
+	if to.Int64(props.TTL) != ttl {
+		return false
+	}
+
+	if targetResourceID != nil {
+		return props.TargetResource != nil && strings.EqualFold(to.String(props.TargetResource.ID), *targetResourceID)
+	}
+	if props.TargetResource != nil && props.TargetResource.ID != nil {
+		return false
+	}
+
+	var actual []string
+	switch armdns.RecordType(recordType) {
+	case armdns.RecordTypeA:
+		for _, r := range props.ARecords {
+			actual = append(actual, to.String(r.IPv4Address))
+		}
+	case armdns.RecordTypeAAAA:
+		for _, r := range props.AaaaRecords {
+			actual = append(actual, to.String(r.IPv6Address))
+		}
+	case armdns.RecordTypeCNAME:
+		if props.CnameRecord != nil {
+			actual = append(actual, to.String(props.CnameRecord.Cname))
+		}
+	case armdns.RecordTypeTXT:
+		for _, r := range props.TxtRecords {
+			for _, v := range r.Value {
+				actual = append(actual, to.String(v))
+			}
+		}
+	default:
+		return false
+	}
+
+	return sets.New(actual...).Equal(sets.New(values...)) && len(actual) == len(values)
+}
+
 func ignoreAzureNotFoundError(err error) error {
 	if err == nil {
 		return nil
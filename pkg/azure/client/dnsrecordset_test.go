@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("DNSRecordSet", func() {
+	Describe("#IsRecordSetUpToDate", func() {
+		const publicIPID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/ip"
+
+		aRecordSet := func(ttl int64, addresses ...string) *armdns.RecordSet {
+			recordSet := &armdns.RecordSet{Properties: &armdns.RecordSetProperties{TTL: ptr.To(ttl)}}
+			for _, address := range addresses {
+				recordSet.Properties.ARecords = append(recordSet.Properties.ARecords, &armdns.ARecord{IPv4Address: ptr.To(address)})
+			}
+			return recordSet
+		}
+
+		aliasRecordSet := func(ttl int64, targetResourceID string) *armdns.RecordSet {
+			return &armdns.RecordSet{Properties: &armdns.RecordSetProperties{
+				TTL:            ptr.To(ttl),
+				TargetResource: &armdns.SubResource{ID: ptr.To(targetResourceID)},
+			}}
+		}
+
+		DescribeTable("should compare the recordset with the desired state",
+			func(recordSet *armdns.RecordSet, recordType string, values []string, targetResourceID *string, upToDate bool) {
+				Expect(IsRecordSetUpToDate(recordSet, recordType, values, targetResourceID, 120)).To(Equal(upToDate))
+			},
+			Entry("missing recordset", nil, "A", []string{"1.2.3.4"}, nil, false),
+			Entry("same values in another order", aRecordSet(120, "1.2.3.4", "5.6.7.8"), "A", []string{"5.6.7.8", "1.2.3.4"}, nil, true),
+			Entry("changed values", aRecordSet(120, "1.2.3.4"), "A", []string{"5.6.7.8"}, nil, false),
+			Entry("additional value", aRecordSet(120, "1.2.3.4", "5.6.7.8"), "A", []string{"1.2.3.4"}, nil, false),
+			Entry("changed TTL", aRecordSet(300, "1.2.3.4"), "A", []string{"1.2.3.4"}, nil, false),
+			Entry("CNAME record", &armdns.RecordSet{Properties: &armdns.RecordSetProperties{TTL: ptr.To[int64](120), CnameRecord: &armdns.CnameRecord{Cname: ptr.To("foo.example.com")}}}, "CNAME", []string{"foo.example.com"}, nil, true),
+			Entry("TXT record", &armdns.RecordSet{Properties: &armdns.RecordSetProperties{TTL: ptr.To[int64](120), TxtRecords: []*armdns.TxtRecord{{Value: []*string{ptr.To("foo")}}}}}, "TXT", []string{"foo"}, nil, true),
+			Entry("alias recordset with the same target", aliasRecordSet(120, strings.ToUpper(publicIPID)), "A", nil, ptr.To(publicIPID), true),
+			Entry("alias recordset with another target", aliasRecordSet(120, publicIPID+"-other"), "A", nil, ptr.To(publicIPID), false),
+			Entry("regular recordset instead of an alias recordset", aRecordSet(120, "1.2.3.4"), "A", nil, ptr.To(publicIPID), false),
+			Entry("alias recordset instead of a regular recordset", aliasRecordSet(120, publicIPID), "A", []string{"1.2.3.4"}, nil, false),
+		)
+	})
+})
@@ -54,6 +54,25 @@ func (c *DNSZoneClient) List(ctx context.Context) (map[string]string, error) {
 	return zones, nil
 }
 
+// ListByResourceGroup returns a map of all zone names in the given resource group mapped to their IDs.
+func (c *DNSZoneClient) ListByResourceGroup(ctx context.Context, resourceGroupName string) (map[string]string, error) {
+	zones := make(map[string]string)
+
+	results := c.client.NewListByResourceGroupPager(resourceGroupName, nil)
+	for results.More() {
+		nextResult, err := results.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range nextResult.Value {
+			zoneName := *zone.Name
+			zones[zoneName] = zoneID(resourceGroupName, zoneName)
+		}
+	}
+
+	return zones, nil
+}
+
 func getResourceGroupName(zoneID string) (string, error) {
 	submatches := resourceGroupRegex.FindStringSubmatch(zoneID)
 	if len(submatches) != 2 {
@@ -6,10 +6,12 @@ package client
 
 import (
 	"context"
+	"slices"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	azpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -26,11 +28,57 @@ func WithCloudConfiguration(cloudConfiguration cloud.Configuration) AzureFactory
 	}
 }
 
+// WithSubscriptionID is the option that overrides the subscription of the clients created by the factory, e.g. to access
+// resources in another subscription the credentials are authorized for.
+func WithSubscriptionID(subscriptionID string) AzureFactoryOption {
+	return func(f *azureFactory) {
+		auth := *f.auth
+		auth.SubscriptionID = subscriptionID
+		f.auth = &auth
+	}
+}
+
+// WithTenantID is the option that overrides the tenant of the clients created by the factory, e.g. to access resources
+// in a subscription of another tenant which is delegated to the tenant of the credentials via Azure Lighthouse. The
+// token credential is derived anew for the given tenant unless an explicit one is set.
+func WithTenantID(tenantID string) AzureFactoryOption {
+	return func(f *azureFactory) {
+		auth := *f.auth
+		auth.TenantID = tenantID
+		f.auth = &auth
+	}
+}
+
+// WithTransport is the option that sets the HTTP transport of the clients created by the factory.
+func WithTransport(transport azpolicy.Transporter) AzureFactoryOption {
+	return func(f *azureFactory) {
+		f.clientOpts.Transport = transport
+		f.uncached = true
+	}
+}
+
+// WithTokenCredential is the option that overrides the credential of the clients created by the factory, which is
+// otherwise derived from the client secret.
+func WithTokenCredential(tokenCredential azcore.TokenCredential) AzureFactoryOption {
+	return func(f *azureFactory) {
+		f.tokenCredential = tokenCredential
+		f.explicitTokenCredential = true
+		f.uncached = true
+	}
+}
+
 // AzureFactory is an implementation of Factory to produce clients for various Azure services.
 type azureFactory struct {
 	auth            *internal.ClientAuth
 	tokenCredential azcore.TokenCredential
-	clientOpts      *policy.ClientOptions
+	// explicitTokenCredential indicates that the token credential is set via an option instead of being derived from the
+	// credentials of the factory.
+	explicitTokenCredential bool
+	clientOpts              *policy.ClientOptions
+	// uncached indicates that the clients of the factory must not be shared with other factories, e.g. because they
+	// use an explicit transport or token credential.
+	uncached bool
+	clients  *clientCache
 }
 
 // NewAzureClientFactoryFromSecret builds the factory from the given secret (by ref).
@@ -58,116 +106,275 @@ func NewAzureClientFactoryFromSecret(
 
 // NewAzureClientFactory constructs a new factory using the provided Credentials and applying the provided options.
 func NewAzureClientFactory(authCredentials *internal.ClientAuth, options ...AzureFactoryOption) (Factory, error) {
-	// prepare tokenCredential for more convenient access later on
-	cred, err := authCredentials.GetAzClientCredentials()
-	if err != nil {
-		return nil, err
+	factory := &azureFactory{
+		auth:       authCredentials,
+		clientOpts: DefaultAzureClientOpts(),
 	}
 
-	factory := &azureFactory{
-		auth:            authCredentials,
-		tokenCredential: cred,
-		clientOpts:      DefaultAzureClientOpts(),
+	if err := factory.apply(options); err != nil {
+		return nil, err
 	}
+	return *factory, nil
+}
 
+// apply applies the given options to the factory and derives the token credential from the credentials of the factory
+// unless an explicit one is set. The clients of the factory are shared with the other factories of the process with
+// the same configuration, so that they are not constructed for every reconciliation.
+func (f *azureFactory) apply(options []AzureFactoryOption) error {
 	for _, option := range options {
-		option(factory)
+		option(f)
 	}
+	f.clientOpts.PerCallPolicies = bindCircuitBreakers(f.clientOpts.PerCallPolicies, f.auth.SubscriptionID)
 
-	return *factory, nil
+	if !f.explicitTokenCredential {
+		// the token credential is shared with the other factories for the same credentials to reuse its access tokens
+		cred, err := CachedTokenCredential(f.auth, f.clientOpts.Cloud)
+		if err != nil {
+			return err
+		}
+		f.tokenCredential = cred
+	}
+	f.clients = clientCacheFor(f)
+	return nil
 }
 
 // StorageAccount returns an Azure storage account client.
 func (f azureFactory) StorageAccount() (StorageAccount, error) {
-	return NewStorageAccountClient(f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "StorageAccount", func() (StorageAccount, error) {
+		return NewStorageAccountClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// BlobInventoryPolicies returns an Azure storage blob inventory policies client.
+func (f azureFactory) BlobInventoryPolicies() (BlobInventoryPolicies, error) {
+	return cachedClient(f, "BlobInventoryPolicies", func() (BlobInventoryPolicies, error) {
+		return NewBlobInventoryPoliciesClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// ManagementPolicies returns an Azure storage lifecycle management policies client.
+func (f azureFactory) ManagementPolicies() (ManagementPolicies, error) {
+	return cachedClient(f, "ManagementPolicies", func() (ManagementPolicies, error) {
+		return NewManagementPoliciesClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// BlobContainers returns an Azure storage blob containers client.
+func (f azureFactory) BlobContainers() (BlobContainers, error) {
+	return cachedClient(f, "BlobContainers", func() (BlobContainers, error) {
+		return NewBlobContainersClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // DNSZone returns an Azure DNS zone client.
 func (f azureFactory) DNSZone() (DNSZone, error) {
-	return NewDnsZoneClient(f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "DNSZone", func() (DNSZone, error) {
+		return NewDnsZoneClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // DNSRecordSet returns an Azure DNS record set client.
 func (f azureFactory) DNSRecordSet() (DNSRecordSet, error) {
-	return NewDnsRecordSetClient(f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "DNSRecordSet", func() (DNSRecordSet, error) {
+		return NewDnsRecordSetClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// PrivateDNSRecordSet returns an Azure private DNS record set client.
+func (f azureFactory) PrivateDNSRecordSet() (PrivateDNSRecordSet, error) {
+	return cachedClient(f, "PrivateDNSRecordSet", func() (PrivateDNSRecordSet, error) {
+		return NewPrivateDNSRecordSetClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // Group returns an Azure resource group client.
 func (f azureFactory) Group() (ResourceGroup, error) {
-	return NewResourceGroupsClient(f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "Group", func() (ResourceGroup, error) {
+		return NewResourceGroupsClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // Resource returns an Azure resource client.
 func (f azureFactory) Resource() (Resource, error) {
-	return NewResourceClient(f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "Resource", func() (Resource, error) {
+		return NewResourceClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // Vmss returns an Azure virtual machine scale set client.
 func (f azureFactory) Vmss() (Vmss, error) {
-	return NewVmssClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "Vmss", func() (Vmss, error) {
+		return NewVmssClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // VirtualMachine returns an Azure virtual machine client.
 func (f azureFactory) VirtualMachine() (VirtualMachine, error) {
-	return NewVMClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "VirtualMachine", func() (VirtualMachine, error) {
+		return NewVMClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // NetworkSecurityGroup returns an Azure network security group client.
 func (f azureFactory) NetworkSecurityGroup() (NetworkSecurityGroup, error) {
-	return NewSecurityGroupClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "NetworkSecurityGroup", func() (NetworkSecurityGroup, error) {
+		return NewSecurityGroupClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // PublicIP returns an Azure network PublicIPClient.
 func (f azureFactory) PublicIP() (PublicIP, error) {
-	return NewPublicIPClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "PublicIP", func() (PublicIP, error) {
+		return NewPublicIPClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // NetworkInterface returns an Azure network interface client.
 func (f azureFactory) NetworkInterface() (NetworkInterface, error) {
-	return NewNetworkInterfaceClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "NetworkInterface", func() (NetworkInterface, error) {
+		return NewNetworkInterfaceClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // Disk returns an Azure disk client.
 func (f azureFactory) Disk() (Disk, error) {
-	return NewDisksClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "Disk", func() (Disk, error) {
+		return NewDisksClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // Vnet returns an Azure Vnet client.
 func (f azureFactory) Vnet() (VirtualNetwork, error) {
-	return NewVnetClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "Vnet", func() (VirtualNetwork, error) {
+		return NewVnetClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // Subnet returns an Azure Subnet client.
 func (f azureFactory) Subnet() (Subnet, error) {
-	return NewSubnetsClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "Subnet", func() (Subnet, error) {
+		return NewSubnetsClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // LoadBalancer returns an Azure LoadBalancer client.
 func (f azureFactory) LoadBalancer() (LoadBalancer, error) {
-	return NewLoadBalancersClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "LoadBalancer", func() (LoadBalancer, error) {
+		return NewLoadBalancersClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // RouteTables returns an Azure RouteTables client.
 func (f azureFactory) RouteTables() (RouteTables, error) {
-	return NewRouteTablesClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "RouteTables", func() (RouteTables, error) {
+		return NewRouteTablesClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // NatGateway returns a NatGateway client.
 func (f azureFactory) NatGateway() (NatGateway, error) {
-	return NewNatGatewaysClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "NatGateway", func() (NatGateway, error) {
+		return NewNatGatewaysClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // AvailabilitySet returns an AvailabilitySet client.
 func (f azureFactory) AvailabilitySet() (AvailabilitySet, error) {
-	return NewAvailabilitySetClient(*f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "AvailabilitySet", func() (AvailabilitySet, error) {
+		return NewAvailabilitySetClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
-// ManagedUserIdentity returns a ManagedUserIdentity client.
-func (f azureFactory) ManagedUserIdentity() (ManagedUserIdentity, error) {
-	return NewManagedUserIdentityClient(f.auth, f.tokenCredential, f.clientOpts)
+// ManagedUserIdentity returns a ManagedUserIdentity client. The given options are applied on top of the ones of the
+// factory, e.g. to access identities in another subscription or tenant.
+func (f azureFactory) ManagedUserIdentity(options ...AzureFactoryOption) (ManagedUserIdentity, error) {
+	if len(options) > 0 {
+		clientOpts := *f.clientOpts
+		clientOpts.PerCallPolicies = slices.Clone(clientOpts.PerCallPolicies)
+		clientOpts.PerRetryPolicies = slices.Clone(clientOpts.PerRetryPolicies)
+		f.clientOpts = &clientOpts
+		if err := f.apply(options); err != nil {
+			return nil, err
+		}
+	}
+	return cachedClient(f, "ManagedUserIdentity", func() (ManagedUserIdentity, error) {
+		return NewManagedUserIdentityClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
 
 // VirtualMachineImages returns a VirtualMachineImages client.
 func (f azureFactory) VirtualMachineImages() (VirtualMachineImages, error) {
-	return NewVirtualMachineImagesClient(f.auth, f.tokenCredential, f.clientOpts)
+	return cachedClient(f, "VirtualMachineImages", func() (VirtualMachineImages, error) {
+		return NewVirtualMachineImagesClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// GalleryImageVersions returns a GalleryImageVersions client.
+func (f azureFactory) GalleryImageVersions() (GalleryImageVersions, error) {
+	return cachedClient(f, "GalleryImageVersions", func() (GalleryImageVersions, error) {
+		return NewGalleryImageVersionsClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// ManagementLocks returns a ManagementLocks client.
+func (f azureFactory) ManagementLocks() (ManagementLocks, error) {
+	return cachedClient(f, "ManagementLocks", func() (ManagementLocks, error) {
+		return NewManagementLocksClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// NetworkWatcher returns a NetworkWatcher client.
+func (f azureFactory) NetworkWatcher() (NetworkWatcher, error) {
+	return cachedClient(f, "NetworkWatcher", func() (NetworkWatcher, error) {
+		return NewNetworkWatcherClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// DiagnosticSettings returns a DiagnosticSettings client.
+func (f azureFactory) DiagnosticSettings() (DiagnosticSettings, error) {
+	return cachedClient(f, "DiagnosticSettings", func() (DiagnosticSettings, error) {
+		return NewDiagnosticSettingsClient(f.tokenCredential, f.clientOpts)
+	})
+}
+
+// AzureFirewall returns an AzureFirewall client.
+func (f azureFactory) AzureFirewall() (AzureFirewall, error) {
+	return cachedClient(f, "AzureFirewall", func() (AzureFirewall, error) {
+		return NewAzureFirewallClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// FirewallPolicy returns a FirewallPolicy client.
+func (f azureFactory) FirewallPolicy() (FirewallPolicy, error) {
+	return cachedClient(f, "FirewallPolicy", func() (FirewallPolicy, error) {
+		return NewFirewallPolicyClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// FirewallPolicyRuleCollectionGroup returns a FirewallPolicyRuleCollectionGroup client.
+func (f azureFactory) FirewallPolicyRuleCollectionGroup() (FirewallPolicyRuleCollectionGroup, error) {
+	return cachedClient(f, "FirewallPolicyRuleCollectionGroup", func() (FirewallPolicyRuleCollectionGroup, error) {
+		return NewFirewallPolicyRuleCollectionGroupClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// MaintenanceAssignments returns a MaintenanceAssignments client.
+func (f azureFactory) MaintenanceAssignments() (MaintenanceAssignments, error) {
+	return cachedClient(f, "MaintenanceAssignments", func() (MaintenanceAssignments, error) {
+		return NewMaintenanceAssignmentsClient(f.tokenCredential, f.clientOpts)
+	})
+}
+
+// Locations returns a Locations client.
+func (f azureFactory) Locations() (Locations, error) {
+	return cachedClient(f, "Locations", func() (Locations, error) {
+		return NewLocationsClient(*f.auth, f.tokenCredential, f.clientOpts)
+	})
+}
+
+// ActivityLogs returns an ActivityLogs client.
+func (f azureFactory) ActivityLogs() (ActivityLogs, error) {
+	return cachedClient(f, "ActivityLogs", func() (ActivityLogs, error) {
+		return NewActivityLogsClient(f.auth, f.tokenCredential, f.clientOpts)
+	})
 }
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+type fakeTransport struct {
+	requests []*http.Request
+}
+
+func (t *fakeTransport) Do(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"name":"foo","location":"westeurope"}`)),
+		Request:    req,
+	}, nil
+}
+
+var _ = Describe("Factory", func() {
+	var (
+		ctx       = context.Background()
+		auth      *internal.ClientAuth
+		transport *fakeTransport
+	)
+
+	BeforeEach(func() {
+		auth = &internal.ClientAuth{
+			SubscriptionID: "subscription",
+			TenantID:       "tenant",
+			ClientID:       "client",
+			ClientSecret:   "secret",
+		}
+		transport = &fakeTransport{}
+	})
+
+	It("should send the requests with the given transport and credential", func() {
+		factory, err := NewAzureClientFactory(auth, WithTransport(transport), WithTokenCredential(&azfake.TokenCredential{}))
+		Expect(err).NotTo(HaveOccurred())
+
+		groupClient, err := factory.Group()
+		Expect(err).NotTo(HaveOccurred())
+		group, err := groupClient.Get(ctx, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(group.Name).To(Equal(ptr.To("foo")))
+
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].URL.Path).To(Equal("/subscriptions/subscription/resourcegroups/foo"))
+	})
+
+	It("should create the managed user identity client for the subscription and tenant of the identity", func() {
+		factory, err := NewAzureClientFactory(auth, WithTransport(transport), WithTokenCredential(&azfake.TokenCredential{}))
+		Expect(err).NotTo(HaveOccurred())
+
+		// the credential for the other tenant is replaced as it would request a token from the tenant.
+		options := ManagedUserIdentityOptions(&azure.IdentityConfig{
+			SubscriptionID: ptr.To("other-subscription"),
+			TenantID:       ptr.To("other-tenant"),
+		})
+		Expect(options).To(HaveLen(2))
+		identityClient, err := factory.ManagedUserIdentity(append(options, WithTokenCredential(&azfake.TokenCredential{}))...)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = identityClient.Get(ctx, "foo", "bar")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].URL.Path).To(Equal("/subscriptions/other-subscription/resourceGroups/foo/providers/Microsoft.ManagedIdentity/userAssignedIdentities/bar"))
+	})
+
+	It("should keep an explicit credential when the tenant is overridden", func() {
+		factory, err := NewAzureClientFactory(auth, WithTransport(transport), WithTokenCredential(&azfake.TokenCredential{}))
+		Expect(err).NotTo(HaveOccurred())
+
+		identityClient, err := factory.ManagedUserIdentity(WithTenantID("other-tenant"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = identityClient.Get(ctx, "foo", "bar")
+		Expect(err).NotTo(HaveOccurred())
+
+		// a credential derived for the other tenant would request a token from the tenant via the transport.
+		Expect(transport.requests).To(HaveLen(1))
+		Expect(transport.requests[0].URL.Path).To(Equal("/subscriptions/subscription/resourceGroups/foo/providers/Microsoft.ManagedIdentity/userAssignedIdentities/bar"))
+	})
+})
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var (
+	_ FirewallPolicy                    = &FirewallPolicyClient{}
+	_ FirewallPolicyRuleCollectionGroup = &FirewallPolicyRuleCollectionGroupClient{}
+)
+
+// FirewallPolicyClient is an implementation of FirewallPolicy for an Azure Firewall Policy k8sClient.
+type FirewallPolicyClient struct {
+	client *armnetwork.FirewallPoliciesClient
+}
+
+// NewFirewallPolicyClient creates a new FirewallPolicy client.
+func NewFirewallPolicyClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*FirewallPolicyClient, error) {
+	client, err := armnetwork.NewFirewallPoliciesClient(auth.SubscriptionID, tc, opts)
+	return &FirewallPolicyClient{client}, err
+}
+
+// CreateOrUpdate creates or updates a firewall policy.
+func (c *FirewallPolicyClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name string, parameters armnetwork.FirewallPolicy) (*armnetwork.FirewallPolicy, error) {
+	poller, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, name, parameters, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create firewall policy: %w", err)
+	}
+	res, err := poller.PollUntilDone(ctx, nil)
+	return &res.FirewallPolicy, err
+}
+
+// Delete deletes the firewall policy with the given name.
+func (c *FirewallPolicyClient) Delete(ctx context.Context, resourceGroupName, name string) error {
+	poller, err := c.client.BeginDelete(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
+// Get returns a firewall policy by name or nil if it does not exist.
+func (c *FirewallPolicyClient) Get(ctx context.Context, resourceGroupName, name string) (*armnetwork.FirewallPolicy, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.FirewallPolicy, nil
+}
+
+// FirewallPolicyRuleCollectionGroupClient is an implementation of FirewallPolicyRuleCollectionGroup for an Azure
+// Firewall Policy rule collection group k8sClient.
+type FirewallPolicyRuleCollectionGroupClient struct {
+	client *armnetwork.FirewallPolicyRuleCollectionGroupsClient
+}
+
+// NewFirewallPolicyRuleCollectionGroupClient creates a new FirewallPolicyRuleCollectionGroup client.
+func NewFirewallPolicyRuleCollectionGroupClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*FirewallPolicyRuleCollectionGroupClient, error) {
+	client, err := armnetwork.NewFirewallPolicyRuleCollectionGroupsClient(auth.SubscriptionID, tc, opts)
+	return &FirewallPolicyRuleCollectionGroupClient{client}, err
+}
+
+// CreateOrUpdate creates or updates a rule collection group of a firewall policy.
+func (c *FirewallPolicyRuleCollectionGroupClient) CreateOrUpdate(ctx context.Context, resourceGroupName, policyName, name string, parameters armnetwork.FirewallPolicyRuleCollectionGroup) (*armnetwork.FirewallPolicyRuleCollectionGroup, error) {
+	poller, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, policyName, name, parameters, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create firewall policy rule collection group: %w", err)
+	}
+	res, err := poller.PollUntilDone(ctx, nil)
+	return &res.FirewallPolicyRuleCollectionGroup, err
+}
+
+// Get returns a rule collection group of a firewall policy by name or nil if it does not exist.
+func (c *FirewallPolicyRuleCollectionGroupClient) Get(ctx context.Context, resourceGroupName, policyName, name string) (*armnetwork.FirewallPolicyRuleCollectionGroup, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, policyName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.FirewallPolicyRuleCollectionGroup, nil
+}
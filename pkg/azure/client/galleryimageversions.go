@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ GalleryImageVersions = &GalleryImageVersionsClient{}
+
+// GalleryImageVersionsClient is an implementation of GalleryImageVersions for the image versions of community and
+// shared Azure Compute Galleries.
+type GalleryImageVersionsClient struct {
+	communityClient *armcompute.CommunityGalleryImageVersionsClient
+	sharedClient    *armcompute.SharedGalleryImageVersionsClient
+}
+
+// NewGalleryImageVersionsClient creates a new GalleryImageVersions client.
+func NewGalleryImageVersionsClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*GalleryImageVersionsClient, error) {
+	communityClient, err := armcompute.NewCommunityGalleryImageVersionsClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	sharedClient, err := armcompute.NewSharedGalleryImageVersionsClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &GalleryImageVersionsClient{communityClient, sharedClient}, nil
+}
+
+// ListCommunityGalleryImageVersions returns all versions of the given community gallery image in the given location.
+func (c *GalleryImageVersionsClient) ListCommunityGalleryImageVersions(ctx context.Context, location, publicGalleryName, galleryImageName string) ([]*armcompute.CommunityGalleryImageVersion, error) {
+	pager := c.communityClient.NewListPager(location, publicGalleryName, galleryImageName, nil)
+	var versions []*armcompute.CommunityGalleryImageVersion
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, res.Value...)
+	}
+	return versions, nil
+}
+
+// ListSharedGalleryImageVersions returns all versions of the given shared gallery image in the given location.
+func (c *GalleryImageVersionsClient) ListSharedGalleryImageVersions(ctx context.Context, location, galleryUniqueName, galleryImageName string) ([]*armcompute.SharedGalleryImageVersion, error) {
+	pager := c.sharedClient.NewListPager(location, galleryUniqueName, galleryImageName, nil)
+	var versions []*armcompute.SharedGalleryImageVersion
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, res.Value...)
+	}
+	return versions, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	azerrors "github.com/AzureAD/microsoft-authentication-library-for-go/apps/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
@@ -60,6 +61,11 @@ func IsAzureAPINotFoundError(err error) bool {
 	return isAzureAPIStatusError(err, http.StatusNotFound)
 }
 
+// IsAzureAPIForbidden tries to determine if the API error is due to missing permissions.
+func IsAzureAPIForbidden(err error) bool {
+	return isAzureAPIStatusError(err, http.StatusForbidden)
+}
+
 // IsAzureAPIUnauthorized tries to determine if the API error is due to unauthorized access
 func IsAzureAPIUnauthorized(err error) bool {
 	if isAzureAPIStatusError(err, http.StatusUnauthorized) {
@@ -81,6 +87,21 @@ func CloudConfiguration(cloudConfiguration *azure.CloudConfiguration, region *st
 	return nil, fmt.Errorf("either CloudConfiguration or region must not be nil to determine Azure Cloud configuration")
 }
 
+// CloudInstanceName returns the name of the cloud instance for the given CloudConfiguration as it is used in the
+// configuration files of the cloud-provider-azure components.
+func CloudInstanceName(cloudConfiguration azure.CloudConfiguration) string {
+	switch {
+	case strings.EqualFold(cloudConfiguration.Name, azure.AzureChinaCloudName):
+		return "AZURECHINACLOUD"
+	case strings.EqualFold(cloudConfiguration.Name, azure.AzureGovCloudName):
+		return "AZUREUSGOVERNMENT"
+	case strings.EqualFold(cloudConfiguration.Name, azure.AzureStackCloudName):
+		return "AZURESTACKCLOUD"
+	default:
+		return "AZUREPUBLICCLOUD"
+	}
+}
+
 // AzureCloudConfiguration is a convenience function to get the corresponding Azure Cloud configuration (from the Azure SDK) to the given input,
 // preferring the cloudConfiguration if both values are not nil.
 func AzureCloudConfiguration(cloudConfiguration *azure.CloudConfiguration, region *string) (cloud.Configuration, error) {
@@ -127,12 +148,31 @@ func AzureCloudConfigurationFromCloudConfiguration(cloudConfiguration *azure.Clo
 		return cloud.AzureGovernment, nil
 	case strings.EqualFold(cloudConfigurationName, azure.AzureChinaCloudName):
 		return cloud.AzureChina, nil
+	case strings.EqualFold(cloudConfigurationName, azure.AzureStackCloudName):
+		return azureStackCloudConfiguration(cloudConfiguration)
 
 	default:
 		return cloud.Configuration{}, fmt.Errorf("unknown cloud configuration name '%s'", cloudConfigurationName)
 	}
 }
 
+// azureStackCloudConfiguration returns the cloud.Configuration for a private Azure Stack Hub instance from the endpoints of the given cloud configuration.
+func azureStackCloudConfiguration(cloudConfiguration *azure.CloudConfiguration) (cloud.Configuration, error) {
+	if cloudConfiguration.ActiveDirectoryAuthorityHost == nil || cloudConfiguration.ResourceManagerEndpoint == nil {
+		return cloud.Configuration{}, fmt.Errorf("cloud configuration '%s' requires the active directory authority host and the resource manager endpoint", cloudConfiguration.Name)
+	}
+
+	return cloud.Configuration{
+		ActiveDirectoryAuthorityHost: *cloudConfiguration.ActiveDirectoryAuthorityHost,
+		Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+			cloud.ResourceManager: {
+				Audience: ptr.Deref(cloudConfiguration.ResourceManagerAudience, *cloudConfiguration.ResourceManagerEndpoint),
+				Endpoint: *cloudConfiguration.ResourceManagerEndpoint,
+			},
+		},
+	}, nil
+}
+
 func hasAnyPrefix(s string, prefixes ...string) bool {
 	lString := strings.ToLower(s)
 	for _, p := range prefixes {
@@ -9,11 +9,14 @@ import (
 	"net/http"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/go-autorest/autorest"
 	azerrors "github.com/AzureAD/microsoft-authentication-library-for-go/apps/errors"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
@@ -71,4 +74,48 @@ var _ = Describe("Helper", func() {
 		Entry("should return false as error if it is an NotFound call error", 2, http.StatusNotFound, false),
 		Entry("should return false as error if it is an unknown error", -1, http.StatusUnauthorized, false),
 	)
+
+	DescribeTable("#IsAzureAPIForbidden",
+		func(statusCode int, expectIsForbiddenError bool) {
+			Expect(IsAzureAPIForbidden(&azcore.ResponseError{StatusCode: statusCode})).To(Equal(expectIsForbiddenError))
+		},
+		Entry("should return true as error if it is a Forbidden response error", http.StatusForbidden, true),
+		Entry("should return false as error if it is an Unauthorized response error", http.StatusUnauthorized, false),
+	)
+
+	Describe("#AzureCloudConfigurationFromCloudConfiguration", func() {
+		It("should return the configuration of a well-known cloud", func() {
+			Expect(AzureCloudConfigurationFromCloudConfiguration(&azure.CloudConfiguration{Name: "AzureChina"})).To(Equal(cloud.AzureChina))
+		})
+
+		It("should return the configuration of an Azure Stack Hub from its endpoints", func() {
+			Expect(AzureCloudConfigurationFromCloudConfiguration(&azure.CloudConfiguration{
+				Name:                         azure.AzureStackCloudName,
+				ActiveDirectoryAuthorityHost: ptr.To("https://adfs.local.azurestack.external/"),
+				ResourceManagerEndpoint:      ptr.To("https://management.local.azurestack.external/"),
+			})).To(Equal(cloud.Configuration{
+				ActiveDirectoryAuthorityHost: "https://adfs.local.azurestack.external/",
+				Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+					cloud.ResourceManager: {
+						Audience: "https://management.local.azurestack.external/",
+						Endpoint: "https://management.local.azurestack.external/",
+					},
+				},
+			}))
+		})
+
+		It("should fail for an Azure Stack Hub without endpoints", func() {
+			_, err := AzureCloudConfigurationFromCloudConfiguration(&azure.CloudConfiguration{Name: azure.AzureStackCloudName})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("#BlobStorageDomainFromCloudConfiguration",
+		func(cloudConfiguration *azure.CloudConfiguration, expectedDomain string) {
+			Expect(BlobStorageDomainFromCloudConfiguration(cloudConfiguration)).To(Equal(expectedDomain))
+		},
+		Entry("should default to the public cloud", nil, "blob.core.windows.net"),
+		Entry("should return the domain of a well-known cloud", &azure.CloudConfiguration{Name: "AzureGovernment"}, "blob.core.usgovcloudapi.net"),
+		Entry("should return the domain of an Azure Stack Hub", &azure.CloudConfiguration{Name: azure.AzureStackCloudName, StorageEndpointSuffix: ptr.To("local.azurestack.external")}, "blob.local.azurestack.external"),
+	)
 })
@@ -16,13 +16,36 @@ import (
 
 // LoadBalancersClient implements the interface for the LoadBalancers client.
 type LoadBalancersClient struct {
-	client *armnetwork.LoadBalancersClient
+	client                *armnetwork.LoadBalancersClient
+	frontendConfigsClient *armnetwork.LoadBalancerFrontendIPConfigurationsClient
+	rulesClient           *armnetwork.LoadBalancerLoadBalancingRulesClient
 }
 
 // NewLoadBalancersClient creates a new client for the LoadBalancers API.
 func NewLoadBalancersClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*LoadBalancersClient, error) {
 	client, err := armnetwork.NewLoadBalancersClient(auth.SubscriptionID, tc, opts)
-	return &LoadBalancersClient{client}, err
+	if err != nil {
+		return nil, err
+	}
+	frontendConfigsClient, err := armnetwork.NewLoadBalancerFrontendIPConfigurationsClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	rulesClient, err := armnetwork.NewLoadBalancerLoadBalancingRulesClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &LoadBalancersClient{client, frontendConfigsClient, rulesClient}, nil
+}
+
+// CreateOrUpdate creates or updates a load balancer.
+func (c *LoadBalancersClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name string, parameters armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+	poller, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, name, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := poller.PollUntilDone(ctx, nil)
+	return &resp.LoadBalancer, err
 }
 
 // Get gets a given virtual load balancer by name
@@ -48,6 +71,35 @@ func (c *LoadBalancersClient) List(ctx context.Context, resourceGroupName string
 	return loadBalancers, nil
 }
 
+// GetFrontendConfigs returns the frontend IP configurations of a load balancer. It returns nil if the load balancer does
+// not exist.
+func (c *LoadBalancersClient) GetFrontendConfigs(ctx context.Context, resourceGroupName, loadBalancerName string) ([]*armnetwork.FrontendIPConfiguration, error) {
+	pager := c.frontendConfigsClient.NewListPager(resourceGroupName, loadBalancerName, nil)
+	var frontendConfigs []*armnetwork.FrontendIPConfiguration
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, FilterNotFoundError(err)
+		}
+		frontendConfigs = append(frontendConfigs, page.Value...)
+	}
+	return frontendConfigs, nil
+}
+
+// ListRules returns the load balancing rules of a load balancer. It returns nil if the load balancer does not exist.
+func (c *LoadBalancersClient) ListRules(ctx context.Context, resourceGroupName, loadBalancerName string) ([]*armnetwork.LoadBalancingRule, error) {
+	pager := c.rulesClient.NewListPager(resourceGroupName, loadBalancerName, nil)
+	var rules []*armnetwork.LoadBalancingRule
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, FilterNotFoundError(err)
+		}
+		rules = append(rules, page.Value...)
+	}
+	return rules, nil
+}
+
 // Delete deletes a subnet in a given virtual network.
 func (c *LoadBalancersClient) Delete(ctx context.Context, resourceGroupName, loadBalancerName string) error {
 	poller, err := c.client.BeginDelete(ctx, resourceGroupName, loadBalancerName, nil)
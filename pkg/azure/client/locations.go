@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armsubscriptions"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ Locations = &LocationsClient{}
+
+// LocationsClient is a client for the locations of a subscription.
+type LocationsClient struct {
+	client         *armsubscriptions.Client
+	subscriptionID string
+}
+
+// NewLocationsClient creates a new LocationsClient.
+func NewLocationsClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*LocationsClient, error) {
+	client, err := armsubscriptions.NewClient(tc, opts)
+	return &LocationsClient{client: client, subscriptionID: auth.SubscriptionID}, err
+}
+
+// AvailabilityZoneMappings returns the mapping of the logical availability zones of the subscription to the physical
+// availability zones in the given location. It returns nil if the location does not exist or has no availability zones.
+func (c *LocationsClient) AvailabilityZoneMappings(ctx context.Context, locationName string) ([]*armsubscriptions.AvailabilityZoneMappings, error) {
+	pager := c.client.NewListLocationsPager(c.subscriptionID, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, location := range page.Value {
+			if location != nil && strings.EqualFold(ptr.Deref(location.Name, ""), locationName) {
+				return location.AvailabilityZoneMappings, nil
+			}
+		}
+	}
+	return nil, nil
+}
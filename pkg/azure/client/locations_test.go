@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armsubscriptions"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("LocationsClient", func() {
+	const locationsPath = "/subscriptions/subscription/locations"
+
+	var (
+		ctx       = context.Background()
+		transport *responderTransport
+		client    *LocationsClient
+	)
+
+	BeforeEach(func() {
+		transport = &responderTransport{responses: map[string]*http.Response{}}
+
+		var err error
+		client, err = NewLocationsClient(internal.ClientAuth{SubscriptionID: "subscription"}, &azfake.TokenCredential{}, withTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#AvailabilityZoneMappings", func() {
+		It("should return the availability zone mappings of the location", func() {
+			transport.responses["GET "+locationsPath] = jsonResponse(http.StatusOK, `{"value": [`+
+				`{"name": "northeurope", "availabilityZoneMappings": [{"logicalZone": "1", "physicalZone": "northeurope-az3"}]},`+
+				`{"name": "westeurope", "availabilityZoneMappings": [{"logicalZone": "1", "physicalZone": "westeurope-az2"}, {"logicalZone": "2", "physicalZone": "westeurope-az1"}]}]}`)
+
+			mappings, err := client.AvailabilityZoneMappings(ctx, "WestEurope")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mappings).To(Equal([]*armsubscriptions.AvailabilityZoneMappings{
+				{LogicalZone: ptr.To("1"), PhysicalZone: ptr.To("westeurope-az2")},
+				{LogicalZone: ptr.To("2"), PhysicalZone: ptr.To("westeurope-az1")},
+			}))
+
+			Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2022-12-01"))
+		})
+
+		It("should return nil if the location does not exist", func() {
+			transport.responses["GET "+locationsPath] = jsonResponse(http.StatusOK, `{"value": [{"name": "northeurope"}]}`)
+
+			mappings, err := client.AvailabilityZoneMappings(ctx, "westeurope")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mappings).To(BeNil())
+		})
+
+		It("should return the error of the request", func() {
+			_, err := client.AvailabilityZoneMappings(ctx, "westeurope")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
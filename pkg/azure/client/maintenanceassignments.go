@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+)
+
+const maintenanceAssignmentsAPIVersion = "2023-04-01"
+
+// MaintenanceAssignment is the assignment of a maintenance configuration to a resource.
+type MaintenanceAssignment struct {
+	// ID is the resource ID of the configuration assignment.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the configuration assignment.
+	Name *string `json:"name,omitempty"`
+	// Location is the location of the resource to which the maintenance configuration is assigned.
+	Location *string `json:"location,omitempty"`
+	// Properties are the properties of the configuration assignment.
+	Properties *MaintenanceAssignmentProperties `json:"properties,omitempty"`
+}
+
+// MaintenanceAssignmentProperties are the properties of the assignment of a maintenance configuration.
+type MaintenanceAssignmentProperties struct {
+	// MaintenanceConfigurationID is the resource ID of the assigned maintenance configuration.
+	MaintenanceConfigurationID *string `json:"maintenanceConfigurationId,omitempty"`
+	// ResourceID is the resource ID of the resource to which the maintenance configuration is assigned.
+	ResourceID *string `json:"resourceId,omitempty"`
+}
+
+var _ MaintenanceAssignments = &MaintenanceAssignmentsClient{}
+
+// MaintenanceAssignmentsClient is a client for the assignments of maintenance configurations to resources.
+type MaintenanceAssignmentsClient struct {
+	client *restClient
+}
+
+// NewMaintenanceAssignmentsClient creates a new MaintenanceAssignmentsClient.
+func NewMaintenanceAssignmentsClient(tc azcore.TokenCredential, opts *arm.ClientOptions) (*MaintenanceAssignmentsClient, error) {
+	client, err := newRESTClient(maintenanceAssignmentsAPIVersion, tc, opts)
+	return &MaintenanceAssignmentsClient{client: client}, err
+}
+
+// Get returns the configuration assignment with the given name of the resource with the given ID. It returns nil if the
+// configuration assignment does not exist.
+func (c *MaintenanceAssignmentsClient) Get(ctx context.Context, resourceID, name string) (*MaintenanceAssignment, error) {
+	var result MaintenanceAssignment
+	if found, err := c.client.get(ctx, c.endpoint(resourceID, name), &result); err != nil || !found {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateOrUpdate creates or updates the configuration assignment with the given name of the resource with the given ID.
+func (c *MaintenanceAssignmentsClient) CreateOrUpdate(ctx context.Context, resourceID, name string, parameters MaintenanceAssignment) (*MaintenanceAssignment, error) {
+	var result MaintenanceAssignment
+	if err := c.client.put(ctx, c.endpoint(resourceID, name), parameters, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Delete deletes the configuration assignment with the given name of the resource with the given ID if it exists.
+func (c *MaintenanceAssignmentsClient) Delete(ctx context.Context, resourceID, name string) error {
+	return c.client.delete(ctx, c.endpoint(resourceID, name))
+}
+
+func (c *MaintenanceAssignmentsClient) endpoint(resourceID, name string) string {
+	return c.client.endpoint(nil, resourceID, "/providers/Microsoft.Maintenance/configurationAssignments/", url.PathEscape(name))
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+var _ = Describe("MaintenanceAssignmentsClient", func() {
+	const (
+		resourceID       = "/subscriptions/subscription/resourceGroups/shoot--foo--bar/providers/Microsoft.Compute/virtualMachines/vm"
+		assignmentPath   = resourceID + "/providers/Microsoft.Maintenance/configurationAssignments/gardener"
+		configurationID  = "/subscriptions/subscription/resourceGroups/maintenance/providers/Microsoft.Maintenance/maintenanceConfigurations/weekend"
+		assignmentResult = `{"id": "` + assignmentPath + `", "name": "gardener", "location": "westeurope", "properties": {"maintenanceConfigurationId": "` + configurationID + `", "resourceId": "` + resourceID + `"}}`
+	)
+
+	var (
+		ctx       = context.Background()
+		transport *responderTransport
+		client    *MaintenanceAssignmentsClient
+	)
+
+	BeforeEach(func() {
+		transport = &responderTransport{responses: map[string]*http.Response{}}
+
+		var err error
+		client, err = NewMaintenanceAssignmentsClient(&azfake.TokenCredential{}, withTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#Get", func() {
+		It("should return the configuration assignment", func() {
+			transport.responses["GET "+assignmentPath] = jsonResponse(http.StatusOK, assignmentResult)
+
+			assignment, err := client.Get(ctx, resourceID, "gardener")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*assignment.Properties.MaintenanceConfigurationID).To(Equal(configurationID))
+
+			Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2023-04-01"))
+		})
+
+		It("should return nil if the configuration assignment does not exist", func() {
+			assignment, err := client.Get(ctx, resourceID, "gardener")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(assignment).To(BeNil())
+		})
+	})
+
+	Describe("#CreateOrUpdate", func() {
+		It("should send the configuration assignment", func() {
+			transport.responses["PUT "+assignmentPath] = jsonResponse(http.StatusCreated, assignmentResult)
+
+			assignment, err := client.CreateOrUpdate(ctx, resourceID, "gardener", MaintenanceAssignment{
+				Location:   ptr.To("westeurope"),
+				Properties: &MaintenanceAssignmentProperties{MaintenanceConfigurationID: ptr.To(configurationID)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*assignment.ID).To(Equal(assignmentPath))
+
+			body, err := io.ReadAll(transport.requests[0].Body)
+			Expect(err).NotTo(HaveOccurred())
+			var sent MaintenanceAssignment
+			Expect(json.Unmarshal(body, &sent)).To(Succeed())
+			Expect(*sent.Location).To(Equal("westeurope"))
+			Expect(*sent.Properties.MaintenanceConfigurationID).To(Equal(configurationID))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the configuration assignment", func() {
+			transport.responses["DELETE "+assignmentPath] = jsonResponse(http.StatusOK, ``)
+
+			Expect(client.Delete(ctx, resourceID, "gardener")).To(Succeed())
+			Expect(transport.requests).To(HaveLen(1))
+		})
+
+		It("should ignore configuration assignments which do not exist", func() {
+			Expect(client.Delete(ctx, resourceID, "gardener")).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+const managementLocksAPIVersion = "2016-09-01"
+
+// LockLevel is the level of an Azure management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete means that authorized users can read and modify the locked resources, but not delete them.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly means that authorized users can only read the locked resources.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// ManagementLockObject is an Azure management lock.
+type ManagementLockObject struct {
+	// ID is the resource ID of the lock, which also contains the scope of the lock.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the lock.
+	Name *string `json:"name,omitempty"`
+	// Properties are the properties of the lock.
+	Properties *ManagementLockProperties `json:"properties,omitempty"`
+}
+
+// ManagementLockProperties are the properties of an Azure management lock.
+type ManagementLockProperties struct {
+	// Level is the level of the lock.
+	Level *LockLevel `json:"level,omitempty"`
+	// Notes are the notes of the lock.
+	Notes *string `json:"notes,omitempty"`
+}
+
+var _ ManagementLocks = &ManagementLocksClient{}
+
+// ManagementLocksClient is a client for Azure management locks.
+type ManagementLocksClient struct {
+	client         *restClient
+	subscriptionID string
+}
+
+// NewManagementLocksClient creates a new ManagementLocksClient.
+func NewManagementLocksClient(auth *internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*ManagementLocksClient, error) {
+	client, err := newRESTClient(managementLocksAPIVersion, tc, opts)
+	return &ManagementLocksClient{client: client, subscriptionID: auth.SubscriptionID}, err
+}
+
+// ListAtResourceGroupLevel lists the management locks of a resource group. This includes the locks on the resource group
+// itself, the locks inherited from the subscription and the locks on the resources contained in the resource group.
+// No locks are returned if the resource group does not exist.
+func (c *ManagementLocksClient) ListAtResourceGroupLevel(ctx context.Context, resourceGroupName string) ([]*ManagementLockObject, error) {
+	locks, err := listAll[*ManagementLockObject](ctx, c.client, c.client.endpoint(nil, "/subscriptions/", url.PathEscape(c.subscriptionID),
+		"/resourceGroups/", url.PathEscape(resourceGroupName), "/providers/Microsoft.Authorization/locks"))
+	return locks, FilterNotFoundError(err)
+}
+
+// CreateOrUpdateByScope creates or updates the management lock with the given name on the given scope, i.e. the
+// resource ID of the locked resource.
+func (c *ManagementLocksClient) CreateOrUpdateByScope(ctx context.Context, scope, lockName string, lock ManagementLockObject) (*ManagementLockObject, error) {
+	var result ManagementLockObject
+	if err := c.client.put(ctx, c.client.endpoint(nil, scope, "/providers/Microsoft.Authorization/locks/", url.PathEscape(lockName)), lock, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteByID deletes the management lock with the given resource ID if it exists.
+func (c *ManagementLocksClient) DeleteByID(ctx context.Context, lockID string) error {
+	return c.client.delete(ctx, c.client.endpoint(nil, lockID))
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+type responderTransport struct {
+	requests  []*http.Request
+	responses map[string]*http.Response
+}
+
+func (t *responderTransport) Do(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	resp, ok := t.responses[req.Method+" "+req.URL.Path]
+	if !ok {
+		resp = jsonResponse(http.StatusNotFound, `{"error":{"code":"ResourceGroupNotFound","message":"not found"}}`)
+	}
+	resp.Request = req
+	return resp, nil
+}
+
+func jsonResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+var _ = Describe("ManagementLocksClient", func() {
+	const (
+		locksPath = "/subscriptions/subscription/resourceGroups/shoot--foo--bar/providers/Microsoft.Authorization/locks"
+		lockID    = "/subscriptions/subscription/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/virtualNetworks/vnet/providers/Microsoft.Authorization/locks/lock"
+	)
+
+	var (
+		ctx       = context.Background()
+		transport *responderTransport
+		client    *ManagementLocksClient
+	)
+
+	BeforeEach(func() {
+		transport = &responderTransport{responses: map[string]*http.Response{}}
+
+		var err error
+		client, err = NewManagementLocksClient(&internal.ClientAuth{SubscriptionID: "subscription"}, &azfake.TokenCredential{}, withTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#ListAtResourceGroupLevel", func() {
+		It("should list the locks of all pages", func() {
+			transport.responses["GET "+locksPath] = jsonResponse(http.StatusOK, `{
+  "value": [{"id": "`+lockID+`", "name": "lock", "properties": {"level": "CanNotDelete", "notes": "foo"}}],
+  "nextLink": "https://management.azure.com/next-page"
+}`)
+			transport.responses["GET /next-page"] = jsonResponse(http.StatusOK, `{"value": [{"name": "other", "properties": {"level": "ReadOnly"}}]}`)
+
+			locks, err := client.ListAtResourceGroupLevel(ctx, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(locks).To(HaveLen(2))
+			Expect(*locks[0].ID).To(Equal(lockID))
+			Expect(*locks[0].Properties.Level).To(Equal(LockLevelCanNotDelete))
+			Expect(*locks[0].Properties.Notes).To(Equal("foo"))
+			Expect(*locks[1].Name).To(Equal("other"))
+			Expect(*locks[1].Properties.Level).To(Equal(LockLevelReadOnly))
+
+			Expect(transport.requests[0].URL.Query().Get("api-version")).To(Equal("2016-09-01"))
+		})
+
+		It("should return no locks if the resource group does not exist", func() {
+			locks, err := client.ListAtResourceGroupLevel(ctx, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(locks).To(BeEmpty())
+		})
+
+		It("should return other errors", func() {
+			transport.responses["GET "+locksPath] = jsonResponse(http.StatusForbidden, `{"error":{"code":"AuthorizationFailed","message":"forbidden"}}`)
+
+			_, err := client.ListAtResourceGroupLevel(ctx, "shoot--foo--bar")
+			Expect(err).To(MatchError(ContainSubstring("AuthorizationFailed")))
+		})
+	})
+
+	Describe("#CreateOrUpdateByScope", func() {
+		const (
+			scope        = "/subscriptions/subscription/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/networkSecurityGroups/nsg"
+			scopeLocksID = scope + "/providers/Microsoft.Authorization/locks/protection"
+		)
+
+		It("should create the lock on the resource", func() {
+			transport.responses["PUT "+scopeLocksID] = jsonResponse(http.StatusCreated, `{"id": "`+scopeLocksID+`", "name": "protection", "properties": {"level": "CanNotDelete", "notes": "foo"}}`)
+
+			lock, err := client.CreateOrUpdateByScope(ctx, scope, "protection", ManagementLockObject{
+				Properties: &ManagementLockProperties{Level: ptr.To(LockLevelCanNotDelete), Notes: ptr.To("foo")},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*lock.ID).To(Equal(scopeLocksID))
+
+			Expect(transport.requests).To(HaveLen(1))
+			body, err := io.ReadAll(transport.requests[0].Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"properties": {"level": "CanNotDelete", "notes": "foo"}}`))
+		})
+
+		It("should return errors", func() {
+			transport.responses["PUT "+scopeLocksID] = jsonResponse(http.StatusForbidden, `{"error":{"code":"AuthorizationFailed","message":"forbidden"}}`)
+
+			_, err := client.CreateOrUpdateByScope(ctx, scope, "protection", ManagementLockObject{})
+			Expect(err).To(MatchError(ContainSubstring("AuthorizationFailed")))
+		})
+	})
+
+	Describe("#DeleteByID", func() {
+		It("should delete the lock", func() {
+			transport.responses["DELETE "+lockID] = jsonResponse(http.StatusOK, ``)
+
+			Expect(client.DeleteByID(ctx, lockID)).To(Succeed())
+			Expect(transport.requests).To(HaveLen(1))
+			Expect(transport.requests[0].URL.Path).To(Equal(lockID))
+		})
+
+		It("should ignore locks which do not exist", func() {
+			Expect(client.DeleteByID(ctx, lockID)).To(Succeed())
+		})
+	})
+})
+
+func withTransport(transport *responderTransport) *arm.ClientOptions {
+	opts := &arm.ClientOptions{}
+	opts.Transport = transport
+	return opts
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ ManagementPolicies = &ManagementPoliciesClient{}
+
+// ManagementPoliciesClient is an implementation of ManagementPolicies for the lifecycle management policies of storage
+// accounts. A storage account has at most one lifecycle management policy, which is always named "default".
+type ManagementPoliciesClient struct {
+	client *armstorage.ManagementPoliciesClient
+}
+
+// NewManagementPoliciesClient creates a new ManagementPoliciesClient.
+func NewManagementPoliciesClient(auth *internal.ClientAuth, tc azcore.TokenCredential, opts *policy.ClientOptions) (*ManagementPoliciesClient, error) {
+	client, err := armstorage.NewManagementPoliciesClient(auth.SubscriptionID, tc, opts)
+	return &ManagementPoliciesClient{client}, err
+}
+
+// Get returns the lifecycle management policy of a storage account. It returns nil if the storage account has no
+// lifecycle management policy.
+func (c *ManagementPoliciesClient) Get(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.ManagementPolicy, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, storageAccountName, armstorage.ManagementPolicyNameDefault, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.ManagementPolicy, nil
+}
+
+// CreateOrUpdate creates or replaces the lifecycle management policy of a storage account.
+func (c *ManagementPoliciesClient) CreateOrUpdate(ctx context.Context, resourceGroupName, storageAccountName string, parameters armstorage.ManagementPolicy) (*armstorage.ManagementPolicy, error) {
+	res, err := c.client.CreateOrUpdate(ctx, resourceGroupName, storageAccountName, armstorage.ManagementPolicyNameDefault, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.ManagementPolicy, nil
+}
+
+// Delete deletes the lifecycle management policy of a storage account if it exists.
+func (c *ManagementPoliciesClient) Delete(ctx context.Context, resourceGroupName, storageAccountName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, storageAccountName, armstorage.ManagementPolicyNameDefault, nil)
+	return FilterNotFoundError(err)
+}
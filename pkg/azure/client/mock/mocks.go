@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extension-provider-azure/pkg/azure/client (interfaces: DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,AvailabilitySet,NetworkSecurityGroup,ManagedUserIdentity)
+// Source: github.com/gardener/gardener-extension-provider-azure/pkg/azure/client (interfaces: DNSZone,DNSRecordSet,PrivateDNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,AvailabilitySet,NetworkSecurityGroup,ManagedUserIdentity,GalleryImageVersions,Resource,NetworkInterface,Disk,ManagementLocks,DiagnosticSettings,NetworkWatcher,BlobInventoryPolicies,ManagementPolicies,BlobContainers,LoadBalancer,MaintenanceAssignments,AzureFirewall,FirewallPolicy,FirewallPolicyRuleCollectionGroup,Locations,ActivityLogs,StorageAccount,BlobStorage)
 //
 // Generated by this command:
 //
-//	mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-azure/pkg/azure/client DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,AvailabilitySet,NetworkSecurityGroup,ManagedUserIdentity
+//	mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-azure/pkg/azure/client DNSZone,DNSRecordSet,PrivateDNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,AvailabilitySet,NetworkSecurityGroup,ManagedUserIdentity,GalleryImageVersions,Resource,NetworkInterface,Disk,ManagementLocks,DiagnosticSettings,NetworkWatcher,BlobInventoryPolicies,ManagementPolicies,BlobContainers,LoadBalancer,MaintenanceAssignments,AzureFirewall,FirewallPolicy,FirewallPolicyRuleCollectionGroup,Locations,ActivityLogs,StorageAccount,BlobStorage
 //
 
 // Package client is a generated GoMock package.
@@ -12,11 +12,15 @@ package client
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	armcompute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	armdns "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
 	armmsi "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
 	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	armresources "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	armsubscriptions "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armsubscriptions"
+	armstorage "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	client "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -60,6 +64,21 @@ func (mr *MockDNSZoneMockRecorder) List(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDNSZone)(nil).List), arg0)
 }
 
+// ListByResourceGroup mocks base method.
+func (m *MockDNSZone) ListByResourceGroup(arg0 context.Context, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByResourceGroup", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByResourceGroup indicates an expected call of ListByResourceGroup.
+func (mr *MockDNSZoneMockRecorder) ListByResourceGroup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByResourceGroup", reflect.TypeOf((*MockDNSZone)(nil).ListByResourceGroup), arg0, arg1)
+}
+
 // MockDNSRecordSet is a mock of DNSRecordSet interface.
 type MockDNSRecordSet struct {
 	ctrl     *gomock.Controller
@@ -98,6 +117,20 @@ func (mr *MockDNSRecordSetMockRecorder) CreateOrUpdate(arg0, arg1, arg2, arg3, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockDNSRecordSet)(nil).CreateOrUpdate), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// CreateOrUpdateAlias mocks base method.
+func (m *MockDNSRecordSet) CreateOrUpdateAlias(arg0 context.Context, arg1, arg2, arg3, arg4 string, arg5 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateAlias", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateAlias indicates an expected call of CreateOrUpdateAlias.
+func (mr *MockDNSRecordSetMockRecorder) CreateOrUpdateAlias(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateAlias", reflect.TypeOf((*MockDNSRecordSet)(nil).CreateOrUpdateAlias), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // Delete mocks base method.
 func (m *MockDNSRecordSet) Delete(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -112,6 +145,73 @@ func (mr *MockDNSRecordSetMockRecorder) Delete(arg0, arg1, arg2, arg3 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDNSRecordSet)(nil).Delete), arg0, arg1, arg2, arg3)
 }
 
+// Get mocks base method.
+func (m *MockDNSRecordSet) Get(arg0 context.Context, arg1, arg2, arg3 string) (*armdns.RecordSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*armdns.RecordSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDNSRecordSetMockRecorder) Get(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDNSRecordSet)(nil).Get), arg0, arg1, arg2, arg3)
+}
+
+// MockPrivateDNSRecordSet is a mock of PrivateDNSRecordSet interface.
+type MockPrivateDNSRecordSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateDNSRecordSetMockRecorder
+	isgomock struct{}
+}
+
+// MockPrivateDNSRecordSetMockRecorder is the mock recorder for MockPrivateDNSRecordSet.
+type MockPrivateDNSRecordSetMockRecorder struct {
+	mock *MockPrivateDNSRecordSet
+}
+
+// NewMockPrivateDNSRecordSet creates a new mock instance.
+func NewMockPrivateDNSRecordSet(ctrl *gomock.Controller) *MockPrivateDNSRecordSet {
+	mock := &MockPrivateDNSRecordSet{ctrl: ctrl}
+	mock.recorder = &MockPrivateDNSRecordSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivateDNSRecordSet) EXPECT() *MockPrivateDNSRecordSetMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdateA mocks base method.
+func (m *MockPrivateDNSRecordSet) CreateOrUpdateA(ctx context.Context, resourceGroupName, zoneName, name string, ipAddresses []string, ttl int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateA", ctx, resourceGroupName, zoneName, name, ipAddresses, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateA indicates an expected call of CreateOrUpdateA.
+func (mr *MockPrivateDNSRecordSetMockRecorder) CreateOrUpdateA(ctx, resourceGroupName, zoneName, name, ipAddresses, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateA", reflect.TypeOf((*MockPrivateDNSRecordSet)(nil).CreateOrUpdateA), ctx, resourceGroupName, zoneName, name, ipAddresses, ttl)
+}
+
+// DeleteA mocks base method.
+func (m *MockPrivateDNSRecordSet) DeleteA(ctx context.Context, resourceGroupName, zoneName, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteA", ctx, resourceGroupName, zoneName, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteA indicates an expected call of DeleteA.
+func (mr *MockPrivateDNSRecordSetMockRecorder) DeleteA(ctx, resourceGroupName, zoneName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteA", reflect.TypeOf((*MockPrivateDNSRecordSet)(nil).DeleteA), ctx, resourceGroupName, zoneName, name)
+}
+
 // MockSubnet is a mock of Subnet interface.
 type MockSubnet struct {
 	ctrl     *gomock.Controller
@@ -219,6 +319,21 @@ func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
 	return m.recorder
 }
 
+// ActivityLogs mocks base method.
+func (m *MockFactory) ActivityLogs() (client.ActivityLogs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivityLogs")
+	ret0, _ := ret[0].(client.ActivityLogs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivityLogs indicates an expected call of ActivityLogs.
+func (mr *MockFactoryMockRecorder) ActivityLogs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivityLogs", reflect.TypeOf((*MockFactory)(nil).ActivityLogs))
+}
+
 // AvailabilitySet mocks base method.
 func (m *MockFactory) AvailabilitySet() (client.AvailabilitySet, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +349,51 @@ func (mr *MockFactoryMockRecorder) AvailabilitySet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySet", reflect.TypeOf((*MockFactory)(nil).AvailabilitySet))
 }
 
+// AzureFirewall mocks base method.
+func (m *MockFactory) AzureFirewall() (client.AzureFirewall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureFirewall")
+	ret0, _ := ret[0].(client.AzureFirewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AzureFirewall indicates an expected call of AzureFirewall.
+func (mr *MockFactoryMockRecorder) AzureFirewall() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureFirewall", reflect.TypeOf((*MockFactory)(nil).AzureFirewall))
+}
+
+// BlobContainers mocks base method.
+func (m *MockFactory) BlobContainers() (client.BlobContainers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobContainers")
+	ret0, _ := ret[0].(client.BlobContainers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlobContainers indicates an expected call of BlobContainers.
+func (mr *MockFactoryMockRecorder) BlobContainers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobContainers", reflect.TypeOf((*MockFactory)(nil).BlobContainers))
+}
+
+// BlobInventoryPolicies mocks base method.
+func (m *MockFactory) BlobInventoryPolicies() (client.BlobInventoryPolicies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobInventoryPolicies")
+	ret0, _ := ret[0].(client.BlobInventoryPolicies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlobInventoryPolicies indicates an expected call of BlobInventoryPolicies.
+func (mr *MockFactoryMockRecorder) BlobInventoryPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobInventoryPolicies", reflect.TypeOf((*MockFactory)(nil).BlobInventoryPolicies))
+}
+
 // DNSRecordSet mocks base method.
 func (m *MockFactory) DNSRecordSet() (client.DNSRecordSet, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +424,21 @@ func (mr *MockFactoryMockRecorder) DNSZone() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DNSZone", reflect.TypeOf((*MockFactory)(nil).DNSZone))
 }
 
+// DiagnosticSettings mocks base method.
+func (m *MockFactory) DiagnosticSettings() (client.DiagnosticSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiagnosticSettings")
+	ret0, _ := ret[0].(client.DiagnosticSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiagnosticSettings indicates an expected call of DiagnosticSettings.
+func (mr *MockFactoryMockRecorder) DiagnosticSettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiagnosticSettings", reflect.TypeOf((*MockFactory)(nil).DiagnosticSettings))
+}
+
 // Disk mocks base method.
 func (m *MockFactory) Disk() (client.Disk, error) {
 	m.ctrl.T.Helper()
@@ -279,6 +454,51 @@ func (mr *MockFactoryMockRecorder) Disk() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disk", reflect.TypeOf((*MockFactory)(nil).Disk))
 }
 
+// FirewallPolicy mocks base method.
+func (m *MockFactory) FirewallPolicy() (client.FirewallPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallPolicy")
+	ret0, _ := ret[0].(client.FirewallPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallPolicy indicates an expected call of FirewallPolicy.
+func (mr *MockFactoryMockRecorder) FirewallPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallPolicy", reflect.TypeOf((*MockFactory)(nil).FirewallPolicy))
+}
+
+// FirewallPolicyRuleCollectionGroup mocks base method.
+func (m *MockFactory) FirewallPolicyRuleCollectionGroup() (client.FirewallPolicyRuleCollectionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FirewallPolicyRuleCollectionGroup")
+	ret0, _ := ret[0].(client.FirewallPolicyRuleCollectionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FirewallPolicyRuleCollectionGroup indicates an expected call of FirewallPolicyRuleCollectionGroup.
+func (mr *MockFactoryMockRecorder) FirewallPolicyRuleCollectionGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FirewallPolicyRuleCollectionGroup", reflect.TypeOf((*MockFactory)(nil).FirewallPolicyRuleCollectionGroup))
+}
+
+// GalleryImageVersions mocks base method.
+func (m *MockFactory) GalleryImageVersions() (client.GalleryImageVersions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GalleryImageVersions")
+	ret0, _ := ret[0].(client.GalleryImageVersions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GalleryImageVersions indicates an expected call of GalleryImageVersions.
+func (mr *MockFactoryMockRecorder) GalleryImageVersions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GalleryImageVersions", reflect.TypeOf((*MockFactory)(nil).GalleryImageVersions))
+}
+
 // Group mocks base method.
 func (m *MockFactory) Group() (client.ResourceGroup, error) {
 	m.ctrl.T.Helper()
@@ -309,19 +529,83 @@ func (mr *MockFactoryMockRecorder) LoadBalancer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancer", reflect.TypeOf((*MockFactory)(nil).LoadBalancer))
 }
 
+// Locations mocks base method.
+func (m *MockFactory) Locations() (client.Locations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Locations")
+	ret0, _ := ret[0].(client.Locations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Locations indicates an expected call of Locations.
+func (mr *MockFactoryMockRecorder) Locations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Locations", reflect.TypeOf((*MockFactory)(nil).Locations))
+}
+
+// MaintenanceAssignments mocks base method.
+func (m *MockFactory) MaintenanceAssignments() (client.MaintenanceAssignments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaintenanceAssignments")
+	ret0, _ := ret[0].(client.MaintenanceAssignments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaintenanceAssignments indicates an expected call of MaintenanceAssignments.
+func (mr *MockFactoryMockRecorder) MaintenanceAssignments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaintenanceAssignments", reflect.TypeOf((*MockFactory)(nil).MaintenanceAssignments))
+}
+
 // ManagedUserIdentity mocks base method.
-func (m *MockFactory) ManagedUserIdentity() (client.ManagedUserIdentity, error) {
+func (m *MockFactory) ManagedUserIdentity(options ...client.AzureFactoryOption) (client.ManagedUserIdentity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ManagedUserIdentity")
+	varargs := []any{}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ManagedUserIdentity", varargs...)
 	ret0, _ := ret[0].(client.ManagedUserIdentity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ManagedUserIdentity indicates an expected call of ManagedUserIdentity.
-func (mr *MockFactoryMockRecorder) ManagedUserIdentity() *gomock.Call {
+func (mr *MockFactoryMockRecorder) ManagedUserIdentity(options ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedUserIdentity", reflect.TypeOf((*MockFactory)(nil).ManagedUserIdentity), options...)
+}
+
+// ManagementLocks mocks base method.
+func (m *MockFactory) ManagementLocks() (client.ManagementLocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManagementLocks")
+	ret0, _ := ret[0].(client.ManagementLocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManagementLocks indicates an expected call of ManagementLocks.
+func (mr *MockFactoryMockRecorder) ManagementLocks() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedUserIdentity", reflect.TypeOf((*MockFactory)(nil).ManagedUserIdentity))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagementLocks", reflect.TypeOf((*MockFactory)(nil).ManagementLocks))
+}
+
+// ManagementPolicies mocks base method.
+func (m *MockFactory) ManagementPolicies() (client.ManagementPolicies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManagementPolicies")
+	ret0, _ := ret[0].(client.ManagementPolicies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManagementPolicies indicates an expected call of ManagementPolicies.
+func (mr *MockFactoryMockRecorder) ManagementPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagementPolicies", reflect.TypeOf((*MockFactory)(nil).ManagementPolicies))
 }
 
 // NatGateway mocks base method.
@@ -369,6 +653,36 @@ func (mr *MockFactoryMockRecorder) NetworkSecurityGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkSecurityGroup", reflect.TypeOf((*MockFactory)(nil).NetworkSecurityGroup))
 }
 
+// NetworkWatcher mocks base method.
+func (m *MockFactory) NetworkWatcher() (client.NetworkWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkWatcher")
+	ret0, _ := ret[0].(client.NetworkWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkWatcher indicates an expected call of NetworkWatcher.
+func (mr *MockFactoryMockRecorder) NetworkWatcher() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkWatcher", reflect.TypeOf((*MockFactory)(nil).NetworkWatcher))
+}
+
+// PrivateDNSRecordSet mocks base method.
+func (m *MockFactory) PrivateDNSRecordSet() (client.PrivateDNSRecordSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivateDNSRecordSet")
+	ret0, _ := ret[0].(client.PrivateDNSRecordSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivateDNSRecordSet indicates an expected call of PrivateDNSRecordSet.
+func (mr *MockFactoryMockRecorder) PrivateDNSRecordSet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateDNSRecordSet", reflect.TypeOf((*MockFactory)(nil).PrivateDNSRecordSet))
+}
+
 // PublicIP mocks base method.
 func (m *MockFactory) PublicIP() (client.PublicIP, error) {
 	m.ctrl.T.Helper()
@@ -1049,6 +1363,21 @@ func (m *MockManagedUserIdentity) EXPECT() *MockManagedUserIdentityMockRecorder
 	return m.recorder
 }
 
+// CreateOrUpdate mocks base method.
+func (m *MockManagedUserIdentity) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armmsi.Identity) (*armmsi.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armmsi.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockManagedUserIdentityMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockManagedUserIdentity)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
 // Get mocks base method.
 func (m *MockManagedUserIdentity) Get(ctx context.Context, resourceGroupName, resourceName string) (*armmsi.UserAssignedIdentitiesClientGetResponse, error) {
 	m.ctrl.T.Helper()
@@ -1063,3 +1392,1355 @@ func (mr *MockManagedUserIdentityMockRecorder) Get(ctx, resourceGroupName, resou
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockManagedUserIdentity)(nil).Get), ctx, resourceGroupName, resourceName)
 }
+
+// MockGalleryImageVersions is a mock of GalleryImageVersions interface.
+type MockGalleryImageVersions struct {
+	ctrl     *gomock.Controller
+	recorder *MockGalleryImageVersionsMockRecorder
+	isgomock struct{}
+}
+
+// MockGalleryImageVersionsMockRecorder is the mock recorder for MockGalleryImageVersions.
+type MockGalleryImageVersionsMockRecorder struct {
+	mock *MockGalleryImageVersions
+}
+
+// NewMockGalleryImageVersions creates a new mock instance.
+func NewMockGalleryImageVersions(ctrl *gomock.Controller) *MockGalleryImageVersions {
+	mock := &MockGalleryImageVersions{ctrl: ctrl}
+	mock.recorder = &MockGalleryImageVersionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGalleryImageVersions) EXPECT() *MockGalleryImageVersionsMockRecorder {
+	return m.recorder
+}
+
+// ListCommunityGalleryImageVersions mocks base method.
+func (m *MockGalleryImageVersions) ListCommunityGalleryImageVersions(ctx context.Context, location, publicGalleryName, galleryImageName string) ([]*armcompute.CommunityGalleryImageVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommunityGalleryImageVersions", ctx, location, publicGalleryName, galleryImageName)
+	ret0, _ := ret[0].([]*armcompute.CommunityGalleryImageVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommunityGalleryImageVersions indicates an expected call of ListCommunityGalleryImageVersions.
+func (mr *MockGalleryImageVersionsMockRecorder) ListCommunityGalleryImageVersions(ctx, location, publicGalleryName, galleryImageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommunityGalleryImageVersions", reflect.TypeOf((*MockGalleryImageVersions)(nil).ListCommunityGalleryImageVersions), ctx, location, publicGalleryName, galleryImageName)
+}
+
+// ListSharedGalleryImageVersions mocks base method.
+func (m *MockGalleryImageVersions) ListSharedGalleryImageVersions(ctx context.Context, location, galleryUniqueName, galleryImageName string) ([]*armcompute.SharedGalleryImageVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSharedGalleryImageVersions", ctx, location, galleryUniqueName, galleryImageName)
+	ret0, _ := ret[0].([]*armcompute.SharedGalleryImageVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedGalleryImageVersions indicates an expected call of ListSharedGalleryImageVersions.
+func (mr *MockGalleryImageVersionsMockRecorder) ListSharedGalleryImageVersions(ctx, location, galleryUniqueName, galleryImageName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedGalleryImageVersions", reflect.TypeOf((*MockGalleryImageVersions)(nil).ListSharedGalleryImageVersions), ctx, location, galleryUniqueName, galleryImageName)
+}
+
+// MockResource is a mock of Resource interface.
+type MockResource struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceMockRecorder
+	isgomock struct{}
+}
+
+// MockResourceMockRecorder is the mock recorder for MockResource.
+type MockResourceMockRecorder struct {
+	mock *MockResource
+}
+
+// NewMockResource creates a new mock instance.
+func NewMockResource(ctrl *gomock.Controller) *MockResource {
+	mock := &MockResource{ctrl: ctrl}
+	mock.recorder = &MockResourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResource) EXPECT() *MockResourceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockResource) GetByID(ctx context.Context, resourceID, apiVersion string) (*armresources.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, resourceID, apiVersion)
+	ret0, _ := ret[0].(*armresources.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockResourceMockRecorder) GetByID(ctx, resourceID, apiVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockResource)(nil).GetByID), ctx, resourceID, apiVersion)
+}
+
+// ListByResourceGroup mocks base method.
+func (m *MockResource) ListByResourceGroup(ctx context.Context, resourceGroupName string, options *armresources.ClientListByResourceGroupOptions) ([]*armresources.GenericResourceExpanded, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByResourceGroup", ctx, resourceGroupName, options)
+	ret0, _ := ret[0].([]*armresources.GenericResourceExpanded)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByResourceGroup indicates an expected call of ListByResourceGroup.
+func (mr *MockResourceMockRecorder) ListByResourceGroup(ctx, resourceGroupName, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByResourceGroup", reflect.TypeOf((*MockResource)(nil).ListByResourceGroup), ctx, resourceGroupName, options)
+}
+
+// MockNetworkInterface is a mock of NetworkInterface interface.
+type MockNetworkInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockNetworkInterfaceMockRecorder is the mock recorder for MockNetworkInterface.
+type MockNetworkInterfaceMockRecorder struct {
+	mock *MockNetworkInterface
+}
+
+// NewMockNetworkInterface creates a new mock instance.
+func NewMockNetworkInterface(ctrl *gomock.Controller) *MockNetworkInterface {
+	mock := &MockNetworkInterface{ctrl: ctrl}
+	mock.recorder = &MockNetworkInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkInterface) EXPECT() *MockNetworkInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockNetworkInterface) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armnetwork.Interface) (*armnetwork.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockNetworkInterfaceMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockNetworkInterface)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockNetworkInterface) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNetworkInterfaceMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNetworkInterface)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockNetworkInterface) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.Interface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.Interface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNetworkInterfaceMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNetworkInterface)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// GetEffectiveRouteTable mocks base method.
+func (m *MockNetworkInterface) GetEffectiveRouteTable(ctx context.Context, resourceGroupName, name string) ([]*armnetwork.EffectiveRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEffectiveRouteTable", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].([]*armnetwork.EffectiveRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEffectiveRouteTable indicates an expected call of GetEffectiveRouteTable.
+func (mr *MockNetworkInterfaceMockRecorder) GetEffectiveRouteTable(ctx, resourceGroupName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEffectiveRouteTable", reflect.TypeOf((*MockNetworkInterface)(nil).GetEffectiveRouteTable), ctx, resourceGroupName, name)
+}
+
+// ListEffectiveNetworkSecurityGroups mocks base method.
+func (m *MockNetworkInterface) ListEffectiveNetworkSecurityGroups(ctx context.Context, resourceGroupName, name string) ([]*armnetwork.EffectiveNetworkSecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEffectiveNetworkSecurityGroups", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].([]*armnetwork.EffectiveNetworkSecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEffectiveNetworkSecurityGroups indicates an expected call of ListEffectiveNetworkSecurityGroups.
+func (mr *MockNetworkInterfaceMockRecorder) ListEffectiveNetworkSecurityGroups(ctx, resourceGroupName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveNetworkSecurityGroups", reflect.TypeOf((*MockNetworkInterface)(nil).ListEffectiveNetworkSecurityGroups), ctx, resourceGroupName, name)
+}
+
+// MockDisk is a mock of Disk interface.
+type MockDisk struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiskMockRecorder
+	isgomock struct{}
+}
+
+// MockDiskMockRecorder is the mock recorder for MockDisk.
+type MockDiskMockRecorder struct {
+	mock *MockDisk
+}
+
+// NewMockDisk creates a new mock instance.
+func NewMockDisk(ctrl *gomock.Controller) *MockDisk {
+	mock := &MockDisk{ctrl: ctrl}
+	mock.recorder = &MockDiskMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisk) EXPECT() *MockDiskMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockDisk) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armcompute.Disk) (*armcompute.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armcompute.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockDiskMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockDisk)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockDisk) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDiskMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDisk)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockDisk) Get(ctx context.Context, resourceGroupName, resourceName string) (*armcompute.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armcompute.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDiskMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDisk)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// MockManagementLocks is a mock of ManagementLocks interface.
+type MockManagementLocks struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagementLocksMockRecorder
+	isgomock struct{}
+}
+
+// MockManagementLocksMockRecorder is the mock recorder for MockManagementLocks.
+type MockManagementLocksMockRecorder struct {
+	mock *MockManagementLocks
+}
+
+// NewMockManagementLocks creates a new mock instance.
+func NewMockManagementLocks(ctrl *gomock.Controller) *MockManagementLocks {
+	mock := &MockManagementLocks{ctrl: ctrl}
+	mock.recorder = &MockManagementLocksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagementLocks) EXPECT() *MockManagementLocksMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdateByScope mocks base method.
+func (m *MockManagementLocks) CreateOrUpdateByScope(ctx context.Context, scope, lockName string, lock client.ManagementLockObject) (*client.ManagementLockObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateByScope", ctx, scope, lockName, lock)
+	ret0, _ := ret[0].(*client.ManagementLockObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateByScope indicates an expected call of CreateOrUpdateByScope.
+func (mr *MockManagementLocksMockRecorder) CreateOrUpdateByScope(ctx, scope, lockName, lock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateByScope", reflect.TypeOf((*MockManagementLocks)(nil).CreateOrUpdateByScope), ctx, scope, lockName, lock)
+}
+
+// DeleteByID mocks base method.
+func (m *MockManagementLocks) DeleteByID(ctx context.Context, lockID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, lockID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockManagementLocksMockRecorder) DeleteByID(ctx, lockID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockManagementLocks)(nil).DeleteByID), ctx, lockID)
+}
+
+// ListAtResourceGroupLevel mocks base method.
+func (m *MockManagementLocks) ListAtResourceGroupLevel(ctx context.Context, resourceGroupName string) ([]*client.ManagementLockObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAtResourceGroupLevel", ctx, resourceGroupName)
+	ret0, _ := ret[0].([]*client.ManagementLockObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAtResourceGroupLevel indicates an expected call of ListAtResourceGroupLevel.
+func (mr *MockManagementLocksMockRecorder) ListAtResourceGroupLevel(ctx, resourceGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAtResourceGroupLevel", reflect.TypeOf((*MockManagementLocks)(nil).ListAtResourceGroupLevel), ctx, resourceGroupName)
+}
+
+// MockDiagnosticSettings is a mock of DiagnosticSettings interface.
+type MockDiagnosticSettings struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiagnosticSettingsMockRecorder
+	isgomock struct{}
+}
+
+// MockDiagnosticSettingsMockRecorder is the mock recorder for MockDiagnosticSettings.
+type MockDiagnosticSettingsMockRecorder struct {
+	mock *MockDiagnosticSettings
+}
+
+// NewMockDiagnosticSettings creates a new mock instance.
+func NewMockDiagnosticSettings(ctrl *gomock.Controller) *MockDiagnosticSettings {
+	mock := &MockDiagnosticSettings{ctrl: ctrl}
+	mock.recorder = &MockDiagnosticSettingsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiagnosticSettings) EXPECT() *MockDiagnosticSettingsMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockDiagnosticSettings) CreateOrUpdate(ctx context.Context, resourceID, name string, parameters client.DiagnosticSettingsResource) (*client.DiagnosticSettingsResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceID, name, parameters)
+	ret0, _ := ret[0].(*client.DiagnosticSettingsResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockDiagnosticSettingsMockRecorder) CreateOrUpdate(ctx, resourceID, name, parameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockDiagnosticSettings)(nil).CreateOrUpdate), ctx, resourceID, name, parameters)
+}
+
+// Delete mocks base method.
+func (m *MockDiagnosticSettings) Delete(ctx context.Context, resourceID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDiagnosticSettingsMockRecorder) Delete(ctx, resourceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDiagnosticSettings)(nil).Delete), ctx, resourceID, name)
+}
+
+// Get mocks base method.
+func (m *MockDiagnosticSettings) Get(ctx context.Context, resourceID, name string) (*client.DiagnosticSettingsResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceID, name)
+	ret0, _ := ret[0].(*client.DiagnosticSettingsResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDiagnosticSettingsMockRecorder) Get(ctx, resourceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDiagnosticSettings)(nil).Get), ctx, resourceID, name)
+}
+
+// MockNetworkWatcher is a mock of NetworkWatcher interface.
+type MockNetworkWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkWatcherMockRecorder
+	isgomock struct{}
+}
+
+// MockNetworkWatcherMockRecorder is the mock recorder for MockNetworkWatcher.
+type MockNetworkWatcherMockRecorder struct {
+	mock *MockNetworkWatcher
+}
+
+// NewMockNetworkWatcher creates a new mock instance.
+func NewMockNetworkWatcher(ctrl *gomock.Controller) *MockNetworkWatcher {
+	mock := &MockNetworkWatcher{ctrl: ctrl}
+	mock.recorder = &MockNetworkWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkWatcher) EXPECT() *MockNetworkWatcherMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdateFlowLog mocks base method.
+func (m *MockNetworkWatcher) CreateOrUpdateFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string, parameters armnetwork.FlowLog) (*armnetwork.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateFlowLog", ctx, resourceGroupName, networkWatcherName, flowLogName, parameters)
+	ret0, _ := ret[0].(*armnetwork.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateFlowLog indicates an expected call of CreateOrUpdateFlowLog.
+func (mr *MockNetworkWatcherMockRecorder) CreateOrUpdateFlowLog(ctx, resourceGroupName, networkWatcherName, flowLogName, parameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateFlowLog", reflect.TypeOf((*MockNetworkWatcher)(nil).CreateOrUpdateFlowLog), ctx, resourceGroupName, networkWatcherName, flowLogName, parameters)
+}
+
+// DeleteFlowLog mocks base method.
+func (m *MockNetworkWatcher) DeleteFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlowLog", ctx, resourceGroupName, networkWatcherName, flowLogName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFlowLog indicates an expected call of DeleteFlowLog.
+func (mr *MockNetworkWatcherMockRecorder) DeleteFlowLog(ctx, resourceGroupName, networkWatcherName, flowLogName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlowLog", reflect.TypeOf((*MockNetworkWatcher)(nil).DeleteFlowLog), ctx, resourceGroupName, networkWatcherName, flowLogName)
+}
+
+// GetFlowLog mocks base method.
+func (m *MockNetworkWatcher) GetFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) (*armnetwork.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlowLog", ctx, resourceGroupName, networkWatcherName, flowLogName)
+	ret0, _ := ret[0].(*armnetwork.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlowLog indicates an expected call of GetFlowLog.
+func (mr *MockNetworkWatcherMockRecorder) GetFlowLog(ctx, resourceGroupName, networkWatcherName, flowLogName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlowLog", reflect.TypeOf((*MockNetworkWatcher)(nil).GetFlowLog), ctx, resourceGroupName, networkWatcherName, flowLogName)
+}
+
+// ListAll mocks base method.
+func (m *MockNetworkWatcher) ListAll(ctx context.Context) ([]*armnetwork.Watcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx)
+	ret0, _ := ret[0].([]*armnetwork.Watcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockNetworkWatcherMockRecorder) ListAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockNetworkWatcher)(nil).ListAll), ctx)
+}
+
+// MockBlobInventoryPolicies is a mock of BlobInventoryPolicies interface.
+type MockBlobInventoryPolicies struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobInventoryPoliciesMockRecorder
+	isgomock struct{}
+}
+
+// MockBlobInventoryPoliciesMockRecorder is the mock recorder for MockBlobInventoryPolicies.
+type MockBlobInventoryPoliciesMockRecorder struct {
+	mock *MockBlobInventoryPolicies
+}
+
+// NewMockBlobInventoryPolicies creates a new mock instance.
+func NewMockBlobInventoryPolicies(ctrl *gomock.Controller) *MockBlobInventoryPolicies {
+	mock := &MockBlobInventoryPolicies{ctrl: ctrl}
+	mock.recorder = &MockBlobInventoryPoliciesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobInventoryPolicies) EXPECT() *MockBlobInventoryPoliciesMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockBlobInventoryPolicies) CreateOrUpdate(ctx context.Context, resourceGroupName, storageAccountName string, parameters armstorage.BlobInventoryPolicy) (*armstorage.BlobInventoryPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, storageAccountName, parameters)
+	ret0, _ := ret[0].(*armstorage.BlobInventoryPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockBlobInventoryPoliciesMockRecorder) CreateOrUpdate(ctx, resourceGroupName, storageAccountName, parameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockBlobInventoryPolicies)(nil).CreateOrUpdate), ctx, resourceGroupName, storageAccountName, parameters)
+}
+
+// Delete mocks base method.
+func (m *MockBlobInventoryPolicies) Delete(ctx context.Context, resourceGroupName, storageAccountName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, storageAccountName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBlobInventoryPoliciesMockRecorder) Delete(ctx, resourceGroupName, storageAccountName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobInventoryPolicies)(nil).Delete), ctx, resourceGroupName, storageAccountName)
+}
+
+// Get mocks base method.
+func (m *MockBlobInventoryPolicies) Get(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.BlobInventoryPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, storageAccountName)
+	ret0, _ := ret[0].(*armstorage.BlobInventoryPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobInventoryPoliciesMockRecorder) Get(ctx, resourceGroupName, storageAccountName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobInventoryPolicies)(nil).Get), ctx, resourceGroupName, storageAccountName)
+}
+
+// MockManagementPolicies is a mock of ManagementPolicies interface.
+type MockManagementPolicies struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagementPoliciesMockRecorder
+	isgomock struct{}
+}
+
+// MockManagementPoliciesMockRecorder is the mock recorder for MockManagementPolicies.
+type MockManagementPoliciesMockRecorder struct {
+	mock *MockManagementPolicies
+}
+
+// NewMockManagementPolicies creates a new mock instance.
+func NewMockManagementPolicies(ctrl *gomock.Controller) *MockManagementPolicies {
+	mock := &MockManagementPolicies{ctrl: ctrl}
+	mock.recorder = &MockManagementPoliciesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagementPolicies) EXPECT() *MockManagementPoliciesMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockManagementPolicies) CreateOrUpdate(ctx context.Context, resourceGroupName, storageAccountName string, parameters armstorage.ManagementPolicy) (*armstorage.ManagementPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, storageAccountName, parameters)
+	ret0, _ := ret[0].(*armstorage.ManagementPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockManagementPoliciesMockRecorder) CreateOrUpdate(ctx, resourceGroupName, storageAccountName, parameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockManagementPolicies)(nil).CreateOrUpdate), ctx, resourceGroupName, storageAccountName, parameters)
+}
+
+// Delete mocks base method.
+func (m *MockManagementPolicies) Delete(ctx context.Context, resourceGroupName, storageAccountName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, storageAccountName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockManagementPoliciesMockRecorder) Delete(ctx, resourceGroupName, storageAccountName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockManagementPolicies)(nil).Delete), ctx, resourceGroupName, storageAccountName)
+}
+
+// Get mocks base method.
+func (m *MockManagementPolicies) Get(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.ManagementPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, storageAccountName)
+	ret0, _ := ret[0].(*armstorage.ManagementPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockManagementPoliciesMockRecorder) Get(ctx, resourceGroupName, storageAccountName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockManagementPolicies)(nil).Get), ctx, resourceGroupName, storageAccountName)
+}
+
+// MockBlobContainers is a mock of BlobContainers interface.
+type MockBlobContainers struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobContainersMockRecorder
+	isgomock struct{}
+}
+
+// MockBlobContainersMockRecorder is the mock recorder for MockBlobContainers.
+type MockBlobContainersMockRecorder struct {
+	mock *MockBlobContainers
+}
+
+// NewMockBlobContainers creates a new mock instance.
+func NewMockBlobContainers(ctrl *gomock.Controller) *MockBlobContainers {
+	mock := &MockBlobContainers{ctrl: ctrl}
+	mock.recorder = &MockBlobContainersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobContainers) EXPECT() *MockBlobContainersMockRecorder {
+	return m.recorder
+}
+
+// ClearLegalHold mocks base method.
+func (m *MockBlobContainers) ClearLegalHold(ctx context.Context, resourceGroupName, storageAccountName, containerName string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearLegalHold", ctx, resourceGroupName, storageAccountName, containerName, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearLegalHold indicates an expected call of ClearLegalHold.
+func (mr *MockBlobContainersMockRecorder) ClearLegalHold(ctx, resourceGroupName, storageAccountName, containerName, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearLegalHold", reflect.TypeOf((*MockBlobContainers)(nil).ClearLegalHold), ctx, resourceGroupName, storageAccountName, containerName, tags)
+}
+
+// Get mocks base method.
+func (m *MockBlobContainers) Get(ctx context.Context, resourceGroupName, storageAccountName, containerName string) (*armstorage.BlobContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, storageAccountName, containerName)
+	ret0, _ := ret[0].(*armstorage.BlobContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobContainersMockRecorder) Get(ctx, resourceGroupName, storageAccountName, containerName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobContainers)(nil).Get), ctx, resourceGroupName, storageAccountName, containerName)
+}
+
+// SetLegalHold mocks base method.
+func (m *MockBlobContainers) SetLegalHold(ctx context.Context, resourceGroupName, storageAccountName, containerName string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLegalHold", ctx, resourceGroupName, storageAccountName, containerName, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLegalHold indicates an expected call of SetLegalHold.
+func (mr *MockBlobContainersMockRecorder) SetLegalHold(ctx, resourceGroupName, storageAccountName, containerName, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLegalHold", reflect.TypeOf((*MockBlobContainers)(nil).SetLegalHold), ctx, resourceGroupName, storageAccountName, containerName, tags)
+}
+
+// MockLoadBalancer is a mock of LoadBalancer interface.
+type MockLoadBalancer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadBalancerMockRecorder
+	isgomock struct{}
+}
+
+// MockLoadBalancerMockRecorder is the mock recorder for MockLoadBalancer.
+type MockLoadBalancerMockRecorder struct {
+	mock *MockLoadBalancer
+}
+
+// NewMockLoadBalancer creates a new mock instance.
+func NewMockLoadBalancer(ctrl *gomock.Controller) *MockLoadBalancer {
+	mock := &MockLoadBalancer{ctrl: ctrl}
+	mock.recorder = &MockLoadBalancerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadBalancer) EXPECT() *MockLoadBalancerMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockLoadBalancer) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armnetwork.LoadBalancer) (*armnetwork.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockLoadBalancerMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockLoadBalancer)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockLoadBalancer) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoadBalancerMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoadBalancer)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockLoadBalancer) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLoadBalancerMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoadBalancer)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// GetFrontendConfigs mocks base method.
+func (m *MockLoadBalancer) GetFrontendConfigs(ctx context.Context, resourceGroupName, loadBalancerName string) ([]*armnetwork.FrontendIPConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFrontendConfigs", ctx, resourceGroupName, loadBalancerName)
+	ret0, _ := ret[0].([]*armnetwork.FrontendIPConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFrontendConfigs indicates an expected call of GetFrontendConfigs.
+func (mr *MockLoadBalancerMockRecorder) GetFrontendConfigs(ctx, resourceGroupName, loadBalancerName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontendConfigs", reflect.TypeOf((*MockLoadBalancer)(nil).GetFrontendConfigs), ctx, resourceGroupName, loadBalancerName)
+}
+
+// List mocks base method.
+func (m *MockLoadBalancer) List(ctx context.Context, resourceGroupName string) ([]*armnetwork.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, resourceGroupName)
+	ret0, _ := ret[0].([]*armnetwork.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoadBalancerMockRecorder) List(ctx, resourceGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoadBalancer)(nil).List), ctx, resourceGroupName)
+}
+
+// ListRules mocks base method.
+func (m *MockLoadBalancer) ListRules(ctx context.Context, resourceGroupName, loadBalancerName string) ([]*armnetwork.LoadBalancingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx, resourceGroupName, loadBalancerName)
+	ret0, _ := ret[0].([]*armnetwork.LoadBalancingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockLoadBalancerMockRecorder) ListRules(ctx, resourceGroupName, loadBalancerName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockLoadBalancer)(nil).ListRules), ctx, resourceGroupName, loadBalancerName)
+}
+
+// MockMaintenanceAssignments is a mock of MaintenanceAssignments interface.
+type MockMaintenanceAssignments struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaintenanceAssignmentsMockRecorder
+	isgomock struct{}
+}
+
+// MockMaintenanceAssignmentsMockRecorder is the mock recorder for MockMaintenanceAssignments.
+type MockMaintenanceAssignmentsMockRecorder struct {
+	mock *MockMaintenanceAssignments
+}
+
+// NewMockMaintenanceAssignments creates a new mock instance.
+func NewMockMaintenanceAssignments(ctrl *gomock.Controller) *MockMaintenanceAssignments {
+	mock := &MockMaintenanceAssignments{ctrl: ctrl}
+	mock.recorder = &MockMaintenanceAssignmentsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaintenanceAssignments) EXPECT() *MockMaintenanceAssignmentsMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockMaintenanceAssignments) CreateOrUpdate(ctx context.Context, resourceID, name string, parameters client.MaintenanceAssignment) (*client.MaintenanceAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceID, name, parameters)
+	ret0, _ := ret[0].(*client.MaintenanceAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockMaintenanceAssignmentsMockRecorder) CreateOrUpdate(ctx, resourceID, name, parameters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockMaintenanceAssignments)(nil).CreateOrUpdate), ctx, resourceID, name, parameters)
+}
+
+// Delete mocks base method.
+func (m *MockMaintenanceAssignments) Delete(ctx context.Context, resourceID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMaintenanceAssignmentsMockRecorder) Delete(ctx, resourceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMaintenanceAssignments)(nil).Delete), ctx, resourceID, name)
+}
+
+// Get mocks base method.
+func (m *MockMaintenanceAssignments) Get(ctx context.Context, resourceID, name string) (*client.MaintenanceAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceID, name)
+	ret0, _ := ret[0].(*client.MaintenanceAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMaintenanceAssignmentsMockRecorder) Get(ctx, resourceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMaintenanceAssignments)(nil).Get), ctx, resourceID, name)
+}
+
+// MockAzureFirewall is a mock of AzureFirewall interface.
+type MockAzureFirewall struct {
+	ctrl     *gomock.Controller
+	recorder *MockAzureFirewallMockRecorder
+	isgomock struct{}
+}
+
+// MockAzureFirewallMockRecorder is the mock recorder for MockAzureFirewall.
+type MockAzureFirewallMockRecorder struct {
+	mock *MockAzureFirewall
+}
+
+// NewMockAzureFirewall creates a new mock instance.
+func NewMockAzureFirewall(ctrl *gomock.Controller) *MockAzureFirewall {
+	mock := &MockAzureFirewall{ctrl: ctrl}
+	mock.recorder = &MockAzureFirewallMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAzureFirewall) EXPECT() *MockAzureFirewallMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockAzureFirewall) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armnetwork.AzureFirewall) (*armnetwork.AzureFirewall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.AzureFirewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockAzureFirewallMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockAzureFirewall)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockAzureFirewall) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAzureFirewallMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAzureFirewall)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockAzureFirewall) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.AzureFirewall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.AzureFirewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAzureFirewallMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAzureFirewall)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// MockFirewallPolicy is a mock of FirewallPolicy interface.
+type MockFirewallPolicy struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallPolicyMockRecorder
+	isgomock struct{}
+}
+
+// MockFirewallPolicyMockRecorder is the mock recorder for MockFirewallPolicy.
+type MockFirewallPolicyMockRecorder struct {
+	mock *MockFirewallPolicy
+}
+
+// NewMockFirewallPolicy creates a new mock instance.
+func NewMockFirewallPolicy(ctrl *gomock.Controller) *MockFirewallPolicy {
+	mock := &MockFirewallPolicy{ctrl: ctrl}
+	mock.recorder = &MockFirewallPolicyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallPolicy) EXPECT() *MockFirewallPolicyMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockFirewallPolicy) CreateOrUpdate(ctx context.Context, resourceGroupName, resourceName string, resourceParam armnetwork.FirewallPolicy) (*armnetwork.FirewallPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.FirewallPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockFirewallPolicyMockRecorder) CreateOrUpdate(ctx, resourceGroupName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockFirewallPolicy)(nil).CreateOrUpdate), ctx, resourceGroupName, resourceName, resourceParam)
+}
+
+// Delete mocks base method.
+func (m *MockFirewallPolicy) Delete(ctx context.Context, resourceGroupName, resourceName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFirewallPolicyMockRecorder) Delete(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFirewallPolicy)(nil).Delete), ctx, resourceGroupName, resourceName)
+}
+
+// Get mocks base method.
+func (m *MockFirewallPolicy) Get(ctx context.Context, resourceGroupName, resourceName string) (*armnetwork.FirewallPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.FirewallPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFirewallPolicyMockRecorder) Get(ctx, resourceGroupName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFirewallPolicy)(nil).Get), ctx, resourceGroupName, resourceName)
+}
+
+// MockFirewallPolicyRuleCollectionGroup is a mock of FirewallPolicyRuleCollectionGroup interface.
+type MockFirewallPolicyRuleCollectionGroup struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallPolicyRuleCollectionGroupMockRecorder
+	isgomock struct{}
+}
+
+// MockFirewallPolicyRuleCollectionGroupMockRecorder is the mock recorder for MockFirewallPolicyRuleCollectionGroup.
+type MockFirewallPolicyRuleCollectionGroupMockRecorder struct {
+	mock *MockFirewallPolicyRuleCollectionGroup
+}
+
+// NewMockFirewallPolicyRuleCollectionGroup creates a new mock instance.
+func NewMockFirewallPolicyRuleCollectionGroup(ctrl *gomock.Controller) *MockFirewallPolicyRuleCollectionGroup {
+	mock := &MockFirewallPolicyRuleCollectionGroup{ctrl: ctrl}
+	mock.recorder = &MockFirewallPolicyRuleCollectionGroupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallPolicyRuleCollectionGroup) EXPECT() *MockFirewallPolicyRuleCollectionGroupMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockFirewallPolicyRuleCollectionGroup) CreateOrUpdate(ctx context.Context, resourceGroupName, parentResourceName, resourceName string, resourceParam armnetwork.FirewallPolicyRuleCollectionGroup) (*armnetwork.FirewallPolicyRuleCollectionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+	ret0, _ := ret[0].(*armnetwork.FirewallPolicyRuleCollectionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockFirewallPolicyRuleCollectionGroupMockRecorder) CreateOrUpdate(ctx, resourceGroupName, parentResourceName, resourceName, resourceParam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockFirewallPolicyRuleCollectionGroup)(nil).CreateOrUpdate), ctx, resourceGroupName, parentResourceName, resourceName, resourceParam)
+}
+
+// Get mocks base method.
+func (m *MockFirewallPolicyRuleCollectionGroup) Get(ctx context.Context, resourceGroupName, parentResourceName, resourceName string) (*armnetwork.FirewallPolicyRuleCollectionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, parentResourceName, resourceName)
+	ret0, _ := ret[0].(*armnetwork.FirewallPolicyRuleCollectionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFirewallPolicyRuleCollectionGroupMockRecorder) Get(ctx, resourceGroupName, parentResourceName, resourceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFirewallPolicyRuleCollectionGroup)(nil).Get), ctx, resourceGroupName, parentResourceName, resourceName)
+}
+
+// MockLocations is a mock of Locations interface.
+type MockLocations struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationsMockRecorder
+	isgomock struct{}
+}
+
+// MockLocationsMockRecorder is the mock recorder for MockLocations.
+type MockLocationsMockRecorder struct {
+	mock *MockLocations
+}
+
+// NewMockLocations creates a new mock instance.
+func NewMockLocations(ctrl *gomock.Controller) *MockLocations {
+	mock := &MockLocations{ctrl: ctrl}
+	mock.recorder = &MockLocationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocations) EXPECT() *MockLocationsMockRecorder {
+	return m.recorder
+}
+
+// AvailabilityZoneMappings mocks base method.
+func (m *MockLocations) AvailabilityZoneMappings(ctx context.Context, location string) ([]*armsubscriptions.AvailabilityZoneMappings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailabilityZoneMappings", ctx, location)
+	ret0, _ := ret[0].([]*armsubscriptions.AvailabilityZoneMappings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailabilityZoneMappings indicates an expected call of AvailabilityZoneMappings.
+func (mr *MockLocationsMockRecorder) AvailabilityZoneMappings(ctx, location any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilityZoneMappings", reflect.TypeOf((*MockLocations)(nil).AvailabilityZoneMappings), ctx, location)
+}
+
+// MockActivityLogs is a mock of ActivityLogs interface.
+type MockActivityLogs struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityLogsMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityLogsMockRecorder is the mock recorder for MockActivityLogs.
+type MockActivityLogsMockRecorder struct {
+	mock *MockActivityLogs
+}
+
+// NewMockActivityLogs creates a new mock instance.
+func NewMockActivityLogs(ctrl *gomock.Controller) *MockActivityLogs {
+	mock := &MockActivityLogs{ctrl: ctrl}
+	mock.recorder = &MockActivityLogsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityLogs) EXPECT() *MockActivityLogsMockRecorder {
+	return m.recorder
+}
+
+// ListResourceGroupEvents mocks base method.
+func (m *MockActivityLogs) ListResourceGroupEvents(ctx context.Context, resourceGroupName string, from, to time.Time) ([]*client.ActivityLogEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceGroupEvents", ctx, resourceGroupName, from, to)
+	ret0, _ := ret[0].([]*client.ActivityLogEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceGroupEvents indicates an expected call of ListResourceGroupEvents.
+func (mr *MockActivityLogsMockRecorder) ListResourceGroupEvents(ctx, resourceGroupName, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceGroupEvents", reflect.TypeOf((*MockActivityLogs)(nil).ListResourceGroupEvents), ctx, resourceGroupName, from, to)
+}
+
+// MockStorageAccount is a mock of StorageAccount interface.
+type MockStorageAccount struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageAccountMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageAccountMockRecorder is the mock recorder for MockStorageAccount.
+type MockStorageAccountMockRecorder struct {
+	mock *MockStorageAccount
+}
+
+// NewMockStorageAccount creates a new mock instance.
+func NewMockStorageAccount(ctrl *gomock.Controller) *MockStorageAccount {
+	mock := &MockStorageAccount{ctrl: ctrl}
+	mock.recorder = &MockStorageAccountMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageAccount) EXPECT() *MockStorageAccountMockRecorder {
+	return m.recorder
+}
+
+// CreateStorageAccount mocks base method.
+func (m *MockStorageAccount) CreateStorageAccount(arg0 context.Context, arg1, arg2, arg3 string, arg4 armstorage.SKUName, arg5 client.StorageAccountSecurity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageAccount", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStorageAccount indicates an expected call of CreateStorageAccount.
+func (mr *MockStorageAccountMockRecorder) CreateStorageAccount(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageAccount", reflect.TypeOf((*MockStorageAccount)(nil).CreateStorageAccount), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Get mocks base method.
+func (m *MockStorageAccount) Get(arg0 context.Context, arg1, arg2 string) (*armstorage.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*armstorage.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStorageAccountMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStorageAccount)(nil).Get), arg0, arg1, arg2)
+}
+
+// ListStorageAccountKey mocks base method.
+func (m *MockStorageAccount) ListStorageAccountKey(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageAccountKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageAccountKey indicates an expected call of ListStorageAccountKey.
+func (mr *MockStorageAccountMockRecorder) ListStorageAccountKey(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageAccountKey", reflect.TypeOf((*MockStorageAccount)(nil).ListStorageAccountKey), arg0, arg1, arg2)
+}
+
+// UpdateMinimumTLSVersion mocks base method.
+func (m *MockStorageAccount) UpdateMinimumTLSVersion(arg0 context.Context, arg1, arg2 string, arg3 armstorage.MinimumTLSVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMinimumTLSVersion", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMinimumTLSVersion indicates an expected call of UpdateMinimumTLSVersion.
+func (mr *MockStorageAccountMockRecorder) UpdateMinimumTLSVersion(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMinimumTLSVersion", reflect.TypeOf((*MockStorageAccount)(nil).UpdateMinimumTLSVersion), arg0, arg1, arg2, arg3)
+}
+
+// UpdateNetworkRules mocks base method.
+func (m *MockStorageAccount) UpdateNetworkRules(arg0 context.Context, arg1, arg2 string, arg3 *armstorage.NetworkRuleSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkRules", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkRules indicates an expected call of UpdateNetworkRules.
+func (mr *MockStorageAccountMockRecorder) UpdateNetworkRules(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkRules", reflect.TypeOf((*MockStorageAccount)(nil).UpdateNetworkRules), arg0, arg1, arg2, arg3)
+}
+
+// MockBlobStorage is a mock of BlobStorage interface.
+type MockBlobStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockBlobStorageMockRecorder is the mock recorder for MockBlobStorage.
+type MockBlobStorageMockRecorder struct {
+	mock *MockBlobStorage
+}
+
+// NewMockBlobStorage creates a new mock instance.
+func NewMockBlobStorage(ctrl *gomock.Controller) *MockBlobStorage {
+	mock := &MockBlobStorage{ctrl: ctrl}
+	mock.recorder = &MockBlobStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStorage) EXPECT() *MockBlobStorageMockRecorder {
+	return m.recorder
+}
+
+// BlobSASURL mocks base method.
+func (m *MockBlobStorage) BlobSASURL(arg0, arg1 string, arg2 time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobSASURL", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlobSASURL indicates an expected call of BlobSASURL.
+func (mr *MockBlobStorageMockRecorder) BlobSASURL(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobSASURL", reflect.TypeOf((*MockBlobStorage)(nil).BlobSASURL), arg0, arg1, arg2)
+}
+
+// CopyContainer mocks base method.
+func (m *MockBlobStorage) CopyContainer(arg0 context.Context, arg1 client.BlobStorage, arg2, arg3 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyContainer", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyContainer indicates an expected call of CopyContainer.
+func (mr *MockBlobStorageMockRecorder) CopyContainer(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyContainer", reflect.TypeOf((*MockBlobStorage)(nil).CopyContainer), arg0, arg1, arg2, arg3)
+}
+
+// CreateContainerIfNotExists mocks base method.
+func (m *MockBlobStorage) CreateContainerIfNotExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContainerIfNotExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateContainerIfNotExists indicates an expected call of CreateContainerIfNotExists.
+func (mr *MockBlobStorageMockRecorder) CreateContainerIfNotExists(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContainerIfNotExists", reflect.TypeOf((*MockBlobStorage)(nil).CreateContainerIfNotExists), arg0, arg1)
+}
+
+// DeleteContainerIfExists mocks base method.
+func (m *MockBlobStorage) DeleteContainerIfExists(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContainerIfExists", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContainerIfExists indicates an expected call of DeleteContainerIfExists.
+func (mr *MockBlobStorageMockRecorder) DeleteContainerIfExists(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContainerIfExists", reflect.TypeOf((*MockBlobStorage)(nil).DeleteContainerIfExists), arg0, arg1)
+}
+
+// DeleteObjectsWithPrefix mocks base method.
+func (m *MockBlobStorage) DeleteObjectsWithPrefix(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectsWithPrefix", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectsWithPrefix indicates an expected call of DeleteObjectsWithPrefix.
+func (mr *MockBlobStorageMockRecorder) DeleteObjectsWithPrefix(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectsWithPrefix", reflect.TypeOf((*MockBlobStorage)(nil).DeleteObjectsWithPrefix), arg0, arg1, arg2)
+}
+
+// ListBlobs mocks base method.
+func (m *MockBlobStorage) ListBlobs(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlobs", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlobs indicates an expected call of ListBlobs.
+func (mr *MockBlobStorageMockRecorder) ListBlobs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlobs", reflect.TypeOf((*MockBlobStorage)(nil).ListBlobs), arg0, arg1)
+}
@@ -58,3 +58,30 @@ func (c *NetworkInterfaceClient) Delete(ctx context.Context, resourceGroupName,
 	_, err = future.PollUntilDone(ctx, nil)
 	return err
 }
+
+// GetEffectiveRouteTable gets the routes which are effectively applied to a Network interface.
+func (c *NetworkInterfaceClient) GetEffectiveRouteTable(ctx context.Context, resourceGroupName, name string) ([]*armnetwork.EffectiveRoute, error) {
+	future, err := c.client.BeginGetEffectiveRouteTable(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := future.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+// ListEffectiveNetworkSecurityGroups lists the network security groups which are effectively applied to a Network
+// interface.
+func (c *NetworkInterfaceClient) ListEffectiveNetworkSecurityGroups(ctx context.Context, resourceGroupName, name string) ([]*armnetwork.EffectiveNetworkSecurityGroup, error) {
+	future, err := c.client.BeginListEffectiveNetworkSecurityGroups(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := future.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ NetworkWatcher = &NetworkWatcherClient{}
+
+// NetworkWatcherClient is an implementation of NetworkWatcher for the Azure Network Watcher service.
+type NetworkWatcherClient struct {
+	watchers *armnetwork.WatchersClient
+	flowLogs *armnetwork.FlowLogsClient
+}
+
+// NewNetworkWatcherClient creates a new NetworkWatcher client.
+func NewNetworkWatcherClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*NetworkWatcherClient, error) {
+	watchers, err := armnetwork.NewWatchersClient(auth.SubscriptionID, tc, opts)
+	if err != nil {
+		return nil, err
+	}
+	flowLogs, err := armnetwork.NewFlowLogsClient(auth.SubscriptionID, tc, opts)
+	return &NetworkWatcherClient{watchers: watchers, flowLogs: flowLogs}, err
+}
+
+// ListAll returns all network watchers of the subscription.
+func (c *NetworkWatcherClient) ListAll(ctx context.Context) ([]*armnetwork.Watcher, error) {
+	pager := c.watchers.NewListAllPager(nil)
+	var watchers []*armnetwork.Watcher
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, res.Value...)
+	}
+	return watchers, nil
+}
+
+// GetFlowLog returns the flow log of the given network watcher or nil if it doesn't exist.
+func (c *NetworkWatcherClient) GetFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) (*armnetwork.FlowLog, error) {
+	res, err := c.flowLogs.Get(ctx, resourceGroupName, networkWatcherName, flowLogName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.FlowLog, nil
+}
+
+// CreateOrUpdateFlowLog creates or updates a flow log of the given network watcher.
+func (c *NetworkWatcherClient) CreateOrUpdateFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string, parameters armnetwork.FlowLog) (*armnetwork.FlowLog, error) {
+	poller, err := c.flowLogs.BeginCreateOrUpdate(ctx, resourceGroupName, networkWatcherName, flowLogName, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := poller.PollUntilDone(ctx, nil)
+	return &res.FlowLog, err
+}
+
+// DeleteFlowLog deletes the flow log of the given network watcher if it exists.
+func (c *NetworkWatcherClient) DeleteFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) error {
+	poller, err := c.flowLogs.BeginDelete(ctx, resourceGroupName, networkWatcherName, flowLogName, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+// privateDNSAPIVersion is the API version of the Microsoft.Network/privateDnsZones resource provider.
+const privateDNSAPIVersion = "2020-06-01"
+
+var _ PrivateDNSRecordSet = &PrivateDNSRecordSetClient{}
+
+// PrivateDNSRecordSetClient is an implementation of PrivateDNSRecordSet for A record sets in private DNS zones.
+// The record sets are managed as generic resources, as there is no dedicated module of the Azure SDK in use for private DNS zones.
+type PrivateDNSRecordSetClient struct {
+	subscriptionID string
+	client         *armresources.Client
+}
+
+// NewPrivateDNSRecordSetClient creates a new PrivateDNSRecordSetClient.
+func NewPrivateDNSRecordSetClient(auth *internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*PrivateDNSRecordSetClient, error) {
+	client, err := armresources.NewClient(auth.SubscriptionID, tc, opts)
+	return &PrivateDNSRecordSetClient{subscriptionID: auth.SubscriptionID, client: client}, err
+}
+
+// CreateOrUpdateA creates or updates the A record set with the given relative name and IPv4 addresses in the given private DNS zone.
+func (c *PrivateDNSRecordSetClient) CreateOrUpdateA(ctx context.Context, resourceGroupName, zoneName, name string, ipAddresses []string, ttl int64) error {
+	aRecords := make([]map[string]interface{}, 0, len(ipAddresses))
+	for _, ipAddress := range ipAddresses {
+		aRecords = append(aRecords, map[string]interface{}{"ipv4Address": ipAddress})
+	}
+
+	poller, err := c.client.BeginCreateOrUpdateByID(ctx, c.aRecordSetID(resourceGroupName, zoneName, name), privateDNSAPIVersion, armresources.GenericResource{
+		Properties: map[string]interface{}{
+			"ttl":      ttl,
+			"aRecords": aRecords,
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
+// DeleteA deletes the A record set with the given relative name in the given private DNS zone. If the record set does not exist, no error is returned.
+func (c *PrivateDNSRecordSetClient) DeleteA(ctx context.Context, resourceGroupName, zoneName, name string) error {
+	poller, err := c.client.BeginDeleteByID(ctx, c.aRecordSetID(resourceGroupName, zoneName, name), privateDNSAPIVersion, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = poller.PollUntilDone(ctx, nil)
+	return FilterNotFoundError(err)
+}
+
+func (c *PrivateDNSRecordSetClient) aRecordSetID(resourceGroupName, zoneName, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s/A/%s", c.subscriptionID, resourceGroupName, zoneName, name)
+}
@@ -40,3 +40,13 @@ func (c *ResourceClient) ListByResourceGroup(ctx context.Context, resourceGroupN
 	}
 	return res, nil
 }
+
+// GetByID returns the resource with the given ID or nil if it doesn't exist. The API version must be supported by the
+// resource provider of the resource.
+func (c *ResourceClient) GetByID(ctx context.Context, resourceID, apiVersion string) (*armresources.GenericResource, error) {
+	res, err := c.client.GetByID(ctx, resourceID, apiVersion, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.GenericResource, nil
+}
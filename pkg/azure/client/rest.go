@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const (
+	// restModuleName and restModuleVersion identify the REST clients of this package in the user agent of their requests.
+	restModuleName    = "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	restModuleVersion = "v1.0.0"
+)
+
+// restClient is a client for Azure Resource Manager REST APIs whose SDK modules are not yet dependencies of this
+// project, e.g. armmaintenance, armmonitor and armlocks. The clients built on it only mirror the parts of the models
+// which are used by the extension. They should be replaced by the SDK clients once the modules are added as
+// dependencies.
+type restClient struct {
+	client     *arm.Client
+	apiVersion string
+}
+
+func newRESTClient(apiVersion string, tc azcore.TokenCredential, opts *arm.ClientOptions) (*restClient, error) {
+	client, err := arm.NewClient(restModuleName, restModuleVersion, tc, opts)
+	return &restClient{client: client, apiVersion: apiVersion}, err
+}
+
+// endpoint returns the URL of the given path with the given query parameters and the API version of the client.
+func (c *restClient) endpoint(query url.Values, paths ...string) string {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", c.apiVersion)
+	return runtime.JoinPaths(c.client.Endpoint(), paths...) + "?" + query.Encode()
+}
+
+// get unmarshals the resource at the given endpoint into result. It returns false if the resource does not exist.
+func (c *restClient) get(ctx context.Context, endpoint string, result any) (bool, error) {
+	resp, err := c.do(ctx, http.MethodGet, endpoint, nil, http.StatusOK)
+	if err != nil {
+		if err := FilterNotFoundError(err); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+	return true, runtime.UnmarshalAsJSON(resp, result)
+}
+
+// put creates or updates the resource at the given endpoint and unmarshals the response into result.
+func (c *restClient) put(ctx context.Context, endpoint string, body, result any) error {
+	resp, err := c.do(ctx, http.MethodPut, endpoint, body, http.StatusOK, http.StatusCreated)
+	if err != nil {
+		return err
+	}
+	return runtime.UnmarshalAsJSON(resp, result)
+}
+
+// delete deletes the resource at the given endpoint if it exists.
+func (c *restClient) delete(ctx context.Context, endpoint string) error {
+	_, err := c.do(ctx, http.MethodDelete, endpoint, nil, http.StatusOK, http.StatusNoContent)
+	return FilterNotFoundError(err)
+}
+
+// listPage is a page of a list result of Azure Resource Manager.
+type listPage[T any] struct {
+	Value    []T     `json:"value,omitempty"`
+	NextLink *string `json:"nextLink,omitempty"`
+}
+
+// listAll lists the items of all pages starting at the given endpoint.
+func listAll[T any](ctx context.Context, c *restClient, endpoint string) ([]T, error) {
+	var items []T
+	for endpoint != "" {
+		resp, err := c.do(ctx, http.MethodGet, endpoint, nil, http.StatusOK)
+		if err != nil {
+			return nil, err
+		}
+
+		var page listPage[T]
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Value...)
+
+		endpoint = ""
+		if page.NextLink != nil {
+			endpoint = *page.NextLink
+		}
+	}
+	return items, nil
+}
+
+func (c *restClient) do(ctx context.Context, method, endpoint string, body any, statusCodes ...int) (*http.Response, error) {
+	req, err := runtime.NewRequest(ctx, method, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.client.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, statusCodes...) {
+		return nil, runtime.NewResponseError(resp)
+	}
+	return resp, nil
+}
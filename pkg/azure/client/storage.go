@@ -9,10 +9,14 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
@@ -24,12 +28,41 @@ import (
 
 var _ BlobStorage = &BlobStorageClient{}
 
+const (
+	// copySourceSASExpiry is the validity of the SAS URLs which are used as source of server-side blob copies.
+	copySourceSASExpiry = 24 * time.Hour
+	// SASClockSkew is the tolerated difference between the clocks of the seed and the storage service. The start time of
+	// SAS tokens is backdated by it, so that new tokens are accepted immediately.
+	SASClockSkew = 15 * time.Minute
+)
+
 // BlobStorageClient is an implementation of Storage for a blob storage k8sClient.
 type BlobStorageClient struct {
 	// serviceURL *azblob.ServiceURL
 	client *azblob.Client
 }
 
+// BlobStorageClientOption represents an option for the constructors of the BlobStorageClient.
+type BlobStorageClientOption func(*azblob.ClientOptions)
+
+// WithBlobTransport is the option that sets the HTTP transport of the blob storage client.
+func WithBlobTransport(transport azpolicy.Transporter) BlobStorageClientOption {
+	return func(o *azblob.ClientOptions) {
+		o.Transport = transport
+	}
+}
+
+func blobClientOptions(options []BlobStorageClientOption) *azblob.ClientOptions {
+	if len(options) == 0 {
+		return nil
+	}
+	clientOptions := &azblob.ClientOptions{}
+	for _, option := range options {
+		option(clientOptions)
+	}
+	return clientOptions
+}
+
 // BlobStorageDomainFromCloudConfiguration returns the storage service domain given a known cloudConfiguration.
 func BlobStorageDomainFromCloudConfiguration(cloudConfiguration *azureapi.CloudConfiguration) (string, error) {
 	// Unfortunately the valid values for storage domains run by Microsoft do not seem to be part of any sdk module. They might be queryable from the cloud configuration,
@@ -46,12 +79,17 @@ func BlobStorageDomainFromCloudConfiguration(cloudConfiguration *azureapi.CloudC
 	case strings.EqualFold(cloudConfiguration.Name, "AzureChina"):
 		// source: https://learn.microsoft.com/en-us/azure/china/resources-developer-guide#check-endpoints-in-azure
 		return azure.AzureChinaBlobStorageDomain, nil
+	case strings.EqualFold(cloudConfiguration.Name, azureapi.AzureStackCloudName):
+		if cloudConfiguration.StorageEndpointSuffix == nil {
+			return "", fmt.Errorf("cloud configuration '%s' requires a storage endpoint suffix", cloudConfiguration.Name)
+		}
+		return "blob." + *cloudConfiguration.StorageEndpointSuffix, nil
 	}
 	return "", fmt.Errorf("unknown cloud configuration name '%s'", cloudConfiguration.Name)
 }
 
 // NewBlobStorageClient creates a blob storage client.
-func NewBlobStorageClient(_ context.Context, storageAccountName, storageAccountKey, storageDomain string) (*BlobStorageClient, error) {
+func NewBlobStorageClient(_ context.Context, storageAccountName, storageAccountKey, storageDomain string, options ...BlobStorageClientOption) (*BlobStorageClient, error) {
 	credentials, err := azblob.NewSharedKeyCredential(storageAccountName, storageAccountKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create shared key credentials: %v", err)
@@ -61,12 +99,43 @@ func NewBlobStorageClient(_ context.Context, storageAccountName, storageAccountK
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse service url: %v", err)
 	}
-	blobclient, err := azblob.NewClientWithSharedKeyCredential(storageEndpointURL.String(), credentials, nil)
+	blobclient, err := azblob.NewClientWithSharedKeyCredential(storageEndpointURL.String(), credentials, blobClientOptions(options))
 	return &BlobStorageClient{blobclient}, err
 }
 
+// NewBlobStorageClientWithSASToken creates a blob storage client which authenticates with a SAS token.
+func NewBlobStorageClientWithSASToken(_ context.Context, storageAccountName, sasToken, storageDomain string, options ...BlobStorageClientOption) (*BlobStorageClient, error) {
+	storageEndpointURL, err := url.Parse(fmt.Sprintf("https://%s.%s/?%s", storageAccountName, storageDomain, sasToken))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service url: %v", err)
+	}
+	blobclient, err := azblob.NewClientWithNoCredential(storageEndpointURL.String(), blobClientOptions(options))
+	return &BlobStorageClient{blobclient}, err
+}
+
+// NewContainerSASToken creates a SAS token which grants access to the blobs of the given container until the given expiry
+// time. The token is signed with the key of the storage account.
+func NewContainerSASToken(storageAccountName, storageAccountKey, container string, now, expiry time.Time) (string, error) {
+	credentials, err := azblob.NewSharedKeyCredential(storageAccountName, storageAccountKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to create shared key credentials: %v", err)
+	}
+
+	queryParameters, err := sas.BlobSignatureValues{
+		Protocol:      sas.ProtocolHTTPS,
+		StartTime:     now.Add(-SASClockSkew).UTC(),
+		ExpiryTime:    expiry.UTC(),
+		Permissions:   (&sas.ContainerPermissions{Read: true, Add: true, Create: true, Write: true, Delete: true, List: true}).String(),
+		ContainerName: container,
+	}.SignWithSharedKey(credentials)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign SAS token: %v", err)
+	}
+	return queryParameters.Encode(), nil
+}
+
 // NewBlobStorageClientFromSecretRef creates a client for an Azure Blob storage by reading auth information from secret reference.
-func NewBlobStorageClientFromSecretRef(ctx context.Context, client client.Client, secretRef *corev1.SecretReference) (*BlobStorageClient, error) {
+func NewBlobStorageClientFromSecretRef(ctx context.Context, client client.Client, secretRef *corev1.SecretReference, options ...BlobStorageClientOption) (*BlobStorageClient, error) {
 	secret, err := extensionscontroller.GetSecretByReference(ctx, client, secretRef)
 	if err != nil {
 		return nil, err
@@ -76,17 +145,20 @@ func NewBlobStorageClientFromSecretRef(ctx context.Context, client client.Client
 		return nil, fmt.Errorf("secret %s/%s doesn't have a storage account", secret.Namespace, secret.Name)
 	}
 
-	storageAccountKey, ok := secret.Data[azure.StorageKey]
-	if !ok {
-		return nil, fmt.Errorf("secret %s/%s doesn't have a storage key", secret.Namespace, secret.Name)
-	}
-
 	storageDomain := azure.AzureBlobStorageDomain
 	if v, ok := secret.Data[azure.StorageDomain]; ok {
 		storageDomain = string(v)
 	}
 
-	return NewBlobStorageClient(ctx, string(storageAccountName), string(storageAccountKey), storageDomain)
+	storageAccountKey, ok := secret.Data[azure.StorageKey]
+	if !ok {
+		if sasToken, ok := secret.Data[azure.StorageSASToken]; ok {
+			return NewBlobStorageClientWithSASToken(ctx, string(storageAccountName), string(sasToken), storageDomain, options...)
+		}
+		return nil, fmt.Errorf("secret %s/%s doesn't have a storage key or SAS token", secret.Namespace, secret.Name)
+	}
+
+	return NewBlobStorageClient(ctx, string(storageAccountName), string(storageAccountKey), storageDomain, options...)
 }
 
 // DeleteObjectsWithPrefix deletes the blob objects with the specific <prefix> from <container>.
@@ -138,3 +210,73 @@ func (c *BlobStorageClient) DeleteContainerIfExists(ctx context.Context, contain
 	}
 	return err
 }
+
+// ListBlobs returns the names of all blobs in <container>.
+func (c *BlobStorageClient) ListBlobs(ctx context.Context, container string) ([]string, error) {
+	var names []string
+	pager := c.client.NewListBlobsFlatPager(container, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			names = append(names, *item.Name)
+		}
+	}
+	return names, nil
+}
+
+// BlobSASURL returns a URL with a SAS token which grants read access to the blob <blobName> in <container> until the
+// given expiry time. The client must use the key of the storage account.
+func (c *BlobStorageClient) BlobSASURL(container, blobName string, expiry time.Time) (string, error) {
+	return c.client.ServiceClient().NewContainerClient(container).NewBlobClient(blobName).GetSASURL(sas.BlobPermissions{Read: true}, expiry, nil)
+}
+
+// CopyContainer starts server-side copies of all blobs in <sourceContainer> of the <source> blob storage into
+// <container>. Blobs which already exist in <container> are never overwritten, only failed or aborted copies are
+// restarted. It returns the number of copies which are still pending.
+func (c *BlobStorageClient) CopyContainer(ctx context.Context, source BlobStorage, sourceContainer, container string) (int, error) {
+	blobNames, err := source.ListBlobs(ctx, sourceContainer)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		pending         int
+		containerClient = c.client.ServiceClient().NewContainerClient(container)
+	)
+	for _, blobName := range blobNames {
+		blobClient := containerClient.NewBlobClient(blobName)
+
+		// The copy must not overwrite blobs which are created in the meantime.
+		accessConditions := &blob.AccessConditions{ModifiedAccessConditions: &blob.ModifiedAccessConditions{IfNoneMatch: ptr.To(azcore.ETagAny)}}
+		props, err := blobClient.GetProperties(ctx, nil)
+		switch {
+		case bloberror.HasCode(err, bloberror.BlobNotFound):
+		case err != nil:
+			return 0, err
+		case props.CopyStatus != nil && *props.CopyStatus == blob.CopyStatusTypePending:
+			pending++
+			continue
+		case props.CopyStatus != nil && (*props.CopyStatus == blob.CopyStatusTypeFailed || *props.CopyStatus == blob.CopyStatusTypeAborted):
+			accessConditions.ModifiedAccessConditions = &blob.ModifiedAccessConditions{IfMatch: props.ETag}
+		default:
+			continue
+		}
+
+		// The source container may be immutable, hence the blobs are read via a short-lived SAS URL instead of moving them.
+		sourceURL, err := source.BlobSASURL(sourceContainer, blobName, time.Now().Add(copySourceSASExpiry))
+		if err != nil {
+			return 0, fmt.Errorf("failed to create SAS URL for blob %s: %w", blobName, err)
+		}
+		if _, err := blobClient.StartCopyFromURL(ctx, sourceURL, &blob.StartCopyFromURLOptions{AccessConditions: accessConditions}); err != nil {
+			if bloberror.HasCode(err, bloberror.BlobAlreadyExists, bloberror.ConditionNotMet) {
+				continue
+			}
+			return 0, fmt.Errorf("failed to start copy of blob %s: %w", blobName, err)
+		}
+		pending++
+	}
+	return pending, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+var _ = Describe("Storage", func() {
+	Describe("#NewContainerSASToken", func() {
+		var (
+			storageAccountKey = base64.StdEncoding.EncodeToString([]byte("key"))
+			now               = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		)
+
+		It("should create a container-scoped SAS token with a backdated start time", func() {
+			token, err := NewContainerSASToken("account", storageAccountKey, "bucket", now, now.Add(24*time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+
+			query, err := url.ParseQuery(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(query.Get("sr")).To(Equal("c"))
+			Expect(query.Get("sp")).To(Equal("racwdl"))
+			Expect(query.Get("spr")).To(Equal("https"))
+			Expect(query.Get("st")).To(Equal(now.Add(-SASClockSkew).Format(time.RFC3339)))
+			Expect(query.Get("se")).To(Equal(now.Add(24 * time.Hour).Format(time.RFC3339)))
+			Expect(query.Get("sig")).NotTo(BeEmpty())
+		})
+
+		It("should fail for an invalid storage account key", func() {
+			_, err := NewContainerSASToken("account", "not-base64!", "bucket", now, now.Add(time.Hour))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#NewBlobStorageClientWithSASToken", func() {
+		It("should send the requests with the given transport", func() {
+			transport := &fakeTransport{}
+			client, err := NewBlobStorageClientWithSASToken(context.Background(), "account", "sv=2022-11-02&sig=foo", "blob.core.windows.net", WithBlobTransport(transport))
+			Expect(err).NotTo(HaveOccurred())
+
+			// the response of the fake transport is not a valid response of the blob storage service, only the request
+			// is of interest.
+			_ = client.CreateContainerIfNotExists(context.Background(), "bucket")
+
+			Expect(transport.requests).NotTo(BeEmpty())
+			Expect(transport.requests[0].URL.Host).To(Equal("account.blob.core.windows.net"))
+			Expect(transport.requests[0].URL.Path).To(Equal("/bucket"))
+		})
+	})
+
+	Describe("#CopyContainer", func() {
+		var (
+			ctx       = context.Background()
+			ctrl      *gomock.Controller
+			transport *responderTransport
+			source    *mockazureclient.MockBlobStorage
+			client    *BlobStorageClient
+
+			blobResponse = func(statusCode int, header http.Header) *http.Response {
+				header.Set("Content-Type", "application/xml")
+				return &http.Response{StatusCode: statusCode, Header: header, Body: io.NopCloser(strings.NewReader(""))}
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			transport = &responderTransport{responses: map[string]*http.Response{}}
+			source = mockazureclient.NewMockBlobStorage(ctrl)
+
+			var err error
+			client, err = NewBlobStorageClient(ctx, "account", base64.StdEncoding.EncodeToString([]byte("key")), "blob.core.windows.net", WithBlobTransport(transport))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should only copy blobs which do not exist and restart failed copies", func() {
+			source.EXPECT().ListBlobs(ctx, "source").Return([]string{"new", "existing", "pending", "failed"}, nil)
+			source.EXPECT().BlobSASURL("source", "new", gomock.Any()).Return("https://source.blob.core.windows.net/source/new?sig=foo", nil)
+			source.EXPECT().BlobSASURL("source", "failed", gomock.Any()).Return("https://source.blob.core.windows.net/source/failed?sig=foo", nil)
+
+			transport.responses["HEAD /restore/new"] = blobResponse(http.StatusNotFound, http.Header{"X-Ms-Error-Code": []string{"BlobNotFound"}})
+			transport.responses["HEAD /restore/existing"] = blobResponse(http.StatusOK, http.Header{})
+			transport.responses["HEAD /restore/pending"] = blobResponse(http.StatusOK, http.Header{"X-Ms-Copy-Status": []string{"pending"}})
+			transport.responses["HEAD /restore/failed"] = blobResponse(http.StatusOK, http.Header{"X-Ms-Copy-Status": []string{"failed"}, "Etag": []string{`"etag"`}})
+			transport.responses["PUT /restore/new"] = blobResponse(http.StatusAccepted, http.Header{"X-Ms-Copy-Status": []string{"pending"}})
+			transport.responses["PUT /restore/failed"] = blobResponse(http.StatusAccepted, http.Header{"X-Ms-Copy-Status": []string{"pending"}})
+
+			Expect(client.CopyContainer(ctx, source, "source", "restore")).To(Equal(3))
+
+			var copies []*http.Request
+			for _, req := range transport.requests {
+				if req.Method == http.MethodPut {
+					copies = append(copies, req)
+				}
+			}
+			Expect(copies).To(HaveLen(2))
+			Expect(copies[0].URL.Path).To(Equal("/restore/new"))
+			Expect(copies[0].Header.Get("If-None-Match")).To(Equal("*"))
+			Expect(copies[0].Header["x-ms-copy-source"]).To(ConsistOf("https://source.blob.core.windows.net/source/new?sig=foo"))
+			Expect(copies[1].URL.Path).To(Equal("/restore/failed"))
+			Expect(copies[1].Header.Get("If-Match")).To(Equal(`"etag"`))
+		})
+
+		It("should skip blobs which are created concurrently", func() {
+			source.EXPECT().ListBlobs(ctx, "source").Return([]string{"new"}, nil)
+			source.EXPECT().BlobSASURL("source", "new", gomock.Any()).Return("https://source.blob.core.windows.net/source/new?sig=foo", nil)
+
+			transport.responses["HEAD /restore/new"] = blobResponse(http.StatusNotFound, http.Header{"X-Ms-Error-Code": []string{"BlobNotFound"}})
+			transport.responses["PUT /restore/new"] = blobResponse(http.StatusConflict, http.Header{"X-Ms-Error-Code": []string{"BlobAlreadyExists"}})
+
+			Expect(client.CopyContainer(ctx, source, "source", "restore")).To(Equal(0))
+		})
+
+		It("should return errors of the source", func() {
+			source.EXPECT().ListBlobs(ctx, "source").Return(nil, fmt.Errorf("not found"))
+
+			_, err := client.CopyContainer(ctx, source, "source", "restore")
+			Expect(err).To(MatchError("not found"))
+		})
+	})
+})
@@ -29,18 +29,49 @@ func NewStorageAccountClient(auth *internal.ClientAuth, tc azcore.TokenCredentia
 	return &StorageAccountClient{client}, err
 }
 
-// CreateStorageAccount creates a storage account.
-func (c *StorageAccountClient) CreateStorageAccount(ctx context.Context, resourceGroupName, storageAccountName, region string) error {
+// Get gets a storage account. It returns nil if the storage account does not exist.
+func (c *StorageAccountClient) Get(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.Account, error) {
+	res, err := c.client.GetProperties(ctx, resourceGroupName, storageAccountName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.Account, nil
+}
+
+// StorageAccountSecurity contains the security settings of a storage account.
+type StorageAccountSecurity struct {
+	// RequireInfrastructureEncryption enables a second layer of encryption of the data at rest. It can only be set when
+	// the storage account is created.
+	RequireInfrastructureEncryption bool
+	// MinimumTLSVersion is the minimum TLS version of requests to the storage account. Defaults to TLS1_2.
+	MinimumTLSVersion armstorage.MinimumTLSVersion
+}
+
+// CreateStorageAccount creates a storage account with the given SKU and security settings.
+func (c *StorageAccountClient) CreateStorageAccount(ctx context.Context, resourceGroupName, storageAccountName, region string, sku armstorage.SKUName, security StorageAccountSecurity) error {
+	minimumTLSVersion := security.MinimumTLSVersion
+	if minimumTLSVersion == "" {
+		minimumTLSVersion = armstorage.MinimumTLSVersionTLS12
+	}
+
+	properties := &armstorage.AccountPropertiesCreateParameters{
+		AccessTier:             ptr.To(armstorage.AccessTierCool),
+		EnableHTTPSTrafficOnly: ptr.To(true),
+		AllowBlobPublicAccess:  ptr.To(false),
+		MinimumTLSVersion:      ptr.To(minimumTLSVersion),
+	}
+	if security.RequireInfrastructureEncryption {
+		properties.Encryption = &armstorage.Encryption{
+			KeySource:                       ptr.To(armstorage.KeySourceMicrosoftStorage),
+			RequireInfrastructureEncryption: ptr.To(true),
+		}
+	}
+
 	poller, err := c.client.BeginCreate(ctx, resourceGroupName, storageAccountName, armstorage.AccountCreateParameters{
-		Kind:     ptr.To(armstorage.KindStorageV2),
-		Location: &region,
-		SKU:      &armstorage.SKU{Name: ptr.To(armstorage.SKUNameStandardZRS)},
-		Properties: &armstorage.AccountPropertiesCreateParameters{
-			AccessTier:             ptr.To(armstorage.AccessTierCool),
-			EnableHTTPSTrafficOnly: ptr.To(true),
-			AllowBlobPublicAccess:  ptr.To(false),
-			MinimumTLSVersion:      ptr.To(armstorage.MinimumTLSVersionTLS12),
-		},
+		Kind:       ptr.To(armstorage.KindStorageV2),
+		Location:   &region,
+		SKU:        &armstorage.SKU{Name: ptr.To(sku)},
+		Properties: properties,
 	}, nil)
 
 	if err != nil {
@@ -52,6 +83,26 @@ func (c *StorageAccountClient) CreateStorageAccount(ctx context.Context, resourc
 	return err
 }
 
+// UpdateNetworkRules replaces the network rules of a storage account.
+func (c *StorageAccountClient) UpdateNetworkRules(ctx context.Context, resourceGroupName, storageAccountName string, rules *armstorage.NetworkRuleSet) error {
+	_, err := c.client.Update(ctx, resourceGroupName, storageAccountName, armstorage.AccountUpdateParameters{
+		Properties: &armstorage.AccountPropertiesUpdateParameters{
+			NetworkRuleSet: rules,
+		},
+	}, nil)
+	return err
+}
+
+// UpdateMinimumTLSVersion sets the minimum TLS version of requests to a storage account.
+func (c *StorageAccountClient) UpdateMinimumTLSVersion(ctx context.Context, resourceGroupName, storageAccountName string, version armstorage.MinimumTLSVersion) error {
+	_, err := c.client.Update(ctx, resourceGroupName, storageAccountName, armstorage.AccountUpdateParameters{
+		Properties: &armstorage.AccountPropertiesUpdateParameters{
+			MinimumTLSVersion: ptr.To(version),
+		},
+	}, nil)
+	return err
+}
+
 // ListStorageAccountKey lists the first key of a storage account.
 func (c *StorageAccountClient) ListStorageAccountKey(ctx context.Context, resourceGroupName, storageAccountName string) (string, error) {
 	response, err := c.client.ListKeys(ctx, resourceGroupName, storageAccountName, &armstorage.AccountsClientListKeysOptions{
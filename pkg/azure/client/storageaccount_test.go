@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("StorageAccountClient", func() {
+	const accountPath = "/subscriptions/subscription/resourceGroups/backup/providers/Microsoft.Storage/storageAccounts/account"
+
+	var (
+		ctx       = context.Background()
+		transport *responderTransport
+		client    *StorageAccountClient
+	)
+
+	BeforeEach(func() {
+		transport = &responderTransport{responses: map[string]*http.Response{}}
+
+		var err error
+		client, err = NewStorageAccountClient(&internal.ClientAuth{SubscriptionID: "subscription"}, &azfake.TokenCredential{}, withTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#UpdateMinimumTLSVersion", func() {
+		It("should only update the minimum TLS version", func() {
+			transport.responses["PATCH "+accountPath] = jsonResponse(http.StatusOK, `{"name": "account", "properties": {"minimumTlsVersion": "TLS1_3"}}`)
+
+			Expect(client.UpdateMinimumTLSVersion(ctx, "backup", "account", armstorage.MinimumTLSVersionTLS13)).To(Succeed())
+
+			Expect(transport.requests).To(HaveLen(1))
+			body, err := io.ReadAll(transport.requests[0].Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"properties": {"minimumTlsVersion": "TLS1_3"}}`))
+		})
+
+		It("should return errors", func() {
+			transport.responses["PATCH "+accountPath] = jsonResponse(http.StatusForbidden, `{"error":{"code":"AuthorizationFailed","message":"forbidden"}}`)
+
+			Expect(client.UpdateMinimumTLSVersion(ctx, "backup", "account", armstorage.MinimumTLSVersionTLS13)).To(MatchError(ContainSubstring("AuthorizationFailed")))
+		})
+	})
+})
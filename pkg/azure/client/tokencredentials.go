@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/gardener/gardener/pkg/utils"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var (
+	tokenCredentialsMutex sync.Mutex
+	tokenCredentials      = map[tokenCredentialKey]*cachedTokenCredential{}
+)
+
+type tokenCredentialKey struct {
+	tenantID      string
+	clientID      string
+	authorityHost string
+}
+
+type cachedTokenCredential struct {
+	secretHash string
+	credential azcore.TokenCredential
+	lastUsed   time.Time
+}
+
+// CachedTokenCredential returns the token credential for the given client credentials. The credentials are shared by
+// all factories of the process, so that the access tokens which are cached by a credential are reused across
+// reconciliations instead of being acquired from Microsoft Entra ID for every factory. A cached credential is replaced
+// once the client secret changed, e.g. after the secret was rotated. Credentials for different authority hosts, e.g. of
+// sovereign clouds or Azure Stack Hub instances, are not shared as they acquire their tokens from different endpoints.
+func CachedTokenCredential(auth *internal.ClientAuth, cloudConfiguration cloud.Configuration) (azcore.TokenCredential, error) {
+	var (
+		key        = tokenCredentialKey{tenantID: auth.TenantID, clientID: auth.ClientID, authorityHost: cloudConfiguration.ActiveDirectoryAuthorityHost}
+		secretHash = utils.ComputeSHA256Hex([]byte(auth.ClientSecret))
+		now        = time.Now()
+	)
+
+	tokenCredentialsMutex.Lock()
+	defer tokenCredentialsMutex.Unlock()
+
+	for k, cached := range tokenCredentials {
+		if now.Sub(cached.lastUsed) > cacheIdleTimeout {
+			delete(tokenCredentials, k)
+		}
+	}
+
+	if cached, ok := tokenCredentials[key]; ok && cached.secretHash == secretHash {
+		cached.lastUsed = now
+		return cached.credential, nil
+	}
+
+	credential, err := auth.GetAzClientCredentials(cloudConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	tokenCredentials[key] = &cachedTokenCredential{secretHash: secretHash, credential: credential, lastUsed: now}
+	return credential, nil
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("TokenCredentials", func() {
+	var auth *internal.ClientAuth
+
+	BeforeEach(func() {
+		auth = &internal.ClientAuth{
+			SubscriptionID: "subscription",
+			TenantID:       "tenant",
+			ClientID:       "client-" + CurrentSpecReport().LeafNodeText,
+			ClientSecret:   "secret",
+		}
+	})
+
+	Describe("#CachedTokenCredential", func() {
+		It("should share the credential for the same credentials", func() {
+			credential, err := CachedTokenCredential(auth, cloud.AzurePublic)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherAuth := *auth
+			otherAuth.SubscriptionID = "other-subscription"
+			Expect(CachedTokenCredential(&otherAuth, cloud.AzurePublic)).To(BeIdenticalTo(credential))
+		})
+
+		It("should replace the credential if the client secret changed", func() {
+			credential, err := CachedTokenCredential(auth, cloud.AzurePublic)
+			Expect(err).NotTo(HaveOccurred())
+
+			auth.ClientSecret = "rotated-secret"
+			Expect(CachedTokenCredential(auth, cloud.AzurePublic)).NotTo(BeIdenticalTo(credential))
+		})
+
+		It("should not share the credential with other clients", func() {
+			credential, err := CachedTokenCredential(auth, cloud.AzurePublic)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherAuth := *auth
+			otherAuth.ClientID = "other-client"
+			Expect(CachedTokenCredential(&otherAuth, cloud.AzurePublic)).NotTo(BeIdenticalTo(credential))
+		})
+
+		It("should not share the credential with other authority hosts", func() {
+			credential, err := CachedTokenCredential(auth, cloud.AzurePublic)
+			Expect(err).NotTo(HaveOccurred())
+
+			azureStack := cloud.Configuration{ActiveDirectoryAuthorityHost: "https://login.azurestack.example/"}
+			otherCredential, err := CachedTokenCredential(auth, azureStack)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherCredential).NotTo(BeIdenticalTo(credential))
+			Expect(CachedTokenCredential(auth, azureStack)).To(BeIdenticalTo(otherCredential))
+		})
+
+		It("should not share the credential after the caches were reset", func() {
+			credential, err := CachedTokenCredential(auth, cloud.AzurePublic)
+			Expect(err).NotTo(HaveOccurred())
+
+			ResetClientCaches()
+			Expect(CachedTokenCredential(auth, cloud.AzurePublic)).NotTo(BeIdenticalTo(credential))
+		})
+	})
+})
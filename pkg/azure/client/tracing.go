@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/go-logr/logr"
+)
+
+const redactedValue = "REDACTED"
+
+// tracedHeaders are the headers of requests and responses which are logged unredacted. They identify the requests in
+// Azure, e.g. for support cases, and explain throttling and retries, but never contain credentials.
+var tracedHeaders = map[string]struct{}{
+	"Content-Type":                {},
+	"Content-Length":              {},
+	"Date":                        {},
+	"Location":                    {},
+	"Azure-Asyncoperation":        {},
+	"Retry-After":                 {},
+	"User-Agent":                  {},
+	"X-Ms-Client-Request-Id":      {},
+	"X-Ms-Correlation-Request-Id": {},
+	"X-Ms-Request-Id":             {},
+	"X-Ms-Routing-Request-Id":     {},
+	"X-Ms-Failure-Cause":          {},
+	"X-Ms-Ratelimit-Remaining-Subscription-Reads":  {},
+	"X-Ms-Ratelimit-Remaining-Subscription-Writes": {},
+	"X-Ms-Ratelimit-Remaining-Resource":            {},
+}
+
+// tracedQueryParameters are the query parameters of request URLs which are logged unredacted. Other parameters, e.g.
+// the signatures of SAS tokens, are redacted.
+var tracedQueryParameters = map[string]struct{}{
+	"api-version": {},
+	"$filter":     {},
+	"$expand":     {},
+	"$top":        {},
+}
+
+// WithRequestTracing is the option that logs every request of the clients created by the factory to Azure with the
+// given logger, including the identifiers of the request in Azure, the duration and the status code of the response.
+// Credentials in the headers and the query of the requests are redacted. As a per-retry policy, every attempt of a
+// request is logged.
+func WithRequestTracing(log logr.Logger) AzureFactoryOption {
+	return func(f *azureFactory) {
+		f.clientOpts.PerRetryPolicies = append(f.clientOpts.PerRetryPolicies, &requestTracingPolicy{log: log})
+	}
+}
+
+type requestTracingPolicy struct {
+	log logr.Logger
+}
+
+// Do implements policy.Policy.
+func (p *requestTracingPolicy) Do(req *policy.Request) (*http.Response, error) {
+	raw := req.Raw()
+	start := time.Now()
+	resp, err := req.Next()
+
+	keysAndValues := []any{
+		"method", raw.Method,
+		"url", sanitizeURL(raw.URL),
+		"duration", time.Since(start).String(),
+		"clientRequestID", raw.Header.Get("X-Ms-Client-Request-Id"),
+		"requestHeaders", sanitizeHeaders(raw.Header),
+	}
+	if err != nil {
+		p.log.Info("Azure request failed", append(keysAndValues, "error", err.Error())...)
+		return resp, err
+	}
+
+	p.log.Info("Azure request", append(keysAndValues,
+		"statusCode", resp.StatusCode,
+		"correlationRequestID", resp.Header.Get("X-Ms-Correlation-Request-Id"),
+		"requestID", resp.Header.Get("X-Ms-Request-Id"),
+		"responseHeaders", sanitizeHeaders(resp.Header),
+	)...)
+	return resp, nil
+}
+
+func sanitizeHeaders(header http.Header) map[string]string {
+	sanitized := make(map[string]string, len(header))
+	for name, values := range header {
+		name = http.CanonicalHeaderKey(name)
+		if _, ok := tracedHeaders[name]; !ok || len(values) == 0 {
+			sanitized[name] = redactedValue
+			continue
+		}
+		sanitized[name] = values[0]
+	}
+	return sanitized
+}
+
+func sanitizeURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	sanitized := *u
+	sanitized.User = nil
+	query := sanitized.Query()
+	for name := range query {
+		if _, ok := tracedQueryParameters[name]; !ok {
+			query.Set(name, redactedValue)
+		}
+	}
+	sanitized.RawQuery = query.Encode()
+	return sanitized.String()
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+type correlationTransport struct{}
+
+func (correlationTransport) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type":                []string{"application/json"},
+			"X-Ms-Correlation-Request-Id": []string{"correlation-id"},
+			"Set-Cookie":                  []string{"session"},
+		},
+		Body:    io.NopCloser(strings.NewReader(`{"name":"foo","location":"westeurope"}`)),
+		Request: req,
+	}, nil
+}
+
+var _ = Describe("RequestTracing", func() {
+	It("should log the requests with sanitized headers", func() {
+		var lines []string
+		log := funcr.New(func(prefix, args string) { lines = append(lines, args) }, funcr.Options{})
+
+		factory, err := NewAzureClientFactory(&internal.ClientAuth{SubscriptionID: "subscription", TenantID: "tenant", ClientID: "client", ClientSecret: "secret"},
+			WithTransport(correlationTransport{}), WithTokenCredential(&azfake.TokenCredential{}), WithRequestTracing(log))
+		Expect(err).NotTo(HaveOccurred())
+		groups, err := factory.Group()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = groups.Get(context.Background(), "foo")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(lines).To(ConsistOf(And(
+			ContainSubstring(`"msg"="Azure request"`),
+			ContainSubstring(`"method"="GET"`),
+			ContainSubstring(`/subscriptions/subscription/resourcegroups/foo?api-version=`),
+			ContainSubstring(`"statusCode"=200`),
+			ContainSubstring(`"correlationRequestID"="correlation-id"`),
+			ContainSubstring(`"Authorization"="REDACTED"`),
+			ContainSubstring(`"Set-Cookie"="REDACTED"`),
+			Not(ContainSubstring("Bearer")),
+		)))
+	})
+})
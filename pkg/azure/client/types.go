@@ -6,19 +6,27 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armsubscriptions"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 )
 
 // Factory represents a factory to produce clients for various Azure services.
 type Factory interface {
 	StorageAccount() (StorageAccount, error)
+	BlobInventoryPolicies() (BlobInventoryPolicies, error)
+	ManagementPolicies() (ManagementPolicies, error)
+	BlobContainers() (BlobContainers, error)
 	Vmss() (Vmss, error)
 	DNSZone() (DNSZone, error)
 	DNSRecordSet() (DNSRecordSet, error)
+	PrivateDNSRecordSet() (PrivateDNSRecordSet, error)
 	VirtualMachine() (VirtualMachine, error)
 	NetworkInterface() (NetworkInterface, error)
 	Disk() (Disk, error)
@@ -32,8 +40,18 @@ type Factory interface {
 	RouteTables() (RouteTables, error)
 	NatGateway() (NatGateway, error)
 	AvailabilitySet() (AvailabilitySet, error)
-	ManagedUserIdentity() (ManagedUserIdentity, error)
+	ManagedUserIdentity(options ...AzureFactoryOption) (ManagedUserIdentity, error)
 	VirtualMachineImages() (VirtualMachineImages, error)
+	GalleryImageVersions() (GalleryImageVersions, error)
+	ManagementLocks() (ManagementLocks, error)
+	DiagnosticSettings() (DiagnosticSettings, error)
+	MaintenanceAssignments() (MaintenanceAssignments, error)
+	Locations() (Locations, error)
+	NetworkWatcher() (NetworkWatcher, error)
+	AzureFirewall() (AzureFirewall, error)
+	FirewallPolicy() (FirewallPolicy, error)
+	FirewallPolicyRuleCollectionGroup() (FirewallPolicyRuleCollectionGroup, error)
+	ActivityLogs() (ActivityLogs, error)
 }
 
 // ResourceGroup represents an Azure ResourceGroup k8sClient.
@@ -66,9 +84,30 @@ type RouteTables interface {
 	GetFunc[armnetwork.RouteTable]
 }
 
+// AzureFirewall is a k8sClient for the Azure Firewall service.
+type AzureFirewall interface {
+	CreateOrUpdateFunc[armnetwork.AzureFirewall]
+	DeleteFunc[armnetwork.AzureFirewall]
+	GetFunc[armnetwork.AzureFirewall]
+}
+
+// FirewallPolicy is a k8sClient for the Azure Firewall Policy service.
+type FirewallPolicy interface {
+	CreateOrUpdateFunc[armnetwork.FirewallPolicy]
+	DeleteFunc[armnetwork.FirewallPolicy]
+	GetFunc[armnetwork.FirewallPolicy]
+}
+
+// FirewallPolicyRuleCollectionGroup is a k8sClient for the rule collection groups of Azure Firewall Policies.
+type FirewallPolicyRuleCollectionGroup interface {
+	SubResourceCreateOrUpdateFunc[armnetwork.FirewallPolicyRuleCollectionGroup]
+	SubResourceGetFunc[armnetwork.FirewallPolicyRuleCollectionGroup]
+}
+
 // ManagedUserIdentity is a k8sClient for the Azure Managed User Identity service.
 type ManagedUserIdentity interface {
 	GetFunc[armmsi.UserAssignedIdentitiesClientGetResponse]
+	CreateOrUpdateFunc[armmsi.Identity]
 }
 
 // Vmss represents an Azure virtual machine scale set k8sClient.
@@ -106,6 +145,8 @@ type NetworkInterface interface {
 	GetFunc[armnetwork.Interface]
 	CreateOrUpdateFunc[armnetwork.Interface]
 	DeleteFunc[armnetwork.Interface]
+	GetEffectiveRouteTable(ctx context.Context, resourceGroupName, name string) ([]*armnetwork.EffectiveRoute, error)
+	ListEffectiveNetworkSecurityGroups(ctx context.Context, resourceGroupName, name string) ([]*armnetwork.EffectiveNetworkSecurityGroup, error)
 }
 
 // Disk represents an Azure Disk k8sClient.
@@ -123,11 +164,24 @@ type Subnet interface {
 	SubResourceDeleteFunc[armnetwork.Subnet]
 }
 
+// NetworkWatcher represents an Azure Network Watcher k8sClient.
+type NetworkWatcher interface {
+	ListAll(ctx context.Context) ([]*armnetwork.Watcher, error)
+	GetFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) (*armnetwork.FlowLog, error)
+	CreateOrUpdateFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string, parameters armnetwork.FlowLog) (*armnetwork.FlowLog, error)
+	DeleteFlowLog(ctx context.Context, resourceGroupName, networkWatcherName, flowLogName string) error
+}
+
 // LoadBalancer represents an Azure LoadBalancer k8sClient.
 type LoadBalancer interface {
+	CreateOrUpdateFunc[armnetwork.LoadBalancer]
 	GetFunc[armnetwork.LoadBalancer]
 	ListFunc[armnetwork.LoadBalancer]
 	DeleteFunc[armnetwork.LoadBalancer]
+	// GetFrontendConfigs returns the frontend IP configurations of a load balancer.
+	GetFrontendConfigs(ctx context.Context, resourceGroupName, loadBalancerName string) ([]*armnetwork.FrontendIPConfiguration, error)
+	// ListRules returns the load balancing rules of a load balancer.
+	ListRules(ctx context.Context, resourceGroupName, loadBalancerName string) ([]*armnetwork.LoadBalancingRule, error)
 }
 
 // VirtualNetwork represents an Azure Virtual Network k8sClient.
@@ -139,34 +193,108 @@ type VirtualNetwork interface {
 
 // StorageAccount represents an Azure storage account k8sClient.
 type StorageAccount interface {
-	CreateStorageAccount(context.Context, string, string, string) error
+	Get(context.Context, string, string) (*armstorage.Account, error)
+	CreateStorageAccount(context.Context, string, string, string, armstorage.SKUName, StorageAccountSecurity) error
 	ListStorageAccountKey(context.Context, string, string) (string, error)
+	UpdateNetworkRules(context.Context, string, string, *armstorage.NetworkRuleSet) error
+	UpdateMinimumTLSVersion(context.Context, string, string, armstorage.MinimumTLSVersion) error
+}
+
+// BlobInventoryPolicies represents an Azure storage blob inventory policies k8sClient.
+type BlobInventoryPolicies interface {
+	Get(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.BlobInventoryPolicy, error)
+	CreateOrUpdate(ctx context.Context, resourceGroupName, storageAccountName string, parameters armstorage.BlobInventoryPolicy) (*armstorage.BlobInventoryPolicy, error)
+	Delete(ctx context.Context, resourceGroupName, storageAccountName string) error
+}
+
+// ManagementPolicies represents an Azure storage lifecycle management policies k8sClient.
+type ManagementPolicies interface {
+	Get(ctx context.Context, resourceGroupName, storageAccountName string) (*armstorage.ManagementPolicy, error)
+	CreateOrUpdate(ctx context.Context, resourceGroupName, storageAccountName string, parameters armstorage.ManagementPolicy) (*armstorage.ManagementPolicy, error)
+	Delete(ctx context.Context, resourceGroupName, storageAccountName string) error
+}
+
+// BlobContainers represents an Azure storage blob containers k8sClient.
+type BlobContainers interface {
+	Get(ctx context.Context, resourceGroupName, storageAccountName, containerName string) (*armstorage.BlobContainer, error)
+	SetLegalHold(ctx context.Context, resourceGroupName, storageAccountName, containerName string, tags []string) error
+	ClearLegalHold(ctx context.Context, resourceGroupName, storageAccountName, containerName string, tags []string) error
 }
 
 // DNSZone represents an Azure DNS zone k8sClient.
 type DNSZone interface {
 	List(context.Context) (map[string]string, error)
+	ListByResourceGroup(context.Context, string) (map[string]string, error)
 }
 
 // DNSRecordSet represents an Azure DNS recordset k8sClient.
 type DNSRecordSet interface {
+	Get(context.Context, string, string, string) (*armdns.RecordSet, error)
 	CreateOrUpdate(context.Context, string, string, string, []string, int64) error
+	CreateOrUpdateAlias(context.Context, string, string, string, string, int64) error
 	Delete(context.Context, string, string, string) error
 }
 
+// PrivateDNSRecordSet represents an Azure private DNS recordset k8sClient.
+type PrivateDNSRecordSet interface {
+	CreateOrUpdateA(ctx context.Context, resourceGroupName, zoneName, name string, ipAddresses []string, ttl int64) error
+	DeleteA(ctx context.Context, resourceGroupName, zoneName, name string) error
+}
+
 // VirtualMachineImages represents an Azure Virtual Machine Image k8sClient.
 type VirtualMachineImages interface {
 	ListSkus(ctx context.Context, location string, publisherName string, offer string) (*armcompute.VirtualMachineImagesClientListSKUsResponse, error)
 }
 
+// GalleryImageVersions represents an Azure Compute Gallery image versions k8sClient.
+type GalleryImageVersions interface {
+	ListCommunityGalleryImageVersions(ctx context.Context, location, publicGalleryName, galleryImageName string) ([]*armcompute.CommunityGalleryImageVersion, error)
+	ListSharedGalleryImageVersions(ctx context.Context, location, galleryUniqueName, galleryImageName string) ([]*armcompute.SharedGalleryImageVersion, error)
+}
+
 // BlobStorage represents an Azure blob storage k8sClient.
 type BlobStorage interface {
 	DeleteObjectsWithPrefix(context.Context, string, string) error
 	CreateContainerIfNotExists(context.Context, string) error
 	DeleteContainerIfExists(context.Context, string) error
+	ListBlobs(context.Context, string) ([]string, error)
+	BlobSASURL(string, string, time.Time) (string, error)
+	CopyContainer(context.Context, BlobStorage, string, string) (int, error)
+}
+
+// ManagementLocks represents an Azure management locks k8sClient.
+type ManagementLocks interface {
+	ListAtResourceGroupLevel(ctx context.Context, resourceGroupName string) ([]*ManagementLockObject, error)
+	CreateOrUpdateByScope(ctx context.Context, scope, lockName string, lock ManagementLockObject) (*ManagementLockObject, error)
+	DeleteByID(ctx context.Context, lockID string) error
+}
+
+// DiagnosticSettings represents an Azure Monitor diagnostic settings k8sClient.
+type DiagnosticSettings interface {
+	Get(ctx context.Context, resourceID, name string) (*DiagnosticSettingsResource, error)
+	CreateOrUpdate(ctx context.Context, resourceID, name string, parameters DiagnosticSettingsResource) (*DiagnosticSettingsResource, error)
+	Delete(ctx context.Context, resourceID, name string) error
+}
+
+// MaintenanceAssignments represents an Azure maintenance configuration assignments k8sClient.
+type MaintenanceAssignments interface {
+	Get(ctx context.Context, resourceID, name string) (*MaintenanceAssignment, error)
+	CreateOrUpdate(ctx context.Context, resourceID, name string, parameters MaintenanceAssignment) (*MaintenanceAssignment, error)
+	Delete(ctx context.Context, resourceID, name string) error
+}
+
+// Locations represents an Azure subscription locations k8sClient.
+type Locations interface {
+	AvailabilityZoneMappings(ctx context.Context, location string) ([]*armsubscriptions.AvailabilityZoneMappings, error)
+}
+
+// ActivityLogs represents an Azure activity logs k8sClient.
+type ActivityLogs interface {
+	ListResourceGroupEvents(ctx context.Context, resourceGroupName string, from, to time.Time) ([]*ActivityLogEvent, error)
 }
 
 // Resource is an Azure resources client.
 type Resource interface {
 	ListByResourceGroup(ctx context.Context, resourceGroupName string, options *armresources.ClientListByResourceGroupOptions) ([]*armresources.GenericResourceExpanded, error)
+	GetByID(ctx context.Context, resourceID, apiVersion string) (*armresources.GenericResource, error)
 }
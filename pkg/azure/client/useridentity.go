@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
 )
 
@@ -27,6 +28,22 @@ func NewManagedUserIdentityClient(auth *internal.ClientAuth, tc azcore.TokenCred
 	return &ManagedUserIdentityClient{client}, err
 }
 
+// ManagedUserIdentityOptions returns the factory options to access the given identity if it resides in another
+// subscription or tenant than the one of the credentials.
+func ManagedUserIdentityOptions(identity *azure.IdentityConfig) []AzureFactoryOption {
+	var options []AzureFactoryOption
+	if identity == nil {
+		return options
+	}
+	if identity.TenantID != nil {
+		options = append(options, WithTenantID(*identity.TenantID))
+	}
+	if identity.SubscriptionID != nil {
+		options = append(options, WithSubscriptionID(*identity.SubscriptionID))
+	}
+	return options
+}
+
 // Get returns a Managed User Identity by name.
 func (m *ManagedUserIdentityClient) Get(ctx context.Context, resourceGroup, id string) (*armmsi.UserAssignedIdentitiesClientGetResponse, error) {
 	res, err := m.client.Get(ctx, resourceGroup, id, nil)
@@ -35,3 +52,12 @@ func (m *ManagedUserIdentityClient) Get(ctx context.Context, resourceGroup, id s
 	}
 	return &res, nil
 }
+
+// CreateOrUpdate creates or updates a Managed User Identity.
+func (m *ManagedUserIdentityClient) CreateOrUpdate(ctx context.Context, resourceGroup, name string, identity armmsi.Identity) (*armmsi.Identity, error) {
+	res, err := m.client.CreateOrUpdate(ctx, resourceGroup, name, identity, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.Identity, nil
+}
@@ -16,10 +16,29 @@ const (
 	ShootVmoUsageAnnotation = "alpha.azure.provider.extensions.gardener.cloud/vmo"
 	// ShootVmoMigrationAnnotation is an annotation assigned to the Shoot resource which indicates if the availability set shoot, should be migrated to a VMO shoot.
 	ShootVmoMigrationAnnotation = "migration.azure.provider.extensions.gardener.cloud/vmo"
+	// ShootVmoMigrationStrategyAnnotation is an annotation assigned to the Shoot resource which selects how the machines of
+	// an availability set shoot are rolled to VMOs during the migration.
+	ShootVmoMigrationStrategyAnnotation = "migration.azure.provider.extensions.gardener.cloud/vmo-strategy"
+	// VmoMigrationStrategyStaged is the value of the ShootVmoMigrationStrategyAnnotation to roll the worker pools to VMOs
+	// one after another instead of all at once.
+	VmoMigrationStrategyStaged = "staged"
+	// ShootZonalMigrationAnnotation is an annotation assigned to the Shoot resource which allows converting a non-zoned
+	// shoot to a zoned shoot.
+	ShootZonalMigrationAnnotation = "migration.azure.provider.extensions.gardener.cloud/zonal"
+
+	// ShootAdoptResourcesAnnotation is an annotation assigned to the Shoot resource which lets the infrastructure
+	// reconciler adopt already existing resources in the shoot's resource group, e.g. when migrating clusters created by
+	// other tooling.
+	ShootAdoptResourcesAnnotation = "azure.provider.extensions.gardener.cloud/adopt-resources"
+	// InfrastructureTraceRequestsAnnotation is an annotation assigned to the Infrastructure resource which lets the
+	// infrastructure controller log every request to Azure made while reconciling or deleting this infrastructure.
+	InfrastructureTraceRequestsAnnotation = "azure.provider.extensions.gardener.cloud/trace-requests"
 
 	// NetworkLayoutZoneMigrationAnnotation is used when migrating from a single subnet network layout to a multiple subnet network layout to indicate the zone that the existing subnet should be assigned to.
 	NetworkLayoutZoneMigrationAnnotation = "migration.azure.provider.extensions.gardener.cloud/zone"
 
+	// ACRCredentialProviderImageName is the name of the image containing the acr-credential-provider binary.
+	ACRCredentialProviderImageName = "acr-credential-provider"
 	// CloudControllerManagerImageName is the name of the cloud-controller-manager image.
 	CloudControllerManagerImageName = "cloud-controller-manager"
 	// CloudNodeManagerImageName is the name of the cloud-node-manager image.
@@ -79,6 +98,8 @@ const (
 	StorageKey = "storageKey"
 	// StorageDomain is a constant for the key in a backup secret that holds the domain for the Azure blob storage service.
 	StorageDomain = "domain"
+	// StorageSASToken is a constant for the key in a backup secret that holds a SAS token for the container of the backup bucket.
+	StorageSASToken = "storageSASToken" // #nosec G101 -- No credential.
 
 	// AzureBlobStorageDomain is the host name for azure blob storage service.
 	AzureBlobStorageDomain = "blob.core.windows.net"
@@ -138,6 +159,9 @@ const (
 	RemedyControllerName = "remedy-controller-azure"
 	// DisableRemedyControllerAnnotation disables the Azure remedy controller (enabled by default)
 	DisableRemedyControllerAnnotation = "azure.provider.extensions.gardener.cloud/disable-remedy-controller"
+	// TaintExternalCloudProvider is the key of the taint the kubelet registers nodes with when it runs with the external
+	// cloud provider. It is removed by the cloud-controller-manager once it initialized the node.
+	TaintExternalCloudProvider = "node.cloudprovider.kubernetes.io/uninitialized"
 	// ExtensionPurposeLabel is a label to define the purpose of a resource for the extension.
 	ExtensionPurposeLabel = "azure.provider.extensions.gardener.cloud/purpose"
 	// ExtensionPurposeServicePrincipalSecret is the label value for a Secret resource
@@ -155,6 +179,35 @@ const (
 	SeedAnnotationUseFlowValueNew = "new"
 	// AnnotationEnableVolumeAttributesClass is the annotation to use on shoots to enable VolumeAttributesClasses
 	AnnotationEnableVolumeAttributesClass = "azure.provider.extensions.gardener.cloud/enable-volume-attributes-class"
+	// BackupBucketRestoreSourceAnnotation is the annotation to use on backup buckets to restore the contents of an
+	// (immutable) container into the working container of the backup bucket. The value must have the format
+	// '<resource-group>/<storage-account>/<container>'. The annotation is removed once the restore has finished.
+	BackupBucketRestoreSourceAnnotation = "azure.provider.extensions.gardener.cloud/restore-source"
+	// InfrastructureRerunStepsAnnotation is the annotation to use on infrastructures to let the flow reconciler verify
+	// the inventory of the given comma-separated steps, e.g. `ensure subnets` or `EnsureSubnets`, against Azure on the
+	// next reconciliation. The annotation is removed once the reconciliation succeeded.
+	InfrastructureRerunStepsAnnotation = "azure.provider.extensions.gardener.cloud/rerun-step"
+	// SASTokenExpiryAnnotation is an annotation of the generated backup secret which contains the expiry time of its SAS
+	// token in RFC3339 format.
+	SASTokenExpiryAnnotation = "azure.provider.extensions.gardener.cloud/sas-token-expiry" // #nosec G101 -- No credential.
+	// AnnotationBastionZone is the annotation to use on shoots to place the bastion host of the shoot in the given
+	// availability zone. If not set, the bastion host is not pinned to a zone.
+	AnnotationBastionZone = "azure.provider.extensions.gardener.cloud/bastion-zone"
+	// AnnotationBastionPrivateOnly is the annotation to use on shoots to create the bastion host of the shoot without a
+	// public IP. The bastion host is then only reachable via private connectivity, e.g. ExpressRoute or VPN.
+	AnnotationBastionPrivateOnly = "azure.provider.extensions.gardener.cloud/bastion-private-only"
+	// ActivityLogCheckedUntilAnnotation is an annotation of infrastructures which contains the end of the period of the
+	// activity log which was already checked for out-of-band modifications in RFC3339 format.
+	ActivityLogCheckedUntilAnnotation = "azure.provider.extensions.gardener.cloud/activity-log-checked-until"
+	// MaintenanceConfigurationAnnotation is an annotation of machines which contains the resource ID of the maintenance
+	// configuration which was assigned to their virtual machine by the extension.
+	MaintenanceConfigurationAnnotation = "azure.provider.extensions.gardener.cloud/maintenance-configuration"
+	// ManagementLockNotesPrefix is the prefix of the notes of Azure management locks which were created by the extension.
+	// Such locks may be removed by the extension before the infrastructure is deleted.
+	ManagementLockNotesPrefix = "managed-by: gardener-extension-provider-azure"
+	// NodeConditionScheduledEvent is the node condition which is set by the scheduled events agent if a Scheduled Event
+	// is pending which redeploys, preempts or terminates the VM of the node.
+	NodeConditionScheduledEvent = "AzureScheduledEvent"
 
 	// CCMServiceTagKey is the service key applied for public IP tags.
 	CCMServiceTagKey = "k8s-azure-service"
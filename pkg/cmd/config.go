@@ -6,12 +6,21 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
 	healthcheckconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
 	"github.com/spf13/pflag"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	configloader "github.com/gardener/gardener-extension-provider-azure/pkg/apis/config/loader"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/resync"
 )
 
 // ConfigOptions are command line options that can be set for config.ControllerConfiguration.
@@ -85,6 +94,69 @@ func (c *Config) ApplyHealthCheckConfig(config *healthcheckconfig.HealthCheckCon
 	}
 }
 
+// ApplyRemedyControllerConfig applies the RemedyControllerConfig to the config
+func (c *Config) ApplyRemedyControllerConfig(remedyController *config.RemedyControllerConfig) {
+	if c.Config.RemedyController != nil {
+		*remedyController = *c.Config.RemedyController
+	}
+}
+
+// ApplyOrphanDetectionConfig applies the OrphanDetectionConfig to the config
+func (c *Config) ApplyOrphanDetectionConfig(orphanDetection *config.OrphanDetectionConfig) {
+	if c.Config.OrphanDetection != nil {
+		*orphanDetection = *c.Config.OrphanDetection
+	}
+}
+
+// ApplyControlPlaneExposureConfig applies the ControlPlaneExposureConfig to the config
+func (c *Config) ApplyControlPlaneExposureConfig(controlPlaneExposure *config.ControlPlaneExposureConfig) {
+	if c.Config.ControlPlaneExposure != nil {
+		*controlPlaneExposure = *c.Config.ControlPlaneExposure
+	}
+}
+
+// ApplyManagementLocksConfig applies the ManagementLocksConfig to the config
+func (c *Config) ApplyManagementLocksConfig(managementLocks *config.ManagementLocksConfig) {
+	if c.Config.ManagementLocks != nil {
+		*managementLocks = *c.Config.ManagementLocks
+	}
+}
+
+// ApplyDriftDetectionConfig applies the DriftDetectionConfig to the config
+func (c *Config) ApplyDriftDetectionConfig(driftDetection *config.DriftDetectionConfig) {
+	if c.Config.DriftDetection != nil {
+		*driftDetection = *c.Config.DriftDetection
+	}
+}
+
+// ApplyDNSRecordConfig applies the DNSRecordConfig to the config
+func (c *Config) ApplyDNSRecordConfig(dnsRecord *config.DNSRecordConfig) {
+	if c.Config.DNSRecord != nil {
+		*dnsRecord = *c.Config.DNSRecord
+	}
+}
+
+// ApplyMandatoryVMTags applies the MandatoryVMTags to the config
+func (c *Config) ApplyMandatoryVMTags(mandatoryVMTags *map[string]string) {
+	if c.Config.MandatoryVMTags != nil {
+		*mandatoryVMTags = c.Config.MandatoryVMTags
+	}
+}
+
+// ApplyImageVectorOverrides applies the ImageVectorOverrides to the config
+func (c *Config) ApplyImageVectorOverrides(imageVectorOverrides *[]config.ImageVectorOverride) {
+	if c.Config.ImageVectorOverrides != nil {
+		*imageVectorOverrides = c.Config.ImageVectorOverrides
+	}
+}
+
+// ApplyDisableProjectedTokenMount applies the DisableProjectedTokenMount setting to the config
+func (c *Config) ApplyDisableProjectedTokenMount(disableProjectedTokenMount *bool) {
+	if c.Config.DisableProjectedTokenMount != nil {
+		*disableProjectedTokenMount = *c.Config.DisableProjectedTokenMount
+	}
+}
+
 // SeedConfig is a completed configuration for the topology webhook.
 type SeedConfig struct {
 	Region   string
@@ -118,3 +190,80 @@ func (s *SeedConfigOptions) Complete() error {
 func (s *SeedConfigOptions) Completed() *SeedConfig {
 	return s.config
 }
+
+// fastFailoverLeaderElection contains the durations of the leader election of the FastFailover profile. The renew
+// deadline is the default one, so that the leader does not give up its leadership on short hiccups of the API server
+// of the seed. The lease is only slightly longer, so that a standby replica takes over soon after the leader crashed.
+var fastFailoverLeaderElection = struct {
+	leaseDuration, renewDeadline, retryPeriod time.Duration
+}{
+	leaseDuration: 12 * time.Second,
+	renewDeadline: 10 * time.Second,
+	retryPeriod:   2 * time.Second,
+}
+
+// ApplyLeaderElectionConfig applies the LeaderElectionConfig to the manager options
+func (c *Config) ApplyLeaderElectionConfig(opts *manager.Options) error {
+	leaderElection := c.Config.LeaderElection
+	if leaderElection == nil {
+		return nil
+	}
+
+	switch profile := ptr.Deref(leaderElection.Profile, config.LeaderElectionProfileDefault); profile {
+	case config.LeaderElectionProfileDefault:
+	case config.LeaderElectionProfileFastFailover:
+		opts.LeaseDuration = ptr.To(fastFailoverLeaderElection.leaseDuration)
+		opts.RenewDeadline = ptr.To(fastFailoverLeaderElection.renewDeadline)
+		opts.RetryPeriod = ptr.To(fastFailoverLeaderElection.retryPeriod)
+		opts.LeaderElectionReleaseOnCancel = true
+	default:
+		return fmt.Errorf("unsupported leader election profile %q, supported profiles are %q and %q", profile, config.LeaderElectionProfileDefault, config.LeaderElectionProfileFastFailover)
+	}
+
+	if leaderElection.LeaseDuration != nil {
+		opts.LeaseDuration = &leaderElection.LeaseDuration.Duration
+	}
+	if leaderElection.RenewDeadline != nil {
+		opts.RenewDeadline = &leaderElection.RenewDeadline.Duration
+	}
+	if leaderElection.RetryPeriod != nil {
+		opts.RetryPeriod = &leaderElection.RetryPeriod.Duration
+	}
+	if leaderElection.ReleaseOnCancel != nil {
+		opts.LeaderElectionReleaseOnCancel = *leaderElection.ReleaseOnCancel
+	}
+	return nil
+}
+
+// ControllersConfig returns the ControllersConfig of the config. It is never nil.
+func (c *Config) ControllersConfig() *config.ControllersConfig {
+	if c.Config.Controllers == nil {
+		return &config.ControllersConfig{}
+	}
+	return c.Config.Controllers
+}
+
+// ApplyControllerConfig applies the given ControllerConfig to the controller options. If a sync period is configured,
+// the extension objects of the given list type are listed with the reader and reconciled periodically.
+func ApplyControllerConfig(cfg *config.ControllerConfig, opts *controller.Options, reader client.Reader, list client.ObjectList) {
+	if cfg == nil {
+		return
+	}
+
+	if cfg.MaxConcurrentReconciles != nil {
+		opts.MaxConcurrentReconciles = *cfg.MaxConcurrentReconciles
+	}
+	if cfg.RequeueBaseDelay != nil || cfg.RequeueMaxDelay != nil {
+		baseDelay, maxDelay := 5*time.Millisecond, 1000*time.Second
+		if cfg.RequeueBaseDelay != nil {
+			baseDelay = cfg.RequeueBaseDelay.Duration
+		}
+		if cfg.RequeueMaxDelay != nil {
+			maxDelay = cfg.RequeueMaxDelay.Duration
+		}
+		opts.RateLimiter = workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](baseDelay, maxDelay)
+	}
+	if cfg.SyncPeriod != nil {
+		opts.NewQueue = resync.NewQueue(reader, list, azure.Type, cfg.SyncPeriod.Duration)
+	}
+}
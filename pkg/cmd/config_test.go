@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/cmd"
+)
+
+var _ = Describe("Config", func() {
+	Describe("#ApplyLeaderElectionConfig", func() {
+		var opts manager.Options
+
+		BeforeEach(func() {
+			opts = manager.Options{}
+		})
+
+		apply := func(leaderElection *config.LeaderElectionConfig) error {
+			c := &Config{Config: &config.ControllerConfiguration{LeaderElection: leaderElection}}
+			return c.ApplyLeaderElectionConfig(&opts)
+		}
+
+		It("should keep the options if no leader election is configured", func() {
+			Expect(apply(nil)).To(Succeed())
+			Expect(opts).To(Equal(manager.Options{}))
+		})
+
+		It("should keep the default durations for the Default profile", func() {
+			Expect(apply(&config.LeaderElectionConfig{Profile: ptr.To(config.LeaderElectionProfileDefault)})).To(Succeed())
+			Expect(opts).To(Equal(manager.Options{}))
+		})
+
+		It("should apply the durations of the FastFailover profile and release the leadership on cancel", func() {
+			Expect(apply(&config.LeaderElectionConfig{Profile: ptr.To(config.LeaderElectionProfileFastFailover)})).To(Succeed())
+			Expect(opts.LeaseDuration).To(PointTo(Equal(12 * time.Second)))
+			Expect(opts.RenewDeadline).To(PointTo(Equal(10 * time.Second)))
+			Expect(opts.RetryPeriod).To(PointTo(Equal(2 * time.Second)))
+			Expect(opts.LeaderElectionReleaseOnCancel).To(BeTrue())
+		})
+
+		It("should prefer the configured durations and release on cancel over the ones of the profile", func() {
+			Expect(apply(&config.LeaderElectionConfig{
+				Profile:         ptr.To(config.LeaderElectionProfileFastFailover),
+				LeaseDuration:   &metav1.Duration{Duration: 30 * time.Second},
+				RenewDeadline:   &metav1.Duration{Duration: 20 * time.Second},
+				RetryPeriod:     &metav1.Duration{Duration: 5 * time.Second},
+				ReleaseOnCancel: ptr.To(false),
+			})).To(Succeed())
+			Expect(opts.LeaseDuration).To(PointTo(Equal(30 * time.Second)))
+			Expect(opts.RenewDeadline).To(PointTo(Equal(20 * time.Second)))
+			Expect(opts.RetryPeriod).To(PointTo(Equal(5 * time.Second)))
+			Expect(opts.LeaderElectionReleaseOnCancel).To(BeFalse())
+		})
+
+		It("should release the leadership on cancel without a profile if configured", func() {
+			Expect(apply(&config.LeaderElectionConfig{ReleaseOnCancel: ptr.To(true)})).To(Succeed())
+			Expect(opts.LeaseDuration).To(BeNil())
+			Expect(opts.LeaderElectionReleaseOnCancel).To(BeTrue())
+		})
+
+		It("should fail for an unknown profile", func() {
+			Expect(apply(&config.LeaderElectionConfig{Profile: ptr.To(config.LeaderElectionProfile("Unknown"))})).To(MatchError(ContainSubstring(`unsupported leader election profile "Unknown"`)))
+		})
+	})
+})
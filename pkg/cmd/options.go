@@ -24,13 +24,16 @@ import (
 	backupentrycontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupentry"
 	bastioncontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/bastion"
 	controlplanecontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/controlplane"
+	controlplaneexposurecontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/controlplaneexposure"
 	dnsrecordcontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/dnsrecord"
 	healthcheckcontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/healthcheck"
 	infrastructurecontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure"
+	orphandetectioncontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/orphandetection"
 	workercontroller "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
 	acceleratednetworkwebhook "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/acceleratednetwork"
 	cloudproviderwebhook "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/cloudprovider"
 	controlplanewebhook "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/controlplane"
+	controlplaneexposurewebhook "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/controlplaneexposure"
 	haNamespace "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/highavailability/namespace"
 	infrastructurewebhook "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/infrastructure"
 	networkwebhook "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/network"
@@ -48,6 +51,8 @@ func ControllerSwitchOptions() *controllercmd.SwitchOptions {
 		controllercmd.Switch(extensionsdnsrecordcontroller.ControllerName, dnsrecordcontroller.AddToManager),
 		controllercmd.Switch(extensionsinfrastructurecontroller.ControllerName, infrastructurecontroller.AddToManager),
 		controllercmd.Switch(extensionsworkercontroller.ControllerName, workercontroller.AddToManager),
+		controllercmd.Switch(orphandetectioncontroller.ControllerName, orphandetectioncontroller.AddToManager),
+		controllercmd.Switch(controlplaneexposurecontroller.ControllerName, controlplaneexposurecontroller.AddToManager),
 		controllercmd.Switch(extensionshealthcheckcontroller.ControllerName, healthcheckcontroller.AddToManager),
 		controllercmd.Switch(extensionsheartbeatcontroller.ControllerName, extensionsheartbeatcontroller.AddToManager),
 	)
@@ -63,6 +68,7 @@ func WebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(extensionscontrolplanewebhook.SeedProviderWebhookName, seedproviderwebhook.AddToManager),
 		webhookcmd.Switch(extensionscloudproviderwebhook.WebhookName, cloudproviderwebhook.AddToManager),
 		webhookcmd.Switch(topology.WebhookName, topology.AddToManager),
+		webhookcmd.Switch(controlplaneexposurewebhook.WebhookName, controlplaneexposurewebhook.AddToManager),
 		webhookcmd.Switch(haNamespace.WebhookName, haNamespace.AddToManager),
 	)
 }
@@ -10,14 +10,22 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
 	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
 )
 
 var (
@@ -27,20 +35,29 @@ var (
 
 type actuator struct {
 	backupbucket.Actuator
-	client client.Client
+	client         client.Client
+	recorder       record.EventRecorder
+	factoryOptions []azureclient.AzureFactoryOption
+	blobOptions    []azureclient.BlobStorageClientOption
 }
 
-func newActuator(mgr manager.Manager) backupbucket.Actuator {
+func newActuator(mgr manager.Manager, factoryOptions []azureclient.AzureFactoryOption, blobOptions []azureclient.BlobStorageClientOption) backupbucket.Actuator {
 	return &actuator{
-		client: mgr.GetClient(),
+		client:         mgr.GetClient(),
+		recorder:       mgr.GetEventRecorderFor(azuretypes.Name + "-backupbucket-controller"),
+		factoryOptions: factoryOptions,
+		blobOptions:    blobOptions,
 	}
 }
 
-func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
 	backupConfig, err := helper.BackupConfigFromBackupBucket(backupBucket)
 	if err != nil {
 		return err
 	}
+	if errs := validation.ValidateBackupBucketConfig(&backupConfig, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return fmt.Errorf("invalid providerConfig of BackupBucket: %w", errs.ToAggregate())
+	}
 
 	azCloudConfiguration, err := azureclient.AzureCloudConfiguration(backupConfig.CloudConfiguration, &backupBucket.Spec.Region)
 	if err != nil {
@@ -50,42 +67,81 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, backupBucket *e
 	factory, err := azureclient.NewAzureClientFactoryFromSecret(
 		ctx,
 		a.client,
-		backupBucket.Spec.SecretRef,
+		credentialsSecretRef(backupBucket, &backupConfig),
 		false,
-		azureclient.WithCloudConfiguration(azCloudConfiguration),
+		append([]azureclient.AzureFactoryOption{azureclient.WithCloudConfiguration(azCloudConfiguration)}, a.factoryOptions...)...,
 	)
 	if err != nil {
 		return err
 	}
 
+	bucketCloudConfiguration, err := azureclient.CloudConfiguration(backupConfig.CloudConfiguration, &backupBucket.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	storageDomain, err := azureclient.BlobStorageDomainFromCloudConfiguration(bucketCloudConfiguration)
+	if err != nil {
+		return fmt.Errorf("failed to determine blob storage service domain: %w", err)
+	}
+
 	// If the generated secret in the backupbucket status not exists that means
 	// no backupbucket exists and it need to be created.
 	if backupBucket.Status.GeneratedSecretRef == nil {
-		storageAccountName, storageAccountKey, err := ensureBackupBucket(ctx, factory, backupBucket)
+		storageAccountName, storageAccountKey, err := ensureBackupBucket(ctx, factory, backupBucket, &backupConfig)
 		if err != nil {
 			return util.DetermineError(err, helper.KnownCodes)
 		}
 
-		bucketCloudConfiguration, err := azureclient.CloudConfiguration(backupConfig.CloudConfiguration, &backupBucket.Spec.Region)
-		if err != nil {
-			return err
-		}
-
-		storageDomain, err := azureclient.BlobStorageDomainFromCloudConfiguration(bucketCloudConfiguration)
-		if err != nil {
-			return fmt.Errorf("failed to determine blob storage service domain: %w", err)
-		}
 		// Create the generated backupbucket secret.
-		if err := a.createBackupBucketGeneratedSecret(ctx, backupBucket, storageAccountName, storageAccountKey, storageDomain); err != nil {
+		if err := a.createBackupBucketGeneratedSecret(ctx, backupBucket, storageKeySecretData(storageAccountName, storageAccountKey, storageDomain), nil); err != nil {
 			return util.DetermineError(err, helper.KnownCodes)
 		}
 	}
 
-	blobStorageClient, err := DefaultBlobStorageClient(ctx, a.client, backupBucket.Status.GeneratedSecretRef)
+	if err := a.checkResourceGroupRegion(ctx, factory, backupBucket, &backupConfig); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	if err := a.ensureStorageAccountSecurity(ctx, factory, backupBucket, &backupConfig); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	if err := ensureNetworkRules(ctx, factory, backupBucket, &backupConfig); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	blobStorageClient, err := a.blobStorageClient(ctx, factory, backupBucket, storageDomain)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
-	return util.DetermineError(blobStorageClient.CreateContainerIfNotExists(ctx, backupBucket.Name), helper.KnownCodes)
+	if err := blobStorageClient.CreateContainerIfNotExists(ctx, backupBucket.Name); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	if features.ExtensionFeatureGate.Enabled(features.BackupBucketInventory) {
+		if err := ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, &backupConfig); err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
+		}
+	}
+
+	if err := ensureLifecyclePolicy(ctx, factory, backupBucket, &backupConfig); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	if err := a.ensureLegalHold(ctx, factory, backupBucket, &backupConfig); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	// The credentials in the generated secret are only switched to a SAS token once the container exists.
+	if err := a.ensureGeneratedSecretCredentials(ctx, factory, backupBucket, &backupConfig, storageDomain); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	if _, ok := backupBucket.Annotations[azuretypes.BackupBucketRestoreSourceAnnotation]; ok {
+		return a.restore(ctx, log, factory, blobStorageClient, backupBucket, storageDomain)
+	}
+	return nil
 }
 
 func (a *actuator) Delete(ctx context.Context, logger logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
@@ -109,6 +165,12 @@ func (a *actuator) delete(ctx context.Context, _ logr.Logger, backupBucket *exte
 		return err
 	}
 
+	// The backups in a container under legal hold cannot be deleted, the legal hold has to be lifted by configuring it
+	// without any tag first.
+	if hasLegalHold(&backupBucketConfig) {
+		return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("the backup container is under legal hold with the tags %v, it cannot be deleted", backupBucketConfig.LegalHold.Tags), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
 	var (
 		cloudConfiguration *azure.CloudConfiguration
 		region             *string
@@ -124,17 +186,6 @@ func (a *actuator) delete(ctx context.Context, _ logr.Logger, backupBucket *exte
 		return err
 	}
 
-	if secret != nil {
-		// Get a storage account client to delete the backup container in the storage account.
-		storageClient, err := DefaultBlobStorageClient(ctx, a.client, backupBucket.Status.GeneratedSecretRef)
-		if err != nil {
-			return err
-		}
-		if err := storageClient.DeleteContainerIfExists(ctx, backupBucket.Name); err != nil {
-			return err
-		}
-	}
-
 	azCloudConfiguration, err := azureclient.AzureCloudConfigurationFromCloudConfiguration(cloudConfiguration)
 	if err != nil {
 		return err
@@ -143,14 +194,34 @@ func (a *actuator) delete(ctx context.Context, _ logr.Logger, backupBucket *exte
 	factory, err := azureclient.NewAzureClientFactoryFromSecret(
 		ctx,
 		a.client,
-		backupBucket.Spec.SecretRef,
+		credentialsSecretRef(backupBucket, &backupBucketConfig),
 		false,
-		azureclient.WithCloudConfiguration(azCloudConfiguration),
+		append([]azureclient.AzureFactoryOption{azureclient.WithCloudConfiguration(azCloudConfiguration)}, a.factoryOptions...)...,
 	)
 
 	if err != nil {
 		return err
 	}
+
+	if secret != nil {
+		storageDomain, err := azureclient.BlobStorageDomainFromCloudConfiguration(cloudConfiguration)
+		if err != nil {
+			return fmt.Errorf("failed to determine blob storage service domain: %w", err)
+		}
+
+		// Get a storage account client to delete the backup container in the storage account.
+		storageClient, err := a.blobStorageClient(ctx, factory, backupBucket, storageDomain)
+		if err != nil {
+			return err
+		}
+		if err := a.ensureLegalHold(ctx, factory, backupBucket, &backupBucketConfig); err != nil {
+			return err
+		}
+		if err := storageClient.DeleteContainerIfExists(ctx, backupBucket.Name); err != nil {
+			return err
+		}
+	}
+
 	// Get resource group client and delete the resource group which contains the backup storage account.
 	groupClient, err := factory.Group()
 	if err != nil {
@@ -163,3 +234,12 @@ func (a *actuator) delete(ctx context.Context, _ logr.Logger, backupBucket *exte
 	// Delete the generated backup secret in the garden namespace.
 	return a.deleteBackupBucketGeneratedSecret(ctx, backupBucket)
 }
+
+// credentialsSecretRef returns the reference to the secret containing the credentials to manage the backup storage
+// account. The credentials of the BackupBucket are used unless the provider config references other credentials.
+func credentialsSecretRef(backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig) corev1.SecretReference {
+	if backupConfig.CredentialsSecretRef != nil {
+		return *backupConfig.CredentialsSecretRef
+	}
+	return backupBucket.Spec.SecretRef
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Actuator", func() {
+	Describe("#Delete", func() {
+		var (
+			ctx = context.Background()
+
+			a            *actuator
+			backupBucket *extensionsv1alpha1.BackupBucket
+		)
+
+		BeforeEach(func() {
+			backupBucket = &extensionsv1alpha1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+				Spec: extensionsv1alpha1.BackupBucketSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","legalHold":{"tags":["case123"]}}`)},
+					},
+				},
+				Status: extensionsv1alpha1.BackupBucketStatus{
+					GeneratedSecretRef: &corev1.SecretReference{Name: "generated-bucket-bucket", Namespace: "garden"},
+				},
+			}
+			a = &actuator{client: fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()}
+		})
+
+		It("should refuse to delete a backup container under legal hold", func() {
+			err := a.Delete(ctx, logr.Discard(), backupBucket)
+			Expect(err).To(MatchError(ContainSubstring("under legal hold")))
+
+			coder, ok := err.(v1beta1helper.Coder)
+			Expect(ok).To(BeTrue())
+			Expect(coder.Codes()).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+		})
+
+		It("should do nothing if the backup bucket was never created", func() {
+			backupBucket.Status.GeneratedSecretRef = nil
+			Expect(a.Delete(ctx, logr.Discard(), backupBucket)).To(Succeed())
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 var (
@@ -25,17 +26,26 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// ClientFactoryOptions are additional options for the Azure client factory, e.g. to inject a fake transport in tests.
+	ClientFactoryOptions []azureclient.AzureFactoryOption
+	// BlobStorageClientOptions are additional options for the clients of the blob storage service, e.g. to inject a fake
+	// transport in tests.
+	BlobStorageClientOptions []azureclient.BlobStorageClientOption
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
+// Additionally, a controller which triggers the renewal of SAS tokens in the generated backup secrets is added.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
-	return backupbucket.Add(ctx, mgr, backupbucket.AddArgs{
-		Actuator:          newActuator(mgr),
+	if err := backupbucket.Add(ctx, mgr, backupbucket.AddArgs{
+		Actuator:          newActuator(mgr, opts.ClientFactoryOptions, opts.BlobStorageClientOptions),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              azure.Type,
-	})
+	}); err != nil {
+		return err
+	}
+	return addSASRenewalController(mgr, opts)
 }
 
 // AddToManager adds a controller with the default Options.
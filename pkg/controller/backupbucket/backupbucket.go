@@ -7,28 +7,39 @@ package backupbucket
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
-func ensureBackupBucket(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket) (string, string, error) {
-	var (
-		backupBucketNameSha = utils.ComputeSHA256Hex([]byte(backupBucket.Name))
-		storageAccountName  = fmt.Sprintf("bkp%s", backupBucketNameSha[:15])
-	)
+// storageAccountName returns the name of the storage account which hosts the container of the given backup bucket.
+func storageAccountName(backupBucket *extensionsv1alpha1.BackupBucket) string {
+	backupBucketNameSha := utils.ComputeSHA256Hex([]byte(backupBucket.Name))
+	return fmt.Sprintf("bkp%s", backupBucketNameSha[:15])
+}
+
+func ensureBackupBucket(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig) (string, string, error) {
+	storageAccountName := storageAccountName(backupBucket)
 
 	// Get resource group client to ensure resource group to host backup storage account exists.
 	groupClient, err := factory.Group()
 	if err != nil {
 		return "", "", err
 	}
+	// The resource group can be placed in another region than the bucket. The storage account holding the backup data
+	// is always created in the region of the bucket.
 	if _, err := groupClient.CreateOrUpdate(ctx, backupBucket.Name, armresources.ResourceGroup{
-		Location: to.Ptr(backupBucket.Spec.Region),
+		Location: to.Ptr(ptr.Deref(backupConfig.ResourceGroupRegion, backupBucket.Spec.Region)),
 	}); err != nil {
 		return "", "", err
 	}
@@ -38,7 +49,7 @@ func ensureBackupBucket(ctx context.Context, factory azureclient.Factory, backup
 	if err != nil {
 		return "", "", err
 	}
-	if err := storageAccountClient.CreateStorageAccount(ctx, backupBucket.Name, storageAccountName, backupBucket.Spec.Region); err != nil {
+	if err := storageAccountClient.CreateStorageAccount(ctx, backupBucket.Name, storageAccountName, backupBucket.Spec.Region, armstorage.SKUNameStandardZRS, storageAccountSecurity(backupConfig)); err != nil {
 		return "", "", err
 	}
 
@@ -50,3 +61,166 @@ func ensureBackupBucket(ctx context.Context, factory azureclient.Factory, backup
 
 	return storageAccountName, storageAccountKey, nil
 }
+
+// storageAccountSecurity returns the security settings of the backup storage account.
+func storageAccountSecurity(backupConfig *azure.BackupBucketConfig) azureclient.StorageAccountSecurity {
+	return azureclient.StorageAccountSecurity{
+		RequireInfrastructureEncryption: ptr.Deref(backupConfig.RequireInfrastructureEncryption, false),
+		MinimumTLSVersion:               armstorage.MinimumTLSVersion(ptr.Deref(backupConfig.MinimumTLSVersion, azure.MinimumTLSVersionTLS12)),
+	}
+}
+
+// EventReasonInfrastructureEncryptionMismatch is the reason of the event emitted when the infrastructure encryption of
+// the backup storage account differs from the configured one.
+const EventReasonInfrastructureEncryptionMismatch = "InfrastructureEncryptionMismatch"
+
+// ensureStorageAccountSecurity reconciles the minimum TLS version of the backup storage account. The infrastructure
+// encryption cannot be changed once the storage account exists, hence a mismatch is only reported with an event.
+func (a *actuator) ensureStorageAccountSecurity(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig) error {
+	storageAccountClient, err := factory.StorageAccount()
+	if err != nil {
+		return err
+	}
+
+	storageAccountName := storageAccountName(backupBucket)
+	account, err := storageAccountClient.Get(ctx, backupBucket.Name, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return fmt.Errorf("backup storage account %s/%s does not exist", backupBucket.Name, storageAccountName)
+	}
+
+	var (
+		desired                         = storageAccountSecurity(backupConfig)
+		requireInfrastructureEncryption bool
+		minimumTLSVersion               armstorage.MinimumTLSVersion
+	)
+	if account.Properties != nil {
+		if account.Properties.Encryption != nil {
+			requireInfrastructureEncryption = ptr.Deref(account.Properties.Encryption.RequireInfrastructureEncryption, false)
+		}
+		minimumTLSVersion = ptr.Deref(account.Properties.MinimumTLSVersion, "")
+	}
+
+	if requireInfrastructureEncryption != desired.RequireInfrastructureEncryption {
+		a.recorder.Eventf(backupBucket, corev1.EventTypeWarning, EventReasonInfrastructureEncryptionMismatch,
+			"The infrastructure encryption of the backup storage account %s/%s cannot be changed after its creation (currently required: %t)", backupBucket.Name, storageAccountName, requireInfrastructureEncryption)
+	}
+	if minimumTLSVersion == desired.MinimumTLSVersion {
+		return nil
+	}
+	return storageAccountClient.UpdateMinimumTLSVersion(ctx, backupBucket.Name, storageAccountName, desired.MinimumTLSVersion)
+}
+
+// EventReasonResourceGroupRegionMismatch is the reason of the event emitted when the resource group of the backup bucket
+// is located in another region than the configured one.
+const EventReasonResourceGroupRegionMismatch = "ResourceGroupRegionMismatch"
+
+// checkResourceGroupRegion reports a mismatch of the region of the backup resource group with an event. Azure cannot
+// move resource groups to another region, hence the configured region only takes effect when the resource group is
+// created, e.g. for backup buckets which existed before the region was configured.
+func (a *actuator) checkResourceGroupRegion(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig) error {
+	groupClient, err := factory.Group()
+	if err != nil {
+		return err
+	}
+	group, err := groupClient.Get(ctx, backupBucket.Name)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return fmt.Errorf("backup resource group %s does not exist", backupBucket.Name)
+	}
+
+	var (
+		location = ptr.Deref(group.Location, "")
+		desired  = ptr.Deref(backupConfig.ResourceGroupRegion, backupBucket.Spec.Region)
+	)
+	if !strings.EqualFold(strings.ReplaceAll(location, " ", ""), strings.ReplaceAll(desired, " ", "")) {
+		a.recorder.Eventf(backupBucket, corev1.EventTypeWarning, EventReasonResourceGroupRegionMismatch,
+			"The backup resource group %s is located in region %s instead of %s, it cannot be moved to another region after its creation", backupBucket.Name, location, desired)
+	}
+	return nil
+}
+
+// ensureNetworkRules reconciles the network rules of the backup storage account. If no network rules are configured, the
+// storage account is accessible from all networks.
+func ensureNetworkRules(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig) error {
+	storageAccountClient, err := factory.StorageAccount()
+	if err != nil {
+		return err
+	}
+
+	storageAccountName := storageAccountName(backupBucket)
+	account, err := storageAccountClient.Get(ctx, backupBucket.Name, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return fmt.Errorf("backup storage account %s/%s does not exist", backupBucket.Name, storageAccountName)
+	}
+
+	desired := networkRuleSet(backupConfig.NetworkACLs)
+	if account.Properties != nil && networkRuleSetEqual(account.Properties.NetworkRuleSet, desired) {
+		return nil
+	}
+	return storageAccountClient.UpdateNetworkRules(ctx, backupBucket.Name, storageAccountName, desired)
+}
+
+// networkRuleSet translates the network ACLs of the backup bucket into the network rule set of the storage account.
+func networkRuleSet(acls *azure.BackupBucketNetworkACLs) *armstorage.NetworkRuleSet {
+	rules := &armstorage.NetworkRuleSet{
+		DefaultAction:       to.Ptr(armstorage.DefaultActionAllow),
+		Bypass:              to.Ptr(armstorage.BypassAzureServices),
+		IPRules:             []*armstorage.IPRule{},
+		VirtualNetworkRules: []*armstorage.VirtualNetworkRule{},
+	}
+	if acls == nil {
+		return rules
+	}
+
+	rules.DefaultAction = to.Ptr(armstorage.DefaultAction(acls.DefaultAction))
+	for _, ipRule := range acls.IPRules {
+		rules.IPRules = append(rules.IPRules, &armstorage.IPRule{
+			IPAddressOrRange: to.Ptr(ipRule),
+			Action:           to.Ptr("Allow"),
+		})
+	}
+	for _, subnetID := range acls.VirtualNetworkRules {
+		rules.VirtualNetworkRules = append(rules.VirtualNetworkRules, &armstorage.VirtualNetworkRule{
+			VirtualNetworkResourceID: to.Ptr(subnetID),
+			Action:                   to.Ptr("Allow"),
+		})
+	}
+	return rules
+}
+
+// networkRuleSetEqual checks whether the current network rule set of the storage account matches the desired one.
+func networkRuleSetEqual(current, desired *armstorage.NetworkRuleSet) bool {
+	if current == nil {
+		return ptr.Deref(desired.DefaultAction, "") == armstorage.DefaultActionAllow && len(desired.IPRules) == 0 && len(desired.VirtualNetworkRules) == 0
+	}
+	if ptr.Deref(current.DefaultAction, "") != ptr.Deref(desired.DefaultAction, "") {
+		return false
+	}
+
+	ipRules := func(rules []*armstorage.IPRule) sets.Set[string] {
+		s := sets.New[string]()
+		for _, rule := range rules {
+			// Azure reports single addresses without the /32 suffix.
+			s.Insert(strings.TrimSuffix(ptr.Deref(rule.IPAddressOrRange, ""), "/32"))
+		}
+		return s
+	}
+	virtualNetworkRules := func(rules []*armstorage.VirtualNetworkRule) sets.Set[string] {
+		s := sets.New[string]()
+		for _, rule := range rules {
+			s.Insert(strings.ToLower(ptr.Deref(rule.VirtualNetworkResourceID, "")))
+		}
+		return s
+	}
+
+	return ipRules(current.IPRules).Equal(ipRules(desired.IPRules)) &&
+		virtualNetworkRules(current.VirtualNetworkRules).Equal(virtualNetworkRules(desired.VirtualNetworkRules))
+}
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+var _ = Describe("BackupBucket", func() {
+	Describe("#ensureStorageAccountSecurity", func() {
+		var (
+			ctx  = context.Background()
+			ctrl *gomock.Controller
+
+			factory         *mockazureclient.MockFactory
+			storageAccounts *mockazureclient.MockStorageAccount
+			recorder        *record.FakeRecorder
+			a               *actuator
+
+			backupBucket *extensionsv1alpha1.BackupBucket
+			backupConfig *azure.BackupBucketConfig
+			accountName  string
+			account      = func(requireInfrastructureEncryption bool, minimumTLSVersion armstorage.MinimumTLSVersion) *armstorage.Account {
+				return &armstorage.Account{Properties: &armstorage.AccountProperties{
+					Encryption:        &armstorage.Encryption{RequireInfrastructureEncryption: ptr.To(requireInfrastructureEncryption)},
+					MinimumTLSVersion: ptr.To(minimumTLSVersion),
+				}}
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			factory = mockazureclient.NewMockFactory(ctrl)
+			storageAccounts = mockazureclient.NewMockStorageAccount(ctrl)
+			factory.EXPECT().StorageAccount().Return(storageAccounts, nil).AnyTimes()
+			recorder = record.NewFakeRecorder(10)
+			a = &actuator{recorder: recorder}
+
+			backupBucket = &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}}
+			backupConfig = &azure.BackupBucketConfig{}
+			accountName = storageAccountName(backupBucket)
+		})
+
+		It("should do nothing if the storage account is up to date", func() {
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(account(false, armstorage.MinimumTLSVersionTLS12), nil)
+
+			Expect(a.ensureStorageAccountSecurity(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should update the minimum TLS version", func() {
+			backupConfig.MinimumTLSVersion = ptr.To(azure.MinimumTLSVersionTLS13)
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(account(false, armstorage.MinimumTLSVersionTLS12), nil)
+			storageAccounts.EXPECT().UpdateMinimumTLSVersion(ctx, backupBucket.Name, accountName, armstorage.MinimumTLSVersionTLS13)
+
+			Expect(a.ensureStorageAccountSecurity(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should report a mismatch of the infrastructure encryption and still update the minimum TLS version", func() {
+			backupConfig.RequireInfrastructureEncryption = ptr.To(true)
+			backupConfig.MinimumTLSVersion = ptr.To(azure.MinimumTLSVersionTLS13)
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(account(false, armstorage.MinimumTLSVersionTLS12), nil)
+			storageAccounts.EXPECT().UpdateMinimumTLSVersion(ctx, backupBucket.Name, accountName, armstorage.MinimumTLSVersionTLS13)
+
+			Expect(a.ensureStorageAccountSecurity(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonInfrastructureEncryptionMismatch)))
+		})
+
+		It("should fail if the storage account does not exist", func() {
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(nil, nil)
+
+			Expect(a.ensureStorageAccountSecurity(ctx, factory, backupBucket, backupConfig)).To(MatchError(ContainSubstring("does not exist")))
+		})
+
+		It("should return errors of the update", func() {
+			backupConfig.MinimumTLSVersion = ptr.To(azure.MinimumTLSVersionTLS13)
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(account(false, armstorage.MinimumTLSVersionTLS12), nil)
+			storageAccounts.EXPECT().UpdateMinimumTLSVersion(ctx, backupBucket.Name, accountName, armstorage.MinimumTLSVersionTLS13).Return(fmt.Errorf("forbidden"))
+
+			Expect(a.ensureStorageAccountSecurity(ctx, factory, backupBucket, backupConfig)).To(MatchError("forbidden"))
+		})
+	})
+
+	Describe("#checkResourceGroupRegion", func() {
+		var (
+			ctx  = context.Background()
+			ctrl *gomock.Controller
+
+			factory        *mockazureclient.MockFactory
+			resourceGroups *mockazureclient.MockResourceGroup
+			recorder       *record.FakeRecorder
+			a              *actuator
+
+			backupBucket *extensionsv1alpha1.BackupBucket
+			backupConfig *azure.BackupBucketConfig
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			factory = mockazureclient.NewMockFactory(ctrl)
+			resourceGroups = mockazureclient.NewMockResourceGroup(ctrl)
+			factory.EXPECT().Group().Return(resourceGroups, nil).AnyTimes()
+			recorder = record.NewFakeRecorder(10)
+			a = &actuator{recorder: recorder}
+
+			backupBucket = &extensionsv1alpha1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+				Spec:       extensionsv1alpha1.BackupBucketSpec{Region: "westeurope"},
+			}
+			backupConfig = &azure.BackupBucketConfig{ResourceGroupRegion: ptr.To("northeurope")}
+		})
+
+		It("should not report anything if the resource group is located in the configured region", func() {
+			resourceGroups.EXPECT().Get(ctx, backupBucket.Name).Return(&armresources.ResourceGroup{Location: ptr.To("northeurope")}, nil)
+
+			Expect(a.checkResourceGroupRegion(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should report if the resource group is located in another region", func() {
+			backupConfig.ResourceGroupRegion = nil
+			resourceGroups.EXPECT().Get(ctx, backupBucket.Name).Return(&armresources.ResourceGroup{Location: ptr.To("northeurope")}, nil)
+
+			Expect(a.checkResourceGroupRegion(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+			Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonResourceGroupRegionMismatch)))
+		})
+
+		It("should fail if the resource group does not exist", func() {
+			resourceGroups.EXPECT().Get(ctx, backupBucket.Name).Return(nil, nil)
+
+			Expect(a.checkResourceGroupRegion(ctx, factory, backupBucket, backupConfig)).To(MatchError(ContainSubstring("does not exist")))
+		})
+	})
+
+	Describe("#ensureNetworkRules", func() {
+		const subnetID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/nodes"
+
+		var (
+			ctx  = context.Background()
+			ctrl *gomock.Controller
+
+			factory         *mockazureclient.MockFactory
+			storageAccounts *mockazureclient.MockStorageAccount
+
+			backupBucket *extensionsv1alpha1.BackupBucket
+			backupConfig *azure.BackupBucketConfig
+			accountName  string
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			factory = mockazureclient.NewMockFactory(ctrl)
+			storageAccounts = mockazureclient.NewMockStorageAccount(ctrl)
+			factory.EXPECT().StorageAccount().Return(storageAccounts, nil).AnyTimes()
+
+			backupBucket = &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}}
+			backupConfig = &azure.BackupBucketConfig{
+				NetworkACLs: &azure.BackupBucketNetworkACLs{
+					DefaultAction:       azure.NetworkACLDefaultActionDeny,
+					IPRules:             []string{"1.2.3.4", "10.0.0.0/24"},
+					VirtualNetworkRules: []string{subnetID},
+				},
+			}
+			accountName = storageAccountName(backupBucket)
+		})
+
+		It("should update the network rules if they differ", func() {
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(&armstorage.Account{Properties: &armstorage.AccountProperties{}}, nil)
+			storageAccounts.EXPECT().UpdateNetworkRules(ctx, backupBucket.Name, accountName, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, rules *armstorage.NetworkRuleSet) error {
+					Expect(rules.DefaultAction).To(PointTo(Equal(armstorage.DefaultActionDeny)))
+					Expect(rules.Bypass).To(PointTo(Equal(armstorage.BypassAzureServices)))
+					Expect(rules.IPRules).To(HaveLen(2))
+					Expect(rules.IPRules[0].IPAddressOrRange).To(PointTo(Equal("1.2.3.4")))
+					Expect(rules.IPRules[1].IPAddressOrRange).To(PointTo(Equal("10.0.0.0/24")))
+					Expect(rules.VirtualNetworkRules).To(HaveLen(1))
+					Expect(rules.VirtualNetworkRules[0].VirtualNetworkResourceID).To(PointTo(Equal(subnetID)))
+					return nil
+				},
+			)
+
+			Expect(ensureNetworkRules(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should not update the network rules if they are up to date", func() {
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(&armstorage.Account{Properties: &armstorage.AccountProperties{
+				NetworkRuleSet: networkRuleSet(backupConfig.NetworkACLs),
+			}}, nil)
+
+			Expect(ensureNetworkRules(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should allow access from all networks if no network rules are configured", func() {
+			backupConfig.NetworkACLs = nil
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(&armstorage.Account{Properties: &armstorage.AccountProperties{
+				NetworkRuleSet: &armstorage.NetworkRuleSet{
+					DefaultAction: ptr.To(armstorage.DefaultActionDeny),
+					IPRules:       []*armstorage.IPRule{{IPAddressOrRange: ptr.To("1.2.3.4")}},
+				},
+			}}, nil)
+			storageAccounts.EXPECT().UpdateNetworkRules(ctx, backupBucket.Name, accountName, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, rules *armstorage.NetworkRuleSet) error {
+					Expect(rules.DefaultAction).To(PointTo(Equal(armstorage.DefaultActionAllow)))
+					Expect(rules.IPRules).To(BeEmpty())
+					Expect(rules.VirtualNetworkRules).To(BeEmpty())
+					return nil
+				},
+			)
+
+			Expect(ensureNetworkRules(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should fail if the storage account does not exist", func() {
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(nil, nil)
+
+			Expect(ensureNetworkRules(ctx, factory, backupBucket, backupConfig)).To(MatchError(ContainSubstring("does not exist")))
+		})
+
+		It("should return errors of the update", func() {
+			storageAccounts.EXPECT().Get(ctx, backupBucket.Name, accountName).Return(&armstorage.Account{Properties: &armstorage.AccountProperties{}}, nil)
+			storageAccounts.EXPECT().UpdateNetworkRules(ctx, backupBucket.Name, accountName, gomock.Any()).Return(fmt.Errorf("forbidden"))
+
+			Expect(ensureNetworkRules(ctx, factory, backupBucket, backupConfig)).To(MatchError("forbidden"))
+		})
+	})
+
+	Describe("#networkRuleSetEqual", func() {
+		const subnetID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/nodes"
+
+		var desired *armstorage.NetworkRuleSet
+
+		BeforeEach(func() {
+			desired = networkRuleSet(&azure.BackupBucketNetworkACLs{
+				DefaultAction:       azure.NetworkACLDefaultActionDeny,
+				IPRules:             []string{"1.2.3.4/32", "10.0.0.0/24"},
+				VirtualNetworkRules: []string{subnetID},
+			})
+		})
+
+		It("should consider a missing rule set equal to allowing all networks", func() {
+			Expect(networkRuleSetEqual(nil, networkRuleSet(nil))).To(BeTrue())
+			Expect(networkRuleSetEqual(nil, desired)).To(BeFalse())
+		})
+
+		It("should ignore the order of the rules, the /32 suffix of single addresses and the case of subnet IDs", func() {
+			current := &armstorage.NetworkRuleSet{
+				DefaultAction: ptr.To(armstorage.DefaultActionDeny),
+				IPRules: []*armstorage.IPRule{
+					{IPAddressOrRange: ptr.To("10.0.0.0/24")},
+					{IPAddressOrRange: ptr.To("1.2.3.4")},
+				},
+				VirtualNetworkRules: []*armstorage.VirtualNetworkRule{{VirtualNetworkResourceID: ptr.To(strings.ToUpper(subnetID))}},
+			}
+
+			Expect(networkRuleSetEqual(current, desired)).To(BeTrue())
+		})
+
+		It("should detect a different default action", func() {
+			current := networkRuleSet(&azure.BackupBucketNetworkACLs{
+				DefaultAction:       azure.NetworkACLDefaultActionAllow,
+				IPRules:             []string{"1.2.3.4/32", "10.0.0.0/24"},
+				VirtualNetworkRules: []string{subnetID},
+			})
+
+			Expect(networkRuleSetEqual(current, desired)).To(BeFalse())
+		})
+
+		It("should detect different IP rules", func() {
+			current := networkRuleSet(&azure.BackupBucketNetworkACLs{
+				DefaultAction:       azure.NetworkACLDefaultActionDeny,
+				IPRules:             []string{"1.2.3.4/32"},
+				VirtualNetworkRules: []string{subnetID},
+			})
+
+			Expect(networkRuleSetEqual(current, desired)).To(BeFalse())
+		})
+
+		It("should detect different virtual network rules", func() {
+			current := networkRuleSet(&azure.BackupBucketNetworkACLs{
+				DefaultAction: azure.NetworkACLDefaultActionDeny,
+				IPRules:       []string{"1.2.3.4/32", "10.0.0.0/24"},
+			})
+
+			Expect(networkRuleSetEqual(current, desired)).To(BeFalse())
+		})
+	})
+})
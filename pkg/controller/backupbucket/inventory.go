@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// defaultInventoryContainer is the container to which the inventory reports are written if none is configured.
+	defaultInventoryContainer = "gardener-inventory"
+	// inventoryRuleName is the name of the rule of the blob inventory policy which reports the backup container.
+	inventoryRuleName = "gardener-backup"
+)
+
+// inventorySchemaFields are the properties of the blobs which are contained in the inventory report. They suffice to
+// audit the number and size of the backups.
+var inventorySchemaFields = []string{"Name", "Creation-Time", "Last-Modified", "Content-Length", "BlobType", "AccessTier"}
+
+// ensureInventoryPolicy reconciles the rule of the blob inventory policy of the backup storage account which reports
+// the backup container. If an inventory is configured, the inventory container is created and the rule reports the
+// blobs of the backup container once a day. Otherwise, the rule is removed. Rules which were added by others are left
+// untouched, the policy is only deleted if no rule remains. Reports that were already written are kept.
+func ensureInventoryPolicy(ctx context.Context, factory azureclient.Factory, blobStorageClient *azureclient.BlobStorageClient, backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig) error {
+	policiesClient, err := factory.BlobInventoryPolicies()
+	if err != nil {
+		return err
+	}
+
+	storageAccountName := storageAccountName(backupBucket)
+	current, err := policiesClient.Get(ctx, backupBucket.Name, storageAccountName)
+	if err != nil {
+		return err
+	}
+
+	if backupConfig.Inventory == nil {
+		if findInventoryRule(current) == nil {
+			return nil
+		}
+		desired := inventoryPolicy(current, nil)
+		if len(desired.Properties.Policy.Rules) == 0 {
+			return policiesClient.Delete(ctx, backupBucket.Name, storageAccountName)
+		}
+		_, err = policiesClient.CreateOrUpdate(ctx, backupBucket.Name, storageAccountName, desired)
+		return err
+	}
+
+	container := ptr.Deref(backupConfig.Inventory.Container, defaultInventoryContainer)
+	if container == backupBucket.Name {
+		return fmt.Errorf("the inventory container must not be the container of the backup bucket")
+	}
+	if err := blobStorageClient.CreateContainerIfNotExists(ctx, container); err != nil {
+		return err
+	}
+
+	desiredRule := inventoryRule(backupBucket.Name, container)
+	if inventoryPolicyUpToDate(current, desiredRule) {
+		return nil
+	}
+	_, err = policiesClient.CreateOrUpdate(ctx, backupBucket.Name, storageAccountName, inventoryPolicy(current, desiredRule))
+	return err
+}
+
+// inventoryRule returns the blob inventory rule which reports the blobs of the given backup container daily into the
+// given inventory container.
+func inventoryRule(backupContainer, inventoryContainer string) *armstorage.BlobInventoryPolicyRule {
+	return &armstorage.BlobInventoryPolicyRule{
+		Name:        to.Ptr(inventoryRuleName),
+		Enabled:     to.Ptr(true),
+		Destination: to.Ptr(inventoryContainer),
+		Definition: &armstorage.BlobInventoryPolicyDefinition{
+			Format:       to.Ptr(armstorage.FormatCSV),
+			Schedule:     to.Ptr(armstorage.ScheduleDaily),
+			ObjectType:   to.Ptr(armstorage.ObjectTypeBlob),
+			SchemaFields: to.SliceOfPtrs(inventorySchemaFields...),
+			Filters: &armstorage.BlobInventoryPolicyFilter{
+				BlobTypes:   to.SliceOfPtrs("blockBlob"),
+				PrefixMatch: to.SliceOfPtrs(backupContainer + "/"),
+			},
+		},
+	}
+}
+
+// inventoryPolicy returns an enabled blob inventory policy with the rules of the current policy which were added by
+// others and the given rule. The given rule is omitted if it is nil.
+func inventoryPolicy(current *armstorage.BlobInventoryPolicy, rule *armstorage.BlobInventoryPolicyRule) armstorage.BlobInventoryPolicy {
+	var rules []*armstorage.BlobInventoryPolicyRule
+	if current != nil && current.Properties != nil && current.Properties.Policy != nil {
+		for _, r := range current.Properties.Policy.Rules {
+			if r != nil && ptr.Deref(r.Name, "") != inventoryRuleName {
+				rules = append(rules, r)
+			}
+		}
+	}
+	if rule != nil {
+		rules = append(rules, rule)
+	}
+
+	return armstorage.BlobInventoryPolicy{
+		Properties: &armstorage.BlobInventoryPolicyProperties{
+			Policy: &armstorage.BlobInventoryPolicySchema{
+				Enabled: to.Ptr(true),
+				Type:    to.Ptr(armstorage.InventoryRuleTypeInventory),
+				Rules:   rules,
+			},
+		},
+	}
+}
+
+// findInventoryRule returns the rule of the given blob inventory policy which reports the backup container or nil if
+// there is none.
+func findInventoryRule(policy *armstorage.BlobInventoryPolicy) *armstorage.BlobInventoryPolicyRule {
+	if policy == nil || policy.Properties == nil || policy.Properties.Policy == nil {
+		return nil
+	}
+	for _, rule := range policy.Properties.Policy.Rules {
+		if rule != nil && ptr.Deref(rule.Name, "") == inventoryRuleName {
+			return rule
+		}
+	}
+	return nil
+}
+
+// inventoryPolicyUpToDate checks whether the current blob inventory policy is enabled and contains the desired rule.
+func inventoryPolicyUpToDate(current *armstorage.BlobInventoryPolicy, desiredRule *armstorage.BlobInventoryPolicyRule) bool {
+	rule := findInventoryRule(current)
+	if rule == nil || !ptr.Deref(current.Properties.Policy.Enabled, false) {
+		return false
+	}
+
+	if rule.Definition == nil || rule.Definition.Filters == nil ||
+		!ptr.Deref(rule.Enabled, false) ||
+		ptr.Deref(rule.Destination, "") != *desiredRule.Destination {
+		return false
+	}
+
+	definition, desiredDefinition := rule.Definition, desiredRule.Definition
+	return ptr.Deref(definition.Format, "") == *desiredDefinition.Format &&
+		ptr.Deref(definition.Schedule, "") == *desiredDefinition.Schedule &&
+		ptr.Deref(definition.ObjectType, "") == *desiredDefinition.ObjectType &&
+		slices.Equal(derefAll(definition.SchemaFields), derefAll(desiredDefinition.SchemaFields)) &&
+		slices.Equal(derefAll(definition.Filters.PrefixMatch), derefAll(desiredDefinition.Filters.PrefixMatch))
+}
+
+func derefAll(values []*string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, ptr.Deref(v, ""))
+	}
+	return result
+}
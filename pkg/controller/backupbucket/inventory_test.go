@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+// createdContainersTransport records the containers which are created via the blob storage client.
+type createdContainersTransport struct {
+	containers []string
+}
+
+func (t *createdContainersTransport) Do(req *http.Request) (*http.Response, error) {
+	t.containers = append(t.containers, strings.TrimPrefix(req.URL.Path, "/"))
+	return &http.Response{StatusCode: http.StatusCreated, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+var _ = Describe("Inventory", func() {
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		factory           *mockazureclient.MockFactory
+		policies          *mockazureclient.MockBlobInventoryPolicies
+		transport         *createdContainersTransport
+		blobStorageClient *azureclient.BlobStorageClient
+
+		backupBucket *extensionsv1alpha1.BackupBucket
+		backupConfig *azure.BackupBucketConfig
+		accountName  string
+		foreignRule  *armstorage.BlobInventoryPolicyRule
+		withRules    = func(rules ...*armstorage.BlobInventoryPolicyRule) *armstorage.BlobInventoryPolicy {
+			return &armstorage.BlobInventoryPolicy{Properties: &armstorage.BlobInventoryPolicyProperties{Policy: &armstorage.BlobInventoryPolicySchema{
+				Enabled: ptr.To(true),
+				Type:    ptr.To(armstorage.InventoryRuleTypeInventory),
+				Rules:   rules,
+			}}}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockazureclient.NewMockFactory(ctrl)
+		policies = mockazureclient.NewMockBlobInventoryPolicies(ctrl)
+		factory.EXPECT().BlobInventoryPolicies().Return(policies, nil).AnyTimes()
+
+		transport = &createdContainersTransport{}
+		var err error
+		blobStorageClient, err = azureclient.NewBlobStorageClient(ctx, "account", base64.StdEncoding.EncodeToString([]byte("key")), "blob.core.windows.net", azureclient.WithBlobTransport(transport))
+		Expect(err).NotTo(HaveOccurred())
+
+		backupBucket = &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}}
+		backupConfig = &azure.BackupBucketConfig{Inventory: &azure.BackupBucketInventory{}}
+		accountName = storageAccountName(backupBucket)
+		foreignRule = &armstorage.BlobInventoryPolicyRule{Name: ptr.To("foreign"), Enabled: ptr.To(true), Destination: ptr.To("foreign")}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should create the inventory container and add the rule while keeping foreign rules", func() {
+		policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(foreignRule), nil)
+		policies.EXPECT().CreateOrUpdate(ctx, "bucket", accountName, *withRules(foreignRule, inventoryRule("bucket", defaultInventoryContainer)))
+
+		Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(Succeed())
+		Expect(transport.containers).To(ConsistOf(defaultInventoryContainer))
+	})
+
+	It("should not update the policy if the rule is up to date", func() {
+		backupConfig.Inventory.Container = ptr.To("reports")
+		policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(inventoryRule("bucket", "reports"), foreignRule), nil)
+
+		Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(Succeed())
+		Expect(transport.containers).To(ConsistOf("reports"))
+	})
+
+	It("should update the rule if the inventory container changed", func() {
+		backupConfig.Inventory.Container = ptr.To("reports")
+		policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(inventoryRule("bucket", defaultInventoryContainer)), nil)
+		policies.EXPECT().CreateOrUpdate(ctx, "bucket", accountName, *withRules(inventoryRule("bucket", "reports")))
+
+		Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(Succeed())
+	})
+
+	It("should enable a disabled policy", func() {
+		current := withRules(inventoryRule("bucket", defaultInventoryContainer))
+		current.Properties.Policy.Enabled = ptr.To(false)
+		policies.EXPECT().Get(ctx, "bucket", accountName).Return(current, nil)
+		policies.EXPECT().CreateOrUpdate(ctx, "bucket", accountName, *withRules(inventoryRule("bucket", defaultInventoryContainer)))
+
+		Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(Succeed())
+	})
+
+	It("should refuse to write the reports into the backup container", func() {
+		backupConfig.Inventory.Container = ptr.To("bucket")
+		policies.EXPECT().Get(ctx, "bucket", accountName).Return(nil, nil)
+
+		Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(MatchError(ContainSubstring("must not be the container of the backup bucket")))
+		Expect(transport.containers).To(BeEmpty())
+	})
+
+	Context("no inventory configured", func() {
+		BeforeEach(func() {
+			backupConfig.Inventory = nil
+		})
+
+		It("should do nothing if there is no policy", func() {
+			policies.EXPECT().Get(ctx, "bucket", accountName).Return(nil, nil)
+
+			Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should delete the policy if it only contains the rule for the backup container", func() {
+			policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(inventoryRule("bucket", defaultInventoryContainer)), nil)
+			policies.EXPECT().Delete(ctx, "bucket", accountName)
+
+			Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should only remove the rule for the backup container if the policy contains foreign rules", func() {
+			policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(foreignRule, inventoryRule("bucket", defaultInventoryContainer)), nil)
+			policies.EXPECT().CreateOrUpdate(ctx, "bucket", accountName, *withRules(foreignRule))
+
+			Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should not touch a policy without the rule for the backup container", func() {
+			policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(foreignRule), nil)
+
+			Expect(ensureInventoryPolicy(ctx, factory, blobStorageClient, backupBucket, backupConfig)).To(Succeed())
+		})
+	})
+})
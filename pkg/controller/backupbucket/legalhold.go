@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azurev1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// ensureLegalHold reconciles the legal hold of the backup container. Configured tags which are missing are added and
+// recorded in the provider status of the backup bucket. Tags which are no longer configured are only removed if they
+// were added by the extension, hence tags set by others, e.g. directly in Azure, are never lifted. Nothing is done if
+// no legal hold is configured and no tags are recorded, so that the credentials do not need permissions for legal holds.
+func (a *actuator) ensureLegalHold(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig) error {
+	status, err := helper.BackupBucketStatusFromBackupBucket(backupBucket)
+	if err != nil {
+		return err
+	}
+	if backupConfig.LegalHold == nil && len(status.LegalHoldTags) == 0 {
+		return nil
+	}
+
+	containersClient, err := factory.BlobContainers()
+	if err != nil {
+		return err
+	}
+
+	storageAccountName := storageAccountName(backupBucket)
+	container, err := containersClient.Get(ctx, backupBucket.Name, storageAccountName, backupBucket.Name)
+	if err != nil {
+		return err
+	}
+	if container == nil {
+		return nil
+	}
+
+	// Azure normalizes the tags to lower case.
+	desired := sets.New[string]()
+	if backupConfig.LegalHold != nil {
+		for _, tag := range backupConfig.LegalHold.Tags {
+			desired.Insert(strings.ToLower(tag))
+		}
+	}
+	var (
+		current = legalHoldTags(container.ContainerProperties)
+		// Recorded tags which were already removed by others are forgotten.
+		managed = sets.New(status.LegalHoldTags...).Intersection(current)
+	)
+
+	missing := desired.Difference(current)
+	if missing.Len() > 0 {
+		if err := containersClient.SetLegalHold(ctx, backupBucket.Name, storageAccountName, backupBucket.Name, sets.List(missing)); err != nil {
+			return fmt.Errorf("failed to set the legal hold of the backup container: %w", err)
+		}
+	}
+	obsolete := managed.Difference(desired)
+	if obsolete.Len() > 0 {
+		if err := containersClient.ClearLegalHold(ctx, backupBucket.Name, storageAccountName, backupBucket.Name, sets.List(obsolete)); err != nil {
+			return fmt.Errorf("failed to clear the legal hold of the backup container: %w", err)
+		}
+	}
+
+	return a.updateLegalHoldStatus(ctx, backupBucket, status, sets.List(managed.Difference(obsolete).Union(missing)))
+}
+
+func (a *actuator) updateLegalHoldStatus(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, status *azure.BackupBucketStatus, legalHoldTags []string) error {
+	if sets.New(status.LegalHoldTags...).Equal(sets.New(legalHoldTags...)) {
+		return nil
+	}
+
+	statusV1alpha1 := &azurev1alpha1.BackupBucketStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: azurev1alpha1.SchemeGroupVersion.String(),
+			Kind:       "BackupBucketStatus",
+		},
+	}
+	status.LegalHoldTags = legalHoldTags
+	if err := helper.Scheme.Convert(status, statusV1alpha1, nil); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	backupBucket.Status.ProviderStatus = &runtime.RawExtension{Object: statusV1alpha1}
+	return a.client.Status().Patch(ctx, backupBucket, patch)
+}
+
+func legalHoldTags(properties *armstorage.ContainerProperties) sets.Set[string] {
+	tags := sets.New[string]()
+	if properties == nil || properties.LegalHold == nil {
+		return tags
+	}
+	for _, tag := range properties.LegalHold.Tags {
+		if tag != nil && tag.Tag != nil {
+			tags.Insert(strings.ToLower(*tag.Tag))
+		}
+	}
+	return tags
+}
+
+// hasLegalHold checks whether the backup container is supposed to be under legal hold.
+func hasLegalHold(backupConfig *azure.BackupBucketConfig) bool {
+	return backupConfig.LegalHold != nil && len(backupConfig.LegalHold.Tags) > 0
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+var _ = Describe("LegalHold", func() {
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		c          client.Client
+		factory    *mockazureclient.MockFactory
+		containers *mockazureclient.MockBlobContainers
+		a          *actuator
+
+		backupBucket   *extensionsv1alpha1.BackupBucket
+		backupConfig   *azure.BackupBucketConfig
+		accountName    string
+		containerTags  []string
+		recordedTags   []string
+		withProperties = func(tags ...string) *armstorage.BlobContainer {
+			legalHold := &armstorage.LegalHoldProperties{}
+			for _, tag := range tags {
+				legalHold.Tags = append(legalHold.Tags, &armstorage.TagProperty{Tag: ptr.To(tag)})
+			}
+			return &armstorage.BlobContainer{ContainerProperties: &armstorage.ContainerProperties{LegalHold: legalHold}}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockazureclient.NewMockFactory(ctrl)
+		containers = mockazureclient.NewMockBlobContainers(ctrl)
+		factory.EXPECT().BlobContainers().Return(containers, nil).AnyTimes()
+
+		backupBucket = &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}}
+		backupConfig = &azure.BackupBucketConfig{}
+		accountName = storageAccountName(backupBucket)
+		containerTags = nil
+		recordedTags = nil
+	})
+
+	JustBeforeEach(func() {
+		if recordedTags != nil {
+			raw, err := json.Marshal(map[string]interface{}{
+				"apiVersion":    "azure.provider.extensions.gardener.cloud/v1alpha1",
+				"kind":          "BackupBucketStatus",
+				"legalHoldTags": recordedTags,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			backupBucket.Status.ProviderStatus = &runtime.RawExtension{Raw: raw}
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(backupBucket).WithStatusSubresource(backupBucket).Build()
+		a = &actuator{client: c}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectRecordedTags := func(tags ...string) {
+		stored := &extensionsv1alpha1.BackupBucket{}
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(backupBucket), stored)).To(Succeed())
+		status, err := helper.BackupBucketStatusFromBackupBucket(stored)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		if len(tags) == 0 {
+			ExpectWithOffset(1, status.LegalHoldTags).To(BeEmpty())
+			return
+		}
+		ExpectWithOffset(1, status.LegalHoldTags).To(ConsistOf(tags))
+	}
+
+	It("should do nothing if no legal hold is configured and no tags are recorded", func() {
+		Expect(a.ensureLegalHold(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+	})
+
+	It("should set the missing tags and record them", func() {
+		backupConfig.LegalHold = &azure.BackupBucketLegalHold{Tags: []string{"Case123", "case456"}}
+		containers.EXPECT().Get(ctx, "bucket", accountName, "bucket").Return(withProperties("case456"), nil)
+		containers.EXPECT().SetLegalHold(ctx, "bucket", accountName, "bucket", []string{"case123"})
+
+		Expect(a.ensureLegalHold(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		// case456 was set by others and is not taken over.
+		expectRecordedTags("case123")
+	})
+
+	It("should do nothing if the container does not exist", func() {
+		backupConfig.LegalHold = &azure.BackupBucketLegalHold{Tags: []string{"case123"}}
+		containers.EXPECT().Get(ctx, "bucket", accountName, "bucket").Return(nil, nil)
+
+		Expect(a.ensureLegalHold(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		expectRecordedTags()
+	})
+
+	Context("with recorded tags", func() {
+		BeforeEach(func() {
+			recordedTags = []string{"case123", "case789"}
+			containerTags = []string{"case123", "case456", "case789"}
+		})
+
+		It("should only clear the recorded tags which are no longer configured", func() {
+			backupConfig.LegalHold = &azure.BackupBucketLegalHold{Tags: []string{"case123"}}
+			containers.EXPECT().Get(ctx, "bucket", accountName, "bucket").Return(withProperties(containerTags...), nil)
+			containers.EXPECT().ClearLegalHold(ctx, "bucket", accountName, "bucket", []string{"case789"})
+
+			Expect(a.ensureLegalHold(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+			expectRecordedTags("case123")
+		})
+
+		It("should clear all recorded tags if the legal hold is omitted, but never foreign tags", func() {
+			containers.EXPECT().Get(ctx, "bucket", accountName, "bucket").Return(withProperties(containerTags...), nil)
+			containers.EXPECT().ClearLegalHold(ctx, "bucket", accountName, "bucket", []string{"case123", "case789"})
+
+			Expect(a.ensureLegalHold(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+			expectRecordedTags()
+		})
+
+		It("should forget recorded tags which were already cleared by others", func() {
+			backupConfig.LegalHold = &azure.BackupBucketLegalHold{Tags: []string{"case123", "case789"}}
+			containers.EXPECT().Get(ctx, "bucket", accountName, "bucket").Return(withProperties("case123", "case456"), nil)
+			containers.EXPECT().SetLegalHold(ctx, "bucket", accountName, "bucket", []string{"case789"})
+
+			Expect(a.ensureLegalHold(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+			expectRecordedTags("case123", "case789")
+		})
+	})
+})
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// lifecycleRuleName is the name of the rule of the lifecycle management policy which manages the backup container.
+const lifecycleRuleName = "gardener-backup"
+
+// ensureLifecyclePolicy reconciles the rule of the lifecycle management policy of the backup storage account which
+// manages the backup container. If lifecycle rules are configured, the rule tiers and deletes the blobs of the backup
+// container accordingly. If the lifecycle is configured without any rule, the rule is removed. Rules which were added
+// by others are left untouched, the policy is only deleted if no rule remains. Nothing is done if no lifecycle is
+// configured, so that the credentials do not need permissions for lifecycle management policies.
+func ensureLifecyclePolicy(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig) error {
+	if backupConfig.Lifecycle == nil {
+		return nil
+	}
+
+	policiesClient, err := factory.ManagementPolicies()
+	if err != nil {
+		return err
+	}
+
+	storageAccountName := storageAccountName(backupBucket)
+	current, err := policiesClient.Get(ctx, backupBucket.Name, storageAccountName)
+	if err != nil {
+		return err
+	}
+
+	desiredRule := lifecycleRule(backupBucket.Name, backupConfig.Lifecycle)
+	if desiredRule == nil {
+		if findLifecycleRule(current) == nil {
+			return nil
+		}
+		desired := lifecyclePolicy(current, nil)
+		if len(desired.Properties.Policy.Rules) == 0 {
+			return policiesClient.Delete(ctx, backupBucket.Name, storageAccountName)
+		}
+		_, err = policiesClient.CreateOrUpdate(ctx, backupBucket.Name, storageAccountName, desired)
+		return err
+	}
+
+	if lifecyclePolicyUpToDate(current, backupBucket.Name, backupConfig.Lifecycle) {
+		return nil
+	}
+	_, err = policiesClient.CreateOrUpdate(ctx, backupBucket.Name, storageAccountName, lifecyclePolicy(current, desiredRule))
+	return err
+}
+
+// lifecycleRule returns a lifecycle management rule which applies the given lifecycle rules to the block blobs of the
+// given backup container. It returns nil if no rule is configured.
+func lifecycleRule(backupContainer string, lifecycle *azure.BackupBucketLifecycle) *armstorage.ManagementPolicyRule {
+	if lifecycle == nil {
+		return nil
+	}
+
+	actions := &armstorage.ManagementPolicyBaseBlob{}
+	if days := lifecycle.TierToColdAfterDays; days != nil {
+		actions.TierToCold = &armstorage.DateAfterModification{DaysAfterModificationGreaterThan: to.Ptr(float32(*days))}
+	}
+	if days := lifecycle.DeleteAfterDays; days != nil {
+		actions.Delete = &armstorage.DateAfterModification{DaysAfterModificationGreaterThan: to.Ptr(float32(*days))}
+	}
+	if actions.TierToCold == nil && actions.Delete == nil {
+		return nil
+	}
+
+	return &armstorage.ManagementPolicyRule{
+		Name:    to.Ptr(lifecycleRuleName),
+		Enabled: to.Ptr(true),
+		Type:    to.Ptr(armstorage.RuleTypeLifecycle),
+		Definition: &armstorage.ManagementPolicyDefinition{
+			Actions: &armstorage.ManagementPolicyAction{BaseBlob: actions},
+			Filters: &armstorage.ManagementPolicyFilter{
+				BlobTypes:   to.SliceOfPtrs("blockBlob"),
+				PrefixMatch: to.SliceOfPtrs(backupContainer + "/"),
+			},
+		},
+	}
+}
+
+// lifecyclePolicy returns a lifecycle management policy with the rules of the current policy which were added by
+// others and the given rule. The given rule is omitted if it is nil.
+func lifecyclePolicy(current *armstorage.ManagementPolicy, rule *armstorage.ManagementPolicyRule) armstorage.ManagementPolicy {
+	var rules []*armstorage.ManagementPolicyRule
+	if current != nil && current.Properties != nil && current.Properties.Policy != nil {
+		for _, r := range current.Properties.Policy.Rules {
+			if r != nil && ptr.Deref(r.Name, "") != lifecycleRuleName {
+				rules = append(rules, r)
+			}
+		}
+	}
+	if rule != nil {
+		rules = append(rules, rule)
+	}
+
+	return armstorage.ManagementPolicy{
+		Properties: &armstorage.ManagementPolicyProperties{
+			Policy: &armstorage.ManagementPolicySchema{Rules: rules},
+		},
+	}
+}
+
+// findLifecycleRule returns the rule of the given lifecycle management policy which manages the backup container or nil
+// if there is none.
+func findLifecycleRule(policy *armstorage.ManagementPolicy) *armstorage.ManagementPolicyRule {
+	if policy == nil || policy.Properties == nil || policy.Properties.Policy == nil {
+		return nil
+	}
+	for _, rule := range policy.Properties.Policy.Rules {
+		if rule != nil && ptr.Deref(rule.Name, "") == lifecycleRuleName {
+			return rule
+		}
+	}
+	return nil
+}
+
+// lifecyclePolicyUpToDate checks whether the current lifecycle management policy contains the rule for the backup
+// container with the given lifecycle rules.
+func lifecyclePolicyUpToDate(current *armstorage.ManagementPolicy, backupContainer string, lifecycle *azure.BackupBucketLifecycle) bool {
+	rule := findLifecycleRule(current)
+	if rule == nil || !ptr.Deref(rule.Enabled, false) || rule.Definition == nil || rule.Definition.Filters == nil ||
+		rule.Definition.Actions == nil || rule.Definition.Actions.BaseBlob == nil {
+		return false
+	}
+
+	actions := rule.Definition.Actions.BaseBlob
+	return slices.Equal(derefAll(rule.Definition.Filters.PrefixMatch), []string{backupContainer + "/"}) &&
+		daysAfterModification(actions.TierToCold) == ptr.Deref(lifecycle.TierToColdAfterDays, -1) &&
+		daysAfterModification(actions.Delete) == ptr.Deref(lifecycle.DeleteAfterDays, -1)
+}
+
+// daysAfterModification returns the days after the last modification of the given action or -1 if it is not set.
+func daysAfterModification(action *armstorage.DateAfterModification) int32 {
+	if action == nil || action.DaysAfterModificationGreaterThan == nil {
+		return -1
+	}
+	return int32(*action.DaysAfterModificationGreaterThan)
+}
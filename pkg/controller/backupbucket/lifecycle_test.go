@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+var _ = Describe("Lifecycle", func() {
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		factory  *mockazureclient.MockFactory
+		policies *mockazureclient.MockManagementPolicies
+
+		backupBucket *extensionsv1alpha1.BackupBucket
+		backupConfig *azure.BackupBucketConfig
+		accountName  string
+		foreignRule  *armstorage.ManagementPolicyRule
+		withRules    = func(rules ...*armstorage.ManagementPolicyRule) *armstorage.ManagementPolicy {
+			return &armstorage.ManagementPolicy{Properties: &armstorage.ManagementPolicyProperties{Policy: &armstorage.ManagementPolicySchema{Rules: rules}}}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockazureclient.NewMockFactory(ctrl)
+		policies = mockazureclient.NewMockManagementPolicies(ctrl)
+		factory.EXPECT().ManagementPolicies().Return(policies, nil).AnyTimes()
+
+		backupBucket = &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{Name: "bucket"}}
+		backupConfig = &azure.BackupBucketConfig{Lifecycle: &azure.BackupBucketLifecycle{TierToColdAfterDays: ptr.To[int32](30), DeleteAfterDays: ptr.To[int32](90)}}
+		accountName = storageAccountName(backupBucket)
+		foreignRule = &armstorage.ManagementPolicyRule{Name: ptr.To("foreign"), Enabled: ptr.To(true)}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should not touch the policy if no lifecycle is configured", func() {
+		backupConfig.Lifecycle = nil
+		Expect(ensureLifecyclePolicy(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+	})
+
+	It("should add the rule for the backup container and keep foreign rules", func() {
+		policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(foreignRule), nil)
+		policies.EXPECT().CreateOrUpdate(ctx, "bucket", accountName, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, _ string, policy armstorage.ManagementPolicy) (*armstorage.ManagementPolicy, error) {
+				rules := policy.Properties.Policy.Rules
+				Expect(rules).To(HaveLen(2))
+				Expect(rules[0]).To(Equal(foreignRule))
+				Expect(rules[1].Name).To(Equal(ptr.To(lifecycleRuleName)))
+				Expect(rules[1].Definition.Filters.PrefixMatch).To(Equal([]*string{ptr.To("bucket/")}))
+				Expect(rules[1].Definition.Actions.BaseBlob.TierToCold.DaysAfterModificationGreaterThan).To(Equal(ptr.To[float32](30)))
+				Expect(rules[1].Definition.Actions.BaseBlob.Delete.DaysAfterModificationGreaterThan).To(Equal(ptr.To[float32](90)))
+				return &policy, nil
+			})
+
+		Expect(ensureLifecyclePolicy(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+	})
+
+	It("should not update the policy if the rule is up to date", func() {
+		policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(foreignRule, lifecycleRule("bucket", backupConfig.Lifecycle)), nil)
+
+		Expect(ensureLifecyclePolicy(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+	})
+
+	It("should update the rule if the lifecycle changed", func() {
+		policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(lifecycleRule("bucket", backupConfig.Lifecycle)), nil)
+		backupConfig.Lifecycle.DeleteAfterDays = ptr.To[int32](180)
+		policies.EXPECT().CreateOrUpdate(ctx, "bucket", accountName, lifecyclePolicy(nil, lifecycleRule("bucket", backupConfig.Lifecycle)))
+
+		Expect(ensureLifecyclePolicy(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+	})
+
+	Context("lifecycle without rules", func() {
+		BeforeEach(func() {
+			backupConfig.Lifecycle = &azure.BackupBucketLifecycle{}
+		})
+
+		It("should delete the policy if it only contains the rule for the backup container", func() {
+			policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(lifecycleRule("bucket", &azure.BackupBucketLifecycle{DeleteAfterDays: ptr.To[int32](90)})), nil)
+			policies.EXPECT().Delete(ctx, "bucket", accountName)
+
+			Expect(ensureLifecyclePolicy(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should only remove the rule for the backup container if the policy contains foreign rules", func() {
+			policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(foreignRule, lifecycleRule("bucket", &azure.BackupBucketLifecycle{DeleteAfterDays: ptr.To[int32](90)})), nil)
+			policies.EXPECT().CreateOrUpdate(ctx, "bucket", accountName, *withRules(foreignRule))
+
+			Expect(ensureLifecyclePolicy(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		})
+
+		It("should not touch a policy without the rule for the backup container", func() {
+			policies.EXPECT().Get(ctx, "bucket", accountName).Return(withRules(foreignRule), nil)
+
+			Expect(ensureLifecyclePolicy(ctx, factory, backupBucket, backupConfig)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/util"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// requeueAfterPendingCopies is the duration after which the restore progress is checked again if copies are still pending.
+const requeueAfterPendingCopies = 30 * time.Second
+
+type restoreSource struct {
+	resourceGroup  string
+	storageAccount string
+	container      string
+}
+
+func parseRestoreSource(value string) (*restoreSource, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid value %q for annotation %s, expected format '<resource-group>/<storage-account>/<container>'", value, azure.BackupBucketRestoreSourceAnnotation)
+	}
+	return &restoreSource{
+		resourceGroup:  parts[0],
+		storageAccount: parts[1],
+		container:      parts[2],
+	}, nil
+}
+
+// restoreContainerName returns the name of the container into which the contents of the restore source are copied.
+func restoreContainerName(backupBucket *extensionsv1alpha1.BackupBucket) string {
+	return backupBucket.Name + "-restore"
+}
+
+// newSourceBlobStorage returns the blob storage of the storage account of a restore source. Can be overridden for tests.
+var newSourceBlobStorage = func(ctx context.Context, storageAccountName, storageAccountKey, storageDomain string, options ...azureclient.BlobStorageClientOption) (azureclient.BlobStorage, error) {
+	return azureclient.NewBlobStorageClient(ctx, storageAccountName, storageAccountKey, storageDomain, options...)
+}
+
+// restore copies the contents of the container referenced by the restore source annotation into the restore container
+// of the backup bucket, so that the working container, which still receives new backups, is not modified. The source
+// container is only read, so it may be an immutable (locked) container. Blobs which already exist in the restore
+// container are not overwritten. Once all copies have finished, the annotation is removed from the backup bucket.
+func (a *actuator) restore(ctx context.Context, log logr.Logger, factory azureclient.Factory, blobStorage azureclient.BlobStorage, backupBucket *extensionsv1alpha1.BackupBucket, storageDomain string) error {
+	source, err := parseRestoreSource(backupBucket.Annotations[azure.BackupBucketRestoreSourceAnnotation])
+	if err != nil {
+		return err
+	}
+
+	storageAccountClient, err := factory.StorageAccount()
+	if err != nil {
+		return err
+	}
+	sourceStorageAccountKey, err := storageAccountClient.ListStorageAccountKey(ctx, source.resourceGroup, source.storageAccount)
+	if err != nil {
+		return util.DetermineError(fmt.Errorf("failed to get key of restore source storage account %s: %w", source.storageAccount, err), helper.KnownCodes)
+	}
+	sourceBlobStorage, err := newSourceBlobStorage(ctx, source.storageAccount, sourceStorageAccountKey, storageDomain, a.blobOptions...)
+	if err != nil {
+		return err
+	}
+
+	container := restoreContainerName(backupBucket)
+	if err := blobStorage.CreateContainerIfNotExists(ctx, container); err != nil {
+		return util.DetermineError(fmt.Errorf("failed to create restore container %s: %w", container, err), helper.KnownCodes)
+	}
+
+	log.Info("Restoring backup bucket contents", "sourceStorageAccount", source.storageAccount, "sourceContainer", source.container, "container", container)
+	pending, err := blobStorage.CopyContainer(ctx, sourceBlobStorage, source.container, container)
+	if err != nil {
+		return util.DetermineError(fmt.Errorf("failed to restore contents of container %s in storage account %s: %w", source.container, source.storageAccount, err), helper.KnownCodes)
+	}
+	if pending > 0 {
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("restore of backup bucket is in progress, %d copies are still pending", pending),
+			RequeueAfter: requeueAfterPendingCopies,
+		}
+	}
+
+	log.Info("Restored backup bucket contents, removing restore annotation", "container", container)
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	delete(backupBucket.Annotations, azure.BackupBucketRestoreSourceAnnotation)
+	return a.client.Patch(ctx, backupBucket, patch)
+}
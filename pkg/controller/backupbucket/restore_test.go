@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+var _ = Describe("Restore", func() {
+	const storageDomain = "blob.core.windows.net"
+
+	var (
+		ctx  = context.Background()
+		log  = logr.Discard()
+		ctrl *gomock.Controller
+
+		c               client.Client
+		factory         *mockazureclient.MockFactory
+		storageAccounts *mockazureclient.MockStorageAccount
+		blobStorage     *mockazureclient.MockBlobStorage
+		sourceStorage   *mockazureclient.MockBlobStorage
+		a               *actuator
+
+		backupBucket *extensionsv1alpha1.BackupBucket
+
+		oldNewSourceBlobStorage = newSourceBlobStorage
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockazureclient.NewMockFactory(ctrl)
+		storageAccounts = mockazureclient.NewMockStorageAccount(ctrl)
+		factory.EXPECT().StorageAccount().Return(storageAccounts, nil).AnyTimes()
+		blobStorage = mockazureclient.NewMockBlobStorage(ctrl)
+		sourceStorage = mockazureclient.NewMockBlobStorage(ctrl)
+		newSourceBlobStorage = func(_ context.Context, storageAccountName, storageAccountKey, domain string, _ ...azureclient.BlobStorageClientOption) (azureclient.BlobStorage, error) {
+			Expect(storageAccountName).To(Equal("sourceaccount"))
+			Expect(storageAccountKey).To(Equal("key"))
+			Expect(domain).To(Equal(storageDomain))
+			return sourceStorage, nil
+		}
+
+		backupBucket = &extensionsv1alpha1.BackupBucket{ObjectMeta: metav1.ObjectMeta{
+			Name:        "bucket",
+			Annotations: map[string]string{azuretypes.BackupBucketRestoreSourceAnnotation: "source-rg/sourceaccount/immutable"},
+		}}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(backupBucket).Build()
+		a = &actuator{client: c}
+	})
+
+	AfterEach(func() {
+		newSourceBlobStorage = oldNewSourceBlobStorage
+	})
+
+	It("should fail for an invalid restore source", func() {
+		backupBucket.Annotations[azuretypes.BackupBucketRestoreSourceAnnotation] = "sourceaccount/immutable"
+
+		Expect(a.restore(ctx, log, factory, blobStorage, backupBucket, storageDomain)).To(MatchError(ContainSubstring("expected format")))
+	})
+
+	It("should copy the source into the restore container and requeue while copies are pending", func() {
+		storageAccounts.EXPECT().ListStorageAccountKey(ctx, "source-rg", "sourceaccount").Return("key", nil)
+		blobStorage.EXPECT().CreateContainerIfNotExists(ctx, "bucket-restore")
+		blobStorage.EXPECT().CopyContainer(ctx, sourceStorage, "immutable", "bucket-restore").Return(2, nil)
+
+		err := a.restore(ctx, log, factory, blobStorage, backupBucket, storageDomain)
+		requeueErr := &reconcilerutils.RequeueAfterError{}
+		Expect(err).To(BeAssignableToTypeOf(requeueErr))
+		Expect(err).To(MatchError(ContainSubstring("2 copies are still pending")))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+		Expect(backupBucket.Annotations).To(HaveKey(azuretypes.BackupBucketRestoreSourceAnnotation))
+	})
+
+	It("should remove the annotation once all copies have finished", func() {
+		storageAccounts.EXPECT().ListStorageAccountKey(ctx, "source-rg", "sourceaccount").Return("key", nil)
+		blobStorage.EXPECT().CreateContainerIfNotExists(ctx, "bucket-restore")
+		blobStorage.EXPECT().CopyContainer(ctx, sourceStorage, "immutable", "bucket-restore").Return(0, nil)
+
+		Expect(a.restore(ctx, log, factory, blobStorage, backupBucket, storageDomain)).To(Succeed())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+		Expect(backupBucket.Annotations).NotTo(HaveKey(azuretypes.BackupBucketRestoreSourceAnnotation))
+	})
+
+	It("should keep the annotation if the copies cannot be started", func() {
+		storageAccounts.EXPECT().ListStorageAccountKey(ctx, "source-rg", "sourceaccount").Return("key", nil)
+		blobStorage.EXPECT().CreateContainerIfNotExists(ctx, "bucket-restore")
+		blobStorage.EXPECT().CopyContainer(ctx, sourceStorage, "immutable", "bucket-restore").Return(0, fmt.Errorf("forbidden"))
+
+		Expect(a.restore(ctx, log, factory, blobStorage, backupBucket, storageDomain)).To(MatchError(ContainSubstring("forbidden")))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+		Expect(backupBucket.Annotations).To(HaveKey(azuretypes.BackupBucketRestoreSourceAnnotation))
+	})
+})
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// defaultSASTokenValidity is the validity of the SAS tokens in the generated backup secret if none is configured.
+const defaultSASTokenValidity = 7 * 24 * time.Hour
+
+// rotationMode returns the configured rotation mode of the backup bucket credentials. Defaults to the storage account key.
+func rotationMode(backupConfig *azure.BackupBucketConfig) azure.RotationMode {
+	if backupConfig.Rotation == nil || backupConfig.Rotation.Mode == "" {
+		return azure.RotationModeKey
+	}
+	return backupConfig.Rotation.Mode
+}
+
+// sasTokenValidity returns the configured validity of the SAS tokens in the generated backup secret.
+func sasTokenValidity(backupConfig *azure.BackupBucketConfig) time.Duration {
+	if backupConfig.Rotation == nil || backupConfig.Rotation.SASTokenValidity == nil {
+		return defaultSASTokenValidity
+	}
+	return backupConfig.Rotation.SASTokenValidity.Duration
+}
+
+// sasTokenRenewalTime returns the point in time at which the SAS token of the given generated backup secret has to be
+// renewed. The token is renewed once two thirds of its validity have elapsed. The renewal time is additionally brought
+// forward by the tolerated clock skew, so that the token never expires earlier than expected from the view of the
+// storage service. It returns false if the secret contains no SAS token or its expiry time is unknown.
+func sasTokenRenewalTime(secret *corev1.Secret, validity time.Duration) (time.Time, bool) {
+	if _, ok := secret.Data[azuretypes.StorageSASToken]; !ok {
+		return time.Time{}, false
+	}
+	expiry, err := time.Parse(time.RFC3339, secret.Annotations[azuretypes.SASTokenExpiryAnnotation])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expiry.Add(-validity / 3).Add(-azureclient.SASClockSkew), true
+}
+
+// ensureGeneratedSecretCredentials ensures that the generated backup secret contains the credentials of the configured
+// rotation mode. In mode `sas`, the SAS token is renewed before it expires.
+func (a *actuator) ensureGeneratedSecretCredentials(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket, backupConfig *azure.BackupBucketConfig, storageDomain string) error {
+	secret, err := a.getBackupBucketGeneratedSecret(ctx, backupBucket)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mode     = rotationMode(backupConfig)
+		validity = sasTokenValidity(backupConfig)
+		now      = time.Now()
+	)
+
+	if secret != nil && secret.Data[azuretypes.StorageKey] != nil {
+		switch mode {
+		case azure.RotationModeKey:
+			if _, ok := secret.Data[azuretypes.StorageSASToken]; !ok {
+				return nil
+			}
+		case azure.RotationModeSAS:
+			if renewAt, ok := sasTokenRenewalTime(secret, validity); ok && now.Before(renewAt) {
+				return nil
+			}
+		}
+	}
+
+	storageAccountKey, err := a.storageAccountKey(ctx, factory, backupBucket)
+	if err != nil {
+		return err
+	}
+
+	if mode == azure.RotationModeKey {
+		return a.createBackupBucketGeneratedSecret(ctx, backupBucket, storageKeySecretData(storageAccountName(backupBucket), storageAccountKey, storageDomain), nil)
+	}
+
+	expiry := now.Add(validity)
+	sasToken, err := azureclient.NewContainerSASToken(storageAccountName(backupBucket), storageAccountKey, backupBucket.Name, now, expiry)
+	if err != nil {
+		return err
+	}
+	return a.createBackupBucketGeneratedSecret(ctx, backupBucket, sasTokenSecretData(storageAccountName(backupBucket), storageAccountKey, sasToken, storageDomain), &expiry)
+}
+
+// blobStorageClient returns a client for the blob storage of the backup bucket. If the generated backup secret was
+// written by an earlier version of the extension and only contains a SAS token, the client authenticates with the key
+// of the storage account, as container-scoped SAS tokens do not permit to create or delete the container itself.
+func (a *actuator) blobStorageClient(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket, storageDomain string) (*azureclient.BlobStorageClient, error) {
+	secret, err := a.getBackupBucketGeneratedSecret(ctx, backupBucket)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil || secret.Data[azuretypes.StorageSASToken] == nil {
+		return DefaultBlobStorageClient(ctx, a.client, backupBucket.Status.GeneratedSecretRef, a.blobOptions...)
+	}
+
+	storageAccountKey, err := a.storageAccountKey(ctx, factory, backupBucket)
+	if err != nil {
+		return nil, err
+	}
+	return azureclient.NewBlobStorageClient(ctx, storageAccountName(backupBucket), storageAccountKey, storageDomain, a.blobOptions...)
+}
+
+func (a *actuator) storageAccountKey(ctx context.Context, factory azureclient.Factory, backupBucket *extensionsv1alpha1.BackupBucket) (string, error) {
+	storageAccountClient, err := factory.StorageAccount()
+	if err != nil {
+		return "", err
+	}
+	return storageAccountClient.ListStorageAccountKey(ctx, backupBucket.Name, storageAccountName(backupBucket))
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+)
+
+var _ = Describe("Rotation", func() {
+	Describe("#rotationMode", func() {
+		It("should default to the storage account key", func() {
+			Expect(rotationMode(&azure.BackupBucketConfig{})).To(Equal(azure.RotationModeKey))
+			Expect(rotationMode(&azure.BackupBucketConfig{Rotation: &azure.RotationConfig{}})).To(Equal(azure.RotationModeKey))
+		})
+
+		It("should return the configured mode", func() {
+			Expect(rotationMode(&azure.BackupBucketConfig{Rotation: &azure.RotationConfig{Mode: azure.RotationModeSAS}})).To(Equal(azure.RotationModeSAS))
+		})
+	})
+
+	Describe("#sasTokenValidity", func() {
+		It("should default to one week", func() {
+			Expect(sasTokenValidity(&azure.BackupBucketConfig{})).To(Equal(7 * 24 * time.Hour))
+		})
+
+		It("should return the configured validity", func() {
+			Expect(sasTokenValidity(&azure.BackupBucketConfig{Rotation: &azure.RotationConfig{SASTokenValidity: &metav1.Duration{Duration: time.Hour}}})).To(Equal(time.Hour))
+		})
+	})
+
+	Describe("#sasTokenRenewalTime", func() {
+		var secret *corev1.Secret
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{azuretypes.SASTokenExpiryAnnotation: "2024-01-10T12:00:00Z"}},
+				Data:       map[string][]byte{azuretypes.StorageSASToken: []byte("token")},
+			}
+		})
+
+		It("should renew after two thirds of the validity, brought forward by the clock skew", func() {
+			renewAt, ok := sasTokenRenewalTime(secret, 3*time.Hour)
+			Expect(ok).To(BeTrue())
+			Expect(renewAt).To(Equal(time.Date(2024, 1, 10, 10, 45, 0, 0, time.UTC)))
+			Expect(azureclient.SASClockSkew).To(Equal(15 * time.Minute))
+		})
+
+		It("should return false if the secret contains no token", func() {
+			delete(secret.Data, azuretypes.StorageSASToken)
+			_, ok := sasTokenRenewalTime(secret, time.Hour)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return false if the expiry time is unknown", func() {
+			secret.Annotations[azuretypes.SASTokenExpiryAnnotation] = "invalid"
+			_, ok := sasTokenRenewalTime(secret, time.Hour)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("#ensureGeneratedSecretCredentials", func() {
+		const storageDomain = "blob.core.windows.net"
+
+		var (
+			ctx  = context.Background()
+			ctrl *gomock.Controller
+
+			c              client.Client
+			factory        *mockazureclient.MockFactory
+			storageAccount *mockazureclient.MockStorageAccount
+			a              *actuator
+
+			backupBucket    *extensionsv1alpha1.BackupBucket
+			backupConfig    *azure.BackupBucketConfig
+			generatedSecret *corev1.Secret
+			storageKey      = base64.StdEncoding.EncodeToString([]byte("storage-account-key"))
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			factory = mockazureclient.NewMockFactory(ctrl)
+			storageAccount = mockazureclient.NewMockStorageAccount(ctrl)
+			factory.EXPECT().StorageAccount().Return(storageAccount, nil).AnyTimes()
+
+			backupBucket = &extensionsv1alpha1.BackupBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+				Status: extensionsv1alpha1.BackupBucketStatus{
+					GeneratedSecretRef: &corev1.SecretReference{Name: "generated-bucket-bucket", Namespace: "garden"},
+				},
+			}
+			backupConfig = &azure.BackupBucketConfig{Rotation: &azure.RotationConfig{Mode: azure.RotationModeSAS}}
+			generatedSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "generated-bucket-bucket", Namespace: "garden"},
+				Data:       storageKeySecretData(storageAccountName(backupBucket), storageKey, storageDomain),
+			}
+		})
+
+		JustBeforeEach(func() {
+			c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(backupBucket, generatedSecret).WithStatusSubresource(backupBucket).Build()
+			a = &actuator{client: c}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		getGeneratedSecret := func() *corev1.Secret {
+			secret := &corev1.Secret{}
+			ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(generatedSecret), secret)).To(Succeed())
+			return secret
+		}
+
+		It("should keep the storage account key in mode key", func() {
+			backupConfig.Rotation = nil
+
+			Expect(a.ensureGeneratedSecretCredentials(ctx, factory, backupBucket, backupConfig, storageDomain)).To(Succeed())
+			Expect(getGeneratedSecret().Data).To(Equal(generatedSecret.Data))
+		})
+
+		It("should issue a SAS token and keep the storage account key in mode sas", func() {
+			storageAccount.EXPECT().ListStorageAccountKey(ctx, "bucket", storageAccountName(backupBucket)).Return(storageKey, nil)
+
+			Expect(a.ensureGeneratedSecretCredentials(ctx, factory, backupBucket, backupConfig, storageDomain)).To(Succeed())
+
+			secret := getGeneratedSecret()
+			Expect(secret.Data).To(HaveKeyWithValue(azuretypes.StorageKey, []byte(storageKey)))
+			Expect(secret.Data).To(HaveKeyWithValue(azuretypes.StorageAccount, []byte(storageAccountName(backupBucket))))
+			Expect(secret.Data).To(HaveKey(azuretypes.StorageSASToken))
+			expiry, err := time.Parse(time.RFC3339, secret.Annotations[azuretypes.SASTokenExpiryAnnotation])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expiry).To(BeTemporally("~", time.Now().Add(defaultSASTokenValidity), time.Minute))
+		})
+
+		Context("with a SAS token", func() {
+			BeforeEach(func() {
+				generatedSecret.Data[azuretypes.StorageSASToken] = []byte("token")
+				generatedSecret.Annotations = map[string]string{azuretypes.SASTokenExpiryAnnotation: time.Now().Add(defaultSASTokenValidity).UTC().Format(time.RFC3339)}
+			})
+
+			It("should not renew the token before it is due", func() {
+				Expect(a.ensureGeneratedSecretCredentials(ctx, factory, backupBucket, backupConfig, storageDomain)).To(Succeed())
+				Expect(getGeneratedSecret().Data).To(HaveKeyWithValue(azuretypes.StorageSASToken, []byte("token")))
+			})
+
+			It("should renew the token within the tolerated clock skew of the renewal time", func() {
+				secret := getGeneratedSecret()
+				secret.Annotations[azuretypes.SASTokenExpiryAnnotation] = time.Now().Add(defaultSASTokenValidity/3 + 10*time.Minute).UTC().Format(time.RFC3339)
+				Expect(c.Update(ctx, secret)).To(Succeed())
+				storageAccount.EXPECT().ListStorageAccountKey(ctx, "bucket", storageAccountName(backupBucket)).Return(storageKey, nil)
+
+				Expect(a.ensureGeneratedSecretCredentials(ctx, factory, backupBucket, backupConfig, storageDomain)).To(Succeed())
+				Expect(getGeneratedSecret().Data[azuretypes.StorageSASToken]).NotTo(Equal([]byte("token")))
+			})
+
+			It("should restore the storage account key of secrets which only contain a token", func() {
+				secret := getGeneratedSecret()
+				delete(secret.Data, azuretypes.StorageKey)
+				Expect(c.Update(ctx, secret)).To(Succeed())
+				storageAccount.EXPECT().ListStorageAccountKey(ctx, "bucket", storageAccountName(backupBucket)).Return(storageKey, nil)
+
+				Expect(a.ensureGeneratedSecretCredentials(ctx, factory, backupBucket, backupConfig, storageDomain)).To(Succeed())
+				Expect(getGeneratedSecret().Data).To(HaveKeyWithValue(azuretypes.StorageKey, []byte(storageKey)))
+			})
+
+			It("should remove the token when switching back to mode key", func() {
+				backupConfig.Rotation.Mode = azure.RotationModeKey
+				storageAccount.EXPECT().ListStorageAccountKey(ctx, "bucket", storageAccountName(backupBucket)).Return(storageKey, nil)
+
+				Expect(a.ensureGeneratedSecretCredentials(ctx, factory, backupBucket, backupConfig, storageDomain)).To(Succeed())
+
+				secret := getGeneratedSecret()
+				Expect(secret.Data).NotTo(HaveKey(azuretypes.StorageSASToken))
+				Expect(secret.Annotations).NotTo(HaveKey(azuretypes.SASTokenExpiryAnnotation))
+			})
+		})
+	})
+})
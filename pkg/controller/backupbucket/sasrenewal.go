@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+// SASRenewalControllerName is the name of the controller which triggers the renewal of the SAS tokens in the generated
+// backup secrets.
+const SASRenewalControllerName = "backupbucket-sas-renewal"
+
+// addSASRenewalController adds a controller which triggers a reconciliation of BackupBuckets in rotation mode `sas`
+// once the SAS token in their generated secret is due for renewal. BackupBuckets are otherwise only reconciled on
+// request, hence the token would expire without it.
+func addSASRenewalController(mgr manager.Manager, opts AddOptions) error {
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(SASRenewalControllerName).
+		WithOptions(opts.Controller).
+		For(&extensionsv1alpha1.BackupBucket{}, builder.WithPredicates(extensionspredicate.HasType(azuretypes.Type))).
+		Complete(&sasRenewalReconciler{client: mgr.GetClient()})
+}
+
+type sasRenewalReconciler struct {
+	client client.Client
+}
+
+func (r *sasRenewalReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := log.FromContext(ctx)
+
+	backupBucket := &extensionsv1alpha1.BackupBucket{}
+	if err := r.client.Get(ctx, req.NamespacedName, backupBucket); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if backupBucket.DeletionTimestamp != nil || backupBucket.Status.GeneratedSecretRef == nil {
+		return reconcile.Result{}, nil
+	}
+	// A reconciliation is already pending, it renews the token if necessary.
+	if v1beta1helper.HasOperationAnnotation(backupBucket.Annotations) {
+		return reconcile.Result{}, nil
+	}
+
+	// Invalid provider configs are reported by the actuator.
+	backupConfig, err := helper.BackupConfigFromBackupBucket(backupBucket)
+	if err != nil || rotationMode(&backupConfig) != azure.RotationModeSAS {
+		return reconcile.Result{}, nil
+	}
+
+	secret, err := kutil.GetSecretByReference(ctx, r.client, backupBucket.Status.GeneratedSecretRef)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if renewAt, ok := sasTokenRenewalTime(secret, sasTokenValidity(&backupConfig)); ok {
+		if now := time.Now(); now.Before(renewAt) {
+			return reconcile.Result{RequeueAfter: renewAt.Sub(now)}, nil
+		}
+	}
+
+	log.Info("Triggering reconciliation of BackupBucket to renew SAS token")
+	patch := client.MergeFrom(backupBucket.DeepCopy())
+	metav1.SetMetaDataAnnotation(&backupBucket.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	if err := r.client.Patch(ctx, backupBucket, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to trigger reconciliation of BackupBucket: %w", err)
+	}
+	return reconcile.Result{}, nil
+}
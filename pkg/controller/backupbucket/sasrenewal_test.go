@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+var _ = Describe("SASRenewal", func() {
+	var (
+		ctx = context.Background()
+
+		c          client.Client
+		reconciler *sasRenewalReconciler
+		request    reconcile.Request
+
+		backupBucket    *extensionsv1alpha1.BackupBucket
+		generatedSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		backupBucket = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+			Spec: extensionsv1alpha1.BackupBucketSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           azuretypes.Type,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","rotation":{"mode":"sas","sasTokenValidity":"3h"}}`)},
+				},
+			},
+			Status: extensionsv1alpha1.BackupBucketStatus{
+				GeneratedSecretRef: &corev1.SecretReference{Name: "generated-bucket-bucket", Namespace: "garden"},
+			},
+		}
+		generatedSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "generated-bucket-bucket",
+				Namespace:   "garden",
+				Annotations: map[string]string{azuretypes.SASTokenExpiryAnnotation: time.Now().Add(3 * time.Hour).UTC().Format(time.RFC3339)},
+			},
+			Data: map[string][]byte{azuretypes.StorageKey: []byte("key"), azuretypes.StorageSASToken: []byte("token")},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(backupBucket)}
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(backupBucket, generatedSecret).Build()
+		reconciler = &sasRenewalReconciler{client: c}
+	})
+
+	expectReconcileAnnotation := func(expected bool) {
+		ExpectWithOffset(1, c.Get(ctx, request.NamespacedName, backupBucket)).To(Succeed())
+		if expected {
+			ExpectWithOffset(1, backupBucket.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		} else {
+			ExpectWithOffset(1, backupBucket.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+		}
+	}
+
+	It("should requeue until the token is due for renewal", func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		// The token is renewed one third of its validity and the tolerated clock skew of 15m before it expires.
+		Expect(result.RequeueAfter).To(BeNumerically("~", 2*time.Hour-15*time.Minute, time.Minute))
+		expectReconcileAnnotation(false)
+	})
+
+	Context("token due for renewal", func() {
+		BeforeEach(func() {
+			generatedSecret.Annotations[azuretypes.SASTokenExpiryAnnotation] = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		})
+
+		It("should trigger a reconciliation of the backup bucket", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			expectReconcileAnnotation(true)
+		})
+
+		It("should not trigger a reconciliation if one is already pending", func() {
+			backupBucket.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile}
+			Expect(c.Update(ctx, backupBucket)).To(Succeed())
+			resourceVersion := backupBucket.ResourceVersion
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(c.Get(ctx, request.NamespacedName, backupBucket)).To(Succeed())
+			Expect(backupBucket.ResourceVersion).To(Equal(resourceVersion))
+		})
+	})
+
+	It("should trigger a reconciliation if the secret contains no token yet", func() {
+		generatedSecret.Annotations = nil
+		delete(generatedSecret.Data, azuretypes.StorageSASToken)
+		Expect(c.Update(ctx, generatedSecret)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		expectReconcileAnnotation(true)
+	})
+
+	Context("rotation mode key", func() {
+		BeforeEach(func() {
+			backupBucket.Spec.ProviderConfig = nil
+			generatedSecret.Annotations[azuretypes.SASTokenExpiryAnnotation] = time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+		})
+
+		It("should not trigger a reconciliation", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			expectReconcileAnnotation(false)
+		})
+	})
+
+	It("should ignore backup buckets which do not exist anymore", func() {
+		request.Name = "other"
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+})
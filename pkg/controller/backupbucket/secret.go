@@ -7,6 +7,7 @@ package backupbucket
 import (
 	"context"
 	"fmt"
+	"time"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -18,7 +19,7 @@ import (
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
-func (a *actuator) createBackupBucketGeneratedSecret(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, storageAccountName, storageKey, storageDomain string) error {
+func (a *actuator) createBackupBucketGeneratedSecret(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, data map[string][]byte, sasTokenExpiry *time.Time) error {
 	var generatedSecret = &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("generated-bucket-%s", backupBucket.Name),
@@ -27,10 +28,11 @@ func (a *actuator) createBackupBucketGeneratedSecret(ctx context.Context, backup
 	}
 
 	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, generatedSecret, func() error {
-		generatedSecret.Data = map[string][]byte{
-			azure.StorageAccount: []byte(storageAccountName),
-			azure.StorageKey:     []byte(storageKey),
-			azure.StorageDomain:  []byte(storageDomain),
+		generatedSecret.Data = data
+		if sasTokenExpiry != nil {
+			metav1.SetMetaDataAnnotation(&generatedSecret.ObjectMeta, azure.SASTokenExpiryAnnotation, sasTokenExpiry.UTC().Format(time.RFC3339))
+		} else {
+			delete(generatedSecret.Annotations, azure.SASTokenExpiryAnnotation)
 		}
 		return nil
 	}); err != nil {
@@ -45,6 +47,24 @@ func (a *actuator) createBackupBucketGeneratedSecret(ctx context.Context, backup
 	return a.client.Status().Patch(ctx, backupBucket, patch)
 }
 
+// storageKeySecretData returns the data of a generated backup secret which contains the key of the storage account.
+func storageKeySecretData(storageAccountName, storageKey, storageDomain string) map[string][]byte {
+	return map[string][]byte{
+		azure.StorageAccount: []byte(storageAccountName),
+		azure.StorageKey:     []byte(storageKey),
+		azure.StorageDomain:  []byte(storageDomain),
+	}
+}
+
+// sasTokenSecretData returns the data of a generated backup secret which contains a SAS token for the container of the
+// backup bucket in addition to the key of the storage account. The key is kept, as not all consumers of the backup
+// secret support SAS tokens, e.g. the deletion of backup entries.
+func sasTokenSecretData(storageAccountName, storageKey, sasToken, storageDomain string) map[string][]byte {
+	data := storageKeySecretData(storageAccountName, storageKey, storageDomain)
+	data[azure.StorageSASToken] = []byte(sasToken)
+	return data
+}
+
 // deleteBackupBucketGeneratedSecret deletes generated secret referred by core BackupBucket resource in garden.
 func (a *actuator) deleteBackupBucketGeneratedSecret(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket) error {
 	if backupBucket.Status.GeneratedSecretRef == nil {
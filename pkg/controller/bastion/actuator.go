@@ -10,6 +10,7 @@ import (
 	"crypto/rsa"
 	"encoding/json"
 	"fmt"
+	"net"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
@@ -18,6 +19,8 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	"github.com/go-logr/logr"
 	"golang.org/x/crypto/ssh"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -53,15 +56,15 @@ func createBastionInstance(ctx context.Context, factory azureclient.Factory, opt
 	return instance, nil
 }
 
-func createOrUpdatePublicIP(ctx context.Context, factory azureclient.Factory, opt *Options, parameters *armnetwork.PublicIPAddress) (*armnetwork.PublicIPAddress, error) {
+func createOrUpdatePublicIP(ctx context.Context, factory azureclient.Factory, opt *Options, name string, parameters *armnetwork.PublicIPAddress) (*armnetwork.PublicIPAddress, error) {
 	publicClient, err := factory.PublicIP()
 	if err != nil {
 		return nil, err
 	}
 
-	ip, err := publicClient.CreateOrUpdate(ctx, opt.ResourceGroupName, opt.BastionPublicIPName, *parameters)
+	ip, err := publicClient.CreateOrUpdate(ctx, opt.ResourceGroupName, name, *parameters)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create or update Public IP address %s: %w", opt.BastionPublicIPName, err)
+		return nil, fmt.Errorf("unable to create or update Public IP address %s: %w", name, err)
 	}
 	return ip, nil
 }
@@ -156,16 +159,16 @@ func getWorkersCIDR(cluster *controller.Cluster) ([]string, error) {
 	return nil, fmt.Errorf("InfrastructureConfig.Networks.Workers is nil")
 }
 
-func getPublicIP(ctx context.Context, log logr.Logger, factory azureclient.Factory, opt *Options) (*armnetwork.PublicIPAddress, error) {
+func getPublicIP(ctx context.Context, log logr.Logger, factory azureclient.Factory, opt *Options, name string) (*armnetwork.PublicIPAddress, error) {
 	ipClient, err := factory.PublicIP()
 	if err != nil {
 		return nil, err
 	}
 
-	ip, err := ipClient.Get(ctx, opt.ResourceGroupName, opt.BastionPublicIPName, nil)
+	ip, err := ipClient.Get(ctx, opt.ResourceGroupName, name, nil)
 	if err != nil {
 		if azureclient.IsAzureAPINotFoundError(err) {
-			log.Info("public IP not found,", "publicIP_name", opt.BastionPublicIPName)
+			log.Info("public IP not found,", "publicIP_name", name)
 			return nil, nil
 		}
 		return nil, err
@@ -186,19 +189,54 @@ func getSubnet(ctx context.Context, log logr.Logger, factory azureclient.Factory
 		sg = opt.ResourceGroupName
 	}
 
-	subnet, err := subnetClient.Get(ctx, sg, infrastructureStatus.Networks.VNet.Name, infrastructureStatus.Networks.Subnets[0].Name, nil)
+	subnetName, err := bastionSubnetName(infrastructureStatus, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	subnet, err := subnetClient.Get(ctx, sg, infrastructureStatus.Networks.VNet.Name, subnetName, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	if subnet == nil {
-		log.Info("subnet not found,", "subnet_name", infrastructureStatus.Networks.Subnets[0].Name)
+		log.Info("subnet not found,", "subnet_name", subnetName)
 		return nil, nil
 	}
 
 	return subnet, nil
 }
 
+// bastionSubnetName returns the name of the subnet the bastion host is placed in. If the bastion is pinned to a zone and
+// the shoot has dedicated subnets per zone, the subnet of the zone is used.
+func bastionSubnetName(infrastructureStatus *azure.InfrastructureStatus, opt *Options) (string, error) {
+	subnets := infrastructureStatus.Networks.Subnets
+	if opt.Zone == nil || subnets[0].Zone == nil {
+		return subnets[0].Name, nil
+	}
+
+	for _, subnet := range subnets {
+		if ptr.Equal(subnet.Zone, opt.Zone) {
+			return subnet.Name, nil
+		}
+	}
+	return "", fmt.Errorf("the shoot has no subnet in bastion zone %q", *opt.Zone)
+}
+
+// isDualStack returns true if the given subnet has an IPv6 address prefix.
+func isDualStack(subnet *armnetwork.Subnet) bool {
+	if subnet.Properties == nil {
+		return false
+	}
+
+	for _, prefix := range append([]*string{subnet.Properties.AddressPrefix}, subnet.Properties.AddressPrefixes...) {
+		if ip, _, err := net.ParseCIDR(ptr.Deref(prefix, "")); err == nil && ip.To4() == nil {
+			return true
+		}
+	}
+	return false
+}
+
 func deleteSecurityRuleDefinitionsByName(rulesArr []*armnetwork.SecurityRule, namesToRemove ...string) ([]*armnetwork.SecurityRule, bool) {
 	rulesWereDeleted := false
 	if rulesArr == nil {
@@ -219,18 +257,43 @@ rules:
 	return result, rulesWereDeleted
 }
 
-func equalNotNil(str1 *string, str2 *string) bool {
-	if str1 == nil || str2 == nil {
-		return false
+// bastionSecurityRuleNames returns the names of all security rules which may be created for the given bastion instance.
+func bastionSecurityRuleNames(bastionInstanceName string) []string {
+	return []string{
+		NSGIngressAllowSSHResourceNameIPv4(bastionInstanceName),
+		NSGIngressAllowSSHResourceNameIPv6(bastionInstanceName),
+		NSGEgressDenyAllResourceName(bastionInstanceName),
+		NSGEgressAllowOnlyResourceName(bastionInstanceName),
 	}
-	return str1 == str2
 }
 
-func notEqualNotNil(str1 *string, str2 *string) bool {
-	if str1 == nil || str2 == nil {
-		return false
+// securityRuleMatches checks whether the existing security rule grants the same access as the desired one. The address
+// prefixes are compared as sets, as Azure does not guarantee to return them in the order they were specified in.
+func securityRuleMatches(desired, existing *armnetwork.SecurityRule) bool {
+	if desired.Properties == nil || existing.Properties == nil {
+		return desired.Properties == existing.Properties
+	}
+	d, e := desired.Properties, existing.Properties
+
+	return addressPrefixes(d.SourceAddressPrefix, d.SourceAddressPrefixes).Equal(addressPrefixes(e.SourceAddressPrefix, e.SourceAddressPrefixes)) &&
+		addressPrefixes(d.DestinationAddressPrefix, d.DestinationAddressPrefixes).Equal(addressPrefixes(e.DestinationAddressPrefix, e.DestinationAddressPrefixes)) &&
+		ptr.Deref(d.DestinationPortRange, "") == ptr.Deref(e.DestinationPortRange, "") &&
+		ptr.Deref(d.Protocol, "") == ptr.Deref(e.Protocol, "") &&
+		ptr.Deref(d.Access, "") == ptr.Deref(e.Access, "") &&
+		ptr.Deref(d.Direction, "") == ptr.Deref(e.Direction, "")
+}
+
+func addressPrefixes(prefix *string, prefixes []*string) sets.Set[string] {
+	result := sets.New[string]()
+	if prefix != nil && *prefix != "" {
+		result.Insert(*prefix)
+	}
+	for _, p := range prefixes {
+		if p != nil {
+			result.Insert(*p)
+		}
 	}
-	return str1 != str2
+	return result
 }
 
 func createSSHPublicKey() (string, error) {
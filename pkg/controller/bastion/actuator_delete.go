@@ -108,15 +108,14 @@ func (a *actuator) ForceDelete(_ context.Context, _ logr.Logger, _ *extensionsv1
 func removeNSGRule(ctx context.Context, log logr.Logger, factory azureclient.Factory, opt *Options) error {
 	securityGroupResp, err := getNetworkSecurityGroup(ctx, log, factory, opt)
 	if err != nil {
+		if azureclient.IsAzureAPINotFoundError(err) {
+			// the security group was already deleted together with the infrastructure, hence there is nothing to clean up.
+			return nil
+		}
 		return err
 	}
 
-	rules := []string{
-		NSGIngressAllowSSHResourceNameIPv4(opt.BastionInstanceName),
-		NSGIngressAllowSSHResourceNameIPv6(opt.BastionInstanceName),
-		NSGEgressDenyAllResourceName(opt.BastionInstanceName),
-		NSGEgressAllowOnlyResourceName(opt.BastionInstanceName),
-	}
+	rules := bastionSecurityRuleNames(opt.BastionInstanceName)
 
 	modifiedRules, rulesWereDeleted := deleteSecurityRuleDefinitionsByName(securityGroupResp.Properties.SecurityRules, rules...)
 	securityGroupResp.Properties.SecurityRules = modifiedRules
@@ -139,12 +138,12 @@ func removePublicIP(ctx context.Context, log logr.Logger, factory azureclient.Fa
 		return err
 	}
 
-	err = publicClient.Delete(ctx, opt.ResourceGroupName, opt.BastionPublicIPName)
-	if err != nil {
-		return fmt.Errorf("failed to delete Public IP: %w", err)
+	for _, name := range []string{opt.BastionPublicIPName, opt.BastionPublicIPNameV6} {
+		if err := publicClient.Delete(ctx, opt.ResourceGroupName, name); err != nil {
+			return fmt.Errorf("failed to delete Public IP: %w", err)
+		}
+		log.Info("Public IP removed", "ip", name)
 	}
-
-	log.Info("Public IP removed", "ip", opt.BastionPublicIPName)
 	return nil
 }
 
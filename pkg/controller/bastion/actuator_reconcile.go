@@ -19,6 +19,8 @@ import (
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
@@ -77,12 +79,31 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return err
 	}
 
-	publicIP, err := ensurePublicIPAddress(ctx, log, clientFactory, opt)
+	subnet, err := getSubnet(ctx, log, clientFactory, infrastructureStatus, opt)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
+	if subnet == nil || subnet.ID == nil || *subnet.ID == "" {
+		return errors.New("virtual network subnet must be not empty")
+	}
+	opt.IPv6 = isDualStack(subnet)
+
+	var publicIP, publicIPv6 *armnetwork.PublicIPAddress
+	if !opt.PrivateOnly {
+		publicIP, err = ensurePublicIPAddress(ctx, log, clientFactory, opt, opt.BastionPublicIPName, armnetwork.IPVersionIPv4)
+		if err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
+		}
+
+		if opt.IPv6 {
+			publicIPv6, err = ensurePublicIPAddress(ctx, log, clientFactory, opt, opt.BastionPublicIPNameV6, armnetwork.IPVersionIPv6)
+			if err != nil {
+				return util.DetermineError(err, helper.KnownCodes)
+			}
+		}
+	}
 
-	nic, err := ensureNic(ctx, log, clientFactory, infrastructureStatus, opt, publicIP)
+	nic, err := ensureNic(ctx, log, clientFactory, opt, publicIP, publicIPv6, subnet)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -111,7 +132,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 	}
 
 	// check if the instance already exists and has an IP
-	endpoints, err := getInstanceEndpoints(nic, publicIP)
+	endpoints, err := getInstanceEndpoints(nic, publicIP, publicIPv6, opt)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -209,11 +230,19 @@ func ensureNetworkSecurityGroups(ctx context.Context, log logr.Logger, factory a
 		return err
 	}
 
-	if expectedNSGRulesPresentAndValid(networkSecGroupResp.Properties.SecurityRules, expectedNSGRuleList) {
+	// rules which are no longer desired, e.g. the IPv6 ingress rule after the IPv6 ranges were removed from the ingress,
+	// have to be removed so that the bastion host is only reachable from the requested ranges.
+	obsoleteRuleNames := sets.New(bastionSecurityRuleNames(opt.BastionInstanceName)...)
+	for _, rule := range expectedNSGRuleList {
+		obsoleteRuleNames.Delete(*rule.Name)
+	}
+	existingRules, rulesWereDeleted := deleteSecurityRuleDefinitionsByName(networkSecGroupResp.Properties.SecurityRules, sets.List(obsoleteRuleNames)...)
+
+	if !rulesWereDeleted && expectedNSGRulesPresentAndValid(existingRules, expectedNSGRuleList) {
 		return nil
 	}
 
-	networkSecGroupResp.Properties.SecurityRules = addOrReplaceNsgRulesDefinition(networkSecGroupResp.Properties.SecurityRules, expectedNSGRuleList)
+	networkSecGroupResp.Properties.SecurityRules = addOrReplaceNsgRulesDefinition(existingRules, expectedNSGRuleList)
 
 	if err := createOrUpdateNetworkSecGroup(ctx, factory, opt, networkSecGroupResp); err != nil {
 		return err
@@ -243,8 +272,10 @@ func prepareNSGRules(opt *Options) []*armnetwork.SecurityRule {
 		}
 	}
 
-	ipv4Name := NSGIngressAllowSSHResourceNameIPv4(opt.BastionInstanceName)
-	res = append(res, nsgIngressAllowSSH(ipv4Name, opt.PrivateIPAddressV4, ipv4cidr))
+	if len(ipv4cidr) > 0 {
+		ipv4Name := NSGIngressAllowSSHResourceNameIPv4(opt.BastionInstanceName)
+		res = append(res, nsgIngressAllowSSH(ipv4Name, opt.PrivateIPAddressV4, ipv4cidr))
+	}
 
 	if len(ipv6cidr) > 0 && opt.PrivateIPAddressV6 != "" {
 		ipv6Name := NSGIngressAllowSSHResourceNameIPv6(opt.BastionInstanceName)
@@ -254,8 +285,8 @@ func prepareNSGRules(opt *Options) []*armnetwork.SecurityRule {
 	return res
 }
 
-func ensurePublicIPAddress(ctx context.Context, log logr.Logger, factory azureclient.Factory, opt *Options) (*armnetwork.PublicIPAddress, error) {
-	publicIP, err := getPublicIP(ctx, log, factory, opt)
+func ensurePublicIPAddress(ctx context.Context, log logr.Logger, factory azureclient.Factory, opt *Options, name string, version armnetwork.IPVersion) (*armnetwork.PublicIPAddress, error) {
+	publicIP, err := getPublicIP(ctx, log, factory, opt, name)
 	if err != nil {
 		return nil, err
 	}
@@ -266,9 +297,9 @@ func ensurePublicIPAddress(ctx context.Context, log logr.Logger, factory azurecl
 		return publicIP, nil
 	}
 
-	parameters := publicIPAddressDefine(opt)
+	parameters := publicIPAddressDefine(opt, name, version)
 
-	publicIP, err = createOrUpdatePublicIP(ctx, factory, opt, parameters)
+	publicIP, err = createOrUpdatePublicIP(ctx, factory, opt, name, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +332,12 @@ func ensureComputeInstance(ctx context.Context, log logr.Logger, bastion *extens
 		return err
 	}
 
-	parameters := computeInstanceDefine(opt, bastion, publickey)
+	userData, err := generateUserData(bastion.Spec.UserData)
+	if err != nil {
+		return err
+	}
+
+	parameters := computeInstanceDefine(opt, userData, publickey)
 
 	_, err = createBastionInstance(ctx, factory, opt, parameters)
 	if err != nil {
@@ -310,30 +346,31 @@ func ensureComputeInstance(ctx context.Context, log logr.Logger, bastion *extens
 	return nil
 }
 
-func ensureNic(ctx context.Context, log logr.Logger, factory azureclient.Factory, infrastructureStatus *azure.InfrastructureStatus, opt *Options, publicIP *armnetwork.PublicIPAddress) (*armnetwork.Interface, error) {
+func ensureNic(ctx context.Context, log logr.Logger, factory azureclient.Factory, opt *Options, publicIP, publicIPv6 *armnetwork.PublicIPAddress, subnet *armnetwork.Subnet) (*armnetwork.Interface, error) {
 	nic, err := getNic(ctx, log, factory, opt)
 	if err != nil {
 		return nil, err
 	}
+	detachPublicIPs := nic != nil && opt.PrivateOnly && hasPublicIPAddress(nic)
 	if nic != nil {
 		if *nic.Properties.ProvisioningState != "Succeeded" {
 			return nil, fmt.Errorf("network interface with name %v is not in \"Succeeded\" status: %s", nic.Name, *nic.Properties.ProvisioningState)
 		}
-		return nic, nil
-	}
-
-	log.Info("create new bastion compute instance nic")
-
-	subnet, err := getSubnet(ctx, log, factory, infrastructureStatus, opt)
-	if err != nil {
-		return nil, err
-	}
-
-	if subnet == nil || *subnet.ID == "" {
-		return nil, errors.New("virtual network subnet must be not empty")
+		switch {
+		case detachPublicIPs:
+			log.Info("remove public IPs from bastion compute instance nic")
+		case !opt.PrivateOnly && !hasPublicIPAddress(nic):
+			log.Info("add public IPs to bastion compute instance nic")
+		case !opt.IPv6 || hasIPv6Configuration(nic):
+			return nic, nil
+		default:
+			log.Info("add IPv6 configuration to bastion compute instance nic")
+		}
+	} else {
+		log.Info("create new bastion compute instance nic")
 	}
 
-	parameters := nicDefine(opt, publicIP, subnet)
+	parameters := nicDefine(opt, publicIP, publicIPv6, subnet)
 
 	nicClient, err := factory.NetworkInterface()
 	if err != nil {
@@ -345,10 +382,35 @@ func ensureNic(ctx context.Context, log logr.Logger, factory azureclient.Factory
 		return nil, fmt.Errorf("failed to create bastion compute nic: %w", err)
 	}
 
+	// the public IPs of a bastion which was publicly reachable before can only be removed after they were detached.
+	if detachPublicIPs {
+		if err := removePublicIP(ctx, log, factory, opt); err != nil {
+			return nil, fmt.Errorf("failed to remove public ip: %w", err)
+		}
+	}
+
 	return nic, nil
 }
 
-func getInstanceEndpoints(nic *armnetwork.Interface, publicIP *armnetwork.PublicIPAddress) (*bastionEndpoints, error) {
+func hasPublicIPAddress(nic *armnetwork.Interface) bool {
+	for _, ipConfiguration := range nic.Properties.IPConfigurations {
+		if ipConfiguration.Properties != nil && ipConfiguration.Properties.PublicIPAddress != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIPv6Configuration(nic *armnetwork.Interface) bool {
+	for _, ipConfiguration := range nic.Properties.IPConfigurations {
+		if ipConfiguration.Properties != nil && ptr.Deref(ipConfiguration.Properties.PrivateIPAddressVersion, "") == armnetwork.IPVersionIPv6 {
+			return true
+		}
+	}
+	return false
+}
+
+func getInstanceEndpoints(nic *armnetwork.Interface, publicIP, publicIPv6 *armnetwork.PublicIPAddress, opt *Options) (*bastionEndpoints, error) {
 	endpoints := &bastionEndpoints{}
 
 	internalIP, err := getPrivateIPv4Address(nic)
@@ -363,7 +425,20 @@ func getInstanceEndpoints(nic *armnetwork.Interface, publicIP *armnetwork.Public
 	// Azure does not automatically assign a public dns name to the instance (in contrast to e.g. AWS).
 	// As we provide an externalIP to connect to the bastion, having a public dns name would just be an alternative way to connect to the bastion.
 	// Out of this reason, we spare the effort to create a PTR record (see https://docs.microsoft.com/en-us/azure/dns/dns-reverse-dns-hosting) just for the sake of having it.
-	externalIP := publicIP.Properties.IPAddress
+	// The status only holds a single public endpoint, the IPv6 address is published if the bastion is only accessible
+	// from IPv6 ranges.
+	// A bastion without public IPs is reached via private connectivity, hence its private address is published instead.
+	var externalIP *string
+	switch {
+	case opt.PrivateOnly && opt.PrivateIPAddressV6 != "" && ipv6Only(opt.CIDRs):
+		externalIP = &opt.PrivateIPAddressV6
+	case opt.PrivateOnly:
+		externalIP = &internalIP
+	case publicIPv6 != nil && ipv6Only(opt.CIDRs):
+		externalIP = publicIPv6.Properties.IPAddress
+	default:
+		externalIP = publicIP.Properties.IPAddress
+	}
 	if ingress := addressToIngress(nil, externalIP); ingress != nil {
 		endpoints.public = ingress
 	}
@@ -405,11 +480,8 @@ func expectedNSGRulesPresentAndValid(existingRules []*armnetwork.SecurityRule, e
 		ruleExistAndValid := false
 		for _, existingRule := range existingRules {
 			// compare firewall rules by its names because names here kind of "IDs"
-			if equalNotNil(desRule.Name, existingRule.Name) {
-				if notEqualNotNil(desRule.Properties.SourceAddressPrefix, existingRule.Properties.SourceAddressPrefix) {
-					return false
-				}
-				if notEqualNotNil(desRule.Properties.DestinationAddressPrefix, existingRule.Properties.DestinationAddressPrefix) {
+			if desRule.Name != nil && existingRule.Name != nil && *desRule.Name == *existingRule.Name {
+				if !securityRuleMatches(desRule, existingRule) {
 					return false
 				}
 				ruleExistAndValid = true
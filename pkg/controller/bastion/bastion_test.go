@@ -27,6 +27,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 func createRule(name, sourceAddrPrefix, destinationAddressPrefix string) *armnetwork.SecurityRule {
@@ -86,6 +87,18 @@ var _ = Describe("Bastion test", func() {
 			rules := prepareNSGRules(opt)
 			Expect(len(rules)).Should(Equal(3))
 		})
+		It("should not allow IPv4 ingress if only IPv6 ranges are requested", func() {
+			opt := &Options{
+				BastionInstanceName: "bastion",
+				PrivateIPAddressV4:  "1.1.1.1",
+				PrivateIPAddressV6:  "::2.2.2.2",
+				CIDRs:               []string{"2001:db8:3333:4444:5555:6666:7777:8888/128"},
+			}
+			rules := prepareNSGRules(opt)
+			Expect(rules).To(HaveLen(3))
+			Expect(RuleExist(ptr.To(NSGIngressAllowSSHResourceNameIPv4(opt.BastionInstanceName)), rules)).To(BeFalse())
+			Expect(RuleExist(ptr.To(NSGIngressAllowSSHResourceNameIPv6(opt.BastionInstanceName)), rules)).To(BeTrue())
+		})
 	})
 
 	Describe("Testing manipulations with Firewall Rules", func() {
@@ -108,6 +121,14 @@ var _ = Describe("Bastion test", func() {
 			Expect(expectedNSGRulesPresentAndValid(ruleSet1, ruleSet3)).To(Equal(false))
 		})
 
+		It("should compare the rules by value", func() {
+			existing := []*armnetwork.SecurityRule{nsgIngressAllowSSH("ruleName1", "1.1.1.1", []string{"8.8.8.8/32", "9.9.9.9/32"})}
+
+			Expect(expectedNSGRulesPresentAndValid(existing, []*armnetwork.SecurityRule{createRule("ruleName1", "*", "1.1.1.1")})).To(BeFalse())
+			Expect(expectedNSGRulesPresentAndValid(existing, []*armnetwork.SecurityRule{nsgIngressAllowSSH("ruleName1", "1.1.1.1", []string{"9.9.9.9/32", "8.8.8.8/32"})})).To(BeTrue())
+			Expect(expectedNSGRulesPresentAndValid(existing, []*armnetwork.SecurityRule{nsgIngressAllowSSH("ruleName1", "1.1.1.1", []string{"8.8.8.8/32"})})).To(BeFalse())
+		})
+
 		It("should add Rules with new priority numbers and delete old one", func() {
 			oldSet := []*armnetwork.SecurityRule{
 				createRuleWithPriority("defaultRule", 50),
@@ -175,6 +196,181 @@ var _ = Describe("Bastion test", func() {
 		})
 	})
 
+	Describe("#getZone", func() {
+		BeforeEach(func() {
+			cluster.CloudProfile.Spec.Regions[0].Zones = []gardencorev1beta1.AvailabilityZone{{Name: "1"}, {Name: "2"}}
+		})
+
+		It("should not pin the bastion to a zone by default", func() {
+			options, err := DetermineOptions(bastion, cluster, "cluster1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.Zone).To(BeNil())
+			Expect(options.BastionPublicIPNameV6).To(Equal("cluster1-bastionName1-bastion-1cdc8-public-ip-v6"))
+		})
+
+		It("should use the zone selected via annotation", func() {
+			cluster.Shoot.Annotations = map[string]string{azuretypes.AnnotationBastionZone: "2"}
+
+			options, err := DetermineOptions(bastion, cluster, "cluster1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.Zone).To(HaveValue(Equal("2")))
+		})
+
+		It("should fail for a zone which is not offered in the region", func() {
+			cluster.Shoot.Annotations = map[string]string{azuretypes.AnnotationBastionZone: "3"}
+
+			_, err := DetermineOptions(bastion, cluster, "cluster1")
+			Expect(err).To(MatchError(ContainSubstring(`bastion zone "3"`)))
+		})
+	})
+
+	Describe("#bastionSubnetName", func() {
+		var infrastructureStatus *api.InfrastructureStatus
+
+		BeforeEach(func() {
+			infrastructureStatus = &api.InfrastructureStatus{Networks: api.NetworkStatus{Subnets: []api.Subnet{
+				{Name: "nodes-z1", Zone: ptr.To("1")},
+				{Name: "nodes-z2", Zone: ptr.To("2")},
+			}}}
+		})
+
+		It("should use the first subnet if the bastion is not pinned to a zone", func() {
+			Expect(bastionSubnetName(infrastructureStatus, &Options{})).To(Equal("nodes-z1"))
+		})
+
+		It("should use the subnet of the bastion zone", func() {
+			Expect(bastionSubnetName(infrastructureStatus, &Options{Zone: ptr.To("2")})).To(Equal("nodes-z2"))
+		})
+
+		It("should use the only subnet of a shoot without zonal subnets", func() {
+			infrastructureStatus.Networks.Subnets = []api.Subnet{{Name: "nodes"}}
+			Expect(bastionSubnetName(infrastructureStatus, &Options{Zone: ptr.To("2")})).To(Equal("nodes"))
+		})
+
+		It("should fail if there is no subnet in the bastion zone", func() {
+			_, err := bastionSubnetName(infrastructureStatus, &Options{Zone: ptr.To("3")})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("IPv6 access", func() {
+		var (
+			opt      *Options
+			subnet   *armnetwork.Subnet
+			publicIP = &armnetwork.PublicIPAddress{Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.30.40.50")}}
+			publicV6 = &armnetwork.PublicIPAddress{Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("2001:db8::1")}}
+			nic      = &armnetwork.Interface{Properties: &armnetwork.InterfacePropertiesFormat{IPConfigurations: []*armnetwork.InterfaceIPConfiguration{
+				{Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{PrivateIPAddress: ptr.To("10.250.0.4")}},
+				{Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{PrivateIPAddress: ptr.To("fd00::4"), PrivateIPAddressVersion: ptr.To(armnetwork.IPVersionIPv6)}},
+			}}}
+		)
+
+		BeforeEach(func() {
+			opt = &Options{NicName: "nic", Location: "westeurope", Zone: ptr.To("1")}
+			subnet = &armnetwork.Subnet{
+				ID: ptr.To("subnet-id"),
+				Properties: &armnetwork.SubnetPropertiesFormat{
+					AddressPrefixes: []*string{ptr.To("10.250.0.0/16"), ptr.To("fd00::/64")},
+				},
+			}
+		})
+
+		It("should detect dual-stack subnets", func() {
+			Expect(isDualStack(subnet)).To(BeTrue())
+
+			subnet.Properties.AddressPrefixes = nil
+			subnet.Properties.AddressPrefix = ptr.To("10.250.0.0/16")
+			Expect(isDualStack(subnet)).To(BeFalse())
+		})
+
+		It("should define a nic with an additional IPv6 configuration", func() {
+			opt.IPv6 = true
+			definition := nicDefine(opt, publicIP, publicV6, subnet)
+			Expect(definition.Properties.IPConfigurations).To(HaveLen(2))
+			Expect(definition.Properties.IPConfigurations[0].Properties.Primary).To(HaveValue(BeTrue()))
+			Expect(definition.Properties.IPConfigurations[1].Properties.PrivateIPAddressVersion).To(HaveValue(Equal(armnetwork.IPVersionIPv6)))
+			Expect(definition.Properties.IPConfigurations[1].Properties.PublicIPAddress).To(Equal(publicV6))
+			Expect(hasIPv6Configuration(nic)).To(BeTrue())
+		})
+
+		It("should place the public IP in the bastion zone", func() {
+			definition := publicIPAddressDefine(opt, "ip-v6", armnetwork.IPVersionIPv6)
+			Expect(definition.Zones).To(ConsistOf(HaveValue(Equal("1"))))
+			Expect(definition.Properties.PublicIPAddressVersion).To(HaveValue(Equal(armnetwork.IPVersionIPv6)))
+		})
+
+		It("should publish the IPv4 endpoint if IPv4 ranges are allowed", func() {
+			opt.CIDRs = []string{"213.69.151.0/24", "2001:db8::/32"}
+
+			endpoints, err := getInstanceEndpoints(nic, publicIP, publicV6, opt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints.public.IP).To(Equal("20.30.40.50"))
+		})
+
+		It("should publish the IPv6 endpoint if only IPv6 ranges are allowed", func() {
+			opt.CIDRs = []string{"2001:db8::/32"}
+
+			endpoints, err := getInstanceEndpoints(nic, publicIP, publicV6, opt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints.public.IP).To(Equal("2001:db8::1"))
+			Expect(endpoints.private.IP).To(Equal("10.250.0.4"))
+		})
+	})
+
+	Describe("private-only access", func() {
+		var (
+			opt    *Options
+			subnet = &armnetwork.Subnet{ID: ptr.To("subnet-id")}
+			nic    = &armnetwork.Interface{Properties: &armnetwork.InterfacePropertiesFormat{IPConfigurations: []*armnetwork.InterfaceIPConfiguration{
+				{Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{PrivateIPAddress: ptr.To("10.250.0.4")}},
+			}}}
+		)
+
+		BeforeEach(func() {
+			opt = &Options{NicName: "nic", Location: "westeurope", PrivateOnly: true, PrivateIPAddressV4: "10.250.0.4", CIDRs: []string{"10.0.0.0/8"}}
+		})
+
+		It("should not create the bastion without public IPs by default", func() {
+			options, err := DetermineOptions(bastion, cluster, "cluster1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.PrivateOnly).To(BeFalse())
+		})
+
+		It("should create the bastion without public IPs if requested via annotation", func() {
+			cluster.Shoot.Annotations = map[string]string{azuretypes.AnnotationBastionPrivateOnly: "true"}
+
+			options, err := DetermineOptions(bastion, cluster, "cluster1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.PrivateOnly).To(BeTrue())
+		})
+
+		It("should define a nic without public IPs", func() {
+			opt.IPv6 = true
+			definition := nicDefine(opt, nil, nil, subnet)
+			Expect(definition.Properties.IPConfigurations).To(HaveLen(2))
+			Expect(definition.Properties.IPConfigurations[0].Properties.PublicIPAddress).To(BeNil())
+			Expect(definition.Properties.IPConfigurations[1].Properties.PublicIPAddress).To(BeNil())
+			Expect(hasPublicIPAddress(nic)).To(BeFalse())
+		})
+
+		It("should publish the private IPv4 endpoint", func() {
+			endpoints, err := getInstanceEndpoints(nic, nil, nil, opt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints.Ready()).To(BeTrue())
+			Expect(endpoints.public.IP).To(Equal("10.250.0.4"))
+			Expect(endpoints.private.IP).To(Equal("10.250.0.4"))
+		})
+
+		It("should publish the private IPv6 endpoint if only IPv6 ranges are allowed", func() {
+			opt.CIDRs = []string{"fd00::/8"}
+			opt.PrivateIPAddressV6 = "fd00::4"
+
+			endpoints, err := getInstanceEndpoints(nic, nil, nil, opt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoints.public.IP).To(Equal("fd00::4"))
+		})
+	})
+
 	Describe("check Names generations", func() {
 		It("should generate idempotent name", func() {
 			expected := "clusterName-shortName-bastion-79641"
@@ -284,6 +480,28 @@ var _ = Describe("Bastion test", func() {
 		})
 	})
 
+	Describe("#generateUserData", func() {
+		It("should only contain the audit configuration if no user data is given", func() {
+			userData, err := generateUserData(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(userData)).To(HavePrefix("Content-Type: multipart/mixed"))
+			Expect(string(userData)).To(ContainSubstring("ForceCommand " + sessionCommandPath))
+			Expect(string(userData)).To(ContainSubstring("TMOUT=1800"))
+			Expect(string(userData)).NotTo(ContainSubstring("text/x-shellscript"))
+		})
+
+		It("should append the given user data", func() {
+			userData, err := generateUserData([]byte("#!/bin/bash\necho gardener"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(userData)).To(ContainSubstring("text/x-shellscript"))
+			Expect(string(userData)).To(ContainSubstring("echo gardener"))
+		})
+
+		It("should keep cloud-config user data as cloud-config", func() {
+			Expect(userDataContentType([]byte("#cloud-config\nruncmd: []"))).To(Equal("text/cloud-config"))
+		})
+	})
+
 	Describe("check getPrivateIPAddress ", func() {
 		nic := &armnetwork.Interface{
 			Properties: &armnetwork.InterfacePropertiesFormat{
@@ -15,12 +15,15 @@ import (
 	"github.com/Azure/go-autorest/autorest/to"
 	extensionsbastion "github.com/gardener/gardener/extensions/pkg/bastion"
 	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 // Maximum length for "base" name due to fact that we use this name to name other Azure resources,
@@ -32,22 +35,30 @@ const maxLengthForBaseName = 33
 // bastion instance name with the IDs of pre-existing cloud provider
 // resources, like the nic name etc.
 type Options struct {
-	BastionInstanceName string
-	BastionPublicIPName string
-	PrivateIPAddressV4  string
-	PrivateIPAddressV6  string
-	ResourceGroupName   string
-	SecurityGroupName   string
-	Location            string
-	NicName             string
-	NicID               string
-	DiskName            string
-	SecretReference     corev1.SecretReference
-	WorkersCIDR         []string
-	CIDRs               []string
-	Tags                map[string]*string
-	MachineType         string
-	ImageRef            *armcompute.ImageReference
+	BastionInstanceName   string
+	BastionPublicIPName   string
+	BastionPublicIPNameV6 string
+	PrivateIPAddressV4    string
+	PrivateIPAddressV6    string
+	ResourceGroupName     string
+	SecurityGroupName     string
+	Location              string
+	Zone                  *string
+	NicName               string
+	NicID                 string
+	DiskName              string
+	SecretReference       corev1.SecretReference
+	WorkersCIDR           []string
+	CIDRs                 []string
+	Tags                  map[string]*string
+	MachineType           string
+	ImageRef              *armcompute.ImageReference
+	// IPv6 specifies whether the bastion is additionally reachable via IPv6. It is determined during the reconciliation
+	// based on the address prefixes of the subnet.
+	IPv6 bool
+	// PrivateOnly specifies whether the bastion is created without public IPs, i.e. it is only reachable via its private
+	// IP address.
+	PrivateOnly bool
 }
 
 // DetermineOptions determines the information that are required to reconcile a Bastion on Azure. This
@@ -94,20 +105,28 @@ func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.C
 		return nil, fmt.Errorf("failed to extract image from provider config: %w", err)
 	}
 
+	zone, err := getZone(cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Options{
-		BastionInstanceName: baseResourceName,
-		BastionPublicIPName: publicIPResourceName(baseResourceName),
-		SecretReference:     secretReference,
-		CIDRs:               cidrs,
-		WorkersCIDR:         workersCidr,
-		DiskName:            DiskResourceName(baseResourceName),
-		Location:            cluster.Shoot.Spec.Region,
-		ResourceGroupName:   resourceGroup,
-		NicName:             NicResourceName(baseResourceName),
-		Tags:                tags,
-		SecurityGroupName:   NSGName(clusterName),
-		MachineType:         machineSpec.MachineTypeName,
-		ImageRef:            imageRef,
+		BastionInstanceName:   baseResourceName,
+		BastionPublicIPName:   publicIPResourceName(baseResourceName),
+		BastionPublicIPNameV6: publicIPv6ResourceName(baseResourceName),
+		SecretReference:       secretReference,
+		CIDRs:                 cidrs,
+		WorkersCIDR:           workersCidr,
+		DiskName:              DiskResourceName(baseResourceName),
+		Location:              cluster.Shoot.Spec.Region,
+		Zone:                  zone,
+		ResourceGroupName:     resourceGroup,
+		NicName:               NicResourceName(baseResourceName),
+		Tags:                  tags,
+		SecurityGroupName:     NSGName(clusterName),
+		MachineType:           machineSpec.MachineTypeName,
+		ImageRef:              imageRef,
+		PrivateOnly:           kutil.HasMetaDataAnnotation(cluster.Shoot, azuretypes.AnnotationBastionPrivateOnly, "true"),
 	}, nil
 }
 
@@ -132,6 +151,39 @@ func generateBastionBaseResourceName(clusterName string, bastionName string) (st
 	return fmt.Sprintf("%s-bastion-%s", staticName, hash[:5]), nil
 }
 
+// getZone returns the availability zone selected for the bastion host via the shoot annotation. The zone must be
+// offered in the region of the shoot.
+func getZone(cluster *controller.Cluster) (*string, error) {
+	zone, ok := cluster.Shoot.Annotations[azuretypes.AnnotationBastionZone]
+	if !ok {
+		return nil, nil
+	}
+
+	regionIndex := slices.IndexFunc(cluster.CloudProfile.Spec.Regions, func(region gardencorev1beta1.Region) bool {
+		return region.Name == cluster.Shoot.Spec.Region
+	})
+	if regionIndex == -1 || !slices.ContainsFunc(cluster.CloudProfile.Spec.Regions[regionIndex].Zones, func(z gardencorev1beta1.AvailabilityZone) bool {
+		return z.Name == zone
+	}) {
+		return nil, fmt.Errorf("bastion zone %q selected via annotation %s is not available in region %s", zone, azuretypes.AnnotationBastionZone, cluster.Shoot.Spec.Region)
+	}
+	return &zone, nil
+}
+
+// ipv6Only returns true if the given CIDRs only contain IPv6 ranges.
+func ipv6Only(cidrs []string) bool {
+	if len(cidrs) == 0 {
+		return false
+	}
+	for _, cidr := range cidrs {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil || ip.To4() != nil {
+			return false
+		}
+	}
+	return true
+}
+
 func ingressPermissions(bastion *extensionsv1alpha1.Bastion) ([]string, error) {
 	var cidrs []string
 	for _, ingress := range bastion.Spec.Ingress {
@@ -210,6 +262,10 @@ func publicIPResourceName(baseName string) string {
 	return fmt.Sprintf("%s-public-ip", baseName)
 }
 
+func publicIPv6ResourceName(baseName string) string {
+	return fmt.Sprintf("%s-public-ip-v6", baseName)
+}
+
 // NSGIngressAllowSSHResourceNameIPv4 is network security group ingress allow ssh resource name
 func NSGIngressAllowSSHResourceNameIPv4(baseName string) string {
 	return fmt.Sprintf("%s-allow-ssh-ipv4", baseName)
@@ -10,50 +10,67 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
-	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"k8s.io/utils/ptr"
 )
 
-func nicDefine(opt *Options, publicIP *armnetwork.PublicIPAddress, subnet *armnetwork.Subnet) *armnetwork.Interface {
+func nicDefine(opt *Options, publicIP, publicIPv6 *armnetwork.PublicIPAddress, subnet *armnetwork.Subnet) *armnetwork.Interface {
+	ipConfigurations := []*armnetwork.InterfaceIPConfiguration{
+		{
+			Name: to.Ptr("ipConfig1"),
+			Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+				Subnet: &armnetwork.Subnet{
+					ID: subnet.ID,
+				},
+				Primary:                   to.Ptr(true),
+				PrivateIPAllocationMethod: to.Ptr(armnetwork.IPAllocationMethodDynamic),
+				PublicIPAddress:           publicIP,
+			},
+		},
+	}
+	if opt.IPv6 {
+		ipConfigurations = append(ipConfigurations, &armnetwork.InterfaceIPConfiguration{
+			Name: to.Ptr("ipConfig2"),
+			Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+				Subnet: &armnetwork.Subnet{
+					ID: subnet.ID,
+				},
+				PrivateIPAddressVersion:   to.Ptr(armnetwork.IPVersionIPv6),
+				PrivateIPAllocationMethod: to.Ptr(armnetwork.IPAllocationMethodDynamic),
+				PublicIPAddress:           publicIPv6,
+			},
+		})
+	}
+
 	return &armnetwork.Interface{
 		Name:     &opt.NicName,
 		Location: &opt.Location,
 		Properties: &armnetwork.InterfacePropertiesFormat{
-			IPConfigurations: []*armnetwork.InterfaceIPConfiguration{
-				{
-					Name: to.Ptr("ipConfig1"),
-					Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
-						Subnet: &armnetwork.Subnet{
-							ID: subnet.ID,
-						},
-						PrivateIPAllocationMethod: to.Ptr(armnetwork.IPAllocationMethodDynamic),
-						PublicIPAddress:           publicIP,
-					},
-				},
-			},
+			IPConfigurations: ipConfigurations,
 		},
 		Tags: opt.Tags,
 	}
 }
 
-func publicIPAddressDefine(opt *Options) *armnetwork.PublicIPAddress {
+func publicIPAddressDefine(opt *Options, name string, version armnetwork.IPVersion) *armnetwork.PublicIPAddress {
 	return &armnetwork.PublicIPAddress{
-		Name:     &opt.BastionPublicIPName,
+		Name:     &name,
 		Location: &opt.Location,
+		Zones:    zones(opt),
 		SKU: &armnetwork.PublicIPAddressSKU{
 			Name: to.Ptr(armnetwork.PublicIPAddressSKUNameStandard),
 		},
 		Properties: &armnetwork.PublicIPAddressPropertiesFormat{
-			PublicIPAddressVersion:   to.Ptr(armnetwork.IPVersionIPv4),
+			PublicIPAddressVersion:   to.Ptr(version),
 			PublicIPAllocationMethod: to.Ptr(armnetwork.IPAllocationMethodStatic),
 		},
 		Tags: opt.Tags,
 	}
 }
 
-func computeInstanceDefine(opt *Options, bastion *extensionsv1alpha1.Bastion, publickey string) armcompute.VirtualMachine {
+func computeInstanceDefine(opt *Options, userData []byte, publickey string) armcompute.VirtualMachine {
 	return armcompute.VirtualMachine{
 		Location: &opt.Location,
+		Zones:    zones(opt),
 		Properties: &armcompute.VirtualMachineProperties{
 			HardwareProfile: &armcompute.HardwareProfile{
 				VMSize: ptr.To(armcompute.VirtualMachineSizeTypes(opt.MachineType)),
@@ -83,7 +100,7 @@ func computeInstanceDefine(opt *Options, bastion *extensionsv1alpha1.Bastion, pu
 					},
 				},
 			},
-			UserData: to.Ptr(base64.StdEncoding.EncodeToString(bastion.Spec.UserData)),
+			UserData: to.Ptr(base64.StdEncoding.EncodeToString(userData)),
 			NetworkProfile: &armcompute.NetworkProfile{
 				NetworkInterfaces: []*armcompute.NetworkInterfaceReference{
 					{
@@ -149,3 +166,11 @@ func nsgEgressAllowSSHToWorkerIPv4(opt *Options) *armnetwork.SecurityRule {
 		},
 	}
 }
+
+// zones returns the availability zones of the bastion resources. It is empty if the bastion is not pinned to a zone.
+func zones(opt *Options) []*string {
+	if opt.Zone == nil {
+		return nil
+	}
+	return []*string{opt.Zone}
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// sessionIdleTimeout is the duration after which idle shell sessions on the bastion host are terminated.
+	sessionIdleTimeout = 30 * time.Minute
+
+	sshdConfigPath     = "/etc/ssh/sshd_config.d/90-gardener-bastion.conf"
+	sessionCommandPath = "/usr/local/bin/gardener-bastion-session"
+	idleTimeoutPath    = "/etc/profile.d/gardener-bastion-timeout.sh"
+	userDataBoundary   = "gardener-bastion-user-data"
+)
+
+// sshdConfig raises the log level of sshd, so that the fingerprints of the keys used to log in are logged, and forces
+// all sessions through the session command, which logs the executed commands. Forwarded connections, e.g. via
+// `ssh -J`, do not open a session and are not affected.
+var sshdConfig = fmt.Sprintf(`# Written by Gardener to audit the SSH sessions on the bastion host.
+LogLevel VERBOSE
+ClientAliveInterval 60
+ClientAliveCountMax 3
+ForceCommand %s
+`, sessionCommandPath)
+
+const sessionCommand = `#!/bin/sh
+# Written by Gardener to log the SSH sessions on the bastion host.
+logger -p authpriv.notice -t gardener-bastion "session opened user=${USER} client=\"${SSH_CLIENT}\" command=\"${SSH_ORIGINAL_COMMAND:-<shell>}\""
+if [ -n "${SSH_ORIGINAL_COMMAND}" ]; then
+  exec /bin/sh -c "${SSH_ORIGINAL_COMMAND}"
+fi
+exec "${SHELL:-/bin/sh}" -l
+`
+
+var idleTimeout = fmt.Sprintf(`# Written by Gardener to terminate idle shell sessions on the bastion host.
+TMOUT=%d
+readonly TMOUT
+export TMOUT
+`, int(sessionIdleTimeout.Seconds()))
+
+// reloadSSHD validates the sshd configuration before reloading sshd. The audit configuration is removed again if sshd
+// does not accept it, so that the bastion host stays reachable.
+var reloadSSHD = fmt.Sprintf("if /usr/sbin/sshd -t; then systemctl reload ssh || systemctl reload sshd; else rm -f %s; fi", sshdConfigPath)
+
+type cloudConfig struct {
+	WriteFiles []cloudConfigFile `json:"write_files"`
+	RunCmd     [][]string        `json:"runcmd"`
+}
+
+type userDataPart struct {
+	contentType string
+	content     []byte
+}
+
+type cloudConfigFile struct {
+	Path        string `json:"path"`
+	Permissions string `json:"permissions"`
+	Content     string `json:"content"`
+}
+
+// generateUserData returns the user data of the bastion host. It combines the cloud-init configuration which audits the
+// SSH sessions with the given user data of the Bastion in a multipart message, which is processed part by part by
+// cloud-init.
+func generateUserData(userData []byte) ([]byte, error) {
+	auditConfig, err := yaml.Marshal(cloudConfig{
+		WriteFiles: []cloudConfigFile{
+			{Path: sshdConfigPath, Permissions: "0644", Content: sshdConfig},
+			{Path: sessionCommandPath, Permissions: "0755", Content: sessionCommand},
+			{Path: idleTimeoutPath, Permissions: "0644", Content: idleTimeout},
+		},
+		RunCmd: [][]string{{"sh", "-c", reloadSSHD}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the cloud-init configuration of the bastion host: %w", err)
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.SetBoundary(userDataBoundary); err != nil {
+		return nil, err
+	}
+
+	parts := []userDataPart{{contentType: "text/cloud-config", content: append([]byte("#cloud-config\n"), auditConfig...)}}
+	if len(userData) > 0 {
+		parts = append(parts, userDataPart{contentType: userDataContentType(userData), content: userData})
+	}
+
+	for _, p := range parts {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":        {fmt.Sprintf("%s; charset=\"utf-8\"", p.contentType)},
+			"Mime-Version":        {"1.0"},
+			"Content-Disposition": {"attachment"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(p.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	header := fmt.Sprintf("Content-Type: multipart/mixed; boundary=%q\nMIME-Version: 1.0\n\n", userDataBoundary)
+	return append([]byte(header), body.Bytes()...), nil
+}
+
+// userDataContentType returns the content type of the given user data for cloud-init.
+func userDataContentType(userData []byte) string {
+	if bytes.HasPrefix(userData, []byte("#cloud-config")) {
+		return "text/cloud-config"
+	}
+	return "text/x-shellscript"
+}
@@ -17,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-azure/imagevector"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -37,6 +38,10 @@ type AddOptions struct {
 	ShootWebhookConfig *atomic.Value
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// RemedyController is the default configuration for the remedy controller.
+	RemedyController config.RemedyControllerConfig
+	// ImageVectorOverrides are the overrides of the images deployed for shoots of a cloud instance.
+	ImageVectorOverrides []config.ImageVectorOverride
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -45,7 +50,7 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	genericActuator, err := genericactuator.NewActuator(mgr, azure.Name,
 		secretConfigsFunc, shootAccessSecretsFunc, nil, nil,
 		configChart, controlPlaneChart, controlPlaneShootChart, controlPlaneShootCRDsChart, storageClassChart, nil,
-		NewValuesProvider(mgr), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
+		NewValuesProvider(mgr, opts.RemedyController, opts.ImageVectorOverrides), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
 		imagevector.ImageVector(), "", opts.ShootWebhookConfig, opts.WebhookServerNamespace)
 	if err != nil {
 		return err
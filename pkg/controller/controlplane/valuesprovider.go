@@ -7,7 +7,10 @@ package controlplane
 import (
 	"context"
 	"fmt"
+	"maps"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -20,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	gutil "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -34,14 +38,17 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-azure/charts"
+	"github.com/gardener/gardener-extension-provider-azure/imagevector"
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	azureapihelper "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
@@ -196,9 +203,6 @@ var (
 			},
 			{
 				Name: azure.CloudControllerManagerName,
-				Images: []string{
-					azure.CloudNodeManagerImageName,
-				},
 				Objects: []*chart.Object{
 					{Type: &rbacv1.ClusterRole{}, Name: "system:controller:cloud-node-controller"},
 					{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
@@ -290,18 +294,23 @@ var (
 )
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(mgr manager.Manager) genericactuator.ValuesProvider {
+func NewValuesProvider(mgr manager.Manager, remedyController config.RemedyControllerConfig, imageVectorOverrides []config.ImageVectorOverride) genericactuator.ValuesProvider {
 	return &valuesProvider{
-		client:  mgr.GetClient(),
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		client:               mgr.GetClient(),
+		decoder:              serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		remedyController:     remedyController,
+		imageVectorOverrides: imageVectorOverrides,
 	}
 }
 
 // valuesProvider is a ValuesProvider that provides azure-specific values for the 2 charts applied by the generic actuator.
 type valuesProvider struct {
 	genericactuator.NoopValuesProvider
-	client  k8sclient.Client
-	decoder runtime.Decoder
+	client           k8sclient.Client
+	decoder          runtime.Decoder
+	remedyController config.RemedyControllerConfig
+	// imageVectorOverrides are the overrides of the images of the charts for shoots of a cloud instance.
+	imageVectorOverrides []config.ImageVectorOverride
 }
 
 // GetConfigChartValues returns the values for the config chart applied by the generic actuator.
@@ -331,15 +340,16 @@ func (vp *valuesProvider) GetConfigChartValues(ctx context.Context, cp *extensio
 		return nil, fmt.Errorf("could not get service account from secret '%s/%s': %w", cp.Spec.SecretRef.Namespace, cp.Spec.SecretRef.Name, err)
 	}
 
-	// Check if the configmap for the acr access need to be removed.
-	if infraStatus.Identity == nil || !infraStatus.Identity.ACRAccess {
+	// Check if the configmap for the acr access need to be removed. It is only used by the legacy in-tree credential
+	// provider of the kubelet, the acr-credential-provider is configured by the OperatingSystemConfig webhook.
+	if ptr.Deref(azureapihelper.ACRAccessMode(infraStatus.Identity), "") != apisazure.ACRAccessModeConfigMap {
 		if err := vp.removeAcrConfig(ctx, cp.Namespace); err != nil {
 			return nil, fmt.Errorf("could not remove acr config map: %w", err)
 		}
 	}
 
 	// Get config chart values
-	return getConfigChartValues(infraStatus, cp, cluster, auth)
+	return getConfigChartValues(cpConfig, infraStatus, cp, cluster, auth)
 }
 
 // GetControlPlaneChartValues returns the values for the control plane chart applied by the generic actuator.
@@ -392,7 +402,11 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 		}
 	}
 
-	return getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, checksums, scaledDown, infraStatus, gep19Monitoring)
+	values, err := getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, checksums, scaledDown, infraStatus, gep19Monitoring, vp.remedyController)
+	if err != nil {
+		return nil, err
+	}
+	return vp.injectImageOverrides(values, controlPlaneChart, cluster)
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
@@ -403,7 +417,76 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	secretsReader secretsmanager.Reader,
 	_ map[string]string,
 ) (map[string]interface{}, error) {
-	return getControlPlaneShootChartValues(ctx, cp, cluster, secretsReader, vp.client)
+	// Decode providerConfig
+	cpConfig := &apisazure.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
+		}
+	}
+
+	imageOverrides, err := vp.imageOverridesForCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := getControlPlaneShootChartValues(ctx, cpConfig, cp, cluster, secretsReader, vp.client, imageOverrides)
+	if err != nil {
+		return nil, err
+	}
+	return vp.injectImageOverrides(values, controlPlaneShootChart, cluster)
+}
+
+// injectImageOverrides overrides the images of the sub charts of the given chart with the images configured for the
+// cloud instance of the shoot. The generic actuator merges the values with the images it found in the image vector.
+func (vp *valuesProvider) injectImageOverrides(values map[string]interface{}, c *chart.Chart, cluster *extensionscontroller.Cluster) (map[string]interface{}, error) {
+	overrides, err := vp.imageOverridesForCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subChart := range c.SubCharts {
+		images := map[string]interface{}{}
+		for _, name := range subChart.Images {
+			if image, ok := overrides[name]; ok {
+				images[name] = image
+			}
+		}
+		if len(images) == 0 {
+			continue
+		}
+
+		subChartValues, ok := values[subChart.Name].(map[string]interface{})
+		if !ok {
+			subChartValues = map[string]interface{}{}
+			values[subChart.Name] = subChartValues
+		}
+		subChartValues["images"] = images
+	}
+	return values, nil
+}
+
+// imageOverridesForCluster returns the images which are overridden for the cloud instance of the shoot, keyed by the
+// names of the images in the image vector.
+func (vp *valuesProvider) imageOverridesForCluster(cluster *extensionscontroller.Cluster) (map[string]string, error) {
+	if len(vp.imageVectorOverrides) == 0 {
+		return nil, nil
+	}
+
+	cloudProfileConfig, err := azureapihelper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	var cloudProfileCloudConfiguration *apisazure.CloudConfiguration
+	if cloudProfileConfig != nil {
+		cloudProfileCloudConfiguration = cloudProfileConfig.CloudConfiguration
+	}
+	cloudConfiguration, err := azureclient.CloudConfiguration(cloudProfileCloudConfiguration, &cluster.Shoot.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return imagevector.OverridesForCloud(vp.imageVectorOverrides, cloudConfiguration.Name), nil
 }
 
 // GetControlPlaneShootCRDsChartValues returns the values for the control plane shoot CRDs chart applied by the generic actuator.
@@ -423,7 +506,7 @@ func (vp *valuesProvider) GetControlPlaneShootCRDsChartValues(
 func (vp *valuesProvider) GetStorageClassesChartValues(
 	_ context.Context,
 	cp *extensionsv1alpha1.ControlPlane,
-	_ *extensionscontroller.Cluster,
+	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
 	// Decode providerConfig
 	cpConfig := &apisazure.ControlPlaneConfig{}
@@ -437,11 +520,72 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 	if cpConfig.Storage != nil {
 		values["managedDefaultStorageClass"] = ptr.Deref(cpConfig.Storage.ManagedDefaultStorageClass, true)
 		values["managedDefaultVolumeSnapshotClass"] = ptr.Deref(cpConfig.Storage.ManagedDefaultVolumeSnapshotClass, true)
+
+		if parameters := getVolumeSnapshotClassParameters(cpConfig.Storage.VolumeSnapshotClass); len(parameters) > 0 {
+			values["volumeSnapshotClassParameters"] = parameters
+		}
+	}
+
+	if zones := getStorageClassZones(cluster); len(zones) > 0 {
+		values["zones"] = zones
 	}
 
 	return values, nil
 }
 
+// getStorageClassZones returns the topology values of the zones of the shoot's worker pools, to which the disks of the
+// StorageClasses are restricted. The worker pools of the shoot are used instead of the Worker resource, as the control
+// plane is reconciled before the Worker when pools gain or lose zones. The StorageClasses are recreated on changes, as
+// their allowed topologies are immutable. Nil is returned if a worker pool is not zoned, as disks must then be
+// provisionable without a zone.
+func getStorageClassZones(cluster *extensionscontroller.Cluster) []string {
+	if cluster == nil || cluster.Shoot == nil {
+		return nil
+	}
+
+	zones := sets.New[string]()
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		if len(worker.Zones) == 0 {
+			return nil
+		}
+		for _, zone := range worker.Zones {
+			zones.Insert(cluster.Shoot.Spec.Region + "-" + zone)
+		}
+	}
+	return sets.List(zones)
+}
+
+// getVolumeSnapshotClassParameters returns the parameters of the 'default' VolumeSnapshotClass as understood by the
+// Azure disk CSI driver.
+func getVolumeSnapshotClassParameters(config *apisazure.VolumeSnapshotClassConfig) map[string]interface{} {
+	parameters := map[string]interface{}{}
+	if config == nil {
+		return parameters
+	}
+
+	if config.Incremental != nil {
+		parameters["incremental"] = strconv.FormatBool(*config.Incremental)
+	}
+	if config.ResourceGroup != nil {
+		parameters["resourceGroup"] = *config.ResourceGroup
+	}
+	if len(config.Tags) > 0 {
+		tags := make([]string, 0, len(config.Tags))
+		for key, value := range config.Tags {
+			tags = append(tags, key+"="+value)
+		}
+		slices.Sort(tags)
+		parameters["tags"] = strings.Join(tags, ",")
+	}
+
+	return parameters
+}
+
+// isSnapshotValidationWebhookEnabled returns true if the CSI snapshot validation webhook should be deployed.
+func isSnapshotValidationWebhookEnabled(cpConfig *apisazure.ControlPlaneConfig) bool {
+	return cpConfig.Storage == nil || ptr.Deref(cpConfig.Storage.SnapshotValidationWebhook, true)
+}
+
 func (vp *valuesProvider) removeAcrConfig(ctx context.Context, namespace string) error {
 	cm := corev1.ConfigMap{}
 	cm.SetName(azure.CloudProviderAcrConfigName)
@@ -450,7 +594,7 @@ func (vp *valuesProvider) removeAcrConfig(ctx context.Context, namespace string)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
-func getConfigChartValues(infraStatus *apisazure.InfrastructureStatus, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster, ca *internal.ClientAuth) (map[string]interface{}, error) {
+func getConfigChartValues(cpConfig *apisazure.ControlPlaneConfig, infraStatus *apisazure.InfrastructureStatus, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster, ca *internal.ClientAuth) (map[string]interface{}, error) {
 	subnetName, routeTableName, securityGroupName, err := getInfraNames(infraStatus)
 	if err != nil {
 		return nil, fmt.Errorf("could not determine subnet, availability set, route table or security group name from infrastructureStatus of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(cp), err)
@@ -476,38 +620,96 @@ func getConfigChartValues(infraStatus *apisazure.InfrastructureStatus, cp *exten
 		"maxNodes":          maxNodes,
 	}
 
-	cloudConfiguration, err := azureclient.CloudConfiguration(nil, &cluster.Shoot.Spec.Region)
+	cloudProfileConfig, err := azureapihelper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var cloudProfileCloudConfiguration *apisazure.CloudConfiguration
+	if cloudProfileConfig != nil {
+		cloudProfileCloudConfiguration = cloudProfileConfig.CloudConfiguration
+	}
+
+	cloudConfiguration, err := azureclient.CloudConfiguration(cloudProfileCloudConfiguration, &cluster.Shoot.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
 
-	values["cloud"] = cloudInstanceName(*cloudConfiguration)
+	values["cloud"] = azureclient.CloudInstanceName(*cloudConfiguration)
+	if cloudConfiguration.ResourceManagerEndpoint != nil {
+		// The cloud-provider-azure discovers the endpoints of private clouds from the metadata of the resource manager.
+		values["resourceManagerEndpoint"] = *cloudConfiguration.ResourceManagerEndpoint
+	}
 
 	if infraStatus.Networks.VNet.ResourceGroup != nil {
 		values["vnetResourceGroup"] = *infraStatus.Networks.VNet.ResourceGroup
 	}
 
-	if infraStatus.Identity != nil && infraStatus.Identity.ACRAccess {
+	if ptr.Deref(azureapihelper.ACRAccessMode(infraStatus.Identity), "") == apisazure.ACRAccessModeConfigMap {
 		values["acrIdentityClientId"] = infraStatus.Identity.ClientID
 	}
 
-	return appendMachineSetValues(values, infraStatus), nil
+	// cloud-provider-azure only supports a single default subnet for internal load balancers, services in other zones
+	// select their subnet via the annotation service.beta.kubernetes.io/azure-load-balancer-internal-subnet.
+	if subnetName := getFirstZoneSubnetName(infraStatus); subnetName != "" {
+		values["subnetName"] = subnetName
+	}
+
+	if cpConfig.CloudControllerManager != nil && cpConfig.CloudControllerManager.LoadBalancer != nil {
+		appendLoadBalancerValues(values, cpConfig.CloudControllerManager.LoadBalancer)
+	}
+	if infraStatus.Networks.OutboundLoadBalancer != nil {
+		// The egress traffic is handled by the outbound rule of the infrastructure, hence the load balancing rules of the
+		// cloud-controller-manager must not use the frontends of the services for SNAT.
+		values["disableOutboundSNAT"] = true
+	}
+
+	return appendMachineSetValues(values, infraStatus, cluster), nil
 }
 
-func cloudInstanceName(cloudConfiguration apisazure.CloudConfiguration) string {
-	switch {
-	case cloudConfiguration.Name == apisazure.AzureChinaCloudName:
-		return "AZURECHINACLOUD"
-	case cloudConfiguration.Name == apisazure.AzureGovCloudName:
-		return "AZUREUSGOVERNMENT"
-	default:
-		return "AZUREPUBLICCLOUD"
+// getFirstZoneSubnetName returns the name of the node subnet of the first zone if the cluster uses a dedicated subnet
+// per zone, so that the default subnet does not depend on the order of the subnets in the status.
+func getFirstZoneSubnetName(infraStatus *apisazure.InfrastructureStatus) string {
+	if infraStatus.Networks.Layout != apisazure.NetworkLayoutMultipleSubnet {
+		return ""
+	}
+
+	var first *apisazure.Subnet
+	for i, subnet := range infraStatus.Networks.Subnets {
+		if subnet.Purpose != apisazure.PurposeNodes || subnet.Zone == nil {
+			continue
+		}
+		if first == nil || *subnet.Zone < *first.Zone {
+			first = &infraStatus.Networks.Subnets[i]
+		}
+	}
+
+	if first == nil {
+		return ""
+	}
+	return first.Name
+}
+
+func appendLoadBalancerValues(values map[string]interface{}, lb *apisazure.LoadBalancerConfig) {
+	if lb.SKU != nil {
+		values["loadBalancerSku"] = strings.ToLower(string(*lb.SKU))
+	}
+	if lb.DisableOutboundSNAT != nil {
+		values["disableOutboundSNAT"] = *lb.DisableOutboundSNAT
+	}
+	if rule := lb.OutboundRule; rule != nil {
+		if rule.AllocatedOutboundPorts != nil {
+			values["outboundRuleAllocatedOutboundPorts"] = *rule.AllocatedOutboundPorts
+		}
+		if rule.IdleTimeoutInMinutes != nil {
+			values["outboundRuleIdleTimeoutInMinutes"] = *rule.IdleTimeoutInMinutes
+		}
 	}
 }
 
-func appendMachineSetValues(values map[string]interface{}, infraStatus *apisazure.InfrastructureStatus) map[string]interface{} {
+func appendMachineSetValues(values map[string]interface{}, infraStatus *apisazure.InfrastructureStatus, cluster *extensionscontroller.Cluster) map[string]interface{} {
 	values["vmType"] = "standard"
-	if azureapihelper.IsVmoRequired(infraStatus) {
+	if isVmssVMType(infraStatus, cluster) {
 		values["vmType"] = "vmss"
 		return values
 	}
@@ -519,6 +721,16 @@ func appendMachineSetValues(values map[string]interface{}, infraStatus *apisazur
 	return values
 }
 
+// isVmssVMType determines whether the Azure cloud provider components have to handle the nodes as members of VMSS.
+// This is the case for VMO shoots and for shoots which are converted to zoned shoots, as nodes of the former VMOs may
+// still exist during the migration.
+func isVmssVMType(infraStatus *apisazure.InfrastructureStatus, cluster *extensionscontroller.Cluster) bool {
+	if azureapihelper.IsVmoRequired(infraStatus) {
+		return true
+	}
+	return infraStatus.Zoned && cluster.Shoot != nil && azureapihelper.HasShootZonalMigrationAnnotation(cluster.Shoot.GetAnnotations())
+}
+
 // getInfraNames determines the subnet, availability set, route table and security group names from the given infrastructure status.
 func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string, string, error) {
 	_, nodesSubnet, err := azureapihelper.FindSubnetByPurposeAndZone(infraStatus.Networks.Subnets, apisazure.PurposeNodes, nil)
@@ -547,6 +759,7 @@ func getControlPlaneChartValues(
 	scaledDown bool,
 	infraStatus *apisazure.InfrastructureStatus,
 	gep19Monitoring bool,
+	remedyController config.RemedyControllerConfig,
 ) (
 	map[string]interface{},
 	error,
@@ -556,12 +769,12 @@ func getControlPlaneChartValues(
 		return nil, err
 	}
 
-	csi, err := getCSIControllerChartValues(cluster, secretsReader, scaledDown, infraStatus, checksums)
+	csi, err := getCSIControllerChartValues(cpConfig, cluster, secretsReader, scaledDown, infraStatus, checksums)
 	if err != nil {
 		return nil, err
 	}
 
-	remedy, err := getRemedyControllerChartValues(cluster, checksums, scaledDown, gep19Monitoring)
+	remedy, err := getRemedyControllerChartValues(cpConfig, cluster, checksums, scaledDown, gep19Monitoring, remedyController)
 	if err != nil {
 		return nil, err
 	}
@@ -576,6 +789,16 @@ func getControlPlaneChartValues(
 	}, nil
 }
 
+// getCredentialsChecksumAnnotations returns the checksum annotations of all secrets carrying credential material for
+// the pods of the control plane components. All components consuming the credentials share the same annotations, so
+// that they are restarted together when the credentials are rotated.
+func getCredentialsChecksumAnnotations(checksums map[string]string) map[string]interface{} {
+	return map[string]interface{}{
+		"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+		"checksum/secret-" + azure.CloudProviderConfigName:            checksums[azure.CloudProviderConfigName],
+	}
+}
+
 // getCCMChartValues collects and returns the CCM chart values.
 func getCCMChartValues(
 	cpConfig *apisazure.ControlPlaneConfig,
@@ -597,10 +820,7 @@ func getCCMChartValues(
 		"clusterName":       cp.Namespace,
 		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
 		"podNetwork":        strings.Join(extensionscontroller.GetPodNetwork(cluster), ","),
-		"podAnnotations": map[string]interface{}{
-			"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
-			"checksum/secret-" + azure.CloudProviderConfigName:            checksums[azure.CloudProviderConfigName],
-		},
+		"podAnnotations":    getCredentialsChecksumAnnotations(checksums),
 		"podLabels": map[string]interface{}{
 			v1beta1constants.LabelPodMaintenanceRestart: "true",
 		},
@@ -611,8 +831,9 @@ func getCCMChartValues(
 		"gep19Monitoring": gep19Monitoring,
 	}
 
-	if cpConfig.CloudControllerManager != nil {
-		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+	if ccm := cpConfig.CloudControllerManager; ccm != nil {
+		values["featureGates"] = ccm.FeatureGates
+		setComponentSettingsValues(values, ccm.Resources, ccm.LeaderElection, ccm.Verbosity)
 	}
 
 	return values, nil
@@ -620,6 +841,7 @@ func getCCMChartValues(
 
 // getCSIControllerChartValues collects and returns the CSIController chart values.
 func getCSIControllerChartValues(
+	cpConfig *apisazure.ControlPlaneConfig,
 	cluster *extensionscontroller.Cluster,
 	secretsReader secretsmanager.Reader,
 	scaledDown bool,
@@ -631,17 +853,21 @@ func getCSIControllerChartValues(
 		return nil, fmt.Errorf("secret %q not found", csiSnapshotValidationServerName)
 	}
 
+	snapshotValidationWebhookReplicas := extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1)
+	if !isSnapshotValidationWebhookEnabled(cpConfig) {
+		// the deployment is scaled down instead of removed as objects which are no longer rendered are not cleaned up.
+		snapshotValidationWebhookReplicas = 0
+	}
+
 	values := map[string]interface{}{
-		"enabled": true,
-		"podAnnotations": map[string]interface{}{
-			"checksum/secret-" + azure.CloudProviderConfigName: checksums[azure.CloudProviderConfigName],
-		},
-		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"enabled":        true,
+		"podAnnotations": getCredentialsChecksumAnnotations(checksums),
+		"replicas":       extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
 		"csiSnapshotController": map[string]interface{}{
 			"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
 		},
 		"csiSnapshotValidationWebhook": map[string]interface{}{
-			"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+			"replicas": snapshotValidationWebhookReplicas,
 			"secrets": map[string]interface{}{
 				"server": serverSecret.Name,
 			},
@@ -668,46 +894,168 @@ func getCSIControllerChartValues(
 		}
 	}
 
-	if azureapihelper.IsVmoRequired(infraStatus) {
+	if isVmssVMType(infraStatus, cluster) {
 		values["vmType"] = "vmss"
 	} else {
 		values["vmType"] = "standard"
 	}
 
+	if csi := cpConfig.CSIDriverController; csi != nil {
+		setComponentSettingsValues(values, nil, csi.LeaderElection, csi.Verbosity)
+		if resources := getResourcesValues(csi.Resources); len(resources) > 0 {
+			values["resources"] = map[string]interface{}{
+				"csiDriverDisk": resources,
+				"csiDriverFile": resources,
+			}
+		}
+	}
+
 	return values, nil
 }
 
+// setComponentSettingsValues sets the values for the resources, the leader election and the verbosity of a control
+// plane component. Settings which are not configured are omitted, so that the defaults of the chart apply.
+func setComponentSettingsValues(values map[string]interface{}, resources *corev1.ResourceRequirements, leaderElection *apisazure.LeaderElectionConfig, verbosity *int32) {
+	if resourcesValues := getResourcesValues(resources); len(resourcesValues) > 0 {
+		values["resources"] = resourcesValues
+	}
+
+	if leaderElection != nil {
+		leaderElectionValues := map[string]interface{}{}
+		setDurationValue(leaderElectionValues, "leaseDuration", leaderElection.LeaseDuration)
+		setDurationValue(leaderElectionValues, "renewDeadline", leaderElection.RenewDeadline)
+		setDurationValue(leaderElectionValues, "retryPeriod", leaderElection.RetryPeriod)
+		values["leaderElection"] = leaderElectionValues
+	}
+
+	setInt32Value(values, "verbosity", verbosity)
+}
+
+// getResourcesValues returns the values for the given resource requirements. The quantities are rendered per resource,
+// so that requests which are not configured are merged with the requests of the chart.
+func getResourcesValues(resources *corev1.ResourceRequirements) map[string]interface{} {
+	values := map[string]interface{}{}
+	if resources == nil {
+		return values
+	}
+
+	for key, list := range map[string]corev1.ResourceList{"requests": resources.Requests, "limits": resources.Limits} {
+		if len(list) == 0 {
+			continue
+		}
+		quantities := map[string]interface{}{}
+		for name, quantity := range list {
+			quantities[string(name)] = quantity.String()
+		}
+		values[key] = quantities
+	}
+
+	return values
+}
+
 // getRemedyControllerChartValues collects and returns the remedy controller chart values.
 func getRemedyControllerChartValues(
+	cpConfig *apisazure.ControlPlaneConfig,
 	cluster *extensionscontroller.Cluster,
 	checksums map[string]string,
 	scaledDown bool,
 	gep19Monitoring bool,
+	remedyController config.RemedyControllerConfig,
 ) (map[string]interface{}, error) {
-	disableRemedyController :=
-		cluster.Shoot.Annotations[azure.DisableRemedyControllerAnnotation] == "true" ||
-			features.ExtensionFeatureGate.Enabled(features.DisableRemedyController)
-
-	if disableRemedyController {
+	if isRemedyControllerDisabled(cpConfig, cluster) {
 		return map[string]interface{}{"enabled": true, "replicas": 0}, nil
 	}
-	return map[string]interface{}{
-		"enabled":  true,
-		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
-		"podAnnotations": map[string]interface{}{
-			"checksum/secret-" + azure.CloudProviderConfigName: checksums[azure.CloudProviderConfigName],
-		},
+
+	values := map[string]interface{}{
+		"enabled":         true,
+		"replicas":        extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"podAnnotations":  getCredentialsChecksumAnnotations(checksums),
 		"gep19Monitoring": gep19Monitoring,
-	}, nil
+	}
+
+	if azureConfig := getRemedyControllerConfigValues(cpConfig.Remedy, remedyController); len(azureConfig) > 0 {
+		values["config"] = map[string]interface{}{
+			"azure": azureConfig,
+		}
+	}
+
+	return values, nil
+}
+
+// isRemedyControllerDisabled returns true if the remedy controller is disabled for the shoot, either via the
+// ControlPlaneConfig, the shoot annotation or the extension's feature gate.
+func isRemedyControllerDisabled(cpConfig *apisazure.ControlPlaneConfig, cluster *extensionscontroller.Cluster) bool {
+	return (cpConfig.Remedy != nil && !ptr.Deref(cpConfig.Remedy.Enabled, true)) ||
+		cluster.Shoot.Annotations[azure.DisableRemedyControllerAnnotation] == "true" ||
+		features.ExtensionFeatureGate.Enabled(features.DisableRemedyController)
+}
+
+// getRemedyControllerConfigValues returns the values for the remedy configuration of the remedy controller. Settings
+// of the ControlPlaneConfig take precedence over the defaults of the extension's configuration. Settings which are
+// configured in neither are omitted, so that the defaults of the chart apply.
+func getRemedyControllerConfigValues(remedy *apisazure.RemedyConfig, defaults config.RemedyControllerConfig) map[string]interface{} {
+	var (
+		values                 = map[string]interface{}{}
+		orphanedPublicIPRemedy = map[string]interface{}{}
+		failedVMRemedy         = map[string]interface{}{}
+
+		defaultIP = ptr.Deref(defaults.OrphanedPublicIPRemedy, config.OrphanedPublicIPRemedyConfig{})
+		defaultVM = ptr.Deref(defaults.FailedVMRemedy, config.FailedVMRemedyConfig{})
+		ip        apisazure.OrphanedPublicIPRemedyConfig
+		vm        apisazure.FailedVMRemedyConfig
+	)
+	if remedy != nil {
+		ip = ptr.Deref(remedy.OrphanedPublicIPRemedy, apisazure.OrphanedPublicIPRemedyConfig{})
+		vm = ptr.Deref(remedy.FailedVMRemedy, apisazure.FailedVMRemedyConfig{})
+	}
+
+	setDurationValue(orphanedPublicIPRemedy, "requeueInterval", defaultIP.RequeueInterval, ip.RequeueInterval)
+	setDurationValue(orphanedPublicIPRemedy, "syncPeriod", defaultIP.SyncPeriod, ip.SyncPeriod)
+	setDurationValue(orphanedPublicIPRemedy, "deletionGracePeriod", defaultIP.DeletionGracePeriod, ip.DeletionGracePeriod)
+	setInt32Value(orphanedPublicIPRemedy, "maxGetAttempts", defaultIP.MaxGetAttempts, ip.MaxGetAttempts)
+	setInt32Value(orphanedPublicIPRemedy, "maxCleanAttempts", defaultIP.MaxCleanAttempts, ip.MaxCleanAttempts)
+
+	setDurationValue(failedVMRemedy, "requeueInterval", defaultVM.RequeueInterval, vm.RequeueInterval)
+	setDurationValue(failedVMRemedy, "syncPeriod", defaultVM.SyncPeriod, vm.SyncPeriod)
+	setInt32Value(failedVMRemedy, "maxGetAttempts", defaultVM.MaxGetAttempts, vm.MaxGetAttempts)
+	setInt32Value(failedVMRemedy, "maxReapplyAttempts", defaultVM.MaxReapplyAttempts, vm.MaxReapplyAttempts)
+
+	if len(orphanedPublicIPRemedy) > 0 {
+		values["orphanedPublicIPRemedy"] = orphanedPublicIPRemedy
+	}
+	if len(failedVMRemedy) > 0 {
+		values["failedVMRemedy"] = failedVMRemedy
+	}
+	return values
+}
+
+// setDurationValue sets the last non-nil of the given durations under the given key.
+func setDurationValue(values map[string]interface{}, key string, durations ...*metav1.Duration) {
+	for _, d := range durations {
+		if d != nil {
+			values[key] = d.Duration.String()
+		}
+	}
+}
+
+// setInt32Value sets the last non-nil of the given values under the given key.
+func setInt32Value(values map[string]interface{}, key string, ints ...*int32) {
+	for _, i := range ints {
+		if i != nil {
+			values[key] = *i
+		}
+	}
 }
 
 // getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
 func getControlPlaneShootChartValues(
 	ctx context.Context,
+	cpConfig *apisazure.ControlPlaneConfig,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	secretsReader secretsmanager.Reader,
 	client k8sclient.Client,
+	imageOverrides map[string]string,
 ) (
 	map[string]interface{},
 	error,
@@ -739,8 +1087,43 @@ func getControlPlaneShootChartValues(
 	}
 	caBundle = string(caSecret.Data[secretutils.DataKeyCertificateBundle])
 
-	disableRemedyController := cluster.Shoot.Annotations[azure.DisableRemedyControllerAnnotation] == "true" ||
-		features.ExtensionFeatureGate.Enabled(features.DisableRemedyController)
+	cloudNodeManagers, err := getCloudNodeManagerValues(cluster, imageOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	csiNodeAttachLimits, err := getCSINodeAttachLimitValues(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	csiNodeValues := map[string]interface{}{
+		"enabled":           true,
+		"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
+		"podAnnotations": map[string]interface{}{
+			"checksum/configmap-" + azure.CloudProviderDiskConfigName: cloudProviderDiskConfigChecksum,
+		},
+		"cloudProviderConfig": cloudProviderDiskConfig,
+		"webhookConfig": map[string]interface{}{
+			"enabled":  isSnapshotValidationWebhookEnabled(cpConfig),
+			"url":      "https://" + azure.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
+			"caBundle": caBundle,
+		},
+	}
+	if len(csiNodeAttachLimits) > 0 {
+		csiNodeValues["attachLimits"] = csiNodeAttachLimits
+	}
+
+	cloudControllerManagerValues := map[string]interface{}{
+		"enabled":           true,
+		"vpaEnabled":        gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
+		"cloudNodeManagers": cloudNodeManagers,
+	}
+	// The responses of public load balancers would be routed through the egress firewall, hence only internal load
+	// balancers are allowed in this case.
+	if infraStatus.Networks.EgressFirewall != nil {
+		cloudControllerManagerValues["internalLoadBalancersOnly"] = true
+	}
 
 	return map[string]interface{}{
 		// the allow-egress chart is enabled in all cases **except**:
@@ -749,24 +1132,75 @@ func getControlPlaneShootChartValues(
 		azure.AllowEgressName: map[string]interface{}{
 			"enabled": (infraStatus.Zoned || azureapihelper.IsVmoRequired(infraStatus)) && infraStatus.Networks.OutboundAccessType == apisazure.OutboundAccessTypeLoadBalancer,
 		},
-		azure.CloudControllerManagerName: map[string]interface{}{
-			"enabled":    true,
-			"vpaEnabled": gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
-		},
-		azure.CSINodeName: map[string]interface{}{
-			"enabled":           true,
-			"kubernetesVersion": cluster.Shoot.Spec.Kubernetes.Version,
-			"podAnnotations": map[string]interface{}{
-				"checksum/configmap-" + azure.CloudProviderDiskConfigName: cloudProviderDiskConfigChecksum,
-			},
-			"cloudProviderConfig": cloudProviderDiskConfig,
-			"webhookConfig": map[string]interface{}{
-				"url":      "https://" + azure.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
-				"caBundle": caBundle,
-			},
-		},
+		azure.CloudControllerManagerName: cloudControllerManagerValues,
+		azure.CSINodeName:                csiNodeValues,
 		azure.RemedyControllerName: map[string]interface{}{
-			"enabled": !disableRemedyController,
+			"enabled": !isRemedyControllerDisabled(cpConfig, cluster),
 		},
 	}, err
 }
+
+// getCloudNodeManagerValues returns the values for one cloud-node-manager DaemonSet per machine architecture used by the
+// worker pools of the shoot. The images are resolved per architecture from the image vector, unless the image is
+// overridden for the cloud instance of the shoot.
+func getCloudNodeManagerValues(cluster *extensionscontroller.Cluster, imageOverrides map[string]string) ([]map[string]interface{}, error) {
+	architectures := sets.New[string]()
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		architectures.Insert(ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64))
+	}
+	if architectures.Len() == 0 {
+		architectures.Insert(v1beta1constants.ArchitectureAMD64)
+	}
+
+	var values []map[string]interface{}
+	for _, architecture := range sets.List(architectures) {
+		if image, ok := imageOverrides[azure.CloudNodeManagerImageName]; ok {
+			values = append(values, map[string]interface{}{
+				"architecture": architecture,
+				"image":        image,
+			})
+			continue
+		}
+
+		image, err := imagevector.ImageVector().FindImage(
+			azure.CloudNodeManagerImageName,
+			imagevectorutils.RuntimeVersion(cluster.Shoot.Spec.Kubernetes.Version),
+			imagevectorutils.TargetVersion(cluster.Shoot.Spec.Kubernetes.Version),
+			imagevectorutils.Architecture(architecture),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not find %s image for architecture %s: %w", azure.CloudNodeManagerImageName, architecture, err)
+		}
+		values = append(values, map[string]interface{}{
+			"architecture": architecture,
+			"image":        image.String(),
+		})
+	}
+	return values, nil
+}
+
+// getCSINodeAttachLimitValues returns the values for one additional csi-driver-node DaemonSet for the Azure Disk driver
+// per disk attach limit of the machine types used by the worker pools of the shoot. Worker pools whose machine type has
+// no known attach limit are served by the default DaemonSet, which relies on the limits built into the driver.
+func getCSINodeAttachLimitValues(cluster *extensionscontroller.Cluster) ([]map[string]interface{}, error) {
+	cloudProfileConfig, err := azureapihelper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	poolsByLimit := map[int32][]string{}
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		if limit, ok := azureapihelper.DiskAttachLimit(cloudProfileConfig, worker.Machine.Type); ok {
+			poolsByLimit[limit] = append(poolsByLimit[limit], worker.Name)
+		}
+	}
+
+	var values []map[string]interface{}
+	for _, limit := range slices.Sorted(maps.Keys(poolsByLimit)) {
+		values = append(values, map[string]interface{}{
+			"limit": limit,
+			"pools": poolsByLimit[limit],
+		})
+	}
+	return values, nil
+}
@@ -7,6 +7,7 @@ package controlplane
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
@@ -14,6 +15,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
@@ -26,6 +28,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -33,8 +36,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener-extension-provider-azure/imagevector"
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -152,7 +157,7 @@ var _ = Describe("ValuesProvider", func() {
 		mgr.EXPECT().GetClient().Return(c)
 		mgr.EXPECT().GetScheme().Return(scheme)
 
-		vp = NewValuesProvider(mgr)
+		vp = NewValuesProvider(mgr, config.RemedyControllerConfig{}, nil)
 
 		infrastructureStatus = defaultInfrastructureStatus.DeepCopy()
 		controlPlaneConfig = defaultControlPlaneConfig.DeepCopy()
@@ -278,6 +283,108 @@ var _ = Describe("ValuesProvider", func() {
 				Expect(values).To(Equal(ControlPlaneChartValues))
 			})
 
+			It("should return correct config chart values for a cluster in an Azure Stack Hub", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+				cluster.CloudProfile = &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","cloudConfiguration":{"name":"AzureStackCloud","activeDirectoryAuthorityHost":"https://adfs.local.azurestack.external/","resourceManagerEndpoint":"https://management.local.azurestack.external/","storageEndpointSuffix":"local.azurestack.external"}}`)},
+					},
+				}
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes":                maxNodes,
+					"cloud":                   "AZURESTACKCLOUD",
+					"resourceManagerEndpoint": "https://management.local.azurestack.external/",
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
+			It("should return correct config chart values for a cluster being converted to a zoned cluster", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+				cluster.Shoot.Annotations = map[string]string{azure.ShootZonalMigrationAnnotation: "true"}
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes": maxNodes,
+					"vmType":   "vmss",
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
+			It("should return correct config chart values for zoned cluster with dedicated subnets per zone", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+
+				infrastructureStatus.Networks.Layout = v1alpha1.NetworkLayoutMultipleSubnet
+				infrastructureStatus.Networks.Subnets = []v1alpha1.Subnet{
+					{Name: "subnet-abcd1234-nodes-z2", Purpose: "nodes", Zone: ptr.To("2")},
+					{Name: "subnet-abcd1234-nodes-z1", Purpose: "nodes", Zone: ptr.To("1")},
+				}
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes":   maxNodes,
+					"subnetName": "subnet-abcd1234-nodes-z1",
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
+			It("should return correct config chart values with load balancer configuration", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+
+				controlPlaneConfig.CloudControllerManager = &v1alpha1.CloudControllerManagerConfig{
+					LoadBalancer: &v1alpha1.LoadBalancerConfig{
+						SKU: ptr.To(v1alpha1.LoadBalancerSKUStandard),
+						OutboundRule: &v1alpha1.OutboundRuleConfig{
+							AllocatedOutboundPorts: ptr.To[int32](1024),
+							IdleTimeoutInMinutes:   ptr.To[int32](30),
+						},
+						DisableOutboundSNAT: ptr.To(true),
+					},
+				}
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes":                           maxNodes,
+					"loadBalancerSku":                    "standard",
+					"disableOutboundSNAT":                true,
+					"outboundRuleAllocatedOutboundPorts": int32(1024),
+					"outboundRuleIdleTimeoutInMinutes":   int32(30),
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
+			It("should disable the outbound SNAT if the infrastructure configures an outbound rule", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+
+				infrastructureStatus.Networks.OutboundLoadBalancer = &v1alpha1.OutboundLoadBalancerStatus{
+					Name:             namespace,
+					OutboundRuleName: namespace + "-outbound",
+				}
+				controlPlaneConfig.CloudControllerManager = &v1alpha1.CloudControllerManagerConfig{
+					LoadBalancer: &v1alpha1.LoadBalancerConfig{
+						DisableOutboundSNAT: ptr.To(false),
+					},
+				}
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes":            maxNodes,
+					"disableOutboundSNAT": true,
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
+
 			It("should return correct control plane chart values with identity", func() {
 				identityName := "identity-client-id"
 				infrastructureStatus.Identity = &v1alpha1.IdentityStatus{
@@ -295,6 +402,24 @@ var _ = Describe("ValuesProvider", func() {
 				})
 				Expect(values).To(Equal(ControlPlaneChartValues))
 			})
+
+			It("should not render the ACR config and remove it if the acr-credential-provider is used", func() {
+				infrastructureStatus.Identity = &v1alpha1.IdentityStatus{
+					ClientID:      "identity-client-id",
+					ACRAccess:     true,
+					ACRAccessMode: ptr.To(v1alpha1.ACRAccessModeCredentialProvider),
+				}
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				maps.Copy(ControlPlaneChartValues, map[string]interface{}{
+					"maxNodes": maxNodes,
+				})
+				Expect(values).To(Equal(ControlPlaneChartValues))
+			})
 		})
 	})
 
@@ -367,7 +492,8 @@ var _ = Describe("ValuesProvider", func() {
 				azure.CSIControllerName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 					"replicas": 1,
 					"podAnnotations": map[string]interface{}{
-						"checksum/secret-" + azure.CloudProviderConfigName: checksums[azure.CloudProviderConfigName],
+						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+						"checksum/secret-" + azure.CloudProviderConfigName:            checksums[azure.CloudProviderConfigName],
 					},
 					"csiSnapshotController": map[string]interface{}{
 						"replicas": 1,
@@ -384,7 +510,8 @@ var _ = Describe("ValuesProvider", func() {
 				azure.RemedyControllerName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 					"replicas": 1,
 					"podAnnotations": map[string]interface{}{
-						"checksum/secret-" + azure.CloudProviderConfigName: checksums[azure.CloudProviderConfigName],
+						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+						"checksum/secret-" + azure.CloudProviderConfigName:            checksums[azure.CloudProviderConfigName],
 					},
 					"gep19Monitoring": false,
 				}),
@@ -410,7 +537,8 @@ var _ = Describe("ValuesProvider", func() {
 					"replicas": 1,
 					"vmType":   "standard",
 					"podAnnotations": map[string]interface{}{
-						"checksum/secret-" + azure.CloudProviderConfigName: checksums[azure.CloudProviderConfigName],
+						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+						"checksum/secret-" + azure.CloudProviderConfigName:            checksums[azure.CloudProviderConfigName],
 					},
 					"csiSnapshotController": map[string]interface{}{
 						"replicas": 1,
@@ -426,7 +554,8 @@ var _ = Describe("ValuesProvider", func() {
 				azure.RemedyControllerName: utils.MergeMaps(enabledTrue, map[string]interface{}{
 					"replicas": 1,
 					"podAnnotations": map[string]interface{}{
-						"checksum/secret-" + azure.CloudProviderConfigName: checksums[azure.CloudProviderConfigName],
+						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+						"checksum/secret-" + azure.CloudProviderConfigName:            checksums[azure.CloudProviderConfigName],
 					},
 					"gep19Monitoring": false,
 				}),
@@ -455,7 +584,8 @@ var _ = Describe("ValuesProvider", func() {
 					"replicas": 1,
 					"vmType":   "standard",
 					"podAnnotations": map[string]interface{}{
-						"checksum/secret-" + azure.CloudProviderConfigName: checksums[azure.CloudProviderConfigName],
+						"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+						"checksum/secret-" + azure.CloudProviderConfigName:            checksums[azure.CloudProviderConfigName],
 					},
 					"csiSnapshotController": map[string]interface{}{
 						"replicas": 1,
@@ -472,6 +602,130 @@ var _ = Describe("ValuesProvider", func() {
 			}))
 		})
 
+		It("should disable the remedy controller if it is disabled in the ControlPlaneConfig", func() {
+			cluster = generateCluster(cidr, k8sVersion, false, nil, nil, &gardencorev1beta1.Seed{})
+			controlPlaneConfig.Remedy = &v1alpha1.RemedyConfig{Enabled: ptr.To(false)}
+
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.RemedyControllerName, remedyDisabled))
+		})
+
+		It("should scale down the snapshot validation webhook if it is disabled", func() {
+			cluster = generateCluster(cidr, k8sVersion, false, nil, nil, &gardencorev1beta1.Seed{})
+			controlPlaneConfig.Storage = &v1alpha1.Storage{SnapshotValidationWebhook: ptr.To(false)}
+
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[azure.CSIControllerName]).To(HaveKeyWithValue("csiSnapshotValidationWebhook", HaveKeyWithValue("replicas", 0)))
+		})
+
+		It("should return the resources, leader election and verbosity settings of the cloud-controller-manager and the CSI driver controllers", func() {
+			cluster = generateCluster(cidr, k8sVersion, false, nil, nil, &gardencorev1beta1.Seed{})
+			controlPlaneConfig.CloudControllerManager.Resources = &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			}
+			controlPlaneConfig.CloudControllerManager.LeaderElection = &v1alpha1.LeaderElectionConfig{
+				LeaseDuration: &metav1.Duration{Duration: 30 * time.Second},
+				RenewDeadline: &metav1.Duration{Duration: 20 * time.Second},
+			}
+			controlPlaneConfig.CloudControllerManager.Verbosity = ptr.To[int32](4)
+			controlPlaneConfig.CSIDriverController = &v1alpha1.CSIDriverControllerConfig{
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+				},
+				LeaderElection: &v1alpha1.LeaderElectionConfig{RetryPeriod: &metav1.Duration{Duration: 5 * time.Second}},
+				Verbosity:      ptr.To[int32](2),
+			}
+
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[azure.CloudControllerManagerName]).To(SatisfyAll(
+				HaveKeyWithValue("resources", map[string]interface{}{
+					"limits": map[string]interface{}{"memory": "2Gi"},
+				}),
+				HaveKeyWithValue("leaderElection", map[string]interface{}{
+					"leaseDuration": "30s",
+					"renewDeadline": "20s",
+				}),
+				HaveKeyWithValue("verbosity", int32(4)),
+			))
+			csiDriverResources := map[string]interface{}{
+				"requests": map[string]interface{}{"memory": "128Mi"},
+			}
+			Expect(values[azure.CSIControllerName]).To(SatisfyAll(
+				HaveKeyWithValue("resources", map[string]interface{}{
+					"csiDriverDisk": csiDriverResources,
+					"csiDriverFile": csiDriverResources,
+				}),
+				HaveKeyWithValue("leaderElection", map[string]interface{}{"retryPeriod": "5s"}),
+				HaveKeyWithValue("verbosity", int32(2)),
+			))
+		})
+
+		It("should return the remedy controller configuration with the ControlPlaneConfig taking precedence over the defaults", func() {
+			vp.(*valuesProvider).remedyController = config.RemedyControllerConfig{
+				OrphanedPublicIPRemedy: &config.OrphanedPublicIPRemedyConfig{
+					RequeueInterval:  &metav1.Duration{Duration: 2 * time.Minute},
+					MaxCleanAttempts: ptr.To[int32](10),
+				},
+				FailedVMRemedy: &config.FailedVMRemedyConfig{
+					MaxReapplyAttempts: ptr.To[int32](3),
+				},
+			}
+			cluster = generateCluster(cidr, k8sVersion, false, nil, nil, &gardencorev1beta1.Seed{})
+			controlPlaneConfig.Remedy = &v1alpha1.RemedyConfig{
+				OrphanedPublicIPRemedy: &v1alpha1.OrphanedPublicIPRemedyConfig{
+					MaxCleanAttempts: ptr.To[int32](7),
+				},
+				FailedVMRemedy: &v1alpha1.FailedVMRemedyConfig{
+					SyncPeriod: &metav1.Duration{Duration: time.Hour},
+				},
+			}
+
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.RemedyControllerName, HaveKeyWithValue("config", map[string]interface{}{
+				"azure": map[string]interface{}{
+					"orphanedPublicIPRemedy": map[string]interface{}{
+						"requeueInterval":  "2m0s",
+						"maxCleanAttempts": int32(7),
+					},
+					"failedVMRemedy": map[string]interface{}{
+						"syncPeriod":         "1h0m0s",
+						"maxReapplyAttempts": int32(3),
+					},
+				},
+			})))
+		})
+
+		It("should override the images configured for the cloud instance of the shoot", func() {
+			vp.(*valuesProvider).imageVectorOverrides = []config.ImageVectorOverride{
+				{Cloud: "AzureChina", Images: map[string]string{azure.CloudControllerManagerImageName: "china/ccm:v1"}},
+				{Cloud: "azurepublic", Images: map[string]string{
+					azure.CloudControllerManagerImageName: "public/ccm:v1",
+					azure.CSIDriverDiskImageName:          "public/csi-driver-disk:v1",
+				}},
+			}
+			cluster = generateCluster(cidr, k8sVersion, false, nil, nil, &gardencorev1beta1.Seed{})
+
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[azure.CloudControllerManagerName]).To(HaveKeyWithValue("images", map[string]interface{}{azure.CloudControllerManagerImageName: "public/ccm:v1"}))
+			Expect(values[azure.CSIControllerName]).To(HaveKeyWithValue("images", map[string]interface{}{azure.CSIDriverDiskImageName: "public/csi-driver-disk:v1"}))
+			Expect(values[azure.RemedyControllerName]).NotTo(HaveKey("images"))
+		})
+
 		DescribeTable("topologyAwareRoutingEnabled value",
 			func(seedSettings *gardencorev1beta1.SeedSettings, shootControlPlane *gardencorev1beta1.ControlPlane, expected bool) {
 				seed := &gardencorev1beta1.Seed{
@@ -532,13 +786,23 @@ var _ = Describe("ValuesProvider", func() {
 				"cloudProviderConfig": cloudProviderConfigData,
 				"kubernetesVersion":   "1.28.2",
 			})
+			cloudNodeManagerImage = func(architecture string) string {
+				image, err := imagevector.ImageVector().FindImage(azure.CloudNodeManagerImageName, imagevectorutils.RuntimeVersion(k8sVersion), imagevectorutils.TargetVersion(k8sVersion), imagevectorutils.Architecture(architecture))
+				Expect(err).NotTo(HaveOccurred())
+				return image.String()
+			}
+			cloudNodeManagers = []map[string]interface{}{
+				{"architecture": v1beta1constants.ArchitectureAMD64, "image": cloudNodeManagerImage(v1beta1constants.ArchitectureAMD64)},
+			}
 			cloudControllerManager = map[string]interface{}{
-				"enabled":    true,
-				"vpaEnabled": true,
+				"enabled":           true,
+				"vpaEnabled":        true,
+				"cloudNodeManagers": cloudNodeManagers,
 			}
 			cloudControllerManagerWithVPADisabled = map[string]interface{}{
-				"enabled":    true,
-				"vpaEnabled": false,
+				"enabled":           true,
+				"vpaEnabled":        false,
+				"cloudNodeManagers": cloudNodeManagers,
 			}
 		)
 
@@ -571,6 +835,7 @@ var _ = Describe("ValuesProvider", func() {
 			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
 			csiNode := utils.MergeMaps(csiNodeEnabled, map[string]interface{}{
 				"webhookConfig": map[string]interface{}{
+					"enabled":  true,
 					"url":      "https://" + azure.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
 					"caBundle": "",
 				},
@@ -592,6 +857,7 @@ var _ = Describe("ValuesProvider", func() {
 			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
 			csiNode := utils.MergeMaps(csiNodeEnabled, map[string]interface{}{
 				"webhookConfig": map[string]interface{}{
+					"enabled":  true,
 					"url":      "https://" + azure.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
 					"caBundle": "",
 				},
@@ -613,6 +879,7 @@ var _ = Describe("ValuesProvider", func() {
 			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
 			csiNode := utils.MergeMaps(csiNodeEnabled, map[string]interface{}{
 				"webhookConfig": map[string]interface{}{
+					"enabled":  true,
 					"url":      "https://" + azure.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
 					"caBundle": "",
 				},
@@ -628,6 +895,73 @@ var _ = Describe("ValuesProvider", func() {
 			}))
 		})
 
+		It("should return a cloud-node-manager per worker pool architecture", func() {
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "amd64", Machine: gardencorev1beta1.Machine{Architecture: ptr.To(v1beta1constants.ArchitectureAMD64)}},
+				{Name: "arm64", Machine: gardencorev1beta1.Machine{Architecture: ptr.To(v1beta1constants.ArchitectureARM64)}},
+				{Name: "default"},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.CloudControllerManagerName, HaveKeyWithValue("cloudNodeManagers", []map[string]interface{}{
+				{"architecture": v1beta1constants.ArchitectureAMD64, "image": cloudNodeManagerImage(v1beta1constants.ArchitectureAMD64)},
+				{"architecture": v1beta1constants.ArchitectureARM64, "image": cloudNodeManagerImage(v1beta1constants.ArchitectureARM64)},
+			})))
+		})
+
+		It("should use the cloud-node-manager image configured for the cloud instance of the shoot", func() {
+			vp.(*valuesProvider).imageVectorOverrides = []config.ImageVectorOverride{
+				{Cloud: "AzureChina", Images: map[string]string{azure.CloudNodeManagerImageName: "china/cloud-node-manager:v1"}},
+				{Cloud: "AzurePublic", Images: map[string]string{azure.CloudNodeManagerImageName: "public/cloud-node-manager:v1"}},
+			}
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "amd64", Machine: gardencorev1beta1.Machine{Architecture: ptr.To(v1beta1constants.ArchitectureAMD64)}},
+				{Name: "arm64", Machine: gardencorev1beta1.Machine{Architecture: ptr.To(v1beta1constants.ArchitectureARM64)}},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.CloudControllerManagerName, HaveKeyWithValue("cloudNodeManagers", []map[string]interface{}{
+				{"architecture": v1beta1constants.ArchitectureAMD64, "image": "public/cloud-node-manager:v1"},
+				{"architecture": v1beta1constants.ArchitectureARM64, "image": "public/cloud-node-manager:v1"},
+			})))
+		})
+
+		It("should only allow internal load balancers if the egress traffic is routed through an egress firewall", func() {
+			infrastructureStatus.Networks.EgressFirewall = &v1alpha1.EgressFirewallStatus{Name: "firewall"}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.CloudControllerManagerName, HaveKeyWithValue("internalLoadBalancersOnly", true)))
+		})
+
+		It("should return a csi-driver-node DaemonSet per disk attach limit of the worker pools", func() {
+			cluster.CloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","machineTypes":[{"name":"Standard_D4s_v5","attachLimits":{"disk.csi.azure.com":8}},{"name":"Standard_E4s_v5","attachLimits":{"disk.csi.azure.com":8}},{"name":"Standard_D8s_v5","attachLimits":{"disk.csi.azure.com":12}},{"name":"Standard_E104ids_v5"}]}`)},
+				},
+			}
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "d4", Machine: gardencorev1beta1.Machine{Type: "Standard_D4s_v5"}},
+				{Name: "e4", Machine: gardencorev1beta1.Machine{Type: "Standard_E4s_v5"}},
+				{Name: "d8", Machine: gardencorev1beta1.Machine{Type: "Standard_D8s_v5"}},
+				{Name: "e104", Machine: gardencorev1beta1.Machine{Type: "Standard_E104ids_v5"}},
+				{Name: "unknown", Machine: gardencorev1beta1.Machine{Type: "Standard_D4s_v6"}},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.CSINodeName, HaveKeyWithValue("attachLimits", []map[string]interface{}{
+				{"limit": int32(8), "pools": []string{"d4", "e4"}},
+				{"limit": int32(12), "pools": []string{"d8"}},
+			})))
+		})
+
 		Context("remedy controller is disabled", func() {
 			BeforeEach(func() {
 				shootAnnotations := map[string]string{
@@ -640,6 +974,7 @@ var _ = Describe("ValuesProvider", func() {
 				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
 				csiNode := utils.MergeMaps(csiNodeEnabled, map[string]interface{}{
 					"webhookConfig": map[string]interface{}{
+						"enabled":  true,
 						"url":      "https://" + azure.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
 						"caBundle": "",
 					},
@@ -661,6 +996,7 @@ var _ = Describe("ValuesProvider", func() {
 				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
 				csiNode := utils.MergeMaps(csiNodeEnabled, map[string]interface{}{
 					"webhookConfig": map[string]interface{}{
+						"enabled":  true,
 						"url":      "https://" + azure.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
 						"caBundle": "",
 					},
@@ -718,6 +1054,54 @@ var _ = Describe("ValuesProvider", func() {
 				"managedDefaultVolumeSnapshotClass": true,
 			}))
 		})
+
+		It("should return the parameters of the volume snapshot class", func() {
+			controlPlaneConfig.Storage = &v1alpha1.Storage{
+				VolumeSnapshotClass: &v1alpha1.VolumeSnapshotClassConfig{
+					Incremental:   ptr.To(false),
+					ResourceGroup: ptr.To("snapshots"),
+					Tags:          map[string]string{"team": "storage", "cost-center": "1234"},
+				},
+			}
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, nil)
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"managedDefaultStorageClass":        true,
+				"managedDefaultVolumeSnapshotClass": true,
+				"volumeSnapshotClassParameters": map[string]interface{}{
+					"incremental":   "false",
+					"resourceGroup": "snapshots",
+					"tags":          "cost-center=1234,team=storage",
+				},
+			}))
+		})
+
+		It("should restrict the storage classes to the zones of the worker pools", func() {
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, nil)
+			cluster.Shoot.Spec.Region = "westeurope"
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "worker", Zones: []string{"2", "1"}},
+				{Name: "worker-large", Zones: []string{"1"}},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("zones", []string{"westeurope-1", "westeurope-2"}))
+		})
+
+		It("should not restrict the storage classes if a worker pool is not zoned", func() {
+			cluster = generateCluster(cidr, k8sVersion, true, nil, nil, nil)
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "worker", Zones: []string{"1"}},
+				{Name: "worker-large"},
+			}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).NotTo(HaveKey("zones"))
+		})
 	})
 })
 
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplaneexposure
+
+import (
+	"context"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+)
+
+const (
+	// ControllerName is the name of the controlplane exposure controller.
+	ControllerName = "controlplaneexposure"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are Options to apply when adding the Azure controlplane exposure controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// Config is the configuration of the controlplane exposure.
+	Config config.ControlPlaneExposureConfig
+	// SeedRegion is the region of the seed, used to determine the Azure cloud of the private DNS zone.
+	SeedRegion string
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+// The controller is only added if a private DNS zone is configured in the given Options.
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	if len(opts.Config.PrivateDNSZone.Name) == 0 {
+		return nil
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(opts.Controller).
+		For(&corev1.Service{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetName() == v1beta1constants.DeploymentNameKubeAPIServer &&
+					obj.GetLabels()[v1beta1constants.LabelApp] == v1beta1constants.LabelKubernetes &&
+					obj.GetLabels()[v1beta1constants.LabelRole] == v1beta1constants.LabelAPIServer
+			}),
+		)).
+		Complete(NewReconciler(mgr.GetClient(), opts.Config, opts.SeedRegion))
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(_ context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplaneexposure
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// FinalizerName is the finalizer which the controller adds to kube-apiserver services for which it maintains a
+	// record in the private DNS zone.
+	FinalizerName = "extensions.gardener.cloud/azure-controlplane-exposure"
+
+	defaultTTL int64 = 300
+)
+
+// NewAzureClientFactoryFunc is a hook to monkeypatch the factory ctor during tests.
+var NewAzureClientFactoryFunc = azureclient.NewAzureClientFactoryFromSecret
+
+type reconciler struct {
+	client     client.Client
+	config     config.ControlPlaneExposureConfig
+	seedRegion string
+}
+
+// NewReconciler creates a new reconcile.Reconciler which maintains an A record in the configured private DNS zone
+// pointing to the internal load balancer of the kube-apiserver service of a shoot. The record is removed again once
+// the service no longer has a load balancer IP or is deleted.
+func NewReconciler(client client.Client, config config.ControlPlaneExposureConfig, seedRegion string) reconcile.Reconciler {
+	return &reconciler{
+		client:     client,
+		config:     config,
+		seedRegion: seedRegion,
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	service := &corev1.Service{}
+	if err := r.client.Get(ctx, request.NamespacedName, service); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	ipAddresses := loadBalancerIPAddresses(service)
+	if service.DeletionTimestamp != nil || len(ipAddresses) == 0 {
+		if !controllerutil.ContainsFinalizer(service, FinalizerName) {
+			return reconcile.Result{}, nil
+		}
+
+		logger.Info("Deleting private DNS record for kube-apiserver", "zone", r.config.PrivateDNSZone.Name)
+		if err := r.deleteRecord(ctx, service); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		patch := client.MergeFromWithOptions(service.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		controllerutil.RemoveFinalizer(service, FinalizerName)
+		return reconcile.Result{}, client.IgnoreNotFound(r.client.Patch(ctx, service, patch))
+	}
+
+	if !controllerutil.ContainsFinalizer(service, FinalizerName) {
+		patch := client.MergeFromWithOptions(service.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		controllerutil.AddFinalizer(service, FinalizerName)
+		if err := r.client.Patch(ctx, service, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not add finalizer to service: %w", err)
+		}
+	}
+
+	logger.Info("Ensuring private DNS record for kube-apiserver", "zone", r.config.PrivateDNSZone.Name, "ipAddresses", ipAddresses)
+	return reconcile.Result{}, r.ensureRecord(ctx, service, ipAddresses)
+}
+
+func loadBalancerIPAddresses(service *corev1.Service) []string {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	var ipAddresses []string
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if len(ingress.IP) > 0 {
+			ipAddresses = append(ipAddresses, ingress.IP)
+		}
+	}
+	return ipAddresses
+}
+
+func (r *reconciler) ensureRecord(ctx context.Context, service *corev1.Service, ipAddresses []string) error {
+	recordSetClient, err := r.recordSetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := recordSetClient.CreateOrUpdateA(ctx, r.config.PrivateDNSZone.ResourceGroup, r.config.PrivateDNSZone.Name, recordName(service), ipAddresses, ptr.Deref(r.config.TTL, defaultTTL)); err != nil {
+		return fmt.Errorf("could not create or update private DNS record for kube-apiserver in zone %q: %w", r.config.PrivateDNSZone.Name, err)
+	}
+	return nil
+}
+
+func (r *reconciler) deleteRecord(ctx context.Context, service *corev1.Service) error {
+	recordSetClient, err := r.recordSetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := recordSetClient.DeleteA(ctx, r.config.PrivateDNSZone.ResourceGroup, r.config.PrivateDNSZone.Name, recordName(service)); err != nil {
+		return fmt.Errorf("could not delete private DNS record for kube-apiserver in zone %q: %w", r.config.PrivateDNSZone.Name, err)
+	}
+	return nil
+}
+
+func (r *reconciler) recordSetClient(ctx context.Context) (azureclient.PrivateDNSRecordSet, error) {
+	azCloudConfiguration, err := azureclient.AzureCloudConfiguration(nil, &r.seedRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	factory, err := NewAzureClientFactoryFunc(ctx, r.client, r.config.SecretRef, false, azureclient.WithCloudConfiguration(azCloudConfiguration))
+	if err != nil {
+		return nil, fmt.Errorf("could not create Azure client factory: %w", err)
+	}
+	return factory.PrivateDNSRecordSet()
+}
+
+// recordName returns the name of the record relative to the private DNS zone. The shoot namespace is unique per seed.
+func recordName(service *corev1.Service) string {
+	return service.Namespace
+}
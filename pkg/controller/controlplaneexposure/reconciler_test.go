@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplaneexposure_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/controlplaneexposure"
+)
+
+var _ = Describe("Reconciler", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		c         client.Client
+		factory   *mockazureclient.MockFactory
+		recordSet *mockazureclient.MockPrivateDNSRecordSet
+		secretRef corev1.SecretReference
+
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+		service    *corev1.Service
+
+		oldFactoryFunc = NewAzureClientFactoryFunc
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		factory = mockazureclient.NewMockFactory(ctrl)
+		recordSet = mockazureclient.NewMockPrivateDNSRecordSet(ctrl)
+		factory.EXPECT().PrivateDNSRecordSet().Return(recordSet, nil).AnyTimes()
+		NewAzureClientFactoryFunc = func(_ context.Context, _ client.Client, ref corev1.SecretReference, _ bool, _ ...azureclient.AzureFactoryOption) (azureclient.Factory, error) {
+			secretRef = ref
+			return factory, nil
+		}
+
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: namespace},
+			Spec: corev1.ServiceSpec{
+				Type:  corev1.ServiceTypeLoadBalancer,
+				Ports: []corev1.ServicePort{{Name: "kube-apiserver", Port: 443}},
+			},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{IP: "10.1.0.4"}}},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(service)}
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithObjects(service).WithStatusSubresource(service).Build()
+		reconciler = NewReconciler(c, config.ControlPlaneExposureConfig{
+			PrivateDNSZone: config.PrivateDNSZone{ResourceGroup: "seed-dns", Name: "seed.internal.example.com"},
+			SecretRef:      corev1.SecretReference{Name: "private-dns-credentials", Namespace: "garden"},
+		}, "westeurope")
+	})
+
+	AfterEach(func() {
+		NewAzureClientFactoryFunc = oldFactoryFunc
+		ctrl.Finish()
+	})
+
+	It("should create the record for the load balancer IP and add the finalizer", func() {
+		recordSet.EXPECT().CreateOrUpdateA(gomock.Any(), "seed-dns", "seed.internal.example.com", namespace, []string{"10.1.0.4"}, int64(300))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(secretRef).To(Equal(corev1.SecretReference{Name: "private-dns-credentials", Namespace: "garden"}))
+		Expect(c.Get(ctx, request.NamespacedName, service)).To(Succeed())
+		Expect(service.Finalizers).To(ConsistOf(FinalizerName))
+	})
+
+	Context("without load balancer IP", func() {
+		BeforeEach(func() {
+			service.Status.LoadBalancer.Ingress = nil
+		})
+
+		It("should neither create a record nor add the finalizer", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(c.Get(ctx, request.NamespacedName, service)).To(Succeed())
+			Expect(service.Finalizers).To(BeEmpty())
+		})
+
+		It("should delete a previously created record and remove the finalizer", func() {
+			Expect(c.Get(ctx, request.NamespacedName, service)).To(Succeed())
+			service.Finalizers = []string{FinalizerName}
+			Expect(c.Update(ctx, service)).To(Succeed())
+			recordSet.EXPECT().DeleteA(gomock.Any(), "seed-dns", "seed.internal.example.com", namespace)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(c.Get(ctx, request.NamespacedName, service)).To(Succeed())
+			Expect(service.Finalizers).To(BeEmpty())
+		})
+	})
+
+	Context("service in deletion", func() {
+		BeforeEach(func() {
+			service.Finalizers = []string{FinalizerName}
+			service.DeletionTimestamp = ptr.To(metav1.Now())
+		})
+
+		It("should delete the record and release the service", func() {
+			recordSet.EXPECT().DeleteA(gomock.Any(), "seed-dns", "seed.internal.example.com", namespace)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(apierrors.IsNotFound(c.Get(ctx, request.NamespacedName, service))).To(BeTrue())
+		})
+
+		It("should keep the finalizer if the record cannot be deleted", func() {
+			recordSet.EXPECT().DeleteA(gomock.Any(), "seed-dns", "seed.internal.example.com", namespace).Return(fmt.Errorf("fake"))
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+			Expect(c.Get(ctx, request.NamespacedName, service)).To(Succeed())
+			Expect(service.Finalizers).To(ConsistOf(FinalizerName))
+		})
+	})
+
+	It("should ignore services which do not exist anymore", func() {
+		request.Name = "other"
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+})
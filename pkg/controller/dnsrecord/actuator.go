@@ -7,6 +7,7 @@ package dnsrecord
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -15,11 +16,18 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/validation"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
@@ -34,24 +42,36 @@ const (
 var DefaultAzureClientFactoryFunc = azureclient.NewAzureClientFactoryFromSecret
 
 type actuator struct {
-	client k8sclient.Client
+	client    k8sclient.Client
+	zoneCache *zoneCache
+	batcher   *recordSetBatcher
 }
 
 // NewActuator creates a new dnsrecord.Actuator.
-func NewActuator(mgr manager.Manager) dnsrecord.Actuator {
+func NewActuator(mgr manager.Manager, config config.DNSRecordConfig) dnsrecord.Actuator {
+	zoneCacheTTL := defaultZoneCacheTTL
+	if config.ZoneCacheTTL != nil {
+		zoneCacheTTL = config.ZoneCacheTTL.Duration
+	}
+
 	return &actuator{
-		client: mgr.GetClient(),
+		client:    mgr.GetClient(),
+		zoneCache: newZoneCache(zoneCacheTTL),
+		batcher:   newRecordSetBatcher(recordSetBatchWindow),
 	}
 }
 
 // Reconcile reconciles the DNSRecord.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
-	clientFactory, err := DefaultAzureClientFactoryFunc(
-		ctx,
-		a.client,
-		dns.Spec.SecretRef,
-		true,
-	)
+	dnsRecordConfig, err := helper.DNSRecordConfigFromDNSRecord(dns)
+	if err != nil {
+		return err
+	}
+	clientFactory, err := a.newClientFactory(ctx, dns, dnsRecordConfig)
+	if err != nil {
+		return err
+	}
+	account, err := a.accountKey(ctx, dns, dnsRecordConfig)
 	if err != nil {
 		return err
 	}
@@ -66,21 +86,53 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 	}
 
 	// Determine DNS zone ID
-	zone, err := a.getZone(ctx, log, dns, dnsZoneClient)
+	zone, err := a.getZone(ctx, log, dns, dnsRecordConfig, account, dnsZoneClient)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	// Create or update DNS recordset
+	// Determine whether the DNS recordset should be an alias recordset pointing to an Azure resource
+	targetResourceID, err := getAliasTargetResourceID(dns, dnsRecordConfig)
+	if err != nil {
+		return err
+	}
+
+	// Skip the update if the DNS recordset is already up-to-date
 	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
-	log.Info("Creating or updating DNS recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "values", dns.Spec.Values, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
-	if err := dnsRecordSetClient.CreateOrUpdate(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl); err != nil {
+	recordSet, err := dnsRecordSetClient.Get(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType))
+	if err != nil {
 		return &reconcilerutils.RequeueAfterError{
-			Cause:        fmt.Errorf("could not create or update DNS recordset in zone %s with name %s, type %s, and values %v: %+v", zone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
+			Cause:        fmt.Errorf("could not get DNS recordset in zone %s with name %s and type %s: %+v", zone, dns.Spec.Name, dns.Spec.RecordType, err),
 			RequeueAfter: requeueAfterOnProviderError,
 		}
 	}
 
+	if azureclient.IsRecordSetUpToDate(recordSet, string(dns.Spec.RecordType), dns.Spec.Values, targetResourceID, ttl) {
+		log.Info("DNS recordset is up-to-date", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+	} else if targetResourceID != nil {
+		// Create or update DNS alias recordset
+		log.Info("Creating or updating DNS alias recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "targetResource", *targetResourceID, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		if err := a.batcher.run(ctx, account+"/"+zone, recordSetKey(dns), func() error {
+			return dnsRecordSetClient.CreateOrUpdateAlias(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), *targetResourceID, ttl)
+		}); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS alias recordset in zone %s with name %s, type %s, and target resource %s: %+v", zone, dns.Spec.Name, dns.Spec.RecordType, *targetResourceID, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	} else {
+		// Create or update DNS recordset
+		log.Info("Creating or updating DNS recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "values", dns.Spec.Values, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+		if err := a.batcher.run(ctx, account+"/"+zone, recordSetKey(dns), func() error {
+			return dnsRecordSetClient.CreateOrUpdate(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl)
+		}); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS recordset in zone %s with name %s, type %s, and values %v: %+v", zone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	}
+
 	// Update resource status
 	patch := k8sclient.MergeFrom(dns.DeepCopy())
 	dns.Status.Zone = &zone
@@ -89,12 +141,15 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 
 // Delete deletes the DNSRecord.
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
-	clientFactory, err := DefaultAzureClientFactoryFunc(
-		ctx,
-		a.client,
-		dns.Spec.SecretRef,
-		true,
-	)
+	dnsRecordConfig, err := helper.DNSRecordConfigFromDNSRecord(dns)
+	if err != nil {
+		return err
+	}
+	clientFactory, err := a.newClientFactory(ctx, dns, dnsRecordConfig)
+	if err != nil {
+		return err
+	}
+	account, err := a.accountKey(ctx, dns, dnsRecordConfig)
 	if err != nil {
 		return err
 	}
@@ -110,14 +165,16 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv
 	}
 
 	// Determine DNS zone ID
-	zone, err := a.getZone(ctx, log, dns, dnsZoneClient)
+	zone, err := a.getZone(ctx, log, dns, dnsRecordConfig, account, dnsZoneClient)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
 	// Delete DNS recordset
 	log.Info("Deleting DNS recordset", "zone", zone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
-	if err := dnsRecordSetClient.Delete(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType)); err != nil {
+	if err := a.batcher.run(ctx, account+"/"+zone, recordSetKey(dns), func() error {
+		return dnsRecordSetClient.Delete(ctx, zone, dns.Spec.Name, string(dns.Spec.RecordType))
+	}); err != nil {
 		return &reconcilerutils.RequeueAfterError{
 			Cause:        fmt.Errorf("could not delete DNS recordset in zone %s with name %s and type %s: %+v", zone, dns.Spec.Name, dns.Spec.RecordType, err),
 			RequeueAfter: requeueAfterOnProviderError,
@@ -142,27 +199,96 @@ func (a *actuator) Migrate(_ context.Context, _ logr.Logger, _ *extensionsv1alph
 	return nil
 }
 
-func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsZoneClient azureclient.DNSZone) (string, error) {
+func (a *actuator) newClientFactory(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsRecordConfig *api.DNSRecordConfig) (azureclient.Factory, error) {
+	if errs := validation.ValidateDNSRecordConfig(dnsRecordConfig, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid providerConfig of DNSRecord: %w", errs.ToAggregate())
+	}
+
+	var options []azureclient.AzureFactoryOption
+	if dnsRecordConfig.SubscriptionID != nil {
+		// The DNS zone is located in another subscription than the one of the DNS credentials.
+		options = append(options, azureclient.WithSubscriptionID(*dnsRecordConfig.SubscriptionID))
+	}
+
+	return DefaultAzureClientFactoryFunc(
+		ctx,
+		a.client,
+		helper.CredentialsSecretRef(dns.Spec.SecretRef, dns.Namespace, dnsRecordConfig.CredentialsRef),
+		true,
+		options...,
+	)
+}
+
+func (a *actuator) getZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsRecordConfig *api.DNSRecordConfig, account string, dnsZoneClient azureclient.DNSZone) (string, error) {
 	switch {
 	case dns.Spec.Zone != nil && *dns.Spec.Zone != "":
+		if dnsRecordConfig.ResourceGroup != nil && !strings.Contains(*dns.Spec.Zone, "/") {
+			// The zone is specified by name only, qualify it with the configured resource group.
+			return *dnsRecordConfig.ResourceGroup + "/" + *dns.Spec.Zone, nil
+		}
 		return *dns.Spec.Zone, nil
 	case dns.Status.Zone != nil && *dns.Status.Zone != "":
 		return *dns.Status.Zone, nil
 	default:
 		// The zone is not specified in the resource status or spec. Try to determine the zone by
-		// getting all zones of the account and searching for the longest zone name that is a suffix of dns.spec.Name
-		zones, err := dnsZoneClient.List(ctx)
-		if err != nil {
-			return "", &reconcilerutils.RequeueAfterError{
-				Cause:        fmt.Errorf("could not get DNS zones: %+v", err),
-				RequeueAfter: requeueAfterOnProviderError,
+		// getting all zones of the account (or the configured resource group) and searching for the longest zone name
+		// that is a suffix of dns.spec.Name. The zones are shared with other DNSRecords using the same credentials via the
+		// zone cache, which is refreshed if no zone is found as the zone might have been created recently.
+		list := func() (map[string]string, error) {
+			if dnsRecordConfig.ResourceGroup != nil {
+				return dnsZoneClient.ListByResourceGroup(ctx, *dnsRecordConfig.ResourceGroup)
 			}
+			return dnsZoneClient.List(ctx)
 		}
-		log.Info("Got DNS zones", "zones", zones, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
-		zone := dnsrecord.FindZoneForName(zones, dns.Spec.Name)
-		if zone == "" {
-			return "", fmt.Errorf("could not find DNS zone for name %s", dns.Spec.Name)
+		key := account + "/" + ptr.Deref(dnsRecordConfig.ResourceGroup, "")
+
+		for _, refresh := range []bool{false, true} {
+			zones, err := a.zoneCache.get(key, refresh, list)
+			if err != nil {
+				return "", &reconcilerutils.RequeueAfterError{
+					Cause:        fmt.Errorf("could not get DNS zones: %+v", err),
+					RequeueAfter: requeueAfterOnProviderError,
+				}
+			}
+			log.Info("Got DNS zones", "zones", zones, "dnsrecord", k8sclient.ObjectKeyFromObject(dns))
+			if zone := dnsrecord.FindZoneForName(zones, dns.Spec.Name); zone != "" {
+				return zone, nil
+			}
 		}
-		return zone, nil
+		return "", fmt.Errorf("could not find DNS zone for name %s", dns.Spec.Name)
+	}
+}
+
+// accountKey returns a key for the Azure account the given DNSRecord is managed in, which is derived from the content of
+// its credentials and the configured subscription. DNSRecords share the cached zones and the batches of recordset calls
+// if they use the same credentials, even if these are stored in different secrets, e.g. in the namespaces of different
+// shoots.
+func (a *actuator) accountKey(ctx context.Context, dns *extensionsv1alpha1.DNSRecord, dnsRecordConfig *api.DNSRecordConfig) (string, error) {
+	secretRef := helper.CredentialsSecretRef(dns.Spec.SecretRef, dns.Namespace, dnsRecordConfig.CredentialsRef)
+	secret := &corev1.Secret{}
+	if err := a.client.Get(ctx, k8sclient.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, secret); err != nil {
+		return "", fmt.Errorf("could not read the credentials secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err)
+	}
+	return utils.ComputeSecretChecksum(secret.Data) + "/" + ptr.Deref(dnsRecordConfig.SubscriptionID, ""), nil
+}
+
+// recordSetKey returns a key for the recordset of the given DNSRecord within its zone.
+func recordSetKey(dns *extensionsv1alpha1.DNSRecord) string {
+	return dns.Spec.Name + "/" + string(dns.Spec.RecordType)
+}
+
+// getAliasTargetResourceID returns the Azure resource ID the DNS recordset should point to if the providerConfig of the
+// DNSRecord has an alias target, or nil if a regular recordset should be created. The target cannot be passed via the
+// values of the DNSRecord, as they are validated to be IP addresses or domain names.
+func getAliasTargetResourceID(dns *extensionsv1alpha1.DNSRecord, dnsRecordConfig *api.DNSRecordConfig) (*string, error) {
+	if dnsRecordConfig.AliasTarget == nil {
+		return nil, nil
+	}
+
+	switch dns.Spec.RecordType {
+	case extensionsv1alpha1.DNSRecordTypeA, extensionsv1alpha1.DNSRecordTypeAAAA, extensionsv1alpha1.DNSRecordTypeCNAME:
+	default:
+		return nil, fmt.Errorf("alias recordsets are not supported for record type %s", dns.Spec.RecordType)
 	}
+	return dnsRecordConfig.AliasTarget, nil
 }
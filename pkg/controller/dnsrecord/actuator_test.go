@@ -6,7 +6,12 @@ package dnsrecord_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dns/armdns"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
@@ -14,13 +19,16 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
@@ -34,6 +42,7 @@ const (
 	domainName  = "api.azure.foobar." + shootDomain
 	zone        = "zone"
 	address     = "1.2.3.4"
+	publicIPID  = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/ip"
 )
 
 var _ = Describe("Actuator", func() {
@@ -49,6 +58,7 @@ var _ = Describe("Actuator", func() {
 		logger                  logr.Logger
 		a                       dnsrecord.Actuator
 		dns                     *extensionsv1alpha1.DNSRecord
+		secrets                 map[client.ObjectKey]map[string][]byte
 		zones                   map[string]string
 		defaultFactory          = DefaultAzureClientFactoryFunc
 	)
@@ -64,6 +74,20 @@ var _ = Describe("Actuator", func() {
 
 		c.EXPECT().Status().Return(sw).AnyTimes()
 
+		secrets = map[client.ObjectKey]map[string][]byte{
+			{Namespace: namespace, Name: name}: {"clientSecret": []byte("secret")},
+		}
+		c.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+			func(_ context.Context, key client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+				data, ok := secrets[key]
+				if !ok {
+					return fmt.Errorf("secret %s not found", key)
+				}
+				secret.Data = data
+				return nil
+			},
+		).AnyTimes()
+
 		DefaultAzureClientFactoryFunc = func(_ context.Context, _ client.Client, _ corev1.SecretReference, _ bool, _ ...azclient.AzureFactoryOption) (azclient.Factory, error) {
 			return azureClientFactory, nil
 		}
@@ -74,7 +98,7 @@ var _ = Describe("Actuator", func() {
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
 
-		a = NewActuator(mgr)
+		a = NewActuator(mgr, config.DNSRecordConfig{})
 
 		dns = &extensionsv1alpha1.DNSRecord{
 			ObjectMeta: metav1.ObjectMeta{
@@ -109,11 +133,7 @@ var _ = Describe("Actuator", func() {
 	})
 
 	Describe("#Reconcile", func() {
-		It("should reconcile the DNSRecord", func() {
-			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
-			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
-			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
-			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+		expectStatusPatch := func() {
 			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
 				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
 					Expect(obj.Status).To(Equal(extensionsv1alpha1.DNSRecordStatus{
@@ -122,10 +142,284 @@ var _ = Describe("Actuator", func() {
 					return nil
 				},
 			)
+		}
+
+		It("should reconcile the DNSRecord", func() {
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil, nil)
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			expectStatusPatch()
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not update the recordset if it is up-to-date", func() {
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(&armdns.RecordSet{
+				Properties: &armdns.RecordSetProperties{
+					TTL:      ptr.To[int64](120),
+					ARecords: []*armdns.ARecord{{IPv4Address: ptr.To(address)}},
+				},
+			}, nil)
+			expectStatusPatch()
 
 			err := a.Reconcile(ctx, logger, dns, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should update the recordset if its values or TTL have changed", func() {
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(&armdns.RecordSet{
+				Properties: &armdns.RecordSetProperties{
+					TTL:      ptr.To[int64](300),
+					ARecords: []*armdns.ARecord{{IPv4Address: ptr.To("5.6.7.8")}},
+				},
+			}, nil)
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			expectStatusPatch()
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the recordset cannot be read", func() {
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil, fmt.Errorf("test"))
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("could not get DNS recordset")))
+		})
+
+		It("should create an alias recordset if the alias target is set in the providerConfig", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","aliasTarget":"` + publicIPID + `"}`),
+			}
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil, nil)
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdateAlias(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), publicIPID, int64(120)).Return(nil)
+			expectStatusPatch()
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not update the alias recordset if it points to the alias target", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","aliasTarget":"` + publicIPID + `"}`),
+			}
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(&armdns.RecordSet{
+				Properties: &armdns.RecordSetProperties{
+					TTL:            ptr.To[int64](120),
+					TargetResource: &armdns.SubResource{ID: ptr.To(publicIPID)},
+				},
+			}, nil)
+			expectStatusPatch()
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reconcile the DNSRecord in the subscription and resource group of the providerConfig", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","subscriptionID":"00000000-0000-0000-0000-000000000000","resourceGroup":"dns"}`),
+			}
+			DefaultAzureClientFactoryFunc = func(_ context.Context, _ client.Client, _ corev1.SecretReference, _ bool, opts ...azclient.AzureFactoryOption) (azclient.Factory, error) {
+				Expect(opts).To(HaveLen(1))
+				return azureClientFactory, nil
+			}
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().ListByResourceGroup(ctx, "dns").Return(zones, nil)
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil, nil)
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			expectStatusPatch()
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the providerConfig is invalid", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","subscriptionID":"foo"}`),
+			}
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("invalid providerConfig")))
+		})
+
+		It("should fail if the alias target is not an Azure resource ID", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","aliasTarget":"` + address + `"}`),
+			}
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("invalid providerConfig")))
+		})
+
+		It("should fail if the alias target is set for a TXT record", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"DNSRecordConfig","aliasTarget":"` + publicIPID + `"}`),
+			}
+			dns.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeTXT
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil)
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil)
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("not supported for record type TXT")))
+		})
+	})
+
+	Describe("zone cache", func() {
+		BeforeEach(func() {
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil).AnyTimes()
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil).AnyTimes()
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, gomock.Any(), string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil, nil).AnyTimes()
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, gomock.Any(), string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil).AnyTimes()
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil).AnyTimes()
+		})
+
+		It("should share the listed DNS zones between DNSRecords using the same credentials", func() {
+			otherDNS := dns.DeepCopy()
+			otherDNS.Name = "azure-internal"
+			otherDNS.Spec.Name = "api.internal." + shootDomain
+
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			Expect(a.Reconcile(ctx, logger, otherDNS, nil)).To(Succeed())
+			Expect(otherDNS.Status.Zone).To(PointTo(Equal(zone)))
+		})
+
+		It("should share the listed DNS zones between DNSRecords using the same credentials from different secrets", func() {
+			otherDNS := dns.DeepCopy()
+			otherDNS.Namespace = "shoot--foobar--other"
+			otherDNS.Spec.SecretRef.Namespace = otherDNS.Namespace
+			secrets[client.ObjectKey{Namespace: otherDNS.Namespace, Name: name}] = secrets[client.ObjectKey{Namespace: namespace, Name: name}]
+
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			Expect(a.Reconcile(ctx, logger, otherDNS, nil)).To(Succeed())
+			Expect(otherDNS.Status.Zone).To(PointTo(Equal(zone)))
+		})
+
+		It("should not share the listed DNS zones between DNSRecords using different credentials", func() {
+			otherDNS := dns.DeepCopy()
+			otherDNS.Namespace = "shoot--foobar--other"
+			otherDNS.Spec.SecretRef.Namespace = otherDNS.Namespace
+			secrets[client.ObjectKey{Namespace: otherDNS.Namespace, Name: name}] = map[string][]byte{"clientSecret": []byte("other")}
+
+			azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil).Times(2)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			Expect(a.Reconcile(ctx, logger, otherDNS, nil)).To(Succeed())
+		})
+
+		It("should refresh the cached DNS zones if no zone matches the name", func() {
+			gomock.InOrder(
+				azureDNSZoneClient.EXPECT().List(ctx).Return(map[string]string{"other.com": "zone3"}, nil),
+				azureDNSZoneClient.EXPECT().List(ctx).Return(zones, nil),
+			)
+
+			Expect(a.Reconcile(ctx, logger, dns, nil)).To(Succeed())
+			Expect(dns.Status.Zone).To(PointTo(Equal(zone)))
+		})
+	})
+
+	Describe("batching", func() {
+		It("should not update recordsets of the same zone concurrently", func() {
+			var (
+				inFlight, maxInFlight, calls atomic.Int32
+				wg                           sync.WaitGroup
+			)
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil).AnyTimes()
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil).AnyTimes()
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, gomock.Any(), string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil, nil).AnyTimes()
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, gomock.Any(), string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).DoAndReturn(
+				func(_ context.Context, _, _, _ string, _ []string, _ int64) error {
+					current := inFlight.Add(1)
+					defer inFlight.Add(-1)
+					if current > maxInFlight.Load() {
+						maxInFlight.Store(current)
+					}
+					calls.Add(1)
+					time.Sleep(10 * time.Millisecond)
+					return nil
+				},
+			).AnyTimes()
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil).AnyTimes()
+
+			for i := range 5 {
+				record := dns.DeepCopy()
+				record.Spec.Name = fmt.Sprintf("record-%d.%s", i, shootDomain)
+				record.Spec.Zone = ptr.To(zone)
+
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(a.Reconcile(ctx, logger, record, nil)).To(Succeed())
+				}()
+			}
+			wg.Wait()
+
+			Expect(calls.Load()).To(Equal(int32(5)))
+			Expect(maxInFlight.Load()).To(Equal(int32(1)))
+		})
+
+		It("should coalesce concurrent updates of the same recordset", func() {
+			var (
+				calls atomic.Int32
+				wg    sync.WaitGroup
+			)
+
+			azureClientFactory.EXPECT().DNSZone().Return(azureDNSZoneClient, nil).AnyTimes()
+			azureClientFactory.EXPECT().DNSRecordSet().Return(azureDNSRecordSetClient, nil).AnyTimes()
+			azureDNSRecordSetClient.EXPECT().Get(ctx, zone, gomock.Any(), string(extensionsv1alpha1.DNSRecordTypeA)).Return(nil, nil).AnyTimes()
+			azureDNSRecordSetClient.EXPECT().CreateOrUpdate(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).DoAndReturn(
+				func(_ context.Context, _, _, _ string, _ []string, _ int64) error {
+					calls.Add(1)
+					return nil
+				},
+			).AnyTimes()
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil).AnyTimes()
+
+			for i := range 3 {
+				record := dns.DeepCopy()
+				record.Name = fmt.Sprintf("%s-%d", name, i)
+				record.Spec.Zone = ptr.To(zone)
+
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(a.Reconcile(ctx, logger, record, nil)).To(Succeed())
+				}()
+			}
+			wg.Wait()
+
+			Expect(calls.Load()).To(Equal(int32(1)))
+		})
 	})
 
 	Describe("#Delete", func() {
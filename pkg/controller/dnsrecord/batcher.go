@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnsrecord
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// recordSetBatchWindow is the duration for which the recordset calls of concurrent reconciliations are collected
+// before the batch of a DNS zone is sent.
+const recordSetBatchWindow = 200 * time.Millisecond
+
+// recordSetBatcher batches the mutating recordset calls of concurrent reconciliations per DNS zone. The calls which are
+// queued within the batch window or while the previous batch is sent form the next batch. Calls of a batch for the same
+// recordset are coalesced, i.e. only the last one is sent and its result is returned to all callers, as it reflects the
+// latest desired state of the recordset. Azure DNS has no batch API for recordsets, hence the remaining calls of a batch
+// are sent sequentially. This keeps bursts of reconciliations, e.g. after a restart of the extension, from exceeding the
+// ARM write limits of a zone.
+type recordSetBatcher struct {
+	window time.Duration
+
+	mutex  sync.Mutex
+	queues map[string][]*recordSetOperation
+}
+
+type recordSetOperation struct {
+	recordSet string
+	do        func() error
+	done      chan error
+}
+
+func newRecordSetBatcher(window time.Duration) *recordSetBatcher {
+	return &recordSetBatcher{
+		window: window,
+		queues: map[string][]*recordSetOperation{},
+	}
+}
+
+// run queues the given operation on the given recordset for the given zone and waits until it has been processed. The
+// operation is expected to use the given context, so that it returns early if the context is canceled while it is
+// queued.
+func (b *recordSetBatcher) run(ctx context.Context, zone, recordSet string, do func() error) error {
+	op := &recordSetOperation{recordSet: recordSet, do: do, done: make(chan error, 1)}
+
+	b.mutex.Lock()
+	queue, processing := b.queues[zone]
+	b.queues[zone] = append(queue, op)
+	b.mutex.Unlock()
+
+	if !processing {
+		go b.process(zone)
+	}
+
+	select {
+	case err := <-op.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *recordSetBatcher) process(zone string) {
+	for {
+		time.Sleep(b.window)
+
+		b.mutex.Lock()
+		batch := b.queues[zone]
+		if len(batch) == 0 {
+			delete(b.queues, zone)
+			b.mutex.Unlock()
+			return
+		}
+		b.queues[zone] = nil
+		b.mutex.Unlock()
+
+		for _, ops := range coalesce(batch) {
+			err := ops[len(ops)-1].do()
+			for _, op := range ops {
+				op.done <- err
+			}
+		}
+	}
+}
+
+// coalesce groups the operations of the given batch by recordset in the order of their first occurrence.
+func coalesce(batch []*recordSetOperation) [][]*recordSetOperation {
+	var (
+		groups  [][]*recordSetOperation
+		indices = map[string]int{}
+	)
+
+	for _, op := range batch {
+		i, ok := indices[op.recordSet]
+		if !ok {
+			i = len(groups)
+			indices[op.recordSet] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], op)
+	}
+	return groups
+}
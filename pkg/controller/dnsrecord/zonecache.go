@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnsrecord
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// defaultZoneCacheTTL is the default duration for which listed DNS zones are cached.
+	defaultZoneCacheTTL = 5 * time.Minute
+
+	zoneCacheResultHit  = "hit"
+	zoneCacheResultMiss = "miss"
+)
+
+var zoneCacheRequestsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "azure_dnsrecord_zone_cache_requests_total",
+		Help: "Number of lookups of DNS zones in the zone cache of the DNSRecord controller by result (hit or miss).",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(zoneCacheRequestsTotal)
+}
+
+// zoneCache caches the DNS zones listed for DNSRecords without zone, so that the zones are not listed again for every
+// DNSRecord using the same credentials. Concurrent lookups of the same key list the zones only once.
+type zoneCache struct {
+	ttl time.Duration
+
+	mutex   sync.Mutex
+	entries map[string]*zoneCacheEntry
+}
+
+type zoneCacheEntry struct {
+	mutex     sync.Mutex
+	zones     map[string]string
+	expiresAt time.Time
+}
+
+func newZoneCache(ttl time.Duration) *zoneCache {
+	return &zoneCache{
+		ttl:     ttl,
+		entries: map[string]*zoneCacheEntry{},
+	}
+}
+
+// get returns the cached zones for the given key. The zones are listed with the given function if they are not cached,
+// if the cached zones are expired or if refresh is set.
+func (c *zoneCache) get(key string, refresh bool, list func() (map[string]string, error)) (map[string]string, error) {
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &zoneCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mutex.Unlock()
+
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	if !refresh && entry.zones != nil && time.Now().Before(entry.expiresAt) {
+		zoneCacheRequestsTotal.WithLabelValues(zoneCacheResultHit).Inc()
+		return entry.zones, nil
+	}
+
+	zoneCacheRequestsTotal.WithLabelValues(zoneCacheResultMiss).Inc()
+	zones, err := list()
+	if err != nil {
+		return nil, err
+	}
+	entry.zones = zones
+	entry.expiresAt = time.Now().Add(c.ttl)
+	return zones, nil
+}
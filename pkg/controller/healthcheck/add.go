@@ -65,15 +65,15 @@ func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthc
 			},
 			{
 				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
-				HealthCheck:   general.NewSeedDeploymentHealthChecker(azure.CSIControllerDiskName),
+				HealthCheck:   NewSeedDeploymentProgressingChecker(azure.CSIControllerDiskName),
 			},
 			{
 				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
-				HealthCheck:   general.NewSeedDeploymentHealthChecker(azure.CSIControllerFileName),
+				HealthCheck:   NewSeedDeploymentProgressingChecker(azure.CSIControllerFileName),
 			},
 			{
 				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
-				HealthCheck:   general.NewSeedDeploymentHealthChecker(azure.RemedyControllerName),
+				HealthCheck:   NewSeedDeploymentProgressingChecker(azure.RemedyControllerName),
 				PreCheckFunc:  remedyControllerPreCheckFunc,
 			},
 			{
@@ -84,6 +84,10 @@ func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthc
 				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
 				HealthCheck:   general.NewSeedDeploymentHealthChecker(azure.CSISnapshotValidationName),
 			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
+				HealthCheck:   NewCloudProviderConfigChecker(),
+			},
 		},
 		sets.Set[gardencorev1beta1.ConditionType]{},
 	); err != nil {
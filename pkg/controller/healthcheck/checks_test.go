@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/healthcheck"
+)
+
+const namespace = "shoot--foo--bar"
+
+var _ = Describe("Checks", func() {
+	var (
+		ctx     = context.Background()
+		request = types.NamespacedName{Namespace: namespace, Name: "control-plane"}
+		c       client.Client
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+	})
+
+	check := func(healthCheck healthcheck.HealthCheck) *healthcheck.SingleCheckResult {
+		healthCheck.SetLoggerSuffix("azure", "controlplane")
+		healthCheck.(interface{ InjectSeedClient(client.Client) }).InjectSeedClient(c)
+		result, err := healthCheck.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	Describe("CloudProviderConfigChecker", func() {
+		BeforeEach(func() {
+			Expect(c.Create(ctx, &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: request.Name},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					InfrastructureProviderStatus: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus",` +
+						`"resourceGroup":{"name":"rg"},"networks":{"vnet":{"name":"vnet"},"subnets":[{"name":"nodes","purpose":"nodes"}]},` +
+						`"routeTables":[{"name":"rt","purpose":"nodes"}],"securityGroups":[{"name":"sg","purpose":"nodes"}]}`)},
+				},
+			})).To(Succeed())
+		})
+
+		createCloudProviderConfig := func(subnetName string) {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: azure.CloudProviderConfigName},
+				Data: map[string][]byte{azure.CloudProviderConfigMapKey: []byte(`cloud: "AZUREPUBLICCLOUD"
+resourceGroup: "rg"
+routeTableName: "rt"
+securityGroupName: "sg"
+subnetName: "` + subnetName + `"
+vnetName: "vnet"
+cloudProviderRateLimitQPS: 10
+`)},
+			})).To(Succeed())
+		}
+
+		It("should succeed if the cloud provider config matches the infrastructure status", func() {
+			createCloudProviderConfig("nodes")
+			Expect(check(NewCloudProviderConfigChecker()).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		})
+
+		It("should report an outdated cloud provider config", func() {
+			createCloudProviderConfig("old-nodes")
+			result := check(NewCloudProviderConfigChecker())
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+			Expect(result.Detail).To(Equal(`CloudProviderConfigOutdated: cloud provider config does not match the infrastructure status: subnetName is "old-nodes" instead of "nodes"`))
+			Expect(result.ProgressingThreshold).NotTo(BeNil())
+		})
+
+		It("should report a missing cloud provider config", func() {
+			result := check(NewCloudProviderConfigChecker())
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(result.Detail).To(HavePrefix("CloudProviderConfigMissing: "))
+		})
+	})
+
+	Describe("DeploymentProgressingChecker", func() {
+		createDeployment := func(progressing appsv1.DeploymentCondition) {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: azure.RemedyControllerName, Generation: 1},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+						progressing,
+					},
+				},
+			}
+			Expect(c.Create(ctx, deployment)).To(Succeed())
+		}
+
+		It("should succeed if the deployment is rolled out and healthy", func() {
+			createDeployment(appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "NewReplicaSetAvailable"})
+			Expect(check(NewSeedDeploymentProgressingChecker(azure.RemedyControllerName)).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		})
+
+		It("should report a rollout in progress", func() {
+			createDeployment(appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "ReplicaSetUpdated", Message: "rolling out"})
+			result := check(NewSeedDeploymentProgressingChecker(azure.RemedyControllerName))
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionProgressing))
+			Expect(result.Detail).To(HavePrefix("DeploymentProgressing: "))
+			Expect(result.ProgressingThreshold).To(PointTo(BeNumerically(">", 0)))
+		})
+
+		It("should fail if the rollout exceeded its progress deadline", func() {
+			createDeployment(appsv1.DeploymentCondition{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"})
+			result := check(NewSeedDeploymentProgressingChecker(azure.RemedyControllerName))
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(result.Detail).To(HavePrefix("DeploymentUnhealthy: "))
+		})
+
+		It("should report a missing deployment", func() {
+			result := check(NewSeedDeploymentProgressingChecker(azure.RemedyControllerName))
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(result.Detail).To(HavePrefix("DeploymentMissing: "))
+		})
+	})
+})
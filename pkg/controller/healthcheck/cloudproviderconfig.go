@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+// cloudProviderConfig contains the fields of the cloud provider config which are derived from the infrastructure
+// status.
+type cloudProviderConfig struct {
+	ResourceGroup     string `json:"resourceGroup"`
+	VnetName          string `json:"vnetName"`
+	VnetResourceGroup string `json:"vnetResourceGroup"`
+	SubnetName        string `json:"subnetName"`
+	RouteTableName    string `json:"routeTableName"`
+	SecurityGroupName string `json:"securityGroupName"`
+}
+
+// CloudProviderConfigChecker checks that the cloud provider config used by the cloud-controller-manager and the CSI
+// controllers references the resources of the current infrastructure status, e.g. after a subnet was renamed.
+type CloudProviderConfigChecker struct {
+	logger     logr.Logger
+	seedClient client.Client
+}
+
+// NewCloudProviderConfigChecker is a health check function which checks the cloud provider config of a ControlPlane
+// against its infrastructure status.
+func NewCloudProviderConfigChecker() healthcheck.HealthCheck {
+	return &CloudProviderConfigChecker{}
+}
+
+// InjectSeedClient injects the seed client.
+func (c *CloudProviderConfigChecker) InjectSeedClient(seedClient client.Client) {
+	c.seedClient = seedClient
+}
+
+// SetLoggerSuffix injects the logger.
+func (c *CloudProviderConfigChecker) SetLoggerSuffix(provider, extension string) {
+	c.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-cloud-provider-config", provider, extension))
+}
+
+// DeepCopy clones the health check.
+func (c *CloudProviderConfigChecker) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *c
+	return &shallowCopy
+}
+
+// Check executes the health check.
+func (c *CloudProviderConfigChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	cp := &extensionsv1alpha1.ControlPlane{}
+	if err := c.seedClient.Get(ctx, request, cp); err != nil {
+		return nil, fmt.Errorf("failed to retrieve controlplane %q: %w", request, err)
+	}
+	if cp.Spec.InfrastructureProviderStatus == nil {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+	infraStatus, err := helper.InfrastructureStatusFromRaw(cp.Spec.InfrastructureProviderStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the infrastructure status of controlplane %q: %w", request, err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.seedClient.Get(ctx, client.ObjectKey{Namespace: request.Namespace, Name: azure.CloudProviderConfigName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: detail(ReasonCloudProviderConfigMissing, fmt.Sprintf("secret %q in namespace %q not found", azure.CloudProviderConfigName, request.Namespace)),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve secret %q in namespace %q: %w", azure.CloudProviderConfigName, request.Namespace, err)
+	}
+
+	current := &cloudProviderConfig{}
+	if err := yaml.Unmarshal(secret.Data[azure.CloudProviderConfigMapKey], current); err != nil {
+		return nil, fmt.Errorf("failed to decode the cloud provider config in namespace %q: %w", request.Namespace, err)
+	}
+
+	if mismatches := compareCloudProviderConfig(current, expectedCloudProviderConfig(infraStatus)); len(mismatches) > 0 {
+		message := fmt.Sprintf("cloud provider config does not match the infrastructure status: %s", strings.Join(mismatches, ", "))
+		c.logger.Info("Health check failed", "controlplane", request, "reason", message)
+		// The cloud provider config is updated by the next reconciliation of the ControlPlane, hence the check is only
+		// considered failed if the mismatch persists.
+		return &healthcheck.SingleCheckResult{
+			Status:               gardencorev1beta1.ConditionProgressing,
+			Detail:               detail(ReasonCloudProviderConfigOutdated, message),
+			ProgressingThreshold: ptr.To(progressingThreshold),
+		}, nil
+	}
+
+	return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+}
+
+// expectedCloudProviderConfig returns the fields of the cloud provider config for the given infrastructure status.
+// Fields which cannot be determined from the infrastructure status are left empty and not compared.
+func expectedCloudProviderConfig(infraStatus *api.InfrastructureStatus) *cloudProviderConfig {
+	expected := &cloudProviderConfig{
+		ResourceGroup:     infraStatus.ResourceGroup.Name,
+		VnetName:          infraStatus.Networks.VNet.Name,
+		VnetResourceGroup: ptr.Deref(infraStatus.Networks.VNet.ResourceGroup, ""),
+	}
+	if _, subnet, err := helper.FindSubnetByPurposeAndZone(infraStatus.Networks.Subnets, api.PurposeNodes, nil); err == nil {
+		expected.SubnetName = subnet.Name
+	}
+	if routeTable, err := helper.FindRouteTableByPurpose(infraStatus.RouteTables, api.PurposeNodes); err == nil {
+		expected.RouteTableName = routeTable.Name
+	}
+	if securityGroup, err := helper.FindSecurityGroupByPurpose(infraStatus.SecurityGroups, api.PurposeNodes); err == nil {
+		expected.SecurityGroupName = securityGroup.Name
+	}
+	return expected
+}
+
+func compareCloudProviderConfig(current, expected *cloudProviderConfig) []string {
+	var mismatches []string
+	for _, field := range []struct {
+		name              string
+		current, expected string
+		optional          bool
+	}{
+		{name: "resourceGroup", current: current.ResourceGroup, expected: expected.ResourceGroup},
+		{name: "vnetName", current: current.VnetName, expected: expected.VnetName},
+		{name: "vnetResourceGroup", current: current.VnetResourceGroup, expected: expected.VnetResourceGroup, optional: true},
+		{name: "subnetName", current: current.SubnetName, expected: expected.SubnetName},
+		{name: "routeTableName", current: current.RouteTableName, expected: expected.RouteTableName},
+		{name: "securityGroupName", current: current.SecurityGroupName, expected: expected.SecurityGroupName},
+	} {
+		if (field.expected != "" || field.optional) && field.current != field.expected {
+			mismatches = append(mismatches, fmt.Sprintf("%s is %q instead of %q", field.name, field.current, field.expected))
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ReasonCloudProviderConfigMissing is the reason of a failed check if the cloud provider config does not exist.
+	ReasonCloudProviderConfigMissing = "CloudProviderConfigMissing"
+	// ReasonCloudProviderConfigOutdated is the reason of a failed check if the cloud provider config does not match the
+	// infrastructure status.
+	ReasonCloudProviderConfigOutdated = "CloudProviderConfigOutdated"
+	// ReasonDeploymentMissing is the reason of a failed check if a deployment does not exist.
+	ReasonDeploymentMissing = "DeploymentMissing"
+	// ReasonDeploymentProgressing is the reason of a failed check if the rollout of a deployment is in progress.
+	ReasonDeploymentProgressing = "DeploymentProgressing"
+	// ReasonDeploymentUnhealthy is the reason of a failed check if a deployment is rolled out but unhealthy.
+	ReasonDeploymentUnhealthy = "DeploymentUnhealthy"
+
+	// progressingThreshold is the duration after which a check which reports the Progressing status is considered failed.
+	progressingThreshold = 5 * time.Minute
+)
+
+// detail returns the detail of a check result prefixed with the given reason. The reason of the health condition is
+// owned by the generic health check reconciler, hence the reasons of the single checks are surfaced in the condition
+// message instead.
+func detail(reason, message string) string {
+	return fmt.Sprintf("%s: %s", reason, message)
+}
+
+// DeploymentProgressingChecker checks a deployment in the seed like the general deployment health check, but reports
+// the Progressing status while its rollout is in progress instead of failing immediately.
+type DeploymentProgressingChecker struct {
+	logger     logr.Logger
+	seedClient client.Client
+	name       string
+}
+
+// NewSeedDeploymentProgressingChecker is a health check function which checks the deployment with the given name in the
+// seed and tolerates rollouts in progress for the progressing threshold.
+func NewSeedDeploymentProgressingChecker(deploymentName string) healthcheck.HealthCheck {
+	return &DeploymentProgressingChecker{name: deploymentName}
+}
+
+// InjectSeedClient injects the seed client.
+func (c *DeploymentProgressingChecker) InjectSeedClient(seedClient client.Client) {
+	c.seedClient = seedClient
+}
+
+// SetLoggerSuffix injects the logger.
+func (c *DeploymentProgressingChecker) SetLoggerSuffix(provider, extension string) {
+	c.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-deployment-progressing", provider, extension))
+}
+
+// DeepCopy clones the health check.
+func (c *DeploymentProgressingChecker) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *c
+	return &shallowCopy
+}
+
+// Check executes the health check.
+func (c *DeploymentProgressingChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	deployment := &appsv1.Deployment{}
+	if err := c.seedClient.Get(ctx, client.ObjectKey{Namespace: request.Namespace, Name: c.name}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{
+				Status: gardencorev1beta1.ConditionFalse,
+				Detail: detail(ReasonDeploymentMissing, fmt.Sprintf("deployment %q in namespace %q not found", c.name, request.Namespace)),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve deployment %q in namespace %q: %w", c.name, request.Namespace, err)
+	}
+
+	if progressing, message := health.IsDeploymentProgressing(deployment); progressing && !hasProgressDeadlineExceeded(deployment) {
+		return &healthcheck.SingleCheckResult{
+			Status:               gardencorev1beta1.ConditionProgressing,
+			Detail:               detail(ReasonDeploymentProgressing, fmt.Sprintf("deployment %q in namespace %q is progressing: %s", c.name, request.Namespace, message)),
+			ProgressingThreshold: ptr.To(progressingThreshold),
+		}, nil
+	}
+
+	if err := health.CheckDeployment(deployment); err != nil {
+		c.logger.Error(err, "Health check failed", "deployment", client.ObjectKeyFromObject(deployment))
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: detail(ReasonDeploymentUnhealthy, fmt.Sprintf("deployment %q in namespace %q is unhealthy: %v", c.name, request.Namespace, err)),
+		}, nil
+	}
+
+	return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+}
+
+// hasProgressDeadlineExceeded returns true if the deployment controller gave up on the rollout of the deployment.
+func hasProgressDeadlineExceeded(deployment *appsv1.Deployment) bool {
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing {
+			return condition.Reason == "ProgressDeadlineExceeded"
+		}
+	}
+	return false
+}
@@ -7,21 +7,33 @@ package infrastructure
 import (
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 type actuator struct {
 	client                     client.Client
 	restConfig                 *rest.Config
+	recorder                   record.EventRecorder
 	disableProjectedTokenMount bool
+	managementLocks            config.ManagementLocksConfig
+	factoryOptions             []azureclient.AzureFactoryOption
 }
 
-// NewActuator creates a new infrastructure.Actuator.
-func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool) infrastructure.Actuator {
+// NewActuator creates a new infrastructure.Actuator. The given factory options are applied to the Azure clients
+// of the flow reconciler, e.g. to inject a fake transport in tests.
+func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool, managementLocks config.ManagementLocksConfig, factoryOptions ...azureclient.AzureFactoryOption) infrastructure.Actuator {
 	return &actuator{
 		client:                     mgr.GetClient(),
 		restConfig:                 mgr.GetConfig(),
+		recorder:                   mgr.GetEventRecorderFor(azure.Name + "-infrastructure-controller"),
 		disableProjectedTokenMount: disableProjectedTokenMount,
+		managementLocks:            managementLocks,
+		factoryOptions:             factoryOptions,
 	}
 }
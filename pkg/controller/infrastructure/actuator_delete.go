@@ -13,10 +13,12 @@ import (
 	"github.com/go-logr/logr"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
-	return util.DetermineError(a.delete(ctx, log, OnDelete, infra, cluster), helper.KnownCodes)
+	err := util.DetermineError(a.delete(ctx, log, OnDelete, infra, cluster), helper.KnownCodes)
+	return azureclient.HandleRegionalOutage(ctx, log, a.client, infra, &infra.Status.Conditions, err)
 }
 
 // Delete implements infrastructure.Actuator.
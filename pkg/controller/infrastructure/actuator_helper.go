@@ -7,6 +7,7 @@ package infrastructure
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
@@ -14,6 +15,8 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
@@ -67,3 +70,82 @@ func GetFlowAnnotationValue(o v1.Object) bool {
 	}
 	return false
 }
+
+// validateZonalMigration checks the preconditions for converting a non-zoned infrastructure to a zoned one.
+func validateZonalMigration(infra *extensionsv1alpha1.Infrastructure, useFlow bool) error {
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return err
+	}
+	status, err := helper.InfrastructureStatusFromInfrastructure(infra)
+	if err != nil {
+		return err
+	}
+
+	if !helper.IsZonalMigrationRequested(config, status) {
+		return nil
+	}
+
+	if !useFlow {
+		return fmt.Errorf("converting a non-zoned to a zoned infrastructure is only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+	}
+
+	if len(status.AvailabilitySets) > 0 {
+		return fmt.Errorf("infrastructures using availability sets must be migrated to VMOs via the annotation %s=true before they can be converted to zoned", azuretypes.ShootVmoMigrationAnnotation)
+	}
+
+	return nil
+}
+
+// validateFlowOnlyFeatures checks that features which are only implemented by the flow reconciler are not configured
+// for infrastructures reconciled by Terraform.
+func validateFlowOnlyFeatures(infra *extensionsv1alpha1.Infrastructure, useFlow bool) error {
+	if useFlow {
+		return nil
+	}
+
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return err
+	}
+	if helper.IsResourceGroupDeletionProtected(config) {
+		return fmt.Errorf("the deletion protection of the resource group is only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+	}
+	if config.Networks.PodSubnet != nil {
+		return fmt.Errorf("a dedicated pod subnet is only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+	}
+	if config.Networks.OutboundAccess != nil {
+		return fmt.Errorf("outbound access via a next hop is only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+	}
+	if (config.Networks.OutboundAccessType != nil && string(*config.Networks.OutboundAccessType) == azure.OutboundAccessTypeLoadBalancer) || config.Networks.OutboundLoadBalancer != nil {
+		return fmt.Errorf("outbound access via the load balancer is only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+	}
+	if config.Networks.EgressFirewall != nil {
+		return fmt.Errorf("an egress firewall is only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+	}
+	if len(config.Networks.VNet.AdditionalCIDRs) > 0 {
+		return fmt.Errorf("additional vnet cidrs are only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+	}
+	if hasNatGatewayIPAutoScaling(config) {
+		return fmt.Errorf("the automatic scaling of the public IPs of NAT gateways is only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+	}
+	for _, zone := range config.Networks.Zones {
+		if zone.NatGateway != nil && zone.NatGateway.Existing != nil {
+			return fmt.Errorf("existing NAT gateways are only supported with the flow reconciler, enable it via the annotation %s=true", azuretypes.AnnotationKeyUseFlow)
+		}
+	}
+
+	return nil
+}
+
+func hasNatGatewayIPAutoScaling(config *azure.InfrastructureConfig) bool {
+	if natGateway := config.Networks.NatGateway; natGateway != nil && natGateway.AutoScaleIPs != nil {
+		return true
+	}
+	for _, zone := range config.Networks.Zones {
+		if zone.NatGateway != nil && zone.NatGateway.AutoScaleIPs != nil {
+			return true
+		}
+	}
+	return false
+}
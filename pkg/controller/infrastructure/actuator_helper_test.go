@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ZonalMigration", func() {
+	var infra *extensionsv1alpha1.Infrastructure
+
+	BeforeEach(func() {
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region: "westeurope",
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+						`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"}}`)},
+				},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ProviderStatus: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus",` +
+						`"resourceGroup":{"name":"shoot--foo--bar"},"networks":{"vnet":{}},"zoned":false}`)},
+				},
+			},
+		}
+	})
+
+	Describe("#validateZonalMigration", func() {
+		It("should allow the conversion of a VMO infrastructure with the flow reconciler", func() {
+			Expect(validateZonalMigration(infra, true)).To(Succeed())
+		})
+
+		It("should reject the conversion with the terraform reconciler", func() {
+			Expect(validateZonalMigration(infra, false)).To(MatchError(ContainSubstring("only supported with the flow reconciler")))
+		})
+
+		It("should reject the conversion of an infrastructure using an availability set", func() {
+			infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus",` +
+				`"resourceGroup":{"name":"shoot--foo--bar"},"networks":{"vnet":{}},"zoned":false,"availabilitySets":[{"purpose":"nodes","id":"avset-id","name":"avset"}]}`)}
+
+			Expect(validateZonalMigration(infra, true)).To(MatchError(ContainSubstring("must be migrated to VMOs")))
+		})
+
+		It("should not validate infrastructures which are not converted", func() {
+			infra.Status.ProviderStatus = nil
+
+			Expect(validateZonalMigration(infra, false)).To(Succeed())
+		})
+	})
+})
@@ -6,18 +6,25 @@ package infrastructure
 
 import (
 	"context"
+	"slices"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
+// EventReasonEgressCIDRsChanged is the reason of the event emitted when the egress CIDRs of an Infrastructure change.
+const EventReasonEgressCIDRsChanged = "EgressCIDRsChanged"
+
 // Reconcile implements infrastructure.Actuator.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
-	return util.DetermineError(a.reconcile(ctx, log, OnReconcile, infra, cluster), helper.KnownCodes)
+	err := util.DetermineError(a.reconcile(ctx, log, OnReconcile, infra, cluster), helper.KnownCodes)
+	return azureclient.HandleRegionalOutage(ctx, log, a.client, infra, &infra.Status.Conditions, err)
 }
 
 func (a *actuator) reconcile(ctx context.Context, logger logr.Logger, selectorFn SelectorFunc, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
@@ -26,6 +33,14 @@ func (a *actuator) reconcile(ctx context.Context, logger logr.Logger, selectorFn
 		return err
 	}
 
+	if err := validateZonalMigration(infra, useFlow); err != nil {
+		return err
+	}
+
+	if err := validateFlowOnlyFeatures(infra, useFlow); err != nil {
+		return err
+	}
+
 	factory := ReconcilerFactoryImpl{
 		ctx:   ctx,
 		log:   logger,
@@ -37,5 +52,29 @@ func (a *actuator) reconcile(ctx context.Context, logger logr.Logger, selectorFn
 	if err != nil {
 		return err
 	}
-	return reconciler.Reconcile(ctx, infra, cluster)
+
+	egressCIDRs := slices.Clone(infra.Status.EgressCIDRs)
+	if err := reconciler.Reconcile(ctx, infra, cluster); err != nil {
+		return err
+	}
+
+	a.recordEgressCIDRsChange(infra, egressCIDRs)
+	return nil
+}
+
+// recordEgressCIDRsChange emits an event on the Infrastructure if its egress CIDRs changed during the reconciliation,
+// so that firewall owners can react to e.g. rotated NAT gateway public IPs.
+func (a *actuator) recordEgressCIDRsChange(infra *extensionsv1alpha1.Infrastructure, previous []string) {
+	current := slices.Clone(infra.Status.EgressCIDRs)
+	if len(previous) == 0 || current == nil {
+		return
+	}
+
+	slices.Sort(previous)
+	slices.Sort(current)
+	if slices.Equal(previous, current) {
+		return
+	}
+
+	a.recorder.Eventf(infra, corev1.EventTypeNormal, EventReasonEgressCIDRsChanged, "Egress CIDRs changed from %v to %v", previous, current)
 }
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Reconcile", func() {
+	Describe("#recordEgressCIDRsChange", func() {
+		var (
+			recorder *record.FakeRecorder
+			a        *actuator
+			infra    *extensionsv1alpha1.Infrastructure
+		)
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(10)
+			a = &actuator{recorder: recorder}
+			infra = &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+				Status: extensionsv1alpha1.InfrastructureStatus{
+					EgressCIDRs: []string{"20.0.0.2/32", "20.0.0.1/32"},
+				},
+			}
+		})
+
+		It("should emit an event if the egress CIDRs changed", func() {
+			a.recordEgressCIDRsChange(infra, []string{"20.0.0.1/32"})
+
+			Expect(recorder.Events).To(Receive(Equal("Normal " + EventReasonEgressCIDRsChanged + " Egress CIDRs changed from [20.0.0.1/32] to [20.0.0.1/32 20.0.0.2/32]")))
+		})
+
+		It("should not emit an event if only the order of the egress CIDRs changed", func() {
+			a.recordEgressCIDRsChange(infra, []string{"20.0.0.1/32", "20.0.0.2/32"})
+
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not emit an event if the egress CIDRs were not known before", func() {
+			a.recordEgressCIDRsChange(infra, nil)
+
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not emit an event if the egress CIDRs are not known anymore", func() {
+			infra.Status.EgressCIDRs = nil
+			a.recordEgressCIDRsChange(infra, []string{"20.0.0.1/32"})
+
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not modify the egress CIDRs of the Infrastructure", func() {
+			a.recordEgressCIDRsChange(infra, []string{"20.0.0.1/32"})
+
+			Expect(infra.Status.EgressCIDRs).To(Equal([]string{"20.0.0.2/32", "20.0.0.1/32"}))
+		})
+	})
+})
@@ -8,12 +8,19 @@ import (
 	"context"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 var (
@@ -30,23 +37,57 @@ type AddOptions struct {
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
 	// DisableProjectedTokenMount specifies whether the projected token mount shall be disabled for the terraformer.
-	// Used for testing only.
 	DisableProjectedTokenMount bool
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// ManagementLocks is the configuration for the handling of management locks during the deletion.
+	ManagementLocks config.ManagementLocksConfig
+	// DriftDetection is the configuration for the periodic detection of drifts of the Azure resources.
+	DriftDetection config.DriftDetectionConfig
+	// ClientFactoryOptions are additional options for the Azure client factory of the flow reconciler, e.g. to inject a
+	// fake transport in tests.
+	ClientFactoryOptions []azureclient.AzureFactoryOption
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
+// If the drift detection is enabled, a second controller detecting drifts of the Azure resources is added.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
-	return infrastructure.Add(ctx, mgr, infrastructure.AddArgs{
-		Actuator:          NewActuator(mgr, opts.DisableProjectedTokenMount),
+	if err := infrastructure.Add(ctx, mgr, infrastructure.AddArgs{
+		Actuator:          NewActuator(mgr, opts.DisableProjectedTokenMount, opts.ManagementLocks, opts.ClientFactoryOptions...),
 		ControllerOptions: opts.Controller,
 		Predicates:        infrastructure.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              azure.Type,
 		KnownCodes:        helper.KnownCodes,
 		ExtensionClass:    opts.ExtensionClass,
-	})
+	}); err != nil {
+		return err
+	}
+
+	if !opts.DriftDetection.Enabled {
+		return nil
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(DriftDetectionControllerName).
+		WithOptions(opts.Controller).
+		For(&extensionsv1alpha1.Infrastructure{}, builder.WithPredicates(
+			extensionspredicate.HasType(azure.Type),
+			extensionspredicate.HasClass(opts.ExtensionClass),
+			// Infrastructures are resynced periodically by the reconciler itself, reacting to every status update
+			// would query the Azure resources far more often than necessary.
+			predicate.Funcs{
+				UpdateFunc: func(event.UpdateEvent) bool { return false },
+				DeleteFunc: func(event.DeleteEvent) bool { return false },
+			},
+		)).
+		Complete(NewDriftDetectionReconciler(
+			mgr.GetClient(),
+			log.Log.WithName(DriftDetectionControllerName),
+			mgr.GetEventRecorderFor(azure.Name+"-"+DriftDetectionControllerName+"-controller"),
+			opts.DriftDetection,
+		))
 }
 
 // AddToManager adds a controller with the default AddOptions.
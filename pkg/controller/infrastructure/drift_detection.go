@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+const (
+	// DriftDetectionControllerName is the name of the controller which detects drifts of the Azure resources of the
+	// infrastructures.
+	DriftDetectionControllerName = "infrastructure-drift-detection"
+
+	// defaultDriftDetectionSyncPeriod is the default interval in which the infrastructures are checked for drifts.
+	defaultDriftDetectionSyncPeriod = time.Hour
+
+	// activityLogIngestionDelay is the delay after which the operations are expected to be available in the activity log.
+	activityLogIngestionDelay = 15 * time.Minute
+	// activityLogRetention is the period for which the activity log keeps the operations.
+	activityLogRetention = 90 * 24 * time.Hour
+)
+
+type driftDetectionReconciler struct {
+	client   client.Client
+	log      logr.Logger
+	recorder record.EventRecorder
+	config   config.DriftDetectionConfig
+}
+
+// NewDriftDetectionReconciler creates a new reconcile.Reconciler which periodically compares the Azure resources of
+// the infrastructures reconciled by the flow reconciler with their desired state. Drifts are reported via events and the
+// AzureInfrastructureInSync condition, they are not repaired. If configured, modifications of the Azure resources which
+// were not done with the credentials of the shoot are reported via events as well.
+func NewDriftDetectionReconciler(client client.Client, log logr.Logger, recorder record.EventRecorder, config config.DriftDetectionConfig) reconcile.Reconciler {
+	return &driftDetectionReconciler{
+		client:   client,
+		log:      log,
+		recorder: recorder,
+		config:   config,
+	}
+}
+
+func (r *driftDetectionReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := r.client.Get(ctx, request.NamespacedName, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if infra.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	result := reconcile.Result{RequeueAfter: defaultDriftDetectionSyncPeriod}
+	if r.config.SyncPeriod != nil {
+		result.RequeueAfter = r.config.SyncPeriod.Duration
+	}
+
+	// The desired state is only known for infrastructures which were reconciled successfully by the flow reconciler.
+	if infra.Status.LastOperation == nil || infra.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return result, nil
+	}
+	fsOk, err := hasFlowState(infra.Status)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !fsOk {
+		return result, nil
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, infra.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log := r.log.WithValues("infrastructure", client.ObjectKeyFromObject(infra))
+	fctx, err := (&FlowReconciler{client: r.client, log: log, recorder: r.recorder}).newFlowContext(ctx, infra, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	drifts, err := fctx.DetectDrift(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed detecting drifts of the infrastructure: %w", err)
+	}
+
+	for _, drift := range drifts {
+		r.recorder.Event(infra, corev1.EventTypeWarning, "InfrastructureDriftDetected", drift.String())
+	}
+	if len(drifts) > 0 {
+		log.Info("Detected drifts of the infrastructure", "count", len(drifts))
+	}
+
+	if r.config.OutOfBandModifications != nil && r.config.OutOfBandModifications.Enabled {
+		if err := r.reportOutOfBandModifications(ctx, log, infra, fctx, result.RequeueAfter); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err := updateDriftCondition(ctx, r.client, infra, drifts); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating the condition %s: %w", infraflow.ConditionTypeInSync, err)
+	}
+
+	return result, nil
+}
+
+// updateDriftCondition updates the drift condition of the infrastructure. The conditions are patched with an optimistic
+// lock because a merge patch replaces the complete list, which would otherwise revert conditions written by the
+// infrastructure reconciler in the meantime. On conflicts the infrastructure is read again and the patch is retried.
+func updateDriftCondition(ctx context.Context, c client.Client, infra *extensionsv1alpha1.Infrastructure, drifts []infraflow.Drift) error {
+	first := true
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if !first {
+			if err := c.Get(ctx, client.ObjectKeyFromObject(infra), infra); err != nil {
+				return err
+			}
+		}
+		first = false
+
+		patch := client.MergeFromWithOptions(infra.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, infraflow.DriftCondition(infra.Status.Conditions, drifts))
+		return c.Status().Patch(ctx, infra, patch)
+	})
+}
+
+// reportOutOfBandModifications reports the modifications of the resources of the infrastructure which were not done with
+// the credentials of the shoot via events. The activity log is checked from the end of the previously checked period,
+// which is persisted in an annotation of the infrastructure, up to now shifted by the ingestion delay of the activity
+// log, so that controller restarts neither cause gaps nor duplicate events.
+func (r *driftDetectionReconciler) reportOutOfBandModifications(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, fctx *infraflow.FlowContext, syncPeriod time.Duration) error {
+	from, to := outOfBandModificationsPeriod(log, infra, time.Now(), syncPeriod)
+	if !from.Before(to) {
+		return nil
+	}
+
+	modifications, err := fctx.DetectOutOfBandModifications(ctx, from, to, r.config.OutOfBandModifications.AllowedCallers)
+	if err != nil {
+		// credentials which lack the permission to read the activity log must not block the drift detection.
+		if azureclient.IsAzureAPIForbidden(err) {
+			log.Info("Skipping detection of out-of-band modifications as the credentials are not allowed to read the activity log", "error", err.Error())
+			return nil
+		}
+		return fmt.Errorf("failed detecting out-of-band modifications of the infrastructure: %w", err)
+	}
+
+	for _, modification := range modifications {
+		r.recorder.Event(infra, corev1.EventTypeWarning, "InfrastructureModifiedOutOfBand", modification.String())
+	}
+	if len(modifications) > 0 {
+		log.Info("Detected out-of-band modifications of the infrastructure", "count", len(modifications))
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	metav1.SetMetaDataAnnotation(&infra.ObjectMeta, azure.ActivityLogCheckedUntilAnnotation, to.UTC().Format(time.RFC3339))
+	if err := r.client.Patch(ctx, infra, patch); err != nil {
+		return fmt.Errorf("failed recording the checked period of the activity log: %w", err)
+	}
+	return nil
+}
+
+// outOfBandModificationsPeriod returns the period of the activity log which is checked for out-of-band modifications.
+// The period starts at the end of the previously checked period, or one sync period before its end if none was checked
+// yet, and is limited to the retention of the activity log.
+func outOfBandModificationsPeriod(log logr.Logger, infra *extensionsv1alpha1.Infrastructure, now time.Time, syncPeriod time.Duration) (time.Time, time.Time) {
+	// The filter of the activity log only supports a precision of seconds.
+	to := now.Add(-activityLogIngestionDelay).Truncate(time.Second)
+	from := to.Add(-syncPeriod)
+	if value, ok := infra.Annotations[azure.ActivityLogCheckedUntilAnnotation]; ok {
+		checkedUntil, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			log.Info("Ignoring invalid end of the checked period of the activity log", "annotation", azure.ActivityLogCheckedUntilAnnotation, "error", err.Error())
+		} else {
+			from = checkedUntil
+		}
+	}
+	if earliest := to.Add(-activityLogRetention); from.Before(earliest) {
+		from = earliest
+	}
+	return from, to
+}
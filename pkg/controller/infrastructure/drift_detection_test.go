@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("DriftDetection", func() {
+	Describe("#outOfBandModificationsPeriod", func() {
+		var (
+			infra *extensionsv1alpha1.Infrastructure
+
+			now        = time.Date(2024, 10, 1, 12, 15, 30, 500, time.UTC)
+			syncPeriod = time.Hour
+			to         = time.Date(2024, 10, 1, 12, 0, 30, 0, time.UTC)
+		)
+
+		BeforeEach(func() {
+			infra = &extensionsv1alpha1.Infrastructure{}
+		})
+
+		It("should check the last sync period if no period was checked yet", func() {
+			from, end := outOfBandModificationsPeriod(logr.Discard(), infra, now, syncPeriod)
+			Expect(from).To(Equal(to.Add(-syncPeriod)))
+			Expect(end).To(Equal(to))
+		})
+
+		It("should continue at the end of the previously checked period", func() {
+			metav1.SetMetaDataAnnotation(&infra.ObjectMeta, azure.ActivityLogCheckedUntilAnnotation, "2024-10-01T11:50:00Z")
+
+			from, end := outOfBandModificationsPeriod(logr.Discard(), infra, now, syncPeriod)
+			Expect(from).To(Equal(time.Date(2024, 10, 1, 11, 50, 0, 0, time.UTC)))
+			Expect(end).To(Equal(to))
+		})
+
+		It("should limit the period to the retention of the activity log", func() {
+			metav1.SetMetaDataAnnotation(&infra.ObjectMeta, azure.ActivityLogCheckedUntilAnnotation, "2024-01-01T00:00:00Z")
+
+			from, end := outOfBandModificationsPeriod(logr.Discard(), infra, now, syncPeriod)
+			Expect(from).To(Equal(to.Add(-90 * 24 * time.Hour)))
+			Expect(end).To(Equal(to))
+		})
+
+		It("should ignore an invalid end of the previously checked period", func() {
+			metav1.SetMetaDataAnnotation(&infra.ObjectMeta, azure.ActivityLogCheckedUntilAnnotation, "yesterday")
+
+			from, _ := outOfBandModificationsPeriod(logr.Discard(), infra, now, syncPeriod)
+			Expect(from).To(Equal(to.Add(-syncPeriod)))
+		})
+	})
+
+	Describe("#updateDriftCondition", func() {
+		It("should not revert conditions which were updated concurrently", func() {
+			ctx := context.Background()
+			infra := &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"}}
+			c := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).WithStatusSubresource(infra).Build()
+
+			stale := &extensionsv1alpha1.Infrastructure{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infra), stale)).To(Succeed())
+
+			current := stale.DeepCopy()
+			current.Status.Conditions = []gardencorev1beta1.Condition{{Type: "Other", Status: gardencorev1beta1.ConditionTrue}}
+			Expect(c.Status().Update(ctx, current)).To(Succeed())
+
+			Expect(updateDriftCondition(ctx, c, stale, nil)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+			Expect(infra.Status.Conditions).To(ConsistOf(
+				HaveField("Type", gardencorev1beta1.ConditionType("Other")),
+				HaveField("Type", infraflow.ConditionTypeInSync),
+			))
+		})
+	})
+})
@@ -6,16 +6,23 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
@@ -27,7 +34,10 @@ type FlowReconciler struct {
 	client                     client.Client
 	restConfig                 *rest.Config
 	log                        logr.Logger
+	recorder                   record.EventRecorder
 	disableProjectedTokenMount bool
+	managementLocks            config.ManagementLocksConfig
+	factoryOptions             []azureclient.AzureFactoryOption
 }
 
 // NewFlowReconciler creates a new flow reconciler.
@@ -36,42 +46,59 @@ func NewFlowReconciler(a *actuator, log logr.Logger, projToken bool) (Reconciler
 		client:                     a.client,
 		restConfig:                 a.restConfig,
 		log:                        log,
+		recorder:                   a.recorder,
 		disableProjectedTokenMount: projToken,
+		managementLocks:            a.managementLocks,
+		factoryOptions:             a.factoryOptions,
 	}, nil
 }
 
 // Reconcile reconciles the infrastructure and returns the status (state of the world), the state (input for the next loops) and any errors that occurred.
 func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
+	fctx, err := f.newFlowContext(ctx, infra, cluster)
+	if err != nil {
+		return err
+	}
+	return fctx.Reconcile(ctx)
+}
+
+// newFlowContext creates the flow context for the reconciliation of the infrastructure.
+func (f *FlowReconciler) newFlowContext(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) (*infraflow.FlowContext, error) {
 	var (
 		infraState *azure.InfrastructureState
 		err        error
 	)
 	fsOk, err := hasFlowState(infra.Status)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if fsOk {
 		infraState, err = helper.InfrastructureStateFromRaw(infra.Status.State)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		// otherwise migrate it from the terraform state if needed.
 		infraState, err = f.migrateFromTerraform(ctx, infra)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	auth, _, err := internal.GetClientAuthData(ctx, f.client, infra.Spec.SecretRef, false)
+	secretRef, err := helper.InfrastructureCredentialsSecretRef(infra)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	auth, _, err := internal.GetClientAuthData(ctx, f.client, secretRef, false)
+	if err != nil {
+		return nil, err
 	}
 
 	cloudProfile, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var cloudConfiguration *azure.CloudConfiguration
@@ -81,34 +108,61 @@ func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha
 
 	azCloudConfiguration, err := azureclient.AzureCloudConfiguration(cloudConfiguration, &cluster.Shoot.Spec.Region)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	factory, err := azureclient.NewAzureClientFactoryFromSecret(
 		ctx,
 		f.client,
-		infra.Spec.SecretRef,
+		secretRef,
 		false,
-		azureclient.WithCloudConfiguration(azCloudConfiguration),
+		f.clientFactoryOptions(infra, azCloudConfiguration)...,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	fctx, err := infraflow.NewFlowContext(infraflow.Opts{
-		Client:  f.client,
-		Factory: factory,
-		Auth:    auth,
-		Logger:  f.log,
-		Infra:   infra,
-		Cluster: cluster,
-		State:   infraState,
-	})
+	worker, err := f.getWorker(ctx, cluster)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return fctx.Reconcile(ctx)
+	return infraflow.NewFlowContext(infraflow.Opts{
+		Client:   f.client,
+		Factory:  factory,
+		Auth:     auth,
+		Logger:   f.log,
+		Infra:    infra,
+		Cluster:  cluster,
+		State:    infraState,
+		Worker:   worker,
+		Recorder: f.recorder,
+	})
+}
+
+// clientFactoryOptions returns the options of the client factory for the given infrastructure. The requests to Azure
+// are only traced for infrastructures which request it via annotation, to keep the volume of the logs low.
+func (f *FlowReconciler) clientFactoryOptions(infra *extensionsv1alpha1.Infrastructure, cloudConfiguration cloud.Configuration) []azureclient.AzureFactoryOption {
+	options := []azureclient.AzureFactoryOption{
+		azureclient.WithCloudConfiguration(cloudConfiguration),
+		azureclient.WithRegion(infra.Spec.Region),
+	}
+	if kutil.HasMetaDataAnnotation(infra, azuretypes.InfrastructureTraceRequestsAnnotation, "true") {
+		options = append(options, azureclient.WithRequestTracing(f.log.WithName("request-trace")))
+	}
+	return append(options, f.factoryOptions...)
+}
+
+// getWorker returns the Worker of the shoot or nil if it does not exist yet, e.g. during the creation of the shoot.
+func (f *FlowReconciler) getWorker(ctx context.Context, cluster *controller.Cluster) (*extensionsv1alpha1.Worker, error) {
+	worker := &extensionsv1alpha1.Worker{}
+	if err := f.client.Get(ctx, client.ObjectKey{Namespace: cluster.ObjectMeta.Name, Name: cluster.Shoot.Name}, worker); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get the worker of the shoot: %w", err)
+	}
+	return worker, nil
 }
 
 // Delete deletes the infrastructure resource using the flow reconciler.
@@ -118,6 +172,11 @@ func (f *FlowReconciler) Delete(ctx context.Context, infra *extensionsv1alpha1.I
 		return err
 	}
 
+	secretRef, err := helper.InfrastructureCredentialsSecretRef(infra)
+	if err != nil {
+		return err
+	}
+
 	var cloudConfiguration *azure.CloudConfiguration
 	if cloudProfile != nil {
 		cloudConfiguration = cloudProfile.CloudConfiguration
@@ -131,9 +190,9 @@ func (f *FlowReconciler) Delete(ctx context.Context, infra *extensionsv1alpha1.I
 	factory, err := azureclient.NewAzureClientFactoryFromSecret(
 		ctx,
 		f.client,
-		infra.Spec.SecretRef,
+		secretRef,
 		false,
-		azureclient.WithCloudConfiguration(azCloudConfiguration),
+		f.clientFactoryOptions(infra, azCloudConfiguration)...,
 	)
 	if err != nil {
 		return err
@@ -145,13 +204,14 @@ func (f *FlowReconciler) Delete(ctx context.Context, infra *extensionsv1alpha1.I
 	}
 
 	fctx, err := infraflow.NewFlowContext(infraflow.Opts{
-		Client:  f.client,
-		Factory: factory,
-		Auth:    nil,
-		Logger:  f.log,
-		Infra:   infra,
-		Cluster: cluster,
-		State:   infraState,
+		Client:          f.client,
+		Factory:         factory,
+		Auth:            nil,
+		Logger:          f.log,
+		Infra:           infra,
+		Cluster:         cluster,
+		State:           infraState,
+		ManagementLocks: f.managementLocks,
 	})
 	if err != nil {
 		return err
@@ -169,9 +229,14 @@ func (f *FlowReconciler) Delete(ctx context.Context, infra *extensionsv1alpha1.I
 	return CleanupTerraformerResources(ctx, tf)
 }
 
-// Restore implements the restoration of an infrastructure resource during the control plane migration.
+// Restore implements the restoration of an infrastructure resource during the control plane migration. The transferred
+// inventory is verified against Azure before the reconciliation.
 func (f *FlowReconciler) Restore(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
-	return f.Reconcile(ctx, infra, cluster)
+	fctx, err := f.newFlowContext(ctx, infra, cluster)
+	if err != nil {
+		return err
+	}
+	return fctx.Restore(ctx)
 }
 
 func (f *FlowReconciler) migrateFromTerraform(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) (*azure.InfrastructureState, error) {
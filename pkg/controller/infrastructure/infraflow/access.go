@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
@@ -20,10 +21,12 @@ var _ Access = &access{}
 
 // Access provides additional methods that are build on top of the azure client primitives.
 type Access interface {
-	// DeletePublicIP deletes a public IP after disassociating it from the NAT Gateway if necessary.
+	// DeletePublicIP deletes a public IP after disassociating it from the NAT Gateway or load balancer if necessary.
 	DeletePublicIP(ctx context.Context, rgName, pipName string) error
 	// DisassociatePublicIP from the NAT Gateway it is attached.
 	DisassociatePublicIP(ctx context.Context, rgName, natName, pipId string) error
+	// DisassociatePublicIPFromLoadBalancer removes the frontend IP configuration of a public IP from the load balancer.
+	DisassociatePublicIPFromLoadBalancer(ctx context.Context, rgName, lbName, frontendName string) error
 	// DeleteNatGateway deletes a NAT Gateway after disassociating from all subnets attached to it.
 	DeleteNatGateway(ctx context.Context, rgName, natName string) error
 	// DisassociateNatGateway disassociates the NAT Gateway from attached subnets.
@@ -34,7 +37,7 @@ type access struct {
 	f client.Factory
 }
 
-// DeletePublicIP deletes a public IP after disassociating it from the NAT Gateway if necessary.
+// DeletePublicIP deletes a public IP after disassociating it from the NAT Gateway or load balancer if necessary.
 func (p *access) DeletePublicIP(ctx context.Context, rgName, pipName string) error {
 	pipClient, err := p.f.PublicIP()
 	if err != nil {
@@ -53,9 +56,70 @@ func (p *access) DeletePublicIP(ctx context.Context, rgName, pipName string) err
 		}
 	}
 
+	if ipConfig := pip.Properties.IPConfiguration; ipConfig != nil && ipConfig.ID != nil {
+		frontendID, err := arm.ParseResourceID(*ipConfig.ID)
+		if err != nil {
+			return err
+		}
+		if frontendID.Parent != nil && frontendID.Parent.ResourceType.String() == KindLoadBalancer.String() {
+			err := p.DisassociatePublicIPFromLoadBalancer(ctx, frontendID.ResourceGroupName, frontendID.Parent.Name, frontendID.Name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return pipClient.Delete(ctx, rgName, pipName)
 }
 
+// DisassociatePublicIPFromLoadBalancer removes the frontend IP configuration of a public IP from the load balancer
+// together with all references of the outbound rules to it.
+func (p *access) DisassociatePublicIPFromLoadBalancer(ctx context.Context, rgName, lbName, frontendName string) error {
+	lbClient, err := p.f.LoadBalancer()
+	if err != nil {
+		return err
+	}
+
+	lb, err := lbClient.Get(ctx, rgName, lbName)
+	if err != nil || lb == nil || lb.Properties == nil {
+		return err
+	}
+
+	var (
+		frontends  []*armnetwork.FrontendIPConfiguration
+		frontendID *string
+	)
+	for _, frontend := range lb.Properties.FrontendIPConfigurations {
+		if frontend != nil && frontend.Name != nil && *frontend.Name == frontendName {
+			frontendID = frontend.ID
+			continue
+		}
+		frontends = append(frontends, frontend)
+	}
+	if frontendID == nil {
+		return nil
+	}
+	lb.Properties.FrontendIPConfigurations = frontends
+
+	var outboundRules []*armnetwork.OutboundRule
+	for _, rule := range lb.Properties.OutboundRules {
+		if rule == nil || rule.Properties == nil {
+			continue
+		}
+		rule.Properties.FrontendIPConfigurations = Filter(rule.Properties.FrontendIPConfigurations, func(ref *armnetwork.SubResource) bool {
+			return ref != nil && ref.ID != nil && !strings.EqualFold(*ref.ID, *frontendID)
+		})
+		// an outbound rule requires at least one frontend.
+		if len(rule.Properties.FrontendIPConfigurations) > 0 {
+			outboundRules = append(outboundRules, rule)
+		}
+	}
+	lb.Properties.OutboundRules = outboundRules
+
+	_, err = lbClient.CreateOrUpdate(ctx, rgName, lbName, *lb)
+	return err
+}
+
 // DisassociatePublicIP disassociates a PublicIPConfig from it's attached NAT Gateway.
 func (p *access) DisassociatePublicIP(ctx context.Context, rgName, natName, pipId string) error {
 	natClient, err := p.f.NatGateway()
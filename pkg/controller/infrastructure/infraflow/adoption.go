@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// adoptionInfo is the additional information of the errors returned for resources which cannot be adopted.
+var adoptionInfo = to.Ptr("The existing resource cannot be adopted. Remove it or the annotation to adopt existing resources to proceed")
+
+// AdoptResources imports the resources which already exist in the shoot's resource group and match the resources the
+// reconciler manages into the inventory. Resources are matched by their name. If an existing resource cannot be
+// reconciled to the desired spec, an error is returned instead of deleting and recreating it.
+func (fctx *FlowContext) AdoptResources(ctx context.Context) error {
+	return errors.Join(
+		fctx.adoptRouteTable(ctx),
+		fctx.adoptSecurityGroup(ctx),
+		fctx.adoptPublicIPs(ctx),
+		fctx.adoptSubnets(ctx),
+	)
+}
+
+func (fctx *FlowContext) adoptRouteTable(ctx context.Context) error {
+	c, err := fctx.factory.RouteTables()
+	if err != nil {
+		return err
+	}
+
+	rtCfg := fctx.adapter.RouteTableConfig()
+	rt, err := c.Get(ctx, rtCfg.ResourceGroup, rtCfg.Name)
+	if err != nil || rt == nil || fctx.inventory.Get(*rt.ID) != nil {
+		return err
+	}
+	if location := ptr.Deref(rt.Location, ""); location != rtCfg.Location {
+		return NewSpecMismatchError(rtCfg.AzureResourceMetadata, "location", rtCfg.Location, location, adoptionInfo)
+	}
+	return fctx.adopt(ctx, *rt.ID)
+}
+
+func (fctx *FlowContext) adoptSecurityGroup(ctx context.Context) error {
+	c, err := fctx.factory.NetworkSecurityGroup()
+	if err != nil {
+		return err
+	}
+
+	sgCfg := fctx.adapter.SecurityGroupConfig()
+	sg, err := c.Get(ctx, sgCfg.ResourceGroup, sgCfg.Name)
+	if err != nil || sg == nil || fctx.inventory.Get(*sg.ID) != nil {
+		return err
+	}
+	if location := ptr.Deref(sg.Location, ""); location != sgCfg.Location {
+		return NewSpecMismatchError(sgCfg.AzureResourceMetadata, "location", sgCfg.Location, location, adoptionInfo)
+	}
+	return fctx.adopt(ctx, *sg.ID)
+}
+
+func (fctx *FlowContext) adoptPublicIPs(ctx context.Context) error {
+	c, err := fctx.factory.PublicIP()
+	if err != nil {
+		return err
+	}
+
+	currentIPs, err := c.List(ctx, fctx.adapter.ResourceGroupName())
+	if err != nil {
+		return err
+	}
+
+	var joinErr error
+	desiredConfiguration := fctx.adapter.ManagedIpConfigs()
+	for _, current := range currentIPs {
+		if current.ID == nil || current.Name == nil || fctx.inventory.Get(*current.ID) != nil {
+			continue
+		}
+		pipCfg, ok := desiredConfiguration[*current.Name]
+		if !ok {
+			continue
+		}
+		if forceNew, offender, v := ForceNewIp(current, pipCfg.ToProvider(current)); forceNew {
+			joinErr = errors.Join(joinErr, NewTerminalConditionError(pipCfg.AzureResourceMetadata,
+				fmt.Errorf("field %s with value %v cannot be reconciled. %s", offender, v, *adoptionInfo)))
+			continue
+		}
+		joinErr = errors.Join(joinErr, fctx.adopt(ctx, *current.ID))
+	}
+	return joinErr
+}
+
+func (fctx *FlowContext) adoptSubnets(ctx context.Context) error {
+	c, err := fctx.factory.Subnet()
+	if err != nil {
+		return err
+	}
+
+	vnetCfg := fctx.adapter.VirtualNetworkConfig()
+	currentSubnets, err := c.List(ctx, vnetCfg.ResourceGroup, vnetCfg.Name)
+	if err != nil {
+		return err
+	}
+	mappedSubnets := ToMap(Filter(currentSubnets, func(s *armnetwork.Subnet) bool {
+		return s.ID != nil && s.Name != nil
+	}), func(s *armnetwork.Subnet) string {
+		return *s.Name
+	})
+
+	var joinErr error
+	for _, z := range fctx.adapter.Zones() {
+		current, ok := mappedSubnets[z.Subnet.Name]
+		if !ok || fctx.inventory.Get(*current.ID) != nil {
+			continue
+		}
+		if current.Properties != nil {
+			if addressPrefix := ptr.Deref(current.Properties.AddressPrefix, ""); addressPrefix != z.Subnet.cidr {
+				joinErr = errors.Join(joinErr, NewSpecMismatchError(z.Subnet.AzureResourceMetadata, "addressPrefix", z.Subnet.cidr, addressPrefix, adoptionInfo))
+				continue
+			}
+		}
+		joinErr = errors.Join(joinErr, fctx.adopt(ctx, *current.ID))
+	}
+	return joinErr
+}
+
+func (fctx *FlowContext) adopt(ctx context.Context, id string) error {
+	shared.LogFromContext(ctx).Info("adopting existing resource", "id", id)
+	return fctx.inventory.Insert(id)
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("AdoptResources", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		region        = "westeurope"
+		idPrefix      = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/"
+		routeTableID  = idPrefix + "routeTables/worker_route_table"
+		sgID          = idPrefix + "networkSecurityGroups/shoot--foo--bar-workers"
+		subnetID      = idPrefix + "virtualNetworks/shoot--foo--bar/subnets/shoot--foo--bar-nodes"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl        *gomock.Controller
+		factory     *mockclient.MockFactory
+		routeTables *mockclient.MockRouteTables
+		sgs         *mockclient.MockNetworkSecurityGroup
+		pips        *mockclient.MockPublicIP
+		subnets     *mockclient.MockSubnet
+		opts        infraflow.Opts
+
+		managedItems = func(fctx *infraflow.FlowContext) []string {
+			var ids []string
+			for _, item := range fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).ManagedItems {
+				ids = append(ids, item.ID)
+			}
+			return ids
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		routeTables = mockclient.NewMockRouteTables(ctrl)
+		sgs = mockclient.NewMockNetworkSecurityGroup(ctrl)
+		pips = mockclient.NewMockPublicIP(ctrl)
+		subnets = mockclient.NewMockSubnet(ctrl)
+		factory.EXPECT().RouteTables().Return(routeTables, nil).AnyTimes()
+		factory.EXPECT().NetworkSecurityGroup().Return(sgs, nil).AnyTimes()
+		factory.EXPECT().PublicIP().Return(pips, nil).AnyTimes()
+		factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: region,
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig",` +
+							`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"}}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+				Shoot: &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{azuretypes.ShootAdoptResourcesAnnotation: "true"},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	It("should adopt the existing resources matching the managed resources", func() {
+		routeTables.EXPECT().Get(gomock.Any(), resourceGroup, "worker_route_table").Return(&armnetwork.RouteTable{ID: ptr.To(routeTableID), Location: ptr.To(region)}, nil)
+		sgs.EXPECT().Get(gomock.Any(), resourceGroup, "shoot--foo--bar-workers").Return(&armnetwork.SecurityGroup{ID: ptr.To(sgID), Location: ptr.To(region)}, nil)
+		pips.EXPECT().List(gomock.Any(), resourceGroup).Return([]*armnetwork.PublicIPAddress{{ID: ptr.To(idPrefix + "publicIPAddresses/foreign"), Name: ptr.To("foreign")}}, nil)
+		subnets.EXPECT().List(gomock.Any(), resourceGroup, resourceGroup).Return([]*armnetwork.Subnet{{
+			ID:         ptr.To(subnetID),
+			Name:       ptr.To("shoot--foo--bar-nodes"),
+			Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: ptr.To("10.250.0.0/19")},
+		}}, nil)
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.AdoptResources(ctx)).To(Succeed())
+		Expect(managedItems(fctx)).To(ConsistOf(routeTableID, sgID, subnetID))
+	})
+
+	It("should fail instead of recreating existing resources which cannot be reconciled", func() {
+		routeTables.EXPECT().Get(gomock.Any(), resourceGroup, "worker_route_table").Return(&armnetwork.RouteTable{ID: ptr.To(routeTableID), Location: ptr.To("northeurope")}, nil)
+		sgs.EXPECT().Get(gomock.Any(), resourceGroup, "shoot--foo--bar-workers").Return(nil, nil)
+		pips.EXPECT().List(gomock.Any(), resourceGroup).Return(nil, nil)
+		subnets.EXPECT().List(gomock.Any(), resourceGroup, resourceGroup).Return([]*armnetwork.Subnet{{
+			ID:         ptr.To(subnetID),
+			Name:       ptr.To("shoot--foo--bar-nodes"),
+			Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: ptr.To("10.0.0.0/24")},
+		}}, nil)
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		err = fctx.AdoptResources(ctx)
+		Expect(err).To(MatchError(ContainSubstring("Name: worker_route_table, Field: location")))
+		Expect(err).To(MatchError(ContainSubstring("Name: shoot--foo--bar-nodes, Field: addressPrefix")))
+		Expect(managedItems(fctx)).To(BeEmpty())
+	})
+})
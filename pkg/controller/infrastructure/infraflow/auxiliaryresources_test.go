@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("AuxiliaryResources", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		identityName  = "shoot--foo--bar-workers"
+		identityID    = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.ManagedIdentity/userAssignedIdentities/shoot--foo--bar-workers"
+		accountID     = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Storage/storageAccounts/diag"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl       *gomock.Controller
+		factory    *mockclient.MockFactory
+		identities *mockclient.MockManagedUserIdentity
+		accounts   *mockclient.MockStorageAccount
+		opts       infraflow.Opts
+
+		accountName string
+	)
+
+	providerConfig := func(auxiliaryResources string) *runtime.RawExtension {
+		return &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig",` +
+			`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"},"auxiliaryResources":` + auxiliaryResources + `}`)}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		identities = mockclient.NewMockManagedUserIdentity(ctrl)
+		accounts = mockclient.NewMockStorageAccount(ctrl)
+		factory.EXPECT().ManagedUserIdentity().Return(identities, nil).AnyTimes()
+		factory.EXPECT().StorageAccount().Return(accounts, nil).AnyTimes()
+
+		accountName = "diag" + utils.ComputeSHA256Hex([]byte("shoot-uid"))[:20]
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: providerConfig(`{"region":"northeurope","managedIdentity":true,"bootDiagnostics":true}`),
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{UID: types.UID("shoot-uid")}},
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+						Regions: []gardencorev1beta1.Region{
+							{Name: "westeurope", Zones: []gardencorev1beta1.AvailabilityZone{{Name: "1"}, {Name: "2"}}},
+							{Name: "northeurope"},
+						},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	Describe("#EnsureManagedIdentity", func() {
+		It("should create the managed identity in the auxiliary resources region and report it in the status", func() {
+			identities.EXPECT().Get(gomock.Any(), resourceGroup, identityName).Return(nil, nil)
+			identities.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, identityName, armmsi.Identity{Location: ptr.To("northeurope")}).Return(&armmsi.Identity{
+				ID:         ptr.To(identityID),
+				Location:   ptr.To("northeurope"),
+				Properties: &armmsi.UserAssignedIdentityProperties{ClientID: ptr.To("client-id")},
+			}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureManagedIdentity(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Identity).To(Equal(&v1alpha1.IdentityStatus{ID: identityID, ClientID: "client-id"}))
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).To(ContainElement(v1alpha1.AzureResource{Kind: string(infraflow.KindManagedIdentity), ID: identityID}))
+		})
+
+		It("should fail if the managed identity exists in a different region", func() {
+			identities.EXPECT().Get(gomock.Any(), resourceGroup, identityName).Return(&armmsi.UserAssignedIdentitiesClientGetResponse{Identity: armmsi.Identity{
+				ID:         ptr.To(identityID),
+				Location:   ptr.To("westeurope"),
+				Properties: &armmsi.UserAssignedIdentityProperties{ClientID: ptr.To("client-id")},
+			}}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureManagedIdentity(ctx)).To(MatchError(ContainSubstring("cannot be moved to region northeurope")))
+		})
+
+		It("should not create a managed identity if none is requested", func() {
+			opts.Infra.Spec.ProviderConfig = providerConfig(`{"bootDiagnostics":true}`)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureManagedIdentity(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Identity).To(BeNil())
+		})
+	})
+
+	Describe("#EnsureBootDiagnosticsStorageAccount", func() {
+		account := func(location string, sku armstorage.SKUName) *armstorage.Account {
+			return &armstorage.Account{
+				ID:       ptr.To(accountID),
+				Location: ptr.To(location),
+				SKU:      &armstorage.SKU{Name: ptr.To(sku)},
+				Properties: &armstorage.AccountProperties{
+					PrimaryEndpoints: &armstorage.Endpoints{Blob: ptr.To("https://" + accountName + ".blob.core.windows.net/")},
+				},
+			}
+		}
+
+		It("should create a locally redundant storage account in an auxiliary resources region without zones", func() {
+			gomock.InOrder(
+				accounts.EXPECT().Get(gomock.Any(), resourceGroup, accountName).Return(nil, nil),
+				accounts.EXPECT().CreateStorageAccount(gomock.Any(), resourceGroup, accountName, "northeurope", armstorage.SKUNameStandardLRS, gomock.Any()),
+				accounts.EXPECT().Get(gomock.Any(), resourceGroup, accountName).Return(account("northeurope", armstorage.SKUNameStandardLRS), nil),
+			)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureBootDiagnosticsStorageAccount(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.BootDiagnostics).To(Equal(&v1alpha1.BootDiagnosticsStatus{
+				StorageAccountName: accountName,
+				StorageURI:         "https://" + accountName + ".blob.core.windows.net/",
+				Region:             "northeurope",
+			}))
+		})
+
+		It("should create a zone-redundant storage account in the shoot's region if it has zones", func() {
+			opts.Infra.Spec.ProviderConfig = providerConfig(`{"bootDiagnostics":true}`)
+			gomock.InOrder(
+				accounts.EXPECT().Get(gomock.Any(), resourceGroup, accountName).Return(nil, nil),
+				accounts.EXPECT().CreateStorageAccount(gomock.Any(), resourceGroup, accountName, "westeurope", armstorage.SKUNameStandardZRS, gomock.Any()),
+				accounts.EXPECT().Get(gomock.Any(), resourceGroup, accountName).Return(account("westeurope", armstorage.SKUNameStandardZRS), nil),
+			)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureBootDiagnosticsStorageAccount(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.BootDiagnostics).To(Equal(&v1alpha1.BootDiagnosticsStatus{
+				StorageAccountName: accountName,
+				StorageURI:         "https://" + accountName + ".blob.core.windows.net/",
+				Region:             "westeurope",
+				ZoneRedundant:      true,
+			}))
+		})
+
+		It("should fail if the storage account exists in a different region", func() {
+			accounts.EXPECT().Get(gomock.Any(), resourceGroup, accountName).Return(account("westeurope", armstorage.SKUNameStandardZRS), nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureBootDiagnosticsStorageAccount(ctx)).To(MatchError(ContainSubstring("cannot be moved to region northeurope")))
+		})
+	})
+})
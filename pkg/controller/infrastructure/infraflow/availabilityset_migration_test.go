@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("AvailabilitySetMigration", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		avSetName     = "shoot--foo--bar-avset-workers"
+		avSetID       = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Compute/availabilitySets/" + avSetName
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl    *gomock.Controller
+		factory *mockclient.MockFactory
+		avSets  *mockclient.MockAvailabilitySet
+		opts    infraflow.Opts
+
+		migrationKey = func(key string) string {
+			return infraflow.ChildKeyMigration + shared.Separator + string(infraflow.KindAvailabilitySet) + shared.Separator + key
+		}
+		releasedKey = func(pool string) string {
+			return migrationKey(infraflow.ChildKeyWorkerPools + shared.Separator + pool)
+		}
+		availabilitySet = func(vmNames ...string) *armcompute.AvailabilitySet {
+			var vms []*armcompute.SubResource
+			for _, name := range vmNames {
+				vms = append(vms, &armcompute.SubResource{ID: ptr.To("/subscriptions/sub/resourceGroups/SHOOT--FOO--BAR/providers/Microsoft.Compute/virtualMachines/" + name)})
+			}
+			return &armcompute.AvailabilitySet{
+				ID:         ptr.To(avSetID),
+				Name:       ptr.To(avSetName),
+				Properties: &armcompute.AvailabilitySetProperties{VirtualMachines: vms},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		avSets = mockclient.NewMockAvailabilitySet(ctrl)
+		factory.EXPECT().AvailabilitySet().Return(avSets, nil).AnyTimes()
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig",` +
+							`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"}}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+				Shoot: &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							azuretypes.ShootVmoMigrationAnnotation:         "true",
+							azuretypes.ShootVmoMigrationStrategyAnnotation: azuretypes.VmoMigrationStrategyStaged,
+						},
+					},
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{Name: "cpu"}, {Name: "cpu-large"}},
+						},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{
+				Data: map[string]string{
+					infraflow.ChildKeyIDs + shared.Separator + string(infraflow.KindAvailabilitySet): avSetID,
+					migrationKey(infraflow.ChildKeyComplete):                                         "true",
+				},
+			},
+		}
+	})
+
+	Describe("#MigrateAvailabilitySet", func() {
+		It("should release the first worker pool", func() {
+			avSets.EXPECT().Get(gomock.Any(), resourceGroup, avSetName).Return(availabilitySet("shoot--foo--bar-cpu-abcde-fghij", "shoot--foo--bar-cpu-large-abcde-fghij"), nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateAvailabilitySet(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).To(HaveKeyWithValue(releasedKey("cpu"), "true"))
+			Expect(state.Data).NotTo(HaveKey(releasedKey("cpu-large")))
+			Expect(state.Data).To(HaveKeyWithValue(migrationKey(infraflow.KeyRemainingVirtualMachines), "2"))
+		})
+
+		It("should wait until the machines of the released worker pool were rolled", func() {
+			opts.State.Data[releasedKey("cpu")] = "true"
+			avSets.EXPECT().Get(gomock.Any(), resourceGroup, avSetName).Return(availabilitySet("SHOOT--FOO--BAR-CPU-ABCDE-FGHIJ", "shoot--foo--bar-cpu-large-abcde-fghij"), nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateAvailabilitySet(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).NotTo(HaveKey(releasedKey("cpu-large")))
+		})
+
+		It("should release the next worker pool once the machines of the released worker pools were rolled", func() {
+			opts.State.Data[releasedKey("cpu")] = "true"
+			avSets.EXPECT().Get(gomock.Any(), resourceGroup, avSetName).Return(availabilitySet("shoot--foo--bar-cpu-large-abcde-fghij"), nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateAvailabilitySet(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).To(HaveKeyWithValue(releasedKey("cpu-large"), "true"))
+			Expect(state.Data).To(HaveKeyWithValue(migrationKey(infraflow.KeyRemainingVirtualMachines), "1"))
+		})
+
+		It("should release worker pools without machines in the availability set together with the next one", func() {
+			avSets.EXPECT().Get(gomock.Any(), resourceGroup, avSetName).Return(availabilitySet("shoot--foo--bar-cpu-large-abcde-fghij"), nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateAvailabilitySet(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).To(HaveKeyWithValue(releasedKey("cpu"), "true"))
+			Expect(state.Data).To(HaveKeyWithValue(releasedKey("cpu-large"), "true"))
+		})
+
+		It("should not release worker pools if the migration is not staged", func() {
+			delete(opts.Cluster.Shoot.Annotations, azuretypes.ShootVmoMigrationStrategyAnnotation)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateAvailabilitySet(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).NotTo(HaveKey(releasedKey("cpu")))
+		})
+	})
+
+	Describe("#EnsureLoadBalancersManagedByCCM", func() {
+		const (
+			ccmFrontendConfig = "a0123456789abcdef0123456789abcdef"
+			frontendConfigID  = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/loadBalancers/shoot--foo--bar/frontendIPConfigurations/"
+		)
+
+		var loadBalancers *mockclient.MockLoadBalancer
+
+		BeforeEach(func() {
+			loadBalancers = mockclient.NewMockLoadBalancer(ctrl)
+			factory.EXPECT().LoadBalancer().Return(loadBalancers, nil).AnyTimes()
+		})
+
+		It("should succeed if all frontend IP configurations were created by the cloud-controller-manager", func() {
+			loadBalancers.EXPECT().GetFrontendConfigs(gomock.Any(), resourceGroup, resourceGroup).Return([]*armnetwork.FrontendIPConfiguration{
+				{Name: ptr.To(ccmFrontendConfig)},
+				{Name: ptr.To(ccmFrontendConfig + "-IPv6")},
+			}, nil)
+			loadBalancers.EXPECT().GetFrontendConfigs(gomock.Any(), resourceGroup, resourceGroup+"-internal").Return(nil, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureLoadBalancersManagedByCCM(ctx, resourceGroup, resourceGroup+"-internal")).To(Succeed())
+		})
+
+		It("should fail if a frontend IP configuration was not created by the cloud-controller-manager", func() {
+			loadBalancers.EXPECT().GetFrontendConfigs(gomock.Any(), resourceGroup, resourceGroup).Return([]*armnetwork.FrontendIPConfiguration{
+				{Name: ptr.To(ccmFrontendConfig)},
+				{Name: ptr.To("custom")},
+			}, nil)
+			loadBalancers.EXPECT().ListRules(gomock.Any(), resourceGroup, resourceGroup).Return([]*armnetwork.LoadBalancingRule{
+				{Name: ptr.To(ccmFrontendConfig + "-TCP-443"), Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
+					FrontendIPConfiguration: &armnetwork.SubResource{ID: ptr.To(frontendConfigID + ccmFrontendConfig)},
+				}},
+				{Name: ptr.To("custom-https"), Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
+					FrontendIPConfiguration: &armnetwork.SubResource{ID: ptr.To(frontendConfigID + "custom")},
+				}},
+			}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureLoadBalancersManagedByCCM(ctx, resourceGroup)).To(MatchError(And(
+				ContainSubstring(`load balancer "shoot--foo--bar" has frontend IP configurations [custom]`),
+				ContainSubstring("load balancing rules [custom-https]"),
+			)))
+		})
+
+		It("should prevent the preparation of the migration", func() {
+			delete(opts.State.Data, migrationKey(infraflow.ChildKeyComplete))
+			loadBalancers.EXPECT().GetFrontendConfigs(gomock.Any(), resourceGroup, gomock.Any()).Return([]*armnetwork.FrontendIPConfiguration{{Name: ptr.To("custom")}}, nil).Times(2)
+			loadBalancers.EXPECT().ListRules(gomock.Any(), resourceGroup, gomock.Any()).Return(nil, nil).Times(2)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateAvailabilitySet(ctx)).To(MatchError(ContainSubstring("refusing to delete the load balancers")))
+		})
+	})
+
+	Describe("#GetInfrastructureStatus", func() {
+		It("should report the progress of the staged migration", func() {
+			opts.State.Data[releasedKey("cpu")] = "true"
+			opts.State.Data[migrationKey(infraflow.KeyRemainingVirtualMachines)] = "3"
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.MigratingToVMO).To(BeTrue())
+			Expect(status.AvailabilitySetMigration).To(Equal(&v1alpha1.AvailabilitySetMigrationStatus{
+				MigratedWorkerPools:      []string{"cpu"},
+				RemainingVirtualMachines: 3,
+			}))
+		})
+	})
+})
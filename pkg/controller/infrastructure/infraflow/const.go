@@ -17,8 +17,72 @@ const (
 	KeyManagedIdentityClientId = "managed_identity_client_id"
 	// KeyManagedIdentityId is a key for the MI's identity ID.
 	KeyManagedIdentityId = "managed_identity_id"
+	// ChildKeyBootDiagnostics is the prefix key for data about the boot diagnostics storage account.
+	ChildKeyBootDiagnostics = "boot_diagnostics"
+	// KeyStorageAccountName is a key for the name of a storage account.
+	KeyStorageAccountName = "storage_account_name"
+	// KeyStorageURI is a key for the blob endpoint of a storage account.
+	KeyStorageURI = "storage_uri"
+	// KeyRegion is a key for the region of a resource.
+	KeyRegion = "region"
+	// KeyZoneRedundant is a key to indicate whether a resource is zone-redundant.
+	KeyZoneRedundant = "zone_redundant"
 	// ChildKeyMigration is the prefix key for data stored during migrations.
 	ChildKeyMigration = "migration"
 	// ChildKeyComplete is a key to indicate whether a task is complete.
 	ChildKeyComplete = "complete"
+	// ChildKeyWorkerPools is the prefix key for the worker pools which were released for a staged migration to VMOs.
+	ChildKeyWorkerPools = "worker_pools"
+	// KeyRemainingVirtualMachines is a key for the number of virtual machines which are still part of an availability set.
+	KeyRemainingVirtualMachines = "remaining_virtual_machines"
+	// ChildKeyBasicPublicIPs is the prefix key for the progress of the public IP migration from Basic to Standard SKU.
+	ChildKeyBasicPublicIPs = "basic-pip"
+	// KeyPhase is a key for the phase a migration of a resource is in.
+	KeyPhase = "phase"
+	// KeyAssociationID is a key for the ID of the resource a public IP was associated with.
+	KeyAssociationID = "association_id"
+	// ChildKeyDiagnosticSettings is the prefix key for the resources with diagnostic settings created by the extension.
+	// The keys are the IDs of the resources and the values the IDs of the Log Analytics workspaces.
+	ChildKeyDiagnosticSettings = "diagnostic_settings"
+
+	// defaultRouteName is the name of the route in the worker route table which routes all egress traffic to the next hop
+	// configured for the outbound access.
+	defaultRouteName = "default-outbound"
+	// podSubnetDelegationName is the name of the delegation of the pod subnet.
+	podSubnetDelegationName = "aks-delegation"
+	// podSubnetDelegationServiceName is the service the pod subnet is delegated to, so that Azure CNI can dynamically
+	// allocate pod IPs from it.
+	podSubnetDelegationServiceName = "Microsoft.ContainerService/managedClusters"
+	// logAnalyticsWorkspaceAPIVersion is the API version used to read Log Analytics workspaces.
+	logAnalyticsWorkspaceAPIVersion = "2022-10-01"
+	// deletionProtectionLockName is the name of the management lock which protects the shoot's resource group against
+	// deletion.
+	deletionProtectionLockName = "gardener-deletion-protection"
+	// defaultTrafficAnalyticsInterval is the interval in minutes in which Traffic Analytics processes the flow logs if
+	// none is configured.
+	defaultTrafficAnalyticsInterval int32 = 60
+	// diagnosticSettingsName is the name of the diagnostic settings created by the extension.
+	diagnosticSettingsName = "gardener"
+	// egressFirewallSubnetName is the name Azure requires for the subnet of a firewall.
+	egressFirewallSubnetName = "AzureFirewallSubnet"
+	// egressFirewallManagementSubnetName is the name Azure requires for the subnet of the management traffic of a firewall.
+	egressFirewallManagementSubnetName = "AzureFirewallManagementSubnet"
+	// egressFirewallRuleCollectionGroupName is the name of the rule collection group of the managed firewall policy.
+	egressFirewallRuleCollectionGroupName = "gardener"
 )
+
+// EventReasonNatGatewayIPsScaled is the reason of the event emitted when the number of public IPs of a NAT gateway was
+// scaled with the number of nodes behind it.
+const EventReasonNatGatewayIPsScaled = "NatGatewayIPsScaled"
+
+// EventReasonInventoryItemMissing is the reason of the event emitted when a resource of the inventory does not exist
+// anymore on restoration.
+const EventReasonInventoryItemMissing = "InventoryItemMissing"
+
+// EventReasonInventoryItemIDChanged is the reason of the event emitted when the ID of a resource of the inventory
+// changed its casing.
+const EventReasonInventoryItemIDChanged = "InventoryItemIDChanged"
+
+// EventReasonRerunStepUnknown is the reason of the event emitted when the re-run of a step is requested which is
+// unknown or cannot be re-run.
+const EventReasonRerunStepUnknown = "RerunStepUnknown"
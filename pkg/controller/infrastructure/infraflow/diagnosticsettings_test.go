@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("DiagnosticSettings", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		workspaceID   = "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.OperationalInsights/workspaces/workspace"
+		nsgID         = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/networkSecurityGroups/shoot--foo--bar-workers"
+		natID         = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/shoot--foo--bar-nat-gateway"
+		staleID       = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/old"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl     *gomock.Controller
+		factory  *mockclient.MockFactory
+		settings *mockclient.MockDiagnosticSettings
+		opts     infraflow.Opts
+
+		providerConfig = func(observability string) []byte {
+			return []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+				`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19","natGateway":{"enabled":true}}` + observability + `}`)
+		}
+		stateKey = func(resourceID string) string {
+			return infraflow.ChildKeyDiagnosticSettings + shared.Separator + resourceID
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		settings = mockclient.NewMockDiagnosticSettings(ctrl)
+		factory.EXPECT().DiagnosticSettings().Return(settings, nil).AnyTimes()
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: providerConfig(`,"observability":{"logAnalyticsWorkspaceID":"` + workspaceID + `"}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{
+				Data: map[string]string{
+					infraflow.ChildKeyIDs + shared.Separator + string(infraflow.KindSecurityGroup): nsgID,
+				},
+			},
+		}
+	})
+
+	Describe("#EnsureDiagnosticSettings", func() {
+		It("should create the diagnostic settings of the managed resources and delete stale ones", func() {
+			opts.State.Data[stateKey(staleID)] = workspaceID
+
+			settings.EXPECT().CreateOrUpdate(gomock.Any(), nsgID, "gardener", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, setting client.DiagnosticSettingsResource) (*client.DiagnosticSettingsResource, error) {
+					Expect(*setting.Properties.WorkspaceID).To(Equal(workspaceID))
+					Expect(*setting.Properties.Logs[0].CategoryGroup).To(Equal("allLogs"))
+					return &setting, nil
+				})
+			settings.EXPECT().CreateOrUpdate(gomock.Any(), natID, "gardener", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _ string, setting client.DiagnosticSettingsResource) (*client.DiagnosticSettingsResource, error) {
+					Expect(*setting.Properties.Metrics[0].Category).To(Equal("AllMetrics"))
+					return &setting, nil
+				})
+			settings.EXPECT().Delete(gomock.Any(), staleID, "gardener").Return(nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureDiagnosticSettings(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).To(HaveKeyWithValue(stateKey(nsgID), workspaceID))
+			Expect(state.Data).To(HaveKeyWithValue(stateKey(natID), workspaceID))
+			Expect(state.Data).NotTo(HaveKey(stateKey(staleID)))
+		})
+
+		It("should delete the diagnostic settings if observability is not configured anymore", func() {
+			opts.Infra.Spec.ProviderConfig.Raw = providerConfig(``)
+			opts.State.Data[stateKey(nsgID)] = workspaceID
+			opts.State.Data[stateKey(natID)] = workspaceID
+
+			settings.EXPECT().Delete(gomock.Any(), nsgID, "gardener").Return(nil)
+			settings.EXPECT().Delete(gomock.Any(), natID, "gardener").Return(nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureDiagnosticSettings(ctx)).To(Succeed())
+		})
+
+		It("should do nothing if observability was never configured", func() {
+			opts.Infra.Spec.ProviderConfig.Raw = providerConfig(``)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureDiagnosticSettings(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#DeleteDiagnosticSettings", func() {
+		It("should delete the diagnostic settings created by the extension", func() {
+			opts.State.Data[stateKey(nsgID)] = workspaceID
+
+			settings.EXPECT().Delete(gomock.Any(), nsgID, "gardener").Return(nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.DeleteDiagnosticSettings(ctx)).To(Succeed())
+		})
+	})
+})
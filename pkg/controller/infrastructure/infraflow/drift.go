@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// ConditionTypeInSync is the type of the condition which reports whether the Azure resources of the infrastructure
+	// match their desired state.
+	ConditionTypeInSync gardencorev1beta1.ConditionType = "AzureInfrastructureInSync"
+
+	// maxDriftsInConditionMessage is the maximum number of drifts which are listed in the message of the condition.
+	maxDriftsInConditionMessage = 10
+
+	driftFieldExistence = "existence"
+	driftValuePresent   = "present"
+	driftValueMissing   = "missing"
+
+	driftFieldProvisioningState = "provisioningState"
+)
+
+// Drift is a deviation of a property of an Azure resource from the state the flow reconciler would apply.
+type Drift struct {
+	// Kind is the kind of the drifted resource.
+	Kind AzureResourceKind
+	// Name is the name of the drifted resource.
+	Name string
+	// Field is the drifted property of the resource.
+	Field string
+	// Desired is the value of the property the flow reconciler would apply.
+	Desired string
+	// Actual is the value of the property in Azure.
+	Actual string
+}
+
+func (d Drift) String() string {
+	return fmt.Sprintf("%s %s: %s is %q instead of %q", d.Kind, d.Name, d.Field, d.Actual, d.Desired)
+}
+
+// DetectDrift compares the actual properties of the security group, the NAT gateways and the subnets of the
+// infrastructure with the desired ones and returns the deviations. It does not modify any resource. The rules of the
+// security group are managed by the cloud-controller-manager and the bastion controller, hence they are not compared.
+// Instead, a security group which is not in the succeeded provisioning state, e.g. after a failed out-of-band update of
+// its rules, is reported, as the next reconciliation updates it again.
+func (fctx *FlowContext) DetectDrift(ctx context.Context) ([]Drift, error) {
+	var drifts []Drift
+
+	for _, detect := range []func(context.Context) ([]Drift, error){
+		fctx.detectSecurityGroupDrift,
+		fctx.detectNatGatewayDrift,
+		fctx.detectSubnetDrift,
+	} {
+		d, err := detect(ctx)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, d...)
+	}
+
+	return drifts, nil
+}
+
+func (fctx *FlowContext) detectSecurityGroupDrift(ctx context.Context) ([]Drift, error) {
+	sgCfg := fctx.adapter.SecurityGroupConfig()
+
+	c, err := fctx.factory.NetworkSecurityGroup()
+	if err != nil {
+		return nil, err
+	}
+	sg, err := c.Get(ctx, sgCfg.ResourceGroup, sgCfg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if sg == nil {
+		return []Drift{missingResourceDrift(KindSecurityGroup, sgCfg.Name)}, nil
+	}
+	var provisioningState string
+	if sg.Properties != nil && sg.Properties.ProvisioningState != nil {
+		provisioningState = string(*sg.Properties.ProvisioningState)
+	}
+	return compareDriftFields(KindSecurityGroup, sgCfg.Name, []driftField{
+		{name: "location", desired: sgCfg.Location, actual: ptr.Deref(sg.Location, "")},
+		{name: driftFieldProvisioningState, desired: string(armnetwork.ProvisioningStateSucceeded), actual: provisioningState},
+	}), nil
+}
+
+func (fctx *FlowContext) detectNatGatewayDrift(ctx context.Context) ([]Drift, error) {
+	natsCfg := fctx.adapter.NatGatewayConfigs()
+	if len(natsCfg) == 0 {
+		return nil, nil
+	}
+
+	c, err := fctx.factory.NatGateway()
+	if err != nil {
+		return nil, err
+	}
+	currentNats, err := c.List(ctx, fctx.adapter.ResourceGroupName())
+	if err != nil {
+		return nil, err
+	}
+	nameToCurrentNats := ToMap(Filter(currentNats, func(nat *armnetwork.NatGateway) bool { return nat.Name != nil }), func(nat *armnetwork.NatGateway) string {
+		return *nat.Name
+	})
+
+	var drifts []Drift
+	for _, name := range sortedKeys(natsCfg) {
+		current, ok := nameToCurrentNats[name]
+		if !ok {
+			drifts = append(drifts, missingResourceDrift(KindNatGateway, name))
+			continue
+		}
+
+		desired := fctx.desiredNatGateway(natsCfg[name], current)
+		fields := []driftField{
+			{name: "zones", desired: joinStrings(desired.Zones), actual: joinStrings(current.Zones)},
+			{name: "publicIPAddresses", desired: joinSubResourceIDs(desired.Properties.PublicIPAddresses), actual: joinSubResourceIDs(natGatewayProperties(current).PublicIPAddresses)},
+		}
+		// without a configured idle timeout, Azure applies its default which is not reconciled.
+		if desired.Properties.IdleTimeoutInMinutes != nil {
+			fields = append(fields, driftField{
+				name:    "idleTimeoutInMinutes",
+				desired: fmt.Sprint(*desired.Properties.IdleTimeoutInMinutes),
+				actual:  formatOptional(natGatewayProperties(current).IdleTimeoutInMinutes),
+			})
+		}
+		drifts = append(drifts, compareDriftFields(KindNatGateway, name, fields)...)
+	}
+
+	return drifts, nil
+}
+
+func (fctx *FlowContext) detectSubnetDrift(ctx context.Context) ([]Drift, error) {
+	vnetCfg := fctx.adapter.VirtualNetworkConfig()
+
+	c, err := fctx.factory.Subnet()
+	if err != nil {
+		return nil, err
+	}
+	currentSubnets, err := c.List(ctx, vnetCfg.ResourceGroup, vnetCfg.Name)
+	if err != nil {
+		return nil, err
+	}
+	nameToCurrentSubnets := ToMap(Filter(currentSubnets, func(s *armnetwork.Subnet) bool { return fctx.adapter.IsOwnSubnetName(s.Name) }), func(s *armnetwork.Subnet) string {
+		return *s.Name
+	})
+
+	var drifts []Drift
+	for _, z := range fctx.adapter.Zones() {
+		current, ok := nameToCurrentSubnets[z.Subnet.Name]
+		if !ok {
+			drifts = append(drifts, missingResourceDrift(KindSubnet, z.Subnet.Name))
+			continue
+		}
+
+		desired, err := fctx.desiredSubnet(z, current)
+		if err != nil {
+			return nil, err
+		}
+		currentProperties := ptr.Deref(current.Properties, armnetwork.SubnetPropertiesFormat{})
+		drifts = append(drifts, compareDriftFields(KindSubnet, z.Subnet.Name, []driftField{
+			{name: "addressPrefix", desired: ptr.Deref(desired.Properties.AddressPrefix, ""), actual: ptr.Deref(currentProperties.AddressPrefix, "")},
+			{name: "serviceEndpoints", desired: joinServiceEndpoints(desired.Properties.ServiceEndpoints), actual: joinServiceEndpoints(currentProperties.ServiceEndpoints)},
+			{name: "networkSecurityGroup", desired: securityGroupID(desired.Properties.NetworkSecurityGroup), actual: securityGroupID(currentProperties.NetworkSecurityGroup)},
+			{name: "routeTable", desired: routeTableID(desired.Properties.RouteTable), actual: routeTableID(currentProperties.RouteTable)},
+			{name: "natGateway", desired: subResourceID(desired.Properties.NatGateway), actual: subResourceID(currentProperties.NatGateway)},
+		})...)
+	}
+
+	return drifts, nil
+}
+
+// DriftCondition returns the condition which reports the given drifts, based on the condition of the given conditions.
+func DriftCondition(conditions []gardencorev1beta1.Condition, drifts []Drift) gardencorev1beta1.Condition {
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, conditions, ConditionTypeInSync)
+	if len(drifts) == 0 {
+		return v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionTrue, "NoDriftDetected",
+			"The Azure resources of the infrastructure match their desired state.")
+	}
+
+	lines := make([]string, 0, min(len(drifts), maxDriftsInConditionMessage)+1)
+	for _, drift := range drifts[:min(len(drifts), maxDriftsInConditionMessage)] {
+		lines = append(lines, "- "+drift.String())
+	}
+	if len(drifts) > maxDriftsInConditionMessage {
+		lines = append(lines, fmt.Sprintf("- and %d more", len(drifts)-maxDriftsInConditionMessage))
+	}
+	return v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionFalse, "DriftDetected",
+		fmt.Sprintf("Detected %d drift(s) of the Azure resources of the infrastructure, they are repaired by the next reconciliation of the infrastructure:\n%s", len(drifts), strings.Join(lines, "\n")))
+}
+
+// driftField is a property of a resource which is compared case-insensitively, like Azure treats resource IDs.
+type driftField struct {
+	name    string
+	desired string
+	actual  string
+}
+
+func compareDriftFields(kind AzureResourceKind, name string, fields []driftField) []Drift {
+	var drifts []Drift
+	for _, f := range fields {
+		if !strings.EqualFold(f.desired, f.actual) {
+			drifts = append(drifts, Drift{Kind: kind, Name: name, Field: f.name, Desired: f.desired, Actual: f.actual})
+		}
+	}
+	return drifts
+}
+
+func missingResourceDrift(kind AzureResourceKind, name string) Drift {
+	return Drift{Kind: kind, Name: name, Field: driftFieldExistence, Desired: driftValuePresent, Actual: driftValueMissing}
+}
+
+func natGatewayProperties(nat *armnetwork.NatGateway) armnetwork.NatGatewayPropertiesFormat {
+	return ptr.Deref(nat.Properties, armnetwork.NatGatewayPropertiesFormat{})
+}
+
+func joinStrings(values []*string) string {
+	var res []string
+	for _, v := range values {
+		if v != nil {
+			res = append(res, strings.ToLower(*v))
+		}
+	}
+	slices.Sort(res)
+	return strings.Join(res, ",")
+}
+
+func joinSubResourceIDs(resources []*armnetwork.SubResource) string {
+	var ids []*string
+	for _, r := range resources {
+		if r != nil {
+			ids = append(ids, r.ID)
+		}
+	}
+	return joinStrings(ids)
+}
+
+func joinServiceEndpoints(endpoints []*armnetwork.ServiceEndpointPropertiesFormat) string {
+	var services []*string
+	for _, e := range endpoints {
+		if e != nil {
+			services = append(services, e.Service)
+		}
+	}
+	return joinStrings(services)
+}
+
+func subResourceID(r *armnetwork.SubResource) string {
+	if r == nil {
+		return ""
+	}
+	return ptr.Deref(r.ID, "")
+}
+
+func securityGroupID(sg *armnetwork.SecurityGroup) string {
+	if sg == nil {
+		return ""
+	}
+	return ptr.Deref(sg.ID, "")
+}
+
+func routeTableID(rt *armnetwork.RouteTable) string {
+	if rt == nil {
+		return ""
+	}
+	return ptr.Deref(rt.ID, "")
+}
+
+func formatOptional[T any](v *T) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(*v)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
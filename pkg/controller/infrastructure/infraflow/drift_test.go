@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("DetectDrift", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		sgName        = resourceGroup + "-workers"
+		subnetName    = resourceGroup + "-nodes-z1"
+		natName       = resourceGroup + "-nat-gateway-z1"
+		ipName        = natName + "-ip"
+		idPrefix      = "/subscriptions/sub/resourceGroups/" + resourceGroup + "/providers/Microsoft.Network/"
+		sgID          = idPrefix + "networkSecurityGroups/" + sgName
+		rtID          = idPrefix + "routeTables/worker_route_table"
+		natID         = idPrefix + "natGateways/" + natName
+		ipID          = idPrefix + "publicIPAddresses/" + ipName
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl    *gomock.Controller
+		factory *mockclient.MockFactory
+		sgs     *mockclient.MockNetworkSecurityGroup
+		nats    *mockclient.MockNatGateway
+		subnets *mockclient.MockSubnet
+		opts    infraflow.Opts
+
+		sg     *armnetwork.SecurityGroup
+		nat    *armnetwork.NatGateway
+		subnet *armnetwork.Subnet
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		sgs = mockclient.NewMockNetworkSecurityGroup(ctrl)
+		nats = mockclient.NewMockNatGateway(ctrl)
+		subnets = mockclient.NewMockSubnet(ctrl)
+		factory.EXPECT().NetworkSecurityGroup().Return(sgs, nil).AnyTimes()
+		factory.EXPECT().NatGateway().Return(nats, nil).AnyTimes()
+		factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+
+		sg = &armnetwork.SecurityGroup{
+			ID:         ptr.To(sgID),
+			Name:       ptr.To(sgName),
+			Location:   ptr.To("westeurope"),
+			Properties: &armnetwork.SecurityGroupPropertiesFormat{ProvisioningState: ptr.To(armnetwork.ProvisioningStateSucceeded)},
+		}
+		nat = &armnetwork.NatGateway{
+			ID:    ptr.To(natID),
+			Name:  ptr.To(natName),
+			Zones: []*string{ptr.To("1")},
+			Properties: &armnetwork.NatGatewayPropertiesFormat{
+				IdleTimeoutInMinutes: ptr.To[int32](10),
+				PublicIPAddresses:    []*armnetwork.SubResource{{ID: ptr.To(ipID)}},
+			},
+		}
+		subnet = &armnetwork.Subnet{
+			ID:   ptr.To(idPrefix + "virtualNetworks/" + resourceGroup + "/subnets/" + subnetName),
+			Name: ptr.To(subnetName),
+			Properties: &armnetwork.SubnetPropertiesFormat{
+				AddressPrefix:        ptr.To("10.250.0.0/24"),
+				ServiceEndpoints:     []*armnetwork.ServiceEndpointPropertiesFormat{{Service: ptr.To("Microsoft.Storage")}},
+				NetworkSecurityGroup: &armnetwork.SecurityGroup{ID: ptr.To(sgID)},
+				RouteTable:           &armnetwork.RouteTable{ID: ptr.To(rtID)},
+				NatGateway:           &armnetwork.SubResource{ID: ptr.To(natID)},
+			},
+		}
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+							`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24","serviceEndpoints":["Microsoft.Storage"],` +
+							`"natGateway":{"enabled":true,"idleConnectionTimeoutMinutes":10}}]}}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	expectList := func() {
+		sgs.EXPECT().Get(gomock.Any(), resourceGroup, sgName).Return(sg, nil)
+		nats.EXPECT().List(gomock.Any(), resourceGroup).Return([]*armnetwork.NatGateway{nat}, nil)
+		subnets.EXPECT().List(gomock.Any(), resourceGroup, resourceGroup).Return([]*armnetwork.Subnet{subnet}, nil)
+	}
+
+	It("should not report drifts if the resources match their desired state", func() {
+		// resource IDs are compared case-insensitively.
+		subnet.Properties.RouteTable.ID = ptr.To(rtID[:len(rtID)-len("worker_route_table")] + "WORKER_ROUTE_TABLE")
+		expectList()
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.DetectDrift(ctx)).To(BeEmpty())
+	})
+
+	It("should report drifted properties without modifying any resource", func() {
+		sg.Properties.ProvisioningState = ptr.To(armnetwork.ProvisioningStateFailed)
+		nat.Properties.IdleTimeoutInMinutes = ptr.To[int32](4)
+		subnet.Properties.NetworkSecurityGroup = nil
+		subnet.Properties.ServiceEndpoints = nil
+		expectList()
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.DetectDrift(ctx)).To(ConsistOf(
+			infraflow.Drift{Kind: infraflow.KindSecurityGroup, Name: sgName, Field: "provisioningState", Desired: "Succeeded", Actual: "Failed"},
+			infraflow.Drift{Kind: infraflow.KindNatGateway, Name: natName, Field: "idleTimeoutInMinutes", Desired: "10", Actual: "4"},
+			infraflow.Drift{Kind: infraflow.KindSubnet, Name: subnetName, Field: "serviceEndpoints", Desired: "microsoft.storage", Actual: ""},
+			infraflow.Drift{Kind: infraflow.KindSubnet, Name: subnetName, Field: "networkSecurityGroup", Desired: sgID, Actual: ""},
+		))
+	})
+
+	It("should report missing resources", func() {
+		sg = nil
+		nat.Name = ptr.To("other")
+		subnet.Name = ptr.To(resourceGroup + "-nodes-z2")
+		expectList()
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.DetectDrift(ctx)).To(ConsistOf(
+			infraflow.Drift{Kind: infraflow.KindSecurityGroup, Name: sgName, Field: "existence", Desired: "present", Actual: "missing"},
+			infraflow.Drift{Kind: infraflow.KindNatGateway, Name: natName, Field: "existence", Desired: "present", Actual: "missing"},
+			infraflow.Drift{Kind: infraflow.KindSubnet, Name: subnetName, Field: "existence", Desired: "present", Actual: "missing"},
+		))
+	})
+
+	Describe("#DriftCondition", func() {
+		It("should report that the infrastructure is in sync", func() {
+			condition := infraflow.DriftCondition(nil, nil)
+			Expect(condition.Type).To(Equal(infraflow.ConditionTypeInSync))
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+			Expect(condition.Reason).To(Equal("NoDriftDetected"))
+		})
+
+		It("should list a limited number of drifts", func() {
+			var drifts []infraflow.Drift
+			for i := 0; i < 12; i++ {
+				drifts = append(drifts, infraflow.Drift{Kind: infraflow.KindSubnet, Name: fmt.Sprintf("subnet-%d", i), Field: "existence", Desired: "present", Actual: "missing"})
+			}
+
+			condition := infraflow.DriftCondition(nil, drifts)
+			Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(condition.Reason).To(Equal("DriftDetected"))
+			Expect(condition.Message).To(ContainSubstring(`- Microsoft.Network/virtualNetworks/subnets subnet-9: existence is "missing" instead of "present"`))
+			Expect(condition.Message).NotTo(ContainSubstring("subnet-10"))
+			Expect(condition.Message).To(HaveSuffix("- and 2 more"))
+		})
+	})
+})
@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("EgressFirewall", func() {
+	const (
+		resourceGroup  = "shoot--foo--bar"
+		rgID           = "/subscriptions/sub/resourceGroups/" + resourceGroup
+		vnetID         = rgID + "/providers/Microsoft.Network/virtualNetworks/" + resourceGroup
+		firewallName   = resourceGroup + "-firewall"
+		firewallID     = rgID + "/providers/Microsoft.Network/azureFirewalls/" + firewallName
+		policyName     = resourceGroup + "-firewall-policy"
+		policyID       = rgID + "/providers/Microsoft.Network/firewallPolicies/" + policyName
+		subnetID       = vnetID + "/subnets/AzureFirewallSubnet"
+		mgmtSubnetID   = vnetID + "/subnets/AzureFirewallManagementSubnet"
+		firewallIPName = resourceGroup + "-firewall-ip"
+		firewallIPID   = rgID + "/providers/Microsoft.Network/publicIPAddresses/" + firewallIPName
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl      *gomock.Controller
+		factory   *mockclient.MockFactory
+		firewalls *mockclient.MockAzureFirewall
+		policies  *mockclient.MockFirewallPolicy
+		groups    *mockclient.MockFirewallPolicyRuleCollectionGroup
+		subnets   *mockclient.MockSubnet
+		ips       *mockclient.MockPublicIP
+		opts      infraflow.Opts
+	)
+
+	infra := func(networks string) *extensionsv1alpha1.Infrastructure {
+		return &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region: "westeurope",
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+						`"networks":` + networks + `}`)},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		firewalls = mockclient.NewMockAzureFirewall(ctrl)
+		policies = mockclient.NewMockFirewallPolicy(ctrl)
+		groups = mockclient.NewMockFirewallPolicyRuleCollectionGroup(ctrl)
+		subnets = mockclient.NewMockSubnet(ctrl)
+		ips = mockclient.NewMockPublicIP(ctrl)
+		factory.EXPECT().AzureFirewall().Return(firewalls, nil).AnyTimes()
+		factory.EXPECT().FirewallPolicy().Return(policies, nil).AnyTimes()
+		factory.EXPECT().FirewallPolicyRuleCollectionGroup().Return(groups, nil).AnyTimes()
+		factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+		factory.EXPECT().PublicIP().Return(ips, nil).AnyTimes()
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: infra(`{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24"}],` +
+				`"egressFirewall":{"cidr":"10.250.1.0/26","managementCIDR":"10.250.1.64/26"}}`),
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	Describe("#EnsureEgressFirewall", func() {
+		BeforeEach(func() {
+			subnets.EXPECT().Get(gomock.Any(), resourceGroup, resourceGroup, "AzureFirewallSubnet", nil).Return(nil, nil)
+			subnets.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, resourceGroup, "AzureFirewallSubnet", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _, _ string, subnet armnetwork.Subnet) (*armnetwork.Subnet, error) {
+					Expect(subnet.Properties.AddressPrefix).To(Equal(ptr.To("10.250.1.0/26")))
+					return &armnetwork.Subnet{ID: ptr.To(subnetID)}, nil
+				})
+			subnets.EXPECT().Get(gomock.Any(), resourceGroup, resourceGroup, "AzureFirewallManagementSubnet", nil).Return(nil, nil)
+			subnets.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, resourceGroup, "AzureFirewallManagementSubnet", gomock.Any()).
+				Return(&armnetwork.Subnet{ID: ptr.To(mgmtSubnetID)}, nil)
+			firewalls.EXPECT().Get(gomock.Any(), resourceGroup, firewallName).Return(nil, nil)
+			ips.EXPECT().Get(gomock.Any(), resourceGroup, firewallIPName, nil).Return(&armnetwork.PublicIPAddress{
+				ID:         ptr.To(firewallIPID),
+				Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.1.2.3")},
+			}, nil)
+		})
+
+		It("should create the firewall with a managed policy and report its IPs", func() {
+			policies.EXPECT().Get(gomock.Any(), resourceGroup, policyName).Return(nil, nil)
+			policies.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, policyName, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, policy armnetwork.FirewallPolicy) (*armnetwork.FirewallPolicy, error) {
+					Expect(policy.Properties.SKU.Tier).To(Equal(ptr.To(armnetwork.FirewallPolicySKUTierBasic)))
+					return &armnetwork.FirewallPolicy{ID: ptr.To(policyID)}, nil
+				})
+			groups.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, policyName, "gardener", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _, _ string, group armnetwork.FirewallPolicyRuleCollectionGroup) (*armnetwork.FirewallPolicyRuleCollectionGroup, error) {
+					rule := group.Properties.RuleCollections[0].(*armnetwork.FirewallPolicyFilterRuleCollection).Rules[0].(*armnetwork.Rule)
+					Expect(rule.SourceAddresses).To(ConsistOf(ptr.To("10.250.0.0/24")))
+					return &group, nil
+				})
+			firewalls.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, firewallName, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, firewall armnetwork.AzureFirewall) (*armnetwork.AzureFirewall, error) {
+					Expect(firewall.Properties.SKU.Tier).To(Equal(ptr.To(armnetwork.AzureFirewallSKUTierBasic)))
+					Expect(firewall.Properties.FirewallPolicy.ID).To(Equal(ptr.To(policyID)))
+					Expect(firewall.Properties.IPConfigurations[0].Properties.Subnet.ID).To(Equal(ptr.To(subnetID)))
+					Expect(firewall.Properties.IPConfigurations[0].Properties.PublicIPAddress.ID).To(Equal(ptr.To(firewallIPID)))
+					Expect(firewall.Properties.ManagementIPConfiguration.Properties.Subnet.ID).To(Equal(ptr.To(mgmtSubnetID)))
+					firewall.ID = ptr.To(firewallID)
+					firewall.Properties.IPConfigurations[0].Properties.PrivateIPAddress = ptr.To("10.250.1.4")
+					return &firewall, nil
+				})
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureEgressFirewall(ctx)).To(Succeed())
+
+			Expect(fctx.GetEgressIpCidrs()).To(ConsistOf("20.1.2.3/32"))
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.OutboundAccessType).To(Equal(v1alpha1.OutboundAccessTypeUserDefinedRouting))
+			Expect(status.Networks.EgressFirewall).To(Equal(&v1alpha1.EgressFirewallStatus{
+				Name:             firewallName,
+				ID:               firewallID,
+				PrivateIPAddress: "10.250.1.4",
+				PublicIPAddress:  v1alpha1.PublicIPAddressStatus{Name: firewallIPName, ResourceGroup: resourceGroup, ID: firewallIPID, IPAddress: "20.1.2.3"},
+			}))
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).To(ContainElements(
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/azureFirewalls", ID: firewallID},
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/firewallPolicies", ID: policyID},
+			))
+		})
+
+		It("should reference an existing policy and delete the managed one", func() {
+			existingPolicyID := "/subscriptions/sub/resourceGroups/central-rg/providers/Microsoft.Network/firewallPolicies/central"
+			opts.Infra = infra(`{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24"}],` +
+				`"egressFirewall":{"cidr":"10.250.1.0/26","managementCIDR":"10.250.1.64/26","policyID":"` + existingPolicyID + `"}}`)
+			opts.State.ManagedItems = []azure.AzureResource{{Kind: "Microsoft.Network/firewallPolicies", ID: policyID}}
+
+			firewalls.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, firewallName, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, firewall armnetwork.AzureFirewall) (*armnetwork.AzureFirewall, error) {
+					Expect(firewall.Properties.FirewallPolicy.ID).To(Equal(ptr.To(existingPolicyID)))
+					firewall.ID = ptr.To(firewallID)
+					firewall.Properties.IPConfigurations[0].Properties.PrivateIPAddress = ptr.To("10.250.1.4")
+					return &firewall, nil
+				})
+			policies.EXPECT().Delete(gomock.Any(), resourceGroup, policyName)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureEgressFirewall(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).NotTo(ContainElement(v1alpha1.AzureResource{Kind: "Microsoft.Network/firewallPolicies", ID: policyID}))
+		})
+	})
+
+	Describe("#DeleteEgressFirewall", func() {
+		It("should delete the firewall, its policy and its subnets once it was removed from the configuration", func() {
+			opts.Infra = infra(`{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24"}]}`)
+			opts.State.ManagedItems = []azure.AzureResource{
+				{Kind: "Microsoft.Network/azureFirewalls", ID: firewallID},
+				{Kind: "Microsoft.Network/firewallPolicies", ID: policyID},
+				{Kind: "Microsoft.Network/virtualNetworks/subnets", ID: subnetID},
+				{Kind: "Microsoft.Network/virtualNetworks/subnets", ID: mgmtSubnetID},
+				{Kind: "Microsoft.Network/virtualNetworks/subnets", ID: vnetID + "/subnets/" + resourceGroup + "-nodes-z1"},
+			}
+
+			gomock.InOrder(
+				firewalls.EXPECT().Delete(gomock.Any(), resourceGroup, firewallName),
+				policies.EXPECT().Delete(gomock.Any(), resourceGroup, policyName),
+			)
+			subnets.EXPECT().Delete(gomock.Any(), resourceGroup, resourceGroup, "AzureFirewallSubnet")
+			subnets.EXPECT().Delete(gomock.Any(), resourceGroup, resourceGroup, "AzureFirewallManagementSubnet")
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.DeleteEgressFirewall(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).To(ConsistOf(
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/virtualNetworks/subnets", ID: vnetID + "/subnets/" + resourceGroup + "-nodes-z1"},
+			))
+		})
+	})
+})
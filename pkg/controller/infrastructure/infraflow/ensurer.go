@@ -8,18 +8,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -288,6 +295,12 @@ func (fctx *FlowContext) ensureRouteTable(ctx context.Context) (*armnetwork.Rout
 	}
 
 	rtCfg := fctx.adapter.RouteTableConfig()
+	if fctx.adapter.EgressFirewallConfig() != nil {
+		rtCfg.NextHopIPAddress = fctx.whiteboard.GetChild(KindAzureFirewall.String()).Get(KeyPrivateIPAddress)
+		if rtCfg.NextHopIPAddress == nil {
+			return nil, fmt.Errorf("the private IP address of the egress firewall is not known yet")
+		}
+	}
 	rt, err := c.Get(ctx, rtCfg.ResourceGroup, rtCfg.Name)
 	if err != nil {
 		return nil, err
@@ -482,6 +495,17 @@ func (fctx *FlowContext) ensurePublicIps(ctx context.Context) error {
 	return joinError
 }
 
+// recordNatGatewayIPScaling emits an event on the Infrastructure if the number of public IPs of a NAT gateway whose
+// public IPs are scaled automatically was changed.
+func (fctx *FlowContext) recordNatGatewayIPScaling(name string, cfg NatGatewayConfig, currentIPCounts map[string]int) {
+	current, ok := currentIPCounts[name]
+	if fctx.recorder == nil || cfg.Nodes == nil || !ok || current == len(cfg.PublicIPList) {
+		return
+	}
+	fctx.recorder.Eventf(fctx.infra, corev1.EventTypeNormal, EventReasonNatGatewayIPsScaled,
+		"Scaled the public IPs of NAT gateway %q from %d to %d for up to %d nodes", name, current, len(cfg.PublicIPList), *cfg.Nodes)
+}
+
 // EnsureNatGateways reconciles all the NAT Gateways for the shoot.
 func (fctx *FlowContext) EnsureNatGateways(ctx context.Context) error {
 	return fctx.ensureNatGateways(ctx)
@@ -505,9 +529,13 @@ func (fctx *FlowContext) ensureNatGateways(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	// filter only thos prefixed by the cluster name.
+	existingNats := fctx.adapter.ExistingNatGatewayConfigs()
+	// filter only thos prefixed by the cluster name. Existing NAT gateways are never touched, even if they happen to be
+	// in the shoot's resource group.
 	currentNats = Filter(currentNats, func(address *armnetwork.NatGateway) bool {
-		return fctx.adapter.HasShootPrefix(address.Name)
+		return fctx.adapter.HasShootPrefix(address.Name) && !slices.ContainsFunc(existingNats, func(existing NatGatewayConfig) bool {
+			return strings.EqualFold(existing.ResourceGroup, fctx.adapter.ResourceGroupName()) && strings.EqualFold(existing.Name, *address.Name)
+		})
 	})
 
 	// obtain an indexed list of current IPs
@@ -517,11 +545,13 @@ func (fctx *FlowContext) ensureNatGateways(ctx context.Context) error {
 
 	natsCfg := fctx.adapter.NatGatewayConfigs()
 	for name, cfg := range natsCfg {
-		target := cfg.ToProvider(nameToCurrentNats[name])
-		for _, ip := range cfg.PublicIPList {
-			target.Properties.PublicIPAddresses = append(target.Properties.PublicIPAddresses, &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplatePublicIP, fctx.auth.SubscriptionID, ip.ResourceGroup, ip.Name))})
+		toReconcile[name] = fctx.desiredNatGateway(cfg, nameToCurrentNats[name])
+	}
+	currentIPCounts := map[string]int{}
+	for name, current := range nameToCurrentNats {
+		if current.Properties != nil {
+			currentIPCounts[name] = len(current.Properties.PublicIPAddresses)
 		}
-		toReconcile[name] = target
 	}
 
 	for _, resource := range fctx.inventory.ByKind(KindNatGateway) {
@@ -562,6 +592,7 @@ func (fctx *FlowContext) ensureNatGateways(ctx context.Context) error {
 
 	ipClient, _ := fctx.factory.PublicIP()
 	ipAddresses := []string{}
+	natGateways := []v1alpha1.NatGatewayStatus{}
 
 	for name, nat := range toReconcile {
 		nat, err := c.CreateOrUpdate(ctx, fctx.adapter.ResourceGroupName(), name, *nat)
@@ -574,264 +605,1170 @@ func (fctx *FlowContext) ensureNatGateways(ctx context.Context) error {
 			continue
 		}
 		fctx.whiteboard.GetChild(KindNatGateway.String()).Set(name, *nat.ID)
+		fctx.recordNatGatewayIPScaling(name, natsCfg[name], currentIPCounts)
 
-		for _, ip := range nat.Properties.PublicIPAddresses {
-			resourceId, err := arm.ParseResourceID(*ip.ID)
-			if err != nil {
-				joinError = errors.Join(joinError, err)
-				continue
-			}
-			ipObj, err := ipClient.Get(ctx, fctx.adapter.ResourceGroupName(), resourceId.Name, nil)
-			if err != nil {
-				joinError = errors.Join(joinError, err)
-				continue
-			}
-			if ipObj == nil {
-				continue
-			}
-			if ipObj.Properties.IPAddress != nil {
-				ipAddresses = append(ipAddresses, *ipObj.Properties.IPAddress)
-			}
+		natGateway, addresses, err := fctx.natGatewayStatus(ctx, ipClient, name, nat, natsCfg[name].Zone)
+		joinError = errors.Join(joinError, err)
+		ipAddresses = append(ipAddresses, addresses...)
+		natGateways = append(natGateways, natGateway)
+	}
+
+	// existing NAT gateways are neither created nor deleted, but their public IPs are used for egress as well.
+	for _, cfg := range existingNats {
+		nat, err := c.Get(ctx, cfg.ResourceGroup, cfg.Name, nil)
+		if err != nil {
+			joinError = errors.Join(joinError, err)
+			continue
+		} else if nat == nil {
+			joinError = errors.Join(joinError, fmt.Errorf("failed to locate existing NAT gateway: %s, %s", cfg.ResourceGroup, cfg.Name))
+			continue
 		}
+
+		natGateway, addresses, err := fctx.natGatewayStatus(ctx, ipClient, cfg.Name, nat, cfg.Zone)
+		joinError = errors.Join(joinError, err)
+		ipAddresses = append(ipAddresses, addresses...)
+		natGateways = append(natGateways, natGateway)
 	}
 
+	slices.SortFunc(natGateways, func(a, b v1alpha1.NatGatewayStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
 	fctx.whiteboard.GetChild(KindNatGateway.String()).SetObject(KeyPublicIPAddresses, ipAddresses)
+	fctx.whiteboard.GetChild(KindNatGateway.String()).SetObject(KeyNatGatewayStatuses, natGateways)
 
 	return joinError
 }
 
-// EnsureSubnets creates or updates subnets.
-func (fctx *FlowContext) EnsureSubnets(ctx context.Context) error {
-	return fctx.ensureSubnets(ctx)
+// desiredNatGateway returns the desired state of the NAT gateway of the given configuration based on its current state.
+func (fctx *FlowContext) desiredNatGateway(cfg NatGatewayConfig, current *armnetwork.NatGateway) *armnetwork.NatGateway {
+	target := cfg.ToProvider(current)
+	for _, ip := range cfg.PublicIPList {
+		target.Properties.PublicIPAddresses = append(target.Properties.PublicIPAddresses, &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplatePublicIP, fctx.auth.SubscriptionID, ip.ResourceGroup, ip.Name))})
+	}
+	return target
 }
 
-func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
+// natGatewayStatus returns the status of the given NAT gateway and the addresses of its public IPs.
+func (fctx *FlowContext) natGatewayStatus(ctx context.Context, ipClient client.PublicIP, name string, nat *armnetwork.NatGateway, zone *string) (v1alpha1.NatGatewayStatus, []string, error) {
 	var (
-		log         = shared.LogFromContext(ctx)
-		vnetRgroup  = fctx.adapter.VirtualNetworkConfig().ResourceGroup
-		vnetName    = fctx.adapter.VirtualNetworkConfig().Name
-		toDelete    = map[string]*armnetwork.Subnet{}
-		toReconcile = map[string]*armnetwork.Subnet{}
-		joinErr     error
+		joinError   error
+		ipAddresses []string
+		natGateway  = v1alpha1.NatGatewayStatus{
+			Name: name,
+			ID:   *nat.ID,
+			Zone: zone,
+		}
 	)
 
-	c, err := fctx.factory.Subnet()
+	if nat.Properties == nil {
+		return natGateway, nil, nil
+	}
+	for _, ip := range nat.Properties.PublicIPAddresses {
+		resourceId, err := arm.ParseResourceID(*ip.ID)
+		if err != nil {
+			joinError = errors.Join(joinError, err)
+			continue
+		}
+		ipObj, err := ipClient.Get(ctx, resourceId.ResourceGroupName, resourceId.Name, nil)
+		if err != nil {
+			joinError = errors.Join(joinError, err)
+			continue
+		}
+		if ipObj == nil {
+			continue
+		}
+		ipStatus := v1alpha1.PublicIPAddressStatus{
+			Name:          resourceId.Name,
+			ResourceGroup: resourceId.ResourceGroupName,
+			ID:            *ip.ID,
+		}
+		if ipObj.Properties.IPAddress != nil {
+			ipAddresses = append(ipAddresses, *ipObj.Properties.IPAddress)
+			ipStatus.IPAddress = *ipObj.Properties.IPAddress
+		}
+		natGateway.PublicIPAddresses = append(natGateway.PublicIPAddresses, ipStatus)
+	}
+	return natGateway, ipAddresses, joinError
+}
+
+// EnsureOutboundLoadBalancer reconciles the outbound rule of the shoot's load balancer. The load balancer is shared with
+// the cloud-controller-manager, hence only the frontends of the managed public IPs, the backend pool and the outbound
+// rule are reconciled.
+func (fctx *FlowContext) EnsureOutboundLoadBalancer(ctx context.Context) error {
+	cfg := fctx.adapter.OutboundLoadBalancerConfig()
+	if cfg == nil {
+		return nil
+	}
+	if fctx.adapter.IsAvailabilitySetReconciliationRequired() {
+		return fmt.Errorf("outbound access via the load balancer is not supported for shoots using an availability set because their basic load balancer does not support outbound rules")
+	}
+
+	c, err := fctx.factory.LoadBalancer()
+	if err != nil {
+		return err
+	}
+	ipClient, err := fctx.factory.PublicIP()
 	if err != nil {
 		return err
 	}
 
-	currentSubnets, err := c.List(ctx, vnetRgroup, vnetName)
+	current, err := c.Get(ctx, cfg.ResourceGroup, cfg.Name)
 	if err != nil {
 		return err
 	}
+	if _, err := c.CreateOrUpdate(ctx, cfg.ResourceGroup, cfg.Name, *cfg.ToProvider(current, fctx.auth.SubscriptionID)); err != nil {
+		return err
+	}
 
-	// filteredSubnets are the subnets of this shoot. In a shared VNet scenario, it is not guaranteed that all subnets in the VNet belong to a particular shoot.
-	filteredSubnets := Filter(currentSubnets, func(s *armnetwork.Subnet) bool {
-		return fctx.adapter.IsOwnSubnetName(s.Name)
-	})
-	// mappedSubnets maps the unique subnet name to the subnet object.
-	mappedSubnets := ToMap(filteredSubnets, func(s *armnetwork.Subnet) string {
-		return *s.Name
-	})
-	// clean the current inventory and rebuild it.
-	for _, resource := range fctx.inventory.ByKind(KindSubnet) {
-		if _, ok := mappedSubnets[resource.Name]; !ok {
-			log.Info("Removing subnet from inventory", "id", resource.String())
-			fctx.inventory.Delete(resource.String())
+	var (
+		joinError   error
+		ipAddresses = []string{}
+		lbStatus    = v1alpha1.OutboundLoadBalancerStatus{
+			Name:             cfg.Name,
+			OutboundRuleName: cfg.OutboundRuleName,
+		}
+	)
+	for _, ipCfg := range cfg.PublicIPList {
+		ip, err := ipClient.Get(ctx, ipCfg.ResourceGroup, ipCfg.Name, nil)
+		if err != nil {
+			joinError = errors.Join(joinError, err)
+			continue
+		}
+		if ip == nil {
+			continue
+		}
+		ipStatus := v1alpha1.PublicIPAddressStatus{
+			Name:          ipCfg.Name,
+			ResourceGroup: ipCfg.ResourceGroup,
+			ID:            *ip.ID,
+		}
+		if ip.Properties != nil && ip.Properties.IPAddress != nil {
+			ipAddresses = append(ipAddresses, *ip.Properties.IPAddress)
+			ipStatus.IPAddress = *ip.Properties.IPAddress
 		}
+		lbStatus.PublicIPAddresses = append(lbStatus.PublicIPAddresses, ipStatus)
 	}
 
-	zones := fctx.adapter.Zones()
-	for _, z := range zones {
-		actual := z.Subnet.ToProvider(mappedSubnets[z.Subnet.Name])
+	fctx.whiteboard.GetChild(KindLoadBalancer.String()).SetObject(KeyPublicIPAddresses, ipAddresses)
+	fctx.whiteboard.GetChild(KindLoadBalancer.String()).SetObject(KeyOutboundLoadBalancerStatus, lbStatus)
 
-		rtCfg := fctx.adapter.RouteTableConfig()
-		actual.Properties.RouteTable = &armnetwork.RouteTable{ID: to.Ptr(GetIdFromTemplate(TemplateRouteTable, fctx.auth.SubscriptionID, rtCfg.ResourceGroup, rtCfg.Name))}
+	return joinError
+}
 
-		sgCfg := fctx.adapter.SecurityGroupConfig()
-		actual.Properties.NetworkSecurityGroup = &armnetwork.SecurityGroup{ID: to.Ptr(GetIdFromTemplate(TemplateSecurityGroup, fctx.auth.SubscriptionID, sgCfg.ResourceGroup, sgCfg.Name))}
+// EnsureEgressFirewall reconciles the Azure Firewall through which the egress traffic of the worker subnets is routed,
+// including its subnets and, if no existing policy is configured, a managed firewall policy which allows all egress
+// traffic of the shoot's subnets.
+func (fctx *FlowContext) EnsureEgressFirewall(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+	cfg := fctx.adapter.EgressFirewallConfig()
+	if cfg == nil {
+		return nil
+	}
 
-		if z.NatGateway != nil {
-			actual.Properties.NatGateway = &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplateNatGateway, fctx.auth.SubscriptionID, z.NatGateway.ResourceGroup, z.NatGateway.Name))}
-		} else {
-			// let's allow users to override the NAT Gateway config for a subnet, if that NGW is not managed by gardener.
-			// It should only apply for existing subnets, hence we also check if actual.ID is not nil.
-			if actual.ID != nil &&
-				actual.Properties != nil &&
-				actual.Properties.NatGateway != nil &&
-				actual.Properties.NatGateway.ID != nil {
-				resourceId, err := arm.ParseResourceID(*actual.Properties.NatGateway.ID)
-				if err != nil {
-					joinErr = errors.Join(joinErr, err)
-					continue
-				}
-				// if this is a user-managed NAT gateway, do nothing. This is checked by looking at the resource group of the NGW.
-				// In case that the NGW belongs to our RG, but it should not exist (z.NatGateway == nil), we remove the association.
-				if resourceId.ResourceGroupName == fctx.adapter.ResourceGroupName() {
-					actual.Properties.NatGateway = nil
-				}
-			}
+	subnetClient, err := fctx.factory.Subnet()
+	if err != nil {
+		return err
+	}
+	for _, subnet := range []struct {
+		meta AzureResourceMetadata
+		cidr string
+	}{
+		{cfg.Subnet, cfg.SubnetCIDR},
+		{cfg.ManagementSubnet, cfg.ManagementCIDR},
+	} {
+		current, err := subnetClient.Get(ctx, subnet.meta.ResourceGroup, subnet.meta.Parent, subnet.meta.Name, nil)
+		if err != nil {
+			return err
 		}
-		toReconcile[z.Subnet.Name] = actual
+		target := armnetwork.Subnet{Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: to.Ptr(subnet.cidr)}}
+		if current != nil && current.Properties != nil && ptr.Deref(current.Properties.AddressPrefix, "") == subnet.cidr {
+			target = *current
+		}
+		log.Info("reconciling egress firewall subnet", "name", subnet.meta.Name)
+		result, err := subnetClient.CreateOrUpdate(ctx, subnet.meta.ResourceGroup, subnet.meta.Parent, subnet.meta.Name, target)
+		if err != nil {
+			return err
+		}
+		if err := fctx.inventory.Insert(*result.ID); err != nil {
+			return err
+		}
+		fctx.whiteboard.GetChild(KindSubnet.String()).Set(subnet.meta.Name, *result.ID)
 	}
 
-	for name, current := range mappedSubnets {
-		if err := fctx.inventory.Insert(*current.ID); err != nil {
+	firewallClient, err := fctx.factory.AzureFirewall()
+	if err != nil {
+		return err
+	}
+	current, err := firewallClient.Get(ctx, cfg.ResourceGroup, cfg.Name)
+	if err != nil {
+		return err
+	}
+
+	if cfg.PolicyID == nil {
+		if err := fctx.ensureEgressFirewallPolicy(ctx, cfg); err != nil {
 			return err
 		}
+	}
 
-		target, ok := toReconcile[name]
-		if !ok {
-			log.Info("Will delete subnet because it is not needed", "Resource Group", vnetRgroup, "Name", *current.Name)
-			toDelete[name] = current
-			continue
-		}
-		if ok, offender, v := ForceNewSubnet(current, target); ok {
-			log.Info("Will delete subnet because it cannot be reconciled", "Resource Group", vnetRgroup, "Name", *current.Name, "Field", offender, "Value", v)
-			toDelete[name] = current
-			continue
+	log.Info("reconciling egress firewall", "name", cfg.Name)
+	firewall, err := firewallClient.CreateOrUpdate(ctx, cfg.ResourceGroup, cfg.Name, *cfg.ToProvider(current, fctx.auth.SubscriptionID))
+	if err != nil {
+		return err
+	}
+	if err := fctx.inventory.Insert(*firewall.ID); err != nil {
+		return err
+	}
+
+	// the managed policy is not needed anymore once the firewall references an existing policy.
+	if cfg.PolicyID != nil {
+		if err := fctx.deleteEgressFirewallPolicy(ctx); err != nil {
+			return err
 		}
 	}
 
-	for name, subnet := range toDelete {
-		err := c.Delete(ctx, vnetRgroup, vnetName, name)
-		if err != nil {
-			joinErr = errors.Join(joinErr, err)
+	wb := fctx.whiteboard.GetChild(KindAzureFirewall.String())
+	firewallStatus := v1alpha1.EgressFirewallStatus{
+		Name: cfg.Name,
+		ID:   *firewall.ID,
+	}
+	if firewall.Properties != nil {
+		for _, ipConfiguration := range firewall.Properties.IPConfigurations {
+			if ipConfiguration != nil && ipConfiguration.Properties != nil && ipConfiguration.Properties.PrivateIPAddress != nil {
+				firewallStatus.PrivateIPAddress = *ipConfiguration.Properties.PrivateIPAddress
+				break
+			}
 		}
-		fctx.inventory.Delete(*subnet.ID)
-		fctx.whiteboard.GetChild(KindNatGateway.String()).Set(name, *subnet.ID)
 	}
-	if joinErr != nil {
-		return joinErr
+	if firewallStatus.PrivateIPAddress == "" {
+		return fmt.Errorf("egress firewall %s does not have a private IP address yet", cfg.Name)
 	}
+	wb.Set(KeyPrivateIPAddress, firewallStatus.PrivateIPAddress)
 
-	for name, subnet := range toReconcile {
-		subnet, err = c.CreateOrUpdate(ctx, vnetRgroup, vnetName, name, *subnet)
-		if err != nil {
-			joinErr = errors.Join(joinErr, err)
-			continue
-		}
-		if err := fctx.inventory.Insert(*subnet.ID); err != nil {
-			joinErr = errors.Join(joinErr, err)
-			continue
+	ipClient, err := fctx.factory.PublicIP()
+	if err != nil {
+		return err
+	}
+	ip, err := ipClient.Get(ctx, cfg.PublicIP.ResourceGroup, cfg.PublicIP.Name, nil)
+	if err != nil {
+		return err
+	}
+	ipAddresses := []string{}
+	if ip != nil {
+		firewallStatus.PublicIPAddress = v1alpha1.PublicIPAddressStatus{
+			Name:          cfg.PublicIP.Name,
+			ResourceGroup: cfg.PublicIP.ResourceGroup,
+			ID:            *ip.ID,
 		}
-		fctx.whiteboard.GetChild(KindSubnet.String()).Set(name, *subnet.ID)
-		if subnet.Properties.NatGateway != nil && subnet.Properties.NatGateway.ID != nil {
-			fctx.whiteboard.GetChild(KindSubnet.String()).GetChild(KindNatGateway.String()).Set("id", *subnet.Properties.NatGateway.ID)
+		if ip.Properties != nil && ip.Properties.IPAddress != nil {
+			ipAddresses = append(ipAddresses, *ip.Properties.IPAddress)
+			firewallStatus.PublicIPAddress.IPAddress = *ip.Properties.IPAddress
 		}
 	}
 
-	return joinErr
+	wb.SetObject(KeyPublicIPAddresses, ipAddresses)
+	wb.SetObject(KeyEgressFirewallStatus, firewallStatus)
+	return nil
 }
 
-// EnsureManagedIdentity reconciles the managed identity specificed in the config.
-func (fctx *FlowContext) EnsureManagedIdentity(ctx context.Context) (err error) {
-	if fctx.cfg.Identity == nil {
-		return nil
+func (fctx *FlowContext) ensureEgressFirewallPolicy(ctx context.Context, cfg *EgressFirewallConfig) error {
+	c, err := fctx.factory.FirewallPolicy()
+	if err != nil {
+		return err
 	}
-
-	c, err := fctx.factory.ManagedUserIdentity()
+	current, err := c.Get(ctx, cfg.Policy.ResourceGroup, cfg.Policy.Name)
 	if err != nil {
 		return err
 	}
-	res, err := c.Get(ctx, fctx.cfg.Identity.ResourceGroup, fctx.cfg.Identity.Name)
+	policy, err := c.CreateOrUpdate(ctx, cfg.Policy.ResourceGroup, cfg.Policy.Name, *cfg.PolicyToProvider(current))
 	if err != nil {
 		return err
 	}
-	if res.ID == nil || res.Properties.ClientID == nil {
-		return nil
+	if err := fctx.inventory.Insert(*policy.ID); err != nil {
+		return err
 	}
 
-	fctx.whiteboard.Set(KeyManagedIdentityClientId, *res.Properties.ClientID)
-	fctx.whiteboard.Set(KeyManagedIdentityId, *res.ID)
+	rcgClient, err := fctx.factory.FirewallPolicyRuleCollectionGroup()
+	if err != nil {
+		return err
+	}
+	_, err = rcgClient.CreateOrUpdate(ctx, cfg.Policy.ResourceGroup, cfg.Policy.Name, egressFirewallRuleCollectionGroupName, *cfg.RuleCollectionGroupToProvider())
 	return err
 }
 
-// MigrateAvailabilitySet prepares an AS-based shoot to be migrated to VMSS-Flex.
-func (fctx *FlowContext) MigrateAvailabilitySet(ctx context.Context) error {
-	var (
-		log = shared.LogFromContext(ctx)
-		c   = fctx.client
-	)
-
-	// return early if the migration has already been complete
-	if v := fctx.whiteboard.GetChild(ChildKeyMigration).GetChild(KindAvailabilitySet.String()).Get(ChildKeyComplete); v != nil && *v == "true" {
-		return nil
-	}
-	// return early if the cluster does not have AS.
-	if fctx.whiteboard.GetChild(ChildKeyIDs).Get(KindAvailabilitySet.String()) == nil {
+func (fctx *FlowContext) deleteEgressFirewallPolicy(ctx context.Context) error {
+	policies := fctx.inventory.ByKind(KindFirewallPolicy)
+	if len(policies) == 0 {
 		return nil
 	}
 
-	// IF VMOs are not needed, or the migration is already done, return early.
-	if !helper.HasShootVmoMigrationAnnotation(fctx.cluster.Shoot.GetAnnotations()) {
-		return nil
+	c, err := fctx.factory.FirewallPolicy()
+	if err != nil {
+		return err
 	}
-
-	log.Info("Preparing for the migration to VMOs")
-	scaleDownDeployment := func(ctx context.Context, key k8sclient.ObjectKey) error {
-		log.Info("Scaling deployment to 0 replicas", "Name", key.String())
-		deployment := &appsv1.Deployment{}
-		if err := fctx.client.Get(ctx, k8sclient.ObjectKey{
-			Namespace: fctx.infra.Namespace,
-			Name:      azure.CloudControllerManagerName,
-		}, deployment); k8sclient.IgnoreNotFound(err) != nil {
+	for _, policy := range policies {
+		if err := c.Delete(ctx, policy.ResourceGroupName, policy.Name); err != nil {
 			return err
-		} else if err != nil && apierrors.IsNotFound(err) {
-			return nil
 		}
+		fctx.inventory.Delete(policy.String())
+	}
+	return nil
+}
 
-		if ptr.Deref(deployment.Spec.Replicas, 1) == 0 {
-			return nil
-		}
-		patch := k8sclient.MergeFrom(deployment.DeepCopy())
-		deployment.Spec.Replicas = ptr.To(int32(0))
-		// Apply the patch on the "scale" subresource
-		if err := c.SubResource("scale").Patch(ctx, deployment, patch); err != nil {
-			return fmt.Errorf("failed to patch deployment %s with replicas: %w", key.String(), err)
-		}
+// DeleteEgressFirewall deletes the Azure Firewall, the managed firewall policy and the subnets of the firewall after the
+// egress firewall was removed from the configuration. The public IPs of the firewall are deleted afterwards together
+// with all other public IPs which are not needed anymore.
+func (fctx *FlowContext) DeleteEgressFirewall(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+	if fctx.adapter.EgressFirewallConfig() != nil {
 		return nil
 	}
 
-	if err := flow.Parallel(func(ctx context.Context) error {
-		return scaleDownDeployment(ctx, k8sclient.ObjectKey{Namespace: fctx.infra.Namespace, Name: azure.CloudControllerManagerName})
-	}, func(ctx context.Context) error {
-		// we want to scale CA down to avoid VMs getting created as they may claim internal subnet IPs in the case of an existing internal loadbalancer.
-		return scaleDownDeployment(ctx, k8sclient.ObjectKey{Namespace: fctx.infra.Namespace, Name: "cluster-autoscaler"})
-	}).RetryUntilTimeout(5*time.Second, defaultTimeout)(ctx); err != nil {
+	firewallClient, err := fctx.factory.AzureFirewall()
+	if err != nil {
 		return err
 	}
-
-	log.Info("Backing-up Public IPs to be migrated")
-	// we will first back up the PIPs that we want to migrate. In the simplest case, we would only migrate PIPs in the shoot's RG. But the loadbalancer may reference PIPs from other RGs.
-	// If we delete the LB we would have no way to recover the PIPs in other RGs, hence we will back it up.
-	if err := fctx.BackupPIPsForBasicLBMigration(ctx); err != nil {
+	for _, firewall := range fctx.inventory.ByKind(KindAzureFirewall) {
+		log.Info("deleting egress firewall", "name", firewall.Name)
+		if err := firewallClient.Delete(ctx, firewall.ResourceGroupName, firewall.Name); err != nil {
+			return err
+		}
+		fctx.inventory.Delete(firewall.String())
+	}
+	if err := fctx.deleteEgressFirewallPolicy(ctx); err != nil {
 		return err
 	}
 
-	loadbalancerClient, err := fctx.factory.LoadBalancer()
+	subnetClient, err := fctx.factory.Subnet()
 	if err != nil {
 		return err
 	}
-	loadbalancerName := fctx.adapter.TechnicalName()
-	log.Info("Deleting load balancer", "Name", loadbalancerName)
-	if err := loadbalancerClient.Delete(ctx, fctx.adapter.ResourceGroupName(), loadbalancerName); err != nil {
-		return err
-	}
-
-	loadbalancerName = fmt.Sprintf("%s-internal", fctx.adapter.TechnicalName())
-	log.Info("Deleting internal load balancer", "Name", loadbalancerName)
-	if err := loadbalancerClient.Delete(ctx, fctx.adapter.ResourceGroupName(), loadbalancerName); err != nil {
-		return err
+	for _, subnet := range fctx.inventory.ByKind(KindSubnet) {
+		if !fctx.adapter.IsEgressFirewallSubnetName(subnet.Name) {
+			continue
+		}
+		log.Info("deleting egress firewall subnet", "name", subnet.Name)
+		if err := subnetClient.Delete(ctx, subnet.ResourceGroupName, subnet.Parent.Name, subnet.Name); err != nil {
+			return err
+		}
+		fctx.inventory.Delete(subnet.String())
+		fctx.whiteboard.GetChild(KindSubnet.String()).Delete(subnet.Name)
+	}
+
+	wb := fctx.whiteboard.GetChild(KindAzureFirewall.String())
+	wb.Delete(KeyPrivateIPAddress)
+	wb.DeleteObject(KeyPublicIPAddresses)
+	wb.DeleteObject(KeyEgressFirewallStatus)
+	return nil
+}
+
+// EnsureDiagnosticSettings reconciles the Azure Monitor diagnostic settings which send the logs and metrics of the NAT
+// gateways, the security group and the outbound load balancer to the configured Log Analytics workspace. Diagnostic
+// settings created earlier are deleted if the observability configuration was removed or the resource is not managed
+// anymore, as Azure keeps them even when the resource is deleted.
+func (fctx *FlowContext) EnsureDiagnosticSettings(ctx context.Context) error {
+	var (
+		joinError error
+		log       = shared.LogFromContext(ctx)
+		targets   = fctx.diagnosticSettingsTargets()
+		wb        = fctx.whiteboard.GetChild(ChildKeyDiagnosticSettings)
+	)
+
+	c, err := fctx.factory.DiagnosticSettings()
+	if err != nil {
+		return err
+	}
+
+	for resourceID, properties := range targets {
+		log.V(1).Info("reconciling diagnostic settings", "resource", resourceID)
+		if _, err := c.CreateOrUpdate(ctx, resourceID, diagnosticSettingsName, client.DiagnosticSettingsResource{Properties: properties}); err != nil {
+			joinError = errors.Join(joinError, err)
+			continue
+		}
+		wb.Set(resourceID, *properties.WorkspaceID)
+	}
+
+	for resourceID := range wb.AsMap() {
+		if _, ok := targets[resourceID]; ok {
+			continue
+		}
+		log.Info("deleting diagnostic settings", "resource", resourceID)
+		if err := c.Delete(ctx, resourceID, diagnosticSettingsName); err != nil {
+			joinError = errors.Join(joinError, err)
+			continue
+		}
+		wb.Delete(resourceID)
+	}
+
+	return joinError
+}
+
+// diagnosticSettingsTargets returns the diagnostic settings of the managed resources, indexed by the resource IDs.
+func (fctx *FlowContext) diagnosticSettingsTargets() map[string]*client.DiagnosticSettingsProperties {
+	targets := map[string]*client.DiagnosticSettingsProperties{}
+	if fctx.cfg.Observability == nil {
+		return targets
+	}
+
+	workspaceID := fctx.cfg.Observability.LogAnalyticsWorkspaceID
+	metrics := func() *client.DiagnosticSettingsProperties {
+		return &client.DiagnosticSettingsProperties{
+			WorkspaceID: to.Ptr(workspaceID),
+			Metrics:     []*client.MetricSettings{{Category: to.Ptr("AllMetrics"), Enabled: to.Ptr(true)}},
+		}
+	}
+
+	// security groups only provide logs, e.g. about the matched security rules.
+	if id := fctx.whiteboard.GetChild(ChildKeyIDs).Get(KindSecurityGroup.String()); id != nil {
+		targets[*id] = &client.DiagnosticSettingsProperties{
+			WorkspaceID: to.Ptr(workspaceID),
+			Logs:        []*client.LogSettings{{CategoryGroup: to.Ptr("allLogs"), Enabled: to.Ptr(true)}},
+		}
+	}
+	for name := range fctx.adapter.NatGatewayConfigs() {
+		targets[GetIdFromTemplate(TemplateNatGateway, fctx.auth.SubscriptionID, fctx.adapter.ResourceGroupName(), name)] = metrics()
+	}
+	if cfg := fctx.adapter.OutboundLoadBalancerConfig(); cfg != nil {
+		targets[GetIdFromTemplate(TemplateLoadBalancer, fctx.auth.SubscriptionID, cfg.ResourceGroup, cfg.Name)] = metrics()
+	}
+	return targets
+}
+
+// EnsureFlowLogs reconciles the Network Watcher flow logs of the worker subnets. Azure retires the flow logs of security
+// groups in favour of virtual network flow logs, which are created per worker subnet, so that they neither conflict with
+// flow logs of a foreign virtual network nor cover the traffic of other shoots in it. Flow logs are child resources of
+// the network watcher of the shoot's region, which Azure creates once per region and subscription in a separate
+// resource group. Hence, they are not deleted together with the shoot's resource group and are removed explicitly if
+// they are not configured anymore.
+func (fctx *FlowContext) EnsureFlowLogs(ctx context.Context) error {
+	var (
+		log      = shared.LogFromContext(ctx)
+		cfg      = fctx.cfg.Networks.FlowLogs
+		current  = fctx.whiteboard.GetChild(KindFlowLog.String())
+		subnets  []string
+		desired  = sets.New[string]()
+		subnetID = func(name string) *string { return fctx.whiteboard.GetChild(KindSubnet.String()).Get(name) }
+	)
+
+	if cfg != nil {
+		for _, z := range fctx.adapter.Zones() {
+			if subnetID(z.Subnet.Name) == nil {
+				return fmt.Errorf("failed to reconcile flow log: the ID of subnet %s is unknown", z.Subnet.Name)
+			}
+			subnets = append(subnets, z.Subnet.Name)
+			desired.Insert(z.Subnet.Name)
+		}
+	}
+
+	for _, name := range current.Keys() {
+		if desired.Has(name) {
+			continue
+		}
+		if id := current.Get(name); id != nil {
+			log.Info("deleting flow log", "id", *id)
+			if err := fctx.deleteFlowLog(ctx, name, *id); err != nil {
+				return err
+			}
+		}
+	}
+	if cfg == nil {
+		return nil
+	}
+
+	c, err := fctx.factory.NetworkWatcher()
+	if err != nil {
+		return err
+	}
+	watcher, err := fctx.networkWatcher(ctx, c)
+	if err != nil {
+		return err
+	}
+	watcherID, err := arm.ParseResourceID(*watcher.ID)
+	if err != nil {
+		return err
+	}
+
+	var flowAnalyticsConfiguration *armnetwork.TrafficAnalyticsProperties
+	if cfg.TrafficAnalytics != nil {
+		trafficAnalytics, err := fctx.trafficAnalyticsConfiguration(ctx, cfg.TrafficAnalytics.WorkspaceID, cfg.TrafficAnalytics.IntervalInMinutes)
+		if err != nil {
+			return err
+		}
+		flowAnalyticsConfiguration = &armnetwork.TrafficAnalyticsProperties{
+			NetworkWatcherFlowAnalyticsConfiguration: trafficAnalytics,
+		}
+	}
+
+	for _, name := range subnets {
+		flowLog := armnetwork.FlowLog{
+			Location: to.Ptr(fctx.adapter.Region()),
+			Properties: &armnetwork.FlowLogPropertiesFormat{
+				Enabled:          to.Ptr(true),
+				StorageID:        to.Ptr(cfg.StorageAccountID),
+				TargetResourceID: subnetID(name),
+				Format: &armnetwork.FlowLogFormatParameters{
+					Type:    to.Ptr(armnetwork.FlowLogFormatTypeJSON),
+					Version: to.Ptr[int32](2),
+				},
+				RetentionPolicy: &armnetwork.RetentionPolicyParameters{
+					Days:    to.Ptr(ptr.Deref(cfg.RetentionDays, 0)),
+					Enabled: to.Ptr(ptr.Deref(cfg.RetentionDays, 0) > 0),
+				},
+				FlowAnalyticsConfiguration: flowAnalyticsConfiguration,
+			},
+		}
+
+		log.Info("reconciling flow log", "networkWatcher", *watcher.ID, "name", name)
+		res, err := c.CreateOrUpdateFlowLog(ctx, watcherID.ResourceGroupName, watcherID.Name, name, flowLog)
+		if err != nil {
+			return err
+		}
+		current.Set(name, *res.ID)
+	}
+	return nil
+}
+
+// networkWatcher returns the network watcher of the shoot's region.
+func (fctx *FlowContext) networkWatcher(ctx context.Context, c client.NetworkWatcher) (*armnetwork.Watcher, error) {
+	watchers, err := c.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, watcher := range watchers {
+		if watcher.ID != nil && strings.EqualFold(ptr.Deref(watcher.Location, ""), fctx.adapter.Region()) {
+			return watcher, nil
+		}
+	}
+	return nil, fmt.Errorf("no network watcher found in region %q, it has to be enabled in the subscription to create flow logs", fctx.adapter.Region())
+}
+
+// trafficAnalyticsConfiguration returns the Traffic Analytics configuration of the flow log. Besides the resource ID,
+// Azure requires the GUID and the region of the Log Analytics workspace, which are read from the workspace.
+func (fctx *FlowContext) trafficAnalyticsConfiguration(ctx context.Context, workspaceID string, interval *int32) (*armnetwork.TrafficAnalyticsConfigurationProperties, error) {
+	c, err := fctx.factory.Resource()
+	if err != nil {
+		return nil, err
+	}
+	workspace, err := c.GetByID(ctx, workspaceID, logAnalyticsWorkspaceAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	if workspace == nil {
+		return nil, fmt.Errorf("log analytics workspace %q for traffic analytics not found", workspaceID)
+	}
+	properties, _ := workspace.Properties.(map[string]any)
+	customerID, _ := properties["customerId"].(string)
+	if customerID == "" {
+		return nil, fmt.Errorf("failed to determine the workspace id of log analytics workspace %q", workspaceID)
+	}
+
+	return &armnetwork.TrafficAnalyticsConfigurationProperties{
+		Enabled:                  to.Ptr(true),
+		TrafficAnalyticsInterval: to.Ptr(ptr.Deref(interval, defaultTrafficAnalyticsInterval)),
+		WorkspaceID:              to.Ptr(customerID),
+		WorkspaceRegion:          workspace.Location,
+		WorkspaceResourceID:      to.Ptr(workspaceID),
+	}, nil
+}
+
+func (fctx *FlowContext) deleteFlowLog(ctx context.Context, name, id string) error {
+	flowLogID, err := arm.ParseResourceID(id)
+	if err != nil {
+		return err
+	}
+
+	c, err := fctx.factory.NetworkWatcher()
+	if err != nil {
+		return err
+	}
+	if err := c.DeleteFlowLog(ctx, flowLogID.ResourceGroupName, flowLogID.Parent.Name, flowLogID.Name); err != nil {
+		return err
+	}
+	fctx.whiteboard.GetChild(KindFlowLog.String()).Delete(name)
+	return nil
+}
+
+// EnsureSubnets creates or updates subnets.
+func (fctx *FlowContext) EnsureSubnets(ctx context.Context) error {
+	return fctx.ensureSubnets(ctx)
+}
+
+func (fctx *FlowContext) ensureSubnets(ctx context.Context) (err error) {
+	var (
+		log         = shared.LogFromContext(ctx)
+		vnetRgroup  = fctx.adapter.VirtualNetworkConfig().ResourceGroup
+		vnetName    = fctx.adapter.VirtualNetworkConfig().Name
+		toDelete    = map[string]*armnetwork.Subnet{}
+		toReconcile = map[string]*armnetwork.Subnet{}
+		joinErr     error
+	)
+
+	c, err := fctx.factory.Subnet()
+	if err != nil {
+		return err
+	}
+
+	currentSubnets, err := c.List(ctx, vnetRgroup, vnetName)
+	if err != nil {
+		return err
+	}
+
+	// filteredSubnets are the subnets of this shoot. In a shared VNet scenario, it is not guaranteed that all subnets in the VNet belong to a particular shoot.
+	filteredSubnets := Filter(currentSubnets, func(s *armnetwork.Subnet) bool {
+		return fctx.adapter.IsOwnSubnetName(s.Name)
+	})
+	// mappedSubnets maps the unique subnet name to the subnet object.
+	mappedSubnets := ToMap(filteredSubnets, func(s *armnetwork.Subnet) string {
+		return *s.Name
+	})
+	// clean the current inventory and rebuild it.
+	for _, resource := range fctx.inventory.ByKind(KindSubnet) {
+		if _, ok := mappedSubnets[resource.Name]; !ok && !fctx.adapter.IsEgressFirewallSubnetName(resource.Name) {
+			log.Info("Removing subnet from inventory", "id", resource.String())
+			fctx.inventory.Delete(resource.String())
+		}
+	}
+
+	zones := fctx.adapter.Zones()
+	for _, z := range zones {
+		actual, err := fctx.desiredSubnet(z, mappedSubnets[z.Subnet.Name])
+		if err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		toReconcile[z.Subnet.Name] = actual
+	}
+
+	for name, current := range mappedSubnets {
+		if err := fctx.inventory.Insert(*current.ID); err != nil {
+			return err
+		}
+
+		target, ok := toReconcile[name]
+		if !ok {
+			log.Info("Will delete subnet because it is not needed", "Resource Group", vnetRgroup, "Name", *current.Name)
+			toDelete[name] = current
+			continue
+		}
+		if ok, offender, v := ForceNewSubnet(current, target); ok {
+			log.Info("Will delete subnet because it cannot be reconciled", "Resource Group", vnetRgroup, "Name", *current.Name, "Field", offender, "Value", v)
+			toDelete[name] = current
+			continue
+		}
+	}
+
+	for name, subnet := range toDelete {
+		err := c.Delete(ctx, vnetRgroup, vnetName, name)
+		if err != nil {
+			joinErr = errors.Join(joinErr, err)
+		}
+		fctx.inventory.Delete(*subnet.ID)
+		fctx.whiteboard.GetChild(KindNatGateway.String()).Set(name, *subnet.ID)
+	}
+	if joinErr != nil {
+		return joinErr
+	}
+
+	for name, subnet := range toReconcile {
+		subnet, err = c.CreateOrUpdate(ctx, vnetRgroup, vnetName, name, *subnet)
+		if err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		if err := fctx.inventory.Insert(*subnet.ID); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		fctx.whiteboard.GetChild(KindSubnet.String()).Set(name, *subnet.ID)
+		if subnet.Properties.NatGateway != nil && subnet.Properties.NatGateway.ID != nil {
+			fctx.whiteboard.GetChild(KindSubnet.String()).GetChild(KindNatGateway.String()).Set("id", *subnet.Properties.NatGateway.ID)
+		}
+		if subnet.Properties.NetworkSecurityGroup != nil && subnet.Properties.NetworkSecurityGroup.ID != nil {
+			fctx.whiteboard.GetChild(KindSubnet.String()).GetChild(KindSecurityGroup.String()).Set(name, *subnet.Properties.NetworkSecurityGroup.ID)
+		}
+	}
+
+	return joinErr
+}
+
+// desiredSubnet returns the desired state of the subnet of the given zone based on its current state.
+func (fctx *FlowContext) desiredSubnet(z ZoneConfig, current *armnetwork.Subnet) (*armnetwork.Subnet, error) {
+	actual := z.Subnet.ToProvider(current)
+
+	rtCfg := fctx.adapter.RouteTableConfig()
+	actual.Properties.RouteTable = &armnetwork.RouteTable{ID: to.Ptr(GetIdFromTemplate(TemplateRouteTable, fctx.auth.SubscriptionID, rtCfg.ResourceGroup, rtCfg.Name))}
+
+	if externalID := z.Subnet.externalSecurityGroupID; externalID != nil {
+		// the subnet carries a centrally managed security group. Only associate it if the subnet does not already reference it,
+		// to not fight over the association with whoever manages it.
+		if actual.Properties.NetworkSecurityGroup == nil ||
+			actual.Properties.NetworkSecurityGroup.ID == nil ||
+			!strings.EqualFold(*actual.Properties.NetworkSecurityGroup.ID, *externalID) {
+			actual.Properties.NetworkSecurityGroup = &armnetwork.SecurityGroup{ID: to.Ptr(*externalID)}
+		}
+	} else {
+		sgCfg := fctx.adapter.SecurityGroupConfig()
+		actual.Properties.NetworkSecurityGroup = &armnetwork.SecurityGroup{ID: to.Ptr(GetIdFromTemplate(TemplateSecurityGroup, fctx.auth.SubscriptionID, sgCfg.ResourceGroup, sgCfg.Name))}
+	}
+
+	if z.NatGateway != nil {
+		actual.Properties.NatGateway = &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplateNatGateway, fctx.auth.SubscriptionID, z.NatGateway.ResourceGroup, z.NatGateway.Name))}
+	} else {
+		// let's allow users to override the NAT Gateway config for a subnet, if that NGW is not managed by gardener.
+		// It should only apply for existing subnets, hence we also check if actual.ID is not nil.
+		if actual.ID != nil &&
+			actual.Properties != nil &&
+			actual.Properties.NatGateway != nil &&
+			actual.Properties.NatGateway.ID != nil {
+			resourceId, err := arm.ParseResourceID(*actual.Properties.NatGateway.ID)
+			if err != nil {
+				return nil, err
+			}
+			// if this is a user-managed NAT gateway, do nothing. This is checked by looking at the resource group of the NGW.
+			// In case that the NGW belongs to our RG, but it should not exist (z.NatGateway == nil), we remove the association.
+			if resourceId.ResourceGroupName == fctx.adapter.ResourceGroupName() {
+				actual.Properties.NatGateway = nil
+			}
+		}
+	}
+	return actual, nil
+}
+
+// EnsurePodSubnet creates or updates the dedicated subnet for pods. Existing subnets referenced by name are only
+// looked up but not modified.
+func (fctx *FlowContext) EnsurePodSubnet(ctx context.Context) error {
+	var (
+		log        = shared.LogFromContext(ctx)
+		cfg        = fctx.adapter.PodSubnetConfig()
+		vnetRgroup = fctx.adapter.VirtualNetworkConfig().ResourceGroup
+		vnetName   = fctx.adapter.VirtualNetworkConfig().Name
+	)
+	if cfg == nil {
+		return nil
+	}
+
+	c, err := fctx.factory.Subnet()
+	if err != nil {
+		return err
+	}
+
+	current, err := c.Get(ctx, vnetRgroup, vnetName, cfg.Name, nil)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.Managed {
+		if current == nil {
+			return fmt.Errorf("failed to locate pod subnet %s in vnet %s/%s", cfg.Name, vnetRgroup, vnetName)
+		}
+		fctx.whiteboard.GetChild(KindSubnet.String()).Set(cfg.Name, *current.ID)
+		return nil
+	}
+
+	target := cfg.ToProvider(current)
+	sgCfg := fctx.adapter.SecurityGroupConfig()
+	target.Properties.NetworkSecurityGroup = &armnetwork.SecurityGroup{ID: to.Ptr(GetIdFromTemplate(TemplateSecurityGroup, fctx.auth.SubscriptionID, sgCfg.ResourceGroup, sgCfg.Name))}
+	// pods egress with their own IPs, hence they need the same outbound access as the nodes. As a subnet can only be
+	// associated with one NAT gateway, this is only possible for the single subnet layout.
+	if zones := fctx.adapter.Zones(); len(zones) == 1 && zones[0].NatGateway != nil {
+		target.Properties.NatGateway = &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplateNatGateway, fctx.auth.SubscriptionID, zones[0].NatGateway.ResourceGroup, zones[0].NatGateway.Name))}
+	}
+
+	log.Info("Reconciling pod subnet", "Resource Group", vnetRgroup, "Name", cfg.Name)
+	subnet, err := c.CreateOrUpdate(ctx, vnetRgroup, vnetName, cfg.Name, *target)
+	if err != nil {
+		return err
+	}
+	if err := fctx.inventory.Insert(*subnet.ID); err != nil {
+		return err
+	}
+	fctx.whiteboard.GetChild(KindSubnet.String()).Set(cfg.Name, *subnet.ID)
+	return nil
+}
+
+// EnsureManagedIdentity reconciles the managed identity specificed in the config. If no identity is referenced but
+// the creation of one is requested, a user-assigned identity is created in the auxiliary resources region.
+func (fctx *FlowContext) EnsureManagedIdentity(ctx context.Context) (err error) {
+	if fctx.cfg.Identity == nil {
+		if !fctx.adapter.IsManagedIdentityRequired() {
+			return nil
+		}
+		return fctx.ensureCreatedManagedIdentity(ctx)
+	}
+
+	c, err := fctx.factory.ManagedUserIdentity(client.ManagedUserIdentityOptions(fctx.cfg.Identity)...)
+	if err != nil {
+		return err
+	}
+	res, err := c.Get(ctx, fctx.cfg.Identity.ResourceGroup, fctx.cfg.Identity.Name)
+	if err != nil {
+		return err
+	}
+	if res.ID == nil || res.Properties.ClientID == nil {
+		return nil
+	}
+
+	fctx.whiteboard.Set(KeyManagedIdentityClientId, *res.Properties.ClientID)
+	fctx.whiteboard.Set(KeyManagedIdentityId, *res.ID)
+	return err
+}
+
+func (fctx *FlowContext) ensureCreatedManagedIdentity(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+	cfg := fctx.adapter.ManagedIdentityConfig()
+
+	c, err := fctx.factory.ManagedUserIdentity()
+	if err != nil {
+		return err
+	}
+	res, err := c.Get(ctx, cfg.ResourceGroup, cfg.Name)
+	if err != nil {
+		return err
+	}
+
+	identity := ptr.To(armmsi.Identity{Location: to.Ptr(cfg.Location)})
+	if res != nil {
+		if location := ptr.Deref(res.Location, ""); !strings.EqualFold(location, cfg.Location) {
+			return fmt.Errorf("managed identity %s already exists in region %s and cannot be moved to region %s", cfg.Name, location, cfg.Location)
+		}
+		identity = &res.Identity
+	} else {
+		log.Info("Creating managed identity", "Name", cfg.Name, "Region", cfg.Location)
+		if identity, err = c.CreateOrUpdate(ctx, cfg.ResourceGroup, cfg.Name, *identity); err != nil {
+			return err
+		}
+	}
+	if identity.ID == nil || identity.Properties == nil || identity.Properties.ClientID == nil {
+		return fmt.Errorf("managed identity %s is missing its ID or client ID", cfg.Name)
+	}
+
+	if err := fctx.inventory.Insert(*identity.ID); err != nil {
+		return err
+	}
+	fctx.whiteboard.Set(KeyManagedIdentityClientId, *identity.Properties.ClientID)
+	fctx.whiteboard.Set(KeyManagedIdentityId, *identity.ID)
+	return nil
+}
+
+// EnsureZoneMappings records the mapping of the logical to the physical availability zones of the region in the
+// subscription. The mapping of a subscription does not change, hence it is only read as long as it is not part of the
+// infrastructure status yet. The mapping is only informational, hence failures to read it are logged instead of failing
+// the reconciliation.
+func (fctx *FlowContext) EnsureZoneMappings(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	if zoneMappings := fctx.reportedZoneMappings(); len(zoneMappings) > 0 {
+		fctx.whiteboard.SetObject(KeyZoneMappings, zoneMappings)
+		return nil
+	}
+
+	c, err := fctx.factory.Locations()
+	if err != nil {
+		return err
+	}
+	mappings, err := c.AvailabilityZoneMappings(ctx, fctx.adapter.Region())
+	if err != nil {
+		log.Error(err, "Failed to read the availability zone mappings of the subscription", "Region", fctx.adapter.Region())
+		return nil
+	}
+
+	zoneMappings := make([]v1alpha1.ZoneMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		if mapping == nil || mapping.LogicalZone == nil || mapping.PhysicalZone == nil {
+			continue
+		}
+		zoneMappings = append(zoneMappings, v1alpha1.ZoneMapping{LogicalZone: *mapping.LogicalZone, PhysicalZone: *mapping.PhysicalZone})
+	}
+	slices.SortFunc(zoneMappings, func(a, b v1alpha1.ZoneMapping) int {
+		return strings.Compare(a.LogicalZone, b.LogicalZone)
+	})
+
+	fctx.whiteboard.SetObject(KeyZoneMappings, zoneMappings)
+	return nil
+}
+
+// reportedZoneMappings returns the availability zone mappings of the current infrastructure status.
+func (fctx *FlowContext) reportedZoneMappings() []v1alpha1.ZoneMapping {
+	if fctx.infra.Status.ProviderStatus == nil {
+		return nil
+	}
+	status, err := helper.InfrastructureStatusFromRaw(fctx.infra.Status.ProviderStatus)
+	if err != nil {
+		return nil
+	}
+
+	zoneMappings := make([]v1alpha1.ZoneMapping, 0, len(status.ZoneMappings))
+	for _, mapping := range status.ZoneMappings {
+		zoneMappings = append(zoneMappings, v1alpha1.ZoneMapping{LogicalZone: mapping.LogicalZone, PhysicalZone: mapping.PhysicalZone})
+	}
+	return zoneMappings
+}
+
+// EnsureBootDiagnosticsStorageAccount reconciles the storage account used for the boot diagnostics of the worker nodes.
+func (fctx *FlowContext) EnsureBootDiagnosticsStorageAccount(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+	cfg := fctx.adapter.BootDiagnosticsStorageAccountConfig()
+
+	c, err := fctx.factory.StorageAccount()
+	if err != nil {
+		return err
+	}
+
+	account, err := c.Get(ctx, cfg.ResourceGroup, cfg.Name)
+	if err != nil {
+		return err
+	}
+
+	if account == nil {
+		sku := armstorage.SKUNameStandardLRS
+		if cfg.ZoneRedundant {
+			sku = armstorage.SKUNameStandardZRS
+		}
+
+		log.Info("Creating storage account for boot diagnostics", "Name", cfg.Name, "Region", cfg.Location, "SKU", sku)
+		if err := c.CreateStorageAccount(ctx, cfg.ResourceGroup, cfg.Name, cfg.Location, sku, client.StorageAccountSecurity{}); err != nil {
+			return err
+		}
+		if account, err = c.Get(ctx, cfg.ResourceGroup, cfg.Name); err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("storage account %s not found after creation", cfg.Name)
+		}
+	} else if location := ptr.Deref(account.Location, ""); !strings.EqualFold(location, cfg.Location) {
+		return fmt.Errorf("storage account %s already exists in region %s and cannot be moved to region %s", cfg.Name, location, cfg.Location)
+	}
+
+	if err := fctx.inventory.Insert(*account.ID); err != nil {
+		return err
+	}
+	fctx.whiteboard.GetChild(ChildKeyIDs).Set(KindStorageAccount.String(), *account.ID)
+
+	wb := fctx.whiteboard.GetChild(ChildKeyBootDiagnostics)
+	wb.Set(KeyStorageAccountName, cfg.Name)
+	wb.Set(KeyRegion, cfg.Location)
+	wb.Set(KeyZoneRedundant, strconv.FormatBool(account.SKU != nil && ptr.Deref(account.SKU.Name, "") == armstorage.SKUNameStandardZRS))
+	if account.Properties != nil && account.Properties.PrimaryEndpoints != nil && account.Properties.PrimaryEndpoints.Blob != nil {
+		wb.Set(KeyStorageURI, *account.Properties.PrimaryEndpoints.Blob)
+	}
+
+	return nil
+}
+
+// MigrateAvailabilitySet prepares an AS-based shoot to be migrated to VMSS-Flex.
+func (fctx *FlowContext) MigrateAvailabilitySet(ctx context.Context) error {
+	var (
+		log = shared.LogFromContext(ctx)
+		c   = fctx.client
+	)
+
+	// only advance a staged migration if the preparation has already been complete
+	if v := fctx.whiteboard.GetChild(ChildKeyMigration).GetChild(KindAvailabilitySet.String()).Get(ChildKeyComplete); v != nil && *v == "true" {
+		return fctx.advanceStagedAvailabilitySetMigration(ctx)
+	}
+	// return early if the cluster does not have AS.
+	if fctx.whiteboard.GetChild(ChildKeyIDs).Get(KindAvailabilitySet.String()) == nil {
+		return nil
+	}
+
+	// IF VMOs are not needed, or the migration is already done, return early.
+	if !helper.HasShootVmoMigrationAnnotation(fctx.cluster.Shoot.GetAnnotations()) {
+		return nil
+	}
+
+	// the load balancers are deleted for the migration and recreated by the cloud-controller-manager afterwards, which does
+	// not know about configurations added by other parties.
+	if err := fctx.EnsureLoadBalancersManagedByCCM(ctx, fctx.adapter.TechnicalName(), fmt.Sprintf("%s-internal", fctx.adapter.TechnicalName())); err != nil {
+		return err
+	}
+
+	log.Info("Preparing for the migration to VMOs")
+	scaleDownDeployment := func(ctx context.Context, key k8sclient.ObjectKey) error {
+		log.Info("Scaling deployment to 0 replicas", "Name", key.String())
+		deployment := &appsv1.Deployment{}
+		if err := fctx.client.Get(ctx, k8sclient.ObjectKey{
+			Namespace: fctx.infra.Namespace,
+			Name:      azure.CloudControllerManagerName,
+		}, deployment); k8sclient.IgnoreNotFound(err) != nil {
+			return err
+		} else if err != nil && apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		if ptr.Deref(deployment.Spec.Replicas, 1) == 0 {
+			return nil
+		}
+		patch := k8sclient.MergeFrom(deployment.DeepCopy())
+		deployment.Spec.Replicas = ptr.To(int32(0))
+		// Apply the patch on the "scale" subresource
+		if err := c.SubResource("scale").Patch(ctx, deployment, patch); err != nil {
+			return fmt.Errorf("failed to patch deployment %s with replicas: %w", key.String(), err)
+		}
+		return nil
+	}
+
+	if err := flow.Parallel(func(ctx context.Context) error {
+		return scaleDownDeployment(ctx, k8sclient.ObjectKey{Namespace: fctx.infra.Namespace, Name: azure.CloudControllerManagerName})
+	}, func(ctx context.Context) error {
+		// we want to scale CA down to avoid VMs getting created as they may claim internal subnet IPs in the case of an existing internal loadbalancer.
+		return scaleDownDeployment(ctx, k8sclient.ObjectKey{Namespace: fctx.infra.Namespace, Name: "cluster-autoscaler"})
+	}).RetryUntilTimeout(5*time.Second, defaultTimeout)(ctx); err != nil {
+		return err
+	}
+
+	log.Info("Backing-up Public IPs to be migrated")
+	// we will first back up the PIPs that we want to migrate. In the simplest case, we would only migrate PIPs in the shoot's RG. But the loadbalancer may reference PIPs from other RGs.
+	// If we delete the LB we would have no way to recover the PIPs in other RGs, hence we will back it up.
+	if err := fctx.BackupPIPsForBasicLBMigration(ctx); err != nil {
+		return err
+	}
+
+	loadbalancerClient, err := fctx.factory.LoadBalancer()
+	if err != nil {
+		return err
+	}
+	loadbalancerName := fctx.adapter.TechnicalName()
+	log.Info("Deleting load balancer", "Name", loadbalancerName)
+	if err := loadbalancerClient.Delete(ctx, fctx.adapter.ResourceGroupName(), loadbalancerName); err != nil {
+		return err
+	}
+
+	loadbalancerName = fmt.Sprintf("%s-internal", fctx.adapter.TechnicalName())
+	log.Info("Deleting internal load balancer", "Name", loadbalancerName)
+	if err := loadbalancerClient.Delete(ctx, fctx.adapter.ResourceGroupName(), loadbalancerName); err != nil {
+		return err
 	}
 
 	if err := fctx.UpdatePublicIPs(ctx); err != nil {
 		return err
 	}
 	fctx.whiteboard.GetChild(ChildKeyMigration).GetChild(KindAvailabilitySet.String()).Set(ChildKeyComplete, "true")
+	if err := fctx.advanceStagedAvailabilitySetMigration(ctx); err != nil {
+		return err
+	}
 	return fctx.PersistState(ctx)
 }
 
+// advanceStagedAvailabilitySetMigration releases the worker pools for a staged migration to VMOs one after another.
+// The next worker pool is only released once the availability set does not contain any machines of the already
+// released worker pools, so that the machine-controller-manager rolls a single worker pool at a time. Worker pools
+// without machines in the availability set are released together with the next worker pool, as there is nothing to
+// roll for them.
+func (fctx *FlowContext) advanceStagedAvailabilitySetMigration(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+	if !helper.HasShootStagedVmoMigrationAnnotation(fctx.cluster.Shoot.GetAnnotations()) {
+		return nil
+	}
+	if fctx.whiteboard.GetChild(ChildKeyIDs).Get(KindAvailabilitySet.String()) == nil {
+		return nil
+	}
+
+	asClient, err := fctx.factory.AvailabilitySet()
+	if err != nil {
+		return err
+	}
+	cfg := fctx.adapter.AvailabilitySetConfig()
+	av, err := asClient.Get(ctx, cfg.ResourceGroup, cfg.Name)
+	if err != nil {
+		return err
+	}
+	if av == nil {
+		return nil
+	}
+
+	var virtualMachines []string
+	if av.Properties != nil {
+		for _, vm := range av.Properties.VirtualMachines {
+			if vm == nil || vm.ID == nil {
+				continue
+			}
+			id, err := arm.ParseResourceID(*vm.ID)
+			if err != nil {
+				return err
+			}
+			virtualMachines = append(virtualMachines, id.Name)
+		}
+	}
+
+	wb := fctx.whiteboard.GetChild(ChildKeyMigration).GetChild(KindAvailabilitySet.String())
+	wb.Set(KeyRemainingVirtualMachines, strconv.Itoa(len(virtualMachines)))
+
+	var (
+		released  = wb.GetChild(ChildKeyWorkerPools)
+		poolNames []string
+	)
+	for _, worker := range fctx.cluster.Shoot.Spec.Provider.Workers {
+		poolNames = append(poolNames, worker.Name)
+	}
+	poolsInAvailabilitySet := sets.New[string]()
+	for _, vm := range virtualMachines {
+		if pool := workerPoolOfVirtualMachine(fctx.infra.Namespace, poolNames, vm); pool != "" {
+			poolsInAvailabilitySet.Insert(pool)
+		}
+	}
+	for _, pool := range poolNames {
+		if released.Get(pool) != nil && poolsInAvailabilitySet.Has(pool) {
+			log.Info("Waiting for the machines of the worker pool to be rolled to VMOs", "workerPool", pool, "remainingVirtualMachines", len(virtualMachines))
+			return nil
+		}
+	}
+	for _, pool := range poolNames {
+		if released.Get(pool) != nil {
+			continue
+		}
+		log.Info("Releasing worker pool for the migration to VMOs", "workerPool", pool)
+		released.Set(pool, "true")
+		if poolsInAvailabilitySet.Has(pool) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// workerPoolOfVirtualMachine returns the name of the worker pool the virtual machine belongs to. The names of the
+// virtual machines start with the name of the machine deployment, i.e. "<namespace>-<pool>-". If several worker pools
+// match, the one with the longest name is returned.
+func workerPoolOfVirtualMachine(namespace string, poolNames []string, vmName string) string {
+	var result string
+	for _, pool := range poolNames {
+		if strings.HasPrefix(strings.ToLower(vmName), strings.ToLower(fmt.Sprintf("%s-%s-", namespace, pool))) && len(pool) > len(result) {
+			result = pool
+		}
+	}
+	return result
+}
+
+// externalSecurityGroup returns the status of a security group which is managed outside of Gardener. The name is taken
+// from the given ID if it can be parsed.
+func externalSecurityGroup(id string) v1alpha1.SecurityGroup {
+	sg := v1alpha1.SecurityGroup{
+		Purpose: v1alpha1.PurposeNodes,
+		ID:      id,
+	}
+	if resourceID, err := arm.ParseResourceID(id); err == nil {
+		sg.Name = resourceID.Name
+	}
+	return sg
+}
+
+func (fctx *FlowContext) availabilitySetMigrationStatus() *v1alpha1.AvailabilitySetMigrationStatus {
+	var (
+		wb       = fctx.whiteboard.GetChild(ChildKeyMigration).GetChild(KindAvailabilitySet.String())
+		released = wb.GetChild(ChildKeyWorkerPools)
+		status   = &v1alpha1.AvailabilitySetMigrationStatus{}
+	)
+	for _, worker := range fctx.cluster.Shoot.Spec.Provider.Workers {
+		if released.Get(worker.Name) != nil {
+			status.MigratedWorkerPools = append(status.MigratedWorkerPools, worker.Name)
+		}
+	}
+	if v := wb.Get(KeyRemainingVirtualMachines); v != nil {
+		if remaining, err := strconv.ParseInt(*v, 10, 32); err == nil {
+			status.RemainingVirtualMachines = int32(remaining)
+		}
+	}
+	return status
+}
+
 // GetInfrastructureStatus returns the infrastructure status.
 func (fctx *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.InfrastructureStatus, error) {
 	status := &v1alpha1.InfrastructureStatus{
@@ -849,12 +1786,16 @@ func (fctx *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.I
 			{
 				Purpose: v1alpha1.PurposeNodes,
 				Name:    fctx.adapter.RouteTableConfig().Name,
+				ID:      ptr.Deref(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KindRouteTable.String()), ""),
+				Managed: true,
 			},
 		},
 		SecurityGroups: []v1alpha1.SecurityGroup{
 			{
 				Purpose: v1alpha1.PurposeNodes,
 				Name:    fctx.adapter.SecurityGroupConfig().Name,
+				ID:      ptr.Deref(fctx.whiteboard.GetChild(ChildKeyIDs).Get(KindSecurityGroup.String()), ""),
+				Managed: true,
 			},
 		},
 		Zoned: fctx.cfg.Zoned,
@@ -878,15 +1819,59 @@ func (fctx *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.I
 			Migrated: z.Migrated,
 		}
 		subnet.NatGatewayID = fctx.whiteboard.GetChild(KindSubnet.String()).GetChild(KindNatGateway.String()).Get("id")
+		subnet.SecurityGroupID = fctx.whiteboard.GetChild(KindSubnet.String()).GetChild(KindSecurityGroup.String()).Get(z.Subnet.Name)
 		if subnet.NatGatewayID == nil {
 			// if at least one of the "zones" does not have NATGateway enabled, mark the outbound access as OutboundAccessTypeLoadBalancer.
 			outboundAccessType = v1alpha1.OutboundAccessTypeLoadBalancer
 		}
 
+		subnet.ID = fctx.whiteboard.GetChild(KindSubnet.String()).Get(z.Subnet.Name)
+
 		status.Networks.Subnets = append(status.Networks.Subnets, subnet)
+
+		if id := z.Subnet.externalSecurityGroupID; id != nil && !slices.ContainsFunc(status.SecurityGroups, func(sg v1alpha1.SecurityGroup) bool {
+			return strings.EqualFold(sg.ID, *id)
+		}) {
+			status.SecurityGroups = append(status.SecurityGroups, externalSecurityGroup(*id))
+		}
+	}
+	if fctx.cfg.Networks.OutboundAccess != nil {
+		outboundAccessType = v1alpha1.OutboundAccessTypeUserDefinedRouting
+	}
+	if fctx.adapter.OutboundLoadBalancerConfig() != nil {
+		outboundAccessType = v1alpha1.OutboundAccessTypeLoadBalancer
+	}
+	if fctx.adapter.EgressFirewallConfig() != nil {
+		outboundAccessType = v1alpha1.OutboundAccessTypeUserDefinedRouting
 	}
 	status.Networks.OutboundAccessType = outboundAccessType
 
+	if firewallWb := fctx.whiteboard.GetChild(KindAzureFirewall.String()); firewallWb.HasObject(KeyEgressFirewallStatus) {
+		if firewallStatus, ok := firewallWb.GetObject(KeyEgressFirewallStatus).(v1alpha1.EgressFirewallStatus); ok {
+			status.Networks.EgressFirewall = &firewallStatus
+		}
+	}
+
+	if lbWb := fctx.whiteboard.GetChild(KindLoadBalancer.String()); lbWb.HasObject(KeyOutboundLoadBalancerStatus) {
+		if lbStatus, ok := lbWb.GetObject(KeyOutboundLoadBalancerStatus).(v1alpha1.OutboundLoadBalancerStatus); ok {
+			status.Networks.OutboundLoadBalancer = &lbStatus
+		}
+	}
+
+	if natWb := fctx.whiteboard.GetChild(KindNatGateway.String()); natWb.HasObject(KeyNatGatewayStatuses) {
+		if natGateways, ok := natWb.GetObject(KeyNatGatewayStatuses).([]v1alpha1.NatGatewayStatus); ok && len(natGateways) > 0 {
+			status.Networks.NatGateways = natGateways
+		}
+	}
+
+	if podSubnetCfg := fctx.adapter.PodSubnetConfig(); podSubnetCfg != nil {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:    podSubnetCfg.Name,
+			Purpose: v1alpha1.PurposePods,
+			ID:      fctx.whiteboard.GetChild(KindSubnet.String()).Get(podSubnetCfg.Name),
+		})
+	}
+
 	if fctx.whiteboard.GetChild(ChildKeyIDs).Get(KindAvailabilitySet.String()) != nil {
 		cfg := fctx.adapter.AvailabilitySetConfig()
 		status.AvailabilitySets = []v1alpha1.AvailabilitySet{
@@ -900,14 +1885,26 @@ func (fctx *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.I
 		}
 		if v := fctx.whiteboard.GetChild(ChildKeyMigration).GetChild(KindAvailabilitySet.String()).Get(ChildKeyComplete); v != nil && *v == "true" {
 			status.MigratingToVMO = true
+			if helper.HasShootStagedVmoMigrationAnnotation(fctx.cluster.Shoot.GetAnnotations()) {
+				status.AvailabilitySetMigration = fctx.availabilitySetMigrationStatus()
+			}
+		}
+	}
+
+	status.Identity = fctx.identityStatus()
+
+	if fctx.whiteboard.HasObject(KeyZoneMappings) {
+		if zoneMappings, ok := fctx.whiteboard.GetObject(KeyZoneMappings).([]v1alpha1.ZoneMapping); ok && len(zoneMappings) > 0 {
+			status.ZoneMappings = zoneMappings
 		}
 	}
 
-	if identity := fctx.cfg.Identity; identity != nil {
-		status.Identity = &v1alpha1.IdentityStatus{
-			ID:        *fctx.whiteboard.Get(KeyManagedIdentityId),
-			ClientID:  *fctx.whiteboard.Get(KeyManagedIdentityClientId),
-			ACRAccess: identity.ACRAccess != nil && *identity.ACRAccess,
+	if wb := fctx.whiteboard.GetChild(ChildKeyBootDiagnostics); fctx.adapter.IsBootDiagnosticsStorageAccountRequired() && wb.Get(KeyStorageURI) != nil {
+		status.BootDiagnostics = &v1alpha1.BootDiagnosticsStatus{
+			StorageAccountName: ptr.Deref(wb.Get(KeyStorageAccountName), ""),
+			StorageURI:         *wb.Get(KeyStorageURI),
+			Region:             ptr.Deref(wb.Get(KeyRegion), ""),
+			ZoneRedundant:      ptr.Deref(wb.Get(KeyZoneRedundant), "") == "true",
 		}
 	}
 
@@ -920,6 +1917,7 @@ func (fctx *FlowContext) GetInfrastructureState() *runtime.RawExtension {
 		TypeMeta:     helper.InfrastructureStateTypeMeta,
 		ManagedItems: fctx.inventory.ToList(),
 		Data:         fctx.whiteboard.ExportAsFlatMap(),
+		Steps:        fctx.steps.export(),
 	}
 
 	return &runtime.RawExtension{
@@ -929,29 +1927,49 @@ func (fctx *FlowContext) GetInfrastructureState() *runtime.RawExtension {
 
 // GetEgressIpCidrs retrieves the CIDRs of the IP ranges used for egress from the FlowContext
 func (fctx *FlowContext) GetEgressIpCidrs() []string {
-	if fctx.whiteboard.HasChild(KindNatGateway.String()) && fctx.whiteboard.GetChild(KindNatGateway.String()).HasObject(KeyPublicIPAddresses) {
-		ipAddresses, ok := fctx.whiteboard.GetChild(KindNatGateway.String()).GetObject(KeyPublicIPAddresses).([]string)
+	var cidrs []string
+	for _, kind := range []AzureResourceKind{KindNatGateway, KindLoadBalancer, KindAzureFirewall} {
+		if !fctx.whiteboard.HasChild(kind.String()) || !fctx.whiteboard.GetChild(kind.String()).HasObject(KeyPublicIPAddresses) {
+			continue
+		}
+		ipAddresses, ok := fctx.whiteboard.GetChild(kind.String()).GetObject(KeyPublicIPAddresses).([]string)
 		if !ok {
-			return nil
+			continue
+		}
+		if cidrs == nil {
+			cidrs = []string{}
 		}
-		cidrs := []string{}
 		for _, address := range ipAddresses {
 			cidrs = append(cidrs, address+"/32")
 		}
-		return cidrs
 	}
-	return nil
+	return cidrs
 }
 
 func (fctx *FlowContext) enrichStatusWithIdentity(_ context.Context, status *v1alpha1.InfrastructureStatus) error {
-	if identity := fctx.cfg.Identity; identity != nil {
-		status.Identity = &v1alpha1.IdentityStatus{
-			ID:        *fctx.whiteboard.Get(KeyManagedIdentityId),
-			ClientID:  *fctx.whiteboard.Get(KeyManagedIdentityClientId),
-			ACRAccess: identity.ACRAccess != nil && *identity.ACRAccess,
+	status.Identity = fctx.identityStatus()
+	return nil
+}
+
+// identityStatus returns the status of the referenced or created managed identity of the worker nodes.
+func (fctx *FlowContext) identityStatus() *v1alpha1.IdentityStatus {
+	id, clientID := fctx.whiteboard.Get(KeyManagedIdentityId), fctx.whiteboard.Get(KeyManagedIdentityClientId)
+	identity := fctx.cfg.Identity
+	if identity == nil && (!fctx.adapter.IsManagedIdentityRequired() || id == nil || clientID == nil) {
+		return nil
+	}
+
+	status := &v1alpha1.IdentityStatus{
+		ID:       ptr.Deref(id, ""),
+		ClientID: ptr.Deref(clientID, ""),
+	}
+	if identity != nil {
+		status.ACRAccess = identity.ACRAccess != nil && *identity.ACRAccess
+		if status.ACRAccess && identity.ACRAccessMode != nil {
+			status.ACRAccessMode = ptr.To(v1alpha1.ACRAccessMode(*identity.ACRAccessMode))
 		}
 	}
-	return nil
+	return status
 }
 
 // DeleteResourceGroup deletes the shoot's resource group.
@@ -995,6 +2013,171 @@ func (fctx *FlowContext) DeleteSubnetsInForeignGroup(ctx context.Context) error
 	return joinErr
 }
 
+// EnsureNoManagementLocks checks the shoot's resource group and the contained resources for management locks, which
+// would block the deletion of the infrastructure. Locks which were created by the extension itself are removed if this is
+// enabled in the configuration. Any other lock has to be removed by its owner, hence they are reported in an error.
+func (fctx *FlowContext) EnsureNoManagementLocks(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	c, err := fctx.factory.ManagementLocks()
+	if err != nil {
+		return err
+	}
+
+	locks, err := c.ListAtResourceGroupLevel(ctx, fctx.adapter.ResourceGroupName())
+	if err != nil {
+		// credentials created before the check was introduced may lack the permission to read locks, in this case the
+		// deletion proceeds as before and fails on the locked resources.
+		if client.IsAzureAPIForbidden(err) {
+			log.Info("skipping check of management locks as the credentials are not allowed to read them", "error", err.Error())
+			return nil
+		}
+		return err
+	}
+
+	var blockingLocks []*client.ManagementLockObject
+	for _, lock := range locks {
+		if lock == nil || lock.ID == nil {
+			continue
+		}
+		if fctx.locksConfig.RemoveOwnLocks && IsOwnManagementLock(lock) {
+			log.Info("removing management lock created by the extension", "id", *lock.ID)
+			if err := c.DeleteByID(ctx, *lock.ID); err != nil {
+				return err
+			}
+			continue
+		}
+		blockingLocks = append(blockingLocks, lock)
+	}
+
+	if len(blockingLocks) > 0 {
+		return NewManagementLockError(fctx.adapter.ResourceGroupName(), blockingLocks)
+	}
+	return nil
+}
+
+// EnsureResourceGroupDeletionProtection creates the management lock which protects the shoot's resource group against
+// deletion if this is configured, and removes a lock created before otherwise. The lock is placed on the security group
+// instead of the resource group, as Azure refuses to delete a resource group containing a locked resource, while a lock on
+// the resource group would be inherited by all resources and let the deletion of virtual machines, disks, load balancers
+// or public IPs by the machine-controller-manager, the cloud-controller-manager or the CSI drivers fail.
+func (fctx *FlowContext) EnsureResourceGroupDeletionProtection(ctx context.Context) error {
+	var (
+		log    = shared.LogFromContext(ctx)
+		ids    = fctx.whiteboard.GetChild(ChildKeyIDs)
+		lockID = ids.Get(KindManagementLock.String())
+	)
+
+	if !helper.IsResourceGroupDeletionProtected(fctx.cfg) && lockID == nil {
+		return nil
+	}
+
+	c, err := fctx.factory.ManagementLocks()
+	if err != nil {
+		return err
+	}
+
+	if !helper.IsResourceGroupDeletionProtected(fctx.cfg) {
+		log.Info("removing deletion protection of resource group", "id", *lockID)
+		if err := c.DeleteByID(ctx, *lockID); err != nil {
+			return err
+		}
+		ids.Delete(KindManagementLock.String())
+		return nil
+	}
+
+	log.Info("ensuring deletion protection of resource group", "name", fctx.adapter.ResourceGroupName(), "scope", fctx.deletionProtectionScope())
+	lock, err := c.CreateOrUpdateByScope(ctx, fctx.deletionProtectionScope(), deletionProtectionLockName, client.ManagementLockObject{
+		Properties: &client.ManagementLockProperties{
+			Level: to.Ptr(client.LockLevelCanNotDelete),
+			Notes: to.Ptr(azure.ManagementLockNotesPrefix + "; protects the resource group of the shoot against deletion"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	ids.Set(KindManagementLock.String(), *lock.ID)
+	return nil
+}
+
+// DeleteResourceGroupDeletionProtection removes the management lock which protects the shoot's resource group against
+// deletion. The lock is also removed if its ID was not recorded, as long as the protection is configured.
+func (fctx *FlowContext) DeleteResourceGroupDeletionProtection(ctx context.Context) error {
+	var (
+		log    = shared.LogFromContext(ctx)
+		ids    = fctx.whiteboard.GetChild(ChildKeyIDs)
+		lockID = ids.Get(KindManagementLock.String())
+	)
+
+	if lockID == nil {
+		if !helper.IsResourceGroupDeletionProtected(fctx.cfg) {
+			return nil
+		}
+		lockID = to.Ptr(fmt.Sprintf("%s/providers/%s/%s", fctx.deletionProtectionScope(), KindManagementLock, deletionProtectionLockName))
+	}
+
+	c, err := fctx.factory.ManagementLocks()
+	if err != nil {
+		return err
+	}
+
+	log.Info("removing deletion protection of resource group", "id", *lockID)
+	if err := c.DeleteByID(ctx, *lockID); err != nil {
+		return err
+	}
+	ids.Delete(KindManagementLock.String())
+	return nil
+}
+
+// deletionProtectionScope returns the ID of the security group which carries the deletion protection lock.
+func (fctx *FlowContext) deletionProtectionScope() string {
+	sgCfg := fctx.adapter.SecurityGroupConfig()
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s/%s", fctx.auth.SubscriptionID, sgCfg.ResourceGroup, KindSecurityGroup, sgCfg.Name)
+}
+
+// IsOwnManagementLock returns true if the given management lock was created by the extension.
+func IsOwnManagementLock(lock *client.ManagementLockObject) bool {
+	return lock.Properties != nil && lock.Properties.Notes != nil && strings.HasPrefix(*lock.Properties.Notes, azure.ManagementLockNotesPrefix)
+}
+
+// DeleteFlowLogs deletes the flow logs of the worker subnets. As they are located in the resource group of the network
+// watcher, they are not deleted together with the shoot's resource group.
+func (fctx *FlowContext) DeleteFlowLogs(ctx context.Context) error {
+	flowLogs := fctx.whiteboard.GetChild(KindFlowLog.String())
+	for _, name := range flowLogs.Keys() {
+		if id := flowLogs.Get(name); id != nil {
+			if err := fctx.deleteFlowLog(ctx, name, *id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteDiagnosticSettings deletes the Azure Monitor diagnostic settings created by the extension. Azure does not delete
+// them together with the resources, instead they would be applied again to resources with the same ID.
+func (fctx *FlowContext) DeleteDiagnosticSettings(ctx context.Context) error {
+	wb := fctx.whiteboard.GetChild(ChildKeyDiagnosticSettings)
+	if len(wb.AsMap()) == 0 {
+		return nil
+	}
+
+	c, err := fctx.factory.DiagnosticSettings()
+	if err != nil {
+		return err
+	}
+
+	var joinErr error
+	for resourceID := range wb.AsMap() {
+		if err := c.Delete(ctx, resourceID, diagnosticSettingsName); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		wb.Delete(resourceID)
+	}
+	return joinErr
+}
+
 // DeleteLoadBalancers deletes all load balancers in shoots resource group
 // This is a prerequisite for the deletion of the subnets in foreign resource group because
 // internal load balancers might have a Frontend IP configuration referencing the
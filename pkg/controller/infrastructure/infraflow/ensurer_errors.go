@@ -6,6 +6,9 @@ package infraflow
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 // SpecMismatchError is an error to indicate that the reconciliation cannot proceed or the operation requested is not supported.
@@ -54,3 +57,45 @@ func (t *TerminalConditionError) Error() string {
 func (t *TerminalConditionError) Unwrap() error {
 	return t.error
 }
+
+// ManagementLockError is an error to indicate that management locks block the deletion of the infrastructure.
+type ManagementLockError struct {
+	// ResourceGroup is the name of the shoot's resource group.
+	ResourceGroup string
+	// Locks are the blocking management locks.
+	Locks []*client.ManagementLockObject
+}
+
+// NewManagementLockError creates a ManagementLockError.
+func NewManagementLockError(resourceGroup string, locks []*client.ManagementLockObject) *ManagementLockError {
+	return &ManagementLockError{ResourceGroup: resourceGroup, Locks: locks}
+}
+
+func (m *ManagementLockError) Error() string {
+	locks := make([]string, 0, len(m.Locks))
+	for _, lock := range m.Locks {
+		var level client.LockLevel
+		if lock.Properties != nil && lock.Properties.Level != nil {
+			level = *lock.Properties.Level
+		}
+		locks = append(locks, fmt.Sprintf("%q (level: %s, scope: %s)", managementLockName(lock), level, managementLockScope(lock)))
+	}
+	return fmt.Sprintf("deletion of resource group %s is blocked by management locks (ScopeLocked), they have to be removed "+
+		"before the infrastructure can be deleted: %s", m.ResourceGroup, strings.Join(locks, ", "))
+}
+
+func managementLockName(lock *client.ManagementLockObject) string {
+	if lock.Name != nil {
+		return *lock.Name
+	}
+	return ""
+}
+
+// managementLockScope returns the ID of the resource the given management lock is applied to.
+func managementLockScope(lock *client.ManagementLockObject) string {
+	id := *lock.ID
+	if i := strings.Index(strings.ToLower(id), "/providers/microsoft.authorization/locks/"); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
@@ -11,13 +11,16 @@ import (
 	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
@@ -27,6 +30,8 @@ import (
 const (
 	defaultTimeout     = 2 * time.Minute
 	defaultLongTimeout = 4 * time.Minute
+	// defaultFirewallTimeout is the timeout for the tasks of the egress firewall, whose provisioning takes several minutes.
+	defaultFirewallTimeout = 20 * time.Minute
 )
 
 var setSeparator = sync.OnceFunc(func() {
@@ -52,6 +57,9 @@ type FlowContext struct {
 	adapter        *InfrastructureAdapter
 	providerAccess Access
 	inventory      *Inventory
+	steps          *stepStates
+	locksConfig    config.ManagementLocksConfig
+	recorder       record.EventRecorder
 
 	*shared.BasicFlowContext
 }
@@ -65,6 +73,13 @@ type Opts struct {
 	Infra   *extensionsv1alpha1.Infrastructure
 	Cluster *controller.Cluster
 	State   *azure.InfrastructureState
+	// ManagementLocks is the configuration for the handling of management locks during the deletion.
+	ManagementLocks config.ManagementLocksConfig
+	// Worker is the Worker of the shoot, if it exists. It determines the number of public IPs of NAT gateways whose
+	// public IPs are scaled automatically.
+	Worker *extensionsv1alpha1.Worker
+	// Recorder records events on the Infrastructure.
+	Recorder record.EventRecorder
 }
 
 // NewFlowContext creates a new FlowContext.
@@ -104,6 +119,9 @@ func NewFlowContext(opts Opts) (*FlowContext, error) {
 	if err != nil {
 		return nil, err
 	}
+	if opts.Worker != nil {
+		adapter.WithWorker(opts.Worker)
+	}
 
 	fc := &FlowContext{
 		factory:    opts.Factory,
@@ -119,15 +137,28 @@ func NewFlowContext(opts Opts) (*FlowContext, error) {
 		providerAccess: &access{
 			opts.Factory,
 		},
-		adapter:   adapter,
-		inventory: inv,
+		adapter:     adapter,
+		inventory:   inv,
+		steps:       newStepStates(opts.State.Steps),
+		locksConfig: opts.ManagementLocks,
+		recorder:    opts.Recorder,
 	}
+	fc.BasicFlowContext = fc.newBasicFlowContext()
 
 	return fc, nil
 }
 
+// newBasicFlowContext returns a new BasicFlowContext which persists the state of the flow context.
+func (fctx *FlowContext) newBasicFlowContext() *shared.BasicFlowContext {
+	return shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState).WithStepRecorder(fctx.recordStep)
+}
+
 // Reconcile reconciles target infrastructure.
 func (fctx *FlowContext) Reconcile(ctx context.Context) error {
+	if err := fctx.PrepareRerunSteps(ctx); err != nil {
+		return err
+	}
+
 	graph := fctx.buildReconcileGraph()
 	fl := graph.Compile()
 	if err := fl.Run(ctx, flow.Opts{
@@ -139,17 +170,23 @@ func (fctx *FlowContext) Reconcile(ctx context.Context) error {
 		return errors.Join(err, fctx.persistState(ctx))
 	}
 
+	// all steps which are still part of the flow succeeded, hence failures of skipped steps are outdated.
+	fctx.steps.resetFailures()
+
 	status, err := fctx.GetInfrastructureStatus(ctx)
 	state := fctx.GetInfrastructureState()
 	egressCidrs := fctx.GetEgressIpCidrs()
 	if err != nil {
 		return err
 	}
-	return infrainternal.PatchProviderStatusAndState(ctx, fctx.client, fctx.infra, status, state, egressCidrs)
+	if err := infrainternal.PatchProviderStatusAndState(ctx, fctx.client, fctx.infra, status, state, egressCidrs, fctx.stepsConditions()...); err != nil {
+		return err
+	}
+	return fctx.removeRerunStepsAnnotation(ctx)
 }
 
 func (fctx *FlowContext) buildReconcileGraph() *flow.Graph {
-	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState)
+	fctx.BasicFlowContext = fctx.newBasicFlowContext()
 	g := flow.NewGraph("Azure infrastructure reconciliation")
 
 	resourceGroup := fctx.AddTask(g, "ensure resource group",
@@ -158,27 +195,66 @@ func (fctx *FlowContext) buildReconcileGraph() *flow.Graph {
 	vnet := fctx.AddTask(g, "ensure vnet",
 		fctx.EnsureVirtualNetwork, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
 
+	adoption := fctx.AddTask(g, "adopt existing resources",
+		fctx.AdoptResources, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup, vnet),
+		shared.DoIf(helper.HasShootAdoptResourcesAnnotation(fctx.cluster.Shoot.GetAnnotations())))
+
 	_ = fctx.AddTask(g, "ensure availability set",
 		fctx.EnsureAvailabilitySet,
 		shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup), shared.DoIf(fctx.adapter.IsAvailabilitySetReconciliationRequired()))
 
 	_ = fctx.AddTask(g, "ensure managed identity",
-		fctx.EnsureManagedIdentity, shared.DoIf(fctx.cfg.Identity != nil))
+		fctx.EnsureManagedIdentity, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup),
+		shared.DoIf(fctx.cfg.Identity != nil || fctx.adapter.IsManagedIdentityRequired()))
 
-	routeTable := fctx.AddTask(g, "ensure route table",
-		fctx.EnsureRouteTable, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
+	_ = fctx.AddTask(g, "ensure zone mappings",
+		fctx.EnsureZoneMappings, shared.Timeout(defaultTimeout), shared.DoIf(fctx.cfg.Zoned))
+
+	_ = fctx.AddTask(g, "ensure boot diagnostics storage account",
+		fctx.EnsureBootDiagnosticsStorageAccount, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup),
+		shared.DoIf(fctx.adapter.IsBootDiagnosticsStorageAccountRequired()))
+
+	egressFirewallDeletion := fctx.AddTask(g, "delete egress firewall",
+		fctx.DeleteEgressFirewall, shared.Timeout(defaultFirewallTimeout), shared.Dependencies(resourceGroup),
+		shared.DoIf(fctx.adapter.EgressFirewallConfig() == nil && len(fctx.inventory.ByKind(KindAzureFirewall)) > 0))
 
 	securityGroup := fctx.AddTask(g, "ensure security group",
-		fctx.EnsureSecurityGroup, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
+		fctx.EnsureSecurityGroup, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup, adoption))
 
+	basicIPMigration := fctx.AddTask(g, "basic public IP migration",
+		fctx.MigrateBasicPublicIPs, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup, adoption))
+	// the public IPs of a removed egress firewall can only be deleted after the firewall.
 	ip := fctx.AddTask(g, "ensure public IPs",
-		fctx.EnsurePublicIps, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup))
+		fctx.EnsurePublicIps, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup, basicIPMigration, egressFirewallDeletion))
+
+	// the default route of the route table points to the private IP of the egress firewall.
+	egressFirewall := fctx.AddTask(g, "ensure egress firewall",
+		fctx.EnsureEgressFirewall, shared.Timeout(defaultFirewallTimeout), shared.Dependencies(resourceGroup, vnet, ip),
+		shared.DoIf(fctx.adapter.EgressFirewallConfig() != nil))
+	routeTable := fctx.AddTask(g, "ensure route table",
+		fctx.EnsureRouteTable, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup, adoption, egressFirewall))
 	nat := fctx.AddTask(g, "ensure nats",
 		fctx.EnsureNatGateways, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup, ip))
 
 	subnet := fctx.AddTask(g, "ensure subnets", fctx.EnsureSubnets,
 		shared.Timeout(defaultLongTimeout), shared.Dependencies(vnet, routeTable, securityGroup, nat))
 
+	outboundLoadBalancer := fctx.AddTask(g, "ensure outbound load balancer", fctx.EnsureOutboundLoadBalancer,
+		shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup, ip),
+		shared.DoIf(fctx.adapter.OutboundLoadBalancerConfig() != nil))
+
+	_ = fctx.AddTask(g, "ensure resource group deletion protection",
+		fctx.EnsureResourceGroupDeletionProtection, shared.Timeout(defaultTimeout), shared.Dependencies(securityGroup))
+
+	_ = fctx.AddTask(g, "ensure flow logs", fctx.EnsureFlowLogs,
+		shared.Timeout(defaultLongTimeout), shared.Dependencies(subnet))
+
+	_ = fctx.AddTask(g, "ensure diagnostic settings", fctx.EnsureDiagnosticSettings,
+		shared.Timeout(defaultTimeout), shared.Dependencies(securityGroup, nat, outboundLoadBalancer))
+
+	_ = fctx.AddTask(g, "ensure pod subnet", fctx.EnsurePodSubnet,
+		shared.Timeout(defaultLongTimeout), shared.Dependencies(subnet), shared.DoIf(fctx.cfg.Networks.PodSubnet != nil))
+
 	// a sync point for when the "normal" reconciliation is finished. Currently, it ends with the subnet reconciliation.
 	reconciliationFinishedPoint := flow.NewTaskIDs(subnet)
 
@@ -198,18 +274,29 @@ func (fctx *FlowContext) Delete(ctx context.Context) error {
 		}
 	}
 
-	fctx.BasicFlowContext = shared.NewBasicFlowContext().WithSpan().WithLogger(fctx.log).WithPersist(fctx.persistState)
+	fctx.BasicFlowContext = fctx.newBasicFlowContext()
 	managedVnet := fctx.adapter.VirtualNetworkConfig().Managed
 	g := flow.NewGraph("Azure infrastructure deletion")
 
+	// the deletion protection is removed first, as it would let the deletion of the security group and the resource group fail.
+	deletionProtection := fctx.AddTask(g, "remove resource group deletion protection",
+		fctx.DeleteResourceGroupDeletionProtection, shared.Timeout(defaultTimeout))
+	// management locks would let the deletion of any resource fail, hence they are checked before anything is deleted.
+	managementLocks := fctx.AddTask(g, "check management locks",
+		fctx.EnsureNoManagementLocks, shared.Timeout(defaultTimeout), shared.Dependencies(deletionProtection))
 	loadBalancers := fctx.AddTask(g, "delete load balancers",
-		fctx.DeleteLoadBalancers, shared.Timeout(defaultLongTimeout), shared.DoIf(!managedVnet))
+		fctx.DeleteLoadBalancers, shared.Timeout(defaultLongTimeout), shared.Dependencies(managementLocks), shared.DoIf(!managedVnet))
+	// the flow logs target the subnets, hence they are deleted first.
+	flowLogs := fctx.AddTask(g, "delete flow logs",
+		fctx.DeleteFlowLogs, shared.Timeout(defaultLongTimeout), shared.Dependencies(managementLocks))
 	foreignSubnets := fctx.AddTask(g, "delete subnets in foreign resource group",
 		fctx.DeleteSubnetsInForeignGroup, shared.Timeout(defaultLongTimeout),
-		shared.Dependencies(loadBalancers), shared.DoIf(!managedVnet))
+		shared.Dependencies(loadBalancers, flowLogs), shared.DoIf(!managedVnet))
+	diagnosticSettings := fctx.AddTask(g, "delete diagnostic settings",
+		fctx.DeleteDiagnosticSettings, shared.Timeout(defaultTimeout), shared.Dependencies(managementLocks))
 
 	fctx.AddTask(g, "delete resource group",
-		fctx.DeleteResourceGroup, shared.Dependencies(foreignSubnets), shared.Timeout(defaultLongTimeout))
+		fctx.DeleteResourceGroup, shared.Dependencies(managementLocks, foreignSubnets, diagnosticSettings, flowLogs), shared.Timeout(defaultLongTimeout))
 
 	fl := g.Compile()
 	if err := fl.Run(ctx, flow.Opts{}); err != nil {
@@ -220,5 +307,17 @@ func (fctx *FlowContext) Delete(ctx context.Context) error {
 }
 
 func (fctx *FlowContext) persistState(ctx context.Context) error {
-	return infrainternal.PatchProviderStatusAndState(ctx, fctx.client, fctx.infra, nil, fctx.GetInfrastructureState(), fctx.GetEgressIpCidrs())
+	return infrainternal.PatchProviderStatusAndState(ctx, fctx.client, fctx.infra, nil, fctx.GetInfrastructureState(), fctx.GetEgressIpCidrs(), fctx.stepsConditions()...)
+}
+
+func (fctx *FlowContext) recordStep(taskName string, err error) {
+	fctx.steps.record(taskName, fctx.infra.Generation, err)
+}
+
+// stepsConditions returns the condition which summarizes the state of the steps of the flow, if any step was executed.
+func (fctx *FlowContext) stepsConditions() []gardencorev1beta1.Condition {
+	if condition := fctx.steps.condition(fctx.infra.Status.Conditions); condition != nil {
+		return []gardencorev1beta1.Condition{*condition}
+	}
+	return nil
 }
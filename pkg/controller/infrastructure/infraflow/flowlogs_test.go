@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("FlowLogs", func() {
+	const (
+		resourceGroup    = "shoot--foo--bar"
+		subnetName       = "shoot--foo--bar-nodes"
+		subnetID         = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/virtualNetworks/shoot--foo--bar/subnets/" + subnetName
+		watcherID        = "/subscriptions/sub/resourceGroups/NetworkWatcherRG/providers/Microsoft.Network/networkWatchers/NetworkWatcher_westeurope"
+		flowLogID        = watcherID + "/flowLogs/" + subnetName
+		storageAccountID = "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.Storage/storageAccounts/flowlogs"
+		workspaceID      = "/subscriptions/sub/resourceGroups/monitoring/providers/Microsoft.OperationalInsights/workspaces/workspace"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl      *gomock.Controller
+		factory   *mockclient.MockFactory
+		watchers  *mockclient.MockNetworkWatcher
+		resources *mockclient.MockResource
+		opts      infraflow.Opts
+
+		flowLogIDKey = func(subnet string) string {
+			return string(infraflow.KindFlowLog) + shared.Separator + subnet
+		}
+
+		providerConfig = func(flowLogs string) []byte {
+			return []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+				`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"` + flowLogs + `}}`)
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		watchers = mockclient.NewMockNetworkWatcher(ctrl)
+		resources = mockclient.NewMockResource(ctrl)
+		factory.EXPECT().NetworkWatcher().Return(watchers, nil).AnyTimes()
+		factory.EXPECT().Resource().Return(resources, nil).AnyTimes()
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: providerConfig(`,"flowLogs":{"storageAccountID":"` + storageAccountID + `","retentionDays":7}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{
+				Data: map[string]string{
+					string(infraflow.KindSubnet) + shared.Separator + subnetName: subnetID,
+				},
+			},
+		}
+	})
+
+	Describe("#EnsureFlowLogs", func() {
+		var networkWatchers []*armnetwork.Watcher
+
+		BeforeEach(func() {
+			networkWatchers = []*armnetwork.Watcher{
+				{ID: ptr.To("/subscriptions/sub/resourceGroups/NetworkWatcherRG/providers/Microsoft.Network/networkWatchers/NetworkWatcher_northeurope"), Location: ptr.To("northeurope")},
+				{ID: ptr.To(watcherID), Location: ptr.To("westeurope")},
+			}
+			watchers.EXPECT().ListAll(gomock.Any()).DoAndReturn(func(_ context.Context) ([]*armnetwork.Watcher, error) {
+				return networkWatchers, nil
+			}).MaxTimes(1)
+		})
+
+		It("should create the flow log of the worker subnet in the network watcher of the region", func() {
+			watchers.EXPECT().CreateOrUpdateFlowLog(gomock.Any(), "NetworkWatcherRG", "NetworkWatcher_westeurope", subnetName, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _, _ string, flowLog armnetwork.FlowLog) (*armnetwork.FlowLog, error) {
+					Expect(*flowLog.Location).To(Equal("westeurope"))
+					Expect(*flowLog.Properties.TargetResourceID).To(Equal(subnetID))
+					Expect(*flowLog.Properties.StorageID).To(Equal(storageAccountID))
+					Expect(*flowLog.Properties.RetentionPolicy).To(Equal(armnetwork.RetentionPolicyParameters{Days: ptr.To[int32](7), Enabled: ptr.To(true)}))
+					Expect(flowLog.Properties.FlowAnalyticsConfiguration).To(BeNil())
+					flowLog.ID = ptr.To(flowLogID)
+					return &flowLog, nil
+				})
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureFlowLogs(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).To(HaveKeyWithValue(flowLogIDKey(subnetName), flowLogID))
+		})
+
+		It("should create a flow log per zonal subnet and delete the flow logs of removed subnets", func() {
+			opts.Infra.Spec.ProviderConfig.Raw = []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+				`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24"},{"name":2,"cidr":"10.250.1.0/24"}],` +
+				`"flowLogs":{"storageAccountID":"` + storageAccountID + `"}}}`)
+			opts.State.Data[flowLogIDKey(subnetName)] = flowLogID
+			for _, zone := range []string{"z1", "z2"} {
+				opts.State.Data[string(infraflow.KindSubnet)+shared.Separator+subnetName+"-"+zone] = subnetID + "-" + zone
+			}
+
+			watchers.EXPECT().DeleteFlowLog(gomock.Any(), "NetworkWatcherRG", "NetworkWatcher_westeurope", subnetName).Return(nil)
+			for _, zone := range []string{"z1", "z2"} {
+				watchers.EXPECT().CreateOrUpdateFlowLog(gomock.Any(), "NetworkWatcherRG", "NetworkWatcher_westeurope", subnetName+"-"+zone, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _, name string, flowLog armnetwork.FlowLog) (*armnetwork.FlowLog, error) {
+						Expect(*flowLog.Properties.TargetResourceID).To(Equal(subnetID + "-" + zone))
+						flowLog.ID = ptr.To(watcherID + "/flowLogs/" + name)
+						return &flowLog, nil
+					})
+			}
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureFlowLogs(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).NotTo(HaveKey(flowLogIDKey(subnetName)))
+			Expect(state.Data).To(HaveKeyWithValue(flowLogIDKey(subnetName+"-z1"), flowLogID+"-z1"))
+			Expect(state.Data).To(HaveKeyWithValue(flowLogIDKey(subnetName+"-z2"), flowLogID+"-z2"))
+		})
+
+		It("should fail if the ID of a worker subnet is unknown", func() {
+			delete(opts.State.Data, string(infraflow.KindSubnet)+shared.Separator+subnetName)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureFlowLogs(ctx)).To(MatchError(ContainSubstring("the ID of subnet " + subnetName + " is unknown")))
+		})
+
+		It("should configure traffic analytics with the workspace details", func() {
+			opts.Infra.Spec.ProviderConfig.Raw = providerConfig(`,"flowLogs":{"storageAccountID":"` + storageAccountID + `","trafficAnalytics":{"workspaceID":"` + workspaceID + `"}}`)
+			resources.EXPECT().GetByID(gomock.Any(), workspaceID, gomock.Any()).Return(&armresources.GenericResource{
+				Location:   ptr.To("northeurope"),
+				Properties: map[string]any{"customerId": "00000000-0000-0000-0000-000000000001"},
+			}, nil)
+			watchers.EXPECT().CreateOrUpdateFlowLog(gomock.Any(), "NetworkWatcherRG", "NetworkWatcher_westeurope", subnetName, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, _, _ string, flowLog armnetwork.FlowLog) (*armnetwork.FlowLog, error) {
+					Expect(*flowLog.Properties.FlowAnalyticsConfiguration.NetworkWatcherFlowAnalyticsConfiguration).To(Equal(armnetwork.TrafficAnalyticsConfigurationProperties{
+						Enabled:                  ptr.To(true),
+						TrafficAnalyticsInterval: ptr.To[int32](60),
+						WorkspaceID:              ptr.To("00000000-0000-0000-0000-000000000001"),
+						WorkspaceRegion:          ptr.To("northeurope"),
+						WorkspaceResourceID:      ptr.To(workspaceID),
+					}))
+					flowLog.ID = ptr.To(flowLogID)
+					return &flowLog, nil
+				})
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureFlowLogs(ctx)).To(Succeed())
+		})
+
+		It("should fail if there is no network watcher in the region", func() {
+			networkWatchers = networkWatchers[:1]
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureFlowLogs(ctx)).To(MatchError(ContainSubstring(`no network watcher found in region "westeurope"`)))
+		})
+
+		It("should delete the flow log if it is not configured anymore", func() {
+			opts.Infra.Spec.ProviderConfig.Raw = providerConfig(``)
+			opts.State.Data[flowLogIDKey(subnetName)] = flowLogID
+			watchers.EXPECT().DeleteFlowLog(gomock.Any(), "NetworkWatcherRG", "NetworkWatcher_westeurope", subnetName).Return(nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureFlowLogs(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.Data).NotTo(HaveKey(flowLogIDKey(subnetName)))
+		})
+	})
+
+	Describe("#DeleteFlowLogs", func() {
+		It("should delete the flow logs", func() {
+			opts.State.Data[flowLogIDKey(subnetName)] = flowLogID
+			watchers.EXPECT().DeleteFlowLog(gomock.Any(), "NetworkWatcherRG", "NetworkWatcher_westeurope", subnetName).Return(nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.DeleteFlowLogs(ctx)).To(Succeed())
+		})
+
+		It("should do nothing if no flow log was created", func() {
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.DeleteFlowLogs(ctx)).To(Succeed())
+		})
+	})
+})
@@ -6,17 +6,24 @@ package infraflow
 
 import (
 	"fmt"
+	"net"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 	consts "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/internal/infrastructure"
 )
 
@@ -29,6 +36,7 @@ type InfrastructureAdapter struct {
 	status         *azure.InfrastructureStatus
 	profile        *azure.CloudProfileConfig
 	cluster        *extensionscontroller.Cluster
+	worker         *extensionsv1alpha1.Worker
 	subscriptionID string
 
 	// cached configuration
@@ -63,6 +71,14 @@ func NewInfrastructureAdapter(
 	return ia, nil
 }
 
+// WithWorker sets the Worker of the shoot. Its worker pools determine the number of public IPs of NAT gateways whose
+// public IPs are scaled automatically.
+func (ia *InfrastructureAdapter) WithWorker(worker *extensionsv1alpha1.Worker) *InfrastructureAdapter {
+	ia.worker = worker
+	ia.zoneConfigs = ia.zonesConfig()
+	return ia
+}
+
 // TechnicalName the cluster's "base" name. Used as a name or as a prefix by other resources.
 func (ia *InfrastructureAdapter) TechnicalName() string {
 	return infrastructure.ShootResourceGroupName(ia.infra, ia.config, ia.status)
@@ -99,6 +115,8 @@ type VirtualNetworkConfig struct {
 	Location string
 	// Cidr is the vnet's CIDR.
 	CIDR *string
+	// AdditionalCIDRs are further address prefixes of the vnet.
+	AdditionalCIDRs []string
 	// DDoSPlanID is the ID reference of the DDoS protection plan.
 	DDoSPlanID *string
 }
@@ -127,9 +145,10 @@ func (ia *InfrastructureAdapter) virtualNetworkConfig() VirtualNetworkConfig {
 			ResourceGroup: rg,
 			Kind:          KindVirtualNetwork,
 		},
-		Managed:    managed,
-		Location:   ia.Region(),
-		DDoSPlanID: ia.config.Networks.VNet.DDosProtectionPlanID,
+		Managed:         managed,
+		Location:        ia.Region(),
+		DDoSPlanID:      ia.config.Networks.VNet.DDosProtectionPlanID,
+		AdditionalCIDRs: slices.Clone(ia.config.Networks.VNet.AdditionalCIDRs),
 	}
 
 	if cidr := ia.config.Networks.VNet.CIDR; cidr != nil {
@@ -213,11 +232,14 @@ func (ia *InfrastructureAdapter) availabilitySetConfig() (*AvailabilitySetConfig
 type RouteTableConfig struct {
 	AzureResourceMetadata
 	Location string
+	// NextHopIPAddress is the IP address of the virtual appliance the default route points to. If nil, no default route is
+	// maintained.
+	NextHopIPAddress *string
 }
 
 // RouteTableConfig returns configuration for the shoot's route table.
 func (ia *InfrastructureAdapter) RouteTableConfig() RouteTableConfig {
-	return RouteTableConfig{
+	cfg := RouteTableConfig{
 		AzureResourceMetadata: AzureResourceMetadata{
 			ResourceGroup: ia.ResourceGroupName(),
 			Name:          "worker_route_table",
@@ -225,6 +247,10 @@ func (ia *InfrastructureAdapter) RouteTableConfig() RouteTableConfig {
 		},
 		Location: ia.Region(),
 	}
+	if outboundAccess := ia.config.Networks.OutboundAccess; outboundAccess != nil {
+		cfg.NextHopIPAddress = to.Ptr(outboundAccess.NextHopIPAddress)
+	}
+	return cfg
 }
 
 // SecurityGroupConfig is the desired configuration for a security group.
@@ -245,12 +271,92 @@ func (ia *InfrastructureAdapter) SecurityGroupConfig() SecurityGroupConfig {
 	}
 }
 
+// StorageAccountConfig is the desired configuration for a storage account.
+type StorageAccountConfig struct {
+	AzureResourceMetadata
+	Location string
+	// ZoneRedundant indicates whether the storage account should be created zone-redundant.
+	ZoneRedundant bool
+}
+
+// AuxiliaryResourcesRegion returns the region in which auxiliary resources are created. Defaults to the shoot's region.
+func (ia *InfrastructureAdapter) AuxiliaryResourcesRegion() string {
+	if ia.config.AuxiliaryResources != nil && ia.config.AuxiliaryResources.Region != nil {
+		return *ia.config.AuxiliaryResources.Region
+	}
+	return ia.Region()
+}
+
+// IsBootDiagnosticsStorageAccountRequired returns true if a storage account for boot diagnostics should be reconciled.
+func (ia *InfrastructureAdapter) IsBootDiagnosticsStorageAccountRequired() bool {
+	return ia.config.AuxiliaryResources != nil && ia.config.AuxiliaryResources.BootDiagnostics
+}
+
+// BootDiagnosticsStorageAccountConfig returns the configuration for the storage account used for boot diagnostics.
+func (ia *InfrastructureAdapter) BootDiagnosticsStorageAccountConfig() StorageAccountConfig {
+	// storage account names are globally unique and limited to 24 lowercase alphanumeric characters, therefore we derive
+	// the name from the shoot's UID which is stable across control plane migrations.
+	shootUIDSha := utils.ComputeSHA256Hex([]byte(ia.cluster.Shoot.UID))
+	location := ia.AuxiliaryResourcesRegion()
+
+	return StorageAccountConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.ResourceGroupName(),
+			Name:          fmt.Sprintf("diag%s", shootUIDSha[:20]),
+			Kind:          KindStorageAccount,
+		},
+		Location:      location,
+		ZoneRedundant: ia.regionHasZones(location),
+	}
+}
+
+// ManagedIdentityConfig is the desired configuration for the user-assigned managed identity of the worker nodes.
+type ManagedIdentityConfig struct {
+	AzureResourceMetadata
+	Location string
+}
+
+// IsManagedIdentityRequired returns true if a user-assigned managed identity should be created for the worker nodes.
+func (ia *InfrastructureAdapter) IsManagedIdentityRequired() bool {
+	return ia.config.AuxiliaryResources != nil && ia.config.AuxiliaryResources.ManagedIdentity
+}
+
+// ManagedIdentityConfig returns the configuration for the user-assigned managed identity of the worker nodes.
+func (ia *InfrastructureAdapter) ManagedIdentityConfig() ManagedIdentityConfig {
+	return ManagedIdentityConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.ResourceGroupName(),
+			Name:          fmt.Sprintf("%s-workers", ia.TechnicalName()),
+			Kind:          KindManagedIdentity,
+		},
+		Location: ia.AuxiliaryResourcesRegion(),
+	}
+}
+
+// regionHasZones returns true if the cloud profile lists availability zones for the given region. Zone-redundant
+// storage is only available in regions with availability zones.
+func (ia *InfrastructureAdapter) regionHasZones(region string) bool {
+	if ia.cluster == nil || ia.cluster.CloudProfile == nil {
+		return false
+	}
+	for _, r := range ia.cluster.CloudProfile.Spec.Regions {
+		if r.Name == region {
+			return len(r.Zones) > 0
+		}
+	}
+	return false
+}
+
 // PublicIPConfig contains configuration for a public IP resource.
 type PublicIPConfig struct {
 	AzureResourceMetadata
 	Zones    []string
 	Location string
 	Managed  bool
+	// DDoSProtectionMode is the DDoS protection mode of a managed public IP.
+	DDoSProtectionMode *string
+	// DNSSettings are the DNS settings of a managed public IP.
+	DNSSettings *azure.PublicIPDNSSettings
 }
 
 // NatGatewayConfig contains configuration for a NAT Gateway.
@@ -260,6 +366,11 @@ type NatGatewayConfig struct {
 	Zone         *string
 	IdleTimeout  *int32
 	PublicIPList []PublicIPConfig
+	// Nodes is the number of nodes behind the NAT gateway if its public IPs are scaled automatically.
+	Nodes *int32
+	// Managed is false for existing NAT gateways which are managed outside of Gardener and only associated with the
+	// subnets.
+	Managed bool
 }
 
 // SubnetConfig is the specification for a subnet
@@ -268,6 +379,9 @@ type SubnetConfig struct {
 	cidr            string
 	serviceEndpoint []string
 	zone            *string
+	// externalSecurityGroupID is the ID of a centrally managed security group that is associated with the subnet
+	// instead of the worker security group.
+	externalSecurityGroupID *string
 }
 
 // ZoneConfig is the specification for a zone.
@@ -319,6 +433,37 @@ func (ia *InfrastructureAdapter) IsOwnSubnetName(name *string) bool {
 	return false
 }
 
+// PodSubnetConfig is the specification for the dedicated subnet for pods.
+type PodSubnetConfig struct {
+	AzureResourceMetadata
+	// Managed is true if the subnet is created by gardener.
+	Managed bool
+	cidr    *string
+}
+
+// PodSubnetConfig returns the configuration of the pod subnet or nil if the shoot does not use a dedicated subnet for pods.
+func (ia *InfrastructureAdapter) PodSubnetConfig() *PodSubnetConfig {
+	podSubnet := ia.config.Networks.PodSubnet
+	if podSubnet == nil {
+		return nil
+	}
+
+	cfg := &PodSubnetConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.vnetConfig.ResourceGroup,
+			Name:          fmt.Sprintf("%s-pods", ia.TechnicalName()),
+			Parent:        ia.vnetConfig.Name,
+			Kind:          KindSubnet,
+		},
+		Managed: podSubnet.Name == nil,
+		cidr:    podSubnet.CIDR,
+	}
+	if podSubnet.Name != nil {
+		cfg.Name = *podSubnet.Name
+	}
+	return cfg
+}
+
 func (ia *InfrastructureAdapter) publicIPName(natName string) string {
 	return fmt.Sprintf("%s-ip", natName)
 }
@@ -352,8 +497,21 @@ func (ia *InfrastructureAdapter) zonesConfig() []ZoneConfig {
 			},
 			Migrated: isMigratedZone,
 		}
+		if configZone.SecurityGroup != nil {
+			z.Subnet.externalSecurityGroupID = to.Ptr(configZone.SecurityGroup.ExternalID)
+		}
 
-		if configZone.NatGateway != nil && configZone.NatGateway.Enabled {
+		if configZone.NatGateway != nil && configZone.NatGateway.Enabled && configZone.NatGateway.Existing != nil {
+			z.NatGateway = &NatGatewayConfig{
+				AzureResourceMetadata: AzureResourceMetadata{
+					ResourceGroup: configZone.NatGateway.Existing.ResourceGroup,
+					Name:          configZone.NatGateway.Existing.Name,
+					Kind:          KindNatGateway,
+				},
+				Zone:    to.Ptr(zoneString),
+				Managed: false,
+			}
+		} else if configZone.NatGateway != nil && configZone.NatGateway.Enabled {
 			ngw := &NatGatewayConfig{
 				AzureResourceMetadata: AzureResourceMetadata{
 					ResourceGroup: ia.ResourceGroupName(),
@@ -363,6 +521,7 @@ func (ia *InfrastructureAdapter) zonesConfig() []ZoneConfig {
 				IdleTimeout: configZone.NatGateway.IdleConnectionTimeoutMinutes,
 				Location:    ia.Region(),
 				Zone:        to.Ptr(zoneString),
+				Managed:     true,
 			}
 			z.NatGateway = ngw
 
@@ -386,11 +545,13 @@ func (ia *InfrastructureAdapter) zonesConfig() []ZoneConfig {
 						Name:          ia.publicIPName(ngw.Name),
 						Kind:          KindPublicIP,
 					},
-					Managed:  true,
-					Zones:    []string{zoneString},
-					Location: ia.Region(),
+					Managed:            true,
+					Zones:              []string{zoneString},
+					Location:           ia.Region(),
+					DDoSProtectionMode: ddosProtectionMode(configZone.NatGateway.DDoSProtection),
+					DNSSettings:        configZone.NatGateway.DNSSettings,
 				}
-				ngw.PublicIPList = append(ngw.PublicIPList, ip)
+				ngw.PublicIPList = append(ngw.PublicIPList, ia.managedNatGatewayIPs(ngw, ip, configZone.NatGateway.AutoScaleIPs, &zoneString)...)
 			}
 		}
 		zones = append(zones, z)
@@ -426,6 +587,7 @@ func (ia *InfrastructureAdapter) defaultZone() []ZoneConfig {
 		},
 		IdleTimeout: config.Networks.NatGateway.IdleConnectionTimeoutMinutes,
 		Location:    ia.Region(),
+		Managed:     true,
 	}
 	if z := config.Networks.NatGateway.Zone; z != nil {
 		ngw.Zone = to.Ptr(strconv.Itoa(int(*z)))
@@ -451,19 +613,77 @@ func (ia *InfrastructureAdapter) defaultZone() []ZoneConfig {
 				Name:          ia.publicIPName(ngw.Name),
 				Kind:          KindPublicIP,
 			},
-			Managed:  true,
-			Location: ia.Region(),
+			Managed:            true,
+			Location:           ia.Region(),
+			DDoSProtectionMode: ddosProtectionMode(config.Networks.NatGateway.DDoSProtection),
+			DNSSettings:        config.Networks.NatGateway.DNSSettings,
 		}
 		if ngw.Zone != nil {
 			ip.Zones = append(ip.Zones, *ngw.Zone)
 		}
-		ngw.PublicIPList = append(ngw.PublicIPList, ip)
+		// all nodes are behind the NAT gateway of the single subnet, regardless of their zone
+		ngw.PublicIPList = append(ngw.PublicIPList, ia.managedNatGatewayIPs(ngw, ip, config.Networks.NatGateway.AutoScaleIPs, nil)...)
 	}
 	z.NatGateway = ngw
 
 	return []ZoneConfig{z}
 }
 
+// managedNatGatewayIPs returns the public IPs created for the given NAT gateway based on the given public IP. If the
+// public IPs are scaled automatically, one public IP is added per the configured number of nodes in the given zone or in
+// all zones if no zone is given.
+func (ia *InfrastructureAdapter) managedNatGatewayIPs(ngw *NatGatewayConfig, ip PublicIPConfig, autoScaling *azure.NatGatewayIPAutoScaling, zone *string) []PublicIPConfig {
+	ips := []PublicIPConfig{ip}
+	if autoScaling == nil || autoScaling.NodesPerIP < 1 {
+		return ips
+	}
+
+	nodes := ia.workerNodes(zone)
+	ngw.Nodes = &nodes
+	count := min(max((nodes+autoScaling.NodesPerIP-1)/autoScaling.NodesPerIP, 1), autoScaling.MaxIPCount)
+	for i := int32(1); i < count; i++ {
+		additional := ip
+		additional.Name = fmt.Sprintf("%s-%d", ip.Name, i)
+		// domain name labels are unique within the region, hence only the first public IP gets the DNS settings.
+		additional.DNSSettings = nil
+		ips = append(ips, additional)
+	}
+	return ips
+}
+
+// workerNodes returns the maximum number of nodes of the worker pools in the given zone or in all zones if no zone is
+// given. The maximum of a worker pool is distributed over its zones like by the worker controller.
+func (ia *InfrastructureAdapter) workerNodes(zone *string) int32 {
+	if ia.worker == nil {
+		return 0
+	}
+
+	var nodes int32
+	for _, pool := range ia.worker.Spec.Pools {
+		if len(pool.Zones) == 0 {
+			if zone == nil {
+				nodes += pool.Maximum
+			}
+			continue
+		}
+		for i, poolZone := range pool.Zones {
+			if zone == nil || poolZone == *zone {
+				nodes += worker.DistributeOverZones(int32(i), pool.Maximum, int32(len(pool.Zones))) // #nosec: G115 - The number of zones of a pool is small.
+			}
+		}
+	}
+	return nodes
+}
+
+// ddosProtectionMode returns the DDoS protection mode of a managed public IP if the PublicIPDDoSProtection feature
+// gate is enabled.
+func ddosProtectionMode(ddosProtection *azure.PublicIPDDoSProtection) *string {
+	if ddosProtection == nil || !features.ExtensionFeatureGate.Enabled(features.PublicIPDDoSProtection) {
+		return nil
+	}
+	return to.Ptr(string(ddosProtection.Mode))
+}
+
 // ManagedIpConfigs returns a filtered list of only the public IPs that are managed by gardener.
 func (ia *InfrastructureAdapter) ManagedIpConfigs() map[string]PublicIPConfig {
 	res := make(map[string]PublicIPConfig)
@@ -479,6 +699,15 @@ func (ia *InfrastructureAdapter) ManagedIpConfigs() map[string]PublicIPConfig {
 			}
 		}
 	}
+	if lb := ia.OutboundLoadBalancerConfig(); lb != nil {
+		for _, ip := range lb.PublicIPList {
+			res[ip.Name] = ip
+		}
+	}
+	if firewall := ia.EgressFirewallConfig(); firewall != nil {
+		res[firewall.PublicIP.Name] = firewall.PublicIP
+		res[firewall.ManagementPublicIP.Name] = firewall.ManagementPublicIP
+	}
 
 	return res
 }
@@ -496,11 +725,11 @@ func (ia *InfrastructureAdapter) IpConfigs() []PublicIPConfig {
 	return res
 }
 
-// NatGatewayConfigs is the configuration for the desired NAT Gateways.
+// NatGatewayConfigs is the configuration for the desired NAT Gateways that are managed by gardener.
 func (ia *InfrastructureAdapter) NatGatewayConfigs() map[string]NatGatewayConfig {
 	res := make(map[string]NatGatewayConfig)
 	for _, z := range ia.Zones() {
-		if z.NatGateway != nil {
+		if z.NatGateway != nil && z.NatGateway.Managed {
 			res[z.NatGateway.Name] = *z.NatGateway
 		}
 	}
@@ -508,6 +737,154 @@ func (ia *InfrastructureAdapter) NatGatewayConfigs() map[string]NatGatewayConfig
 	return res
 }
 
+// ExistingNatGatewayConfigs returns the existing NAT Gateways that are managed outside of gardener and associated with
+// the subnets of the zones.
+func (ia *InfrastructureAdapter) ExistingNatGatewayConfigs() []NatGatewayConfig {
+	var res []NatGatewayConfig
+	for _, z := range ia.Zones() {
+		if z.NatGateway != nil && !z.NatGateway.Managed {
+			res = append(res, *z.NatGateway)
+		}
+	}
+
+	return res
+}
+
+// OutboundLoadBalancerConfig contains the configuration for the outbound rule of the shoot's load balancer.
+type OutboundLoadBalancerConfig struct {
+	AzureResourceMetadata
+	Location               string
+	BackendPoolName        string
+	OutboundRuleName       string
+	AllocatedOutboundPorts *int32
+	PublicIPList           []PublicIPConfig
+}
+
+// OutboundLoadBalancerConfig returns the configuration for the outbound rule of the shoot's load balancer or nil if the
+// egress traffic does not flow via the load balancer.
+func (ia *InfrastructureAdapter) OutboundLoadBalancerConfig() *OutboundLoadBalancerConfig {
+	outboundAccessType := ia.config.Networks.OutboundAccessType
+	if outboundAccessType == nil || string(*outboundAccessType) != azure.OutboundAccessTypeLoadBalancer {
+		return nil
+	}
+
+	// The load balancer and its backend pool are shared with the cloud-controller-manager, which names them after the
+	// cluster, i.e. the shoot's namespace in the seed.
+	cfg := &OutboundLoadBalancerConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.ResourceGroupName(),
+			Name:          ia.infra.Namespace,
+			Kind:          KindLoadBalancer,
+		},
+		Location:         ia.Region(),
+		BackendPoolName:  ia.infra.Namespace,
+		OutboundRuleName: fmt.Sprintf("%s-outbound", ia.TechnicalName()),
+	}
+
+	publicIPCount := int32(1)
+	if lb := ia.config.Networks.OutboundLoadBalancer; lb != nil {
+		cfg.AllocatedOutboundPorts = lb.AllocatedOutboundPorts
+		if lb.PublicIPCount != nil {
+			publicIPCount = *lb.PublicIPCount
+		}
+	}
+	for i := int32(0); i < publicIPCount; i++ {
+		cfg.PublicIPList = append(cfg.PublicIPList, PublicIPConfig{
+			AzureResourceMetadata: AzureResourceMetadata{
+				ResourceGroup: ia.ResourceGroupName(),
+				Name:          fmt.Sprintf("%s-outbound-ip-%d", ia.TechnicalName(), i),
+				Kind:          KindPublicIP,
+			},
+			Managed:  true,
+			Location: ia.Region(),
+		})
+	}
+
+	return cfg
+}
+
+// EgressFirewallConfig contains the configuration for the Azure Firewall through which the egress traffic is routed.
+type EgressFirewallConfig struct {
+	AzureResourceMetadata
+	Location string
+	// Subnet and ManagementSubnet are the subnets of the firewall in the shoot's vnet.
+	Subnet           AzureResourceMetadata
+	SubnetCIDR       string
+	ManagementSubnet AzureResourceMetadata
+	ManagementCIDR   string
+	// Policy is the managed firewall policy. It is only used if no existing policy is configured via PolicyID.
+	Policy   AzureResourceMetadata
+	PolicyID *string
+	// SourceCIDRs are the CIDRs of the subnets whose egress traffic is allowed by the managed firewall policy.
+	SourceCIDRs        []string
+	PublicIP           PublicIPConfig
+	ManagementPublicIP PublicIPConfig
+}
+
+// EgressFirewallConfig returns the configuration for the Azure Firewall through which the egress traffic is routed or
+// nil if no egress firewall is configured.
+func (ia *InfrastructureAdapter) EgressFirewallConfig() *EgressFirewallConfig {
+	firewall := ia.config.Networks.EgressFirewall
+	if firewall == nil {
+		return nil
+	}
+
+	publicIP := func(name string) PublicIPConfig {
+		return PublicIPConfig{
+			AzureResourceMetadata: AzureResourceMetadata{
+				ResourceGroup: ia.ResourceGroupName(),
+				Name:          name,
+				Kind:          KindPublicIP,
+			},
+			Managed:  true,
+			Location: ia.Region(),
+		}
+	}
+	cfg := &EgressFirewallConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.ResourceGroupName(),
+			Name:          fmt.Sprintf("%s-firewall", ia.TechnicalName()),
+			Kind:          KindAzureFirewall,
+		},
+		Location: ia.Region(),
+		Subnet: AzureResourceMetadata{
+			ResourceGroup: ia.vnetConfig.ResourceGroup,
+			Name:          egressFirewallSubnetName,
+			Parent:        ia.vnetConfig.Name,
+			Kind:          KindSubnet,
+		},
+		SubnetCIDR: firewall.CIDR,
+		ManagementSubnet: AzureResourceMetadata{
+			ResourceGroup: ia.vnetConfig.ResourceGroup,
+			Name:          egressFirewallManagementSubnetName,
+			Parent:        ia.vnetConfig.Name,
+			Kind:          KindSubnet,
+		},
+		ManagementCIDR: firewall.ManagementCIDR,
+		Policy: AzureResourceMetadata{
+			ResourceGroup: ia.ResourceGroupName(),
+			Name:          fmt.Sprintf("%s-firewall-policy", ia.TechnicalName()),
+			Kind:          KindFirewallPolicy,
+		},
+		PolicyID:           firewall.PolicyID,
+		PublicIP:           publicIP(fmt.Sprintf("%s-firewall-ip", ia.TechnicalName())),
+		ManagementPublicIP: publicIP(fmt.Sprintf("%s-firewall-mgmt-ip", ia.TechnicalName())),
+	}
+	for _, z := range ia.zoneConfigs {
+		cfg.SourceCIDRs = append(cfg.SourceCIDRs, z.Subnet.cidr)
+	}
+	if podSubnet := ia.config.Networks.PodSubnet; podSubnet != nil && podSubnet.CIDR != nil {
+		cfg.SourceCIDRs = append(cfg.SourceCIDRs, *podSubnet.CIDR)
+	}
+
+	return cfg
+}
+
+// IsEgressFirewallSubnetName returns true if the subnet with the given name is one of the subnets of the egress firewall.
+func (ia *InfrastructureAdapter) IsEgressFirewallSubnetName(name string) bool {
+	return name == egressFirewallSubnetName || name == egressFirewallManagementSubnetName
+}
+
 // HasShootPrefix returns true if the target resource's name is prefixed with the shoot's canonical name.
 func (ia *InfrastructureAdapter) HasShootPrefix(name *string) bool {
 	if name == nil {
@@ -533,6 +910,144 @@ func (ip *PublicIPConfig) ToProvider(base *armnetwork.PublicIPAddress) *armnetwo
 		// if no zones selected, zones has to be nil, to match what the API returns - otherwise reflect.DeepEqual fails the check.
 		target.Zones = to.SliceOfPtrs(ip.Zones...)
 	}
+	if ip.DDoSProtectionMode != nil {
+		target.Properties.DdosSettings = &armnetwork.DdosSettings{
+			ProtectionMode: to.Ptr(armnetwork.DdosSettingsProtectionMode(*ip.DDoSProtectionMode)),
+		}
+	}
+	if ip.DNSSettings != nil {
+		target.Properties.DNSSettings = &armnetwork.PublicIPAddressDNSSettings{
+			DomainNameLabel: to.Ptr(ip.DNSSettings.DomainNameLabel),
+			ReverseFqdn:     ip.DNSSettings.ReverseFQDN,
+		}
+	}
+
+	// inherited from base
+	if base != nil {
+		target.ID = base.ID
+		target.Tags = base.Tags
+	}
+
+	return target
+}
+
+// ToProvider merges the frontends of the managed public IPs, the backend pool and the outbound rule into the given load
+// balancer. The frontends and rules that are managed by the cloud-controller-manager are left untouched.
+func (lb *OutboundLoadBalancerConfig) ToProvider(base *armnetwork.LoadBalancer, subscriptionID string) *armnetwork.LoadBalancer {
+	target := &armnetwork.LoadBalancer{
+		Location: to.Ptr(lb.Location),
+		Name:     to.Ptr(lb.Name),
+		SKU: &armnetwork.LoadBalancerSKU{
+			Name: to.Ptr(armnetwork.LoadBalancerSKUNameStandard),
+			Tier: to.Ptr(armnetwork.LoadBalancerSKUTierRegional),
+		},
+		Properties: &armnetwork.LoadBalancerPropertiesFormat{},
+	}
+
+	// inherited from base
+	if base != nil {
+		target.ID = base.ID
+		target.Tags = base.Tags
+		if base.Properties != nil {
+			properties := *base.Properties
+			target.Properties = &properties
+		}
+	}
+	target.Properties.OutboundRules = Filter(target.Properties.OutboundRules, func(rule *armnetwork.OutboundRule) bool {
+		return rule != nil && ptr.Deref(rule.Name, "") != lb.OutboundRuleName
+	})
+
+	lbID := GetIdFromTemplate(TemplateLoadBalancer, subscriptionID, lb.ResourceGroup, lb.Name)
+
+	ownFrontends := sets.New[string]()
+	for _, ip := range lb.PublicIPList {
+		ownFrontends.Insert(ip.Name)
+	}
+	target.Properties.FrontendIPConfigurations = Filter(target.Properties.FrontendIPConfigurations, func(frontend *armnetwork.FrontendIPConfiguration) bool {
+		return frontend != nil && !ownFrontends.Has(ptr.Deref(frontend.Name, ""))
+	})
+	var frontendRefs []*armnetwork.SubResource
+	for _, ip := range lb.PublicIPList {
+		target.Properties.FrontendIPConfigurations = append(target.Properties.FrontendIPConfigurations, &armnetwork.FrontendIPConfiguration{
+			Name: to.Ptr(ip.Name),
+			Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{
+				PublicIPAddress: &armnetwork.PublicIPAddress{
+					ID: to.Ptr(GetIdFromTemplate(TemplatePublicIP, subscriptionID, ip.ResourceGroup, ip.Name)),
+				},
+			},
+		})
+		frontendRefs = append(frontendRefs, &armnetwork.SubResource{ID: to.Ptr(fmt.Sprintf("%s/frontendIPConfigurations/%s", lbID, ip.Name))})
+	}
+
+	if !slices.ContainsFunc(target.Properties.BackendAddressPools, func(pool *armnetwork.BackendAddressPool) bool {
+		return pool != nil && ptr.Deref(pool.Name, "") == lb.BackendPoolName
+	}) {
+		target.Properties.BackendAddressPools = append(target.Properties.BackendAddressPools, &armnetwork.BackendAddressPool{
+			Name: to.Ptr(lb.BackendPoolName),
+		})
+	}
+
+	target.Properties.OutboundRules = append(target.Properties.OutboundRules, &armnetwork.OutboundRule{
+		Name: to.Ptr(lb.OutboundRuleName),
+		Properties: &armnetwork.OutboundRulePropertiesFormat{
+			Protocol:                 to.Ptr(armnetwork.LoadBalancerOutboundRuleProtocolAll),
+			BackendAddressPool:       &armnetwork.SubResource{ID: to.Ptr(fmt.Sprintf("%s/backendAddressPools/%s", lbID, lb.BackendPoolName))},
+			FrontendIPConfigurations: frontendRefs,
+			AllocatedOutboundPorts:   to.Ptr(ptr.Deref(lb.AllocatedOutboundPorts, 0)),
+			EnableTCPReset:           to.Ptr(true),
+		},
+	})
+
+	return target
+}
+
+// ToProvider translates the config into the actual providerAccess object.
+func (f *EgressFirewallConfig) ToProvider(base *armnetwork.AzureFirewall, subscriptionID string) *armnetwork.AzureFirewall {
+	policyID := GetIdFromTemplate(TemplateFirewallPolicy, subscriptionID, f.Policy.ResourceGroup, f.Policy.Name)
+	if f.PolicyID != nil {
+		policyID = *f.PolicyID
+	}
+	ipConfiguration := func(name string, subnet AzureResourceMetadata, ip PublicIPConfig) *armnetwork.AzureFirewallIPConfiguration {
+		return &armnetwork.AzureFirewallIPConfiguration{
+			Name: to.Ptr(name),
+			Properties: &armnetwork.AzureFirewallIPConfigurationPropertiesFormat{
+				Subnet:          &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplateWithParent(TemplateSubnet, subscriptionID, subnet.ResourceGroup, subnet.Parent, subnet.Name))},
+				PublicIPAddress: &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplatePublicIP, subscriptionID, ip.ResourceGroup, ip.Name))},
+			},
+		}
+	}
+
+	target := &armnetwork.AzureFirewall{
+		Location: to.Ptr(f.Location),
+		Properties: &armnetwork.AzureFirewallPropertiesFormat{
+			SKU: &armnetwork.AzureFirewallSKU{
+				Name: to.Ptr(armnetwork.AzureFirewallSKUNameAZFWVnet),
+				Tier: to.Ptr(armnetwork.AzureFirewallSKUTierBasic),
+			},
+			FirewallPolicy:            &armnetwork.SubResource{ID: to.Ptr(policyID)},
+			IPConfigurations:          []*armnetwork.AzureFirewallIPConfiguration{ipConfiguration("ipconfig", f.Subnet, f.PublicIP)},
+			ManagementIPConfiguration: ipConfiguration("mgmt-ipconfig", f.ManagementSubnet, f.ManagementPublicIP),
+		},
+	}
+
+	// inherited from base
+	if base != nil {
+		target.ID = base.ID
+		target.Tags = base.Tags
+		target.Zones = base.Zones
+	}
+
+	return target
+}
+
+// PolicyToProvider returns the managed firewall policy of the Basic tier.
+func (f *EgressFirewallConfig) PolicyToProvider(base *armnetwork.FirewallPolicy) *armnetwork.FirewallPolicy {
+	target := &armnetwork.FirewallPolicy{
+		Location: to.Ptr(f.Location),
+		Properties: &armnetwork.FirewallPolicyPropertiesFormat{
+			SKU: &armnetwork.FirewallPolicySKU{Tier: to.Ptr(armnetwork.FirewallPolicySKUTierBasic)},
+		},
+	}
 
 	// inherited from base
 	if base != nil {
@@ -543,6 +1058,63 @@ func (ip *PublicIPConfig) ToProvider(base *armnetwork.PublicIPAddress) *armnetwo
 	return target
 }
 
+// RuleCollectionGroupToProvider returns the rule collection group of the managed firewall policy, which allows all
+// egress traffic of the shoot's subnets.
+func (f *EgressFirewallConfig) RuleCollectionGroupToProvider() *armnetwork.FirewallPolicyRuleCollectionGroup {
+	return &armnetwork.FirewallPolicyRuleCollectionGroup{
+		Properties: &armnetwork.FirewallPolicyRuleCollectionGroupProperties{
+			Priority: to.Ptr[int32](100),
+			RuleCollections: []armnetwork.FirewallPolicyRuleCollectionClassification{
+				&armnetwork.FirewallPolicyFilterRuleCollection{
+					Name:               to.Ptr("allow-egress"),
+					Priority:           to.Ptr[int32](100),
+					RuleCollectionType: to.Ptr(armnetwork.FirewallPolicyRuleCollectionTypeFirewallPolicyFilterRuleCollection),
+					Action:             &armnetwork.FirewallPolicyFilterRuleCollectionAction{Type: to.Ptr(armnetwork.FirewallPolicyFilterRuleCollectionActionTypeAllow)},
+					Rules: []armnetwork.FirewallPolicyRuleClassification{
+						&armnetwork.Rule{
+							Name:                 to.Ptr("allow-all-egress"),
+							RuleType:             to.Ptr(armnetwork.FirewallPolicyRuleTypeNetworkRule),
+							IPProtocols:          []*armnetwork.FirewallPolicyRuleNetworkProtocol{to.Ptr(armnetwork.FirewallPolicyRuleNetworkProtocolAny)},
+							SourceAddresses:      to.SliceOfPtrs(f.SourceCIDRs...),
+							DestinationAddresses: []*string{to.Ptr("*")},
+							DestinationPorts:     []*string{to.Ptr("*")},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ToProvider translates the config into the actual providerAccess object.
+func (s *PodSubnetConfig) ToProvider(base *armnetwork.Subnet) *armnetwork.Subnet {
+	target := &armnetwork.Subnet{
+		Name: to.Ptr(s.Name),
+		Properties: &armnetwork.SubnetPropertiesFormat{
+			AddressPrefix: s.cidr,
+			Delegations: []*armnetwork.Delegation{
+				{
+					Name: to.Ptr(podSubnetDelegationName),
+					Properties: &armnetwork.ServiceDelegationPropertiesFormat{
+						ServiceName: to.Ptr(podSubnetDelegationServiceName),
+					},
+				},
+			},
+		},
+	}
+
+	// inherited from base
+	if base != nil {
+		target.ID = base.ID
+		if base.Properties != nil {
+			target.Properties.NatGateway = base.Properties.NatGateway
+			target.Properties.NetworkSecurityGroup = base.Properties.NetworkSecurityGroup
+		}
+	}
+
+	return target
+}
+
 // ToProvider translates the config into the actual providerAccess object.
 func (nat *NatGatewayConfig) ToProvider(base *armnetwork.NatGateway) *armnetwork.NatGateway {
 	target := &armnetwork.NatGateway{
@@ -621,7 +1193,7 @@ func (v *VirtualNetworkConfig) ToProvider(base *armnetwork.VirtualNetwork) *armn
 
 	// apply the desired changes in place.
 	desired.Properties.AddressSpace = &armnetwork.AddressSpace{
-		AddressPrefixes: []*string{v.CIDR},
+		AddressPrefixes: v.addressPrefixes(desired.Properties.AddressSpace),
 	}
 	if ddosId := v.DDoSPlanID; ddosId != nil {
 		desired.Properties.EnableDdosProtection = to.Ptr(true)
@@ -634,6 +1206,41 @@ func (v *VirtualNetworkConfig) ToProvider(base *armnetwork.VirtualNetwork) *armn
 	return desired
 }
 
+// addressPrefixes merges the configured address prefixes into the current address space of the vnet. Current prefixes
+// which do not overlap with the configured ones are kept, as they may be in use by subnets. Overlapping prefixes are
+// replaced, e.g. when the primary CIDR was expanded.
+func (v *VirtualNetworkConfig) addressPrefixes(current *armnetwork.AddressSpace) []*string {
+	prefixes := []*string{v.CIDR}
+	for _, cidr := range v.AdditionalCIDRs {
+		prefixes = append(prefixes, to.Ptr(cidr))
+	}
+	if current == nil {
+		return prefixes
+	}
+
+	var desiredNets []*net.IPNet
+	for _, prefix := range prefixes {
+		if _, ipNet, err := net.ParseCIDR(ptr.Deref(prefix, "")); err == nil {
+			desiredNets = append(desiredNets, ipNet)
+		}
+	}
+
+currentPrefixes:
+	for _, prefix := range current.AddressPrefixes {
+		_, currentNet, err := net.ParseCIDR(ptr.Deref(prefix, ""))
+		if err != nil {
+			continue
+		}
+		for _, desiredNet := range desiredNets {
+			if desiredNet.Contains(currentNet.IP) || currentNet.Contains(desiredNet.IP) {
+				continue currentPrefixes
+			}
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes
+}
+
 // ToProvider translates the config into the actual providerAccess object.
 func (r *SecurityGroupConfig) ToProvider(base *armnetwork.SecurityGroup) *armnetwork.SecurityGroup {
 	desired := &armnetwork.SecurityGroup{
@@ -660,6 +1267,27 @@ func (r *RouteTableConfig) ToProvider(base *armnetwork.RouteTable) *armnetwork.R
 		desired.Properties = base.Properties
 	}
 
+	// the route table also contains the pod routes maintained by the cloud-controller-manager, hence only the default
+	// route is touched.
+	routes := make([]*armnetwork.Route, 0, len(desired.Properties.Routes)+1)
+	for _, route := range desired.Properties.Routes {
+		if route != nil && route.Name != nil && *route.Name == defaultRouteName {
+			continue
+		}
+		routes = append(routes, route)
+	}
+	if r.NextHopIPAddress != nil {
+		routes = append(routes, &armnetwork.Route{
+			Name: to.Ptr(defaultRouteName),
+			Properties: &armnetwork.RoutePropertiesFormat{
+				AddressPrefix:    to.Ptr("0.0.0.0/0"),
+				NextHopType:      to.Ptr(armnetwork.RouteNextHopTypeVirtualAppliance),
+				NextHopIPAddress: to.Ptr(*r.NextHopIPAddress),
+			},
+		})
+	}
+	desired.Properties.Routes = routes
+
 	return desired
 }
 
@@ -0,0 +1,352 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/features"
+)
+
+var _ = Describe("InfrastructureAdapter", func() {
+	var (
+		infra   *extensionsv1alpha1.Infrastructure
+		config  *azure.InfrastructureConfig
+		profile *azure.CloudProfileConfig
+	)
+
+	BeforeEach(func() {
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "westeurope"},
+		}
+		config = &azure.InfrastructureConfig{
+			Networks: azure.NetworkConfig{
+				VNet:    azure.VNet{CIDR: ptr.To("10.250.0.0/16")},
+				Workers: ptr.To("10.250.0.0/19"),
+				NatGateway: &azure.NatGatewayConfig{
+					Enabled:        true,
+					DDoSProtection: &azure.PublicIPDDoSProtection{Mode: azure.PublicIPDDoSProtectionModeEnabled},
+				},
+			},
+		}
+		profile = &azure.CloudProfileConfig{
+			CountFaultDomains:  []azure.DomainCount{{Region: "westeurope", Count: 2}},
+			CountUpdateDomains: []azure.DomainCount{{Region: "westeurope", Count: 5}},
+		}
+	})
+
+	Describe("#ManagedIpConfigs", func() {
+		It("should apply the DDoS protection to the managed public IP if the feature gate is enabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.PublicIPDDoSProtection, true))
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			ips := adapter.ManagedIpConfigs()
+			Expect(ips).To(HaveLen(1))
+			for _, ip := range ips {
+				Expect(ip.DDoSProtectionMode).To(Equal(ptr.To("Enabled")))
+				Expect(ip.ToProvider(nil).Properties.DdosSettings).To(Equal(&armnetwork.DdosSettings{
+					ProtectionMode: ptr.To(armnetwork.DdosSettingsProtectionModeEnabled),
+				}))
+			}
+		})
+
+		It("should ignore the DDoS protection if the feature gate is disabled", func() {
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			ips := adapter.ManagedIpConfigs()
+			Expect(ips).To(HaveLen(1))
+			for _, ip := range ips {
+				Expect(ip.DDoSProtectionMode).To(BeNil())
+				Expect(ip.ToProvider(nil).Properties.DdosSettings).To(BeNil())
+			}
+		})
+
+		It("should apply the DNS settings to the managed public IP", func() {
+			config.Networks.NatGateway.DNSSettings = &azure.PublicIPDNSSettings{
+				DomainNameLabel: "shoot-egress",
+				ReverseFQDN:     ptr.To("egress.example.com."),
+			}
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			ips := adapter.ManagedIpConfigs()
+			Expect(ips).To(HaveLen(1))
+			for _, ip := range ips {
+				Expect(ip.ToProvider(nil).Properties.DNSSettings).To(Equal(&armnetwork.PublicIPAddressDNSSettings{
+					DomainNameLabel: ptr.To("shoot-egress"),
+					ReverseFqdn:     ptr.To("egress.example.com."),
+				}))
+			}
+		})
+
+		It("should not set DNS settings if none are configured", func() {
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, ip := range adapter.ManagedIpConfigs() {
+				Expect(ip.ToProvider(nil).Properties.DNSSettings).To(BeNil())
+			}
+		})
+	})
+
+	Describe("#NatGatewayConfigs", func() {
+		var worker *extensionsv1alpha1.Worker
+
+		ipNames := func(cfg infraflow.NatGatewayConfig) []string {
+			var names []string
+			for _, ip := range cfg.PublicIPList {
+				names = append(names, ip.Name)
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			config.Networks.NatGateway.DDoSProtection = nil
+			worker = &extensionsv1alpha1.Worker{
+				Spec: extensionsv1alpha1.WorkerSpec{
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{Name: "a", Maximum: 90, Zones: []string{"1", "2"}},
+						{Name: "b", Maximum: 20, Zones: []string{"2"}},
+					},
+				},
+			}
+		})
+
+		It("should create one public IP if the public IPs are not scaled automatically", func() {
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+			adapter.WithWorker(worker)
+
+			nats := adapter.NatGatewayConfigs()
+			Expect(nats).To(HaveLen(1))
+			for _, nat := range nats {
+				Expect(ipNames(nat)).To(ConsistOf("shoot--foo--bar-nat-gateway-ip"))
+				Expect(nat.Nodes).To(BeNil())
+			}
+		})
+
+		It("should scale the public IPs with the nodes of all zones", func() {
+			config.Networks.NatGateway.AutoScaleIPs = &azure.NatGatewayIPAutoScaling{NodesPerIP: 50, MaxIPCount: 4}
+			config.Networks.NatGateway.DNSSettings = &azure.PublicIPDNSSettings{DomainNameLabel: "shoot-egress"}
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+			adapter.WithWorker(worker)
+
+			nats := adapter.NatGatewayConfigs()
+			Expect(nats).To(HaveLen(1))
+			for _, nat := range nats {
+				Expect(nat.Nodes).To(Equal(ptr.To[int32](110)))
+				Expect(ipNames(nat)).To(ConsistOf("shoot--foo--bar-nat-gateway-ip", "shoot--foo--bar-nat-gateway-ip-1", "shoot--foo--bar-nat-gateway-ip-2"))
+				Expect(nat.PublicIPList[0].DNSSettings).NotTo(BeNil())
+				Expect(nat.PublicIPList[1].DNSSettings).To(BeNil())
+			}
+			Expect(adapter.ManagedIpConfigs()).To(HaveLen(3))
+		})
+
+		It("should not exceed the maximum number of public IPs", func() {
+			config.Networks.NatGateway.AutoScaleIPs = &azure.NatGatewayIPAutoScaling{NodesPerIP: 10, MaxIPCount: 2}
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+			adapter.WithWorker(worker)
+
+			for _, nat := range adapter.NatGatewayConfigs() {
+				Expect(nat.PublicIPList).To(HaveLen(2))
+			}
+		})
+
+		It("should keep one public IP if the worker does not exist", func() {
+			config.Networks.NatGateway.AutoScaleIPs = &azure.NatGatewayIPAutoScaling{NodesPerIP: 10, MaxIPCount: 2}
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, nat := range adapter.NatGatewayConfigs() {
+				Expect(nat.PublicIPList).To(HaveLen(1))
+				Expect(nat.Nodes).To(Equal(ptr.To[int32](0)))
+			}
+		})
+
+		It("should scale the public IPs of the NAT gateways with the nodes of their zone", func() {
+			config.Zoned = true
+			config.Networks.Workers = nil
+			config.Networks.NatGateway = nil
+			config.Networks.Zones = []azure.Zone{
+				{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true, AutoScaleIPs: &azure.NatGatewayIPAutoScaling{NodesPerIP: 50, MaxIPCount: 4}}},
+				{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true, AutoScaleIPs: &azure.NatGatewayIPAutoScaling{NodesPerIP: 50, MaxIPCount: 4}}},
+			}
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+			adapter.WithWorker(worker)
+
+			nats := adapter.NatGatewayConfigs()
+			Expect(nats).To(HaveLen(2))
+			Expect(nats["shoot--foo--bar-nat-gateway-z1"].Nodes).To(Equal(ptr.To[int32](45)))
+			Expect(nats["shoot--foo--bar-nat-gateway-z1"].PublicIPList).To(HaveLen(1))
+			Expect(nats["shoot--foo--bar-nat-gateway-z2"].Nodes).To(Equal(ptr.To[int32](65)))
+			Expect(nats["shoot--foo--bar-nat-gateway-z2"].PublicIPList).To(HaveLen(2))
+		})
+
+		It("should not manage existing NAT gateways", func() {
+			config.Zoned = true
+			config.Networks.Workers = nil
+			config.Networks.NatGateway = nil
+			config.Networks.Zones = []azure.Zone{
+				{Name: 1, CIDR: "10.250.0.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true}},
+				{Name: 2, CIDR: "10.250.1.0/24", NatGateway: &azure.ZonedNatGatewayConfig{Enabled: true, Existing: &azure.NatGatewayReference{Name: "central-nat", ResourceGroup: "central-rg"}}},
+			}
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.NatGatewayConfigs()).To(HaveKey("shoot--foo--bar-nat-gateway-z1"))
+			Expect(adapter.NatGatewayConfigs()).To(HaveLen(1))
+			Expect(adapter.ManagedIpConfigs()).To(HaveLen(1))
+
+			existing := adapter.ExistingNatGatewayConfigs()
+			Expect(existing).To(HaveLen(1))
+			Expect(existing[0].Name).To(Equal("central-nat"))
+			Expect(existing[0].ResourceGroup).To(Equal("central-rg"))
+			Expect(existing[0].Zone).To(Equal(ptr.To("2")))
+			Expect(existing[0].PublicIPList).To(BeEmpty())
+		})
+	})
+
+	Describe("#Zones", func() {
+		It("should keep the nodes subnet and not reconcile an availability set if a non-zoned shoot is converted to a zoned shoot", func() {
+			status := &azure.InfrastructureStatus{ResourceGroup: azure.ResourceGroup{Name: "shoot--foo--bar"}}
+			nonZoned, err := infraflow.NewInfrastructureAdapter(infra, config, status, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			config.Zoned = true
+			config.Networks.NatGateway.Zone = ptr.To[int32](1)
+			zoned, err := infraflow.NewInfrastructureAdapter(infra, config, status, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(zoned.IsAvailabilitySetReconciliationRequired()).To(BeFalse())
+			Expect(zoned.Zones()).To(HaveLen(1))
+			Expect(zoned.Zones()[0].Subnet.Name).To(Equal(nonZoned.Zones()[0].Subnet.Name))
+			Expect(zoned.Zones()[0].NatGateway.Name).To(Equal(nonZoned.Zones()[0].NatGateway.Name))
+			Expect(zoned.Zones()[0].NatGateway.Zone).To(Equal(ptr.To("1")))
+		})
+	})
+
+	Describe("#OutboundLoadBalancerConfig", func() {
+		BeforeEach(func() {
+			config.Networks.NatGateway = nil
+		})
+
+		It("should return nil if the outbound access type is not LoadBalancer", func() {
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(adapter.OutboundLoadBalancerConfig()).To(BeNil())
+			Expect(adapter.ManagedIpConfigs()).To(BeEmpty())
+		})
+
+		It("should return the configuration of the shoot's load balancer and its public IPs", func() {
+			config.Networks.OutboundAccessType = ptr.To[azure.OutboundAccessType](azure.OutboundAccessTypeLoadBalancer)
+			config.Networks.OutboundLoadBalancer = &azure.OutboundLoadBalancerConfig{
+				AllocatedOutboundPorts: ptr.To[int32](1024),
+				PublicIPCount:          ptr.To[int32](2),
+			}
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			lb := adapter.OutboundLoadBalancerConfig()
+			Expect(lb).NotTo(BeNil())
+			Expect(lb.Name).To(Equal("shoot--foo--bar"))
+			Expect(lb.ResourceGroup).To(Equal("shoot--foo--bar"))
+			Expect(lb.BackendPoolName).To(Equal("shoot--foo--bar"))
+			Expect(lb.OutboundRuleName).To(Equal("shoot--foo--bar-outbound"))
+			Expect(lb.AllocatedOutboundPorts).To(Equal(ptr.To[int32](1024)))
+			Expect(lb.PublicIPList).To(HaveLen(2))
+			Expect(adapter.ManagedIpConfigs()).To(SatisfyAll(
+				HaveLen(2),
+				HaveKey("shoot--foo--bar-outbound-ip-0"),
+				HaveKey("shoot--foo--bar-outbound-ip-1"),
+			))
+		})
+
+		It("should merge the outbound rule into the load balancer of the cloud-controller-manager", func() {
+			config.Networks.OutboundAccessType = ptr.To[azure.OutboundAccessType](azure.OutboundAccessTypeLoadBalancer)
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			current := &armnetwork.LoadBalancer{
+				ID:   ptr.To("lb-id"),
+				Name: ptr.To("shoot--foo--bar"),
+				Properties: &armnetwork.LoadBalancerPropertiesFormat{
+					FrontendIPConfigurations: []*armnetwork.FrontendIPConfiguration{{Name: ptr.To("ccm-frontend")}},
+					BackendAddressPools:      []*armnetwork.BackendAddressPool{{Name: ptr.To("shoot--foo--bar")}},
+					LoadBalancingRules:       []*armnetwork.LoadBalancingRule{{Name: ptr.To("ccm-rule")}},
+					OutboundRules:            []*armnetwork.OutboundRule{{Name: ptr.To("shoot--foo--bar-outbound")}},
+				},
+			}
+
+			lb := adapter.OutboundLoadBalancerConfig().ToProvider(current, "sub")
+			Expect(lb.ID).To(Equal(ptr.To("lb-id")))
+			Expect(lb.Properties.LoadBalancingRules).To(Equal(current.Properties.LoadBalancingRules))
+			Expect(lb.Properties.BackendAddressPools).To(HaveLen(1))
+			Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+			Expect(*lb.Properties.FrontendIPConfigurations[1].Name).To(Equal("shoot--foo--bar-outbound-ip-0"))
+			Expect(lb.Properties.OutboundRules).To(HaveLen(1))
+			rule := lb.Properties.OutboundRules[0].Properties
+			Expect(rule.BackendAddressPool.ID).To(Equal(ptr.To("/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/loadBalancers/shoot--foo--bar/backendAddressPools/shoot--foo--bar")))
+			Expect(rule.FrontendIPConfigurations).To(ConsistOf(&armnetwork.SubResource{
+				ID: ptr.To("/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/loadBalancers/shoot--foo--bar/frontendIPConfigurations/shoot--foo--bar-outbound-ip-0"),
+			}))
+			Expect(rule.Protocol).To(Equal(ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolAll)))
+		})
+	})
+
+	Describe("#VirtualNetworkConfig", func() {
+		It("should merge the additional CIDRs into the address space of the vnet", func() {
+			config.Networks.VNet.AdditionalCIDRs = []string{"172.16.0.0/16"}
+
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			vnetConfig := adapter.VirtualNetworkConfig()
+			current := &armnetwork.VirtualNetwork{Properties: &armnetwork.VirtualNetworkPropertiesFormat{
+				AddressSpace: &armnetwork.AddressSpace{AddressPrefixes: []*string{
+					ptr.To("10.250.0.0/17"),
+					ptr.To("192.168.0.0/24"),
+				}},
+			}}
+			Expect(vnetConfig.ToProvider(current).Properties.AddressSpace.AddressPrefixes).To(HaveExactElements(
+				ptr.To("10.250.0.0/16"),
+				ptr.To("172.16.0.0/16"),
+				ptr.To("192.168.0.0/24"),
+			))
+		})
+
+		It("should only use the configured CIDRs for a new vnet", func() {
+			adapter, err := infraflow.NewInfrastructureAdapter(infra, config, nil, profile, &extensionscontroller.Cluster{})
+			Expect(err).NotTo(HaveOccurred())
+
+			vnetConfig := adapter.VirtualNetworkConfig()
+			Expect(vnetConfig.ToProvider(nil).Properties.AddressSpace.AddressPrefixes).To(HaveExactElements(ptr.To("10.250.0.0/16")))
+		})
+	})
+})
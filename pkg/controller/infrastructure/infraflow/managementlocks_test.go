@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("EnsureNoManagementLocks", func() {
+	const resourceGroup = "shoot--foo--bar"
+
+	var (
+		ctx = context.Background()
+
+		ctrl    *gomock.Controller
+		factory *mockclient.MockFactory
+		locks   *mockclient.MockManagementLocks
+		opts    infraflow.Opts
+
+		vnetLock = &client.ManagementLockObject{
+			ID:   ptr.To("/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/virtualNetworks/vnet/providers/Microsoft.Authorization/locks/vnet-lock"),
+			Name: ptr.To("vnet-lock"),
+			Properties: &client.ManagementLockProperties{
+				Level: ptr.To(client.LockLevelCanNotDelete),
+				Notes: ptr.To("do not delete"),
+			},
+		}
+		ownLock = &client.ManagementLockObject{
+			ID:   ptr.To("/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Authorization/locks/own-lock"),
+			Name: ptr.To("own-lock"),
+			Properties: &client.ManagementLockProperties{
+				Level: ptr.To(client.LockLevelReadOnly),
+				Notes: ptr.To(azuretypes.ManagementLockNotesPrefix + ", shoot--foo--bar"),
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		locks = mockclient.NewMockManagementLocks(ctrl)
+		factory.EXPECT().ManagementLocks().Return(locks, nil).AnyTimes()
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"}}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	It("should succeed if there are no locks", func() {
+		locks.EXPECT().ListAtResourceGroupLevel(gomock.Any(), resourceGroup).Return(nil, nil)
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.EnsureNoManagementLocks(ctx)).To(Succeed())
+	})
+
+	It("should skip the check if the credentials are not allowed to read the locks", func() {
+		locks.EXPECT().ListAtResourceGroupLevel(gomock.Any(), resourceGroup).Return(nil, &azcore.ResponseError{StatusCode: http.StatusForbidden})
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.EnsureNoManagementLocks(ctx)).To(Succeed())
+	})
+
+	It("should return an error naming the blocking locks", func() {
+		locks.EXPECT().ListAtResourceGroupLevel(gomock.Any(), resourceGroup).Return([]*client.ManagementLockObject{vnetLock, ownLock}, nil)
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fctx.EnsureNoManagementLocks(ctx)
+		var lockErr *infraflow.ManagementLockError
+		Expect(errors.As(err, &lockErr)).To(BeTrue())
+		Expect(lockErr.Locks).To(ConsistOf(vnetLock, ownLock))
+		Expect(err).To(MatchError(And(
+			ContainSubstring("ScopeLocked"),
+			ContainSubstring(`"vnet-lock" (level: CanNotDelete, scope: /subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/virtualNetworks/vnet)`),
+			ContainSubstring(`"own-lock" (level: ReadOnly, scope: /subscriptions/sub/resourceGroups/shoot--foo--bar)`),
+		)))
+	})
+
+	It("should only remove the own locks if configured", func() {
+		opts.ManagementLocks = config.ManagementLocksConfig{RemoveOwnLocks: true}
+		locks.EXPECT().ListAtResourceGroupLevel(gomock.Any(), resourceGroup).Return([]*client.ManagementLockObject{vnetLock, ownLock}, nil)
+		locks.EXPECT().DeleteByID(gomock.Any(), *ownLock.ID).Return(nil)
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fctx.EnsureNoManagementLocks(ctx)
+		var lockErr *infraflow.ManagementLockError
+		Expect(errors.As(err, &lockErr)).To(BeTrue())
+		Expect(lockErr.Locks).To(ConsistOf(vnetLock))
+	})
+
+	It("should succeed if all locks were created by the extension and removing them is enabled", func() {
+		opts.ManagementLocks = config.ManagementLocksConfig{RemoveOwnLocks: true}
+		locks.EXPECT().ListAtResourceGroupLevel(gomock.Any(), resourceGroup).Return([]*client.ManagementLockObject{ownLock}, nil)
+		locks.EXPECT().DeleteByID(gomock.Any(), *ownLock.ID).Return(nil)
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.EnsureNoManagementLocks(ctx)).To(Succeed())
+	})
+})
+
+var _ = Describe("ResourceGroupDeletionProtection", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		nsgID         = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/networkSecurityGroups/shoot--foo--bar-workers"
+		lockID        = nsgID + "/providers/Microsoft.Authorization/locks/gardener-deletion-protection"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl    *gomock.Controller
+		factory *mockclient.MockFactory
+		locks   *mockclient.MockManagementLocks
+		opts    infraflow.Opts
+	)
+
+	infraConfig := func(deletionProtection bool) *runtime.RawExtension {
+		raw := `{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"}`
+		if deletionProtection {
+			raw += `,"resourceGroup":{"deletionProtection":true}`
+		}
+		return &runtime.RawExtension{Raw: []byte(raw + "}")}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		locks = mockclient.NewMockManagementLocks(ctrl)
+		factory.EXPECT().ManagementLocks().Return(locks, nil).AnyTimes()
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Logger:  logr.Discard(),
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region:      "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{ProviderConfig: infraConfig(true)},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	It("should create a CanNotDelete lock on the security group and remove it once disabled", func() {
+		locks.EXPECT().CreateOrUpdateByScope(gomock.Any(), nsgID, "gardener-deletion-protection", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, lock client.ManagementLockObject) (*client.ManagementLockObject, error) {
+				Expect(lock.Properties.Level).To(PointTo(Equal(client.LockLevelCanNotDelete)))
+				Expect(lock.Properties.Notes).To(PointTo(HavePrefix(azuretypes.ManagementLockNotesPrefix)))
+				lock.ID = ptr.To(lockID)
+				return &lock, nil
+			})
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.EnsureResourceGroupDeletionProtection(ctx)).To(Succeed())
+
+		state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+		Expect(state.Data).To(ContainElement(lockID))
+		opts.State = &azure.InfrastructureState{Data: state.Data}
+		opts.Infra.Spec.ProviderConfig = infraConfig(false)
+		locks.EXPECT().DeleteByID(gomock.Any(), lockID).Return(nil)
+
+		fctx, err = infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.EnsureResourceGroupDeletionProtection(ctx)).To(Succeed())
+	})
+
+	It("should do nothing if the deletion protection was never enabled", func() {
+		opts.Infra.Spec.ProviderConfig = infraConfig(false)
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.EnsureResourceGroupDeletionProtection(ctx)).To(Succeed())
+		Expect(fctx.DeleteResourceGroupDeletionProtection(ctx)).To(Succeed())
+	})
+
+	It("should remove the lock on deletion even if its ID was not recorded", func() {
+		locks.EXPECT().DeleteByID(gomock.Any(), lockID).Return(nil)
+
+		fctx, err := infraflow.NewFlowContext(opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fctx.DeleteResourceGroupDeletionProtection(ctx)).To(Succeed())
+	})
+})
@@ -7,15 +7,99 @@ package infraflow
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
 )
 
+const (
+	// basicPublicIPPhaseDetached indicates that the public IP was disassociated from its resource but not yet upgraded.
+	basicPublicIPPhaseDetached = "detached"
+	// basicPublicIPPhaseUpgraded indicates that the public IP was upgraded but not yet associated with its resource again.
+	basicPublicIPPhaseUpgraded = "upgraded"
+
+	resourceTypeNatGateway               = "Microsoft.Network/natGateways"
+	resourceTypeNetworkInterfaceIPConfig = "Microsoft.Network/networkInterfaces/ipConfigurations"
+)
+
+// ccmFrontendConfigName matches the names of the frontend IP configurations which the cloud-controller-manager creates
+// for services of type LoadBalancer: "a" followed by the UID of the service without dashes, optionally suffixed by the
+// subnet of an internal load balancer or the IP family.
+var ccmFrontendConfigName = regexp.MustCompile(`^a[0-9a-f]{32}(-.+)?$`)
+
+// EnsureLoadBalancersManagedByCCM returns an error if the given load balancers of the shoot have frontend IP
+// configurations which were not created by the cloud-controller-manager. Those are not recreated after the load balancers
+// were deleted for the migration, hence deleting the load balancers would cause an outage of the traffic they serve.
+func (fctx *FlowContext) EnsureLoadBalancersManagedByCCM(ctx context.Context, loadBalancerNames ...string) error {
+	loadbalancerClient, err := fctx.factory.LoadBalancer()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range loadBalancerNames {
+		frontendConfigs, err := loadbalancerClient.GetFrontendConfigs(ctx, fctx.adapter.ResourceGroupName(), name)
+		if err != nil {
+			return err
+		}
+
+		var unmanaged []string
+		for _, fipc := range frontendConfigs {
+			if fipc == nil || fipc.Name == nil || ccmFrontendConfigName.MatchString(*fipc.Name) {
+				continue
+			}
+			unmanaged = append(unmanaged, *fipc.Name)
+		}
+		if len(unmanaged) == 0 {
+			continue
+		}
+
+		rules, err := loadbalancerClient.ListRules(ctx, fctx.adapter.ResourceGroupName(), name)
+		if err != nil {
+			return err
+		}
+		errs = append(errs, fmt.Errorf("load balancer %q has frontend IP configurations %v which are not managed by the cloud-controller-manager and used by the load balancing rules %v",
+			name, unmanaged, loadBalancingRulesOf(rules, unmanaged)))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("refusing to delete the load balancers for the migration, remove the externally managed configurations first: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// loadBalancingRulesOf returns the names of the given load balancing rules which use one of the given frontend IP
+// configurations.
+func loadBalancingRulesOf(rules []*armnetwork.LoadBalancingRule, frontendConfigNames []string) []string {
+	var names []string
+	for _, rule := range rules {
+		if rule == nil || rule.Name == nil || rule.Properties == nil || rule.Properties.FrontendIPConfiguration == nil || rule.Properties.FrontendIPConfiguration.ID == nil {
+			continue
+		}
+		resourceID, err := arm.ParseResourceID(*rule.Properties.FrontendIPConfiguration.ID)
+		if err != nil {
+			continue
+		}
+		for _, frontendConfigName := range frontendConfigNames {
+			if strings.EqualFold(resourceID.Name, frontendConfigName) {
+				names = append(names, *rule.Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
 // BackupPIPsForBasicLBMigration saves the Public IPs attached to the basic load balancer of the shoot to the state.
 func (fctx *FlowContext) BackupPIPsForBasicLBMigration(ctx context.Context) error {
 	loadbalancerClient, err := fctx.factory.LoadBalancer()
@@ -92,3 +176,237 @@ func (fctx *FlowContext) UpdatePublicIPs(ctx context.Context) error {
 	wg.Wait()
 	return errs
 }
+
+// MigrateBasicPublicIPs upgrades the Basic SKU public IPs owned by the shoot to the Standard SKU. Contrary to a deletion
+// and recreation, the upgrade preserves the addresses of the IPs. Each IP is disassociated from its resource, upgraded
+// and associated again. The progress is persisted in the state so that an interrupted migration is resumed by the next
+// reconciliation.
+func (fctx *FlowContext) MigrateBasicPublicIPs(ctx context.Context) error {
+	ipc, err := fctx.factory.PublicIP()
+	if err != nil {
+		return err
+	}
+
+	currentIPs, err := ipc.List(ctx, fctx.adapter.ResourceGroupName())
+	if err != nil {
+		return err
+	}
+
+	desiredConfiguration := fctx.adapter.ManagedIpConfigs()
+	currentIPs = Filter(currentIPs, func(address *armnetwork.PublicIPAddress) bool {
+		return fctx.adapter.HasShootPrefix(address.Name) &&
+			(address.Tags[azure.CCMServiceTagKey] == nil && address.Tags[azure.CCMLegacyServiceTagKey] == nil) &&
+			isBasicPublicIP(address)
+	}, func(address *armnetwork.PublicIPAddress) bool {
+		cfg, ok := desiredConfiguration[*address.Name]
+		if !ok {
+			// IPs which are not needed anymore are deleted by the public IP reconciliation.
+			return false
+		}
+		// IPs whose location or zones differ from the target are recreated by the public IP reconciliation anyway. The
+		// allocation method is not considered, as the migration switches it to static before upgrading the IP.
+		candidate := *address
+		candidate.Properties = &armnetwork.PublicIPAddressPropertiesFormat{PublicIPAllocationMethod: to.Ptr(armnetwork.IPAllocationMethodStatic)}
+		forceNew, _, _ := ForceNewIp(&candidate, cfg.ToProvider(address))
+		return !forceNew
+	})
+
+	wb := fctx.whiteboard.GetChild(ChildKeyMigration).GetChild(ChildKeyBasicPublicIPs)
+	toMigrate := map[string]struct{}{}
+	for _, ip := range currentIPs {
+		toMigrate[*ip.Name] = struct{}{}
+	}
+	for _, name := range wb.GetChildrenKeys() {
+		if wb.GetChild(name).Get(KeyPhase) != nil {
+			toMigrate[name] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(toMigrate))
+	for name := range toMigrate {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var joinError error
+	for _, name := range names {
+		joinError = errors.Join(joinError, fctx.migrateBasicPublicIP(ctx, ipc, name))
+	}
+	return joinError
+}
+
+func (fctx *FlowContext) migrateBasicPublicIP(ctx context.Context, ipc client.PublicIP, name string) error {
+	var (
+		resourceGroup = fctx.adapter.ResourceGroupName()
+		log           = shared.LogFromContext(ctx).WithValues("ResourceGroup", resourceGroup, "Name", name)
+		wb            = fctx.whiteboard.GetChild(ChildKeyMigration).GetChild(ChildKeyBasicPublicIPs).GetChild(name)
+	)
+
+	pip, err := ipc.Get(ctx, resourceGroup, name, nil)
+	if err != nil {
+		return err
+	}
+	if pip == nil {
+		log.Info("Public IP to be migrated does not exist anymore")
+		clearBasicPublicIPMigration(wb)
+		return fctx.PersistState(ctx)
+	}
+
+	if wb.Get(KeyPhase) == nil {
+		if pip.Properties != nil && ptr.Deref(pip.Properties.PublicIPAllocationMethod, "") != armnetwork.IPAllocationMethodStatic {
+			// only static IPs can be upgraded. Changing the allocation method while the IP is still associated keeps its address.
+			log.Info("Changing allocation method of basic public IP to static")
+			pip.Properties.PublicIPAllocationMethod = to.Ptr(armnetwork.IPAllocationMethodStatic)
+			if pip, err = ipc.CreateOrUpdate(ctx, resourceGroup, name, *pip); err != nil {
+				return fmt.Errorf("failed to change allocation method of public IP %s to static: %w", name, err)
+			}
+		}
+
+		associationID := publicIPAssociationID(pip)
+		if associationID != nil {
+			log.Info("Disassociating basic public IP", "Association", *associationID)
+			if err := fctx.setPublicIPAssociation(ctx, *associationID, *pip.ID, false); err != nil {
+				return fmt.Errorf("failed to disassociate public IP %s: %w", name, err)
+			}
+		}
+		wb.Set(KeyPhase, basicPublicIPPhaseDetached)
+		wb.SetPtr(KeyAssociationID, associationID)
+		if err := fctx.PersistState(ctx); err != nil {
+			return err
+		}
+
+		if pip, err = ipc.Get(ctx, resourceGroup, name, nil); err != nil {
+			return err
+		} else if pip == nil {
+			return fmt.Errorf("failed to locate public IP %s after disassociating it", name)
+		}
+	}
+
+	if *wb.Get(KeyPhase) == basicPublicIPPhaseDetached {
+		if isBasicPublicIP(pip) {
+			log.Info("Upgrading basic public IP to standard SKU")
+			pip.SKU = &armnetwork.PublicIPAddressSKU{
+				Name: to.Ptr(armnetwork.PublicIPAddressSKUNameStandard),
+				Tier: to.Ptr(armnetwork.PublicIPAddressSKUTierRegional),
+			}
+			if _, err := ipc.CreateOrUpdate(ctx, resourceGroup, name, *pip); err != nil {
+				err = fmt.Errorf("failed to upgrade public IP %s to standard SKU: %w", name, err)
+				// roll back so that the resource does not remain without its IP until the next reconciliation.
+				if associationID := wb.Get(KeyAssociationID); associationID != nil {
+					log.Info("Rolling back disassociation of basic public IP", "Association", *associationID)
+					if rollbackErr := fctx.setPublicIPAssociation(ctx, *associationID, *pip.ID, true); rollbackErr != nil {
+						return errors.Join(err, fmt.Errorf("failed to roll back disassociation of public IP %s: %w", name, rollbackErr))
+					}
+				}
+				clearBasicPublicIPMigration(wb)
+				return errors.Join(err, fctx.PersistState(ctx))
+			}
+		}
+		wb.Set(KeyPhase, basicPublicIPPhaseUpgraded)
+		if err := fctx.PersistState(ctx); err != nil {
+			return err
+		}
+	}
+
+	if associationID := wb.Get(KeyAssociationID); associationID != nil {
+		log.Info("Associating upgraded public IP", "Association", *associationID)
+		if err := fctx.setPublicIPAssociation(ctx, *associationID, *pip.ID, true); err != nil {
+			return fmt.Errorf("failed to associate public IP %s: %w", name, err)
+		}
+	}
+	log.Info("Migrated basic public IP to standard SKU")
+	clearBasicPublicIPMigration(wb)
+	return fctx.PersistState(ctx)
+}
+
+// setPublicIPAssociation associates the public IP with or disassociates it from the resource with the given ID. Only
+// NAT gateways and IP configurations of network interfaces are supported.
+func (fctx *FlowContext) setPublicIPAssociation(ctx context.Context, associationID, publicIPID string, associate bool) error {
+	resourceID, err := arm.ParseResourceID(associationID)
+	if err != nil {
+		return err
+	}
+
+	switch resourceType := resourceID.ResourceType.String(); {
+	case strings.EqualFold(resourceType, resourceTypeNatGateway):
+		c, err := fctx.factory.NatGateway()
+		if err != nil {
+			return err
+		}
+		nat, err := c.Get(ctx, resourceID.ResourceGroupName, resourceID.Name, nil)
+		if err != nil {
+			return err
+		} else if nat == nil {
+			return fmt.Errorf("failed to locate NAT gateway: %s", associationID)
+		}
+		if nat.Properties == nil {
+			nat.Properties = &armnetwork.NatGatewayPropertiesFormat{}
+		}
+
+		var ipAddresses []*armnetwork.SubResource
+		for _, ip := range nat.Properties.PublicIPAddresses {
+			if ip.ID == nil || !strings.EqualFold(*ip.ID, publicIPID) {
+				ipAddresses = append(ipAddresses, ip)
+			}
+		}
+		if associate {
+			ipAddresses = append(ipAddresses, &armnetwork.SubResource{ID: to.Ptr(publicIPID)})
+		}
+		nat.Properties.PublicIPAddresses = ipAddresses
+		_, err = c.CreateOrUpdate(ctx, resourceID.ResourceGroupName, resourceID.Name, *nat)
+		return err
+	case strings.EqualFold(resourceType, resourceTypeNetworkInterfaceIPConfig):
+		c, err := fctx.factory.NetworkInterface()
+		if err != nil {
+			return err
+		}
+		nic, err := c.Get(ctx, resourceID.ResourceGroupName, resourceID.Parent.Name)
+		if err != nil {
+			return err
+		} else if nic == nil || nic.Properties == nil {
+			return fmt.Errorf("failed to locate network interface: %s", resourceID.Parent.String())
+		}
+
+		found := false
+		for _, ipConfig := range nic.Properties.IPConfigurations {
+			if ipConfig.Name == nil || !strings.EqualFold(*ipConfig.Name, resourceID.Name) || ipConfig.Properties == nil {
+				continue
+			}
+			found = true
+			ipConfig.Properties.PublicIPAddress = nil
+			if associate {
+				ipConfig.Properties.PublicIPAddress = &armnetwork.PublicIPAddress{ID: to.Ptr(publicIPID)}
+			}
+		}
+		if !found {
+			return fmt.Errorf("failed to locate IP configuration: %s", associationID)
+		}
+		_, err = c.CreateOrUpdate(ctx, resourceID.ResourceGroupName, resourceID.Parent.Name, *nic)
+		return err
+	default:
+		return fmt.Errorf("public IP %s is associated with unsupported resource type %s: %s", publicIPID, resourceType, associationID)
+	}
+}
+
+// publicIPAssociationID returns the ID of the resource the public IP is associated with or nil if it is not associated.
+func publicIPAssociationID(pip *armnetwork.PublicIPAddress) *string {
+	if pip.Properties == nil {
+		return nil
+	}
+	if pip.Properties.NatGateway != nil && pip.Properties.NatGateway.ID != nil {
+		return pip.Properties.NatGateway.ID
+	}
+	if pip.Properties.IPConfiguration != nil && pip.Properties.IPConfiguration.ID != nil {
+		return pip.Properties.IPConfiguration.ID
+	}
+	return nil
+}
+
+func isBasicPublicIP(pip *armnetwork.PublicIPAddress) bool {
+	return pip != nil && pip.SKU != nil && pip.SKU.Name != nil && *pip.SKU.Name == armnetwork.PublicIPAddressSKUNameBasic
+}
+
+func clearBasicPublicIPMigration(wb shared.Whiteboard) {
+	wb.Delete(KeyPhase)
+	wb.Delete(KeyAssociationID)
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("Migrations", func() {
+	const (
+		resourceGroup  = "shoot--foo--bar"
+		natName        = "shoot--foo--bar-nat-gateway-z1"
+		natID          = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/" + natName
+		ipName         = natName + "-ip"
+		ipID           = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/publicIPAddresses/" + ipName
+		phaseKey       = "migration|basic-pip|" + ipName + "|phase"
+		associationKey = "migration|basic-pip|" + ipName + "|association_id"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl    *gomock.Controller
+		factory *mockclient.MockFactory
+		nats    *mockclient.MockNatGateway
+		ips     *mockclient.MockPublicIP
+		opts    infraflow.Opts
+	)
+
+	newPublicIP := func(sku armnetwork.PublicIPAddressSKUName, associated bool) *armnetwork.PublicIPAddress {
+		pip := &armnetwork.PublicIPAddress{
+			ID:         ptr.To(ipID),
+			Name:       ptr.To(ipName),
+			Location:   ptr.To("westeurope"),
+			Zones:      []*string{ptr.To("1")},
+			SKU:        &armnetwork.PublicIPAddressSKU{Name: ptr.To(sku)},
+			Properties: &armnetwork.PublicIPAddressPropertiesFormat{PublicIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodStatic)},
+		}
+		if sku == armnetwork.PublicIPAddressSKUNameStandard {
+			pip.SKU.Tier = ptr.To(armnetwork.PublicIPAddressSKUTierRegional)
+		}
+		if associated {
+			pip.Properties.NatGateway = &armnetwork.NatGateway{ID: ptr.To(natID)}
+		}
+		return pip
+	}
+
+	newNatGateway := func(ipIDs ...string) *armnetwork.NatGateway {
+		nat := &armnetwork.NatGateway{
+			ID:         ptr.To(natID),
+			Name:       ptr.To(natName),
+			Properties: &armnetwork.NatGatewayPropertiesFormat{},
+		}
+		for _, id := range ipIDs {
+			nat.Properties.PublicIPAddresses = append(nat.Properties.PublicIPAddresses, &armnetwork.SubResource{ID: ptr.To(id)})
+		}
+		return nat
+	}
+
+	expectNatGatewayIPs := func(ipIDs ...string) func(context.Context, string, string, armnetwork.NatGateway) (*armnetwork.NatGateway, error) {
+		return func(_ context.Context, _, _ string, params armnetwork.NatGateway) (*armnetwork.NatGateway, error) {
+			Expect(params.Properties.PublicIPAddresses).To(Equal(newNatGateway(ipIDs...).Properties.PublicIPAddresses))
+			return &params, nil
+		}
+	}
+
+	stateData := func(fctx *infraflow.FlowContext) map[string]string {
+		return fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState).Data
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		nats = mockclient.NewMockNatGateway(ctrl)
+		ips = mockclient.NewMockPublicIP(ctrl)
+		factory.EXPECT().NatGateway().Return(nats, nil).AnyTimes()
+		factory.EXPECT().PublicIP().Return(ips, nil).AnyTimes()
+
+		infra := &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region: "westeurope",
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+						`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24","natGateway":{"enabled":true}}]}}`)},
+				},
+			},
+		}
+
+		opts = infraflow.Opts{
+			Client:  fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra).WithStatusSubresource(infra).Build(),
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra:   infra,
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	Describe("#MigrateBasicPublicIPs", func() {
+		It("should disassociate, upgrade and associate the basic public IP again", func() {
+			ips.EXPECT().List(gomock.Any(), resourceGroup).Return([]*armnetwork.PublicIPAddress{newPublicIP(armnetwork.PublicIPAddressSKUNameBasic, true)}, nil)
+			gomock.InOrder(
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, ipName, nil).Return(newPublicIP(armnetwork.PublicIPAddressSKUNameBasic, true), nil),
+				nats.EXPECT().Get(gomock.Any(), resourceGroup, natName, nil).Return(newNatGateway(ipID), nil),
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, natName, gomock.Any()).DoAndReturn(expectNatGatewayIPs()),
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, ipName, nil).Return(newPublicIP(armnetwork.PublicIPAddressSKUNameBasic, false), nil),
+				ips.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, ipName, *newPublicIP(armnetwork.PublicIPAddressSKUNameStandard, false)).
+					Return(newPublicIP(armnetwork.PublicIPAddressSKUNameStandard, false), nil),
+				nats.EXPECT().Get(gomock.Any(), resourceGroup, natName, nil).Return(newNatGateway(), nil),
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, natName, gomock.Any()).DoAndReturn(expectNatGatewayIPs(ipID)),
+			)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateBasicPublicIPs(ctx)).To(Succeed())
+			Expect(stateData(fctx)).NotTo(HaveKey(phaseKey))
+			Expect(stateData(fctx)).NotTo(HaveKey(associationKey))
+		})
+
+		It("should resume an interrupted migration by associating the upgraded public IP", func() {
+			opts.State.Data = map[string]string{phaseKey: "upgraded", associationKey: natID}
+
+			ips.EXPECT().List(gomock.Any(), resourceGroup).Return(nil, nil)
+			ips.EXPECT().Get(gomock.Any(), resourceGroup, ipName, nil).Return(newPublicIP(armnetwork.PublicIPAddressSKUNameStandard, false), nil)
+			nats.EXPECT().Get(gomock.Any(), resourceGroup, natName, nil).Return(newNatGateway(), nil)
+			nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, natName, gomock.Any()).DoAndReturn(expectNatGatewayIPs(ipID))
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateBasicPublicIPs(ctx)).To(Succeed())
+			Expect(stateData(fctx)).NotTo(HaveKey(phaseKey))
+		})
+
+		It("should roll back the disassociation if the upgrade fails", func() {
+			ips.EXPECT().List(gomock.Any(), resourceGroup).Return([]*armnetwork.PublicIPAddress{newPublicIP(armnetwork.PublicIPAddressSKUNameBasic, true)}, nil)
+			gomock.InOrder(
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, ipName, nil).Return(newPublicIP(armnetwork.PublicIPAddressSKUNameBasic, true), nil),
+				nats.EXPECT().Get(gomock.Any(), resourceGroup, natName, nil).Return(newNatGateway(ipID), nil),
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, natName, gomock.Any()).DoAndReturn(expectNatGatewayIPs()),
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, ipName, nil).Return(newPublicIP(armnetwork.PublicIPAddressSKUNameBasic, false), nil),
+				ips.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, ipName, gomock.Any()).Return(nil, errors.New("upgrade failed")),
+				nats.EXPECT().Get(gomock.Any(), resourceGroup, natName, nil).Return(newNatGateway(), nil),
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, natName, gomock.Any()).DoAndReturn(expectNatGatewayIPs(ipID)),
+			)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateBasicPublicIPs(ctx)).To(MatchError(ContainSubstring("upgrade failed")))
+			Expect(stateData(fctx)).NotTo(HaveKey(phaseKey))
+		})
+
+		It("should ignore standard public IPs and public IPs of the cloud controller manager", func() {
+			ccmIP := newPublicIP(armnetwork.PublicIPAddressSKUNameBasic, false)
+			ccmIP.Name = ptr.To(resourceGroup + "-service-ip")
+			ccmIP.Tags = map[string]*string{azuretypes.CCMServiceTagKey: ptr.To("default/service")}
+
+			ips.EXPECT().List(gomock.Any(), resourceGroup).Return([]*armnetwork.PublicIPAddress{newPublicIP(armnetwork.PublicIPAddressSKUNameStandard, true), ccmIP}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.MigrateBasicPublicIPs(ctx)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// activityLogStatusSucceeded is the status of the events of the activity log for completed operations.
+	activityLogStatusSucceeded = "Succeeded"
+	// activityLogClaimAppID is the claim of the token which contains the client ID of the calling application.
+	activityLogClaimAppID = "appid"
+)
+
+// OutOfBandModification is a modification of an Azure resource in the resource group of the infrastructure which was not
+// done with the credentials of the shoot, e.g. a manual change via the Azure portal.
+type OutOfBandModification struct {
+	// Operation is the name of the operation, e.g. "Microsoft.Network/natGateways/write".
+	Operation string
+	// ResourceID is the resource ID of the modified resource.
+	ResourceID string
+	// Caller is the identity which performed the operation.
+	Caller string
+	// Timestamp is the time of the modification.
+	Timestamp time.Time
+	// CorrelationID correlates the events of the modification in the activity log.
+	CorrelationID string
+}
+
+func (m OutOfBandModification) String() string {
+	return fmt.Sprintf("%s of %s by %s at %s (correlation ID %s)", m.Operation, m.ResourceID, m.Caller, m.Timestamp.UTC().Format(time.RFC3339), m.CorrelationID)
+}
+
+// DetectOutOfBandModifications returns the successful write and delete operations in the resource group of the
+// infrastructure from (inclusive) until to (exclusive), which were neither done with the credentials of the shoot nor by one of the
+// allowed callers. The operations are taken from the activity log, hence operations which were not yet ingested into
+// the activity log are not returned.
+func (fctx *FlowContext) DetectOutOfBandModifications(ctx context.Context, from, to time.Time, allowedCallers []string) ([]OutOfBandModification, error) {
+	c, err := fctx.factory.ActivityLogs()
+	if err != nil {
+		return nil, err
+	}
+	events, err := c.ListResourceGroupEvents(ctx, fctx.adapter.ResourceGroupName(), from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var modifications []OutOfBandModification
+	for _, event := range events {
+		if event == nil || !isSucceededModification(event) {
+			continue
+		}
+		// The activity log includes the events at the end of the period, they belong to the subsequent period.
+		if event.EventTimestamp != nil && !event.EventTimestamp.Before(to) {
+			continue
+		}
+
+		caller := ptr.Deref(event.Caller, "")
+		appID := ptr.Deref(event.Claims[activityLogClaimAppID], "")
+		if strings.EqualFold(appID, fctx.auth.ClientID) || strings.EqualFold(caller, fctx.auth.ClientID) ||
+			isAllowedCaller(allowedCallers, caller) || isAllowedCaller(allowedCallers, appID) {
+			continue
+		}
+
+		modifications = append(modifications, OutOfBandModification{
+			Operation:     ptr.Deref(event.OperationName.Value, ""),
+			ResourceID:    ptr.Deref(event.ResourceID, ""),
+			Caller:        caller,
+			Timestamp:     ptr.Deref(event.EventTimestamp, time.Time{}),
+			CorrelationID: ptr.Deref(event.CorrelationID, ""),
+		})
+	}
+
+	slices.SortStableFunc(modifications, func(a, b OutOfBandModification) int { return a.Timestamp.Compare(b.Timestamp) })
+	return modifications, nil
+}
+
+func isSucceededModification(event *client.ActivityLogEvent) bool {
+	if event.OperationName == nil || event.Status == nil || !strings.EqualFold(ptr.Deref(event.Status.Value, ""), activityLogStatusSucceeded) {
+		return false
+	}
+	operation := strings.ToLower(ptr.Deref(event.OperationName.Value, ""))
+	return strings.HasSuffix(operation, "/write") || strings.HasSuffix(operation, "/delete")
+}
+
+func isAllowedCaller(allowedCallers []string, caller string) bool {
+	return caller != "" && slices.ContainsFunc(allowedCallers, func(allowed string) bool { return strings.EqualFold(allowed, caller) })
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("DetectOutOfBandModifications", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		natID         = "/subscriptions/sub/resourceGroups/" + resourceGroup + "/providers/Microsoft.Network/natGateways/nat"
+		subnetID      = "/subscriptions/sub/resourceGroups/" + resourceGroup + "/providers/Microsoft.Network/virtualNetworks/vnet/subnets/nodes"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl         *gomock.Controller
+		factory      *mockclient.MockFactory
+		activityLogs *mockclient.MockActivityLogs
+		fctx         *infraflow.FlowContext
+
+		from = time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC)
+		to   = from.Add(time.Hour)
+	)
+
+	event := func(caller, appID, operation, status, resourceID string, minute int) *client.ActivityLogEvent {
+		return &client.ActivityLogEvent{
+			Caller:         ptr.To(caller),
+			Claims:         map[string]*string{"appid": ptr.To(appID)},
+			CorrelationID:  ptr.To("correlation-" + caller),
+			EventTimestamp: ptr.To(from.Add(time.Duration(minute) * time.Minute)),
+			OperationName:  &client.ActivityLogLocalizableString{Value: ptr.To(operation)},
+			ResourceID:     ptr.To(resourceID),
+			Status:         &client.ActivityLogLocalizableString{Value: ptr.To(status)},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		activityLogs = mockclient.NewMockActivityLogs(ctrl)
+		factory.EXPECT().ActivityLogs().Return(activityLogs, nil).AnyTimes()
+
+		var err error
+		fctx, err = infraflow.NewFlowContext(infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub", ClientID: "shoot-client"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+							`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24"}]}}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should report the successful modifications of other callers", func() {
+		activityLogs.EXPECT().ListResourceGroupEvents(ctx, resourceGroup, from, to).Return([]*client.ActivityLogEvent{
+			event("user@example.com", "portal", "Microsoft.Network/virtualNetworks/subnets/write", "Succeeded", subnetID, 40),
+			event("user@example.com", "portal", "Microsoft.Network/natGateways/write", "Started", natID, 10),
+			event("user@example.com", "portal", "Microsoft.Network/natGateways/write", "Succeeded", natID, 10),
+			event("user@example.com", "portal", "Microsoft.Network/natGateways/read", "Succeeded", natID, 15),
+			event("user@example.com", "portal", "Microsoft.Network/natGateways/write", "Failed", natID, 20),
+			event("other-client", "other-client", "Microsoft.Network/natGateways/delete", "Succeeded", natID, 30),
+			event("shoot-client-object-id", "SHOOT-CLIENT", "Microsoft.Network/natGateways/write", "Succeeded", natID, 50),
+			event("operator@example.com", "portal", "Microsoft.Network/natGateways/write", "Succeeded", natID, 55),
+			nil,
+		}, nil)
+
+		Expect(fctx.DetectOutOfBandModifications(ctx, from, to, []string{"Operator@example.com"})).To(Equal([]infraflow.OutOfBandModification{
+			{Operation: "Microsoft.Network/natGateways/write", ResourceID: natID, Caller: "user@example.com", Timestamp: from.Add(10 * time.Minute), CorrelationID: "correlation-user@example.com"},
+			{Operation: "Microsoft.Network/natGateways/delete", ResourceID: natID, Caller: "other-client", Timestamp: from.Add(30 * time.Minute), CorrelationID: "correlation-other-client"},
+			{Operation: "Microsoft.Network/virtualNetworks/subnets/write", ResourceID: subnetID, Caller: "user@example.com", Timestamp: from.Add(40 * time.Minute), CorrelationID: "correlation-user@example.com"},
+		}))
+	})
+
+	It("should not report the modifications at the end of the period", func() {
+		activityLogs.EXPECT().ListResourceGroupEvents(ctx, resourceGroup, from, to).Return([]*client.ActivityLogEvent{
+			event("user@example.com", "portal", "Microsoft.Network/natGateways/write", "Succeeded", natID, 0),
+			event("user@example.com", "portal", "Microsoft.Network/natGateways/delete", "Succeeded", natID, 60),
+		}, nil)
+
+		Expect(fctx.DetectOutOfBandModifications(ctx, from, to, nil)).To(Equal([]infraflow.OutOfBandModification{
+			{Operation: "Microsoft.Network/natGateways/write", ResourceID: natID, Caller: "user@example.com", Timestamp: from, CorrelationID: "correlation-user@example.com"},
+		}))
+	})
+
+	It("should describe the modification", func() {
+		Expect(infraflow.OutOfBandModification{
+			Operation:     "Microsoft.Network/natGateways/delete",
+			ResourceID:    natID,
+			Caller:        "user@example.com",
+			Timestamp:     from,
+			CorrelationID: "correlation",
+		}.String()).To(Equal("Microsoft.Network/natGateways/delete of " + natID + " by user@example.com at 2024-10-01T10:00:00Z (correlation ID correlation)"))
+	})
+
+	It("should return the error of the activity log", func() {
+		activityLogs.EXPECT().ListResourceGroupEvents(ctx, resourceGroup, from, to).Return(nil, context.DeadlineExceeded)
+
+		_, err := fctx.DetectOutOfBandModifications(ctx, from, to, nil)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+})
@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("NatGateways", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		natName       = "shoot--foo--bar-egress"
+		natID         = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/" + natName
+		ipID          = "/subscriptions/sub/resourceGroups/central-rg/providers/Microsoft.Network/publicIPAddresses/egress-ip"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl     *gomock.Controller
+		factory  *mockclient.MockFactory
+		nats     *mockclient.MockNatGateway
+		ips      *mockclient.MockPublicIP
+		opts     infraflow.Opts
+		existing *armnetwork.NatGateway
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		nats = mockclient.NewMockNatGateway(ctrl)
+		ips = mockclient.NewMockPublicIP(ctrl)
+		factory.EXPECT().NatGateway().Return(nats, nil).AnyTimes()
+		factory.EXPECT().PublicIP().Return(ips, nil).AnyTimes()
+
+		existing = &armnetwork.NatGateway{
+			ID:   ptr.To(natID),
+			Name: ptr.To(natName),
+			Properties: &armnetwork.NatGatewayPropertiesFormat{
+				PublicIPAddresses: []*armnetwork.SubResource{{ID: ptr.To(ipID)}},
+			},
+		}
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+							`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24",` +
+							`"natGateway":{"enabled":true,"existing":{"name":"` + natName + `","resourceGroup":"` + resourceGroup + `"}}}]}}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	Describe("#EnsureNatGateways", func() {
+		It("should neither update nor delete an existing NAT gateway but report its public IPs", func() {
+			// the existing NAT gateway is in the shoot's resource group and carries its prefix, but must not be deleted.
+			nats.EXPECT().List(gomock.Any(), resourceGroup).Return([]*armnetwork.NatGateway{existing}, nil)
+			nats.EXPECT().Get(gomock.Any(), resourceGroup, natName, nil).Return(existing, nil)
+			ips.EXPECT().Get(gomock.Any(), "central-rg", "egress-ip", nil).Return(&armnetwork.PublicIPAddress{
+				ID:         ptr.To(ipID),
+				Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.1.2.3")},
+			}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureNatGateways(ctx)).To(Succeed())
+
+			Expect(fctx.GetEgressIpCidrs()).To(ConsistOf("20.1.2.3/32"))
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.NatGateways).To(ConsistOf(v1alpha1.NatGatewayStatus{
+				Name: natName,
+				ID:   natID,
+				Zone: ptr.To("1"),
+				PublicIPAddresses: []v1alpha1.PublicIPAddressStatus{
+					{Name: "egress-ip", ResourceGroup: "central-rg", ID: ipID, IPAddress: "20.1.2.3"},
+				},
+			}))
+		})
+
+		Context("managed NAT gateways", func() {
+			const (
+				natIDPrefix = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/"
+				ipIDPrefix  = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/publicIPAddresses/"
+			)
+
+			BeforeEach(func() {
+				opts.Infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+					`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[` +
+					`{"name":2,"cidr":"10.250.1.0/24","natGateway":{"enabled":true}},` +
+					`{"name":1,"cidr":"10.250.0.0/24","natGateway":{"enabled":true}}]}}`)}
+				nats.EXPECT().List(gomock.Any(), resourceGroup).Return(nil, nil)
+			})
+
+			// natGateway returns the NAT gateway with the given name and public IPs as returned by Azure.
+			natGateway := func(name string, ipIDs ...string) *armnetwork.NatGateway {
+				nat := &armnetwork.NatGateway{
+					ID:         ptr.To(natIDPrefix + name),
+					Name:       ptr.To(name),
+					Properties: &armnetwork.NatGatewayPropertiesFormat{},
+				}
+				for _, id := range ipIDs {
+					nat.Properties.PublicIPAddresses = append(nat.Properties.PublicIPAddresses, &armnetwork.SubResource{ID: ptr.To(id)})
+				}
+				return nat
+			}
+
+			It("should report the NAT gateways of all zones sorted by name together with their public IPs", func() {
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, "shoot--foo--bar-nat-gateway-z1", gomock.Any()).Return(natGateway("shoot--foo--bar-nat-gateway-z1", ipIDPrefix+"ip-z1"), nil)
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, "shoot--foo--bar-nat-gateway-z2", gomock.Any()).Return(natGateway("shoot--foo--bar-nat-gateway-z2", ipIDPrefix+"ip-z2", ipID), nil)
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, "ip-z1", nil).Return(&armnetwork.PublicIPAddress{
+					Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.0.0.1")},
+				}, nil)
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, "ip-z2", nil).Return(&armnetwork.PublicIPAddress{
+					Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.0.0.2")},
+				}, nil)
+				ips.EXPECT().Get(gomock.Any(), "central-rg", "egress-ip", nil).Return(&armnetwork.PublicIPAddress{
+					Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.1.2.3")},
+				}, nil)
+
+				fctx, err := infraflow.NewFlowContext(opts)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fctx.EnsureNatGateways(ctx)).To(Succeed())
+
+				Expect(fctx.GetEgressIpCidrs()).To(ConsistOf("20.0.0.1/32", "20.0.0.2/32", "20.1.2.3/32"))
+				status, err := fctx.GetInfrastructureStatus(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Networks.NatGateways).To(Equal([]v1alpha1.NatGatewayStatus{
+					{
+						Name: "shoot--foo--bar-nat-gateway-z1",
+						ID:   natIDPrefix + "shoot--foo--bar-nat-gateway-z1",
+						Zone: ptr.To("1"),
+						PublicIPAddresses: []v1alpha1.PublicIPAddressStatus{
+							{Name: "ip-z1", ResourceGroup: resourceGroup, ID: ipIDPrefix + "ip-z1", IPAddress: "20.0.0.1"},
+						},
+					},
+					{
+						Name: "shoot--foo--bar-nat-gateway-z2",
+						ID:   natIDPrefix + "shoot--foo--bar-nat-gateway-z2",
+						Zone: ptr.To("2"),
+						PublicIPAddresses: []v1alpha1.PublicIPAddressStatus{
+							{Name: "ip-z2", ResourceGroup: resourceGroup, ID: ipIDPrefix + "ip-z2", IPAddress: "20.0.0.2"},
+							{Name: "egress-ip", ResourceGroup: "central-rg", ID: ipID, IPAddress: "20.1.2.3"},
+						},
+					},
+				}))
+			})
+
+			It("should report public IPs without an allocated address and skip public IPs which do not exist", func() {
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, "shoot--foo--bar-nat-gateway-z1", gomock.Any()).Return(natGateway("shoot--foo--bar-nat-gateway-z1", ipIDPrefix+"ip-z1"), nil)
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, "shoot--foo--bar-nat-gateway-z2", gomock.Any()).Return(natGateway("shoot--foo--bar-nat-gateway-z2", ipIDPrefix+"ip-z2"), nil)
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, "ip-z1", nil).Return(&armnetwork.PublicIPAddress{
+					Properties: &armnetwork.PublicIPAddressPropertiesFormat{},
+				}, nil)
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, "ip-z2", nil).Return(nil, nil)
+
+				fctx, err := infraflow.NewFlowContext(opts)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fctx.EnsureNatGateways(ctx)).To(Succeed())
+
+				Expect(fctx.GetEgressIpCidrs()).To(BeEmpty())
+				status, err := fctx.GetInfrastructureStatus(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Networks.NatGateways).To(ConsistOf(
+					v1alpha1.NatGatewayStatus{
+						Name:              "shoot--foo--bar-nat-gateway-z1",
+						ID:                natIDPrefix + "shoot--foo--bar-nat-gateway-z1",
+						Zone:              ptr.To("1"),
+						PublicIPAddresses: []v1alpha1.PublicIPAddressStatus{{Name: "ip-z1", ResourceGroup: resourceGroup, ID: ipIDPrefix + "ip-z1"}},
+					},
+					v1alpha1.NatGatewayStatus{
+						Name: "shoot--foo--bar-nat-gateway-z2",
+						ID:   natIDPrefix + "shoot--foo--bar-nat-gateway-z2",
+						Zone: ptr.To("2"),
+					},
+				))
+			})
+
+			It("should report the NAT gateways whose public IPs could be read if reading another public IP fails", func() {
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, "shoot--foo--bar-nat-gateway-z1", gomock.Any()).Return(natGateway("shoot--foo--bar-nat-gateway-z1", ipIDPrefix+"ip-z1"), nil)
+				nats.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, "shoot--foo--bar-nat-gateway-z2", gomock.Any()).Return(natGateway("shoot--foo--bar-nat-gateway-z2", ipIDPrefix+"ip-z2"), nil)
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, "ip-z1", nil).Return(&armnetwork.PublicIPAddress{
+					Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.0.0.1")},
+				}, nil)
+				ips.EXPECT().Get(gomock.Any(), resourceGroup, "ip-z2", nil).Return(nil, errors.New("fake"))
+
+				fctx, err := infraflow.NewFlowContext(opts)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fctx.EnsureNatGateways(ctx)).To(MatchError(ContainSubstring("fake")))
+
+				status, err := fctx.GetInfrastructureStatus(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Networks.NatGateways).To(HaveLen(2))
+				Expect(status.Networks.NatGateways[0].PublicIPAddresses).To(ConsistOf(v1alpha1.PublicIPAddressStatus{Name: "ip-z1", ResourceGroup: resourceGroup, ID: ipIDPrefix + "ip-z1", IPAddress: "20.0.0.1"}))
+				Expect(status.Networks.NatGateways[1].PublicIPAddresses).To(BeEmpty())
+			})
+		})
+
+		It("should not report NAT gateways if none is enabled", func() {
+			opts.Infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+				`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24"}]}}`)}
+			nats.EXPECT().List(gomock.Any(), resourceGroup).Return(nil, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureNatGateways(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.NatGateways).To(BeNil())
+		})
+
+		It("should fail if the existing NAT gateway does not exist", func() {
+			nats.EXPECT().List(gomock.Any(), resourceGroup).Return(nil, nil)
+			nats.EXPECT().Get(gomock.Any(), resourceGroup, natName, nil).Return(nil, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureNatGateways(ctx)).To(MatchError(ContainSubstring("failed to locate existing NAT gateway")))
+		})
+	})
+})
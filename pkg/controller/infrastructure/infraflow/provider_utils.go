@@ -19,8 +19,20 @@ func (a AzureResourceKind) String() string {
 }
 
 const (
+	// KindAzureFirewall is the kind for an Azure Firewall.
+	KindAzureFirewall AzureResourceKind = "Microsoft.Network/azureFirewalls"
 	// KindAvailabilitySet is the kind for an availability set.
 	KindAvailabilitySet AzureResourceKind = "Microsoft.Compute/availabilitySets"
+	// KindFirewallPolicy is the kind for a firewall policy.
+	KindFirewallPolicy AzureResourceKind = "Microsoft.Network/firewallPolicies"
+	// KindFlowLog is the kind for a flow log of a network watcher.
+	KindFlowLog AzureResourceKind = "Microsoft.Network/networkWatchers/flowLogs"
+	// KindManagedIdentity is the kind for a user-assigned managed identity.
+	KindManagedIdentity AzureResourceKind = "Microsoft.ManagedIdentity/userAssignedIdentities"
+	// KindManagementLock is the kind for a management lock.
+	KindManagementLock AzureResourceKind = "Microsoft.Authorization/locks"
+	// KindLoadBalancer is the kind for a load balancer.
+	KindLoadBalancer AzureResourceKind = "Microsoft.Network/loadBalancers"
 	// KindNatGateway is the kind for a NAT Gateway.
 	KindNatGateway AzureResourceKind = "Microsoft.Network/natGateways"
 	// KindPublicIP is the kind for a public ip.
@@ -31,6 +43,8 @@ const (
 	KindRouteTable AzureResourceKind = "Microsoft.Network/routeTables"
 	// KindSecurityGroup is the kind for a security group.
 	KindSecurityGroup AzureResourceKind = "Microsoft.Network/networkSecurityGroups"
+	// KindStorageAccount is the kind for a storage account.
+	KindStorageAccount AzureResourceKind = "Microsoft.Storage/storageAccounts"
 	// KindSubnet is the kind for a subnet
 	KindSubnet AzureResourceKind = "Microsoft.Network/virtualNetworks/subnets"
 	// KindVirtualNetwork is the kind for a virtual network.
@@ -40,11 +54,25 @@ const (
 const (
 	// KeyPublicIPAddresses is the key used to store public IP addresses in the FlowContext's whiteboard.
 	KeyPublicIPAddresses = "PublicIpAddresses"
+	// KeyNatGatewayStatuses is the key used to store the status of the NAT gateways in the FlowContext's whiteboard.
+	KeyNatGatewayStatuses = "NatGatewayStatuses"
+	// KeyOutboundLoadBalancerStatus is the key used to store the status of the outbound load balancer in the FlowContext's whiteboard.
+	KeyOutboundLoadBalancerStatus = "OutboundLoadBalancerStatus"
+	// KeyEgressFirewallStatus is the key used to store the status of the egress firewall in the FlowContext's whiteboard.
+	KeyEgressFirewallStatus = "EgressFirewallStatus"
+	// KeyZoneMappings is the key used to store the availability zone mappings of the subscription in the FlowContext's whiteboard.
+	KeyZoneMappings = "ZoneMappings"
+	// KeyPrivateIPAddress is the key used to store the private IP address of the egress firewall in the FlowContext's whiteboard.
+	KeyPrivateIPAddress = "private_ip_address"
 )
 
 const (
 	// TemplateAvailabilitySet the template for the ID of an availability set.
 	TemplateAvailabilitySet = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s"
+	// TemplateFirewallPolicy the template for the id of a firewall policy.
+	TemplateFirewallPolicy = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s"
+	// TemplateLoadBalancer the template for the id of a load balancer.
+	TemplateLoadBalancer = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s"
 	// TemplateNatGateway the template for the id of a NAT Gateway.
 	TemplateNatGateway = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/natGateways/%s"
 	// TemplatePublicIP the template for the id of a public IP.
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+// rerunStepKinds contains the kinds of the resources managed by the steps of the reconcile flow which can be re-run
+// via the azure.InfrastructureRerunStepsAnnotation, keyed by the name of the step.
+var rerunStepKinds = map[string][]AzureResourceKind{
+	"ensure resource group":                   {KindResourceGroup},
+	"ensure vnet":                             {KindVirtualNetwork},
+	"ensure boot diagnostics storage account": {KindStorageAccount},
+	"ensure managed identity":                 {KindManagedIdentity},
+	"ensure security group":                   {KindSecurityGroup},
+	"ensure public IPs":                       {KindPublicIP},
+	"ensure egress firewall":                  {KindAzureFirewall, KindFirewallPolicy},
+	"ensure route table":                      {KindRouteTable},
+	"ensure nats":                             {KindNatGateway},
+	"ensure subnets":                          {KindSubnet},
+}
+
+// normalizeStepName returns the name of a step in lower case and without separators, so that e.g. `ensure subnets`
+// and `EnsureSubnets` refer to the same step.
+func normalizeStepName(name string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.TrimSpace(name)))
+}
+
+// requestedRerunSteps returns the names of the steps whose re-run is requested via the annotation of the
+// Infrastructure. Unknown steps are reported as event and ignored.
+func (fctx *FlowContext) requestedRerunSteps() []string {
+	value, ok := fctx.infra.GetAnnotations()[azure.InfrastructureRerunStepsAnnotation]
+	if !ok {
+		return nil
+	}
+
+	steps := map[string]string{}
+	for name := range rerunStepKinds {
+		steps[normalizeStepName(name)] = name
+	}
+
+	var requested []string
+	for _, name := range strings.Split(value, ",") {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		step, ok := steps[normalizeStepName(name)]
+		if !ok {
+			fctx.log.Info("Ignoring re-run of unknown step", "step", name)
+			if fctx.recorder != nil {
+				fctx.recorder.Eventf(fctx.infra, corev1.EventTypeWarning, EventReasonRerunStepUnknown,
+					"Step %q is unknown or cannot be re-run", strings.TrimSpace(name))
+			}
+			continue
+		}
+		if !slices.Contains(requested, step) {
+			requested = append(requested, step)
+		}
+	}
+	slices.Sort(requested)
+	return requested
+}
+
+// PrepareRerunSteps prepares the steps whose re-run is requested via the annotation of the Infrastructure. As every step
+// runs on every reconciliation anyway, only the items of the inventory which are managed by these steps are verified
+// against Azure. Items of resources which were removed out-of-band are pruned, so that the steps recreate them instead
+// of relying on the recorded IDs.
+func (fctx *FlowContext) PrepareRerunSteps(ctx context.Context) error {
+	steps := fctx.requestedRerunSteps()
+	if len(steps) == 0 {
+		return nil
+	}
+
+	var kinds []string
+	for _, step := range steps {
+		fctx.log.Info("Re-running step", "step", step)
+		for _, kind := range rerunStepKinds[step] {
+			kinds = append(kinds, kind.String())
+		}
+	}
+
+	return fctx.verifyInventory(ctx, func(id *arm.ResourceID) bool {
+		return slices.ContainsFunc(kinds, func(kind string) bool { return strings.EqualFold(id.ResourceType.String(), kind) })
+	})
+}
+
+// removeRerunStepsAnnotation removes the annotation requesting the re-run of steps from the Infrastructure.
+func (fctx *FlowContext) removeRerunStepsAnnotation(ctx context.Context) error {
+	if _, ok := fctx.infra.GetAnnotations()[azure.InfrastructureRerunStepsAnnotation]; !ok {
+		return nil
+	}
+
+	patch := k8sclient.MergeFrom(fctx.infra.DeepCopy())
+	delete(fctx.infra.Annotations, azure.InfrastructureRerunStepsAnnotation)
+	return fctx.client.Patch(ctx, fctx.infra, patch)
+}
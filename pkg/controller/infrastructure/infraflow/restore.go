@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// Restore reconciles the infrastructure after the control plane migration. Since the state was transferred from another
+// seed and may be outdated, the inventory is verified against Azure before the reconciliation.
+func (fctx *FlowContext) Restore(ctx context.Context) error {
+	if err := fctx.VerifyInventory(ctx); err != nil {
+		return err
+	}
+	return fctx.Reconcile(ctx)
+}
+
+// VerifyInventory checks that all items of the inventory still exist in Azure. Items which do not exist anymore are
+// removed from the inventory and from the whiteboard, so that the reconciliation recreates them. Items whose ID changed
+// its casing are updated to the ID reported by Azure. Any discrepancy is reported as an event on the Infrastructure.
+func (fctx *FlowContext) VerifyInventory(ctx context.Context) error {
+	return fctx.verifyInventory(ctx, func(*arm.ResourceID) bool { return true })
+}
+
+// verifyInventory verifies the items of the inventory selected by the given filter, see VerifyInventory.
+func (fctx *FlowContext) verifyInventory(ctx context.Context, filter func(*arm.ResourceID) bool) error {
+	var (
+		inventoryWb = fctx.whiteboard.GetChild(ChildKeyInventory)
+		// resources are the IDs of the resources of each resource group, keyed by the lower case ID.
+		resources = map[string]map[string]string{}
+		missing   []string
+		changed   = map[string]string{}
+	)
+
+	for _, key := range inventoryWb.ObjectKeys() {
+		resourceID, ok := inventoryWb.GetObject(key).(*arm.ResourceID)
+		if !ok || !filter(resourceID) {
+			continue
+		}
+
+		var (
+			current *string
+			err     error
+		)
+		switch {
+		case strings.EqualFold(resourceID.ResourceType.String(), KindResourceGroup.String()):
+			current, err = fctx.currentResourceGroupID(ctx, resourceID)
+		case strings.EqualFold(resourceID.ResourceType.String(), KindSubnet.String()):
+			current, err = fctx.currentSubnetID(ctx, resourceID)
+		case resourceID.Parent != nil && strings.EqualFold(resourceID.Parent.ResourceType.String(), KindResourceGroup.String()):
+			current, err = fctx.currentResourceID(ctx, resources, key, resourceID.ResourceGroupName)
+		default:
+			// other nested resources cannot be listed by resource group, hence they are kept as they are.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case current == nil:
+			missing = append(missing, key)
+		case *current != key && sameResourceType(resourceID, *current):
+			changed[key] = *current
+		}
+	}
+
+	for _, id := range missing {
+		fctx.log.Info("resource of the inventory does not exist anymore", "id", id)
+		fctx.inventory.Delete(id)
+		if fctx.recorder != nil {
+			fctx.recorder.Eventf(fctx.infra, corev1.EventTypeWarning, EventReasonInventoryItemMissing,
+				"Resource %s does not exist anymore and will be recreated", id)
+		}
+	}
+	for oldID, newID := range changed {
+		if inventoryWb.HasObject(oldID) {
+			inventoryWb.DeleteObject(oldID)
+			if err := fctx.inventory.Insert(newID); err != nil {
+				return err
+			}
+		}
+		fctx.log.Info("ID of resource of the inventory changed", "old", oldID, "new", newID)
+		if fctx.recorder != nil {
+			fctx.recorder.Eventf(fctx.infra, corev1.EventTypeNormal, EventReasonInventoryItemIDChanged,
+				"ID of resource %s changed to %s", oldID, newID)
+		}
+	}
+
+	if len(missing) > 0 || len(changed) > 0 {
+		updateWhiteboardIDs(fctx.whiteboard, missing, changed)
+	}
+	return nil
+}
+
+// currentResourceGroupID returns the ID of the resource group or nil if it does not exist.
+func (fctx *FlowContext) currentResourceGroupID(ctx context.Context, id *arm.ResourceID) (*string, error) {
+	c, err := fctx.factory.Group()
+	if err != nil {
+		return nil, err
+	}
+	rg, err := c.Get(ctx, id.ResourceGroupName)
+	if err != nil || rg == nil {
+		return nil, err
+	}
+	return rg.ID, nil
+}
+
+// currentSubnetID returns the ID of the subnet or nil if it or its virtual network does not exist.
+func (fctx *FlowContext) currentSubnetID(ctx context.Context, id *arm.ResourceID) (*string, error) {
+	c, err := fctx.factory.Subnet()
+	if err != nil {
+		return nil, err
+	}
+	subnet, err := c.Get(ctx, id.ResourceGroupName, id.Parent.Name, id.Name, nil)
+	if err != nil || subnet == nil {
+		return nil, err
+	}
+	return subnet.ID, nil
+}
+
+// currentResourceID returns the ID of a resource of a resource group or nil if it does not exist. The resources of
+// each resource group are listed only once and cached in resources.
+func (fctx *FlowContext) currentResourceID(ctx context.Context, resources map[string]map[string]string, id, resourceGroupName string) (*string, error) {
+	rgName := strings.ToLower(resourceGroupName)
+	if _, ok := resources[rgName]; !ok {
+		c, err := fctx.factory.Resource()
+		if err != nil {
+			return nil, err
+		}
+		list, err := c.ListByResourceGroup(ctx, resourceGroupName, nil)
+		if err != nil && !client.IsAzureAPINotFoundError(err) {
+			return nil, fmt.Errorf("failed to list resources of resource group %s: %w", resourceGroupName, err)
+		}
+		resources[rgName] = map[string]string{}
+		for _, r := range list {
+			if r != nil && r.ID != nil {
+				resources[rgName][strings.ToLower(*r.ID)] = *r.ID
+			}
+		}
+	}
+
+	if current, ok := resources[rgName][strings.ToLower(id)]; ok {
+		return &current, nil
+	}
+	return nil, nil
+}
+
+// sameResourceType returns whether the resource type of id matches the one of resourceID exactly. The resource type
+// determines the kind of the inventory items, hence a different casing of only the resource type is not taken over.
+func sameResourceType(resourceID *arm.ResourceID, id string) bool {
+	current, err := arm.ParseResourceID(id)
+	return err == nil && current.ResourceType.String() == resourceID.ResourceType.String()
+}
+
+// updateWhiteboardIDs deletes all values of the whiteboard which refer to missing resources, so that the reconciliation
+// rebuilds them, and replaces the IDs which changed their casing. The inventory itself is not touched.
+func updateWhiteboardIDs(wb shared.Whiteboard, missing []string, changed map[string]string) {
+	for _, key := range wb.Keys() {
+		value := wb.Get(key)
+		if value == nil {
+			continue
+		}
+		if slices.ContainsFunc(missing, func(id string) bool { return *value == id || strings.HasPrefix(*value, id+"/") }) {
+			wb.Delete(key)
+			continue
+		}
+		if newID, ok := changed[*value]; ok {
+			wb.Set(key, newID)
+		}
+	}
+
+	for _, key := range wb.GetChildrenKeys() {
+		if key == ChildKeyInventory {
+			continue
+		}
+		updateWhiteboardIDs(wb.GetChild(key), missing, changed)
+	}
+}
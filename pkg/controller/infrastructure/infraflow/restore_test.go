@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("Restore", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		rgID          = "/subscriptions/sub/resourceGroups/" + resourceGroup
+		vnetID        = rgID + "/providers/Microsoft.Network/virtualNetworks/" + resourceGroup
+		subnetID      = vnetID + "/subnets/" + resourceGroup + "-nodes"
+		routeTableID  = rgID + "/providers/Microsoft.Network/routeTables/worker_route_table"
+		sgID          = rgID + "/providers/Microsoft.Network/networkSecurityGroups/" + resourceGroup + "-workers"
+		ipID          = rgID + "/providers/Microsoft.Network/publicIPAddresses/" + resourceGroup + "-nat-ip"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl      *gomock.Controller
+		factory   *mockclient.MockFactory
+		groups    *mockclient.MockResourceGroup
+		resources *mockclient.MockResource
+		subnets   *mockclient.MockSubnet
+		recorder  *record.FakeRecorder
+		opts      infraflow.Opts
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		groups = mockclient.NewMockResourceGroup(ctrl)
+		resources = mockclient.NewMockResource(ctrl)
+		subnets = mockclient.NewMockSubnet(ctrl)
+		factory.EXPECT().Group().Return(groups, nil).AnyTimes()
+		factory.EXPECT().Resource().Return(resources, nil).AnyTimes()
+		factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+		recorder = record.NewFakeRecorder(10)
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+							`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/24"}]}}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{
+				ManagedItems: []azure.AzureResource{
+					{Kind: "Microsoft.Resources/resourceGroups", ID: rgID},
+					{Kind: "Microsoft.Network/virtualNetworks", ID: vnetID},
+					{Kind: "Microsoft.Network/virtualNetworks/subnets", ID: subnetID},
+					{Kind: "Microsoft.Network/routeTables", ID: routeTableID},
+					{Kind: "Microsoft.Network/networkSecurityGroups", ID: sgID},
+					{Kind: "Microsoft.Network/publicIPAddresses", ID: ipID},
+				},
+				Data: map[string]string{
+					"ids|Microsoft.Network/routeTables":                                routeTableID,
+					"ids|Microsoft.Network/networkSecurityGroups":                      sgID,
+					"Microsoft.Network/publicIPAddresses|" + resourceGroup + "|nat-ip": ipID,
+				},
+			},
+			Recorder: recorder,
+		}
+
+		groups.EXPECT().Get(gomock.Any(), resourceGroup).Return(&armresources.ResourceGroup{ID: ptr.To(rgID)}, nil).AnyTimes()
+		subnets.EXPECT().Get(gomock.Any(), resourceGroup, resourceGroup, resourceGroup+"-nodes", nil).Return(&armnetwork.Subnet{ID: ptr.To(subnetID)}, nil).AnyTimes()
+	})
+
+	Describe("#VerifyInventory", func() {
+		It("should keep the inventory if all resources exist", func() {
+			resources.EXPECT().ListByResourceGroup(gomock.Any(), resourceGroup, nil).Return([]*armresources.GenericResourceExpanded{
+				{ID: ptr.To(vnetID)}, {ID: ptr.To(routeTableID)}, {ID: ptr.To(sgID)}, {ID: ptr.To(ipID)},
+			}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.VerifyInventory(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).To(HaveLen(6))
+			Expect(state.Data).To(Equal(opts.State.Data))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should remove missing resources and update IDs which changed their casing", func() {
+			changedRouteTableID := rgID + "/providers/Microsoft.Network/routeTables/Worker_Route_Table"
+			resources.EXPECT().ListByResourceGroup(gomock.Any(), resourceGroup, nil).Return([]*armresources.GenericResourceExpanded{
+				{ID: ptr.To(vnetID)}, {ID: ptr.To(changedRouteTableID)},
+			}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.VerifyInventory(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).To(ConsistOf(
+				v1alpha1.AzureResource{Kind: "Microsoft.Resources/resourceGroups", ID: rgID},
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/virtualNetworks", ID: vnetID},
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/virtualNetworks/subnets", ID: subnetID},
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/routeTables", ID: changedRouteTableID},
+			))
+			Expect(state.Data).To(Equal(map[string]string{
+				"ids|Microsoft.Network/routeTables": changedRouteTableID,
+			}))
+			Expect(recorder.Events).To(HaveLen(3))
+			Expect(recorder.Events).To(Receive(ContainSubstring(infraflow.EventReasonInventoryItemMissing)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(infraflow.EventReasonInventoryItemMissing)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(infraflow.EventReasonInventoryItemIDChanged)))
+		})
+
+		It("should remove all resources of a resource group which does not exist anymore", func() {
+			groups = mockclient.NewMockResourceGroup(ctrl)
+			subnets = mockclient.NewMockSubnet(ctrl)
+			factory = mockclient.NewMockFactory(ctrl)
+			factory.EXPECT().Group().Return(groups, nil).AnyTimes()
+			factory.EXPECT().Resource().Return(resources, nil).AnyTimes()
+			factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+			opts.Factory = factory
+
+			groups.EXPECT().Get(gomock.Any(), resourceGroup).Return(nil, nil)
+			subnets.EXPECT().Get(gomock.Any(), resourceGroup, resourceGroup, resourceGroup+"-nodes", nil).Return(nil, nil)
+			resources.EXPECT().ListByResourceGroup(gomock.Any(), resourceGroup, nil).Return(nil, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.VerifyInventory(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).To(BeEmpty())
+			Expect(state.Data).To(BeEmpty())
+		})
+	})
+
+	Describe("#PrepareRerunSteps", func() {
+		BeforeEach(func() {
+			subnets = mockclient.NewMockSubnet(ctrl)
+			factory = mockclient.NewMockFactory(ctrl)
+			factory.EXPECT().Resource().Return(resources, nil).AnyTimes()
+			factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+			opts.Factory = factory
+			opts.State.Steps = []azure.FlowStepState{
+				{Name: "ensure nats", LastSucceededGeneration: ptr.To[int64](1)},
+				{Name: "ensure subnets", LastSucceededGeneration: ptr.To[int64](1)},
+			}
+		})
+
+		It("should not verify the inventory without the annotation", func() {
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.PrepareRerunSteps(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).To(HaveLen(6))
+			Expect(state.Steps).To(HaveLen(2))
+		})
+
+		It("should only prune the inventory of the requested steps", func() {
+			opts.Infra.Annotations = map[string]string{
+				"azure.provider.extensions.gardener.cloud/rerun-step": "EnsureSubnets, ensure public IPs,unknown",
+			}
+			subnets.EXPECT().Get(gomock.Any(), resourceGroup, resourceGroup, resourceGroup+"-nodes", nil).Return(nil, nil)
+			// the route table is not verified, as the re-run of its step was not requested.
+			resources.EXPECT().ListByResourceGroup(gomock.Any(), resourceGroup, nil).Return([]*armresources.GenericResourceExpanded{
+				{ID: ptr.To(vnetID)}, {ID: ptr.To(sgID)},
+			}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.PrepareRerunSteps(ctx)).To(Succeed())
+
+			state := fctx.GetInfrastructureState().Object.(*v1alpha1.InfrastructureState)
+			Expect(state.ManagedItems).To(ConsistOf(
+				v1alpha1.AzureResource{Kind: "Microsoft.Resources/resourceGroups", ID: rgID},
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/virtualNetworks", ID: vnetID},
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/routeTables", ID: routeTableID},
+				v1alpha1.AzureResource{Kind: "Microsoft.Network/networkSecurityGroups", ID: sgID},
+			))
+			Expect(state.Data).To(Equal(map[string]string{
+				"ids|Microsoft.Network/routeTables":           routeTableID,
+				"ids|Microsoft.Network/networkSecurityGroups": sgID,
+			}))
+			Expect(state.Steps).To(HaveLen(2))
+			Expect(recorder.Events).To(HaveLen(3))
+			Expect(recorder.Events).To(Receive(ContainSubstring(infraflow.EventReasonRerunStepUnknown)))
+		})
+	})
+})
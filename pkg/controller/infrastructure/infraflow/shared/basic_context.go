@@ -58,14 +58,18 @@ func DoIf(condition bool) TaskOption {
 	return TaskOption{DoIf: ptr.To(condition)}
 }
 
+// StepRecorderFn is called with the name and the result of each executed task.
+type StepRecorderFn func(taskName string, err error)
+
 // BasicFlowContext provides logic for persisting the state and add tasks to the flow graph.
 type BasicFlowContext struct {
 	log           logr.Logger
 	timer         Timestamper
 	persistorLock sync.Mutex
 
-	span      bool
-	persistFn flow.TaskFn
+	span       bool
+	persistFn  flow.TaskFn
+	recordStep StepRecorderFn
 
 	lastPersistedGeneration int64
 	lastPersistedAt         time.Time
@@ -99,6 +103,13 @@ func (c *BasicFlowContext) WithPersist(task flow.TaskFn) *BasicFlowContext {
 	return c
 }
 
+// WithStepRecorder injects the function which records the result of each task. It is called before the state is
+// persisted, so that the result is part of the persisted state.
+func (c *BasicFlowContext) WithStepRecorder(fn StepRecorderFn) *BasicFlowContext {
+	c.recordStep = fn
+	return c
+}
+
 // PersistState persists the internal state to the provider status.
 func (c *BasicFlowContext) PersistState(ctx context.Context) error {
 	c.persistorLock.Lock()
@@ -170,6 +181,9 @@ func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn)
 		if c.span {
 			log.Info(fmt.Sprintf("task finished - total execution time: %v", c.timer.Now().Sub(beforeTs)))
 		}
+		if c.recordStep != nil {
+			c.recordStep(taskName, err)
+		}
 		if err != nil {
 			// don't wrap error with '%w', as otherwise the error context get lost
 			err = fmt.Errorf("failed to %q: %s", taskName, err)
@@ -102,4 +102,40 @@ var _ = Describe("BasicFlowContext", func() {
 			Expect(persistedData["task3"]).To(Equal("done"))
 		})
 	})
+
+	It("should record the result of each executed task before persisting", func() {
+		var (
+			ctx       = context.Background()
+			recorded  = map[string]error{}
+			persisted []map[string]error
+		)
+
+		c := shared.NewBasicFlowContext().WithLogger(logr.Discard()).
+			WithPersist(func(_ context.Context) error {
+				snapshot := map[string]error{}
+				for k, v := range recorded {
+					snapshot[k] = v
+				}
+				persisted = append(persisted, snapshot)
+				return nil
+			}).
+			WithStepRecorder(func(taskName string, err error) {
+				recorded[taskName] = err
+			})
+
+		g := flow.NewGraph("test")
+		task1 := c.AddTask(g, "task1", func(_ context.Context) error { return nil })
+		_ = c.AddTask(g, "task2", func(_ context.Context) error { return fmt.Errorf("forced error") }, shared.Dependencies(task1))
+		_ = c.AddTask(g, "task3", func(_ context.Context) error { return nil }, shared.DoIf(false))
+
+		err := g.Compile().Run(ctx, flow.Opts{Log: logr.Discard()})
+		Expect(err).To(HaveOccurred())
+
+		Expect(recorded).To(HaveLen(2))
+		Expect(recorded["task1"]).NotTo(HaveOccurred())
+		Expect(recorded["task2"]).To(MatchError("forced error"))
+		Expect(persisted).To(HaveLen(2))
+		Expect(persisted[0]).To(HaveKey("task1"))
+		Expect(persisted[1]).To(HaveKey("task2"))
+	})
 })
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armsubscriptions"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("InfrastructureStatus", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		routeTableID  = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/routeTables/worker_route_table"
+		sgID          = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/networkSecurityGroups/shoot--foo--bar-workers"
+		externalSGID  = "/subscriptions/sub/resourceGroups/central-rg/providers/Microsoft.Network/networkSecurityGroups/central-nsg"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl    *gomock.Controller
+		factory *mockclient.MockFactory
+		rts     *mockclient.MockRouteTables
+		sgs     *mockclient.MockNetworkSecurityGroup
+		opts    infraflow.Opts
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		rts = mockclient.NewMockRouteTables(ctrl)
+		sgs = mockclient.NewMockNetworkSecurityGroup(ctrl)
+		factory.EXPECT().RouteTables().Return(rts, nil).AnyTimes()
+		factory.EXPECT().NetworkSecurityGroup().Return(sgs, nil).AnyTimes()
+
+		opts = infraflow.Opts{
+			Factory: factory,
+			Auth:    &internal.ClientAuth{SubscriptionID: "sub"},
+			Logger:  logr.Discard(),
+			Infra: &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: resourceGroup},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					Region: "westeurope",
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","zoned":true,` +
+							`"networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[` +
+							`{"name":1,"cidr":"10.250.0.0/24","securityGroup":{"externalID":"` + externalSGID + `"}},` +
+							`{"name":2,"cidr":"10.250.1.0/24","securityGroup":{"externalID":"` + externalSGID + `"}},` +
+							`{"name":3,"cidr":"10.250.2.0/24"}]}}`)},
+					},
+				},
+			},
+			Cluster: &extensionscontroller.Cluster{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","countFaultDomains":[{"region":"westeurope","count":2}],"countUpdateDomains":[{"region":"westeurope","count":5}]}`)},
+					},
+				},
+			},
+			State: &azure.InfrastructureState{},
+		}
+	})
+
+	Describe("#GetInfrastructureStatus", func() {
+		It("should report the IDs of the route tables and security groups and whether they are managed", func() {
+			rts.EXPECT().Get(gomock.Any(), resourceGroup, "worker_route_table").Return(nil, nil)
+			rts.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, "worker_route_table", gomock.Any()).Return(&armnetwork.RouteTable{ID: ptr.To(routeTableID)}, nil)
+			sgs.EXPECT().Get(gomock.Any(), resourceGroup, "shoot--foo--bar-workers").Return(nil, nil)
+			sgs.EXPECT().CreateOrUpdate(gomock.Any(), resourceGroup, "shoot--foo--bar-workers", gomock.Any()).Return(&armnetwork.SecurityGroup{ID: ptr.To(sgID)}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureRouteTable(ctx)).To(Succeed())
+			Expect(fctx.EnsureSecurityGroup(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.RouteTables).To(Equal([]v1alpha1.RouteTable{
+				{Purpose: v1alpha1.PurposeNodes, Name: "worker_route_table", ID: routeTableID, Managed: true},
+			}))
+			Expect(status.SecurityGroups).To(Equal([]v1alpha1.SecurityGroup{
+				{Purpose: v1alpha1.PurposeNodes, Name: "shoot--foo--bar-workers", ID: sgID, Managed: true},
+				{Purpose: v1alpha1.PurposeNodes, Name: "central-nsg", ID: externalSGID},
+			}))
+		})
+
+		It("should report the availability zone mappings of the subscription sorted by the logical zone", func() {
+			locations := mockclient.NewMockLocations(ctrl)
+			factory.EXPECT().Locations().Return(locations, nil)
+			locations.EXPECT().AvailabilityZoneMappings(gomock.Any(), "westeurope").Return([]*armsubscriptions.AvailabilityZoneMappings{
+				{LogicalZone: ptr.To("2"), PhysicalZone: ptr.To("westeurope-az3")},
+				{LogicalZone: ptr.To("1"), PhysicalZone: ptr.To("westeurope-az2")},
+			}, nil)
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureZoneMappings(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.ZoneMappings).To(Equal([]v1alpha1.ZoneMapping{
+				{LogicalZone: "1", PhysicalZone: "westeurope-az2"},
+				{LogicalZone: "2", PhysicalZone: "westeurope-az3"},
+			}))
+		})
+
+		It("should keep the reported availability zone mappings without reading them again", func() {
+			opts.Infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus",` +
+				`"networks":{"vnet":{}},"zoneMappings":[{"logicalZone":"1","physicalZone":"westeurope-az2"}]}`)}
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureZoneMappings(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.ZoneMappings).To(Equal([]v1alpha1.ZoneMapping{
+				{LogicalZone: "1", PhysicalZone: "westeurope-az2"},
+			}))
+		})
+
+		It("should not fail if the availability zone mappings cannot be read", func() {
+			locations := mockclient.NewMockLocations(ctrl)
+			factory.EXPECT().Locations().Return(locations, nil)
+			locations.EXPECT().AvailabilityZoneMappings(gomock.Any(), "westeurope").Return(nil, errors.New("forbidden"))
+
+			fctx, err := infraflow.NewFlowContext(opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fctx.EnsureZoneMappings(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.ZoneMappings).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+const (
+	// ConditionTypeFlowStepsSucceeded is the type of the condition which summarizes the execution state of the steps of
+	// the infrastructure flow.
+	ConditionTypeFlowStepsSucceeded gardencorev1beta1.ConditionType = "AzureInfrastructureFlowStepsSucceeded"
+
+	// maxStepErrorLength is the maximum length of the errors of the steps kept in the infrastructure state.
+	maxStepErrorLength = 1024
+)
+
+// stepStates tracks the execution state of the steps of the infrastructure flow. The state is persisted in the
+// InfrastructureState, so that it survives across reconciliations.
+type stepStates struct {
+	mutex sync.Mutex
+	steps map[string]*azure.FlowStepState
+}
+
+func newStepStates(steps []azure.FlowStepState) *stepStates {
+	s := &stepStates{steps: map[string]*azure.FlowStepState{}}
+	for _, step := range steps {
+		s.steps[step.Name] = step.DeepCopy()
+	}
+	return s
+}
+
+// record records the result of an execution of the given step for the given generation of the Infrastructure. Repeated
+// successful executions for the same generation do not change the state of the step, so that the persisted state only
+// changes if the result of the step changes.
+func (s *stepStates) record(name string, generation int64, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	step, ok := s.steps[name]
+	if !ok {
+		step = &azure.FlowStepState{Name: name}
+		s.steps[name] = step
+	}
+
+	now := ptr.To(metav1.NewTime(shared.DefaultTimer.Now()))
+	if err == nil {
+		if ptr.Deref(step.LastSucceededGeneration, 0) == generation && step.LastSucceededTime != nil && step.ConsecutiveFailures == 0 {
+			return
+		}
+		step.LastSucceededGeneration = ptr.To(generation)
+		step.LastSucceededTime = now
+		step.LastError = nil
+		step.ConsecutiveFailures = 0
+		return
+	}
+
+	message := err.Error()
+	if len(message) > maxStepErrorLength {
+		message = message[:maxStepErrorLength] + "..."
+	}
+	step.LastFailedTime = now
+	step.LastError = &message
+	step.ConsecutiveFailures++
+}
+
+// resetFailures resets the failures of all steps. It is called after a successful run of the flow, so that steps
+// which failed before but are skipped now are not reported as failing anymore.
+func (s *stepStates) resetFailures() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, step := range s.steps {
+		step.LastError = nil
+		step.ConsecutiveFailures = 0
+	}
+}
+
+// export returns the state of all steps sorted by their name.
+func (s *stepStates) export() []v1alpha1.FlowStepState {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var steps []v1alpha1.FlowStepState
+	for _, step := range s.steps {
+		out := v1alpha1.FlowStepState{}
+		_ = v1alpha1.Convert_azure_FlowStepState_To_v1alpha1_FlowStepState(step, &out, nil)
+		steps = append(steps, out)
+	}
+	slices.SortFunc(steps, func(a, b v1alpha1.FlowStepState) int { return strings.Compare(a.Name, b.Name) })
+	return steps
+}
+
+// condition returns the condition which summarizes the state of the steps based on the given existing conditions or
+// nil if no step was executed yet.
+func (s *stepStates) condition(conditions []gardencorev1beta1.Condition) *gardencorev1beta1.Condition {
+	steps := s.export()
+	if len(steps) == 0 {
+		return nil
+	}
+
+	condition := v1beta1helper.GetCondition(conditions, ConditionTypeFlowStepsSucceeded)
+	if condition == nil {
+		condition = ptr.To(v1beta1helper.InitConditionWithClock(clock.RealClock{}, ConditionTypeFlowStepsSucceeded))
+	}
+
+	var failures []string
+	for _, step := range steps {
+		if step.ConsecutiveFailures == 0 {
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("step %q failed %d time(s) in a row, last at %s: %s",
+			step.Name, step.ConsecutiveFailures, step.LastFailedTime.UTC().Format(time.RFC3339), ptr.Deref(step.LastError, "")))
+	}
+	if len(failures) > 0 {
+		return ptr.To(v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, *condition, gardencorev1beta1.ConditionFalse, "FlowStepFailing",
+			strings.Join(failures, "; ")))
+	}
+	return ptr.To(v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, *condition, gardencorev1beta1.ConditionTrue, "FlowStepsSucceeded",
+		"All executed steps of the infrastructure flow succeeded."))
+}
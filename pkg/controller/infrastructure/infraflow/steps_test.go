@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+var _ = Describe("stepStates", func() {
+	It("should not report a condition if no step was executed", func() {
+		Expect(newStepStates(nil).condition(nil)).To(BeNil())
+	})
+
+	It("should record the results of the steps", func() {
+		steps := newStepStates([]azure.FlowStepState{{Name: "b", ConsecutiveFailures: 2, LastError: ptr.To("old error")}})
+		steps.record("a", 3, nil)
+		steps.record("b", 3, fmt.Errorf("new error"))
+
+		exported := steps.export()
+		Expect(exported).To(HaveLen(2))
+		Expect(exported[0].Name).To(Equal("a"))
+		Expect(exported[0].LastSucceededGeneration).To(Equal(ptr.To[int64](3)))
+		Expect(exported[0].LastSucceededTime).NotTo(BeNil())
+		Expect(exported[0].ConsecutiveFailures).To(BeZero())
+		Expect(exported[1].Name).To(Equal("b"))
+		Expect(exported[1].LastSucceededGeneration).To(BeNil())
+		Expect(exported[1].LastFailedTime).NotTo(BeNil())
+		Expect(exported[1].LastError).To(Equal(ptr.To("new error")))
+		Expect(exported[1].ConsecutiveFailures).To(Equal(int32(3)))
+
+		condition := steps.condition(nil)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Type).To(Equal(ConditionTypeFlowStepsSucceeded))
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("FlowStepFailing"))
+		Expect(condition.Message).To(ContainSubstring(`step "b" failed 3 time(s) in a row`))
+		Expect(condition.Message).To(ContainSubstring("new error"))
+	})
+
+	It("should clear the failures of a step once it succeeds", func() {
+		steps := newStepStates(nil)
+		steps.record("a", 1, fmt.Errorf("error"))
+		steps.record("a", 2, nil)
+
+		exported := steps.export()
+		Expect(exported).To(HaveLen(1))
+		Expect(exported[0].LastError).To(BeNil())
+		Expect(exported[0].ConsecutiveFailures).To(BeZero())
+		Expect(exported[0].LastFailedTime).NotTo(BeNil())
+
+		condition := steps.condition(nil)
+		Expect(condition.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("FlowStepsSucceeded"))
+	})
+
+	It("should not update the state of a step which succeeds again for the same generation", func() {
+		steps := newStepStates(nil)
+		steps.record("a", 1, nil)
+		succeeded := steps.export()[0].LastSucceededTime
+
+		steps.record("a", 1, nil)
+		Expect(steps.export()[0].LastSucceededTime).To(Equal(succeeded))
+
+		steps.record("a", 2, nil)
+		Expect(steps.export()[0].LastSucceededGeneration).To(Equal(ptr.To[int64](2)))
+		Expect(steps.export()[0].LastSucceededTime).NotTo(Equal(succeeded))
+	})
+
+	It("should reset the failures of all steps", func() {
+		steps := newStepStates(nil)
+		steps.record("a", 1, fmt.Errorf("error"))
+		steps.resetFailures()
+
+		Expect(steps.export()[0].ConsecutiveFailures).To(BeZero())
+		Expect(steps.condition(nil).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should truncate long errors", func() {
+		steps := newStepStates(nil)
+		steps.record("a", 1, fmt.Errorf("%s", strings.Repeat("x", 2*maxStepErrorLength)))
+
+		Expect(*steps.export()[0].LastError).To(HaveLen(maxStepErrorLength + len("...")))
+	})
+})
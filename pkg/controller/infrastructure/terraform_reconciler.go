@@ -230,9 +230,14 @@ func (r *TerraformReconciler) Delete(ctx context.Context, infra *extensionsv1alp
 		return err
 	}
 
+	secretRef, err := helper.InfrastructureCredentialsSecretRef(infra)
+	if err != nil {
+		return err
+	}
+
 	if err = tf.
 		InitializeWith(ctx, terraformer.DefaultInitializer(r.Client, terraformFiles.Main, terraformFiles.Variables, terraformFiles.TFVars, terraformer.StateConfigMapInitializerFunc(NoOpStateInitializer))).
-		SetEnvVars(internal.TerraformerEnvVars(infra.Spec.SecretRef)...).
+		SetEnvVars(internal.TerraformerEnvVars(secretRef)...).
 		Destroy(ctx); err != nil {
 		return err
 	}
@@ -247,10 +252,14 @@ func NoOpStateInitializer(_ context.Context, _ client.Client, _, _ string, _ *me
 }
 
 func (r *TerraformReconciler) getClientFactory(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, _ *controller.Cluster) (azureclient.Factory, error) {
+	secretRef, err := helper.InfrastructureCredentialsSecretRef(infra)
+	if err != nil {
+		return nil, err
+	}
 	return DefaultAzureClientFactoryFunc(
 		ctx,
 		r.Client,
-		infra.Spec.SecretRef,
+		secretRef,
 		false,
 	)
 }
@@ -258,7 +267,7 @@ func (r *TerraformReconciler) getClientFactory(ctx context.Context, infra *exten
 func (r *TerraformReconciler) cleanResourceGroupIfNeeded(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster, cfg *azure.InfrastructureConfig) (bool, error) {
 	var err error
 	// skip operations on user resource groups
-	if cfg.ResourceGroup != nil {
+	if helper.IsUsingExistingResourceGroup(cfg) {
 		return false, nil
 	}
 	// skip operations if we are not creating the resource group for the first time.
@@ -31,12 +31,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	azureclientmocks "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
@@ -84,11 +86,12 @@ var _ = Describe("Actuator", func() {
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
 		mgr.EXPECT().GetConfig().Return(&rest.Config{})
+		mgr.EXPECT().GetEventRecorderFor(gomock.Any()).Return(record.NewFakeRecorder(10))
 
 		ctx = context.TODO()
 		log = logf.Log.WithName("test")
 
-		a = NewActuator(mgr, disableProjectedTokenMount)
+		a = NewActuator(mgr, disableProjectedTokenMount, config.ManagementLocksConfig{})
 
 		providerConfig = &api.InfrastructureConfig{
 			Networks: api.NetworkConfig{
@@ -137,10 +140,10 @@ var _ = Describe("Actuator", func() {
 			},
 			AvailabilitySets: []apiv1alpha1.AvailabilitySet{},
 			RouteTables: []apiv1alpha1.RouteTable{
-				{Purpose: apiv1alpha1.PurposeNodes, Name: ""},
+				{Purpose: apiv1alpha1.PurposeNodes, Name: "", Managed: true},
 			},
 			SecurityGroups: []apiv1alpha1.SecurityGroup{
-				{Name: "", Purpose: apiv1alpha1.PurposeNodes},
+				{Name: "", Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 			},
 			Zoned: true,
 		}
@@ -243,6 +246,27 @@ var _ = Describe("Actuator", func() {
 
 		})
 
+		It("should delete the Infrastructure with the credentials referenced in the InfrastructureConfig", func() {
+			infra.Spec.ProviderConfig.Raw = []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vnet":{"cidr":"10.222.0.0/16"},"workers":"10.222.0.0/16"},"zoned":true,"credentialsRef":"infra-credentials"}`)
+			secretRef := corev1.SecretReference{Name: "ref-infra-credentials", Namespace: infra.Namespace}
+
+			DefaultAzureClientFactoryFunc = func(_ context.Context, _ client.Client, ref v1.SecretReference, _ bool, _ ...azureclient.AzureFactoryOption) (azureclient.Factory, error) {
+				Expect(ref).To(Equal(secretRef))
+				return azureClientFactory, nil
+			}
+			azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil).Times(2)
+			azureGroupClient.EXPECT().Get(ctx, infra.Namespace).Return(&armresources.ResourceGroup{Name: &resourceGroupName}, nil)
+			azureGroupClient.EXPECT().Delete(ctx, infra.Namespace).Return(nil)
+
+			tf.EXPECT().EnsureCleanedUp(ctx)
+			tf.EXPECT().IsStateEmpty(ctx).Return(false)
+			tf.EXPECT().InitializeWith(ctx, gomock.Any()).Return(tf)
+			tf.EXPECT().SetEnvVars(internal.TerraformerEnvVars(secretRef)).Return(tf)
+			tf.EXPECT().Destroy(ctx)
+
+			Expect(a.Delete(ctx, log, infra, cluster)).To(Succeed())
+		})
+
 		It("should delete the Infrastructure with invalid credentials", func() {
 			azureClientFactory.EXPECT().Group().Return(azureGroupClient, nil)
 			azureGroupClient.EXPECT().Get(ctx, infra.Namespace).Return(nil, autorest.DetailedError{Response: &http.Response{StatusCode: http.StatusUnauthorized}})
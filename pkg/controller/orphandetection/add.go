@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphandetection
+
+import (
+	"context"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+const (
+	// ControllerName is the name of the orphan detection controller.
+	ControllerName = "orphandetection"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are Options to apply when adding the Azure orphan detection controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// Config is the configuration of the orphan detection.
+	Config config.OrphanDetectionConfig
+	// ExtensionClass defines the extension class this extension is responsible for.
+	ExtensionClass extensionsv1alpha1.ExtensionClass
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+// The controller is only added if the orphan detection is enabled in the given Options.
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) error {
+	if !opts.Config.Enabled {
+		return nil
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(opts.Controller).
+		For(&extensionsv1alpha1.Infrastructure{}, builder.WithPredicates(
+			extensionspredicate.HasType(azure.Type),
+			extensionspredicate.HasClass(opts.ExtensionClass),
+			// Infrastructures are resynced periodically by the reconciler itself, reacting to every status update
+			// would list the shoot resource group far more often than necessary.
+			predicate.Funcs{
+				UpdateFunc: func(event.UpdateEvent) bool { return false },
+				DeleteFunc: func(event.DeleteEvent) bool { return false },
+			},
+		)).
+		Complete(NewReconciler(mgr.GetClient(), mgr.GetEventRecorderFor(azure.Name+"-"+ControllerName+"-controller"), opts.Config))
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(_ context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
+}
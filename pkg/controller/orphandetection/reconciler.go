@@ -0,0 +1,366 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphandetection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
+)
+
+const (
+	// defaultSyncPeriod is the default interval in which the resource group of a shoot is checked for orphans.
+	defaultSyncPeriod = time.Hour
+	// defaultGracePeriod is the default minimum age of a resource before it is considered orphaned. It protects
+	// resources that were just created by the machine-controller-manager and are not yet attached to a VM.
+	defaultGracePeriod = time.Hour
+
+	// ResourceTypeNetworkInterface is the Azure resource type of network interfaces.
+	ResourceTypeNetworkInterface = "Microsoft.Network/networkInterfaces"
+	// ResourceTypeDisk is the Azure resource type of managed disks.
+	ResourceTypeDisk = "Microsoft.Compute/disks"
+	// ResourceTypePublicIP is the Azure resource type of public IP addresses.
+	ResourceTypePublicIP = "Microsoft.Network/publicIPAddresses"
+)
+
+var (
+	// NewAzureClientFactoryFunc is a hook to monkeypatch the factory ctor during tests.
+	NewAzureClientFactoryFunc = azureclient.NewAzureClientFactoryFromSecret
+
+	orphanedResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "azure_orphaned_resources",
+			Help: "Number of orphaned resources detected in the resource group of a shoot.",
+		},
+		[]string{"namespace", "type"},
+	)
+	orphanedResourcesDeletedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "azure_orphaned_resources_deleted_total",
+			Help: "Number of orphaned resources deleted by the orphan detection.",
+		},
+		[]string{"type"},
+	)
+
+	orphanCandidateTypes = []string{ResourceTypeNetworkInterface, ResourceTypeDisk, ResourceTypePublicIP}
+)
+
+func init() {
+	metrics.Registry.MustRegister(orphanedResources, orphanedResourcesDeletedTotal)
+}
+
+type reconciler struct {
+	client   client.Client
+	recorder record.EventRecorder
+	config   config.OrphanDetectionConfig
+}
+
+// NewReconciler creates a new reconcile.Reconciler which periodically detects resources in the resource group of a
+// shoot that carry the cluster tag but are neither part of the infrastructure inventory nor attached to any VM.
+// Orphans are reported via events and metrics and only deleted if the dry-run mode is explicitly disabled.
+func NewReconciler(client client.Client, recorder record.EventRecorder, config config.OrphanDetectionConfig) reconcile.Reconciler {
+	return &reconciler{
+		client:   client,
+		recorder: recorder,
+		config:   config,
+	}
+}
+
+// orphan is a resource which was detected as orphaned.
+type orphan struct {
+	id           string
+	name         string
+	resourceType string
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := r.client.Get(ctx, request.NamespacedName, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			orphanedResources.DeletePartialMatch(prometheus.Labels{"namespace": request.Namespace})
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if infra.DeletionTimestamp != nil {
+		orphanedResources.DeletePartialMatch(prometheus.Labels{"namespace": infra.Namespace})
+		return reconcile.Result{}, nil
+	}
+
+	result := reconcile.Result{RequeueAfter: durationOrDefault(r.config.SyncPeriod, defaultSyncPeriod)}
+
+	// Only infrastructures which were reconciled successfully by the flow reconciler have a complete inventory.
+	if infra.Status.LastOperation == nil || infra.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return result, nil
+	}
+
+	state, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(state.ManagedItems) == 0 {
+		logger.V(1).Info("Skipping orphan detection since the infrastructure has no inventory")
+		return result, nil
+	}
+
+	status, err := helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, infra.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	factory, err := r.newFactory(ctx, infra, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	orphans, err := r.findOrphans(ctx, factory, infra.Namespace, status.ResourceGroup.Name, state.ManagedItems)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed detecting orphaned resources: %w", err)
+	}
+
+	counts := make(map[string]int, len(orphanCandidateTypes))
+	for _, o := range orphans {
+		counts[o.resourceType]++
+		r.recorder.Eventf(infra, corev1.EventTypeWarning, "OrphanedResourceDetected", "Detected orphaned resource %s", o.id)
+	}
+	for _, resourceType := range orphanCandidateTypes {
+		orphanedResources.WithLabelValues(infra.Namespace, resourceType).Set(float64(counts[resourceType]))
+	}
+
+	if ptr.Deref(r.config.DryRun, true) {
+		if len(orphans) > 0 {
+			logger.Info("Detected orphaned resources, skipping deletion in dry-run mode", "count", len(orphans))
+		}
+		return result, nil
+	}
+
+	for _, o := range orphans {
+		logger.Info("Deleting orphaned resource", "id", o.id)
+		if err := deleteOrphan(ctx, factory, status.ResourceGroup.Name, o); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed deleting orphaned resource %s: %w", o.id, err)
+		}
+		orphanedResourcesDeletedTotal.WithLabelValues(o.resourceType).Inc()
+		r.recorder.Eventf(infra, corev1.EventTypeNormal, "OrphanedResourceDeleted", "Deleted orphaned resource %s", o.id)
+	}
+
+	return result, nil
+}
+
+func (r *reconciler) newFactory(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (azureclient.Factory, error) {
+	cloudProfile, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var cloudConfiguration *api.CloudConfiguration
+	if cloudProfile != nil {
+		cloudConfiguration = cloudProfile.CloudConfiguration
+	}
+
+	azCloudConfiguration, err := azureclient.AzureCloudConfiguration(cloudConfiguration, &cluster.Shoot.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	secretRef, err := helper.InfrastructureCredentialsSecretRef(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAzureClientFactoryFunc(
+		ctx,
+		r.client,
+		secretRef,
+		false,
+		azureclient.WithCloudConfiguration(azCloudConfiguration),
+	)
+}
+
+// findOrphans lists all resources in the given resource group and returns those which carry the cluster tag, are
+// not part of the inventory, are older than the grace period and are not attached to any other resource.
+func (r *reconciler) findOrphans(ctx context.Context, factory azureclient.Factory, namespace, resourceGroup string, inventory []api.AzureResource) ([]orphan, error) {
+	resourceClient, err := factory.Resource()
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := resourceClient.ListByResourceGroup(ctx, resourceGroup, &armresources.ClientListByResourceGroupOptions{
+		Expand: ptr.To("createdTime"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		clusterTag     = worker.SanitizeAzureVMTag(fmt.Sprintf("kubernetes.io-cluster-%s", namespace))
+		deletionCutoff = time.Now().Add(-durationOrDefault(r.config.GracePeriod, defaultGracePeriod))
+		managed        = sets.New[string]()
+		orphans        []orphan
+	)
+
+	for _, item := range inventory {
+		managed.Insert(strings.ToLower(item.ID))
+	}
+
+	for _, resource := range resources {
+		if resource.ID == nil || resource.Name == nil || resource.Type == nil {
+			continue
+		}
+		if managed.Has(strings.ToLower(*resource.ID)) {
+			continue
+		}
+		if _, ok := resource.Tags[clusterTag]; !ok {
+			continue
+		}
+		if resource.CreatedTime == nil || resource.CreatedTime.After(deletionCutoff) {
+			continue
+		}
+
+		resourceType, ok := orphanCandidateType(*resource.Type)
+		if !ok {
+			continue
+		}
+
+		attached, err := isAttached(ctx, factory, resourceGroup, *resource.Name, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		if attached {
+			continue
+		}
+
+		orphans = append(orphans, orphan{id: *resource.ID, name: *resource.Name, resourceType: resourceType})
+	}
+
+	return orphans, nil
+}
+
+func orphanCandidateType(resourceType string) (string, bool) {
+	for _, candidate := range orphanCandidateTypes {
+		if strings.EqualFold(candidate, resourceType) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// isAttached checks whether the given resource is still in use. Resources which vanished in the meantime are reported
+// as attached so that they are not treated as orphans.
+func isAttached(ctx context.Context, factory azureclient.Factory, resourceGroup, name, resourceType string) (bool, error) {
+	switch resourceType {
+	case ResourceTypeNetworkInterface:
+		c, err := factory.NetworkInterface()
+		if err != nil {
+			return false, err
+		}
+		nic, err := c.Get(ctx, resourceGroup, name)
+		if err != nil || nic == nil {
+			return true, err
+		}
+		return nic.Properties != nil && nic.Properties.VirtualMachine != nil, nil
+	case ResourceTypeDisk:
+		c, err := factory.Disk()
+		if err != nil {
+			return false, err
+		}
+		disk, err := c.Get(ctx, resourceGroup, name)
+		if err != nil || disk == nil {
+			return true, err
+		}
+		if disk.ManagedBy != nil {
+			return true, nil
+		}
+		return disk.Properties != nil && ptr.Deref(disk.Properties.DiskState, armcompute.DiskStateUnattached) != armcompute.DiskStateUnattached, nil
+	case ResourceTypePublicIP:
+		c, err := factory.PublicIP()
+		if err != nil {
+			return false, err
+		}
+		ip, err := c.Get(ctx, resourceGroup, name, nil)
+		if err != nil || ip == nil {
+			return true, err
+		}
+		// Public IPs of load balancer services are owned by the cloud-controller-manager and the remedy controller.
+		if ip.Tags[azure.CCMServiceTagKey] != nil || ip.Tags[azure.CCMLegacyServiceTagKey] != nil {
+			return true, nil
+		}
+		return ip.Properties != nil && (ip.Properties.IPConfiguration != nil || ip.Properties.NatGateway != nil), nil
+	}
+	return true, nil
+}
+
+func deleteOrphan(ctx context.Context, factory azureclient.Factory, resourceGroup string, o orphan) error {
+	// Orphans of other resource groups are never deleted, even if the listing returned them.
+	id, err := arm.ParseResourceID(o.id)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(id.ResourceGroupName, resourceGroup) {
+		return fmt.Errorf("resource is not part of resource group %s", resourceGroup)
+	}
+
+	switch o.resourceType {
+	case ResourceTypeNetworkInterface:
+		c, err := factory.NetworkInterface()
+		if err != nil {
+			return err
+		}
+		return c.Delete(ctx, resourceGroup, o.name)
+	case ResourceTypeDisk:
+		c, err := factory.Disk()
+		if err != nil {
+			return err
+		}
+		return c.Delete(ctx, resourceGroup, o.name)
+	case ResourceTypePublicIP:
+		c, err := factory.PublicIP()
+		if err != nil {
+			return err
+		}
+		return c.Delete(ctx, resourceGroup, o.name)
+	}
+	return nil
+}
+
+func durationOrDefault(d *metav1.Duration, def time.Duration) time.Duration {
+	if d == nil || d.Duration <= 0 {
+		return def
+	}
+	return d.Duration
+}
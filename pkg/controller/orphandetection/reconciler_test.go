@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphandetection_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/orphandetection"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace     = "shoot--foo--bar"
+		resourceGroup = "shoot--foo--bar"
+	)
+
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		c        client.Client
+		recorder *record.FakeRecorder
+		factory  *mockazureclient.MockFactory
+		resource *mockazureclient.MockResource
+		nic      *mockazureclient.MockNetworkInterface
+		disk     *mockazureclient.MockDisk
+		pip      *mockazureclient.MockPublicIP
+
+		infra     *extensionsv1alpha1.Infrastructure
+		request   reconcile.Request
+		secretRef corev1.SecretReference
+
+		oldFactoryFunc = NewAzureClientFactoryFunc
+		clusterTag     = fmt.Sprintf("kubernetes.io-cluster-%s", namespace)
+		old            = time.Now().Add(-2 * time.Hour)
+
+		resourceID = func(resourceType, name string) string {
+			return fmt.Sprintf("/subscriptions/sub/resourceGroups/%s/providers/%s/%s", resourceGroup, resourceType, name)
+		}
+		genericResource = func(resourceType, name string, created time.Time, tags map[string]*string) *armresources.GenericResourceExpanded {
+			return &armresources.GenericResourceExpanded{
+				ID:          ptr.To(resourceID(resourceType, name)),
+				Name:        ptr.To(name),
+				Type:        ptr.To(resourceType),
+				CreatedTime: ptr.To(created),
+				Tags:        tags,
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		factory = mockazureclient.NewMockFactory(ctrl)
+		resource = mockazureclient.NewMockResource(ctrl)
+		nic = mockazureclient.NewMockNetworkInterface(ctrl)
+		disk = mockazureclient.NewMockDisk(ctrl)
+		pip = mockazureclient.NewMockPublicIP(ctrl)
+		factory.EXPECT().Resource().Return(resource, nil).AnyTimes()
+		factory.EXPECT().NetworkInterface().Return(nic, nil).AnyTimes()
+		factory.EXPECT().Disk().Return(disk, nil).AnyTimes()
+		factory.EXPECT().PublicIP().Return(pip, nil).AnyTimes()
+		NewAzureClientFactoryFunc = func(_ context.Context, _ client.Client, ref corev1.SecretReference, _ bool, _ ...azureclient.AzureFactoryOption) (azureclient.Factory, error) {
+			secretRef = ref
+			return factory, nil
+		}
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: azure.Type,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(
+						`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vnet":{}}}`,
+					)},
+				},
+				Region:    "westeurope",
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+					ProviderStatus: &runtime.RawExtension{Raw: []byte(fmt.Sprintf(
+						`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus","resourceGroup":{"name":%q},"networks":{"vnet":{}},"zoned":false}`,
+						resourceGroup,
+					))},
+					State: &runtime.RawExtension{Raw: []byte(fmt.Sprintf(
+						`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureState","managedItems":[{"kind":"publicIP","id":%q}]}`,
+						resourceID(ResourceTypePublicIP, "nat-ip"),
+					))},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: infra.Name, Namespace: infra.Namespace}}
+
+		shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Region: "westeurope"}}
+		shootRaw, err := json.Marshal(shoot)
+		Expect(err).NotTo(HaveOccurred())
+		cluster := &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec: extensionsv1alpha1.ClusterSpec{
+				CloudProfile: runtime.RawExtension{Raw: []byte(`{}`)},
+				Seed:         runtime.RawExtension{Raw: []byte(`{}`)},
+				Shoot:        runtime.RawExtension{Raw: shootRaw},
+			},
+		}
+
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(infra, cluster).Build()
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	AfterEach(func() {
+		NewAzureClientFactoryFunc = oldFactoryFunc
+		ctrl.Finish()
+	})
+
+	expectOrphanCandidates := func() {
+		resource.EXPECT().ListByResourceGroup(ctx, resourceGroup, &armresources.ClientListByResourceGroupOptions{Expand: ptr.To("createdTime")}).Return([]*armresources.GenericResourceExpanded{
+			// part of the inventory
+			genericResource(ResourceTypePublicIP, "nat-ip", old, map[string]*string{clusterTag: ptr.To("1")}),
+			// not tagged with the cluster tag
+			genericResource(ResourceTypeNetworkInterface, "foreign-nic", old, nil),
+			// within the grace period
+			genericResource(ResourceTypeNetworkInterface, "new-nic", time.Now(), map[string]*string{clusterTag: ptr.To("1")}),
+			// not a candidate type
+			genericResource("Microsoft.Compute/virtualMachines", "vm", old, map[string]*string{clusterTag: ptr.To("1")}),
+			// attached
+			genericResource(ResourceTypeNetworkInterface, "attached-nic", old, map[string]*string{clusterTag: ptr.To("1")}),
+			// orphans
+			genericResource(ResourceTypeNetworkInterface, "orphaned-nic", old, map[string]*string{clusterTag: ptr.To("1")}),
+			genericResource(ResourceTypeDisk, "orphaned-disk", old, map[string]*string{clusterTag: ptr.To("1")}),
+		}, nil)
+		nic.EXPECT().Get(ctx, resourceGroup, "attached-nic").Return(&armnetwork.Interface{
+			Properties: &armnetwork.InterfacePropertiesFormat{VirtualMachine: &armnetwork.SubResource{ID: ptr.To("vm")}},
+		}, nil)
+		nic.EXPECT().Get(ctx, resourceGroup, "orphaned-nic").Return(&armnetwork.Interface{
+			Properties: &armnetwork.InterfacePropertiesFormat{},
+		}, nil)
+		disk.EXPECT().Get(ctx, resourceGroup, "orphaned-disk").Return(&armcompute.Disk{
+			Properties: &armcompute.DiskProperties{DiskState: ptr.To(armcompute.DiskStateUnattached)},
+		}, nil)
+	}
+
+	It("should only report orphans in dry-run mode", func() {
+		expectOrphanCandidates()
+
+		result, err := NewReconciler(c, recorder, config.OrphanDetectionConfig{Enabled: true}).Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Hour))
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(ContainSubstring("OrphanedResourceDetected"))
+	})
+
+	It("should delete orphans if dry-run is disabled", func() {
+		expectOrphanCandidates()
+		nic.EXPECT().Delete(ctx, resourceGroup, "orphaned-nic")
+		disk.EXPECT().Delete(ctx, resourceGroup, "orphaned-disk")
+
+		result, err := NewReconciler(c, recorder, config.OrphanDetectionConfig{
+			Enabled:    true,
+			SyncPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+			DryRun:     ptr.To(false),
+		}).Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+		Expect(recorder.Events).To(HaveLen(4))
+	})
+
+	It("should use the credentials referenced in the infrastructure config", func() {
+		infra.Spec.ProviderConfig.Raw = []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"vnet":{}},"credentialsRef":"network-spn"}`)
+		Expect(c.Update(ctx, infra)).To(Succeed())
+		expectOrphanCandidates()
+
+		_, err := NewReconciler(c, recorder, config.OrphanDetectionConfig{Enabled: true}).Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secretRef).To(Equal(corev1.SecretReference{Name: "ref-network-spn", Namespace: namespace}))
+	})
+
+	It("should skip infrastructures without inventory", func() {
+		infra.Status.State = nil
+		Expect(c.Update(ctx, infra)).To(Succeed())
+
+		result, err := NewReconciler(c, recorder, config.OrphanDetectionConfig{Enabled: true}).Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Hour))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resync
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewQueueFunc constructs the queue of a controller, see controller.Options.NewQueue.
+type NewQueueFunc = func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request]
+
+// NewQueue returns a NewQueueFunc whose queue is filled with all extension objects of the given list type and extension
+// type every period, so that they are reconciled even if they did not change. The objects are spread over a tenth of
+// the period to avoid that all of them are reconciled at once.
+func NewQueue(reader client.Reader, list client.ObjectList, extensionType string, period time.Duration) NewQueueFunc {
+	return func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		queue := workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+			Name: controllerName,
+		})
+
+		go func() {
+			log := logf.Log.WithName("resync").WithValues("controller", controllerName)
+			ticker := time.NewTicker(period)
+			defer ticker.Stop()
+
+			for range ticker.C {
+				if queue.ShuttingDown() {
+					return
+				}
+				requests, err := Requests(context.Background(), reader, list.DeepCopyObject().(client.ObjectList), extensionType)
+				if err != nil {
+					log.Error(err, "Failed listing objects for resync")
+					continue
+				}
+				for _, request := range requests {
+					queue.AddAfter(request, rand.N(period/10+1)) // #nosec G404 -- No cryptographic context.
+				}
+			}
+		}()
+
+		return queue
+	}
+}
+
+// Requests lists the extension objects of the given list type and extension type and returns the requests to reconcile
+// them.
+func Requests(ctx context.Context, reader client.Reader, list client.ObjectList, extensionType string) ([]reconcile.Request, error) {
+	if err := reader.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	var requests []reconcile.Request
+	if err := meta.EachListItem(list, func(obj runtime.Object) error {
+		extensionObj, ok := obj.(extensionsv1alpha1.Object)
+		if !ok || extensionObj.GetExtensionSpec().GetExtensionType() != extensionType {
+			return nil
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(extensionObj)})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resync_test
+
+import (
+	"context"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/resync"
+)
+
+var _ = Describe("Resync", func() {
+	var (
+		ctx = context.Background()
+		c   client.Client
+
+		infrastructure = func(namespace, extensionType string) *extensionsv1alpha1.Infrastructure {
+			return &extensionsv1alpha1.Infrastructure{
+				ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+				Spec: extensionsv1alpha1.InfrastructureSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: extensionType},
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+			infrastructure("shoot--foo--bar", "azure"),
+			infrastructure("shoot--foo--baz", "azure"),
+			infrastructure("shoot--foo--aws", "aws"),
+		).Build()
+	})
+
+	Describe("#Requests", func() {
+		It("should return the requests for the objects of the extension type", func() {
+			Expect(Requests(ctx, c, &extensionsv1alpha1.InfrastructureList{}, "azure")).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "shoot--foo--bar", Name: "infra"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "shoot--foo--baz", Name: "infra"}},
+			))
+		})
+	})
+
+	Describe("#NewQueue", func() {
+		It("should add the objects to the queue periodically", func() {
+			queue := NewQueue(c, &extensionsv1alpha1.InfrastructureList{}, "azure", 50*time.Millisecond)("infrastructure", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			DeferCleanup(queue.ShutDown)
+
+			Eventually(queue.Len).Should(Equal(2))
+		})
+	})
+})
@@ -14,10 +14,12 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -25,35 +27,67 @@ import (
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azuretypes "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
+// NewAzureClientFactoryFunc is a hook to monkeypatch the factory ctor during tests.
+var NewAzureClientFactoryFunc = azureclient.NewAzureClientFactoryFromSecret
+
 type delegateFactory struct {
 	seedClient   client.Client
 	restConfig   *rest.Config
 	scheme       *runtime.Scheme
 	gardenReader client.Reader
+	recorder     record.EventRecorder
+
+	mandatoryVMTags map[string]string
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
-func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actuator {
+func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster, mandatoryVMTags map[string]string) worker.Actuator {
 	var (
 		workerDelegate = &delegateFactory{
 			seedClient:   mgr.GetClient(),
 			restConfig:   mgr.GetConfig(),
 			scheme:       mgr.GetScheme(),
 			gardenReader: gardenCluster.GetAPIReader(),
+			recorder:     mgr.GetEventRecorderFor(azuretypes.Name + "-worker-controller"),
+
+			mandatoryVMTags: mandatoryVMTags,
 		}
 	)
 
-	return genericactuator.NewActuator(
-		mgr,
-		gardenCluster,
-		workerDelegate,
-		func(err error) []gardencorev1beta1.ErrorCode {
-			return util.DetermineErrorCodes(err, helper.KnownCodes)
-		},
-	)
+	return &actuator{
+		Actuator: genericactuator.NewActuator(
+			mgr,
+			gardenCluster,
+			workerDelegate,
+			func(err error) []gardencorev1beta1.ErrorCode {
+				return util.DetermineErrorCodes(err, helper.KnownCodes)
+			},
+		),
+		client: mgr.GetClient(),
+	}
+}
+
+// actuator reports the availability of the Azure region in the conditions of the Worker, as its clients are guarded by
+// the circuit breaker of the region.
+type actuator struct {
+	worker.Actuator
+	client client.Client
+}
+
+// Reconcile implements worker.Actuator.
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	err := a.Actuator.Reconcile(ctx, log, worker, cluster)
+	return azureclient.HandleRegionalOutage(ctx, log, a.client, worker, &worker.Status.Conditions, err)
+}
+
+// Delete implements worker.Actuator.
+func (a *actuator) Delete(ctx context.Context, log logr.Logger, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	err := a.Actuator.Delete(ctx, log, worker, cluster)
+	return azureclient.HandleRegionalOutage(ctx, log, a.client, worker, &worker.Status.Conditions, err)
 }
 
 func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (genericactuator.WorkerDelegate, error) {
@@ -72,6 +106,15 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		return nil, err
 	}
 
+	clientFactory, err := newClientFactory(ctx, d.seedClient, worker, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkerDelegate(d.seedClient, d.scheme, d.recorder, seedChartApplier, serverVersion.GitVersion, worker, cluster, clientFactory, d.mandatoryVMTags)
+}
+
+func newClientFactory(ctx context.Context, c client.Client, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (azureclient.Factory, error) {
 	cloudProfile, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
 		return nil, err
@@ -87,18 +130,14 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		return nil, err
 	}
 
-	clientFactory, err := azureclient.NewAzureClientFactoryFromSecret(
+	return NewAzureClientFactoryFunc(
 		ctx,
-		d.seedClient,
+		c,
 		worker.Spec.SecretRef,
 		false,
 		azureclient.WithCloudConfiguration(azCloudConfiguration),
+		azureclient.WithRegion(worker.Spec.Region),
 	)
-	if err != nil {
-		return nil, err
-	}
-
-	return NewWorkerDelegate(d.seedClient, d.scheme, seedChartApplier, serverVersion.GitVersion, worker, cluster, clientFactory)
 }
 
 type workerDelegate struct {
@@ -106,6 +145,7 @@ type workerDelegate struct {
 	scheme         *runtime.Scheme
 	decoder        runtime.Decoder
 	lenientDecoder runtime.Decoder
+	recorder       record.EventRecorder
 
 	seedChartApplier gardener.ChartApplier
 	serverVersion    string
@@ -117,19 +157,24 @@ type workerDelegate struct {
 	machineClasses     []map[string]interface{}
 	machineDeployments worker.MachineDeployments
 	machineImages      []api.MachineImage
+	userDataSecrets    map[string][]byte
 
 	clientFactory azureclient.Factory
+
+	mandatoryVMTags map[string]string
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
 func NewWorkerDelegate(
 	client client.Client,
 	scheme *runtime.Scheme,
+	recorder record.EventRecorder,
 	seedChartApplier gardener.ChartApplier,
 	serverVersion string,
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
 	factory azureclient.Factory,
+	mandatoryVMTags map[string]string,
 ) (genericactuator.WorkerDelegate, error) {
 	config, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
@@ -141,6 +186,7 @@ func NewWorkerDelegate(
 		scheme:         scheme,
 		decoder:        serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
 		lenientDecoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+		recorder:       recorder,
 
 		seedChartApplier: seedChartApplier,
 		serverVersion:    serverVersion,
@@ -150,5 +196,7 @@ func NewWorkerDelegate(
 		worker:             worker,
 
 		clientFactory: factory,
+
+		mandatoryVMTags: mandatoryVMTags,
 	}, nil
 }
@@ -6,19 +6,29 @@ package worker
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	machinescheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	apiextensionsscheme "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
+// MaintenanceControllerName is the name of the controller which assigns the maintenance configurations of the worker
+// pools to the virtual machines of new machines.
+const MaintenanceControllerName = "worker-maintenance"
+
 // DefaultAddOptions are the default AddOptions for AddToManager.
 var DefaultAddOptions = AddOptions{}
 
@@ -32,6 +42,8 @@ type AddOptions struct {
 	GardenCluster cluster.Cluster
 	// ExtensionClass defines the extension class this extension is responsible for.
 	ExtensionClass extensionsv1alpha1.ExtensionClass
+	// MandatoryVMTags are tags which are added to the virtual machines of all shoots.
+	MandatoryVMTags map[string]string
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -45,13 +57,32 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 		return err
 	}
 
-	return worker.Add(ctx, mgr, worker.AddArgs{
-		Actuator:          NewActuator(mgr, opts.GardenCluster),
+	if err := worker.Add(ctx, mgr, worker.AddArgs{
+		Actuator:          NewActuator(mgr, opts.GardenCluster, opts.MandatoryVMTags),
 		ControllerOptions: opts.Controller,
 		Predicates:        worker.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              azure.Type,
 		ExtensionClass:    opts.ExtensionClass,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(MaintenanceControllerName).
+		WithOptions(opts.Controller).
+		For(&machinev1alpha1.Machine{}, builder.WithPredicates(
+			// Only machines whose virtual machine was created and which did not get a maintenance configuration yet are
+			// relevant. Changes of the maintenance configurations are handled by the reconciliation of the Worker.
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				machine, ok := obj.(*machinev1alpha1.Machine)
+				return ok &&
+					machine.DeletionTimestamp == nil &&
+					strings.HasPrefix(machine.Spec.ProviderID, azureProviderIDPrefix) &&
+					!metav1.HasAnnotation(machine.ObjectMeta, azure.MaintenanceConfigurationAnnotation)
+			}),
+		)).
+		Complete(NewMaintenanceReconciler(mgr.GetClient(), mgr.GetScheme()))
 }
 
 // AddToManager adds a controller with the default Options.
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,11 +17,31 @@ import (
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 )
 
-func (w *workerDelegate) decodeAzureInfrastructureStatus() (*azureapi.InfrastructureStatus, error) {
+func (w *workerDelegate) decodeAzureInfrastructureStatus(ctx context.Context) (*azureapi.InfrastructureStatus, error) {
 	infrastructureStatus := &azureapi.InfrastructureStatus{}
 	if _, _, err := w.lenientDecoder.Decode(w.worker.Spec.InfrastructureProviderStatus.Raw, nil, infrastructureStatus); err != nil {
 		return nil, err
 	}
+	if infrastructureStatus.AvailabilitySetMigration == nil {
+		return infrastructureStatus, nil
+	}
+
+	// During a staged migration from the availability set to VMOs, the Infrastructure releases the worker pools one
+	// after another. Its status is only copied to the Worker when the shoot is reconciled, hence the progress of the
+	// migration is read from the Infrastructure, which has the same name as the Worker.
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := w.client.Get(ctx, client.ObjectKey{Namespace: w.worker.Namespace, Name: w.worker.Name}, infra); err != nil {
+		return nil, fmt.Errorf("could not read the infrastructure of worker '%s': %w", client.ObjectKeyFromObject(w.worker), err)
+	}
+	if infra.Status.ProviderStatus == nil {
+		return infrastructureStatus, nil
+	}
+	current := &azureapi.InfrastructureStatus{}
+	if _, _, err := w.lenientDecoder.Decode(infra.Status.ProviderStatus.Raw, nil, current); err != nil {
+		return nil, err
+	}
+	infrastructureStatus.AvailabilitySets = current.AvailabilitySets
+	infrastructureStatus.AvailabilitySetMigration = current.AvailabilitySetMigration
 	return infrastructureStatus, nil
 }
 
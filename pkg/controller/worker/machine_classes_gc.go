@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// machineClassGarbageCollectionThreshold is the minimum age of a machine class or machine class secret before it
+	// is considered for garbage collection. It protects objects that were just created by a concurrent or
+	// previously interrupted reconciliation and are not yet referenced by a machine deployment.
+	machineClassGarbageCollectionThreshold = time.Hour
+
+	machineClassGarbageCollectionKindMachineClass       = "MachineClass"
+	machineClassGarbageCollectionKindMachineClassSecret = "Secret"
+)
+
+var machineClassGarbageCollectedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "azure_worker_machine_class_garbage_collected_total",
+		Help: "Number of orphaned machine classes and machine class secrets deleted by the worker garbage collection.",
+	},
+	[]string{"kind"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(machineClassGarbageCollectedTotal)
+}
+
+// collectOrphanedMachineClasses deletes machine classes and machine class secrets in the worker namespace that are
+// neither part of the desired state nor referenced by any MachineDeployment, MachineSet or Machine. The generic
+// actuator only cleans them up after all machine deployments became available, hence namespaces of shoots whose
+// rollouts keep failing accumulate stale classes (e.g. after pool renames or worker pool hash changes).
+func (w *workerDelegate) collectOrphanedMachineClasses(ctx context.Context) error {
+	var (
+		logger          = log.FromContext(ctx).WithName("machine-class-gc")
+		namespace       = w.worker.Namespace
+		deletionCutoff  = time.Now().Add(-machineClassGarbageCollectionThreshold)
+		usedClasses     = sets.New[string]()
+		usedSecrets     = sets.New[string]()
+		isCollectableFn = func(obj client.Object) bool {
+			return obj.GetDeletionTimestamp() == nil && obj.GetCreationTimestamp().Time.Before(deletionCutoff)
+		}
+	)
+
+	for _, machineDeployment := range w.machineDeployments {
+		usedClasses.Insert(machineDeployment.ClassName)
+		usedSecrets.Insert(machineDeployment.SecretName)
+	}
+
+	machineDeploymentList := &machinev1alpha1.MachineDeploymentList{}
+	if err := w.client.List(ctx, machineDeploymentList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list machine deployments: %w", err)
+	}
+	for _, machineDeployment := range machineDeploymentList.Items {
+		usedClasses.Insert(machineDeployment.Spec.Template.Spec.Class.Name)
+	}
+
+	machineSetList := &machinev1alpha1.MachineSetList{}
+	if err := w.client.List(ctx, machineSetList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list machine sets: %w", err)
+	}
+	for _, machineSet := range machineSetList.Items {
+		usedClasses.Insert(machineSet.Spec.Template.Spec.Class.Name)
+	}
+
+	// Machines still need their class to delete the backing virtual machine, so classes of machines without an owning
+	// machine set must be retained as well.
+	machineList := &machinev1alpha1.MachineList{}
+	if err := w.client.List(ctx, machineList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list machines: %w", err)
+	}
+	for _, machine := range machineList.Items {
+		usedClasses.Insert(machine.Spec.Class.Name)
+	}
+
+	machineClassList := &machinev1alpha1.MachineClassList{}
+	if err := w.client.List(ctx, machineClassList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list machine classes: %w", err)
+	}
+	for _, machineClass := range machineClassList.Items {
+		if usedClasses.Has(machineClass.Name) || !isCollectableFn(&machineClass) {
+			if machineClass.SecretRef != nil {
+				usedSecrets.Insert(machineClass.SecretRef.Name)
+			}
+			continue
+		}
+
+		logger.Info("Deleting orphaned machine class", "machineClass", client.ObjectKeyFromObject(&machineClass))
+		if err := w.client.Delete(ctx, machineClass.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete orphaned machine class %s: %w", client.ObjectKeyFromObject(&machineClass), err)
+		}
+		machineClassGarbageCollectedTotal.WithLabelValues(machineClassGarbageCollectionKindMachineClass).Inc()
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := w.client.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass}); err != nil {
+		return fmt.Errorf("failed to list machine class secrets: %w", err)
+	}
+	for _, secret := range secretList.Items {
+		if usedSecrets.Has(secret.Name) || !isCollectableFn(&secret) {
+			continue
+		}
+
+		logger.Info("Deleting orphaned machine class secret", "secret", client.ObjectKeyFromObject(&secret))
+		if err := w.client.Delete(ctx, secret.DeepCopy()); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete orphaned machine class secret %s: %w", client.ObjectKeyFromObject(&secret), err)
+		}
+		machineClassGarbageCollectedTotal.WithLabelValues(machineClassGarbageCollectionKindMachineClassSecret).Inc()
+	}
+
+	return nil
+}
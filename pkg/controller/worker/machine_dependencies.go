@@ -6,10 +6,26 @@ package worker
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 )
 
+// zonalMigrationRequeueInterval is the interval after which the Worker is reconciled again to roll out the next zone of
+// the worker pools which are converted to zoned worker pools.
+const zonalMigrationRequeueInterval = 30 * time.Second
+
+// stagedVmoMigrationRequeueInterval is the interval after which the Worker is reconciled again during a staged
+// migration from the availability set to VMOs to roll out the worker pools which were released in the meantime.
+const stagedVmoMigrationRequeueInterval = 5 * time.Minute
+
 // DeployMachineDependencies implements genericactuator.WorkerDelegate.
 // Deprecated: Do not use this func. It is deprecated in genericactuator.WorkerDelegate.
 func (w *workerDelegate) DeployMachineDependencies(_ context.Context) error {
@@ -24,7 +40,7 @@ func (w *workerDelegate) CleanupMachineDependencies(_ context.Context) error {
 
 // PreReconcileHook implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
-	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
+	infrastructureStatus, err := w.decodeAzureInfrastructureStatus(ctx)
 	if err != nil {
 		return err
 	}
@@ -47,7 +63,46 @@ func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
 
 // PostReconcileHook implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) PostReconcileHook(ctx context.Context) error {
-	return w.cleanupMachineDependencies(ctx)
+	if err := w.cleanupMachineDependencies(ctx); err != nil {
+		return err
+	}
+	if err := w.reconcileMaintenanceAssignments(ctx); err != nil {
+		return err
+	}
+	return w.requeueStagedVmoMigration(ctx)
+}
+
+// requeueStagedVmoMigration triggers the reconciliation of the Infrastructure and requeues the Worker as long as a
+// staged migration from the availability set to VMOs is in progress. The Infrastructure releases the next worker pool
+// once the machines of the released worker pools left the availability set, and the Worker rolls it out when it is
+// reconciled again.
+func (w *workerDelegate) requeueStagedVmoMigration(ctx context.Context) error {
+	infrastructureStatus, err := w.decodeAzureInfrastructureStatus(ctx)
+	if err != nil {
+		return err
+	}
+	migration := infrastructureStatus.AvailabilitySetMigration
+	if w.worker.DeletionTimestamp != nil || migration == nil || len(infrastructureStatus.AvailabilitySets) == 0 {
+		return nil
+	}
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := w.client.Get(ctx, client.ObjectKey{Namespace: w.worker.Namespace, Name: w.worker.Name}, infra); err != nil {
+		return err
+	}
+	if _, ok := infra.Annotations[v1beta1constants.GardenerOperation]; !ok {
+		patch := client.MergeFrom(infra.DeepCopy())
+		metav1.SetMetaDataAnnotation(&infra.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		if err := w.client.Patch(ctx, infra, patch); err != nil {
+			return fmt.Errorf("failed to trigger reconciliation of Infrastructure: %w", err)
+		}
+	}
+
+	return &reconcilerutils.RequeueAfterError{
+		Cause: fmt.Errorf("staged migration to VMOs is in progress, migrated worker pools: %v, remaining virtual machines in the availability set: %d",
+			migration.MigratedWorkerPools, migration.RemainingVirtualMachines),
+		RequeueAfter: stagedVmoMigrationRequeueInterval,
+	}
 }
 
 // PreDeleteHook implements genericactuator.WorkerDelegate.
@@ -68,7 +123,7 @@ func (w *workerDelegate) PostDeleteHook(ctx context.Context) error {
 // Refactor this so that PostDeleteHook executes only the handling for Worker being deleted and PostReconcileHook executes only
 // the handling for Worker reconciled (not being deleted).
 func (w *workerDelegate) cleanupMachineDependencies(ctx context.Context) error {
-	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
+	infrastructureStatus, err := w.decodeAzureInfrastructureStatus(ctx)
 	if err != nil {
 		return err
 	}
@@ -86,5 +141,29 @@ func (w *workerDelegate) cleanupMachineDependencies(ctx context.Context) error {
 		return w.updateWorkerProviderStatus(ctx, workerProviderStatus)
 	}
 
+	// The shoot was converted to a zoned shoot. The VMOs of the former non-zoned machine deployments can be removed
+	// once these machine deployments are gone. Until then, the Worker is requeued to roll out the remaining zones.
+	if infrastructureStatus.Zoned && len(workerProviderStatus.VmoDependencies) > 0 {
+		vmoDependencies, err := w.cleanupZonalMigrationVmoDependencies(ctx, infrastructureStatus, workerProviderStatus)
+		workerProviderStatus.VmoDependencies = vmoDependencies
+		if err != nil {
+			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, err)
+		}
+		if err := w.updateWorkerProviderStatus(ctx, workerProviderStatus); err != nil {
+			return err
+		}
+		if len(vmoDependencies) > 0 && w.worker.DeletionTimestamp == nil {
+			pools := make([]string, 0, len(vmoDependencies))
+			for _, dependency := range vmoDependencies {
+				pools = append(pools, dependency.PoolName)
+			}
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("conversion of worker pools %v to zoned worker pools is in progress", pools),
+				RequeueAfter: zonalMigrationRequeueInterval,
+			}
+		}
+		return nil
+	}
+
 	return nil
 }
@@ -9,25 +9,32 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/go-autorest/autorest"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	factorymock "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
 	vmssmock "github.com/gardener/gardener-extension-provider-azure/pkg/mock/vmss"
 )
@@ -153,6 +160,52 @@ var _ = Describe("MachinesDependencies", func() {
 				err := workerDelegate.PreReconcileHook(ctx)
 				Expect(err).NotTo(HaveOccurred())
 
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ContainElements(MatchFields(IgnoreExtras, Fields{
+					"ID":       Equal(vmoDependency.ID),
+					"Name":     Equal(vmoDependency.Name),
+					"PoolName": Equal(vmoDependency.PoolName),
+				})))
+			})
+			It("should deploy a new vmo dependency as the zone balancing changes", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","vmo":{"zoneBalance":false}}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				vmoClient.EXPECT().Get(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
+					ID:   ptr.To(vmoID),
+					Name: ptr.To(vmoName),
+					Properties: &armcompute.VirtualMachineScaleSetProperties{
+						PlatformFaultDomainCount: ptr.To(faultDomainCount),
+						ZoneBalance:              ptr.To(true),
+					},
+				}, nil)
+				vmoClient.EXPECT().CreateOrUpdate(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), gomock.AssignableToTypeOf(armcompute.VirtualMachineScaleSet{})).DoAndReturn(
+					func(_ context.Context, _, _ string, parameters armcompute.VirtualMachineScaleSet) (*armcompute.VirtualMachineScaleSet, error) {
+						Expect(parameters.Properties.PlatformFaultDomainCount).To(PointTo(Equal(faultDomainCount)))
+						Expect(parameters.Properties.ZoneBalance).To(PointTo(BeFalse()))
+						return &armcompute.VirtualMachineScaleSet{ID: ptr.To(vmoID), Name: ptr.To(vmoName)}, nil
+					})
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+
+			It("should not deploy a new vmo dependency if the existing vmo matches the fault domain count of the worker config", func() {
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","vmo":{"faultDomainCount":2}}`),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoGetToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID, 2)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				err := workerDelegate.PreReconcileHook(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
 				workerStatus := decodeWorkerProviderStatus(w)
 				Expect(workerStatus.VmoDependencies).To(ContainElements(MatchFields(IgnoreExtras, Fields{
 					"ID":       Equal(vmoDependency.ID),
@@ -163,6 +216,11 @@ var _ = Describe("MachinesDependencies", func() {
 		})
 
 		Context("#PostReconcileHook", func() {
+			BeforeEach(func() {
+				// The maintenance configuration assignments are reconciled after the vmo dependencies.
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace)).AnyTimes()
+			})
+
 			It("should cleanup nothing as no vmo was required", func() {
 				w := makeWorker(namespace, region, nil, nil)
 				workerDelegate := wrapNewWorkerDelegate(c, nil, w, nil, factory)
@@ -265,6 +323,38 @@ var _ = Describe("MachinesDependencies", func() {
 				workerStatus := decodeWorkerProviderStatus(w)
 				Expect(workerStatus.VmoDependencies).To(HaveLen(0))
 			})
+
+			It("should cleanup all vmo dependencies as the shoot was converted to a zoned shoot", func() {
+				zonedInfrastructureStatus := makeInfrastructureStatus(resourceGroupName, "vnet-name", "subnet-name", true, nil, nil, nil)
+				w := makeWorker(namespace, region, nil, zonedInfrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: namespace + "-" + pool.Name}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).
+					Return(apierrors.NewNotFound(machinev1alpha1.Resource("machinedeployments"), namespace+"-"+pool.Name))
+				vmoClient.EXPECT().Delete(ctx, resourceGroupName, vmoName, ptr.To(false))
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				err := workerDelegate.PostReconcileHook(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(HaveLen(0))
+			})
+
+			It("should keep the vmo dependency and requeue as long as the former machine deployment exists", func() {
+				zonedInfrastructureStatus := makeInfrastructureStatus(resourceGroupName, "vnet-name", "subnet-name", true, nil, nil, nil)
+				w := makeWorker(namespace, region, nil, zonedInfrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: namespace + "-" + pool.Name}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{}))
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				err := workerDelegate.PostReconcileHook(ctx)
+				Expect(err).To(BeAssignableToTypeOf(&reconcilerutils.RequeueAfterError{}))
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(vmoDependency))
+			})
 		})
 
 		Context("#PostDeleteHook", func() {
@@ -371,6 +461,210 @@ var _ = Describe("MachinesDependencies", func() {
 				Expect(workerStatus.VmoDependencies).To(HaveLen(0))
 			})
 		})
+
+		Context("staged migration from the availability set", func() {
+			var (
+				availabilitySetID = "/subscriptions/sample-subscription/resourceGroups/sample-rg/providers/Microsoft.Compute/availabilitySets/workers"
+
+				w                 *extensionsv1alpha1.Worker
+				infra             *extensionsv1alpha1.Infrastructure
+				currentInfraState = func(availabilitySet bool, migratedWorkerPools ...string) *runtime.RawExtension {
+					status := &v1alpha1.InfrastructureStatus{
+						TypeMeta:       metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureStatus"},
+						MigratingToVMO: true,
+						AvailabilitySetMigration: &v1alpha1.AvailabilitySetMigrationStatus{
+							MigratedWorkerPools:      migratedWorkerPools,
+							RemainingVirtualMachines: 2,
+						},
+					}
+					if availabilitySet {
+						status.AvailabilitySets = []v1alpha1.AvailabilitySet{{Purpose: v1alpha1.PurposeNodes, ID: availabilitySetID}}
+					}
+					return &runtime.RawExtension{Raw: encode(status)}
+				}
+				expectInfrastructureGet = func() {
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace}, gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{})).DoAndReturn(
+						func(_ context.Context, _ client.ObjectKey, obj *extensionsv1alpha1.Infrastructure, _ ...client.GetOption) error {
+							infra.DeepCopyInto(obj)
+							return nil
+						},
+					).AnyTimes()
+				}
+			)
+
+			BeforeEach(func() {
+				// the copy of the infrastructure status in the Worker does not know the released worker pools yet.
+				stagedInfrastructureStatus := makeInfrastructureStatus(resourceGroupName, "vnet-name", "subnet-name", false, nil, &availabilitySetID, nil)
+				stagedInfrastructureStatus.MigratingToVMO = true
+				stagedInfrastructureStatus.AvailabilitySetMigration = &azureapi.AvailabilitySetMigrationStatus{}
+				w = makeWorker(namespace, region, nil, stagedInfrastructureStatus, pool)
+
+				infra = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Namespace: namespace}}
+				infra.Status.ProviderStatus = currentInfraState(true, pool.Name)
+			})
+
+			It("should deploy the vmo dependency of a worker pool which was released in the meantime", func() {
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectInfrastructureGet()
+				expectVmoCreateToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"PoolName": Equal(pool.Name)})))
+			})
+
+			It("should trigger the reconciliation of the infrastructure and requeue as long as the availability set exists", func() {
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectInfrastructureGet()
+				expectVmoListToSucceed(ctx, vmoClient, resourceGroupName)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace))
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.Infrastructure{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, obj *extensionsv1alpha1.Infrastructure, _ client.Patch, _ ...client.PatchOption) error {
+						Expect(obj.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+						return nil
+					},
+				)
+
+				err := workerDelegate.PostReconcileHook(ctx)
+				Expect(err).To(BeAssignableToTypeOf(&reconcilerutils.RequeueAfterError{}))
+			})
+
+			It("should not requeue once the availability set was deleted", func() {
+				infra.Status.ProviderStatus = currentInfraState(false, pool.Name)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectInfrastructureGet()
+				expectVmoListToSucceed(ctx, vmoClient, resourceGroupName)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace))
+
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("Maintenance assignments", func() {
+		const (
+			configurationID = "/subscriptions/sub/resourceGroups/maintenance/providers/Microsoft.Maintenance/maintenanceConfigurations/weekend"
+			vmID            = "/subscriptions/sub/resourceGroups/shoot--foobar--azure/providers/Microsoft.Compute/virtualMachines/"
+		)
+
+		var (
+			assignments *factorymock.MockMaintenanceAssignments
+			w           *extensionsv1alpha1.Worker
+
+			machine = func(name, pool string, created bool) machinev1alpha1.Machine {
+				m := machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: machinev1alpha1.MachineSpec{
+						NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{v1beta1constants.LabelWorkerPool: pool}},
+						},
+					},
+				}
+				if created {
+					m.Spec.ProviderID = "azure://" + vmID + name
+				}
+				return m
+			}
+			expectMachineList = func(machines ...machinev1alpha1.Machine) {
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace)).DoAndReturn(
+					func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+						list.Items = machines
+						return nil
+					},
+				)
+			}
+		)
+
+		BeforeEach(func() {
+			assignments = factorymock.NewMockMaintenanceAssignments(ctrl)
+			factory.EXPECT().MaintenanceAssignments().Return(assignments, nil).AnyTimes()
+
+			infrastructureStatus := makeInfrastructureStatus(resourceGroupName, "vnet-name", "subnet-name", true, nil, nil, nil)
+			w = makeWorker(namespace, region, nil, infrastructureStatus,
+				extensionsv1alpha1.WorkerPool{
+					Name: "maintained",
+					ProviderConfig: &runtime.RawExtension{Raw: encode(&v1alpha1.WorkerConfig{
+						TypeMeta:                 metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+						MaintenanceConfiguration: ptr.To(configurationID),
+					})},
+				},
+				extensionsv1alpha1.WorkerPool{Name: "other"},
+			)
+		})
+
+		It("should assign the maintenance configuration to the virtual machines of the worker pool", func() {
+			expectMachineList(machine("maintained-1", "maintained", true), machine("maintained-2", "maintained", false), machine("other-1", "other", true))
+			assignments.EXPECT().Get(ctx, vmID+"maintained-1", "gardener").Return(nil, nil)
+			assignments.EXPECT().CreateOrUpdate(ctx, vmID+"maintained-1", "gardener", azureclient.MaintenanceAssignment{
+				Location: ptr.To(region),
+				Properties: &azureclient.MaintenanceAssignmentProperties{
+					MaintenanceConfigurationID: ptr.To(configurationID),
+					ResourceID:                 ptr.To(vmID + "maintained-1"),
+				},
+			})
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.Machine{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, m *machinev1alpha1.Machine, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(m.Name).To(Equal("maintained-1"))
+					Expect(m.Annotations).To(HaveKeyWithValue(azure.MaintenanceConfigurationAnnotation, configurationID))
+					return nil
+				},
+			)
+
+			Expect(wrapNewWorkerDelegate(c, nil, w, makeCluster("", region, nil, nil, 0), factory).PostReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should only record assignments which are up to date", func() {
+			expectMachineList(machine("maintained-1", "maintained", true))
+			assignments.EXPECT().Get(ctx, vmID+"maintained-1", "gardener").Return(&azureclient.MaintenanceAssignment{
+				Properties: &azureclient.MaintenanceAssignmentProperties{MaintenanceConfigurationID: ptr.To(strings.ToLower(configurationID))},
+			}, nil)
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.Machine{}), gomock.Any())
+
+			Expect(wrapNewWorkerDelegate(c, nil, w, makeCluster("", region, nil, nil, 0), factory).PostReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should not call Azure for machines whose recorded assignment is up to date", func() {
+			m := machine("maintained-1", "maintained", true)
+			metav1.SetMetaDataAnnotation(&m.ObjectMeta, azure.MaintenanceConfigurationAnnotation, strings.ToLower(configurationID))
+			expectMachineList(m, machine("other-1", "other", true))
+
+			Expect(wrapNewWorkerDelegate(c, nil, w, makeCluster("", region, nil, nil, 0), factory).PostReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should delete the assignments of machines whose worker pool no longer has a maintenance configuration", func() {
+			w.Spec.Pools = w.Spec.Pools[1:]
+			m := machine("other-1", "other", true)
+			metav1.SetMetaDataAnnotation(&m.ObjectMeta, azure.MaintenanceConfigurationAnnotation, configurationID)
+			expectMachineList(m)
+			assignments.EXPECT().Delete(ctx, vmID+"other-1", "gardener")
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.Machine{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, m *machinev1alpha1.Machine, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(m.Annotations).NotTo(HaveKey(azure.MaintenanceConfigurationAnnotation))
+					return nil
+				},
+			)
+
+			Expect(wrapNewWorkerDelegate(c, nil, w, makeCluster("", region, nil, nil, 0), factory).PostReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should replace the assignments of machines whose worker pool has another maintenance configuration", func() {
+			m := machine("maintained-1", "maintained", true)
+			metav1.SetMetaDataAnnotation(&m.ObjectMeta, azure.MaintenanceConfigurationAnnotation, configurationID+"-old")
+			expectMachineList(m)
+			assignments.EXPECT().Get(ctx, vmID+"maintained-1", "gardener").Return(&azureclient.MaintenanceAssignment{
+				Properties: &azureclient.MaintenanceAssignmentProperties{MaintenanceConfigurationID: ptr.To(configurationID + "-old")},
+			}, nil)
+			assignments.EXPECT().CreateOrUpdate(ctx, vmID+"maintained-1", "gardener", gomock.Any())
+			c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.Machine{}), gomock.Any())
+
+			Expect(wrapNewWorkerDelegate(c, nil, w, makeCluster("", region, nil, nil, 0), factory).PostReconcileHook(ctx)).To(Succeed())
+		})
 	})
 })
 
@@ -417,6 +711,21 @@ func generateWorkerStatusWithVmo(vmos ...v1alpha1.VmoDependency) *runtime.RawExt
 	}
 }
 
+func generateWorkerStatusWithMachineImages(machineImages ...v1alpha1.MachineImage) *runtime.RawExtension {
+	workerStatus := &v1alpha1.WorkerStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "WorkerStatus",
+		},
+		MachineImages: machineImages,
+	}
+	workerStatusMarshaled, err := json.Marshal(workerStatus)
+	Expect(err).NotTo(HaveOccurred())
+	return &runtime.RawExtension{
+		Raw: workerStatusMarshaled,
+	}
+}
+
 func generateExpectedVmo(name, id string) *armcompute.VirtualMachineScaleSet {
 	return &armcompute.VirtualMachineScaleSet{
 		ID:   ptr.To(id),
@@ -7,12 +7,21 @@ package worker
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
 // UpdateMachineImagesStatus stores the used machine images for the `Worker` resource in the worker-provider-status.
@@ -61,6 +70,181 @@ func (w *workerDelegate) findMachineImage(name, version string, architecture *st
 	return nil, worker.ErrorMachineImageNotFound(name, version, *architecture)
 }
 
+// galleryImageVersionLatest is the version of a gallery image ID which references the latest version of the image.
+const galleryImageVersionLatest = "latest"
+
+// galleryImageVersion is a version of a community or shared gallery image.
+type galleryImageVersion struct {
+	name              string
+	excludeFromLatest bool
+}
+
+// resolveGalleryImageVersion verifies that the version referenced by community and shared gallery image IDs is
+// replicated to the worker's region, so that the creation of the VMs does not fail late in the
+// machine-controller-manager. Image IDs which reference the `latest` version of an image are resolved to the concrete
+// version which is currently the latest one in the worker's region. Other machine images are returned as they are.
+// The check is only done for images which are not yet recorded in the worker status, i.e. when a pool is created or
+// its image is changed, and it is skipped while the worker is being deleted. The version resolved for `latest` is
+// pinned by the worker status, so that publishing a new version of the image does not roll the machines.
+func (w *workerDelegate) resolveGalleryImageVersion(ctx context.Context, workerStatus *api.WorkerStatus, machineImage *api.MachineImage) (*api.MachineImage, error) {
+	// Gallery image IDs have the format '/<Community|Shared>Galleries/<gallery>/Images/<image>/Versions/<version>'.
+	galleryImageID := ptr.Deref(machineImage.CommunityGalleryImageID, ptr.Deref(machineImage.SharedGalleryImageID, ""))
+	parts := strings.Split(galleryImageID, "/")
+	if len(parts) != 7 || w.worker.DeletionTimestamp != nil {
+		return machineImage, nil
+	}
+	if usedGalleryImageID := findUsedGalleryImageID(workerStatus, machineImage, parts); usedGalleryImageID != "" {
+		return withGalleryImageID(machineImage, usedGalleryImageID), nil
+	}
+
+	client, err := w.clientFactory.GalleryImageVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := listGalleryImageVersions(ctx, client, w.worker.Spec.Region, machineImage.CommunityGalleryImageID != nil, parts[2], parts[4])
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the versions of gallery image %q: %w", galleryImageID, err)
+	}
+
+	if !strings.EqualFold(parts[6], galleryImageVersionLatest) {
+		if slices.ContainsFunc(versions, func(v galleryImageVersion) bool { return v.name == parts[6] }) {
+			return machineImage, nil
+		}
+		replicatedRegions := w.findGalleryImageVersionRegions(ctx, client, machineImage.CommunityGalleryImageID != nil, parts[2], parts[4], parts[6])
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("gallery image %q is not replicated to region %s, it is only replicated to the regions %v of the cloud profile",
+			galleryImageID, w.worker.Spec.Region, replicatedRegions), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	var latest *semver.Version
+	for _, version := range versions {
+		if version.excludeFromLatest {
+			continue
+		}
+		v, err := semver.NewVersion(version.name)
+		if err != nil {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no version of gallery image %q found in region %s", galleryImageID, w.worker.Spec.Region)
+	}
+
+	parts[6] = latest.Original()
+	return withGalleryImageID(machineImage, strings.Join(parts, "/")), nil
+}
+
+// withGalleryImageID returns a copy of the given machine image which references the given gallery image ID instead.
+func withGalleryImageID(machineImage *api.MachineImage, galleryImageID string) *api.MachineImage {
+	image := machineImage.DeepCopy()
+	if image.CommunityGalleryImageID != nil {
+		image.CommunityGalleryImageID = ptr.To(galleryImageID)
+	} else {
+		image.SharedGalleryImageID = ptr.To(galleryImageID)
+	}
+	return image
+}
+
+// findGalleryImageVersionRegions returns the regions of the cloud profile other than the worker's region to which the
+// given version of a gallery image is replicated. Regions in which the versions cannot be listed are skipped, the
+// result is only used to explain why the image cannot be used. The regions are queried concurrently.
+func (w *workerDelegate) findGalleryImageVersionRegions(ctx context.Context, client azureclient.GalleryImageVersions, community bool, gallery, image, version string) []string {
+	regions := []string{}
+	if w.cluster == nil || w.cluster.CloudProfile == nil {
+		return regions
+	}
+
+	var (
+		cloudProfileRegions = w.cluster.CloudProfile.Spec.Regions
+		replicated          = make([]bool, len(cloudProfileRegions))
+		wg                  = sync.WaitGroup{}
+	)
+	for i, region := range cloudProfileRegions {
+		if region.Name == w.worker.Spec.Region {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			versions, err := listGalleryImageVersions(ctx, client, region.Name, community, gallery, image)
+			if err != nil {
+				return
+			}
+			replicated[i] = slices.ContainsFunc(versions, func(v galleryImageVersion) bool { return v.name == version })
+		}()
+	}
+	wg.Wait()
+
+	for i, region := range cloudProfileRegions {
+		if replicated[i] {
+			regions = append(regions, region.Name)
+		}
+	}
+	return regions
+}
+
+// findUsedGalleryImageID returns the gallery image ID recorded for the machine image in the worker status if it was
+// already deployed and does not need to be checked again. This is the case if it equals the given gallery image ID,
+// or if the given ID references the `latest` version and the recorded one a concrete version of the same image. The
+// parts are the segments of the given gallery image ID. An empty string is returned if no such image is recorded.
+func findUsedGalleryImageID(workerStatus *api.WorkerStatus, machineImage *api.MachineImage, parts []string) string {
+	if workerStatus == nil {
+		return ""
+	}
+	architecture := ptr.Deref(machineImage.Architecture, v1beta1constants.ArchitectureAMD64)
+	usedImage, err := helper.FindMachineImage(workerStatus.MachineImages, machineImage.Name, machineImage.Version, &architecture)
+	if err != nil {
+		return ""
+	}
+	if (usedImage.CommunityGalleryImageID != nil) != (machineImage.CommunityGalleryImageID != nil) {
+		return ""
+	}
+
+	usedGalleryImageID := ptr.Deref(usedImage.CommunityGalleryImageID, ptr.Deref(usedImage.SharedGalleryImageID, ""))
+	if usedGalleryImageID == strings.Join(parts, "/") {
+		return usedGalleryImageID
+	}
+	usedParts := strings.Split(usedGalleryImageID, "/")
+	if strings.EqualFold(parts[6], galleryImageVersionLatest) && len(usedParts) == len(parts) && slices.Equal(usedParts[:6], parts[:6]) {
+		return usedGalleryImageID
+	}
+	return ""
+}
+
+// listGalleryImageVersions returns the versions of a community or shared gallery image which are available in the
+// given region.
+func listGalleryImageVersions(ctx context.Context, client azureclient.GalleryImageVersions, region string, community bool, gallery, image string) ([]galleryImageVersion, error) {
+	var versions []galleryImageVersion
+	if community {
+		galleryImageVersions, err := client.ListCommunityGalleryImageVersions(ctx, region, gallery, image)
+		if err != nil {
+			return nil, err
+		}
+		for _, version := range galleryImageVersions {
+			versions = append(versions, galleryImageVersion{
+				name:              ptr.Deref(version.Name, ""),
+				excludeFromLatest: version.Properties != nil && ptr.Deref(version.Properties.ExcludeFromLatest, false),
+			})
+		}
+		return versions, nil
+	}
+
+	galleryImageVersions, err := client.ListSharedGalleryImageVersions(ctx, region, gallery, image)
+	if err != nil {
+		return nil, err
+	}
+	for _, version := range galleryImageVersions {
+		versions = append(versions, galleryImageVersion{
+			name:              ptr.Deref(version.Name, ""),
+			excludeFromLatest: version.Properties != nil && ptr.Deref(version.Properties.ExcludeFromLatest, false),
+		})
+	}
+	return versions, nil
+}
+
 func appendMachineImage(machineImages []api.MachineImage, machineImage api.MachineImage) []api.MachineImage {
 	if _, err := helper.FindMachineImage(machineImages, machineImage.Name, machineImage.Version, machineImage.Architecture); err != nil {
 		return append(machineImages, machineImage)
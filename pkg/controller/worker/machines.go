@@ -6,21 +6,30 @@ package worker
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	genericworkeractuator "github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,7 +41,23 @@ import (
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
-const azureCSIDiskDriverTopologyKey = "topology.disk.csi.azure.com/zone"
+const (
+	azureCSIDiskDriverTopologyKey = "topology.disk.csi.azure.com/zone"
+
+	// userDataSecretKey is the key of the user data in the secrets referenced by the machine classes.
+	userDataSecretKey = "userData"
+
+	// maxVMTags is the maximum number of tags of a virtual machine in Azure.
+	maxVMTags = 50
+	// maxVMTagNameLength is the maximum length of a tag name in Azure.
+	maxVMTagNameLength = 512
+	// maxVMTagValueLength is the maximum length of a tag value in Azure.
+	maxVMTagValueLength = 256
+
+	// EventReasonVMTagsDropped is the reason of the event which is emitted if tags cannot be added to the virtual
+	// machines of a worker pool.
+	EventReasonVMTagsDropped = "VMTagsDropped"
+)
 
 var tagRegex = regexp.MustCompile(`[<>%\\&?/ ]`)
 
@@ -59,7 +84,41 @@ func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 		}
 	}
 
-	return w.seedChartApplier.ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), w.worker.Namespace, "machineclass", kubernetes.Values(map[string]interface{}{"machineClasses": w.machineClasses}))
+	// The user data secrets are deployed first, so that the machine classes never reference a missing secret.
+	if err := w.deployUserDataSecrets(ctx); err != nil {
+		return err
+	}
+
+	if err := w.seedChartApplier.ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), w.worker.Namespace, "machineclass", kubernetes.Values(map[string]interface{}{"machineClasses": w.machineClasses})); err != nil {
+		return err
+	}
+
+	return w.collectOrphanedMachineClasses(ctx)
+}
+
+// deployUserDataSecrets creates the secrets which contain the user data of the worker pools and which are referenced by
+// their machine classes. The secrets are named after the checksum of their content and are immutable, so that machine
+// classes of earlier reconciliations keep referencing the user data they were created with.
+func (w *workerDelegate) deployUserDataSecrets(ctx context.Context) error {
+	for name, userData := range w.userDataSecrets {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: w.worker.Namespace}}
+		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, w.client, secret, func() error {
+			metav1.SetMetaDataLabel(&secret.ObjectMeta, v1beta1constants.GardenerPurpose, v1beta1constants.GardenPurposeMachineClass)
+			secret.Type = corev1.SecretTypeOpaque
+			secret.Data = map[string][]byte{userDataSecretKey: userData}
+			secret.Immutable = ptr.To(true)
+			return nil
+		}, controllerutils.SkipEmptyPatch{}); err != nil {
+			return fmt.Errorf("failed to deploy user data secret %s: %w", client.ObjectKeyFromObject(secret), err)
+		}
+	}
+
+	return nil
+}
+
+// userDataSecretName returns the name of the secret which contains the given user data of the given worker pool.
+func userDataSecretName(namespace, poolName string, userData []byte) string {
+	return fmt.Sprintf("%s-%s-userdata-%s", namespace, poolName, utils.ComputeSHA256Hex(userData)[:8])
 }
 
 // GenerateMachineDeployments generates the configuration for the desired machine deployments.
@@ -89,9 +148,10 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		machineDeployments        = worker.MachineDeployments{}
 		machineClasses            []map[string]interface{}
 		machineImages             []azureapi.MachineImage
+		userDataSecrets           = map[string][]byte{}
 	)
 
-	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
+	infrastructureStatus, err := w.decodeAzureInfrastructureStatus(ctx)
 	if err != nil {
 		return err
 	}
@@ -108,7 +168,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 	for _, pool := range w.worker.Spec.Pools {
 		// Get the vmo dependency from the worker status if exists.
-		vmoDependency, err := w.determineWorkerPoolVmoDependency(ctx, infrastructureStatus, workerStatus, pool.Name)
+		vmoDependency, err := w.determineWorkerPoolVmoDependency(ctx, infrastructureStatus, workerStatus, pool)
 		if err != nil {
 			return err
 		}
@@ -119,12 +179,21 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		machineImage, err = w.resolveGalleryImageVersion(ctx, workerStatus, machineImage)
+		if err != nil {
+			return err
+		}
+		if err := w.checkMachineImageDiskControllerType(pool, machineImage); err != nil {
+			return err
+		}
 		machineImages = appendMachineImage(machineImages, azureapi.MachineImage{
 			Name:                     pool.MachineImage.Name,
 			Version:                  pool.MachineImage.Version,
 			AcceleratedNetworking:    machineImage.AcceleratedNetworking,
+			NVMe:                     machineImage.NVMe,
 			Architecture:             &arch,
 			SkipMarketplaceAgreement: machineImage.SkipMarketplaceAgreement,
+			MinimumOSDiskSizeGB:      machineImage.MinimumOSDiskSizeGB,
 			Image: azureapi.Image{
 				URN:                     machineImage.URN,
 				ID:                      machineImage.ID,
@@ -154,7 +223,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 		}
 
-		disks, err := computeDisks(pool, workerConfig.DataVolumes)
+		cloudConfiguration := w.poolCloudConfiguration(workerConfig.CloudConfiguration)
+
+		vmTags := w.getVMTags(pool, workerConfig.VMTags)
+
+		disks, err := computeDisks(pool, workerConfig.DataVolumes, machineImage.MinimumOSDiskSizeGB)
 		if err != nil {
 			return err
 		}
@@ -163,6 +236,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		secretName := userDataSecretName(w.worker.Namespace, pool.Name, userData)
+		userDataSecrets[secretName] = userData
 
 		generateMachineClassAndDeployment := func(zone *zoneInfo, machineSet *machineSetInfo, subnetName, workerPoolHash string, workerConfig *azureapi.WorkerConfig) (worker.MachineDeployment, map[string]interface{}) {
 			var (
@@ -171,18 +246,19 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 					Maximum:              pool.Maximum,
 					MaxSurge:             pool.MaxSurge,
 					MaxUnavailable:       pool.MaxUnavailable,
-					Labels:               addTopologyLabel(pool.Labels, w.worker.Spec.Region, zone),
+					Labels:               addLoadBalancerExclusionLabel(addTopologyLabel(pool.Labels, w.worker.Spec.Region, zone), infrastructureStatus, subnetName),
 					Annotations:          pool.Annotations,
 					Taints:               pool.Taints,
-					MachineConfiguration: genericworkeractuator.ReadMachineConfiguration(pool),
+					MachineConfiguration: machineConfiguration(pool, workerConfig),
 				}
 
 				machineClassSpec = utils.MergeMaps(map[string]interface{}{
 					"region":        w.worker.Spec.Region,
 					"resourceGroup": infrastructureStatus.ResourceGroup.Name,
-					"tags":          w.getVMTags(pool),
+					"tags":          vmTags,
 					"secret": map[string]interface{}{
-						"cloudConfig": string(userData),
+						"name":     secretName,
+						"checksum": utils.ComputeSHA256Hex(userData),
 					},
 					"credentialsSecretRef": map[string]interface{}{
 						"name":      w.worker.Spec.SecretRef.Name,
@@ -199,11 +275,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				"subnet": subnetName,
 			}
 
-			cloudConfiguration, err := azureclient.CloudConfiguration(nil, &w.worker.Spec.Region)
-			if err == nil {
-				machineClassSpec["cloudConfiguration"] = map[string]interface{}{
-					"name": cloudConfiguration.Name,
-				}
+			if cloudConfiguration != nil {
+				machineClassSpec["cloudConfiguration"] = machineClassCloudConfiguration(cloudConfiguration)
 			}
 
 			if infrastructureStatus.Networks.VNet.ResourceGroup != nil {
@@ -213,6 +286,9 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				networkConfig["acceleratedNetworking"] = true
 			}
 			machineClassSpec["network"] = networkConfig
+			if diskControllerType := w.machineTypeDiskControllerType(pool.MachineType); diskControllerType != nil {
+				machineClassSpec["diskControllerType"] = string(*diskControllerType)
+			}
 
 			if zone != nil {
 				machineDeployment.Minimum = worker.DistributeOverZones(zone.index, pool.Minimum, zone.count)
@@ -222,12 +298,19 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				machineClassSpec["zone"] = zone.name
 			}
 
+			if workerConfig.WarmPool != nil {
+				applyWarmPool(&machineDeployment, workerConfig.WarmPool, zone)
+			}
+
 			if workerConfig.DiagnosticsProfile != nil {
 				diagnosticProfile := map[string]interface{}{
 					"enabled": workerConfig.DiagnosticsProfile.Enabled,
 				}
 				if workerConfig.DiagnosticsProfile.StorageURI != nil {
 					diagnosticProfile["storageURI"] = workerConfig.DiagnosticsProfile.StorageURI
+				} else if workerConfig.DiagnosticsProfile.Enabled && infrastructureStatus.BootDiagnostics != nil {
+					// fall back to the storage account created by the infrastructure controller
+					diagnosticProfile["storageURI"] = &infrastructureStatus.BootDiagnostics.StorageURI
 				}
 				machineClassSpec["diagnosticsProfile"] = diagnosticProfile
 			}
@@ -243,22 +326,22 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 					zoneName = w.worker.Spec.Region + "-" + zone.name
 				}
 
+				capacity := pool.NodeTemplate.Capacity
 				if workerConfig.NodeTemplate != nil {
-					machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-						Capacity:     workerConfig.NodeTemplate.Capacity,
-						InstanceType: pool.MachineType,
-						Region:       w.worker.Spec.Region,
-						Zone:         zoneName,
-						Architecture: &arch,
-					}
-				} else if pool.NodeTemplate != nil {
-					machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-						Capacity:     pool.NodeTemplate.Capacity,
-						InstanceType: pool.MachineType,
-						Region:       w.worker.Spec.Region,
-						Zone:         zoneName,
-						Architecture: &arch,
-					}
+					capacity = workerConfig.NodeTemplate.Capacity
+				}
+
+				capacity = w.nodeTemplateCapacity(pool.MachineType, capacity)
+				if localDisksEphemeralStorage(workerConfig) && (workerConfig.NodeTemplate == nil || workerConfig.NodeTemplate.Capacity.StorageEphemeral().IsZero()) {
+					capacity = w.localDisksEphemeralStorageCapacity(pool.MachineType, capacity)
+				}
+
+				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
+					Capacity:     capacity,
+					InstanceType: pool.MachineType,
+					Region:       w.worker.Spec.Region,
+					Zone:         zoneName,
+					Architecture: &arch,
 				}
 			}
 
@@ -285,7 +368,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 
 			machineDeployment.Name = deploymentName
 			machineDeployment.ClassName = className
-			machineDeployment.SecretName = className
+			machineDeployment.SecretName = secretName
 
 			machineClassSpec["name"] = className
 			machineClassSpec["labels"] = map[string]string{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass}
@@ -308,6 +391,14 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 
 			machineDeployment.ClusterAutoscalerAnnotations = extensionsv1alpha1helper.GetMachineDeploymentClusterAutoscalerAnnotations(pool.ClusterAutoscaler)
+			if workerConfig.WarmPool != nil && workerConfig.WarmPool.MaxAge != nil {
+				if machineDeployment.ClusterAutoscalerAnnotations == nil {
+					machineDeployment.ClusterAutoscalerAnnotations = map[string]string{}
+				}
+				if _, ok := machineDeployment.ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnneededTimeAnnotation]; !ok {
+					machineDeployment.ClusterAutoscalerAnnotations[extensionsv1alpha1.ScaleDownUnneededTimeAnnotation] = workerConfig.WarmPool.MaxAge.Duration.String()
+				}
+			}
 
 			return machineDeployment, machineClassSpec
 		}
@@ -349,10 +440,51 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		}
 
 		// Availability Zones
+		if workerConfig.NodesSubnet != nil && infrastructureStatus.Networks.Layout != azureapi.NetworkLayoutMultipleSubnet {
+			return fmt.Errorf("worker pool %q can only be pinned to nodes subnet %q in the %s network layout", pool.Name, *workerConfig.NodesSubnet, azureapi.NetworkLayoutMultipleSubnet)
+		}
+		// Worker pools of a shoot which was converted to a zoned shoot are rolled out zone by zone. The next zone is only
+		// added once the machine deployments of the previous zones are available. The former non-zoned machine deployment
+		// is kept with the share of the zones which are not rolled out yet until all zones are available.
+		zones := pool.Zones
+		if vmoDependency := zonalMigrationVmoDependency(infrastructureStatus, workerStatus, pool.Name); vmoDependency != nil {
+			availableZones, err := w.availableZonalMachineDeployments(ctx, pool)
+			if err != nil {
+				return err
+			}
+			if availableZones < len(pool.Zones) {
+				vmoSubnet, err := zonalMigrationNodesSubnet(infrastructureStatus.Networks.Subnets)
+				if err != nil {
+					return err
+				}
+				vmoWorkerPoolHash, err := w.generateWorkerPoolHash(pool, workerConfig, infrastructureStatus, vmoDependency, nil)
+				if err != nil {
+					return err
+				}
+				machineDeployment, machineClassSpec := generateMachineClassAndDeployment(nil, &machineSetInfo{
+					id:   vmoDependency.ID,
+					kind: "vmo",
+				}, vmoSubnet.Name, vmoWorkerPoolHash, &workerConfig)
+				machineDeployment.Minimum, machineDeployment.Maximum = 0, 0
+				for zoneIndex := availableZones; zoneIndex < len(pool.Zones); zoneIndex++ {
+					machineDeployment.Minimum += worker.DistributeOverZones(int32(zoneIndex), pool.Minimum, int32(len(pool.Zones))) // #nosec: G115 - We validate if pool zones exceeds max_int32.
+					machineDeployment.Maximum += worker.DistributeOverZones(int32(zoneIndex), pool.Maximum, int32(len(pool.Zones))) // #nosec: G115 - We validate if pool zones exceeds max_int32.
+				}
+				machineDeployments = append(machineDeployments, machineDeployment)
+				machineClasses = append(machineClasses, machineClassSpec)
+				zones = pool.Zones[:availableZones+1]
+			}
+		}
+
 		zoneCount := len(pool.Zones)
-		for zoneIndex, zone := range pool.Zones {
+		for zoneIndex, zone := range zones {
 			if infrastructureStatus.Networks.Layout == azureapi.NetworkLayoutMultipleSubnet {
-				_, nodesSubnet, err = azureapihelper.FindSubnetByPurposeAndZone(infrastructureStatus.Networks.Subnets, azureapi.PurposeNodes, &zone)
+				if workerConfig.NodesSubnet != nil {
+					// the machines of all zones are placed in the nodes subnet the worker pool is pinned to.
+					nodesSubnet, err = azureapihelper.FindSubnetByPurposeAndName(infrastructureStatus.Networks.Subnets, azureapi.PurposeNodes, *workerConfig.NodesSubnet)
+				} else {
+					_, nodesSubnet, err = azureapihelper.FindSubnetByPurposeAndZone(infrastructureStatus.Networks.Subnets, azureapi.PurposeNodes, &zone)
+				}
 				if err != nil {
 					return err
 				}
@@ -382,39 +514,238 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	w.machineDeployments = machineDeployments
 	w.machineClasses = machineClasses
 	w.machineImages = machineImages
+	w.userDataSecrets = userDataSecrets
 
 	return nil
 }
 
 // isMachineTypeSupportingAcceleratedNetworking checks if the passed machine type is supporting Azure accelerated networking.
+// Machine types using the NVMe disk controller (Azure Boost) always support accelerated networking.
 func (w *workerDelegate) isMachineTypeSupportingAcceleratedNetworking(machineTypeName string) bool {
 	for _, machType := range w.cloudProfileConfig.MachineTypes {
-		if machType.Name == machineTypeName && machType.AcceleratedNetworking != nil && *machType.AcceleratedNetworking {
+		if machType.Name != machineTypeName {
+			continue
+		}
+		if ptr.Deref(machType.AcceleratedNetworking, false) || ptr.Deref(machType.DiskControllerType, "") == azureapi.DiskControllerTypeNVMe {
 			return true
 		}
 	}
 	return false
 }
 
-// getVMTags returns a map of vm tags
-func (w *workerDelegate) getVMTags(pool extensionsv1alpha1.WorkerPool) map[string]string {
-	vmTags := map[string]string{
+// machineTypeDiskControllerType returns the disk controller type configured for the passed machine type in the cloud profile.
+func (w *workerDelegate) machineTypeDiskControllerType(machineTypeName string) *azureapi.DiskControllerType {
+	for _, machType := range w.cloudProfileConfig.MachineTypes {
+		if machType.Name == machineTypeName {
+			return machType.DiskControllerType
+		}
+	}
+	return nil
+}
+
+// nodeTemplateCapacity returns the capacity of the node template for the passed machine type. The additional capacity
+// of the machine type in the cloud profile, e.g. extended resources like GPUs, is added to the passed capacity unless
+// it already contains the resource.
+func (w *workerDelegate) nodeTemplateCapacity(machineTypeName string, capacity corev1.ResourceList) corev1.ResourceList {
+	for _, machType := range w.cloudProfileConfig.MachineTypes {
+		if machType.Name != machineTypeName || len(machType.Capacity) == 0 {
+			continue
+		}
+
+		result := capacity.DeepCopy()
+		if result == nil {
+			result = corev1.ResourceList{}
+		}
+		for name, quantity := range machType.Capacity {
+			if _, ok := result[name]; !ok {
+				result[name] = quantity.DeepCopy()
+			}
+		}
+		return result
+	}
+	return capacity
+}
+
+// localDisksEphemeralStorageCapacity returns the passed capacity of the node template with the ephemeral storage of the
+// machine type in the cloud profile, which is provided by the local disks of the machines instead of the OS disk.
+func (w *workerDelegate) localDisksEphemeralStorageCapacity(machineTypeName string, capacity corev1.ResourceList) corev1.ResourceList {
+	for _, machType := range w.cloudProfileConfig.MachineTypes {
+		if machType.Name != machineTypeName {
+			continue
+		}
+
+		ephemeralStorage, ok := machType.Capacity[corev1.ResourceEphemeralStorage]
+		if !ok {
+			return capacity
+		}
+		result := capacity.DeepCopy()
+		if result == nil {
+			result = corev1.ResourceList{}
+		}
+		result[corev1.ResourceEphemeralStorage] = ephemeralStorage.DeepCopy()
+		return result
+	}
+	return capacity
+}
+
+// localDisksEphemeralStorage checks whether the local disks of the machines provide the ephemeral storage.
+func localDisksEphemeralStorage(workerConfig *api.WorkerConfig) bool {
+	return workerConfig.LocalDisks != nil && workerConfig.LocalDisks.EphemeralStorage
+}
+
+// checkMachineImageDiskControllerType verifies that the passed machine image supports the disk controller type of the
+// machine type of the passed worker pool, as the creation of the machines would otherwise fail.
+func (w *workerDelegate) checkMachineImageDiskControllerType(pool extensionsv1alpha1.WorkerPool, machineImage *azureapi.MachineImage) error {
+	if ptr.Deref(w.machineTypeDiskControllerType(pool.MachineType), "") != azureapi.DiskControllerTypeNVMe || ptr.Deref(machineImage.NVMe, false) {
+		return nil
+	}
+	return v1beta1helper.NewErrorWithCodes(fmt.Errorf("machine type %q of worker pool %q requires the NVMe disk controller, but machine image %q in version %q does not support NVMe",
+		pool.MachineType, pool.Name, machineImage.Name, machineImage.Version), gardencorev1beta1.ErrorConfigurationProblem)
+}
+
+// getVMTags returns a map of vm tags. The infrastructure tags, the labels of the worker pool and, if configured, the
+// labels of the shoot are merged according to the merge policy. Tags which cannot be added to the virtual machines are
+// reported via an event on the worker.
+func (w *workerDelegate) getVMTags(pool extensionsv1alpha1.WorkerPool, vmTagsConfig *azureapi.VMTagsConfig) map[string]string {
+	infrastructureTags := map[string]string{
 		"Name": w.worker.Namespace,
 		SanitizeAzureVMTag(fmt.Sprintf("kubernetes.io-cluster-%s", w.worker.Namespace)): "1",
 		SanitizeAzureVMTag("kubernetes.io-role-node"):                                   "1",
 	}
-	for k, v := range pool.Labels {
-		vmTags[SanitizeAzureVMTag(k)] = v
+
+	var (
+		mergePolicy = azureapi.VMTagMergePolicyPoolLabels
+		shootLabels map[string]string
+	)
+	if vmTagsConfig != nil {
+		if vmTagsConfig.MergePolicy != nil {
+			mergePolicy = *vmTagsConfig.MergePolicy
+		}
+		if ptr.Deref(vmTagsConfig.IncludeShootLabels, false) && w.cluster != nil && w.cluster.Shoot != nil {
+			shootLabels = w.cluster.Shoot.Labels
+		}
+	}
+
+	var (
+		infrastructure = vmTagSource{kind: "infrastructure tag", tags: infrastructureTags}
+		poolLabels     = vmTagSource{kind: "pool label", tags: pool.Labels, sanitize: true}
+		shoot          = vmTagSource{kind: "shoot label", tags: shootLabels, sanitize: true}
+		sources        []vmTagSource
+	)
+	switch mergePolicy {
+	case azureapi.VMTagMergePolicyShootLabels:
+		sources = []vmTagSource{shoot, poolLabels, infrastructure}
+	case azureapi.VMTagMergePolicyInfrastructureTags:
+		sources = []vmTagSource{infrastructure, poolLabels, shoot}
+	default:
+		sources = []vmTagSource{poolLabels, shoot, infrastructure}
+	}
+
+	// The mandatory tags of the landscape take precedence over all other sources and are kept like the infrastructure
+	// tags if the tag limit is exceeded.
+	reserved := infrastructureTags
+	if len(w.mandatoryVMTags) > 0 {
+		sources = append([]vmTagSource{{kind: "mandatory tag", tags: w.mandatoryVMTags}}, sources...)
+		reserved = utils.MergeStringMaps(infrastructureTags, w.mandatoryVMTags)
+	}
+
+	vmTags, dropped := mergeVMTags(sources, reserved)
+	if len(dropped) > 0 {
+		w.recorder.Eventf(w.worker, corev1.EventTypeWarning, EventReasonVMTagsDropped, "Dropped tags of the virtual machines of worker pool %q: %s", pool.Name, strings.Join(dropped, ", "))
 	}
 	return vmTags
 }
 
-func computeDisks(pool extensionsv1alpha1.WorkerPool, dataVolumesConfig []azureapi.DataVolume) (map[string]interface{}, error) {
+type vmTagSource struct {
+	kind     string
+	tags     map[string]string
+	sanitize bool
+}
+
+// mergeVMTags merges the tags of the given sources, which are ordered by their precedence. Tag names are compared
+// case-insensitively like in Azure. If the tag limit is exceeded, the reserved tags are kept in any case and the other
+// tags are kept in the order of the precedence of their sources. The second return value describes the dropped tags.
+func mergeVMTags(sources []vmTagSource, reserved map[string]string) (map[string]string, []string) {
+	type vmTag struct {
+		name, key, kind string
+	}
+
+	var (
+		vmTags  = map[string]string{}
+		names   = sets.New[string]()
+		merged  []vmTag
+		dropped []string
+	)
+
+	for _, source := range sources {
+		// Iterate in a stable order, so that the same tags are dropped with every reconciliation.
+		for _, key := range sets.List(sets.KeySet(source.tags)) {
+			name := key
+			if source.sanitize {
+				name = SanitizeAzureVMTag(key)
+			}
+			name = truncateVMTag(name, maxVMTagNameLength)
+
+			if names.Has(strings.ToLower(name)) {
+				dropped = append(dropped, fmt.Sprintf("%s %q (name conflict)", source.kind, key))
+				continue
+			}
+			names.Insert(strings.ToLower(name))
+			vmTags[name] = truncateVMTag(source.tags[key], maxVMTagValueLength)
+			merged = append(merged, vmTag{name: name, key: key, kind: source.kind})
+		}
+	}
+
+	if len(merged) <= maxVMTags {
+		return vmTags, dropped
+	}
+
+	reservedNames := sets.New[string]()
+	for name := range reserved {
+		reservedNames.Insert(strings.ToLower(name))
+	}
+	slices.SortStableFunc(merged, func(a, b vmTag) int {
+		aReserved, bReserved := reservedNames.Has(strings.ToLower(a.name)), reservedNames.Has(strings.ToLower(b.name))
+		switch {
+		case aReserved && !bReserved:
+			return -1
+		case !aReserved && bReserved:
+			return 1
+		default:
+			return 0
+		}
+	})
+	for _, tag := range merged[maxVMTags:] {
+		delete(vmTags, tag.name)
+		dropped = append(dropped, fmt.Sprintf("%s %q (tag limit)", tag.kind, tag.key))
+	}
+
+	return vmTags, dropped
+}
+
+// truncateVMTag truncates the given tag name or value to the given maximum length. A hash of the original string is
+// appended to truncated strings, so that they remain distinguishable.
+func truncateVMTag(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+
+	hash := sha256.Sum256([]byte(s))
+	suffix := hex.EncodeToString(hash[:])[:8]
+	return string(runes[:maxLength-len(suffix)-1]) + "-" + suffix
+}
+
+func computeDisks(pool extensionsv1alpha1.WorkerPool, dataVolumesConfig []azureapi.DataVolume, minimumOSDiskSizeGB *int32) (map[string]interface{}, error) {
 	// handle root disk
 	volumeSize, err := worker.DiskSize(pool.Volume.Size)
 	if err != nil {
 		return nil, err
 	}
+	// images may require a larger OS disk than configured for the pool, e.g. because of preloaded GPU drivers.
+	if minimumOSDiskSizeGB != nil {
+		volumeSize = max(volumeSize, int(*minimumOSDiskSizeGB))
+	}
 	osDisk := map[string]interface{}{
 		"size": volumeSize,
 	}
@@ -453,6 +784,12 @@ func computeDisks(pool extensionsv1alpha1.WorkerPool, dataVolumesConfig []azurea
 			}
 			if volume.Type != nil {
 				disk["storageAccountType"] = *volume.Type
+				// Ultra disks can only be attached to VMs which have the ultra SSD capability enabled.
+				if *volume.Type == string(armcompute.DiskStorageAccountTypesUltraSSDLRS) {
+					disks["additionalCapabilities"] = map[string]interface{}{
+						"ultraSSDEnabled": true,
+					}
+				}
 			}
 			applyWorkerConfig(volume.Name, disk, dataVolumesConfig)
 			dataDisks = append(dataDisks, disk)
@@ -466,8 +803,21 @@ func computeDisks(pool extensionsv1alpha1.WorkerPool, dataVolumesConfig []azurea
 
 func applyWorkerConfig(diskName string, dataDisk map[string]interface{}, dataVolumeConfigs []azureapi.DataVolume) {
 	for _, config := range dataVolumeConfigs {
-		imageRef := config.ImageRef
-		if imageRef != nil && config.Name == diskName {
+		if config.Name != diskName {
+			continue
+		}
+
+		if config.IOPS != nil {
+			dataDisk["diskIOPSReadWrite"] = *config.IOPS
+		}
+		if config.Throughput != nil {
+			dataDisk["diskMBpsReadWrite"] = *config.Throughput
+		}
+		if config.SourceResourceID != nil {
+			dataDisk["sourceResourceID"] = *config.SourceResourceID
+		}
+
+		if imageRef := config.ImageRef; imageRef != nil {
 			if imageRef.URN != nil {
 				dataDisk["imageRef"] = map[string]interface{}{"urn": *imageRef.URN}
 			} else if imageRef.CommunityGalleryImageID != nil {
@@ -487,6 +837,72 @@ func SanitizeAzureVMTag(label string) string {
 	return tagRegex.ReplaceAllString(strings.ToLower(label), "_")
 }
 
+// applyWarmPool raises the minimum of the machine deployment by the (zone share of the) warm pool. The cluster-autoscaler
+// never scales a machine deployment below its minimum, hence the standby nodes are guarded against scale-down.
+func applyWarmPool(machineDeployment *worker.MachineDeployment, warmPool *azureapi.WarmPool, zone *zoneInfo) {
+	count := warmPool.Count
+	if zone != nil {
+		count = worker.DistributeOverZones(zone.index, warmPool.Count, zone.count)
+	}
+	machineDeployment.Minimum = min(machineDeployment.Minimum+count, machineDeployment.Maximum)
+}
+
+// defaultNodeConditions are the node conditions which let the machine-controller-manager declare a machine as failed if
+// the worker pool does not configure them explicitly.
+var defaultNodeConditions = []string{"KernelDeadlock", "ReadonlyFilesystem", "DiskPressure", "NetworkUnavailable"}
+
+// machineConfiguration returns the machine-controller-manager settings of the worker pool. If the handling of
+// Scheduled Events is enabled, the condition set by the scheduled events agent is added to the node conditions, so that
+// machines with a pending redeploy, preemption or termination are drained and replaced.
+func machineConfiguration(pool extensionsv1alpha1.WorkerPool, workerConfig *azureapi.WorkerConfig) *machinev1alpha1.MachineConfiguration {
+	machineConfiguration := genericworkeractuator.ReadMachineConfiguration(pool)
+	if workerConfig.ScheduledEvents == nil || !workerConfig.ScheduledEvents.Enabled {
+		return machineConfiguration
+	}
+
+	nodeConditions := defaultNodeConditions
+	if pool.MachineControllerManagerSettings != nil && len(pool.MachineControllerManagerSettings.NodeConditions) > 0 {
+		nodeConditions = pool.MachineControllerManagerSettings.NodeConditions
+	}
+	if !slices.Contains(nodeConditions, azure.NodeConditionScheduledEvent) {
+		nodeConditions = append(slices.Clone(nodeConditions), azure.NodeConditionScheduledEvent)
+	}
+	machineConfiguration.NodeConditions = ptr.To(strings.Join(nodeConditions, ","))
+	return machineConfiguration
+}
+
+// bypassesWorkerSecurityGroup checks whether the given subnet is associated with a security group other than the one of
+// the worker nodes, e.g. a centrally managed security group of a zone.
+func bypassesWorkerSecurityGroup(infrastructureStatus *azureapi.InfrastructureStatus, subnetName string) bool {
+	var workerSecurityGroupID string
+	for _, securityGroup := range infrastructureStatus.SecurityGroups {
+		if securityGroup.Purpose == azureapi.PurposeNodes && securityGroup.Managed {
+			workerSecurityGroupID = securityGroup.ID
+			break
+		}
+	}
+	if workerSecurityGroupID == "" {
+		return false
+	}
+
+	for _, subnet := range infrastructureStatus.Networks.Subnets {
+		if subnet.Name == subnetName && subnet.SecurityGroupID != nil {
+			return !strings.EqualFold(*subnet.SecurityGroupID, workerSecurityGroupID)
+		}
+	}
+	return false
+}
+
+// addLoadBalancerExclusionLabel excludes the nodes of a subnet from the backend pools of load balancers if the subnet
+// bypasses the worker security group, as the cloud-controller-manager only maintains the rules for load balancer services
+// in the worker security group, hence traffic to these nodes would be subject to rules it does not control.
+func addLoadBalancerExclusionLabel(labels map[string]string, infrastructureStatus *azureapi.InfrastructureStatus, subnetName string) map[string]string {
+	if bypassesWorkerSecurityGroup(infrastructureStatus, subnetName) {
+		return utils.MergeStringMaps(labels, map[string]string{corev1.LabelNodeExcludeBalancers: "true"})
+	}
+	return labels
+}
+
 func addTopologyLabel(labels map[string]string, region string, zone *zoneInfo) map[string]string {
 	if zone != nil {
 		return utils.MergeStringMaps(labels, map[string]string{azureCSIDiskDriverTopologyKey: region + "-" + zone.name})
@@ -528,6 +944,11 @@ func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPo
 		return "", err
 	}
 
+	// Machines need to be rolled when the disk controller type of the machine type has been changed.
+	if diskControllerType := w.machineTypeDiskControllerType(pool.MachineType); diskControllerType != nil {
+		additionalHashDataV2 = append(additionalHashDataV2, string(*diskControllerType))
+	}
+
 	return worker.WorkerPoolHash(pool, w.cluster, additionalHashData, additionalHashDataV2)
 }
 
@@ -540,6 +961,21 @@ func (w workerDelegate) workerPoolHashDataV2(workerConfig api.WorkerConfig, addi
 		hashData = append(hashData, *workerConfig.DiagnosticsProfile.StorageURI)
 	}
 
+	// Machines need to be rolled to apply changed performance settings of data volumes.
+	for _, dataVolume := range workerConfig.DataVolumes {
+		if dataVolume.IOPS != nil {
+			hashData = append(hashData, fmt.Sprintf("%s-iops-%d", dataVolume.Name, *dataVolume.IOPS))
+		}
+		if dataVolume.Throughput != nil {
+			hashData = append(hashData, fmt.Sprintf("%s-throughput-%d", dataVolume.Name, *dataVolume.Throughput))
+		}
+	}
+
+	// Machines need to be rolled as the local disks are only mounted on boot.
+	if localDisksEphemeralStorage(&workerConfig) {
+		hashData = append(hashData, "local-disks-ephemeral-storage")
+	}
+
 	return hashData, nil
 }
 
@@ -552,3 +988,38 @@ func isConfidentialVM(pool extensionsv1alpha1.WorkerPool) bool {
 	}
 	return false
 }
+
+// poolCloudConfiguration returns the cloud configuration for the machines of a worker pool. The cloud configuration of
+// the pool takes precedence over the one of the CloudProfile resp. the one derived from the region. That it matches the
+// cloud instance of the shoot's credentials is validated on admission of the shoot.
+func (w *workerDelegate) poolCloudConfiguration(poolCloudConfiguration *api.CloudConfiguration) *api.CloudConfiguration {
+	if poolCloudConfiguration != nil {
+		return poolCloudConfiguration
+	}
+	cloudConfiguration, err := azureclient.CloudConfiguration(w.cloudProfileConfig.CloudConfiguration, &w.worker.Spec.Region)
+	if err != nil {
+		return nil
+	}
+	return cloudConfiguration
+}
+
+// machineClassCloudConfiguration returns the cloud configuration of the machine class. The endpoints are only set for
+// private clouds, well-known clouds are identified by their name.
+func machineClassCloudConfiguration(cloudConfiguration *api.CloudConfiguration) map[string]interface{} {
+	machineClassCloudConfiguration := map[string]interface{}{
+		"name": cloudConfiguration.Name,
+	}
+	if cloudConfiguration.ActiveDirectoryAuthorityHost != nil {
+		machineClassCloudConfiguration["activeDirectoryAuthorityHost"] = *cloudConfiguration.ActiveDirectoryAuthorityHost
+	}
+	if cloudConfiguration.ResourceManagerEndpoint != nil {
+		machineClassCloudConfiguration["resourceManagerEndpoint"] = *cloudConfiguration.ResourceManagerEndpoint
+	}
+	if cloudConfiguration.ResourceManagerAudience != nil {
+		machineClassCloudConfiguration["resourceManagerAudience"] = *cloudConfiguration.ResourceManagerAudience
+	}
+	if cloudConfiguration.StorageEndpointSuffix != nil {
+		machineClassCloudConfiguration["storageEndpointSuffix"] = *cloudConfiguration.StorageEndpointSuffix
+	}
+	return machineClassCloudConfiguration
+}
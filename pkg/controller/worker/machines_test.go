@@ -6,16 +6,19 @@ package worker_test
 
 import (
 	"context"
+	"embed"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
@@ -26,10 +29,12 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,6 +42,7 @@ import (
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	factorymock "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
 )
 
@@ -49,6 +55,9 @@ var _ = Describe("Machines", func() {
 		statusWriter *mockclient.MockStatusWriter
 		chartApplier *mockkubernetes.MockChartApplier
 
+		factory              *factorymock.MockFactory
+		galleryImageVersions *factorymock.MockGalleryImageVersions
+
 		namespace, region string
 	)
 
@@ -64,6 +73,13 @@ var _ = Describe("Machines", func() {
 
 		namespace = "shoot--foobar--azure"
 		region = "westeurope"
+
+		// All gallery images used by the tests are replicated to the region of the workers.
+		factory = factorymock.NewMockFactory(ctrl)
+		galleryImageVersions = factorymock.NewMockGalleryImageVersions(ctrl)
+		factory.EXPECT().GalleryImageVersions().Return(galleryImageVersions, nil).AnyTimes()
+		galleryImageVersions.EXPECT().ListCommunityGalleryImageVersions(gomock.Any(), region, gomock.Any(), gomock.Any()).Return([]*armcompute.CommunityGalleryImageVersion{{Name: ptr.To("123")}}, nil).AnyTimes()
+		galleryImageVersions.EXPECT().ListSharedGalleryImageVersions(gomock.Any(), region, gomock.Any(), gomock.Any()).Return([]*armcompute.SharedGalleryImageVersion{{Name: ptr.To("123")}}, nil).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -97,6 +113,9 @@ var _ = Describe("Machines", func() {
 				userDataSecretDataKey string
 				sshKey                string
 
+				deployedUserDataSecrets map[string]*corev1.Secret
+				userDataSecretPatches   int
+
 				volumeSize      int
 				volumeType      string
 				dataVolume1Name string
@@ -433,8 +452,40 @@ var _ = Describe("Machines", func() {
 				cluster = makeCluster(shootVersion, region, machineTypes, machineImages, 0)
 				infrastructureStatus = makeInfrastructureStatus(resourceGroupName, vnetName, subnetName, false, &vnetResourceGroupName, &availabilitySetID, &identityID)
 				w = makeWorker(namespace, region, &sshKey, infrastructureStatus, pool1, pool2, pool3, pool4)
+
+				deployedUserDataSecrets = map[string]*corev1.Secret{}
+				userDataSecretPatches = 0
+				isUserDataSecretKey := gomock.Cond(func(key client.ObjectKey) bool {
+					return key.Namespace == namespace && strings.Contains(key.Name, "-userdata-")
+				})
+				c.EXPECT().Get(ctx, isUserDataSecretKey, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+					func(_ context.Context, key client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+						if deployed, ok := deployedUserDataSecrets[key.Name]; ok {
+							deployed.DeepCopyInto(secret)
+							return nil
+						}
+						return apierrors.NewNotFound(corev1.Resource("secrets"), key.Name)
+					},
+				).AnyTimes()
+				c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+					func(_ context.Context, secret *corev1.Secret, _ ...client.CreateOption) error {
+						deployedUserDataSecrets[secret.Name] = secret.DeepCopy()
+						return nil
+					},
+				).AnyTimes()
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&corev1.Secret{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, secret *corev1.Secret, _ client.Patch, _ ...client.PatchOption) error {
+						userDataSecretPatches++
+						deployedUserDataSecrets[secret.Name] = secret.DeepCopy()
+						return nil
+					},
+				).AnyTimes()
 			})
 
+			userDataSecretNameOf := func(poolName string) string {
+				return fmt.Sprintf("%s-%s-userdata-%s", namespace, poolName, utils.ComputeSHA256Hex(userData)[:8])
+			}
+
 			expectedUserDataSecretRefRead := func() {
 				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: userDataSecretName}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
 					func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
@@ -444,6 +495,29 @@ var _ = Describe("Machines", func() {
 				).AnyTimes()
 			}
 
+			expectMachineClassGarbageCollectionListing := func(machineClasses []machinev1alpha1.MachineClass, secrets []corev1.Secret, machines []machinev1alpha1.Machine) {
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{}), client.InNamespace(namespace))
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineSetList{}), client.InNamespace(namespace))
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace)).DoAndReturn(
+					func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+						list.Items = machines
+						return nil
+					},
+				)
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineClassList{}), client.InNamespace(namespace)).DoAndReturn(
+					func(_ context.Context, list *machinev1alpha1.MachineClassList, _ ...client.ListOption) error {
+						list.Items = machineClasses
+						return nil
+					},
+				)
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&corev1.SecretList{}), client.InNamespace(namespace), client.MatchingLabels{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass}).DoAndReturn(
+					func(_ context.Context, list *corev1.SecretList, _ ...client.ListOption) error {
+						list.Items = secrets
+						return nil
+					},
+				)
+			}
+
 			Describe("machine images", func() {
 				var (
 					urnMachineClass                     map[string]interface{}
@@ -483,10 +557,7 @@ var _ = Describe("Machines", func() {
 							"id":   availabilitySetID,
 							"kind": "availabilityset",
 						},
-						"tags": vmTags,
-						"secret": map[string]interface{}{
-							"cloudConfig": string(userData),
-						},
+						"tags":        vmTags,
 						"machineType": machineType,
 						"osDisk": map[string]interface{}{
 							"size": volumeSize,
@@ -543,10 +614,10 @@ var _ = Describe("Machines", func() {
 						machineClassWithHashPool4 = fmt.Sprintf("%s-%s", machineClassNamePool4, workerPoolHash4)
 					)
 
-					addNameAndSecretsToMachineClass(machineClassPool1, machineClassWithHashPool1, w.Spec.SecretRef)
-					addNameAndSecretsToMachineClass(machineClassPool2, machineClassWithHashPool2, w.Spec.SecretRef)
-					addNameAndSecretsToMachineClass(machineClassPool3, machineClassWithHashPool3, w.Spec.SecretRef)
-					addNameAndSecretsToMachineClass(machineClassPool4, machineClassWithHashPool4, w.Spec.SecretRef)
+					addNameAndSecretsToMachineClass(machineClassPool1, machineClassWithHashPool1, userDataSecretNameOf(namePool1), userData, w.Spec.SecretRef)
+					addNameAndSecretsToMachineClass(machineClassPool2, machineClassWithHashPool2, userDataSecretNameOf(namePool2), userData, w.Spec.SecretRef)
+					addNameAndSecretsToMachineClass(machineClassPool3, machineClassWithHashPool3, userDataSecretNameOf(namePool3), userData, w.Spec.SecretRef)
+					addNameAndSecretsToMachineClass(machineClassPool4, machineClassWithHashPool4, userDataSecretNameOf(namePool4), userData, w.Spec.SecretRef)
 
 					machineClassPool1["nodeTemplate"] = nodeTemplateZone1
 					machineClassPool2["nodeTemplate"] = nodeTemplateZone2
@@ -614,7 +685,7 @@ var _ = Describe("Machines", func() {
 						{
 							Name:                 machineClassNamePool1,
 							ClassName:            machineClassWithHashPool1,
-							SecretName:           machineClassWithHashPool1,
+							SecretName:           userDataSecretNameOf(namePool1),
 							Minimum:              minPool1,
 							Maximum:              maxPool1,
 							MaxSurge:             maxSurgePool1,
@@ -625,7 +696,7 @@ var _ = Describe("Machines", func() {
 						{
 							Name:                 machineClassNamePool2,
 							ClassName:            machineClassWithHashPool2,
-							SecretName:           machineClassWithHashPool2,
+							SecretName:           userDataSecretNameOf(namePool2),
 							Minimum:              minPool2,
 							Maximum:              maxPool2,
 							MaxSurge:             maxSurgePool2,
@@ -636,7 +707,7 @@ var _ = Describe("Machines", func() {
 						{
 							Name:                 machineClassNamePool3,
 							ClassName:            machineClassWithHashPool3,
-							SecretName:           machineClassWithHashPool3,
+							SecretName:           userDataSecretNameOf(namePool3),
 							Minimum:              minPool3,
 							Maximum:              maxPool3,
 							MaxSurge:             maxSurgePool3,
@@ -647,7 +718,7 @@ var _ = Describe("Machines", func() {
 						{
 							Name:                 machineClassNamePool4,
 							ClassName:            machineClassWithHashPool4,
-							SecretName:           machineClassWithHashPool4,
+							SecretName:           userDataSecretNameOf(namePool4),
 							Minimum:              minPool4,
 							Maximum:              maxPool4,
 							MaxSurge:             maxSurgePool4,
@@ -659,7 +730,7 @@ var _ = Describe("Machines", func() {
 				})
 
 				It("should return the expected machine deployments for profile image types", func() {
-					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 					expectedUserDataSecretRefRead()
 
@@ -673,6 +744,7 @@ var _ = Describe("Machines", func() {
 							"machineclass",
 							kubernetes.Values(machineClasses),
 						)
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
 
 					// Test workerDelegate.DeployMachineClasses()
 					err := workerDelegate.DeployMachineClasses(ctx)
@@ -689,6 +761,182 @@ var _ = Describe("Machines", func() {
 					Expect(result).To(Equal(machineDeployments))
 				})
 
+				It("should resolve the latest version of gallery images", func() {
+					machineImages[0].Versions[2].CommunityGalleryImageID = ptr.To("/CommunityGalleries/gallery/Images/image/Versions/latest")
+					cluster = makeCluster(shootVersion, region, machineTypes, machineImages, 0)
+
+					factory := factorymock.NewMockFactory(ctrl)
+					galleryImageVersions := factorymock.NewMockGalleryImageVersions(ctrl)
+					factory.EXPECT().GalleryImageVersions().Return(galleryImageVersions, nil).AnyTimes()
+					galleryImageVersions.EXPECT().ListCommunityGalleryImageVersions(ctx, region, "gallery", "image").Return([]*armcompute.CommunityGalleryImageVersion{
+						{Name: ptr.To("122")},
+						{Name: ptr.To("123")},
+						{Name: ptr.To("124"), Properties: &armcompute.CommunityGalleryImageVersionProperties{ExcludeFromLatest: ptr.To(true)}},
+					}, nil)
+					galleryImageVersions.EXPECT().ListSharedGalleryImageVersions(ctx, region, "gallery", "image").Return([]*armcompute.SharedGalleryImageVersion{
+						{Name: ptr.To("123")},
+					}, nil)
+
+					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+					expectedUserDataSecretRefRead()
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", kubernetes.Values(machineClasses))
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+					Expect(decodeWorkerProviderStatus(w).MachineImages).To(ContainElement(apiv1alpha1.MachineImage{
+						Name:         machineImageName,
+						Version:      machineImageVersionCommunityID,
+						Architecture: ptr.To(archARM),
+						Image: apiv1alpha1.Image{
+							CommunityGalleryImageID: &machineImageCommunityID,
+						},
+					}))
+				})
+
+				It("should fail if the version of a gallery image is not replicated to the region", func() {
+					cluster.CloudProfile.Spec.Regions = []gardencorev1beta1.Region{{Name: region}, {Name: "northeurope"}, {Name: "eastus"}, {Name: "westus"}}
+
+					factory := factorymock.NewMockFactory(ctrl)
+					galleryImageVersions := factorymock.NewMockGalleryImageVersions(ctrl)
+					factory.EXPECT().GalleryImageVersions().Return(galleryImageVersions, nil).AnyTimes()
+					galleryImageVersions.EXPECT().ListCommunityGalleryImageVersions(ctx, region, "gallery", "image").Return([]*armcompute.CommunityGalleryImageVersion{{Name: ptr.To("122")}}, nil)
+					galleryImageVersions.EXPECT().ListCommunityGalleryImageVersions(ctx, "northeurope", "gallery", "image").Return([]*armcompute.CommunityGalleryImageVersion{{Name: ptr.To("123")}}, nil)
+					galleryImageVersions.EXPECT().ListCommunityGalleryImageVersions(ctx, "eastus", "gallery", "image").Return(nil, fmt.Errorf("gallery not found"))
+					galleryImageVersions.EXPECT().ListCommunityGalleryImageVersions(ctx, "westus", "gallery", "image").Return([]*armcompute.CommunityGalleryImageVersion{{Name: ptr.To("122")}}, nil)
+
+					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+					expectedUserDataSecretRefRead()
+					err := workerDelegate.DeployMachineClasses(ctx)
+					Expect(err).To(MatchError(ContainSubstring(`gallery image "` + machineImageCommunityID + `" is not replicated to region westeurope, it is only replicated to the regions [northeurope] of the cloud profile`)))
+					Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+				})
+
+				It("should not check the replication of gallery images which are already in use", func() {
+					w.Status.ProviderStatus = generateWorkerStatusWithMachineImages(apiv1alpha1.MachineImage{
+						Name:         machineImageName,
+						Version:      machineImageVersionCommunityID,
+						Architecture: ptr.To(archARM),
+						Image: apiv1alpha1.Image{
+							CommunityGalleryImageID: &machineImageCommunityID,
+						},
+					})
+
+					factory := factorymock.NewMockFactory(ctrl)
+					galleryImageVersions := factorymock.NewMockGalleryImageVersions(ctrl)
+					factory.EXPECT().GalleryImageVersions().Return(galleryImageVersions, nil).AnyTimes()
+					galleryImageVersions.EXPECT().ListSharedGalleryImageVersions(ctx, region, "gallery", "image").Return([]*armcompute.SharedGalleryImageVersion{{Name: ptr.To("123")}}, nil)
+
+					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+					expectedUserDataSecretRefRead()
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", kubernetes.Values(machineClasses))
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+				})
+
+				It("should keep the resolved version of gallery images referencing the latest version", func() {
+					machineImages[0].Versions[2].CommunityGalleryImageID = ptr.To("/CommunityGalleries/gallery/Images/image/Versions/latest")
+					cluster = makeCluster(shootVersion, region, machineTypes, machineImages, 0)
+					w.Status.ProviderStatus = generateWorkerStatusWithMachineImages(apiv1alpha1.MachineImage{
+						Name:         machineImageName,
+						Version:      machineImageVersionCommunityID,
+						Architecture: ptr.To(archARM),
+						Image: apiv1alpha1.Image{
+							CommunityGalleryImageID: &machineImageCommunityID,
+						},
+					})
+
+					factory := factorymock.NewMockFactory(ctrl)
+					galleryImageVersions := factorymock.NewMockGalleryImageVersions(ctrl)
+					factory.EXPECT().GalleryImageVersions().Return(galleryImageVersions, nil).AnyTimes()
+					galleryImageVersions.EXPECT().ListSharedGalleryImageVersions(ctx, region, "gallery", "image").Return([]*armcompute.SharedGalleryImageVersion{{Name: ptr.To("123")}}, nil)
+
+					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+					expectedUserDataSecretRefRead()
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", kubernetes.Values(machineClasses))
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+					expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+					Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+					Expect(decodeWorkerProviderStatus(w).MachineImages).To(ContainElement(apiv1alpha1.MachineImage{
+						Name:         machineImageName,
+						Version:      machineImageVersionCommunityID,
+						Architecture: ptr.To(archARM),
+						Image: apiv1alpha1.Image{
+							CommunityGalleryImageID: &machineImageCommunityID,
+						},
+					}))
+				})
+
+				It("should not check the replication of gallery images while the worker is being deleted", func() {
+					w.DeletionTimestamp = ptr.To(metav1.Now())
+
+					factory := factorymock.NewMockFactory(ctrl)
+					galleryImageVersions := factorymock.NewMockGalleryImageVersions(ctrl)
+					factory.EXPECT().GalleryImageVersions().Return(galleryImageVersions, nil).AnyTimes()
+
+					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+					expectedUserDataSecretRefRead()
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", kubernetes.Values(machineClasses))
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+				})
+
+				It("should garbage collect orphaned machine classes and machine class secrets", func() {
+					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+					expectedUserDataSecretRefRead()
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", kubernetes.Values(machineClasses))
+
+					var (
+						stale  = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+						recent = metav1.NewTime(time.Now().Add(-time.Minute))
+
+						newMachineClass = func(name string, creationTimestamp metav1.Time) machinev1alpha1.MachineClass {
+							return machinev1alpha1.MachineClass{
+								ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, CreationTimestamp: creationTimestamp},
+								SecretRef:  &corev1.SecretReference{Name: name, Namespace: namespace},
+							}
+						}
+						newSecret = func(name string, creationTimestamp metav1.Time) corev1.Secret {
+							return corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, CreationTimestamp: creationTimestamp}}
+						}
+
+						orphanedMachineClass = newMachineClass("orphaned", stale)
+						orphanedSecret       = newSecret("orphaned", stale)
+					)
+
+					expectMachineClassGarbageCollectionListing(
+						[]machinev1alpha1.MachineClass{
+							newMachineClass(machineDeployments[0].ClassName, stale),
+							newMachineClass("used-by-machine", stale),
+							newMachineClass("recent", recent),
+							orphanedMachineClass,
+						},
+						[]corev1.Secret{
+							newSecret(machineDeployments[0].ClassName, stale),
+							newSecret("used-by-machine", stale),
+							newSecret("recent", stale),
+							newSecret("recent-without-class", recent),
+							orphanedSecret,
+						},
+						[]machinev1alpha1.Machine{
+							{Spec: machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: "used-by-machine"}}},
+						},
+					)
+					c.EXPECT().Delete(ctx, &orphanedMachineClass)
+					c.EXPECT().Delete(ctx, &orphanedSecret)
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+				})
+
 				Describe("#Zonal setup", func() {
 					var (
 						w                *extensionsv1alpha1.Worker
@@ -765,7 +1013,7 @@ var _ = Describe("Machines", func() {
 							{
 								Name:                 machineClassNamePool1,
 								ClassName:            machineClassWithHashPool1,
-								SecretName:           machineClassWithHashPool1,
+								SecretName:           userDataSecretNameOf(namePoolZones),
 								Minimum:              minPoolZones,
 								Maximum:              maxPoolZones,
 								MaxSurge:             maxSurgePoolZones,
@@ -776,7 +1024,7 @@ var _ = Describe("Machines", func() {
 							{
 								Name:                 machineClassNamePool2,
 								ClassName:            machineClassWithHashPool2,
-								SecretName:           machineClassWithHashPool2,
+								SecretName:           userDataSecretNameOf(namePoolZones),
 								Minimum:              minPoolZones,
 								Maximum:              maxPoolZones,
 								MaxSurge:             maxSurgePoolZones,
@@ -788,7 +1036,7 @@ var _ = Describe("Machines", func() {
 					})
 
 					It("should return the correct machine deployments for zonal setup", func() {
-						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 						expectedUserDataSecretRefRead()
 
@@ -798,6 +1046,185 @@ var _ = Describe("Machines", func() {
 						Expect(result).To(Equal(machineDeployments))
 					})
 
+					Context("conversion to a zoned worker pool", func() {
+						var (
+							vmoDependency           apiv1alpha1.VmoDependency
+							basename                string
+							expectMachineDeployment func(zone string, available bool)
+						)
+
+						BeforeEach(func() {
+							w.Spec.Pools[0].Name = "zones"
+							w.Spec.Pools[0].Minimum = 2
+							w.Spec.Pools[0].Maximum = 6
+							vmoDependency = apiv1alpha1.VmoDependency{
+								ID:       "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachineScaleSets/vmo-zones",
+								Name:     "vmo-zones",
+								PoolName: "zones",
+							}
+							w.Status.ProviderStatus = generateWorkerStatusWithVmo(vmoDependency)
+							basename = fmt.Sprintf("%s-%s", namespace, "zones")
+
+							expectMachineDeployment = func(zone string, available bool) {
+								c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: basename + "-z" + zone}, gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeployment{})).DoAndReturn(
+									func(_ context.Context, key client.ObjectKey, machineDeployment *machinev1alpha1.MachineDeployment, _ ...client.GetOption) error {
+										if !available {
+											return apierrors.NewNotFound(machinev1alpha1.Resource("machinedeployments"), key.Name)
+										}
+										machineDeployment.Spec.Replicas = 1
+										machineDeployment.Status.UpdatedReplicas = 1
+										machineDeployment.Status.AvailableReplicas = 1
+										return nil
+									})
+							}
+						})
+
+						It("should keep the former machine deployment and roll out the first zone", func() {
+							expectMachineDeployment(zone1, false)
+
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+							expectedUserDataSecretRefRead()
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(HaveLen(2))
+
+							workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, []string{identityID, vmoDependency.Name}, []string{identityID, vmoDependency.Name})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result[0].Name).To(Equal(basename))
+							Expect(result[0].ClassName).To(Equal(basename + "-" + workerPoolHash))
+							Expect(result[0].Minimum).To(Equal(int32(2)))
+							Expect(result[0].Maximum).To(Equal(int32(6)))
+							Expect(result[1].Name).To(Equal(basename + "-z" + zone1))
+							Expect(result[1].Minimum).To(Equal(int32(1)))
+							Expect(result[1].Maximum).To(Equal(int32(3)))
+						})
+
+						It("should roll out the next zone once the previous zone is available and scale down the former machine deployment", func() {
+							expectMachineDeployment(zone1, true)
+							expectMachineDeployment(zone2, false)
+
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+							expectedUserDataSecretRefRead()
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(HaveLen(3))
+							Expect(result[0].Name).To(Equal(basename))
+							Expect(result[0].Minimum).To(Equal(int32(1)))
+							Expect(result[0].Maximum).To(Equal(int32(3)))
+							Expect(result[1].Name).To(Equal(basename + "-z" + zone1))
+							Expect(result[2].Name).To(Equal(basename + "-z" + zone2))
+						})
+
+						It("should drop the former machine deployment once all zones are available", func() {
+							expectMachineDeployment(zone1, true)
+							expectMachineDeployment(zone2, true)
+
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+							expectedUserDataSecretRefRead()
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(HaveLen(2))
+							Expect(result[0].Name).To(Equal(basename + "-z" + zone1))
+							Expect(result[1].Name).To(Equal(basename + "-z" + zone2))
+						})
+					})
+
+					It("should raise the machine deployment minimum by the warm pool", func() {
+						warmPoolConfig, err := json.Marshal(apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							WarmPool: &apiv1alpha1.WarmPool{
+								Count:  3,
+								MaxAge: &metav1.Duration{Duration: 5 * time.Minute},
+							},
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						w.Spec.Pools[0].Minimum = 2
+						w.Spec.Pools[0].Maximum = 10
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: warmPoolConfig}
+
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result).To(HaveLen(2))
+
+						Expect(result[0].Minimum).To(Equal(int32(3)))
+						Expect(result[0].Maximum).To(Equal(int32(5)))
+						Expect(result[1].Minimum).To(Equal(int32(2)))
+						Expect(result[1].Maximum).To(Equal(int32(5)))
+						Expect(result[0].ClusterAutoscalerAnnotations).To(HaveKeyWithValue(extensionsv1alpha1.ScaleDownUnneededTimeAnnotation, "5m0s"))
+						Expect(result[1].ClusterAutoscalerAnnotations).To(HaveKeyWithValue(extensionsv1alpha1.ScaleDownUnneededTimeAnnotation, "5m0s"))
+					})
+
+					It("should exclude the nodes of subnets bypassing the worker security group from load balancers", func() {
+						const workerSecurityGroupID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/workers"
+						infrastructureStatus.SecurityGroups = []apisazure.SecurityGroup{
+							{Purpose: apisazure.PurposeNodes, Name: "workers", ID: workerSecurityGroupID, Managed: true},
+							{Purpose: apisazure.PurposeNodes, Name: "central", ID: "/subscriptions/sub/resourceGroups/network/providers/Microsoft.Network/networkSecurityGroups/central"},
+						}
+						infrastructureStatus.Networks.Subnets[0].SecurityGroupID = ptr.To(workerSecurityGroupID)
+						infrastructureStatus.Networks.Subnets[1].SecurityGroupID = ptr.To("/subscriptions/sub/resourceGroups/network/providers/Microsoft.Network/networkSecurityGroups/central")
+						w = makeWorker(namespace, region, &sshKey, infrastructureStatus, poolZones)
+
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result).To(HaveLen(2))
+						Expect(result[0].Labels).NotTo(HaveKey(corev1.LabelNodeExcludeBalancers))
+						Expect(result[1].Labels).To(HaveKeyWithValue(corev1.LabelNodeExcludeBalancers, "true"))
+					})
+
+					It("should place the machines of all zones in the nodes subnet the worker pool is pinned to", func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							NodesSubnet: ptr.To(subnet2),
+						})}
+
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result).To(HaveLen(2))
+
+						// the hash of the non-migrated subnet is used for the machine classes of both zones.
+						Expect(strings.TrimSuffix(result[0].ClassName, "-z"+zone1)).To(Equal(strings.TrimSuffix(result[1].ClassName, "-z"+zone2)))
+					})
+
+					It("should fail if the nodes subnet the worker pool is pinned to does not exist", func() {
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "WorkerConfig",
+							},
+							NodesSubnet: ptr.To("unknown"),
+						})}
+
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).To(HaveOccurred())
+						Expect(result).To(BeNil())
+					})
+
 					It("should set expected cluster-autoscaler annotations on the machine deployment", func() {
 						w.Spec.Pools[0].ClusterAutoscaler = &extensionsv1alpha1.ClusterAutoscalerOptions{
 							MaxNodeProvisionTime:             ptr.To(metav1.Duration{Duration: time.Minute}),
@@ -810,7 +1237,7 @@ var _ = Describe("Machines", func() {
 						w.Spec.Pools = append(w.Spec.Pools, pool2)
 						w.Spec.Pools[1].Zones = []string{zone1, zone2}
 
-						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 						expectedUserDataSecretRefRead()
 
@@ -841,7 +1268,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				cluster = makeCluster("invalid", region, nil, nil, 0)
-				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -850,7 +1277,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the machine image for given architecture cannot be found", func() {
 				w.Spec.Pools[0].Architecture = ptr.To("arm64")
-				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -859,7 +1286,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: []byte("definitely not correct")}
-				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -870,7 +1297,7 @@ var _ = Describe("Machines", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
 					Raw: encode(&apisazure.InfrastructureStatus{}),
 				}
-				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -893,7 +1320,7 @@ var _ = Describe("Machines", func() {
 						},
 					}),
 				}
-				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 				expectedUserDataSecretRefRead()
 
@@ -904,7 +1331,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the machine image information cannot be found", func() {
 				cluster = makeCluster(shootVersion, region, nil, nil, 0)
-				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
@@ -913,13 +1340,481 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the volume size cannot be decoded", func() {
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
-				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 				result, err := workerDelegate.GenerateMachineDeployments(ctx)
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
 			})
 
+			It("should render the data volume configuration into the machine class", func() {
+				snapshotID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/snapshots/snapshot"
+				w.Spec.Pools[0].DataVolumes[1].Type = ptr.To("UltraSSD_LRS")
+				workerConfig.DataVolumes = []apiv1alpha1.DataVolume{
+					{
+						Name:             dataVolume1Name,
+						SourceResourceID: &snapshotID,
+					},
+					{
+						Name:       dataVolume2Name,
+						IOPS:       ptr.To[int64](5000),
+						Throughput: ptr.To[int64](200),
+					},
+				}
+				marshalledWorkerConfig, err := json.Marshal(workerConfig)
+				Expect(err).NotTo(HaveOccurred())
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: marshalledWorkerConfig}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+				expectedUserDataSecretRefRead()
+				expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+				var values map[string]interface{}
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOptions := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOptions)
+						}
+						values = applyOptions.Values.(map[string]interface{})
+						return nil
+					},
+				)
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				machineClass := values["machineClasses"].([]map[string]interface{})[0]
+				Expect(machineClass["additionalCapabilities"]).To(Equal(map[string]interface{}{"ultraSSDEnabled": true}))
+				Expect(machineClass["dataDisks"]).To(ContainElement(map[string]interface{}{
+					"name":               dataVolume2Name,
+					"lun":                int32(0),
+					"diskSizeGB":         dataVolume2Size,
+					"storageAccountType": "UltraSSD_LRS",
+					"caching":            "None",
+					"diskIOPSReadWrite":  int64(5000),
+					"diskMBpsReadWrite":  int64(200),
+				}))
+				Expect(machineClass["dataDisks"]).To(ContainElement(map[string]interface{}{
+					"name":             dataVolume1Name,
+					"lun":              int32(1),
+					"diskSizeGB":       dataVolume1Size,
+					"caching":          "None",
+					"sourceResourceID": snapshotID,
+				}))
+			})
+
+			It("should deploy the user data of the worker pools into immutable secrets named after their content", func() {
+				deployMachineClasses := func() {
+					expectedUserDataSecretRefRead()
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any())
+					Expect(wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory).DeployMachineClasses(ctx)).To(Succeed())
+				}
+
+				deployMachineClasses()
+				secretName := userDataSecretNameOf(w.Spec.Pools[0].Name)
+				Expect(deployedUserDataSecrets).To(HaveKey(secretName))
+				Expect(deployedUserDataSecrets[secretName].Labels).To(HaveKeyWithValue(v1beta1constants.GardenerPurpose, v1beta1constants.GardenPurposeMachineClass))
+				Expect(deployedUserDataSecrets[secretName].Data).To(Equal(map[string][]byte{"userData": userData}))
+				Expect(deployedUserDataSecrets[secretName].Immutable).To(Equal(ptr.To(true)))
+
+				deployMachineClasses()
+				Expect(userDataSecretPatches).To(BeZero())
+
+				oldUserData := userData
+				userData = []byte("new-user-data")
+				deployMachineClasses()
+				Expect(userDataSecretPatches).To(BeZero())
+				Expect(deployedUserDataSecrets).To(HaveLen(2 * len(w.Spec.Pools)))
+				Expect(deployedUserDataSecrets[secretName].Data).To(Equal(map[string][]byte{"userData": oldUserData}))
+				Expect(deployedUserDataSecrets[userDataSecretNameOf(w.Spec.Pools[0].Name)].Data).To(Equal(map[string][]byte{"userData": userData}))
+			})
+
+			It("should render the disk controller type of the machine type into the machine class", func() {
+				for i := range machineImages {
+					for j := range machineImages[i].Versions {
+						machineImages[i].Versions[j].NVMe = ptr.To(true)
+					}
+				}
+				cluster = makeCluster(shootVersion, region, []apiv1alpha1.MachineType{
+					{
+						Name:               machineType,
+						DiskControllerType: ptr.To(apiv1alpha1.DiskControllerTypeNVMe),
+					},
+				}, machineImages, 0)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+				expectedUserDataSecretRefRead()
+				expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+				var values map[string]interface{}
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOptions := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOptions)
+						}
+						values = applyOptions.Values.(map[string]interface{})
+						return nil
+					},
+				)
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				for _, machineClass := range values["machineClasses"].([]map[string]interface{}) {
+					Expect(machineClass["diskControllerType"]).To(Equal("NVMe"))
+				}
+			})
+
+			It("should fail if the machine image does not support the NVMe disk controller of the machine type", func() {
+				cluster = makeCluster(shootVersion, region, []apiv1alpha1.MachineType{
+					{
+						Name:               machineType,
+						DiskControllerType: ptr.To(apiv1alpha1.DiskControllerTypeNVMe),
+					},
+				}, machineImages, 0)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+				expectedUserDataSecretRefRead()
+				err := workerDelegate.DeployMachineClasses(ctx)
+				Expect(err).To(MatchError(ContainSubstring(`machine type "` + machineType + `" of worker pool "` + w.Spec.Pools[0].Name + `" requires the NVMe disk controller, but machine image "` + machineImageName + `"`)))
+				Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+			})
+
+			It("should enlarge the OS disk to the minimum size of the machine image", func() {
+				machineImages[0].Versions[0].MinimumOSDiskSizeGB = ptr.To[int32](64)
+				machineImages[0].Versions[1].MinimumOSDiskSizeGB = ptr.To[int32](10)
+				cluster = makeCluster(shootVersion, region, machineTypes, machineImages, 0)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+				expectedUserDataSecretRefRead()
+				expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+				var values map[string]interface{}
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOptions := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOptions)
+						}
+						values = applyOptions.Values.(map[string]interface{})
+						return nil
+					},
+				)
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				for _, machineClass := range values["machineClasses"].([]map[string]interface{}) {
+					osDisk := machineClass["osDisk"].(map[string]interface{})
+					if _, ok := machineClass["image"].(map[string]interface{})["urn"]; ok {
+						Expect(osDisk["size"]).To(Equal(64))
+					} else {
+						// the volume of the worker pool is larger than the minimum of the other images.
+						Expect(osDisk["size"]).To(Equal(volumeSize))
+					}
+				}
+			})
+
+			It("should add the capacity of the machine type to the node templates", func() {
+				cluster = makeCluster(shootVersion, region, []apiv1alpha1.MachineType{
+					{
+						Name: machineType,
+						Capacity: corev1.ResourceList{
+							"cpu":                           resource.MustParse("4"),
+							"nvidia.com/gpu":                resource.MustParse("1"),
+							corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+						},
+					},
+				}, machineImages, 0)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+				expectedUserDataSecretRefRead()
+				expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+				var values map[string]interface{}
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOptions := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOptions)
+						}
+						values = applyOptions.Values.(map[string]interface{})
+						return nil
+					},
+				)
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				expectedCapacity := corev1.ResourceList{
+					"cpu":                           resource.MustParse("8"),
+					"gpu":                           resource.MustParse("1"),
+					"memory":                        resource.MustParse("128Gi"),
+					"nvidia.com/gpu":                resource.MustParse("1"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+				}
+				for _, machineClass := range values["machineClasses"].([]map[string]interface{}) {
+					Expect(machineClass["nodeTemplate"].(machinev1alpha1.NodeTemplate).Capacity).To(Equal(expectedCapacity))
+				}
+				Expect(nodeCapacity).NotTo(HaveKey(corev1.ResourceEphemeralStorage))
+			})
+
+			It("should use the ephemeral storage of the machine type in the node templates if the local disks provide it", func() {
+				cluster = makeCluster(shootVersion, region, []apiv1alpha1.MachineType{
+					{
+						Name:     machineType,
+						Capacity: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1900Gi")},
+					},
+				}, machineImages, 0)
+				w.Spec.Pools[0].NodeTemplate.Capacity = corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("50Gi")}
+				workerConfig.LocalDisks = &apiv1alpha1.LocalDisks{EphemeralStorage: true}
+				marshalledWorkerConfig, err := json.Marshal(workerConfig)
+				Expect(err).NotTo(HaveOccurred())
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: marshalledWorkerConfig}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+				expectedUserDataSecretRefRead()
+				expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+				var values map[string]interface{}
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOptions := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOptions)
+						}
+						values = applyOptions.Values.(map[string]interface{})
+						return nil
+					},
+				)
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				machineClass := values["machineClasses"].([]map[string]interface{})[0]
+				Expect(machineClass["nodeTemplate"].(machinev1alpha1.NodeTemplate).Capacity).To(HaveKeyWithValue(corev1.ResourceEphemeralStorage, resource.MustParse("1900Gi")))
+			})
+
+			It("should render the endpoints of an Azure Stack Hub into the machine class", func() {
+				cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+				Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+				cloudProfileConfig.CloudConfiguration = &apiv1alpha1.CloudConfiguration{
+					Name:                         "AzureStackCloud",
+					ActiveDirectoryAuthorityHost: ptr.To("https://adfs.local.azurestack.external/"),
+					ResourceManagerEndpoint:      ptr.To("https://management.local.azurestack.external/"),
+					StorageEndpointSuffix:        ptr.To("local.azurestack.external"),
+				}
+				raw, err := json.Marshal(cloudProfileConfig)
+				Expect(err).NotTo(HaveOccurred())
+				cluster.CloudProfile.Spec.ProviderConfig.Raw = raw
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+				expectedUserDataSecretRefRead()
+				expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+				var values map[string]interface{}
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOptions := &kubernetes.ApplyOptions{}
+						for _, opt := range opts {
+							opt.MutateApplyOptions(applyOptions)
+						}
+						values = applyOptions.Values.(map[string]interface{})
+						return nil
+					},
+				)
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				for _, machineClass := range values["machineClasses"].([]map[string]interface{}) {
+					Expect(machineClass["cloudConfiguration"]).To(Equal(map[string]interface{}{
+						"name":                         "AzureStackCloud",
+						"activeDirectoryAuthorityHost": "https://adfs.local.azurestack.external/",
+						"resourceManagerEndpoint":      "https://management.local.azurestack.external/",
+						"storageEndpointSuffix":        "local.azurestack.external",
+					}))
+				}
+			})
+
+			Context("cloud configuration of the worker pool", func() {
+				var deployMachineClasses func() ([]map[string]interface{}, error)
+
+				BeforeEach(func() {
+					w.Spec.Pools = w.Spec.Pools[:1]
+
+					deployMachineClasses = func() ([]map[string]interface{}, error) {
+						marshalledWorkerConfig, err := json.Marshal(workerConfig)
+						Expect(err).NotTo(HaveOccurred())
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: marshalledWorkerConfig}
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+						expectedUserDataSecretRefRead()
+
+						var values map[string]interface{}
+						chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).DoAndReturn(
+							func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOptions := &kubernetes.ApplyOptions{}
+								for _, opt := range opts {
+									opt.MutateApplyOptions(applyOptions)
+								}
+								values = applyOptions.Values.(map[string]interface{})
+								return nil
+							},
+						).MaxTimes(1)
+						if err := workerDelegate.DeployMachineClasses(ctx); err != nil {
+							return nil, err
+						}
+						return values["machineClasses"].([]map[string]interface{}), nil
+					}
+				})
+
+				It("should render the cloud configuration of the worker pool into the machine class", func() {
+					workerConfig.CloudConfiguration = &apiv1alpha1.CloudConfiguration{Name: "AzureChina"}
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+					machineClasses, err := deployMachineClasses()
+					Expect(err).NotTo(HaveOccurred())
+					for _, machineClass := range machineClasses {
+						Expect(machineClass["cloudConfiguration"]).To(Equal(map[string]interface{}{"name": "AzureChina"}))
+					}
+				})
+
+				It("should render the cloud configuration of the shoot if the worker pool does not override it", func() {
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+					machineClasses, err := deployMachineClasses()
+					Expect(err).NotTo(HaveOccurred())
+					for _, machineClass := range machineClasses {
+						Expect(machineClass["cloudConfiguration"]).To(Equal(map[string]interface{}{"name": "AzurePublic"}))
+					}
+				})
+			})
+
+			Context("VM tags", func() {
+				var (
+					recorder        *record.FakeRecorder
+					mandatoryVMTags map[string]string
+				)
+
+				deployMachineClassTags := func(workerConfig *apiv1alpha1.WorkerConfig) []map[string]string {
+					workerConfig.TypeMeta = metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					}
+					marshalledWorkerConfig, err := json.Marshal(workerConfig)
+					Expect(err).NotTo(HaveOccurred())
+					for i := range w.Spec.Pools {
+						w.Spec.Pools[i].ProviderConfig = &runtime.RawExtension{Raw: marshalledWorkerConfig}
+					}
+					workerDelegate := wrapNewWorkerDelegateWithRecorder(c, recorder, chartApplier, w, cluster, factory, mandatoryVMTags)
+
+					expectedUserDataSecretRefRead()
+					expectMachineClassGarbageCollectionListing(nil, nil, nil)
+
+					var values map[string]interface{}
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOptions := &kubernetes.ApplyOptions{}
+							for _, opt := range opts {
+								opt.MutateApplyOptions(applyOptions)
+							}
+							values = applyOptions.Values.(map[string]interface{})
+							return nil
+						},
+					)
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+					var tags []map[string]string
+					for _, machineClass := range values["machineClasses"].([]map[string]interface{}) {
+						tags = append(tags, machineClass["tags"].(map[string]string))
+					}
+					return tags
+				}
+
+				BeforeEach(func() {
+					recorder = record.NewFakeRecorder(10)
+					mandatoryVMTags = nil
+				})
+
+				It("should merge the shoot labels according to the merge policy and report conflicting tags", func() {
+					cluster.Shoot.Labels = map[string]string{"component": "shoot", "cost/center": "1234"}
+
+					tags := deployMachineClassTags(&apiv1alpha1.WorkerConfig{
+						VMTags: &apiv1alpha1.VMTagsConfig{
+							IncludeShootLabels: ptr.To(true),
+							MergePolicy:        ptr.To(apiv1alpha1.VMTagMergePolicyShootLabels),
+						},
+					})
+
+					for _, vmTags := range tags {
+						Expect(vmTags).To(HaveKeyWithValue("component", "shoot"))
+						Expect(vmTags).To(HaveKeyWithValue("cost_center", "1234"))
+						Expect(vmTags).To(HaveKeyWithValue("Name", namespace))
+					}
+					Expect(recorder.Events).To(Receive(And(
+						ContainSubstring(EventReasonVMTagsDropped),
+						ContainSubstring(`pool label "component" (name conflict)`),
+					)))
+				})
+
+				It("should keep the infrastructure tags and drop the labels exceeding the tag limit", func() {
+					poolLabels := map[string]string{}
+					for i := range 60 {
+						poolLabels[fmt.Sprintf("label-%02d", i)] = "value"
+					}
+					for i := range w.Spec.Pools {
+						w.Spec.Pools[i].Labels = poolLabels
+					}
+
+					tags := deployMachineClassTags(&apiv1alpha1.WorkerConfig{})
+
+					for _, vmTags := range tags {
+						Expect(vmTags).To(HaveLen(50))
+						Expect(vmTags).To(HaveKeyWithValue("Name", namespace))
+						Expect(vmTags).To(HaveKeyWithValue(SanitizeAzureVMTag(fmt.Sprintf("kubernetes.io-cluster-%s", namespace)), "1"))
+						Expect(vmTags).To(HaveKey("label-46"))
+						Expect(vmTags).NotTo(HaveKey("label-47"))
+					}
+					Expect(recorder.Events).To(Receive(And(
+						ContainSubstring(`pool label "label-47" (tag limit)`),
+						ContainSubstring(`pool label "label-59" (tag limit)`),
+					)))
+				})
+
+				It("should add the mandatory tags with precedence over the labels and keep them if the tag limit is exceeded", func() {
+					mandatoryVMTags = map[string]string{"cost-center": "platform", "zz-owner": "landscape"}
+					poolLabels := map[string]string{"cost-center": "pool"}
+					for i := range 60 {
+						poolLabels[fmt.Sprintf("label-%02d", i)] = "value"
+					}
+					for i := range w.Spec.Pools {
+						w.Spec.Pools[i].Labels = poolLabels
+					}
+
+					tags := deployMachineClassTags(&apiv1alpha1.WorkerConfig{})
+
+					for _, vmTags := range tags {
+						Expect(vmTags).To(HaveLen(50))
+						Expect(vmTags).To(HaveKeyWithValue("cost-center", "platform"))
+						Expect(vmTags).To(HaveKeyWithValue("zz-owner", "landscape"))
+						Expect(vmTags).To(HaveKeyWithValue("Name", namespace))
+					}
+					Expect(recorder.Events).To(Receive(And(
+						ContainSubstring(`pool label "cost-center" (name conflict)`),
+						ContainSubstring(`(tag limit)`),
+					)))
+				})
+
+				It("should truncate too long tags with a hash suffix", func() {
+					longName, longValue := strings.Repeat("n", 600), strings.Repeat("v", 300)
+					for i := range w.Spec.Pools {
+						w.Spec.Pools[i].Labels = map[string]string{longName: longValue}
+					}
+
+					tags := deployMachineClassTags(&apiv1alpha1.WorkerConfig{})
+
+					for _, vmTags := range tags {
+						Expect(vmTags).To(HaveKeyWithValue(
+							MatchRegexp(`^n{503}-[0-9a-f]{8}$`),
+							MatchRegexp(`^v{247}-[0-9a-f]{8}$`),
+						))
+					}
+					Expect(recorder.Events).To(BeEmpty())
+				})
+			})
+
 			It("should set expected machineControllerManager settings on machine deployment", func() {
 				var (
 					testDrainTimeout    = metav1.Duration{Duration: 10 * time.Minute}
@@ -935,7 +1830,7 @@ var _ = Describe("Machines", func() {
 					MaxEvictRetries:        &testMaxEvictRetries,
 					NodeConditions:         testNodeConditions,
 				}
-				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
 
 				expectedUserDataSecretRefRead()
 
@@ -950,6 +1845,37 @@ var _ = Describe("Machines", func() {
 				Expect(resultSettings.MaxEvictRetries).To(Equal(&testMaxEvictRetries))
 				Expect(resultSettings.NodeConditions).To(Equal(&resultNodeConditions))
 			})
+
+			It("should add the scheduled event node condition if the handling of scheduled events is enabled", func() {
+				scheduledEventsConfig := &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					ScheduledEvents: &apiv1alpha1.ScheduledEvents{Enabled: true},
+				})}
+				w.Spec.Pools[0].ProviderConfig = scheduledEventsConfig
+				w.Spec.Pools[1].ProviderConfig = scheduledEventsConfig
+				w.Spec.Pools[1].MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{
+					NodeConditions: []string{"ReadonlyFilesystem"},
+				}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+				expectedUserDataSecretRefRead()
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				for _, machineDeployment := range result {
+					switch {
+					case strings.Contains(machineDeployment.Name, namePool1):
+						Expect(machineDeployment.MachineConfiguration.NodeConditions).To(Equal(ptr.To("KernelDeadlock,ReadonlyFilesystem,DiskPressure,NetworkUnavailable,AzureScheduledEvent")))
+					case strings.Contains(machineDeployment.Name, namePool2):
+						Expect(machineDeployment.MachineConfiguration.NodeConditions).To(Equal(ptr.To("ReadonlyFilesystem,AzureScheduledEvent")))
+					default:
+						Expect(machineDeployment.MachineConfiguration.NodeConditions).To(BeNil())
+					}
+				}
+			})
 		})
 	})
 
@@ -970,8 +1896,12 @@ func copyMachineClass(def map[string]interface{}) map[string]interface{} {
 	return out
 }
 
-func addNameAndSecretsToMachineClass(class map[string]interface{}, name string, credentialsSecretRef corev1.SecretReference) {
+func addNameAndSecretsToMachineClass(class map[string]interface{}, name, userDataSecretName string, userData []byte, credentialsSecretRef corev1.SecretReference) {
 	class["name"] = name
+	class["secret"] = map[string]interface{}{
+		"name":     userDataSecretName,
+		"checksum": utils.ComputeSHA256Hex(userData),
+	}
 	class["credentialsSecretRef"] = map[string]interface{}{
 		"name":      credentialsSecretRef.Name,
 		"namespace": credentialsSecretRef.Namespace,
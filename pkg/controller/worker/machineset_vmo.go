@@ -12,8 +12,12 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	azureapihelper "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
@@ -29,14 +33,18 @@ func (w *workerDelegate) reconcileVmoDependencies(ctx context.Context, infrastru
 		return vmoDependencies, err
 	}
 
-	faultDomainCount, err := azureapihelper.FindDomainCountByRegion(w.cloudProfileConfig.CountFaultDomains, w.worker.Spec.Region)
-	if err != nil {
-		return nil, err
-	}
-
 	// Deploy workerpool dependencies and store their status to be persistent in the worker provider status.
 	for _, workerPool := range w.worker.Spec.Pools {
-		vmoDependencyStatus, err := w.reconcileVMO(ctx, vmoClient, vmoDependencies, infrastructureStatus.ResourceGroup.Name, workerPool.Name, faultDomainCount)
+		if !azureapihelper.IsWorkerPoolVmoRequired(infrastructureStatus, workerPool.Name) {
+			continue
+		}
+
+		settings, err := w.vmoSettings(workerPool)
+		if err != nil {
+			return vmoDependencies, err
+		}
+
+		vmoDependencyStatus, err := w.reconcileVMO(ctx, vmoClient, vmoDependencies, infrastructureStatus.ResourceGroup.Name, workerPool.Name, settings)
 		if err != nil {
 			return vmoDependencies, err
 		}
@@ -46,7 +54,7 @@ func (w *workerDelegate) reconcileVmoDependencies(ctx context.Context, infrastru
 	return vmoDependencies, nil
 }
 
-func (w *workerDelegate) reconcileVMO(ctx context.Context, client azureclient.Vmss, dependencies []azureapi.VmoDependency, resourceGroupName, workerPoolName string, faultDomainCount int32) (*azureapi.VmoDependency, error) {
+func (w *workerDelegate) reconcileVMO(ctx context.Context, client azureclient.Vmss, dependencies []azureapi.VmoDependency, resourceGroupName, workerPoolName string, settings *vmoSettings) (*azureapi.VmoDependency, error) {
 	var (
 		existingDependency *azureapi.VmoDependency
 		vmo                *armcompute.VirtualMachineScaleSet
@@ -71,17 +79,17 @@ func (w *workerDelegate) reconcileVMO(ctx context.Context, client azureclient.Vm
 
 	// VMO does not exists. Create it.
 	if vmo == nil {
-		newVMO, err := generateAndCreateVmo(ctx, client, workerPoolName, resourceGroupName, w.worker.Spec.Region, faultDomainCount)
+		newVMO, err := generateAndCreateVmo(ctx, client, workerPoolName, resourceGroupName, w.worker.Spec.Region, settings)
 		if err != nil {
 			return nil, err
 		}
 		return newVMO, nil
 	}
 
-	// VMO already exists. Check if the fault domain count configuration has been changed.
+	// VMO already exists. Check if the fault domain count or the zone balancing configuration has been changed.
 	// If yes then it is required to create a new VMO with the correct configuration.
-	if *vmo.Properties.PlatformFaultDomainCount != faultDomainCount {
-		newVMO, err := generateAndCreateVmo(ctx, client, workerPoolName, resourceGroupName, w.worker.Spec.Region, faultDomainCount)
+	if *vmo.Properties.PlatformFaultDomainCount != settings.faultDomainCount || ptr.Deref(vmo.Properties.ZoneBalance, false) != ptr.Deref(settings.zoneBalance, false) {
+		newVMO, err := generateAndCreateVmo(ctx, client, workerPoolName, resourceGroupName, w.worker.Spec.Region, settings)
 		if err != nil {
 			return nil, err
 		}
@@ -136,6 +144,85 @@ func (w *workerDelegate) cleanupVmoDependencies(ctx context.Context, infrastruct
 	return vmoDependencies, nil
 }
 
+// cleanupZonalMigrationVmoDependencies deletes the VMOs of a shoot which was converted from a non-zoned to a zoned shoot.
+// Zoned worker pools do not use VMOs, but the VMO of a worker pool is only deleted once its former non-zoned machine
+// deployment is gone, i.e. after the machines of all zones were rolled out.
+func (w *workerDelegate) cleanupZonalMigrationVmoDependencies(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerProviderStatus *azureapi.WorkerStatus) ([]azureapi.VmoDependency, error) {
+	var vmoDependencies = copyVmoDependencies(workerProviderStatus)
+
+	vmoClient, err := w.clientFactory.Vmss()
+	if err != nil {
+		return vmoDependencies, err
+	}
+
+	for _, dependency := range workerProviderStatus.VmoDependencies {
+		machineDeployment := &machinev1alpha1.MachineDeployment{}
+		if err := w.client.Get(ctx, client.ObjectKey{Namespace: w.worker.Namespace, Name: fmt.Sprintf("%s-%s", w.worker.Namespace, dependency.PoolName)}, machineDeployment); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return vmoDependencies, err
+		}
+
+		if err := vmoClient.Delete(ctx, infrastructureStatus.ResourceGroup.Name, dependency.Name, ptr.To(false)); err != nil {
+			return vmoDependencies, err
+		}
+		vmoDependencies = removeVmoDependency(vmoDependencies, dependency)
+	}
+	return vmoDependencies, nil
+}
+
+// zonalMigrationVmoDependency returns the vmo dependency of the given worker pool if the shoot was converted to a zoned
+// shoot, but the former non-zoned machines of the worker pool may still run in the vmo.
+func zonalMigrationVmoDependency(infrastructureStatus *azureapi.InfrastructureStatus, workerStatus *azureapi.WorkerStatus, poolName string) *azureapi.VmoDependency {
+	if !infrastructureStatus.Zoned {
+		return nil
+	}
+	for _, dependency := range workerStatus.VmoDependencies {
+		if dependency.PoolName == poolName {
+			return &dependency
+		}
+	}
+	return nil
+}
+
+// zonalMigrationNodesSubnet returns the nodes subnet the former non-zoned machines of a shoot converted to a zoned shoot
+// are placed in.
+func zonalMigrationNodesSubnet(subnets []azureapi.Subnet) (*azureapi.Subnet, error) {
+	for _, subnet := range subnets {
+		if subnet.Purpose == azureapi.PurposeNodes && (subnet.Zone == nil || subnet.Migrated) {
+			return &subnet, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find the nodes subnet of the non-zoned machines")
+}
+
+// availableZonalMachineDeployments returns the number of zones of the worker pool whose machine deployments exist and
+// are available, counted in the order of the zones. The zones of a worker pool converted to a zoned worker pool are
+// rolled out one by one in this order.
+func (w *workerDelegate) availableZonalMachineDeployments(ctx context.Context, pool extensionsv1alpha1.WorkerPool) (int, error) {
+	available := 0
+	for _, zone := range pool.Zones {
+		machineDeployment := &machinev1alpha1.MachineDeployment{}
+		if err := w.client.Get(ctx, client.ObjectKey{Namespace: w.worker.Namespace, Name: fmt.Sprintf("%s-%s-z%s", w.worker.Namespace, pool.Name, zone)}, machineDeployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				return available, nil
+			}
+			return 0, err
+		}
+		if !isMachineDeploymentAvailable(machineDeployment) {
+			return available, nil
+		}
+		available++
+	}
+	return available, nil
+}
+
+func isMachineDeploymentAvailable(machineDeployment *machinev1alpha1.MachineDeployment) bool {
+	return machineDeployment.Status.ObservedGeneration >= machineDeployment.Generation &&
+		machineDeployment.Status.UpdatedReplicas >= machineDeployment.Spec.Replicas &&
+		machineDeployment.Status.AvailableReplicas >= machineDeployment.Spec.Replicas
+}
+
 func cleanupOrphanVMODependencies(ctx context.Context, client azureclient.Vmss, dependencies []azureapi.VmoDependency, resourceGroupName string) error {
 	vmoListAll, err := client.List(ctx, resourceGroupName)
 	if err != nil && !azureclient.IsAzureAPINotFoundError(err) {
@@ -160,10 +247,11 @@ func cleanupOrphanVMODependencies(ctx context.Context, client azureclient.Vmss,
 	return nil
 }
 
-func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerStatus *azureapi.WorkerStatus, workerPoolName string) (*azureapi.VmoDependency, error) {
-	if !azureapihelper.IsVmoRequired(infrastructureStatus) {
+func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerStatus *azureapi.WorkerStatus, pool extensionsv1alpha1.WorkerPool) (*azureapi.VmoDependency, error) {
+	if !azureapihelper.IsWorkerPoolVmoRequired(infrastructureStatus, pool.Name) {
 		return nil, nil
 	}
+	workerPoolName := pool.Name
 
 	// First: Lookup the vmo dependency for the worker pool in the worker status.
 	var dependencyInStatus *azureapi.VmoDependency
@@ -213,12 +301,12 @@ func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, i
 	}
 
 	// Third: No vmo for the worker pool was found on Azure. Need to create it.
-	faultDomainCount, err := azureapihelper.FindDomainCountByRegion(w.cloudProfileConfig.CountFaultDomains, w.worker.Spec.Region)
+	settings, err := w.vmoSettings(pool)
 	if err != nil {
 		return nil, err
 	}
 
-	newDependency, err := generateAndCreateVmo(ctx, vmoClient, workerPoolName, infrastructureStatus.ResourceGroup.Name, w.worker.Spec.Region, faultDomainCount)
+	newDependency, err := generateAndCreateVmo(ctx, vmoClient, workerPoolName, infrastructureStatus.ResourceGroup.Name, w.worker.Spec.Region, settings)
 	if err != nil {
 		return nil, err
 	}
@@ -231,12 +319,46 @@ func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, i
 
 // VMO Helper
 
-func generateAndCreateVmo(ctx context.Context, client azureclient.Vmss, workerPoolName, resourceGroupName, region string, faultDomainCount int32) (*azureapi.VmoDependency, error) {
+// vmoSettings contains the configuration of the VMO of a worker pool.
+type vmoSettings struct {
+	faultDomainCount int32
+	zoneBalance      *bool
+}
+
+// vmoSettings returns the settings for the VMO of the given worker pool. The fault domain count configured in the
+// WorkerConfig of the pool takes precedence over the count of the region in the CloudProfileConfig.
+func (w *workerDelegate) vmoSettings(pool extensionsv1alpha1.WorkerPool) (*vmoSettings, error) {
+	workerConfig := azureapi.WorkerConfig{}
+	if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+		if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, &workerConfig); err != nil {
+			return nil, fmt.Errorf("could not decode provider config: %+v", err)
+		}
+	}
+
+	settings := &vmoSettings{}
+	if workerConfig.Vmo != nil {
+		settings.zoneBalance = workerConfig.Vmo.ZoneBalance
+	}
+	if workerConfig.Vmo != nil && workerConfig.Vmo.FaultDomainCount != nil {
+		settings.faultDomainCount = *workerConfig.Vmo.FaultDomainCount
+		return settings, nil
+	}
+
+	faultDomainCount, err := azureapihelper.FindDomainCountByRegion(w.cloudProfileConfig.CountFaultDomains, w.worker.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+	settings.faultDomainCount = faultDomainCount
+	return settings, nil
+}
+
+func generateAndCreateVmo(ctx context.Context, client azureclient.Vmss, workerPoolName, resourceGroupName, region string, settings *vmoSettings) (*azureapi.VmoDependency, error) {
 	var properties = armcompute.VirtualMachineScaleSet{
 		Location: &region,
 		Properties: &armcompute.VirtualMachineScaleSetProperties{
 			SinglePlacementGroup:     ptr.To(false),
-			PlatformFaultDomainCount: &faultDomainCount,
+			PlatformFaultDomainCount: &settings.faultDomainCount,
+			ZoneBalance:              settings.zoneBalance,
 		},
 		Tags: map[string]*string{
 			azure.MachineSetTagKey: ptr.To("1"),
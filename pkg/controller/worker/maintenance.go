@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+const (
+	// maintenanceAssignmentName is the name of the configuration assignment with which the maintenance configuration of
+	// a worker pool is assigned to its virtual machines.
+	maintenanceAssignmentName = "gardener"
+	// azureProviderIDPrefix is the prefix of the provider IDs of machines, which is followed by the resource ID of the
+	// virtual machine.
+	azureProviderIDPrefix = "azure://"
+)
+
+// reconcileMaintenanceAssignments assigns the maintenance configurations of the worker pools to the virtual machines of
+// their machines and removes the assignments of machines whose worker pool no longer has (or has another) maintenance
+// configuration. Machines which are created later on, e.g. by scale-ups, are handled by the maintenance reconciler.
+func (w *workerDelegate) reconcileMaintenanceAssignments(ctx context.Context) error {
+	maintenanceConfigurations, err := workerMaintenanceConfigurations(w.decoder, w.worker)
+	if err != nil {
+		return err
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := w.client.List(ctx, machineList, client.InNamespace(w.worker.Namespace)); err != nil {
+		return fmt.Errorf("failed to list machines: %w", err)
+	}
+
+	assigner := &maintenanceAssigner{
+		client:                 w.client,
+		region:                 w.worker.Spec.Region,
+		newAssignmentsClientFn: w.clientFactory.MaintenanceAssignments,
+	}
+
+	var errs []error
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+		maintenanceConfigurationID := maintenanceConfigurations[machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]]
+		if err := assigner.reconcile(ctx, machine, maintenanceConfigurationID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile maintenance configuration assignment of machine %s: %w", machine.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// workerMaintenanceConfigurations returns the resource IDs of the maintenance configurations of the worker pools by the
+// names of the worker pools.
+func workerMaintenanceConfigurations(decoder runtime.Decoder, worker *extensionsv1alpha1.Worker) (map[string]string, error) {
+	maintenanceConfigurations := map[string]string{}
+	for _, pool := range worker.Spec.Pools {
+		workerConfig := azureapi.WorkerConfig{}
+		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+			if _, _, err := decoder.Decode(pool.ProviderConfig.Raw, nil, &workerConfig); err != nil {
+				return nil, fmt.Errorf("could not decode provider config: %+v", err)
+			}
+		}
+		if workerConfig.MaintenanceConfiguration != nil {
+			maintenanceConfigurations[pool.Name] = *workerConfig.MaintenanceConfiguration
+		}
+	}
+	return maintenanceConfigurations, nil
+}
+
+// maintenanceAssigner assigns maintenance configurations to the virtual machines of machines. The assigned maintenance
+// configuration is recorded in an annotation of the machine, so that machines which are up to date do not cause any
+// request to Azure.
+type maintenanceAssigner struct {
+	client                 client.Client
+	region                 string
+	newAssignmentsClientFn func() (azureclient.MaintenanceAssignments, error)
+
+	assignmentsClient azureclient.MaintenanceAssignments
+}
+
+// reconcile assigns the maintenance configuration with the given ID to the virtual machine of the given machine. If
+// the ID is empty, the assignment of a previously assigned maintenance configuration is removed.
+func (a *maintenanceAssigner) reconcile(ctx context.Context, machine *machinev1alpha1.Machine, maintenanceConfigurationID string) error {
+	if machine.DeletionTimestamp != nil {
+		return nil
+	}
+	// The provider ID is only set once the virtual machine was created.
+	virtualMachineID, ok := strings.CutPrefix(machine.Spec.ProviderID, azureProviderIDPrefix)
+	if !ok {
+		return nil
+	}
+	// Azure resource ids are case-insensitive.
+	if strings.EqualFold(machine.Annotations[azure.MaintenanceConfigurationAnnotation], maintenanceConfigurationID) {
+		return nil
+	}
+
+	if a.assignmentsClient == nil {
+		assignmentsClient, err := a.newAssignmentsClientFn()
+		if err != nil {
+			return err
+		}
+		a.assignmentsClient = assignmentsClient
+	}
+
+	patch := client.MergeFrom(machine.DeepCopy())
+	if maintenanceConfigurationID == "" {
+		if err := a.assignmentsClient.Delete(ctx, virtualMachineID, maintenanceAssignmentName); err != nil {
+			return err
+		}
+		delete(machine.Annotations, azure.MaintenanceConfigurationAnnotation)
+	} else {
+		if err := a.ensureAssignment(ctx, virtualMachineID, maintenanceConfigurationID); err != nil {
+			return err
+		}
+		metav1.SetMetaDataAnnotation(&machine.ObjectMeta, azure.MaintenanceConfigurationAnnotation, maintenanceConfigurationID)
+	}
+	return a.client.Patch(ctx, machine, patch)
+}
+
+func (a *maintenanceAssigner) ensureAssignment(ctx context.Context, virtualMachineID, maintenanceConfigurationID string) error {
+	current, err := a.assignmentsClient.Get(ctx, virtualMachineID, maintenanceAssignmentName)
+	if err != nil {
+		return err
+	}
+	if current != nil && current.Properties != nil && strings.EqualFold(ptr.Deref(current.Properties.MaintenanceConfigurationID, ""), maintenanceConfigurationID) {
+		return nil
+	}
+
+	_, err = a.assignmentsClient.CreateOrUpdate(ctx, virtualMachineID, maintenanceAssignmentName, azureclient.MaintenanceAssignment{
+		Location: ptr.To(a.region),
+		Properties: &azureclient.MaintenanceAssignmentProperties{
+			MaintenanceConfigurationID: ptr.To(maintenanceConfigurationID),
+			ResourceID:                 ptr.To(virtualMachineID),
+		},
+	})
+	return err
+}
+
+type maintenanceReconciler struct {
+	client  client.Client
+	decoder runtime.Decoder
+}
+
+// NewMaintenanceReconciler creates a new reconcile.Reconciler which assigns the maintenance configurations of the
+// worker pools to the virtual machines of new machines.
+func NewMaintenanceReconciler(c client.Client, scheme *runtime.Scheme) reconcile.Reconciler {
+	return &maintenanceReconciler{
+		client:  c,
+		decoder: serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
+	}
+}
+
+// Reconcile assigns the maintenance configuration of its worker pool to the virtual machine of a machine as soon as the
+// virtual machine was created, so that machines which are created between two reconciliations of the Worker, e.g. by
+// scale-ups, are not left without the maintenance configuration. Changed or removed maintenance configurations are
+// handled by the reconciliation of the Worker.
+func (r *maintenanceReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	machine := &machinev1alpha1.Machine{}
+	if err := r.client.Get(ctx, request.NamespacedName, machine); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	worker, err := r.getWorker(ctx, machine.Namespace)
+	if err != nil || worker == nil || worker.DeletionTimestamp != nil {
+		return reconcile.Result{}, err
+	}
+
+	maintenanceConfigurations, err := workerMaintenanceConfigurations(r.decoder, worker)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	maintenanceConfigurationID, ok := maintenanceConfigurations[machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]]
+	if !ok {
+		return reconcile.Result{}, nil
+	}
+
+	assigner := &maintenanceAssigner{
+		client: r.client,
+		region: worker.Spec.Region,
+		newAssignmentsClientFn: func() (azureclient.MaintenanceAssignments, error) {
+			cluster, err := extensionscontroller.GetCluster(ctx, r.client, worker.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			factory, err := newClientFactory(ctx, r.client, worker, cluster)
+			if err != nil {
+				return nil, err
+			}
+			return factory.MaintenanceAssignments()
+		},
+	}
+	return reconcile.Result{}, assigner.reconcile(ctx, machine, maintenanceConfigurationID)
+}
+
+func (r *maintenanceReconciler) getWorker(ctx context.Context, namespace string) (*extensionsv1alpha1.Worker, error) {
+	workerList := &extensionsv1alpha1.WorkerList{}
+	if err := r.client.List(ctx, workerList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list workers: %w", err)
+	}
+	for i, worker := range workerList.Items {
+		if worker.Spec.Type == azure.Type {
+			return &workerList.Items[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/install"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
+)
+
+var _ = Describe("MaintenanceReconciler", func() {
+	const (
+		namespace       = "shoot--foo--bar"
+		region          = "westeurope"
+		vmID            = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Compute/virtualMachines/machine-1"
+		configurationID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Maintenance/maintenanceConfigurations/config"
+	)
+
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		c           client.Client
+		factory     *mockazureclient.MockFactory
+		assignments *mockazureclient.MockMaintenanceAssignments
+		reconciler  reconcile.Reconciler
+
+		worker  *extensionsv1alpha1.Worker
+		machine *machinev1alpha1.Machine
+		request reconcile.Request
+
+		oldFactoryFunc = NewAzureClientFactoryFunc
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		factory = mockazureclient.NewMockFactory(ctrl)
+		assignments = mockazureclient.NewMockMaintenanceAssignments(ctrl)
+		factory.EXPECT().MaintenanceAssignments().Return(assignments, nil).AnyTimes()
+		NewAzureClientFactoryFunc = func(_ context.Context, _ client.Client, _ corev1.SecretReference, _ bool, _ ...azureclient.AzureFactoryOption) (azureclient.Factory, error) {
+			return factory, nil
+		}
+
+		worker = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: namespace},
+			Spec: extensionsv1alpha1.WorkerSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: azure.Type},
+				Region:      region,
+				SecretRef:   corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+				Pools: []extensionsv1alpha1.WorkerPool{
+					{
+						Name: "maintained",
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(
+							`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","maintenanceConfiguration":"` + configurationID + `"}`,
+						)},
+					},
+					{Name: "other"},
+				},
+			},
+		}
+		machine = &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: namespace},
+			Spec: machinev1alpha1.MachineSpec{
+				ProviderID: "azure://" + vmID,
+				NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{v1beta1constants.LabelWorkerPool: "maintained"}},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(machine)}
+
+		shootRaw, err := json.Marshal(&gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Region: region}})
+		Expect(err).NotTo(HaveOccurred())
+		cluster := &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec: extensionsv1alpha1.ClusterSpec{
+				CloudProfile: runtime.RawExtension{Raw: []byte(`{}`)},
+				Seed:         runtime.RawExtension{Raw: []byte(`{}`)},
+				Shoot:        runtime.RawExtension{Raw: shootRaw},
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		install.Install(scheme)
+
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(worker, cluster).Build()
+		reconciler = NewMaintenanceReconciler(c, scheme)
+	})
+
+	AfterEach(func() {
+		NewAzureClientFactoryFunc = oldFactoryFunc
+	})
+
+	It("should assign the maintenance configuration to the virtual machine of a new machine", func() {
+		Expect(c.Create(ctx, machine)).To(Succeed())
+		assignments.EXPECT().Get(ctx, vmID, "gardener").Return(nil, nil)
+		assignments.EXPECT().CreateOrUpdate(ctx, vmID, "gardener", azureclient.MaintenanceAssignment{
+			Location: ptr.To(region),
+			Properties: &azureclient.MaintenanceAssignmentProperties{
+				MaintenanceConfigurationID: ptr.To(configurationID),
+				ResourceID:                 ptr.To(vmID),
+			},
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, machine)).To(Succeed())
+		Expect(machine.Annotations).To(HaveKeyWithValue(azure.MaintenanceConfigurationAnnotation, configurationID))
+	})
+
+	It("should ignore machines of worker pools without maintenance configuration", func() {
+		machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool] = "other"
+		Expect(c.Create(ctx, machine)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, machine)).To(Succeed())
+		Expect(machine.Annotations).NotTo(HaveKey(azure.MaintenanceConfigurationAnnotation))
+	})
+
+	It("should ignore machines whose virtual machine was not created yet", func() {
+		machine.Spec.ProviderID = ""
+		Expect(c.Create(ctx, machine)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should ignore machines of workers which are being deleted", func() {
+		worker.Finalizers = []string{"foo"}
+		Expect(c.Update(ctx, worker)).To(Succeed())
+		Expect(c.Delete(ctx, worker)).To(Succeed())
+		Expect(c.Create(ctx, machine)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should ignore machines which do not exist anymore", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+})
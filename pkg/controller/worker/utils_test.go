@@ -19,6 +19,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apiazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
@@ -29,13 +30,17 @@ import (
 )
 
 func wrapNewWorkerDelegate(client *mockclient.MockClient, seedChartApplier *mockkubernetes.MockChartApplier, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster, factory azureclient.Factory) genericactuator.WorkerDelegate {
+	return wrapNewWorkerDelegateWithRecorder(client, record.NewFakeRecorder(100), seedChartApplier, worker, cluster, factory, nil)
+}
+
+func wrapNewWorkerDelegateWithRecorder(client *mockclient.MockClient, recorder record.EventRecorder, seedChartApplier *mockkubernetes.MockChartApplier, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster, factory azureclient.Factory, mandatoryVMTags map[string]string) genericactuator.WorkerDelegate {
 	expectGetSecretCallToWork(client, worker)
 
 	scheme := runtime.NewScheme()
 	_ = apiazure.AddToScheme(scheme)
 	_ = v1alpha1.AddToScheme(scheme)
 
-	workerDelegate, err := NewWorkerDelegate(client, scheme, seedChartApplier, "", worker, cluster, factory)
+	workerDelegate, err := NewWorkerDelegate(client, scheme, recorder, seedChartApplier, "", worker, cluster, factory, mandatoryVMTags)
 	Expect(err).NotTo(HaveOccurred())
 	return workerDelegate
 }
@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// Severity is the severity of a finding.
+type Severity string
+
+const (
+	// SeverityError is used for findings which break the egress traffic of the node.
+	SeverityError Severity = "Error"
+	// SeverityWarning is used for findings which may break the egress traffic of the node.
+	SeverityWarning Severity = "Warning"
+	// SeverityInfo is used for findings which explain how the egress traffic of the node leaves the VNet.
+	SeverityInfo Severity = "Info"
+)
+
+const (
+	// ReasonSubnetNotFound is reported if the subnet of the network interface does not exist.
+	ReasonSubnetNotFound = "SubnetNotFound"
+	// ReasonNatGatewayNotAssociated is reported if the subnet of the network interface is not associated with the
+	// NAT gateway of the infrastructure.
+	ReasonNatGatewayNotAssociated = "NatGatewayNotAssociated"
+	// ReasonNatGatewayMismatch is reported if the subnet of the network interface is associated with another NAT gateway
+	// than the one recorded in the infrastructure status.
+	ReasonNatGatewayMismatch = "NatGatewayMismatch"
+	// ReasonLoadBalancerBackendPoolMissing is reported if the network interface is not part of a backend pool of the
+	// load balancer which provides the outbound connectivity.
+	ReasonLoadBalancerBackendPoolMissing = "LoadBalancerBackendPoolMissing"
+	// ReasonNoDefaultRoute is reported if no route for 0.0.0.0/0 is effective for the network interface.
+	ReasonNoDefaultRoute = "NoDefaultRoute"
+	// ReasonBlackholeRoute is reported if traffic is dropped by a route with the next hop type None.
+	ReasonBlackholeRoute = "BlackholeRoute"
+	// ReasonDefaultRouteOverridden is reported if the default route sends the traffic to a virtual appliance or a
+	// virtual network gateway instead of the internet.
+	ReasonDefaultRouteOverridden = "DefaultRouteOverridden"
+	// ReasonOutboundTrafficDenied is reported if an effective security rule denies HTTPS traffic to the internet.
+	ReasonOutboundTrafficDenied = "OutboundTrafficDenied"
+)
+
+// Finding is a single observation about the egress traffic of a node.
+type Finding struct {
+	// Severity is the severity of the finding.
+	Severity Severity `json:"severity"`
+	// Reason is a machine-readable reason of the finding.
+	Reason string `json:"reason"`
+	// Message is a human-readable explanation of the finding.
+	Message string `json:"message"`
+}
+
+// EgressReport is the result of the egress diagnosis of a node.
+type EgressReport struct {
+	// NetworkInterface is the ID of the diagnosed network interface.
+	NetworkInterface string `json:"networkInterface"`
+	// Subnet is the ID of the subnet of the network interface.
+	Subnet string `json:"subnet,omitempty"`
+	// OutboundAccessType is the type of outbound access recorded in the infrastructure status.
+	OutboundAccessType azure.OutboundAccessType `json:"outboundAccessType,omitempty"`
+	// NatGateway is the ID of the NAT gateway associated with the subnet.
+	NatGateway string `json:"natGateway,omitempty"`
+	// DefaultRoute is the next hop type of the effective route for 0.0.0.0/0.
+	DefaultRoute string `json:"defaultRoute,omitempty"`
+	// Findings are the observations about the egress traffic. The egress traffic is likely broken if any finding has the
+	// severity Error.
+	Findings []Finding `json:"findings"`
+}
+
+// HasErrors returns true if the report contains a finding with the severity Error.
+func (r *EgressReport) HasErrors() bool {
+	return slices.ContainsFunc(r.Findings, func(f Finding) bool { return f.Severity == SeverityError })
+}
+
+func (r *EgressReport) add(severity Severity, reason, format string, args ...any) {
+	r.Findings = append(r.Findings, Finding{Severity: severity, Reason: reason, Message: fmt.Sprintf(format, args...)})
+}
+
+// DiagnoseEgress queries the subnet, the effective routes and the effective security rules of the given network
+// interface of a node and reports why the egress traffic of the node might be broken.
+func DiagnoseEgress(ctx context.Context, factory azureclient.Factory, status *azure.InfrastructureStatus, networkInterfaceName string) (*EgressReport, error) {
+	nicClient, err := factory.NetworkInterface()
+	if err != nil {
+		return nil, err
+	}
+	nic, err := nicClient.Get(ctx, status.ResourceGroup.Name, networkInterfaceName)
+	if err != nil {
+		return nil, err
+	}
+	if nic == nil {
+		return nil, fmt.Errorf("network interface %s/%s not found", status.ResourceGroup.Name, networkInterfaceName)
+	}
+
+	report := &EgressReport{
+		NetworkInterface:   ptr.Deref(nic.ID, networkInterfaceName),
+		OutboundAccessType: status.Networks.OutboundAccessType,
+		Findings:           []Finding{},
+	}
+
+	var ipConfigurations []*armnetwork.InterfaceIPConfiguration
+	if nic.Properties != nil {
+		ipConfigurations = nic.Properties.IPConfigurations
+	}
+
+	if err := diagnoseSubnet(ctx, factory, status, primaryIPConfiguration(ipConfigurations), report); err != nil {
+		return nil, err
+	}
+	if status.Networks.OutboundAccessType == azure.OutboundAccessTypeLoadBalancer {
+		diagnoseLoadBalancerBackendPools(status, ipConfigurations, report)
+	}
+
+	routes, err := nicClient.GetEffectiveRouteTable(ctx, status.ResourceGroup.Name, networkInterfaceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get effective routes of network interface %s: %w", networkInterfaceName, err)
+	}
+	diagnoseRoutes(routes, report)
+
+	securityGroups, err := nicClient.ListEffectiveNetworkSecurityGroups(ctx, status.ResourceGroup.Name, networkInterfaceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list effective security groups of network interface %s: %w", networkInterfaceName, err)
+	}
+	diagnoseSecurityGroups(securityGroups, report)
+
+	return report, nil
+}
+
+func primaryIPConfiguration(ipConfigurations []*armnetwork.InterfaceIPConfiguration) *armnetwork.InterfaceIPConfiguration {
+	for _, ipConfiguration := range ipConfigurations {
+		if ipConfiguration.Properties != nil && ptr.Deref(ipConfiguration.Properties.Primary, false) {
+			return ipConfiguration
+		}
+	}
+	if len(ipConfigurations) > 0 {
+		return ipConfigurations[0]
+	}
+	return nil
+}
+
+func diagnoseSubnet(ctx context.Context, factory azureclient.Factory, status *azure.InfrastructureStatus, ipConfiguration *armnetwork.InterfaceIPConfiguration, report *EgressReport) error {
+	if ipConfiguration == nil || ipConfiguration.Properties == nil || ipConfiguration.Properties.Subnet == nil || ipConfiguration.Properties.Subnet.ID == nil {
+		report.add(SeverityError, ReasonSubnetNotFound, "the network interface is not connected to a subnet")
+		return nil
+	}
+
+	report.Subnet = *ipConfiguration.Properties.Subnet.ID
+	subnetID, err := arm.ParseResourceID(report.Subnet)
+	if err != nil {
+		return fmt.Errorf("failed to parse subnet ID %s: %w", report.Subnet, err)
+	}
+
+	subnetClient, err := factory.Subnet()
+	if err != nil {
+		return err
+	}
+	subnet, err := subnetClient.Get(ctx, subnetID.ResourceGroupName, subnetID.Parent.Name, subnetID.Name, nil)
+	if err != nil {
+		return err
+	}
+	if subnet == nil {
+		report.add(SeverityError, ReasonSubnetNotFound, "subnet %s does not exist", report.Subnet)
+		return nil
+	}
+
+	var actualNatGatewayID string
+	if subnet.Properties != nil && subnet.Properties.NatGateway != nil {
+		actualNatGatewayID = ptr.Deref(subnet.Properties.NatGateway.ID, "")
+	}
+	report.NatGateway = actualNatGatewayID
+
+	var expectedNatGatewayID string
+	for _, s := range status.Networks.Subnets {
+		if strings.EqualFold(s.Name, subnetID.Name) {
+			expectedNatGatewayID = ptr.Deref(s.NatGatewayID, "")
+			break
+		}
+	}
+
+	switch {
+	case expectedNatGatewayID != "" && actualNatGatewayID == "":
+		report.add(SeverityError, ReasonNatGatewayNotAssociated, "subnet %s is not associated with NAT gateway %s", subnetID.Name, expectedNatGatewayID)
+	case expectedNatGatewayID != "" && !strings.EqualFold(expectedNatGatewayID, actualNatGatewayID):
+		report.add(SeverityError, ReasonNatGatewayMismatch, "subnet %s is associated with NAT gateway %s instead of %s", subnetID.Name, actualNatGatewayID, expectedNatGatewayID)
+	case status.Networks.OutboundAccessType == azure.OutboundAccessTypeNatGateway && actualNatGatewayID == "":
+		report.add(SeverityError, ReasonNatGatewayNotAssociated, "the outbound access type is %s, but subnet %s is not associated with a NAT gateway", azure.OutboundAccessTypeNatGateway, subnetID.Name)
+	}
+	return nil
+}
+
+func diagnoseLoadBalancerBackendPools(status *azure.InfrastructureStatus, ipConfigurations []*armnetwork.InterfaceIPConfiguration, report *EgressReport) {
+	loadBalancer := "/loadBalancers/"
+	if status.Networks.OutboundLoadBalancer != nil {
+		loadBalancer += strings.ToLower(status.Networks.OutboundLoadBalancer.Name) + "/"
+	}
+
+	for _, ipConfiguration := range ipConfigurations {
+		if ipConfiguration.Properties == nil {
+			continue
+		}
+		for _, pool := range ipConfiguration.Properties.LoadBalancerBackendAddressPools {
+			if strings.Contains(strings.ToLower(ptr.Deref(pool.ID, "")), loadBalancer) {
+				return
+			}
+		}
+	}
+
+	report.add(SeverityWarning, ReasonLoadBalancerBackendPoolMissing, "the outbound access type is %s, but the network interface is not part of a backend pool of the load balancer; the cloud-controller-manager adds it once the node is ready", azure.OutboundAccessTypeLoadBalancer)
+}
+
+func diagnoseRoutes(routes []*armnetwork.EffectiveRoute, report *EgressReport) {
+	var defaultRoute *armnetwork.EffectiveRoute
+	for _, route := range routes {
+		if ptr.Deref(route.State, armnetwork.EffectiveRouteStateActive) != armnetwork.EffectiveRouteStateActive {
+			continue
+		}
+
+		prefixes := derefStrings(route.AddressPrefix)
+		if slices.Contains(prefixes, "0.0.0.0/0") {
+			defaultRoute = route
+			continue
+		}
+		if ptr.Deref(route.NextHopType, "") == armnetwork.RouteNextHopTypeNone && ptr.Deref(route.Source, "") == armnetwork.EffectiveRouteSourceUser {
+			report.add(SeverityWarning, ReasonBlackholeRoute, "user defined route %q drops the traffic to %s", ptr.Deref(route.Name, ""), strings.Join(prefixes, ", "))
+		}
+	}
+
+	if defaultRoute == nil {
+		report.add(SeverityError, ReasonNoDefaultRoute, "no route for 0.0.0.0/0 is effective for the network interface")
+		return
+	}
+
+	nextHopType := ptr.Deref(defaultRoute.NextHopType, "")
+	report.DefaultRoute = string(nextHopType)
+	switch nextHopType {
+	case armnetwork.RouteNextHopTypeNone:
+		report.add(SeverityError, ReasonBlackholeRoute, "the route for 0.0.0.0/0 (source %s) drops all traffic to the internet", ptr.Deref(defaultRoute.Source, ""))
+	case armnetwork.RouteNextHopTypeVirtualAppliance, armnetwork.RouteNextHopTypeVirtualNetworkGateway:
+		report.add(SeverityInfo, ReasonDefaultRouteOverridden, "the route for 0.0.0.0/0 (source %s) sends the traffic to %s %s instead of the internet, hence the NAT gateway and the load balancer are bypassed",
+			ptr.Deref(defaultRoute.Source, ""), nextHopType, strings.Join(derefStrings(defaultRoute.NextHopIPAddress), ", "))
+	}
+}
+
+func diagnoseSecurityGroups(securityGroups []*armnetwork.EffectiveNetworkSecurityGroup, report *EgressReport) {
+	// The security groups of the subnet and of the network interface are evaluated independently, the traffic must be
+	// allowed by both.
+	for _, securityGroup := range securityGroups {
+		var rules []*armnetwork.EffectiveNetworkSecurityRule
+		for _, rule := range securityGroup.EffectiveSecurityRules {
+			if ptr.Deref(rule.Direction, "") == armnetwork.SecurityRuleDirectionOutbound && matchesInternetHTTPS(rule) {
+				rules = append(rules, rule)
+			}
+		}
+		if len(rules) == 0 {
+			continue
+		}
+
+		slices.SortStableFunc(rules, func(a, b *armnetwork.EffectiveNetworkSecurityRule) int {
+			return int(ptr.Deref(a.Priority, 0) - ptr.Deref(b.Priority, 0))
+		})
+		if rule := rules[0]; ptr.Deref(rule.Access, "") == armnetwork.SecurityRuleAccessDeny {
+			var securityGroupID string
+			if securityGroup.NetworkSecurityGroup != nil {
+				securityGroupID = ptr.Deref(securityGroup.NetworkSecurityGroup.ID, "")
+			}
+			report.add(SeverityError, ReasonOutboundTrafficDenied, "security rule %q (priority %d) of security group %s denies HTTPS traffic to the internet",
+				ptr.Deref(rule.Name, ""), ptr.Deref(rule.Priority, 0), securityGroupID)
+		}
+	}
+}
+
+// matchesInternetHTTPS checks whether the rule applies to HTTPS traffic to the internet.
+func matchesInternetHTTPS(rule *armnetwork.EffectiveNetworkSecurityRule) bool {
+	if protocol := ptr.Deref(rule.Protocol, armnetwork.EffectiveSecurityRuleProtocolAll); protocol != armnetwork.EffectiveSecurityRuleProtocolAll && protocol != armnetwork.EffectiveSecurityRuleProtocolTCP {
+		return false
+	}
+
+	destinations := append(derefStrings(rule.DestinationAddressPrefixes), ptr.Deref(rule.DestinationAddressPrefix, ""))
+	if !slices.ContainsFunc(destinations, func(destination string) bool {
+		return destination == "*" || destination == "0.0.0.0/0" || strings.EqualFold(destination, "Internet")
+	}) {
+		return false
+	}
+
+	ports := append(derefStrings(rule.DestinationPortRanges), ptr.Deref(rule.DestinationPortRange, ""))
+	return slices.ContainsFunc(ports, func(portRange string) bool { return portRangeContains(portRange, 443) })
+}
+
+func portRangeContains(portRange string, port int) bool {
+	if portRange == "*" {
+		return true
+	}
+	from, to, found := strings.Cut(portRange, "-")
+	if !found {
+		to = from
+	}
+	fromPort, err := strconv.Atoi(from)
+	if err != nil {
+		return false
+	}
+	toPort, err := strconv.Atoi(to)
+	if err != nil {
+		return false
+	}
+	return fromPort <= port && port <= toPort
+}
+
+func derefStrings(values []*string) []string {
+	var result []string
+	for _, value := range values {
+		if value != nil {
+			result = append(result, *value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnostics_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/diagnostics"
+)
+
+var _ = Describe("Egress", func() {
+	const (
+		resourceGroup = "shoot--foo--bar"
+		nicName       = "shoot--foo--bar-worker-z1-abcde-nic"
+		subnetID      = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/virtualNetworks/shoot--foo--bar/subnets/shoot--foo--bar-nodes"
+		natID         = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/natGateways/shoot--foo--bar-nat-gateway"
+		nsgID         = "/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/networkSecurityGroups/shoot--foo--bar-workers"
+	)
+
+	var (
+		ctx = context.Background()
+
+		ctrl    *gomock.Controller
+		factory *mockclient.MockFactory
+		nics    *mockclient.MockNetworkInterface
+		subnets *mockclient.MockSubnet
+
+		status         *azure.InfrastructureStatus
+		nic            *armnetwork.Interface
+		subnet         *armnetwork.Subnet
+		routes         []*armnetwork.EffectiveRoute
+		securityGroups []*armnetwork.EffectiveNetworkSecurityGroup
+
+		findings = func(report *EgressReport) []string {
+			var reasons []string
+			for _, finding := range report.Findings {
+				reasons = append(reasons, string(finding.Severity)+"/"+finding.Reason)
+			}
+			return reasons
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		nics = mockclient.NewMockNetworkInterface(ctrl)
+		subnets = mockclient.NewMockSubnet(ctrl)
+		factory.EXPECT().NetworkInterface().Return(nics, nil).AnyTimes()
+		factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+
+		status = &azure.InfrastructureStatus{
+			ResourceGroup: azure.ResourceGroup{Name: resourceGroup},
+			Networks: azure.NetworkStatus{
+				OutboundAccessType: azure.OutboundAccessTypeNatGateway,
+				Subnets: []azure.Subnet{{
+					Name:         "shoot--foo--bar-nodes",
+					Purpose:      azure.PurposeNodes,
+					NatGatewayID: to.Ptr(natID),
+				}},
+			},
+		}
+		nic = &armnetwork.Interface{
+			ID: to.Ptr("/subscriptions/sub/resourceGroups/shoot--foo--bar/providers/Microsoft.Network/networkInterfaces/" + nicName),
+			Properties: &armnetwork.InterfacePropertiesFormat{
+				IPConfigurations: []*armnetwork.InterfaceIPConfiguration{{
+					Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+						Primary: to.Ptr(true),
+						Subnet:  &armnetwork.Subnet{ID: to.Ptr(subnetID)},
+					},
+				}},
+			},
+		}
+		subnet = &armnetwork.Subnet{
+			ID: to.Ptr(subnetID),
+			Properties: &armnetwork.SubnetPropertiesFormat{
+				NatGateway: &armnetwork.SubResource{ID: to.Ptr(natID)},
+			},
+		}
+		routes = []*armnetwork.EffectiveRoute{
+			{
+				AddressPrefix: []*string{to.Ptr("10.250.0.0/16")},
+				NextHopType:   to.Ptr(armnetwork.RouteNextHopTypeVnetLocal),
+				Source:        to.Ptr(armnetwork.EffectiveRouteSourceDefault),
+				State:         to.Ptr(armnetwork.EffectiveRouteStateActive),
+			},
+			{
+				AddressPrefix: []*string{to.Ptr("0.0.0.0/0")},
+				NextHopType:   to.Ptr(armnetwork.RouteNextHopTypeInternet),
+				Source:        to.Ptr(armnetwork.EffectiveRouteSourceDefault),
+				State:         to.Ptr(armnetwork.EffectiveRouteStateActive),
+			},
+		}
+		securityGroups = []*armnetwork.EffectiveNetworkSecurityGroup{{
+			NetworkSecurityGroup: &armnetwork.SubResource{ID: to.Ptr(nsgID)},
+			EffectiveSecurityRules: []*armnetwork.EffectiveNetworkSecurityRule{{
+				Name:                     to.Ptr("defaultSecurityRules/AllowInternetOutBound"),
+				Access:                   to.Ptr(armnetwork.SecurityRuleAccessAllow),
+				Direction:                to.Ptr(armnetwork.SecurityRuleDirectionOutbound),
+				Protocol:                 to.Ptr(armnetwork.EffectiveSecurityRuleProtocolAll),
+				DestinationAddressPrefix: to.Ptr("Internet"),
+				DestinationPortRange:     to.Ptr("0-65535"),
+				Priority:                 to.Ptr[int32](65001),
+			}},
+		}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	diagnose := func() *EgressReport {
+		nics.EXPECT().Get(ctx, resourceGroup, nicName).Return(nic, nil)
+		subnets.EXPECT().Get(ctx, resourceGroup, "shoot--foo--bar", "shoot--foo--bar-nodes", nil).Return(subnet, nil)
+		nics.EXPECT().GetEffectiveRouteTable(ctx, resourceGroup, nicName).Return(routes, nil)
+		nics.EXPECT().ListEffectiveNetworkSecurityGroups(ctx, resourceGroup, nicName).Return(securityGroups, nil)
+
+		report, err := DiagnoseEgress(ctx, factory, status, nicName)
+		Expect(err).NotTo(HaveOccurred())
+		return report
+	}
+
+	It("should report no findings for a healthy node", func() {
+		report := diagnose()
+
+		Expect(report).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Subnet":       Equal(subnetID),
+			"NatGateway":   Equal(natID),
+			"DefaultRoute": Equal("Internet"),
+			"Findings":     BeEmpty(),
+		})))
+		Expect(report.HasErrors()).To(BeFalse())
+	})
+
+	It("should report a missing NAT gateway association", func() {
+		subnet.Properties.NatGateway = nil
+
+		report := diagnose()
+
+		Expect(findings(report)).To(ConsistOf("Error/NatGatewayNotAssociated"))
+		Expect(report.HasErrors()).To(BeTrue())
+	})
+
+	It("should report a NAT gateway which differs from the infrastructure status", func() {
+		subnet.Properties.NatGateway.ID = to.Ptr(natID + "-other")
+
+		Expect(findings(diagnose())).To(ConsistOf("Error/NatGatewayMismatch"))
+	})
+
+	It("should report a blackhole default route", func() {
+		routes[1].NextHopType = to.Ptr(armnetwork.RouteNextHopTypeNone)
+		routes[1].Source = to.Ptr(armnetwork.EffectiveRouteSourceUser)
+
+		Expect(findings(diagnose())).To(ConsistOf("Error/BlackholeRoute"))
+	})
+
+	It("should report a default route to a virtual appliance", func() {
+		routes[1].NextHopType = to.Ptr(armnetwork.RouteNextHopTypeVirtualAppliance)
+		routes[1].NextHopIPAddress = []*string{to.Ptr("10.0.0.4")}
+
+		Expect(findings(diagnose())).To(ConsistOf("Info/DefaultRouteOverridden"))
+	})
+
+	It("should report a missing default route", func() {
+		routes[1].State = to.Ptr(armnetwork.EffectiveRouteStateInvalid)
+
+		Expect(findings(diagnose())).To(ConsistOf("Error/NoDefaultRoute"))
+	})
+
+	It("should report a security rule denying the egress traffic", func() {
+		securityGroups[0].EffectiveSecurityRules = append(securityGroups[0].EffectiveSecurityRules, &armnetwork.EffectiveNetworkSecurityRule{
+			Name:                       to.Ptr("deny-internet"),
+			Access:                     to.Ptr(armnetwork.SecurityRuleAccessDeny),
+			Direction:                  to.Ptr(armnetwork.SecurityRuleDirectionOutbound),
+			Protocol:                   to.Ptr(armnetwork.EffectiveSecurityRuleProtocolTCP),
+			DestinationAddressPrefixes: []*string{to.Ptr("0.0.0.0/0")},
+			DestinationPortRanges:      []*string{to.Ptr("80"), to.Ptr("443")},
+			Priority:                   to.Ptr[int32](100),
+		})
+
+		Expect(findings(diagnose())).To(ConsistOf("Error/OutboundTrafficDenied"))
+	})
+
+	It("should report a network interface which is not in the backend pool of the outbound load balancer", func() {
+		status.Networks.OutboundAccessType = azure.OutboundAccessTypeLoadBalancer
+		status.Networks.Subnets[0].NatGatewayID = nil
+		status.Networks.OutboundLoadBalancer = &azure.OutboundLoadBalancerStatus{Name: "shoot--foo--bar"}
+		subnet.Properties.NatGateway = nil
+
+		Expect(findings(diagnose())).To(ConsistOf("Warning/LoadBalancerBackendPoolMissing"))
+	})
+
+	It("should fail if the network interface does not exist", func() {
+		nics.EXPECT().Get(ctx, resourceGroup, nicName).Return(nil, nil)
+
+		_, err := DiagnoseEgress(ctx, factory, status, nicName)
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+})
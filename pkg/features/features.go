@@ -9,6 +9,14 @@ const (
 	// DisableRemedyController controls whether the azure provider will disable the remedy-controller. Technically it will still be deployed, but scaled down to zero.
 	// alpha: v1.29.0
 	DisableRemedyController featuregate.Feature = "DisableRemedyController"
+	// PublicIPDDoSProtection controls whether the flow-based infrastructure reconciliation applies the DDoS protection
+	// configured for the public IPs of NAT gateways.
+	// alpha: v1.50.0
+	PublicIPDDoSProtection featuregate.Feature = "PublicIPDDoSProtection"
+	// BackupBucketInventory controls whether the backup bucket controller manages the blob inventory policies of the
+	// backup storage accounts as configured in the BackupBucketConfig.
+	// alpha: v1.50.0
+	BackupBucketInventory featuregate.Feature = "BackupBucketInventory"
 )
 
 // ExtensionFeatureGate is the feature gate for the extension controllers.
@@ -22,5 +30,7 @@ func init() {
 func RegisterExtensionFeatureGate() {
 	runtime.Must(ExtensionFeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
 		DisableRemedyController: {Default: false, PreRelease: featuregate.Alpha},
+		PublicIPDDoSProtection:  {Default: false, PreRelease: featuregate.Alpha},
+		BackupBucketInventory:   {Default: false, PreRelease: featuregate.Alpha},
 	}))
 }
@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	corev1 "k8s.io/api/core/v1"
@@ -29,9 +31,12 @@ type ClientAuth struct {
 	ClientSecret string `yaml:"clientSecret"`
 }
 
-// GetAzClientCredentials returns the credential struct consumed by the Azure client
-func (clientAuth ClientAuth) GetAzClientCredentials() (*azidentity.ClientSecretCredential, error) {
-	return azidentity.NewClientSecretCredential(clientAuth.TenantID, clientAuth.ClientID, clientAuth.ClientSecret, nil)
+// GetAzClientCredentials returns the credential struct consumed by the Azure client. The access tokens are acquired
+// from the authority host of the given cloud configuration, e.g. the one of a private Azure Stack Hub instance.
+func (clientAuth ClientAuth) GetAzClientCredentials(cloudConfiguration cloud.Configuration) (*azidentity.ClientSecretCredential, error) {
+	return azidentity.NewClientSecretCredential(clientAuth.TenantID, clientAuth.ClientID, clientAuth.ClientSecret, &azidentity.ClientSecretCredentialOptions{
+		ClientOptions: azcore.ClientOptions{Cloud: cloudConfiguration},
+	})
 }
 
 // GetClientAuthData retrieves the client auth data specified by the secret reference.
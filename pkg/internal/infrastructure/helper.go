@@ -8,16 +8,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
@@ -63,7 +66,7 @@ func MakeCluster(pods, services string, region string, countFaultDomain, countUp
 
 // IsShootResourceGroupAvailable determines if the managed resource group exists on Azure.
 func IsShootResourceGroupAvailable(ctx context.Context, factory azureclient.Factory, infra *extensionsv1alpha1.Infrastructure, infraConfig *api.InfrastructureConfig) (bool, error) {
-	if infraConfig.ResourceGroup != nil {
+	if helper.IsUsingExistingResourceGroup(infraConfig) {
 		return true, nil
 	}
 
@@ -117,7 +120,7 @@ func DeleteNodeSubnetIfExists(ctx context.Context, factory azureclient.Factory,
 // DeleteShootResourceGroupIfExists will delete the shoot's resource group if it exists.
 func DeleteShootResourceGroupIfExists(ctx context.Context, factory azureclient.Factory, infra *extensionsv1alpha1.Infrastructure, cfg *api.InfrastructureConfig, status *api.InfrastructureStatus) error {
 	// skip if using user resource group.
-	if cfg.ResourceGroup != nil {
+	if helper.IsUsingExistingResourceGroup(cfg) {
 		return nil
 	}
 
@@ -131,7 +134,7 @@ func DeleteShootResourceGroupIfExists(ctx context.Context, factory azureclient.F
 
 // ShootResourceGroupName returns the expected name of the resource group.
 func ShootResourceGroupName(infra *extensionsv1alpha1.Infrastructure, cfg *api.InfrastructureConfig, status *api.InfrastructureStatus) string {
-	if cfg.ResourceGroup != nil {
+	if helper.IsUsingExistingResourceGroup(cfg) {
 		return cfg.ResourceGroup.Name
 	}
 
@@ -142,7 +145,11 @@ func ShootResourceGroupName(infra *extensionsv1alpha1.Infrastructure, cfg *api.I
 	return infra.Namespace
 }
 
-// PatchProviderStatusAndState patches the infrastructure resource with the given provider status and state.
+// MaxEgressCIDRsHistoryEntries is the maximum number of entries kept in the egress CIDRs history of the infrastructure status.
+const MaxEgressCIDRsHistoryEntries = 10
+
+// PatchProviderStatusAndState patches the infrastructure resource with the given provider status and state. The given
+// conditions are merged into the conditions of the infrastructure resource.
 func PatchProviderStatusAndState(
 	ctx context.Context,
 	runtimeClient client.Client,
@@ -150,9 +157,16 @@ func PatchProviderStatusAndState(
 	status *apiv1alpha1.InfrastructureStatus,
 	state *runtime.RawExtension,
 	egressCidrs []string,
+	conditions ...gardencorev1beta1.Condition,
 ) error {
 	patch := client.MergeFrom(infra.DeepCopy())
 	if status != nil {
+		previousHistory, err := egressCIDRsHistoryFromInfrastructure(infra)
+		if err != nil {
+			return err
+		}
+		status.EgressCIDRsHistory = UpdateEgressCIDRsHistory(previousHistory, egressCidrs, metav1.Now())
+
 		infra.Status.ProviderStatus = &runtime.RawExtension{Object: status}
 		if egressCidrs != nil {
 			infra.Status.EgressCIDRs = egressCidrs
@@ -161,6 +175,51 @@ func PatchProviderStatusAndState(
 	if state != nil {
 		infra.Status.State = state
 	}
+	if len(conditions) > 0 {
+		infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, conditions...)
+	}
 
 	return runtimeClient.Status().Patch(ctx, infra, patch)
 }
+
+// UpdateEgressCIDRsHistory appends the given egress CIDRs to the history if they differ from the most recent entry.
+// The history is capped at MaxEgressCIDRsHistoryEntries, dropping the oldest entries first. Nil egress CIDRs indicate
+// that they are unknown, hence the history is returned unchanged. Empty egress CIDRs are only recorded once the history
+// is not empty, i.e. when dedicated egress IPs were removed.
+func UpdateEgressCIDRsHistory(history []apiv1alpha1.EgressCIDRsHistoryEntry, egressCidrs []string, now metav1.Time) []apiv1alpha1.EgressCIDRsHistoryEntry {
+	if egressCidrs == nil || (len(egressCidrs) == 0 && len(history) == 0) {
+		return history
+	}
+
+	cidrs := slices.Clone(egressCidrs)
+	slices.Sort(cidrs)
+	if len(history) > 0 && slices.Equal(history[len(history)-1].CIDRs, cidrs) {
+		return history
+	}
+
+	history = append(history, apiv1alpha1.EgressCIDRsHistoryEntry{CIDRs: cidrs, Timestamp: now})
+	if len(history) > MaxEgressCIDRsHistoryEntries {
+		history = history[len(history)-MaxEgressCIDRsHistoryEntries:]
+	}
+	return history
+}
+
+func egressCIDRsHistoryFromInfrastructure(infra *extensionsv1alpha1.Infrastructure) ([]apiv1alpha1.EgressCIDRsHistoryEntry, error) {
+	if infra.Status.ProviderStatus == nil {
+		return nil, nil
+	}
+
+	if status, ok := infra.Status.ProviderStatus.Object.(*apiv1alpha1.InfrastructureStatus); ok {
+		return status.EgressCIDRsHistory, nil
+	}
+
+	if infra.Status.ProviderStatus.Raw == nil {
+		return nil, nil
+	}
+
+	status := &apiv1alpha1.InfrastructureStatus{}
+	if err := json.Unmarshal(infra.Status.ProviderStatus.Raw, status); err != nil {
+		return nil, fmt.Errorf("failed to decode infrastructure provider status: %w", err)
+	}
+	return status.EgressCIDRsHistory, nil
+}
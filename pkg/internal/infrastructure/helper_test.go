@@ -7,6 +7,7 @@ package infrastructure_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
@@ -18,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	apiv1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 	mockazureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/internal/infrastructure"
 )
@@ -163,4 +165,45 @@ var _ = Describe("InfrastructureHelper", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("#UpdateEgressCIDRsHistory", func() {
+		var (
+			earlier = metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			now     = metav1.NewTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+		)
+
+		It("should not change the history if the egress CIDRs are unknown", func() {
+			history := []apiv1alpha1.EgressCIDRsHistoryEntry{{CIDRs: []string{"1.2.3.4/32"}, Timestamp: earlier}}
+			Expect(UpdateEgressCIDRsHistory(history, nil, now)).To(Equal(history))
+		})
+
+		It("should not record empty egress CIDRs in an empty history", func() {
+			Expect(UpdateEgressCIDRsHistory(nil, []string{}, now)).To(BeEmpty())
+		})
+
+		It("should not add an entry if the egress CIDRs did not change", func() {
+			history := []apiv1alpha1.EgressCIDRsHistoryEntry{{CIDRs: []string{"1.2.3.4/32", "5.6.7.8/32"}, Timestamp: earlier}}
+			Expect(UpdateEgressCIDRsHistory(history, []string{"5.6.7.8/32", "1.2.3.4/32"}, now)).To(Equal(history))
+		})
+
+		It("should add a sorted entry if the egress CIDRs changed", func() {
+			history := []apiv1alpha1.EgressCIDRsHistoryEntry{{CIDRs: []string{"1.2.3.4/32"}, Timestamp: earlier}}
+			Expect(UpdateEgressCIDRsHistory(history, []string{"9.9.9.9/32", "5.6.7.8/32"}, now)).To(Equal([]apiv1alpha1.EgressCIDRsHistoryEntry{
+				{CIDRs: []string{"1.2.3.4/32"}, Timestamp: earlier},
+				{CIDRs: []string{"5.6.7.8/32", "9.9.9.9/32"}, Timestamp: now},
+			}))
+		})
+
+		It("should drop the oldest entries when exceeding the maximum history size", func() {
+			var history []apiv1alpha1.EgressCIDRsHistoryEntry
+			for i := 0; i < MaxEgressCIDRsHistoryEntries; i++ {
+				history = append(history, apiv1alpha1.EgressCIDRsHistoryEntry{CIDRs: []string{fmt.Sprintf("10.0.0.%d/32", i)}, Timestamp: earlier})
+			}
+
+			result := UpdateEgressCIDRsHistory(history, []string{"1.2.3.4/32"}, now)
+			Expect(result).To(HaveLen(MaxEgressCIDRsHistoryEntries))
+			Expect(result[0].CIDRs).To(Equal([]string{"10.0.0.1/32"}))
+			Expect(result[MaxEgressCIDRsHistoryEntries-1]).To(Equal(apiv1alpha1.EgressCIDRsHistoryEntry{CIDRs: []string{"1.2.3.4/32"}, Timestamp: now}))
+		})
+	})
 })
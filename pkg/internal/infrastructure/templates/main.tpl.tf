@@ -184,7 +184,23 @@ resource "azurerm_nat_gateway_public_ip_association" "{{ $natName }}-ip-associat
 #= Identity
 #===============================================
 
+{{ if or .identity.subscriptionID .identity.tenantID -}}
+provider "azurerm" {
+  alias           = "identity"
+  subscription_id = {{ if .identity.subscriptionID }}"{{ .identity.subscriptionID }}"{{ else }}var.SUBSCRIPTION_ID{{ end }}
+  tenant_id       = {{ if .identity.tenantID }}"{{ .identity.tenantID }}"{{ else }}var.TENANT_ID{{ end }}
+  client_id       = var.CLIENT_ID
+  client_secret   = var.CLIENT_SECRET
+
+  skip_provider_registration = "true"
+  features {}
+}
+
+{{ end -}}
 data "azurerm_user_assigned_identity" "identity" {
+{{- if or .identity.subscriptionID .identity.tenantID }}
+  provider            = azurerm.identity
+{{- end }}
   name                = "{{ .identity.name }}"
   resource_group_name = "{{ .identity.resourceGroup }}"
 }
@@ -231,10 +247,18 @@ output "{{ .outputKeys.routeTableName }}" {
   value = azurerm_route_table.workers.name
 }
 
+output "{{ .outputKeys.routeTableID }}" {
+  value = azurerm_route_table.workers.id
+}
+
 output "{{ .outputKeys.securityGroupName }}" {
   value = azurerm_network_security_group.workers.name
 }
 
+output "{{ .outputKeys.securityGroupID }}" {
+  value = azurerm_network_security_group.workers.id
+}
+
 {{ if .create.availabilitySet -}}
 output "{{ .outputKeys.availabilitySetID }}" {
   value = azurerm_availability_set.workers.id
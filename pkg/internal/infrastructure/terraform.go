@@ -49,8 +49,12 @@ const (
 	TerraformerOutputKeyCountUpdateDomains = "countUpdateDomains"
 	// TerraformerOutputKeyRouteTableName is the key for the routeTableName output
 	TerraformerOutputKeyRouteTableName = "routeTableName"
+	// TerraformerOutputKeyRouteTableID is the key for the routeTableID output
+	TerraformerOutputKeyRouteTableID = "routeTableID"
 	// TerraformerOutputKeySecurityGroupName is the key for the securityGroupName output
 	TerraformerOutputKeySecurityGroupName = "securityGroupName"
+	// TerraformerOutputKeySecurityGroupID is the key for the securityGroupID output
+	TerraformerOutputKeySecurityGroupID = "securityGroupID"
 	// TerraformerOutputKeyIdentityID is the key for the identityID output
 	TerraformerOutputKeyIdentityID = "identityID"
 	// TerraformerOutputKeyIdentityClientID is the key for the identityClientID output
@@ -114,7 +118,9 @@ func ComputeTerraformerTemplateValues(
 			"subnetName":        TerraformerOutputKeySubnetName,
 			"subnetNamePrefix":  TerraformerOutputKeySubnetNamePrefix,
 			"routeTableName":    TerraformerOutputKeyRouteTableName,
+			"routeTableID":      TerraformerOutputKeyRouteTableID,
 			"securityGroupName": TerraformerOutputKeySecurityGroupName,
+			"securityGroupID":   TerraformerOutputKeySecurityGroupID,
 		}
 	)
 
@@ -124,7 +130,7 @@ func ComputeTerraformerTemplateValues(
 	}
 
 	// check if we should use an existing ResourceGroupName or create a new one
-	if config.ResourceGroup != nil {
+	if helper.IsUsingExistingResourceGroup(config) {
 		createResourceGroup = false
 		resourceGroupName = config.ResourceGroup.Name
 	}
@@ -158,6 +164,12 @@ func ComputeTerraformerTemplateValues(
 			"name":          config.Identity.Name,
 			"resourceGroup": config.Identity.ResourceGroup,
 		}
+		if config.Identity.SubscriptionID != nil {
+			identityConfig["subscriptionID"] = *config.Identity.SubscriptionID
+		}
+		if config.Identity.TenantID != nil {
+			identityConfig["tenantID"] = *config.Identity.TenantID
+		}
 		outputKeys["identityID"] = TerraformerOutputKeyIdentityID
 		outputKeys["identityClientID"] = TerraformerOutputKeyIdentityClientID
 	}
@@ -352,8 +364,12 @@ type TerraformState struct {
 	Subnets []terraformSubnet
 	// RouteTableName is the name of the route table.
 	RouteTableName string
+	// RouteTableID is the ID of the route table.
+	RouteTableID string
 	// SecuritGroupName is the name of the security group.
 	SecurityGroupName string
+	// SecurityGroupID is the ID of the security group.
+	SecurityGroupID string
 	// IdentityID is the id of the identity.
 	IdentityID string
 	// IdentityClientID is the client id of the identity.
@@ -371,7 +387,9 @@ func ExtractTerraformState(ctx context.Context, tf terraformer.Terraformer, infr
 	outputKeys := []string{
 		TerraformerOutputKeyResourceGroupName,
 		TerraformerOutputKeyRouteTableName,
+		TerraformerOutputKeyRouteTableID,
 		TerraformerOutputKeySecurityGroupName,
+		TerraformerOutputKeySecurityGroupID,
 		TerraformerOutputKeyVNetName,
 	}
 
@@ -402,7 +420,9 @@ func ExtractTerraformState(ctx context.Context, tf terraformer.Terraformer, infr
 		VNetName:          vars[TerraformerOutputKeyVNetName],
 		ResourceGroupName: vars[TerraformerOutputKeyResourceGroupName],
 		RouteTableName:    vars[TerraformerOutputKeyRouteTableName],
+		RouteTableID:      vars[TerraformerOutputKeyRouteTableID],
 		SecurityGroupName: vars[TerraformerOutputKeySecurityGroupName],
+		SecurityGroupID:   vars[TerraformerOutputKeySecurityGroupID],
 	}
 
 	if config.Networks.VNet.Name != nil && config.Networks.VNet.ResourceGroup != nil {
@@ -470,10 +490,10 @@ func StatusFromTerraformState(config *api.InfrastructureConfig, tfState *Terrafo
 		},
 		AvailabilitySets: []apiv1alpha1.AvailabilitySet{},
 		RouteTables: []apiv1alpha1.RouteTable{
-			{Purpose: apiv1alpha1.PurposeNodes, Name: tfState.RouteTableName},
+			{Purpose: apiv1alpha1.PurposeNodes, Name: tfState.RouteTableName, ID: tfState.RouteTableID, Managed: true},
 		},
 		SecurityGroups: []apiv1alpha1.SecurityGroup{
-			{Name: tfState.SecurityGroupName, Purpose: apiv1alpha1.PurposeNodes},
+			{Name: tfState.SecurityGroupName, ID: tfState.SecurityGroupID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 		},
 		Zoned: false,
 	}
@@ -533,6 +553,9 @@ func ComputeTerraformStatus(ctx context.Context, tf terraformer.Terraformer, inf
 	// Check if ACR access should be configured.
 	if config.Identity != nil && config.Identity.ACRAccess != nil && *config.Identity.ACRAccess && status.Identity != nil {
 		status.Identity.ACRAccess = true
+		if mode := config.Identity.ACRAccessMode; mode != nil {
+			status.Identity.ACRAccessMode = ptr.To(apiv1alpha1.ACRAccessMode(*mode))
+		}
 	}
 
 	status.Networks.OutboundAccessType = apiv1alpha1.OutboundAccessTypeNatGateway
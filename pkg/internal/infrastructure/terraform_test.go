@@ -118,7 +118,9 @@ var _ = Describe("Terraform", func() {
 				"subnetName":        TerraformerOutputKeySubnetName,
 				"subnetNamePrefix":  TerraformerOutputKeySubnetNamePrefix,
 				"routeTableName":    TerraformerOutputKeyRouteTableName,
+				"routeTableID":      TerraformerOutputKeyRouteTableID,
 				"securityGroupName": TerraformerOutputKeySecurityGroupName,
+				"securityGroupID":   TerraformerOutputKeySecurityGroupID,
 			}
 
 			expectedNatGatewayValues = map[string]interface{}{
@@ -334,6 +336,28 @@ var _ = Describe("Terraform", func() {
 			Expect(values).To(BeEquivalentTo(expectedValues))
 		})
 
+		It("should correctly compute terraform chart values with an identity of another subscription and tenant", func() {
+			config.Identity = &api.IdentityConfig{
+				Name:           "identity-name",
+				ResourceGroup:  "identity-rg",
+				SubscriptionID: ptr.To("00000000-0000-0000-0000-000000000001"),
+				TenantID:       ptr.To("00000000-0000-0000-0000-000000000002"),
+			}
+
+			expectedValues["identity"] = map[string]interface{}{
+				"name":           "identity-name",
+				"resourceGroup":  "identity-rg",
+				"subscriptionID": "00000000-0000-0000-0000-000000000001",
+				"tenantID":       "00000000-0000-0000-0000-000000000002",
+			}
+			expectedOutputKeysValues["identityID"] = TerraformerOutputKeyIdentityID
+			expectedOutputKeysValues["identityClientID"] = TerraformerOutputKeyIdentityClientID
+
+			values, err := ComputeTerraformerTemplateValues(infra, config, cluster)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(values).To(BeEquivalentTo(expectedValues))
+		})
+
 		It("should correctly compute terraform chart values with ddos protection plan id assigned to the vnet", func() {
 			var ddosProtectionPlanID = "/subscriptions/test/resourceGroups/test/providers/Microsoft.Network/ddosProtectionPlans/test-ddos-protection-plan"
 
@@ -517,9 +541,9 @@ var _ = Describe("Terraform", func() {
 
 	Describe("#StatusFromTerraformState", func() {
 		var (
-			vnetName, subnetName, routeTableName, availabilitySetID, availabilitySetName, securityGroupName, resourceGroupName string
-			state                                                                                                              *TerraformState
-			config                                                                                                             *api.InfrastructureConfig
+			vnetName, subnetName, routeTableName, routeTableID, availabilitySetID, availabilitySetName, securityGroupName, securityGroupID, resourceGroupName string
+			state                                                                                                                                             *TerraformState
+			config                                                                                                                                            *api.InfrastructureConfig
 		)
 
 		BeforeEach(func() {
@@ -527,9 +551,9 @@ var _ = Describe("Terraform", func() {
 
 			vnetName = "vnet_name"
 			subnetName = "subnet_name"
-			routeTableName = "routTable_name"
+			routeTableName, routeTableID = "routTable_name", "routeTable_id"
 			availabilitySetID, availabilitySetName = "as_id", "as_name"
-			securityGroupName = "sg_name"
+			securityGroupName, securityGroupID = "sg_name", "sg_id"
 			resourceGroupName = "rg_name"
 			config = &api.InfrastructureConfig{
 				Networks: api.NetworkConfig{
@@ -546,9 +570,11 @@ var _ = Describe("Terraform", func() {
 					},
 				},
 				RouteTableName:      routeTableName,
+				RouteTableID:        routeTableID,
 				AvailabilitySetID:   "",
 				AvailabilitySetName: "",
 				SecurityGroupName:   securityGroupName,
+				SecurityGroupID:     securityGroupID,
 				ResourceGroupName:   resourceGroupName,
 			}
 		})
@@ -562,10 +588,10 @@ var _ = Describe("Terraform", func() {
 					Name: resourceGroupName,
 				},
 				RouteTables: []apiv1alpha1.RouteTable{
-					{Name: routeTableName, Purpose: apiv1alpha1.PurposeNodes},
+					{Name: routeTableName, ID: routeTableID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 				},
 				SecurityGroups: []apiv1alpha1.SecurityGroup{
-					{Name: securityGroupName, Purpose: apiv1alpha1.PurposeNodes},
+					{Name: securityGroupName, ID: securityGroupID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 				},
 				AvailabilitySets: []apiv1alpha1.AvailabilitySet{},
 				Networks: apiv1alpha1.NetworkStatus{
@@ -596,7 +622,7 @@ var _ = Describe("Terraform", func() {
 					Name: resourceGroupName,
 				},
 				RouteTables: []apiv1alpha1.RouteTable{
-					{Name: routeTableName, Purpose: apiv1alpha1.PurposeNodes},
+					{Name: routeTableName, ID: routeTableID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 				},
 				AvailabilitySets: []apiv1alpha1.AvailabilitySet{
 					{
@@ -605,7 +631,7 @@ var _ = Describe("Terraform", func() {
 					},
 				},
 				SecurityGroups: []apiv1alpha1.SecurityGroup{
-					{Name: securityGroupName, Purpose: apiv1alpha1.PurposeNodes},
+					{Name: securityGroupName, ID: securityGroupID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 				},
 				Networks: apiv1alpha1.NetworkStatus{
 					VNet: apiv1alpha1.VNetStatus{
@@ -638,11 +664,11 @@ var _ = Describe("Terraform", func() {
 					Name: resourceGroupName,
 				},
 				RouteTables: []apiv1alpha1.RouteTable{
-					{Name: routeTableName, Purpose: apiv1alpha1.PurposeNodes},
+					{Name: routeTableName, ID: routeTableID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 				},
 				AvailabilitySets: []apiv1alpha1.AvailabilitySet{},
 				SecurityGroups: []apiv1alpha1.SecurityGroup{
-					{Name: securityGroupName, Purpose: apiv1alpha1.PurposeNodes},
+					{Name: securityGroupName, ID: securityGroupID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 				},
 				Networks: apiv1alpha1.NetworkStatus{
 					VNet: apiv1alpha1.VNetStatus{
@@ -702,10 +728,10 @@ var _ = Describe("Terraform", func() {
 					Name: resourceGroupName,
 				},
 				RouteTables: []apiv1alpha1.RouteTable{
-					{Name: routeTableName, Purpose: apiv1alpha1.PurposeNodes},
+					{Name: routeTableName, ID: routeTableID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 				},
 				SecurityGroups: []apiv1alpha1.SecurityGroup{
-					{Name: securityGroupName, Purpose: apiv1alpha1.PurposeNodes},
+					{Name: securityGroupName, ID: securityGroupID, Purpose: apiv1alpha1.PurposeNodes, Managed: true},
 				},
 				AvailabilitySets: []apiv1alpha1.AvailabilitySet{},
 				Networks: apiv1alpha1.NetworkStatus{
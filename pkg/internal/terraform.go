@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/gardener/gardener-extension-provider-azure/imagevector"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -113,5 +114,9 @@ func defaultNewTerraformerWithAuth(
 		return nil, err
 	}
 
-	return tf.SetEnvVars(TerraformerEnvVars(infra.Spec.SecretRef)...), nil
+	secretRef, err := helper.InfrastructureCredentialsSecretRef(infra)
+	if err != nil {
+		return nil, err
+	}
+	return tf.SetEnvVars(TerraformerEnvVars(secretRef)...), nil
 }
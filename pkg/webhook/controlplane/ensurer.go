@@ -6,7 +6,10 @@ package controlplane
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/coreos/go-systemd/v22/unit"
@@ -18,7 +21,9 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/utils"
 	gutil "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
@@ -26,47 +31,88 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	kubeletconfigv1 "k8s.io/kubelet/config/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
 
 	"github.com/gardener/gardener-extension-provider-azure/imagevector"
+	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureapihelper "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
 )
 
 const (
-	acrConfigPath = "/var/lib/kubelet/acr.conf"
+	acrConfigPath                   = "/var/lib/kubelet/acr.conf"
+	acrCredentialProviderConfigPath = "/var/lib/kubelet/image-credential-provider-config.yaml"
+	acrCredentialProviderName       = "acr-credential-provider"
 )
 
+// acrCredentialProviderMatchImages are the images for which the kubelet retrieves the credentials with the
+// acr-credential-provider, i.e. the registries of all Azure clouds.
+var acrCredentialProviderMatchImages = []string{"*.azurecr.io", "*.azurecr.cn", "*.azurecr.de", "*.azurecr.us"}
+
 // NewEnsurer creates a new controlplane ensurer.
-func NewEnsurer(mgr manager.Manager, logger logr.Logger) genericmutator.Ensurer {
+func NewEnsurer(mgr manager.Manager, imageVectorOverrides []config.ImageVectorOverride, logger logr.Logger) genericmutator.Ensurer {
 	return &ensurer{
-		client: mgr.GetClient(),
-		logger: logger.WithName("azure-controlplane-ensurer"),
+		client:               mgr.GetClient(),
+		logger:               logger.WithName("azure-controlplane-ensurer"),
+		imageVectorOverrides: imageVectorOverrides,
 	}
 }
 
 type ensurer struct {
 	genericmutator.NoopEnsurer
-	client client.Client
-	logger logr.Logger
+	client               client.Client
+	logger               logr.Logger
+	imageVectorOverrides []config.ImageVectorOverride
 }
 
 // ImageVector is exposed for testing.
 var ImageVector = imagevector.ImageVector()
 
 // EnsureMachineControllerManagerDeployment ensures that the machine-controller-manager deployment conforms to the provider requirements.
-func (e *ensurer) EnsureMachineControllerManagerDeployment(_ context.Context, _ gcontext.GardenContext, newObj, _ *appsv1.Deployment) error {
+func (e *ensurer) EnsureMachineControllerManagerDeployment(ctx context.Context, gctx gcontext.GardenContext, newObj, _ *appsv1.Deployment) error {
 	image, err := ImageVector.FindImage(azure.MachineControllerManagerProviderAzureImageName)
 	if err != nil {
 		return err
 	}
+	imageRef := image.String()
+
+	if len(e.imageVectorOverrides) > 0 {
+		cluster, err := gctx.GetCluster(ctx)
+		if err != nil {
+			return err
+		}
+		cloudConfiguration, err := cloudConfigurationFromCluster(cluster)
+		if err != nil {
+			return err
+		}
+		if override, ok := imagevector.OverridesForCloud(e.imageVectorOverrides, cloudConfiguration.Name)[azure.MachineControllerManagerProviderAzureImageName]; ok {
+			imageRef = override
+		}
+	}
 
-	sidecarContainer := machinecontrollermanager.ProviderSidecarContainer(newObj.Namespace, azure.Name, image.String())
+	sidecarContainer := machinecontrollermanager.ProviderSidecarContainer(newObj.Namespace, azure.Name, imageRef)
 	sidecarContainer.Args = append(sidecarContainer.Args, "--machine-pv-reattach-timeout=150s")
 
 	newObj.Spec.Template.Spec.Containers = extensionswebhook.EnsureContainerWithName(newObj.Spec.Template.Spec.Containers, sidecarContainer)
+
+	// The provider sidecar uses the credentials of the cloudprovider secret. It is restarted when they are rotated, like
+	// the other components consuming the credentials, so that no component keeps using the old credentials.
+	secret := &corev1.Secret{}
+	if err := e.client.Get(ctx, client.ObjectKey{Namespace: newObj.Namespace, Name: v1beta1constants.SecretNameCloudProvider}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read the %s secret: %w", v1beta1constants.SecretNameCloudProvider, err)
+	}
+	metav1.SetMetaDataAnnotation(&newObj.Spec.Template.ObjectMeta, "checksum/secret-"+v1beta1constants.SecretNameCloudProvider, utils.ComputeChecksum(secret.Data))
 	return nil
 }
 
@@ -367,18 +413,24 @@ func (e *ensurer) EnsureKubeletServiceUnitOptions(
 		}
 		opt.Value = extensionswebhook.SerializeCommandLine(command, 1, " \\\n    ")
 	}
-	return newUnitOption, nil
+	return ensureKubeletLocalDisksDependency(ctx, gctx, newUnitOption)
 }
 
 func (e *ensurer) ensureKubeletCommandLineArgs(ctx context.Context, cluster *extensionscontroller.Cluster, command []string) ([]string, error) {
 	command = extensionswebhook.EnsureStringWithPrefix(command, "--cloud-provider=", "external")
 
-	acrConfigMap, err := e.getAcrConfigMap(ctx, cluster)
+	acrConfig, err := e.getACRConfig(ctx, cluster)
 	if err != nil {
 		return nil, err
 	}
 
-	if acrConfigMap != nil {
+	switch {
+	case acrConfig == nil:
+	case acrConfig.credentialProvider:
+		command = extensionswebhook.EnsureNoStringWithPrefix(command, "--azure-container-registry-config=")
+		command = extensionswebhook.EnsureStringWithPrefix(command, "--image-credential-provider-config=", acrCredentialProviderConfigPath)
+		command = extensionswebhook.EnsureStringWithPrefix(command, "--image-credential-provider-bin-dir=", v1beta1constants.OperatingSystemConfigFilePathBinaries)
+	default:
 		command = extensionswebhook.EnsureStringWithPrefix(command, "--azure-container-registry-config=", acrConfigPath)
 	}
 
@@ -387,8 +439,8 @@ func (e *ensurer) ensureKubeletCommandLineArgs(ctx context.Context, cluster *ext
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
 func (e *ensurer) EnsureKubeletConfiguration(
-	_ context.Context,
-	_ gcontext.GardenContext,
+	ctx context.Context,
+	gctx gcontext.GardenContext,
 	kubeletVersion *semver.Version,
 	newKubeletConfiguration, _ *kubeletconfigv1beta1.KubeletConfiguration) error {
 	if versionutils.ConstraintK8sLess127.Check(kubeletVersion) {
@@ -406,9 +458,55 @@ func (e *ensurer) EnsureKubeletConfiguration(
 
 	newKubeletConfiguration.EnableControllerAttachDetach = ptr.To(true)
 
+	// The kubelet registers the nodes with the taint of the external cloud provider itself, registering it twice would
+	// fail the registration of the node.
+	newKubeletConfiguration.RegisterWithTaints = slices.DeleteFunc(newKubeletConfiguration.RegisterWithTaints, func(taint corev1.Taint) bool {
+		return taint.Key == azure.TaintExternalCloudProvider
+	})
+
+	return e.ensureWorkerPoolKubeletConfiguration(ctx, gctx, newKubeletConfiguration)
+}
+
+// ensureWorkerPoolKubeletConfiguration applies the kubelet settings of the WorkerConfig of the worker pool whose
+// OperatingSystemConfig is mutated.
+func (e *ensurer) ensureWorkerPoolKubeletConfiguration(ctx context.Context, gctx gcontext.GardenContext, kubeletConfiguration *kubeletconfigv1beta1.KubeletConfiguration) error {
+	workerConfig, err := workerPoolConfig(ctx, gctx)
+	if err != nil || workerConfig == nil {
+		return err
+	}
+
+	if kubelet := workerConfig.Kubelet; kubelet != nil {
+		if kubelet.NodeStatusUpdateFrequency != nil {
+			kubeletConfiguration.NodeStatusUpdateFrequency = *kubelet.NodeStatusUpdateFrequency
+		}
+		if kubelet.NodeStatusReportFrequency != nil {
+			kubeletConfiguration.NodeStatusReportFrequency = *kubelet.NodeStatusReportFrequency
+		}
+	}
 	return nil
 }
 
+// workerPoolConfig returns the WorkerConfig of the worker pool whose OperatingSystemConfig is mutated or nil if the
+// OperatingSystemConfig does not belong to a worker pool of the shoot.
+func workerPoolConfig(ctx context.Context, gctx gcontext.GardenContext) (*apisazure.WorkerConfig, error) {
+	poolName, ok := workerPoolFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	cluster, err := gctx.GetCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pool := range cluster.Shoot.Spec.Provider.Workers {
+		if pool.Name == poolName {
+			return azureapihelper.WorkerConfigFromRaw(pool.ProviderConfig)
+		}
+	}
+	return nil, nil
+}
+
 func setKubeletConfigurationFeatureGate(kubeletConfiguration *kubeletconfigv1beta1.KubeletConfiguration, featureGate string, value bool) {
 	if kubeletConfiguration.FeatureGates == nil {
 		kubeletConfiguration.FeatureGates = make(map[string]bool)
@@ -444,9 +542,23 @@ func (e *ensurer) EnsureKubeletCloudProviderConfig(ctx context.Context, _ gconte
 	return nil
 }
 
+// EnsureAdditionalUnits ensures additional systemd units
+func (e *ensurer) EnsureAdditionalUnits(ctx context.Context, gctx gcontext.GardenContext, newUnits, _ *[]extensionsv1alpha1.Unit) error {
+	if err := ensureLocalDisksUnit(ctx, gctx, newUnits); err != nil {
+		return err
+	}
+	return ensureScheduledEventsUnit(ctx, gctx, newUnits)
+}
+
 // EnsureAdditionalFiles ensures additional systemd files
 func (e *ensurer) EnsureAdditionalFiles(ctx context.Context, gctx gcontext.GardenContext, newFile, _ *[]extensionsv1alpha1.File) error {
-	return e.ensureAcrConfigFile(ctx, gctx, newFile)
+	if err := e.ensureAcrConfigFile(ctx, gctx, newFile); err != nil {
+		return err
+	}
+	if err := ensureLocalDisksFile(ctx, gctx, newFile); err != nil {
+		return err
+	}
+	return ensureScheduledEventsFile(ctx, gctx, newFile)
 }
 
 func (e *ensurer) ensureAcrConfigFile(ctx context.Context, gctx gcontext.GardenContext, files *[]extensionsv1alpha1.File) error {
@@ -455,41 +567,164 @@ func (e *ensurer) ensureAcrConfigFile(ctx context.Context, gctx gcontext.GardenC
 		return err
 	}
 
-	// Check if the ACR configmap exists, if not nothing to do.
-	cm, err := e.getAcrConfigMap(ctx, cluster)
+	// Check if the ACR access is configured, if not nothing to do.
+	acrConfig, err := e.getACRConfig(ctx, cluster)
 	if err != nil {
 		return err
 	}
-	if cm == nil {
+	if acrConfig == nil {
 		return nil
 	}
 
 	// Write the content of the file.
 	fciCodec := oscutils.NewFileContentInlineCodec()
-	fci, err := fciCodec.Encode([]byte(cm.Data[azure.CloudProviderAcrConfigMapKey]), string(extensionsv1alpha1.B64FileCodecID))
+	fci, err := fciCodec.Encode(acrConfig.data, string(extensionsv1alpha1.B64FileCodecID))
 	if err != nil {
 		return fmt.Errorf("could not encode acr cloud provider config: %w", err)
 	}
 
-	// Remove old ACR systemd file(s) before adding a new one.
-	for i, f := range *files {
-		if f.Path == acrConfigPath {
-			l := *files
-			*files = append(l[:i], l[i+1:]...)
-		}
-	}
-
 	// Add new ACR systemd file.
-	*files = append(*files, extensionsv1alpha1.File{
+	*files = extensionswebhook.EnsureFileWithPath(*files, extensionsv1alpha1.File{
 		Path:        acrConfigPath,
 		Permissions: ptr.To[uint32](0644),
 		Content: extensionsv1alpha1.FileContent{
 			Inline: fci,
 		},
 	})
+
+	if !acrConfig.credentialProvider {
+		return nil
+	}
+	return e.ensureACRCredentialProviderFiles(cluster, files)
+}
+
+// ensureACRCredentialProviderFiles adds the acr-credential-provider binary and the kubelet configuration for the image
+// credential provider plugins to the given files.
+func (e *ensurer) ensureACRCredentialProviderFiles(cluster *extensionscontroller.Cluster, files *[]extensionsv1alpha1.File) error {
+	image, err := ImageVector.FindImage(azure.ACRCredentialProviderImageName, imagevectorutils.TargetVersion(cluster.Shoot.Spec.Kubernetes.Version))
+	if err != nil {
+		return err
+	}
+
+	credentialProviderConfig, err := yaml.Marshal(&kubeletconfigv1.CredentialProviderConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubeletconfigv1.SchemeGroupVersion.String(),
+			Kind:       "CredentialProviderConfig",
+		},
+		Providers: []kubeletconfigv1.CredentialProvider{{
+			Name:                 acrCredentialProviderName,
+			MatchImages:          acrCredentialProviderMatchImages,
+			DefaultCacheDuration: &metav1.Duration{Duration: 10 * time.Minute},
+			APIVersion:           "credentialprovider.kubelet.k8s.io/v1",
+			Args:                 []string{acrConfigPath},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("could not marshal image credential provider config: %w", err)
+	}
+
+	*files = extensionswebhook.EnsureFileWithPath(*files, extensionsv1alpha1.File{
+		Path:        acrCredentialProviderConfigPath,
+		Permissions: ptr.To[uint32](0644),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: string(extensionsv1alpha1.B64FileCodecID),
+				Data:     utils.EncodeBase64(credentialProviderConfig),
+			},
+		},
+	})
+	*files = extensionswebhook.EnsureFileWithPath(*files, extensionsv1alpha1.File{
+		Path:        v1beta1constants.OperatingSystemConfigFilePathBinaries + "/" + acrCredentialProviderName,
+		Permissions: ptr.To[uint32](0755),
+		Content: extensionsv1alpha1.FileContent{
+			ImageRef: &extensionsv1alpha1.FileContentImageRef{
+				Image:           image.String(),
+				FilePathInImage: "/usr/local/bin/" + acrCredentialProviderName,
+			},
+		},
+	})
 	return nil
 }
 
+// acrConfig is the configuration of the worker nodes for pulling from an Azure Container Registry.
+type acrConfig struct {
+	// data is the content of the cloud provider config file used to retrieve the credentials.
+	data []byte
+	// credentialProvider is true if the acr-credential-provider is used instead of the legacy in-tree credential
+	// provider of the kubelet.
+	credentialProvider bool
+}
+
+// getACRConfig returns the configuration for pulling from an Azure Container Registry or nil if the ACR access is not
+// enabled for the cluster. The configuration of the acr-credential-provider is derived from the Infrastructure, the
+// legacy configuration is read from the ACR config map rendered by the controlplane controller.
+func (e *ensurer) getACRConfig(ctx context.Context, cluster *extensionscontroller.Cluster) (*acrConfig, error) {
+	if cluster == nil || cluster.Shoot == nil {
+		return nil, fmt.Errorf("could not get cluster resource or cluster resource is invalid")
+	}
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := e.client.Get(ctx, client.ObjectKey{Namespace: cluster.Shoot.Status.TechnicalID, Name: cluster.Shoot.Name}, infra); client.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("could not get infrastructure '%s/%s': %w", cluster.Shoot.Status.TechnicalID, cluster.Shoot.Name, err)
+	}
+	infraStatus, err := azureapihelper.InfrastructureStatusFromInfrastructure(infra)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode infrastructure status of '%s/%s': %w", cluster.Shoot.Status.TechnicalID, cluster.Shoot.Name, err)
+	}
+	if ptr.Deref(azureapihelper.ACRAccessMode(infraStatus.Identity), "") == apisazure.ACRAccessModeCredentialProvider {
+		data, err := e.acrCredentialProviderCloudConfig(ctx, cluster, infraStatus.Identity.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		return &acrConfig{data: data, credentialProvider: true}, nil
+	}
+
+	cm, err := e.getAcrConfigMap(ctx, cluster)
+	if err != nil || cm == nil {
+		return nil, err
+	}
+	return &acrConfig{data: []byte(cm.Data[azure.CloudProviderAcrConfigMapKey])}, nil
+}
+
+// acrCredentialProviderCloudConfig returns the cloud provider config for the acr-credential-provider, which uses the
+// given user-assigned identity of the worker nodes.
+func (e *ensurer) acrCredentialProviderCloudConfig(ctx context.Context, cluster *extensionscontroller.Cluster, identityClientID string) ([]byte, error) {
+	namespace := cluster.Shoot.Status.TechnicalID
+	auth, _, err := internal.GetClientAuthData(ctx, e.client, corev1.SecretReference{Name: v1beta1constants.SecretNameCloudProvider, Namespace: namespace}, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get service account from secret '%s/%s': %w", namespace, v1beta1constants.SecretNameCloudProvider, err)
+	}
+
+	cloudConfiguration, err := cloudConfigurationFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"cloud":                       azureclient.CloudInstanceName(*cloudConfiguration),
+		"tenantId":                    auth.TenantID,
+		"subscriptionId":              auth.SubscriptionID,
+		"aadClientId":                 "msi",
+		"aadClientSecret":             "msi",
+		"useManagedIdentityExtension": true,
+		"userAssignedIdentityID":      identityClientID,
+		"useInstanceMetadata":         true,
+	})
+}
+
+// cloudConfigurationFromCluster returns the configuration of the Azure cloud instance the given shoot cluster runs in.
+func cloudConfigurationFromCluster(cluster *extensionscontroller.Cluster) (*apisazure.CloudConfiguration, error) {
+	cloudProfileConfig, err := azureapihelper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	var cloudProfileCloudConfiguration *apisazure.CloudConfiguration
+	if cloudProfileConfig != nil {
+		cloudProfileCloudConfiguration = cloudProfileConfig.CloudConfiguration
+	}
+	return azureclient.CloudConfiguration(cloudProfileCloudConfiguration, &cluster.Shoot.Spec.Region)
+}
+
 func (e *ensurer) getAcrConfigMap(ctx context.Context, cluster *extensionscontroller.Cluster) (*corev1.ConfigMap, error) {
 	if cluster == nil || cluster.Shoot == nil {
 		return nil, fmt.Errorf("could not get cluster resource or cluster resource is invalid")
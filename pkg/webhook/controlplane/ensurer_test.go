@@ -6,7 +6,9 @@ package controlplane
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/coreos/go-systemd/v22/unit"
@@ -17,7 +19,9 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/test"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	testutils "github.com/gardener/gardener/pkg/utils/test"
 	"github.com/gardener/gardener/pkg/utils/version"
@@ -25,6 +29,7 @@ import (
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -37,6 +42,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
@@ -126,7 +132,7 @@ var _ = Describe("Ensurer", func() {
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
 
-		ensurer = NewEnsurer(mgr, logger)
+		ensurer = NewEnsurer(mgr, nil, logger)
 	})
 
 	AfterEach(func() {
@@ -390,6 +396,7 @@ var _ = Describe("Ensurer", func() {
 	Describe("#EnsureKubeletServiceUnitOptions", func() {
 		var (
 			acrCmKey = client.ObjectKey{Namespace: namespace, Name: azure.CloudProviderAcrConfigName}
+			infraKey = client.ObjectKey{Namespace: namespace}
 
 			oldUnitOptions []*unit.UnitOption
 		)
@@ -425,6 +432,7 @@ var _ = Describe("Ensurer", func() {
     --cloud-provider=external`
 				}
 
+				c.EXPECT().Get(ctx, infraKey, &extensionsv1alpha1.Infrastructure{}).Return(apierrors.NewNotFound(schema.GroupResource{}, ""))
 				if withACRConfig {
 					acrCM := &corev1.ConfigMap{
 						ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: azure.CloudProviderAcrConfigName},
@@ -445,6 +453,182 @@ var _ = Describe("Ensurer", func() {
 
 			Entry("kubelet >= 1.27, w/ acr", eContextK8s127, "external", true, false),
 		)
+
+		It("should configure the acr-credential-provider", func() {
+			c.EXPECT().Get(ctx, infraKey, &extensionsv1alpha1.Infrastructure{}).DoAndReturn(clientGet(acrCredentialProviderInfrastructure()))
+			c.EXPECT().Get(ctx, cloudProviderSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cloudProviderSecret))
+
+			opts, err := ensurer.EnsureKubeletServiceUnitOptions(ctx, eContextK8s131, nil, []*unit.UnitOption{{
+				Section: "Service",
+				Name:    "ExecStart",
+				Value: `/opt/bin/hyperkube kubelet \
+    --config=/var/lib/kubelet/config/kubelet \
+    --azure-container-registry-config=/var/lib/kubelet/acr.conf`,
+			}}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opts).To(Equal([]*unit.UnitOption{{
+				Section: "Service",
+				Name:    "ExecStart",
+				Value: `/opt/bin/hyperkube kubelet \
+    --config=/var/lib/kubelet/config/kubelet \
+    --cloud-provider=external \
+    --image-credential-provider-config=/var/lib/kubelet/image-credential-provider-config.yaml \
+    --image-credential-provider-bin-dir=/opt/bin`,
+			}}))
+		})
+	})
+
+	Describe("#EnsureAdditionalFiles", func() {
+		var infraKey = client.ObjectKey{Namespace: namespace}
+
+		BeforeEach(func() {
+			DeferCleanup(testutils.WithVar(&ImageVector, imagevector.ImageVector{{
+				Name:       "acr-credential-provider",
+				Repository: ptr.To("foo"),
+				Tag:        ptr.To("bar"),
+			}}))
+		})
+
+		It("should add the files of the acr-credential-provider", func() {
+			c.EXPECT().Get(ctx, infraKey, &extensionsv1alpha1.Infrastructure{}).DoAndReturn(clientGet(acrCredentialProviderInfrastructure()))
+			c.EXPECT().Get(ctx, cloudProviderSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cloudProviderSecret))
+
+			files := []extensionsv1alpha1.File{{Path: "/var/lib/kubelet/acr.conf"}}
+			Expect(ensurer.EnsureAdditionalFiles(ctx, eContextK8s131, &files, nil)).To(Succeed())
+
+			Expect(files).To(HaveLen(3))
+			Expect(files[0].Path).To(Equal("/var/lib/kubelet/acr.conf"))
+			acrConfig, err := base64.StdEncoding.DecodeString(files[0].Content.Inline.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acrConfig).To(MatchJSON(`{
+  "cloud": "AZUREPUBLICCLOUD",
+  "tenantId": "tenant",
+  "subscriptionId": "subscription",
+  "aadClientId": "msi",
+  "aadClientSecret": "msi",
+  "useManagedIdentityExtension": true,
+  "userAssignedIdentityID": "identity-client-id",
+  "useInstanceMetadata": true
+}`))
+
+			Expect(files[1].Path).To(Equal("/var/lib/kubelet/image-credential-provider-config.yaml"))
+			credentialProviderConfig, err := base64.StdEncoding.DecodeString(files[1].Content.Inline.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentialProviderConfig).To(MatchYAML(`apiVersion: kubelet.config.k8s.io/v1
+kind: CredentialProviderConfig
+providers:
+- name: acr-credential-provider
+  apiVersion: credentialprovider.kubelet.k8s.io/v1
+  defaultCacheDuration: 10m0s
+  matchImages: ["*.azurecr.io", "*.azurecr.cn", "*.azurecr.de", "*.azurecr.us"]
+  args: ["/var/lib/kubelet/acr.conf"]
+`))
+
+			Expect(files[2]).To(Equal(extensionsv1alpha1.File{
+				Path:        "/opt/bin/acr-credential-provider",
+				Permissions: ptr.To[uint32](0755),
+				Content: extensionsv1alpha1.FileContent{
+					ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "foo:bar", FilePathInImage: "/usr/local/bin/acr-credential-provider"},
+				},
+			}))
+		})
+	})
+
+	Describe("#EnsureAdditionalUnits", func() {
+		var gctx gcontext.GardenContext
+
+		BeforeEach(func() {
+			gctx = gcontext.NewInternalGardenContext(
+				&extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+							Provider: gardencorev1beta1.Provider{
+								Workers: []gardencorev1beta1.Worker{
+									{Name: "default"},
+									{
+										Name:           "scheduled-events",
+										ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","scheduledEvents":{"enabled":true}}`)},
+									},
+									{
+										Name:           "local-disks",
+										ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","localDisks":{"ephemeralStorage":true}}`)},
+									},
+								},
+							},
+						},
+					},
+				},
+			)
+		})
+
+		It("should add the scheduled events agent if enabled for the worker pool", func() {
+			var (
+				units []extensionsv1alpha1.Unit
+				files []extensionsv1alpha1.File
+			)
+
+			Expect(ensurer.EnsureAdditionalUnits(withWorkerPool(ctx, "scheduled-events"), gctx, &units, nil)).To(Succeed())
+			Expect(units).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal("azure-scheduled-events.service"),
+				"Command":   PointTo(Equal(extensionsv1alpha1.CommandRestart)),
+				"Enable":    PointTo(BeTrue()),
+				"Content":   PointTo(ContainSubstring("ExecStart=/opt/bin/azure-scheduled-events.sh")),
+				"FilePaths": ConsistOf("/opt/bin/azure-scheduled-events.sh"),
+			})))
+
+			Expect(ensureScheduledEventsFile(withWorkerPool(ctx, "scheduled-events"), gctx, &files)).To(Succeed())
+			Expect(files).To(HaveLen(1))
+			Expect(files[0].Path).To(Equal("/opt/bin/azure-scheduled-events.sh"))
+			Expect(files[0].Permissions).To(PointTo(Equal(uint32(0755))))
+			script, err := base64.StdEncoding.DecodeString(files[0].Content.Inline.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(script)).To(ContainSubstring(`CONDITION_TYPE="AzureScheduledEvent"`))
+		})
+
+		It("should not add the scheduled events agent for other worker pools", func() {
+			var units []extensionsv1alpha1.Unit
+
+			Expect(ensurer.EnsureAdditionalUnits(withWorkerPool(ctx, "default"), gctx, &units, nil)).To(Succeed())
+			Expect(ensurer.EnsureAdditionalUnits(ctx, gctx, &units, nil)).To(Succeed())
+			Expect(units).To(BeEmpty())
+		})
+
+		It("should mount the local disks before the kubelet if they provide the ephemeral storage", func() {
+			var (
+				units []extensionsv1alpha1.Unit
+				files []extensionsv1alpha1.File
+			)
+
+			Expect(ensurer.EnsureAdditionalUnits(withWorkerPool(ctx, "local-disks"), gctx, &units, nil)).To(Succeed())
+			Expect(units).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal("azure-local-disks.service"),
+				"Command":   PointTo(Equal(extensionsv1alpha1.CommandStart)),
+				"Enable":    PointTo(BeTrue()),
+				"Content":   PointTo(And(ContainSubstring("Before=kubelet.service"), ContainSubstring("ExecStart=/opt/bin/azure-local-disks.sh"))),
+				"FilePaths": ConsistOf("/opt/bin/azure-local-disks.sh"),
+			})))
+
+			Expect(ensureLocalDisksFile(withWorkerPool(ctx, "local-disks"), gctx, &files)).To(Succeed())
+			Expect(files).To(HaveLen(1))
+			Expect(files[0].Path).To(Equal("/opt/bin/azure-local-disks.sh"))
+			Expect(files[0].Permissions).To(PointTo(Equal(uint32(0755))))
+			script, err := base64.StdEncoding.DecodeString(files[0].Content.Inline.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(script)).To(ContainSubstring(`EPHEMERAL_DIRS=("/var/lib/kubelet/pods" "/var/log/pods")`))
+			Expect(string(script)).NotTo(ContainSubstring(`"/var/lib/kubelet"`))
+
+			opts, err := ensureKubeletLocalDisksDependency(withWorkerPool(ctx, "local-disks"), gctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opts).To(ConsistOf(
+				&unit.UnitOption{Section: "Unit", Name: "Wants", Value: "azure-local-disks.service"},
+				&unit.UnitOption{Section: "Unit", Name: "After", Value: "azure-local-disks.service"},
+			))
+
+			opts, err = ensureKubeletLocalDisksDependency(withWorkerPool(ctx, "default"), gctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(opts).To(BeEmpty())
+		})
 	})
 
 	Describe("#EnsureKubeletConfiguration", func() {
@@ -511,6 +695,59 @@ var _ = Describe("Ensurer", func() {
 				map[string]bool{},
 			),
 		)
+
+		It("should remove the taint of the external cloud provider as the kubelet registers the node with it itself", func() {
+			kubeletConfig := &kubeletconfigv1beta1.KubeletConfiguration{
+				RegisterWithTaints: []corev1.Taint{
+					{Key: "node.gardener.cloud/critical-components-not-ready", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "node.cloudprovider.kubernetes.io/uninitialized", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+				},
+			}
+
+			Expect(ensurer.EnsureKubeletConfiguration(ctx, eContextK8s131, semver.MustParse("1.31.0"), kubeletConfig, nil)).To(Succeed())
+			Expect(kubeletConfig.RegisterWithTaints).To(ConsistOf(corev1.Taint{Key: "node.gardener.cloud/critical-components-not-ready", Effect: corev1.TaintEffectNoSchedule}))
+		})
+
+		Context("worker pool kubelet settings", func() {
+			var gctx gcontext.GardenContext
+
+			BeforeEach(func() {
+				gctx = gcontext.NewInternalGardenContext(
+					&extensionscontroller.Cluster{
+						Shoot: &gardencorev1beta1.Shoot{
+							Spec: gardencorev1beta1.ShootSpec{
+								Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+								Provider: gardencorev1beta1.Provider{
+									Workers: []gardencorev1beta1.Worker{
+										{Name: "default"},
+										{
+											Name:           "spot",
+											ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","kubelet":{"nodeStatusUpdateFrequency":"5s","nodeStatusReportFrequency":"1m"}}`)},
+										},
+									},
+								},
+							},
+						},
+					},
+				)
+			})
+
+			It("should apply the kubelet settings of the worker pool", func() {
+				kubeletConfig := &kubeletconfigv1beta1.KubeletConfiguration{}
+
+				Expect(ensurer.EnsureKubeletConfiguration(withWorkerPool(ctx, "spot"), gctx, semver.MustParse("1.31.1"), kubeletConfig, nil)).To(Succeed())
+				Expect(kubeletConfig.NodeStatusUpdateFrequency).To(Equal(metav1.Duration{Duration: 5 * time.Second}))
+				Expect(kubeletConfig.NodeStatusReportFrequency).To(Equal(metav1.Duration{Duration: time.Minute}))
+			})
+
+			It("should not change the kubelet configuration of other worker pools", func() {
+				kubeletConfig := &kubeletconfigv1beta1.KubeletConfiguration{}
+
+				Expect(ensurer.EnsureKubeletConfiguration(withWorkerPool(ctx, "default"), gctx, semver.MustParse("1.31.1"), kubeletConfig, nil)).To(Succeed())
+				Expect(kubeletConfig.NodeStatusUpdateFrequency).To(BeZero())
+				Expect(kubeletConfig.NodeStatusReportFrequency).To(BeZero())
+			})
+		})
 	})
 
 	Describe("#ShouldProvisionKubeletCloudProviderConfig", func() {
@@ -568,7 +805,7 @@ var _ = Describe("Ensurer", func() {
 
 		BeforeEach(func() {
 			mgr.EXPECT().GetClient().Return(c)
-			ensurer = NewEnsurer(mgr, logger)
+			ensurer = NewEnsurer(mgr, nil, logger)
 			DeferCleanup(testutils.WithVar(&ImageVector, imagevector.ImageVector{{
 				Name:       "machine-controller-manager-provider-azure",
 				Repository: ptr.To("foo"),
@@ -577,11 +814,43 @@ var _ = Describe("Ensurer", func() {
 		})
 
 		It("should inject the sidecar container", func() {
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: "foo", Name: v1beta1constants.SecretNameCloudProvider}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, v1beta1constants.SecretNameCloudProvider))
+
 			Expect(deployment.Spec.Template.Spec.Containers).To(BeEmpty())
 			Expect(ensurer.EnsureMachineControllerManagerDeployment(context.TODO(), nil, deployment, nil)).To(BeNil())
 			expectedContainer := machinecontrollermanager.ProviderSidecarContainer(deployment.Namespace, "provider-azure", "foo:bar")
 			expectedContainer.Args = append(expectedContainer.Args, "--machine-pv-reattach-timeout=150s")
 			Expect(deployment.Spec.Template.Spec.Containers).To(ConsistOf(expectedContainer))
+			Expect(deployment.Spec.Template.Annotations).To(BeEmpty())
+		})
+
+		It("should restart the pods when the credentials are rotated", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{"clientSecret": []byte("secret")}}
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: "foo", Name: v1beta1constants.SecretNameCloudProvider}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+				DoAndReturn(clientGet(secret))
+
+			Expect(ensurer.EnsureMachineControllerManagerDeployment(context.TODO(), nil, deployment, nil)).To(Succeed())
+			Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue("checksum/secret-"+v1beta1constants.SecretNameCloudProvider, utils.ComputeChecksum(secret.Data)))
+		})
+
+		It("should inject the sidecar container with the image overridden for the cloud of the shoot", func() {
+			mgr.EXPECT().GetClient().Return(c)
+			ensurer = NewEnsurer(mgr, []config.ImageVectorOverride{
+				{Cloud: "AzurePublic", Images: map[string]string{"machine-controller-manager-provider-azure": "public:bar"}},
+				{Cloud: "azurechina", Images: map[string]string{"machine-controller-manager-provider-azure": "china:bar"}},
+			}, logger)
+			gctx := gcontext.NewInternalGardenContext(&extensionscontroller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Region: "chinanorth3"}},
+			})
+
+			c.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: "foo", Name: v1beta1constants.SecretNameCloudProvider}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+				Return(apierrors.NewNotFound(schema.GroupResource{}, v1beta1constants.SecretNameCloudProvider))
+
+			Expect(ensurer.EnsureMachineControllerManagerDeployment(context.TODO(), gctx, deployment, nil)).To(Succeed())
+			expectedContainer := machinecontrollermanager.ProviderSidecarContainer(deployment.Namespace, "provider-azure", "china:bar")
+			expectedContainer.Args = append(expectedContainer.Args, "--machine-pv-reattach-timeout=150s")
+			Expect(deployment.Spec.Template.Spec.Containers).To(ConsistOf(expectedContainer))
 		})
 	})
 
@@ -597,7 +866,7 @@ var _ = Describe("Ensurer", func() {
 
 		BeforeEach(func() {
 			mgr.EXPECT().GetClient().Return(c)
-			ensurer = NewEnsurer(mgr, logger)
+			ensurer = NewEnsurer(mgr, nil, logger)
 		})
 
 		It("should inject the sidecar container policy", func() {
@@ -724,9 +993,34 @@ func checkClusterAutoscalerDeployment(dep *appsv1.Deployment, k8sVersion string)
 	}
 }
 
+var (
+	cloudProviderSecretKey = client.ObjectKey{Namespace: namespace, Name: v1beta1constants.SecretNameCloudProvider}
+	cloudProviderSecret    = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: v1beta1constants.SecretNameCloudProvider},
+		Data: map[string][]byte{
+			azure.SubscriptionIDKey: []byte("subscription"),
+			azure.TenantIDKey:       []byte("tenant"),
+			azure.ClientIDKey:       []byte("client"),
+			azure.ClientSecretKey:   []byte("secret"),
+		},
+	}
+)
+
+func acrCredentialProviderInfrastructure() *extensionsv1alpha1.Infrastructure {
+	return &extensionsv1alpha1.Infrastructure{
+		Status: extensionsv1alpha1.InfrastructureStatus{
+			DefaultStatus: extensionsv1alpha1.DefaultStatus{
+				ProviderStatus: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus","identity":{"id":"identity-id","clientID":"identity-client-id","acrAccess":true,"acrAccessMode":"CredentialProvider"}}`)},
+			},
+		},
+	}
+}
+
 func clientGet(result runtime.Object) interface{} {
 	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object, _ ...client.GetOption) error {
 		switch obj.(type) {
+		case *extensionsv1alpha1.Infrastructure:
+			*obj.(*extensionsv1alpha1.Infrastructure) = *result.(*extensionsv1alpha1.Infrastructure)
 		case *corev1.Secret:
 			*obj.(*corev1.Secret) = *result.(*corev1.Secret)
 		case *corev1.ConfigMap:
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/coreos/go-systemd/v22/unit"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	localDisksUnitName   = "azure-local-disks.service"
+	localDisksScriptPath = v1beta1constants.OperatingSystemConfigFilePathBinaries + "/azure-local-disks.sh"
+)
+
+var (
+	//go:embed resources/azure-local-disks.sh
+	localDisksScript []byte
+
+	localDisksUnitContent = `[Unit]
+Description=Mounts the local disks of the VM for the ephemeral storage of the pods
+After=local-fs.target
+Before=kubelet.service
+[Install]
+WantedBy=multi-user.target
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + localDisksScriptPath + `
+`
+)
+
+// localDisksEphemeralStorageEnabled checks whether the local disks provide the ephemeral storage of the worker pool
+// whose OperatingSystemConfig is mutated.
+func localDisksEphemeralStorageEnabled(ctx context.Context, gctx gcontext.GardenContext) (bool, error) {
+	workerConfig, err := workerPoolConfig(ctx, gctx)
+	if err != nil || workerConfig == nil {
+		return false, err
+	}
+	return workerConfig.LocalDisks != nil && workerConfig.LocalDisks.EphemeralStorage, nil
+}
+
+// ensureLocalDisksUnit adds the unit which mounts the local disks if they provide the ephemeral storage.
+func ensureLocalDisksUnit(ctx context.Context, gctx gcontext.GardenContext, units *[]extensionsv1alpha1.Unit) error {
+	enabled, err := localDisksEphemeralStorageEnabled(ctx, gctx)
+	if err != nil || !enabled {
+		return err
+	}
+
+	*units = extensionswebhook.EnsureUnitWithName(*units, extensionsv1alpha1.Unit{
+		Name:      localDisksUnitName,
+		Command:   ptr.To(extensionsv1alpha1.CommandStart),
+		Enable:    ptr.To(true),
+		Content:   ptr.To(localDisksUnitContent),
+		FilePaths: []string{localDisksScriptPath},
+	})
+	return nil
+}
+
+// ensureLocalDisksFile adds the script which mounts the local disks if they provide the ephemeral storage.
+func ensureLocalDisksFile(ctx context.Context, gctx gcontext.GardenContext, files *[]extensionsv1alpha1.File) error {
+	enabled, err := localDisksEphemeralStorageEnabled(ctx, gctx)
+	if err != nil || !enabled {
+		return err
+	}
+
+	*files = extensionswebhook.EnsureFileWithPath(*files, extensionsv1alpha1.File{
+		Path:        localDisksScriptPath,
+		Permissions: ptr.To[uint32](0755),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: string(extensionsv1alpha1.B64FileCodecID),
+				Data:     utils.EncodeBase64(localDisksScript),
+			},
+		},
+	})
+	return nil
+}
+
+// ensureKubeletLocalDisksDependency lets the kubelet wait for the local disks to be mounted if they provide the
+// ephemeral storage. The gardener-node-agent starts the units on the first boot in no particular order, hence the
+// ordering of the unit of the local disks alone does not suffice.
+func ensureKubeletLocalDisksDependency(ctx context.Context, gctx gcontext.GardenContext, opts []*unit.UnitOption) ([]*unit.UnitOption, error) {
+	enabled, err := localDisksEphemeralStorageEnabled(ctx, gctx)
+	if err != nil || !enabled {
+		return opts, err
+	}
+
+	opts = extensionswebhook.EnsureUnitOption(opts, &unit.UnitOption{Section: "Unit", Name: "Wants", Value: localDisksUnitName})
+	return extensionswebhook.EnsureUnitOption(opts, &unit.UnitOption{Section: "Unit", Name: "After", Value: localDisksUnitName}), nil
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type workerPoolKey struct{}
+
+// workerPoolMutator hands the worker pool of OperatingSystemConfigs over to the ensurer via the context, as the generic
+// mutator does not pass the mutated OperatingSystemConfig to the kubelet related functions of the ensurer.
+type workerPoolMutator struct {
+	extensionswebhook.Mutator
+}
+
+// Mutate validates and if needed mutates the given object.
+func (m *workerPoolMutator) Mutate(ctx context.Context, newObj, oldObj client.Object) error {
+	if osc, ok := newObj.(*extensionsv1alpha1.OperatingSystemConfig); ok {
+		if poolName, ok := osc.Labels[v1beta1constants.LabelWorkerPool]; ok {
+			ctx = withWorkerPool(ctx, poolName)
+		}
+	}
+	return m.Mutator.Mutate(ctx, newObj, oldObj)
+}
+
+func withWorkerPool(ctx context.Context, poolName string) context.Context {
+	return context.WithValue(ctx, workerPoolKey{}, poolName)
+}
+
+// workerPoolFromContext returns the name of the worker pool whose OperatingSystemConfig is mutated.
+func workerPoolFromContext(ctx context.Context) (string, bool) {
+	poolName, ok := ctx.Value(workerPoolKey{}).(string)
+	return poolName, ok
+}
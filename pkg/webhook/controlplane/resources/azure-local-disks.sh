@@ -0,0 +1,99 @@
+#!/bin/bash
+# SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+#
+# SPDX-License-Identifier: Apache-2.0
+
+# Mounts the local NVMe disks of the VM, or the temporary disk if the VM has no NVMe disks, and bind-mounts the
+# directories of the pods and their logs from them, so that they provide the ephemeral storage of the pods instead of
+# the OS disk. Multiple NVMe disks are combined into a RAID 0 array. The local disks are formatted if they do not contain
+# a file system yet, e.g. on the first boot or after the VM was moved to another host. Only directories whose content
+# may be lost are placed on the local disks, the rest of the kubelet's root directory, e.g. its client certificate,
+# remains on the OS disk. The script must run before the kubelet and leaves machines alone whose kubelet already stored
+# pods on the OS disk.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+LOCAL_DIR="/var/lib/azure-local"
+EPHEMERAL_DIRS=("/var/lib/kubelet/pods" "/var/log/pods")
+FILESYSTEM_LABEL="azure-local"
+RAID_DEVICE="/dev/md/azure-local"
+RESOURCE_DISK="/dev/disk/azure/resource"
+NVME_MODEL="Microsoft NVMe Direct Disk"
+
+# bind_ephemeral_dirs bind-mounts the ephemeral directories from the mounted local disks.
+bind_ephemeral_dirs() {
+  local dir source
+  for dir in "${EPHEMERAL_DIRS[@]}"; do
+    if mountpoint --quiet "$dir"; then
+      continue
+    fi
+    source="$LOCAL_DIR/$(echo "${dir#/}" | tr '/' '-')"
+    mkdir -p "$source" "$dir"
+    echo "Mounting $source to $dir"
+    mount --bind "$source" "$dir"
+  done
+}
+
+if mountpoint --quiet "$LOCAL_DIR"; then
+  echo "$LOCAL_DIR is already mounted"
+  bind_ephemeral_dirs
+  exit 0
+fi
+
+if [[ -n "$(ls --almost-all "${EPHEMERAL_DIRS[0]}" 2> /dev/null)" ]]; then
+  echo "The kubelet already stores the pods on the OS disk, the local disks are only used by new machines"
+  exit 0
+fi
+
+unmounted() {
+  ! lsblk --noheadings --output MOUNTPOINT "$1" | grep --quiet .
+}
+
+disks=()
+for model in /sys/class/nvme/nvme*/model; do
+  [[ -e "$model" ]] || continue
+  grep --quiet "$NVME_MODEL" "$model" || continue
+  controller="$(basename "$(dirname "$model")")"
+  for namespace in /sys/class/nvme/"$controller"/"$controller"n*; do
+    [[ -e "$namespace" ]] || continue
+    disk="/dev/$(basename "$namespace")"
+    if unmounted "$disk"; then
+      disks+=("$disk")
+    fi
+  done
+done
+
+if [[ ${#disks[@]} -eq 0 ]] && [[ -e "$RESOURCE_DISK" ]]; then
+  disk="$(readlink --canonicalize "$RESOURCE_DISK")"
+  if unmounted "$disk"; then
+    disks+=("$disk")
+  fi
+fi
+
+if [[ ${#disks[@]} -eq 0 ]]; then
+  echo "No unused local disks found, the ephemeral storage remains on the OS disk"
+  exit 0
+fi
+
+device="${disks[0]}"
+if [[ ${#disks[@]} -gt 1 ]]; then
+  mdadm --assemble --scan || true
+  if [[ ! -e "$RAID_DEVICE" ]]; then
+    echo "Combining the local disks ${disks[*]} into a RAID 0 array"
+    mdadm --create "$RAID_DEVICE" --level=0 --raid-devices="${#disks[@]}" --run --force "${disks[@]}"
+  fi
+  device="$RAID_DEVICE"
+fi
+
+if [[ "$(blkid --match-tag LABEL --output value "$device" || true)" != "$FILESYSTEM_LABEL" ]]; then
+  echo "Formatting $device"
+  wipefs --all "$device"
+  mkfs.ext4 -F -L "$FILESYSTEM_LABEL" "$device"
+fi
+
+echo "Mounting $device to $LOCAL_DIR"
+mkdir -p "$LOCAL_DIR"
+mount --options defaults,noatime "$device" "$LOCAL_DIR"
+bind_ephemeral_dirs
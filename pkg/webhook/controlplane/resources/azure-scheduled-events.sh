@@ -0,0 +1,74 @@
+#!/bin/bash
+# SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+#
+# SPDX-License-Identifier: Apache-2.0
+
+# Watches the Azure Scheduled Events of the VM via the instance metadata service and reports pending events which
+# redeploy, preempt or terminate the VM via the AzureScheduledEvent condition of the node. The machine-controller-manager
+# considers the condition when checking the health of the machine, hence the node is drained and replaced after the
+# machine health timeout. The responses of the instance metadata service are parsed with jq.
+
+set -o nounset
+set -o pipefail
+
+CONDITION_TYPE="AzureScheduledEvent"
+EVENT_TYPES='["Redeploy", "Preempt", "Terminate"]'
+IMDS_URL="http://169.254.169.254/metadata"
+KUBECONFIG_PATH="/var/lib/kubelet/kubeconfig-real"
+CA_PATH="/var/lib/kubelet/azure-scheduled-events-ca.crt"
+POLL_INTERVAL=10
+
+node_name="$(hostname | tr '[:upper:]' '[:lower:]')"
+vm_name=""
+reported_status=""
+
+if ! command -v jq > /dev/null; then
+  echo "jq is required to parse the Scheduled Events, but it is not installed" >&2
+  exit 1
+fi
+
+update_condition() {
+  local status="$1" reason="$2" message="$3" server client_certificate client_key now patch
+
+  server="$(sed -n 's/^ *server: *//p' "$KUBECONFIG_PATH" | head -n 1)"
+  client_certificate="$(sed -n 's/^ *client-certificate: *//p' "$KUBECONFIG_PATH" | head -n 1)"
+  client_key="$(sed -n 's/^ *client-key: *//p' "$KUBECONFIG_PATH" | head -n 1)"
+  sed -n 's/^ *certificate-authority-data: *//p' "$KUBECONFIG_PATH" | head -n 1 | base64 -d > "$CA_PATH"
+  now="$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+  patch="$(jq --null-input --compact-output \
+    --arg type "$CONDITION_TYPE" --arg status "$status" --arg reason "$reason" --arg message "$message" --arg now "$now" \
+    '{status: {conditions: [{type: $type, status: $status, reason: $reason, message: $message, lastHeartbeatTime: $now, lastTransitionTime: $now}]}}')"
+
+  curl --silent --show-error --fail --max-time 10 \
+    --cacert "$CA_PATH" --cert "$client_certificate" --key "$client_key" \
+    --request PATCH --header "Content-Type: application/strategic-merge-patch+json" \
+    --data "$patch" \
+    "$server/api/v1/nodes/$node_name/status" > /dev/null
+}
+
+while true; do
+  sleep "$POLL_INTERVAL"
+
+  if [[ -z "$vm_name" ]]; then
+    vm_name="$(curl --silent --fail --max-time 10 --header "Metadata: true" "$IMDS_URL/instance/compute/name?api-version=2021-02-01&format=text")" || continue
+  fi
+
+  events="$(curl --silent --fail --max-time 30 --header "Metadata: true" "$IMDS_URL/scheduledevents?api-version=2020-07-01")" || continue
+
+  event="$(jq --compact-output --arg vm "$vm_name" --argjson types "$EVENT_TYPES" \
+    'first(.Events[]? | select((.EventType as $type | $types | index($type)) and (.Resources // [] | index($vm)))) // empty' <<< "$events")" || continue
+  if [[ -n "$event" ]]; then
+    status="True"
+    reason="$(jq --raw-output '.EventType' <<< "$event")"
+    message="$(jq --raw-output '"\(.EventType) of the VM is scheduled not before \(if (.NotBefore // "") == "" then "now" else .NotBefore end)"' <<< "$event")"
+  else
+    status="False"
+    reason="NoEventScheduled"
+    message="No redeploy, preemption or termination of the VM is scheduled"
+  fi
+
+  if [[ "$status" != "$reported_status" ]]; then
+    echo "Reporting $CONDITION_TYPE condition with status $status: $message"
+    update_condition "$status" "$reason" "$message" && reported_status="$status"
+  fi
+done
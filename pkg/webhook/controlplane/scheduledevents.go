@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	_ "embed"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	scheduledEventsUnitName   = "azure-scheduled-events.service"
+	scheduledEventsScriptPath = v1beta1constants.OperatingSystemConfigFilePathBinaries + "/azure-scheduled-events.sh"
+)
+
+var (
+	//go:embed resources/azure-scheduled-events.sh
+	scheduledEventsScript []byte
+
+	scheduledEventsUnitContent = `[Unit]
+Description=Reports pending Azure Scheduled Events via the node condition AzureScheduledEvent
+After=kubelet.service
+[Install]
+WantedBy=multi-user.target
+[Service]
+Restart=always
+RestartSec=10
+ExecStart=` + scheduledEventsScriptPath + `
+`
+)
+
+// scheduledEventsEnabled checks whether the handling of Scheduled Events is enabled for the worker pool whose
+// OperatingSystemConfig is mutated.
+func scheduledEventsEnabled(ctx context.Context, gctx gcontext.GardenContext) (bool, error) {
+	workerConfig, err := workerPoolConfig(ctx, gctx)
+	if err != nil || workerConfig == nil {
+		return false, err
+	}
+	return workerConfig.ScheduledEvents != nil && workerConfig.ScheduledEvents.Enabled, nil
+}
+
+// ensureScheduledEventsUnit adds the unit of the scheduled events agent if the handling of Scheduled Events is enabled.
+func ensureScheduledEventsUnit(ctx context.Context, gctx gcontext.GardenContext, units *[]extensionsv1alpha1.Unit) error {
+	enabled, err := scheduledEventsEnabled(ctx, gctx)
+	if err != nil || !enabled {
+		return err
+	}
+
+	*units = extensionswebhook.EnsureUnitWithName(*units, extensionsv1alpha1.Unit{
+		Name:      scheduledEventsUnitName,
+		Command:   ptr.To(extensionsv1alpha1.CommandRestart),
+		Enable:    ptr.To(true),
+		Content:   ptr.To(scheduledEventsUnitContent),
+		FilePaths: []string{scheduledEventsScriptPath},
+	})
+	return nil
+}
+
+// ensureScheduledEventsFile adds the script of the scheduled events agent if the handling of Scheduled Events is
+// enabled.
+func ensureScheduledEventsFile(ctx context.Context, gctx gcontext.GardenContext, files *[]extensionsv1alpha1.File) error {
+	enabled, err := scheduledEventsEnabled(ctx, gctx)
+	if err != nil || !enabled {
+		return err
+	}
+
+	*files = extensionswebhook.EnsureFileWithPath(*files, extensionsv1alpha1.File{
+		Path:        scheduledEventsScriptPath,
+		Permissions: ptr.To[uint32](0755),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: string(extensionsv1alpha1.B64FileCodecID),
+				Data:     utils.EncodeBase64(scheduledEventsScript),
+			},
+		},
+	})
+	return nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplaneexposure
+
+import (
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+const (
+	// WebhookName is the name of the controlplane exposure webhook.
+	WebhookName = "controlplaneexposure"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+
+	logger = log.Log.WithName("azure-controlplaneexposure-webhook")
+)
+
+// AddOptions are options to apply when adding the Azure controlplane exposure webhook to the manager.
+type AddOptions struct {
+	// Config is the configuration of the controlplane exposure. The webhook does not act on any service unless a
+	// private DNS zone is configured.
+	Config config.ControlPlaneExposureConfig
+}
+
+// AddToManagerWithOptions creates a webhook with the given options and adds it to the manager.
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding webhook to manager")
+
+	types := []extensionswebhook.Type{
+		{Obj: &corev1.Service{}},
+	}
+
+	handler, err := extensionswebhook.NewBuilder(mgr, logger).WithMutator(NewMutator(logger, opts), types...).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Creating webhook")
+	return &extensionswebhook.Webhook{
+		Name:     WebhookName,
+		Provider: azure.Type,
+		Path:     WebhookName,
+		Target:   extensionswebhook.TargetSeed,
+		Types:    types,
+		Webhook:  &admission.Webhook{Handler: handler, RecoverPanic: ptr.To(true)},
+		// Only shoot namespaces of seeds running on Azure are relevant.
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{v1beta1constants.LabelSeedProvider: azure.Type},
+		},
+		ObjectSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
+				v1beta1constants.LabelRole: v1beta1constants.LabelAPIServer,
+			},
+		},
+	}, nil
+}
+
+// AddToManager creates a webhook with the default options and adds it to the manager.
+func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplaneexposure
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+)
+
+const (
+	// AnnotationAzureLoadBalancerInternal is the annotation which instructs the Azure cloud-controller-manager to
+	// create an internal load balancer for a service.
+	AnnotationAzureLoadBalancerInternal = "service.beta.kubernetes.io/azure-load-balancer-internal"
+)
+
+// NewMutator creates a new controlplane exposure mutator.
+func NewMutator(logger logr.Logger, opts AddOptions) extensionswebhook.Mutator {
+	return &mutator{
+		logger: logger.WithName("mutator"),
+		config: opts.Config,
+	}
+}
+
+type mutator struct {
+	logger logr.Logger
+	config config.ControlPlaneExposureConfig
+}
+
+// Mutate instructs the cloud-controller-manager of the seed to expose the kube-apiserver service via an internal load
+// balancer. The records in the private DNS zone are maintained by the controlplaneexposure controller.
+func (m *mutator) Mutate(_ context.Context, newObj, _ client.Object) error {
+	if len(m.config.PrivateDNSZone.Name) == 0 {
+		return nil
+	}
+
+	service, ok := newObj.(*corev1.Service)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+	if service.Name != v1beta1constants.DeploymentNameKubeAPIServer || service.DeletionTimestamp != nil {
+		return nil
+	}
+
+	extensionswebhook.LogMutation(m.logger, "Service", service.Namespace, service.Name)
+	metav1.SetMetaDataAnnotation(&service.ObjectMeta, AnnotationAzureLoadBalancerInternal, "true")
+	return nil
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplaneexposure_test
+
+import (
+	"context"
+	"testing"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/config"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/webhook/controlplaneexposure"
+)
+
+func TestControlPlaneExposure(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ControlPlane Exposure Webhook Suite")
+}
+
+var _ = Describe("Mutator", func() {
+	var (
+		ctx = context.Background()
+
+		opts    AddOptions
+		mutator extensionswebhook.Mutator
+		service *corev1.Service
+	)
+
+	BeforeEach(func() {
+		opts = AddOptions{
+			Config: config.ControlPlaneExposureConfig{
+				PrivateDNSZone: config.PrivateDNSZone{ResourceGroup: "seed-dns", Name: "seed.internal.example.com"},
+				SecretRef:      corev1.SecretReference{Name: "private-dns-credentials", Namespace: "garden"},
+			},
+		}
+
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: "shoot--foo--bar"},
+			Spec: corev1.ServiceSpec{
+				Type:      corev1.ServiceTypeLoadBalancer,
+				ClusterIP: "10.0.0.10",
+				Ports:     []corev1.ServicePort{{Name: "kube-apiserver", Port: 443, NodePort: 30443}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		mutator = NewMutator(logr.Discard(), opts)
+	})
+
+	Context("without private DNS zone", func() {
+		BeforeEach(func() {
+			opts.Config = config.ControlPlaneExposureConfig{}
+		})
+
+		It("should not mutate the service", func() {
+			expected := service.DeepCopy()
+			Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+			Expect(service).To(Equal(expected))
+		})
+	})
+
+	It("should not mutate other services", func() {
+		service.Name = "other"
+		expected := service.DeepCopy()
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service).To(Equal(expected))
+	})
+
+	It("should not mutate services in deletion", func() {
+		service.DeletionTimestamp = &metav1.Time{}
+		expected := service.DeepCopy()
+
+		Expect(mutator.Mutate(ctx, service, service.DeepCopy())).To(Succeed())
+		Expect(service).To(Equal(expected))
+	})
+
+	It("should only annotate the kube-apiserver service for an internal load balancer", func() {
+		expected := service.DeepCopy()
+		expected.Annotations = map[string]string{AnnotationAzureLoadBalancerInternal: "true"}
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service).To(Equal(expected))
+	})
+})
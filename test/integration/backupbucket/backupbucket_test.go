@@ -0,0 +1,367 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	schemev1 "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	azureinstall "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/install"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/backupbucket"
+	. "github.com/gardener/gardener-extension-provider-azure/test/integration/infrastructure"
+	"github.com/gardener/gardener-extension-provider-azure/test/utils/cassette"
+)
+
+var (
+	clientId       = flag.String("client-id", "", "Azure client ID")
+	clientSecret   = flag.String("client-secret", "", "Azure client secret")
+	subscriptionId = flag.String("subscription-id", "", "Azure subscription ID")
+	tenantId       = flag.String("tenant-id", "", "Azure tenant ID")
+	region         = flag.String("region", "", "Azure region")
+	cassetteMode   = flag.String("cassette-mode", "", "Record the interactions with Azure into cassettes (record) or replay them from cassettes without contacting Azure (replay)")
+	cassetteDir    = flag.String("cassette-dir", filepath.Join("testdata", "cassettes"), "Directory of the cassettes")
+
+	testId = string(uuid.NewUUID())
+)
+
+func validateFlags() {
+	if *cassetteMode == string(cassette.ModeReplay) {
+		// No requests are sent to Azure when replaying cassettes, the credentials are only used to build the clients.
+		clientId = to.Ptr("replay")
+		clientSecret = to.Ptr("replay")
+		subscriptionId = to.Ptr(cassette.PlaceholderSubscriptionID)
+		tenantId = to.Ptr(cassette.PlaceholderTenantID)
+	}
+	if len(*clientId) == 0 {
+		panic("client-id flag is not specified")
+	}
+	if len(*clientSecret) == 0 {
+		panic("client-secret flag is not specified")
+	}
+	if len(*subscriptionId) == 0 {
+		panic("subscription-id flag is not specified")
+	}
+	if len(*tenantId) == 0 {
+		panic("tenant-id flag is not specified")
+	}
+	if len(*region) == 0 {
+		panic("region flag is not specified")
+	}
+}
+
+type azureClientSet struct {
+	groups          *armresources.ResourceGroupsClient
+	storageAccounts *armstorage.AccountsClient
+	blobContainers  *armstorage.BlobContainersClient
+}
+
+func newAzureClientSet(subscriptionId string, credential azcore.TokenCredential, opts *arm.ClientOptions) (*azureClientSet, error) {
+	groupsClient, err := armresources.NewResourceGroupsClient(subscriptionId, credential, opts)
+	if err != nil {
+		return nil, err
+	}
+	storageAccountsClient, err := armstorage.NewAccountsClient(subscriptionId, credential, opts)
+	if err != nil {
+		return nil, err
+	}
+	blobContainersClient, err := armstorage.NewBlobContainersClient(subscriptionId, credential, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &azureClientSet{
+		groups:          groupsClient,
+		storageAccounts: storageAccountsClient,
+		blobContainers:  blobContainersClient,
+	}, nil
+}
+
+var (
+	ctx = context.Background()
+	log logr.Logger
+
+	testEnv   *envtest.Environment
+	mgrCancel context.CancelFunc
+	c         client.Client
+
+	clientSet      *azureClientSet
+	recorder       *cassette.Recorder
+	generatedNames int
+)
+
+var _ = BeforeSuite(func() {
+	flag.Parse()
+	validateFlags()
+
+	repoRoot := filepath.Join("..", "..", "..")
+
+	logf.SetLogger(logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, zap.WriteTo(GinkgoWriter)))
+	log = logf.Log.WithName("backupbucket-test")
+
+	var (
+		factoryOptions []azureclient.AzureFactoryOption
+		blobOptions    []azureclient.BlobStorageClientOption
+	)
+	if len(*cassetteMode) > 0 {
+		var err error
+		recorder, err = cassette.NewRecorder(cassette.Mode(*cassetteMode), *cassetteDir, &http.Client{Transport: http.DefaultTransport}, *subscriptionId, *tenantId)
+		Expect(err).NotTo(HaveOccurred())
+		factoryOptions = recorder.FactoryOptions()
+		blobOptions = recorder.BlobStorageClientOptions()
+	}
+
+	DeferCleanup(func() {
+		defer func() {
+			By("stopping manager")
+			mgrCancel()
+		}()
+
+		By("stopping test environment")
+		Expect(testEnv.Stop()).To(Succeed())
+	})
+
+	By("starting test environment")
+	testEnv = &envtest.Environment{
+		UseExistingCluster: ptr.To(true),
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			Paths: []string{
+				filepath.Join(repoRoot, "example", "20-crd-extensions.gardener.cloud_backupbuckets.yaml"),
+			},
+		},
+	}
+
+	restConfig, err := testEnv.Start()
+	Expect(err).ToNot(HaveOccurred())
+
+	scheme := runtime.NewScheme()
+	Expect(schemev1.AddToScheme(scheme)).To(Succeed())
+	Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+	Expect(azureinstall.AddToScheme(scheme)).To(Succeed())
+	mgr, err := manager.New(restConfig, manager.Options{
+		Scheme: scheme,
+		Metrics: server.Options{
+			BindAddress: "0",
+		},
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				&extensionsv1alpha1.BackupBucket{}: {
+					Label: labels.SelectorFromSet(labels.Set{"test-id": testId}),
+				},
+			},
+		},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(backupbucket.AddToManagerWithOptions(ctx, mgr, backupbucket.AddOptions{
+		ClientFactoryOptions:     factoryOptions,
+		BlobStorageClientOptions: blobOptions,
+	})).To(Succeed())
+
+	var mgrContext context.Context
+	mgrContext, mgrCancel = context.WithCancel(ctx)
+
+	By("start manager")
+	go func() {
+		err := mgr.Start(mgrContext)
+		Expect(err).ToNot(HaveOccurred())
+	}()
+
+	c = mgr.GetClient()
+	Expect(c).ToNot(BeNil())
+
+	var (
+		credential azcore.TokenCredential
+		clientOpts = &arm.ClientOptions{}
+	)
+	if recorder != nil && recorder.Mode() == cassette.ModeReplay {
+		credential = &azfake.TokenCredential{}
+	} else {
+		credential, err = azidentity.NewClientSecretCredential(*tenantId, *clientId, *clientSecret, nil)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	if recorder != nil {
+		clientOpts.Transport = recorder
+	}
+	clientSet, err = newAzureClientSet(*subscriptionId, credential, clientOpts)
+	Expect(err).ToNot(HaveOccurred())
+
+	By("ensure namespace of the generated secrets")
+	Expect(client.IgnoreAlreadyExists(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.GardenNamespace}}))).To(Succeed())
+})
+
+var _ = BeforeEach(func() {
+	if recorder == nil {
+		return
+	}
+
+	generatedNames = 0
+	if recorder.Mode() == cassette.ModeReplay && !recorder.HasCassette(CurrentSpecReport().FullText()) {
+		Skip("no cassette is recorded for this spec, it has to be recorded against an Azure subscription with --cassette-mode=record first")
+	}
+	Expect(recorder.Start(CurrentSpecReport().FullText())).To(Succeed())
+	DeferCleanup(func() {
+		Expect(recorder.Stop()).To(Succeed())
+	})
+})
+
+var _ = Describe("BackupBucket tests", func() {
+	It("should successfully create and delete a backup bucket", func() {
+		name, err := generateName()
+		Expect(err).ToNot(HaveOccurred())
+
+		runTest(name, nil)
+	})
+
+	It("should successfully create and delete a backup bucket with a lifecycle policy", func() {
+		name, err := generateName()
+		Expect(err).ToNot(HaveOccurred())
+
+		runTest(name, &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"BackupBucketConfig","lifecycle":{"deleteAfterDays":7}}`)})
+	})
+})
+
+func runTest(name string, providerConfig *runtime.RawExtension) {
+	log.Info("test running for backup bucket", "name", name)
+
+	By("deploy credentials secret")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: v1beta1constants.GardenNamespace,
+		},
+		Data: map[string][]byte{
+			azure.SubscriptionIDKey: []byte(*subscriptionId),
+			azure.TenantIDKey:       []byte(*tenantId),
+			azure.ClientIDKey:       []byte(*clientId),
+			azure.ClientSecretKey:   []byte(*clientSecret),
+		},
+	}
+	Expect(c.Create(ctx, secret)).To(Succeed())
+	DeferCleanup(func() {
+		Expect(client.IgnoreNotFound(c.Delete(ctx, secret))).To(Succeed())
+	})
+
+	By("create backup bucket")
+	backupBucket := &extensionsv1alpha1.BackupBucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"test-id": testId},
+		},
+		Spec: extensionsv1alpha1.BackupBucketSpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{
+				Type:           azure.Type,
+				ProviderConfig: providerConfig,
+			},
+			Region: *region,
+			SecretRef: corev1.SecretReference{
+				Name:      secret.Name,
+				Namespace: secret.Namespace,
+			},
+		},
+	}
+	Expect(c.Create(ctx, backupBucket)).To(Succeed())
+
+	DeferCleanup(func() {
+		By("delete backup bucket")
+		Expect(client.IgnoreNotFound(c.Delete(ctx, backupBucket))).To(Succeed())
+
+		By("wait until backup bucket is deleted")
+		Expect(extensions.WaitUntilExtensionObjectDeleted(
+			ctx,
+			c,
+			log,
+			backupBucket,
+			extensionsv1alpha1.BackupBucketResource,
+			10*time.Second,
+			10*time.Minute,
+		)).To(Succeed())
+
+		By("verify backup bucket deletion")
+		_, err := clientSet.groups.Get(ctx, name, nil)
+		Expect(err).To(BeNotFoundError())
+	})
+
+	By("wait until backup bucket is created")
+	Expect(extensions.WaitUntilExtensionObjectReady(
+		ctx,
+		c,
+		log,
+		backupBucket,
+		extensionsv1alpha1.BackupBucketResource,
+		10*time.Second,
+		30*time.Second,
+		10*time.Minute,
+		nil,
+	)).To(Succeed())
+
+	By("verify backup bucket creation")
+	Expect(c.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+	Expect(backupBucket.Status.GeneratedSecretRef).NotTo(BeNil())
+
+	generatedSecret := &corev1.Secret{}
+	Expect(c.Get(ctx, client.ObjectKey{Namespace: backupBucket.Status.GeneratedSecretRef.Namespace, Name: backupBucket.Status.GeneratedSecretRef.Name}, generatedSecret)).To(Succeed())
+	storageAccountName := string(generatedSecret.Data[azure.StorageAccount])
+	Expect(storageAccountName).NotTo(BeEmpty())
+
+	storageAccount, err := clientSet.storageAccounts.GetProperties(ctx, name, storageAccountName, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(storageAccount.Location).To(Equal(region))
+
+	_, err = clientSet.blobContainers.Get(ctx, name, storageAccountName, name, nil)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+func generateName() (string, error) {
+	// The names of the Azure resources must be the same when replaying the interactions, hence they are derived from the
+	// name of the running spec in cassette mode.
+	if recorder != nil {
+		generatedNames++
+		suffix := utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s-%d", CurrentSpecReport().FullText(), generatedNames)))[:5]
+		return "azure-backupbucket-it--" + suffix, nil
+	}
+
+	suffix, err := utils.GenerateRandomStringFromCharset(5, "0123456789abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		return "", err
+	}
+
+	return "azure-backupbucket-it--" + suffix, nil
+}
@@ -7,11 +7,17 @@ package infrastructure_test
 import (
 	"encoding/base64"
 	"flag"
+	"net/http"
 	"os"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 	"gopkg.in/yaml.v2"
+
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+	"github.com/gardener/gardener-extension-provider-azure/test/utils/cassette"
 )
 
 func setConfigVariablesFromFlags() {
@@ -28,6 +34,13 @@ func setConfigVariablesFromFlags() {
 }
 
 func validateFlags() {
+	if *cassetteMode == string(cassette.ModeReplay) {
+		// No requests are sent to Azure when replaying cassettes, the credentials are only used to build the clients.
+		clientId = to.Ptr("replay")
+		clientSecret = to.Ptr("replay")
+		subscriptionId = to.Ptr(cassette.PlaceholderSubscriptionID)
+		tenantId = to.Ptr(cassette.PlaceholderTenantID)
+	}
 	if len(*clientId) == 0 {
 		panic("client-id flag is not specified")
 	}
@@ -46,8 +59,48 @@ func validateFlags() {
 	if len(*reconciler) == 0 {
 		reconciler = to.Ptr(reconcilerUseTF)
 	}
+	if len(*cassetteMode) > 0 && *reconciler != reconcilerUseFlow {
+		panic("cassette-mode flag is only supported with the flow reconciler")
+	}
+}
+
+var (
+	recorder       *cassette.Recorder
+	generatedNames int
+)
+
+// setupCassetteRecorder creates a recorder which records the interactions of the infrastructure controller with Azure
+// into one cassette per spec or replays them.
+func setupCassetteRecorder() {
+	var err error
+	recorder, err = cassette.NewRecorder(cassette.Mode(*cassetteMode), *cassetteDir, &http.Client{Transport: http.DefaultTransport}, *subscriptionId, *tenantId)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+// clientFactoryOptions returns the options which route the requests of the infrastructure controller through the
+// recorder in cassette mode.
+func clientFactoryOptions() []azureclient.AzureFactoryOption {
+	if recorder == nil {
+		return nil
+	}
+	return recorder.FactoryOptions()
 }
 
+var _ = BeforeEach(func() {
+	if recorder == nil {
+		return
+	}
+
+	generatedNames = 0
+	if recorder.Mode() == cassette.ModeReplay && !recorder.HasCassette(CurrentSpecReport().FullText()) {
+		Skip("no cassette is recorded for this spec, it has to be recorded against an Azure subscription with --cassette-mode=record first")
+	}
+	Expect(recorder.Start(CurrentSpecReport().FullText())).To(Succeed())
+	DeferCleanup(func() {
+		Expect(recorder.Stop()).To(Succeed())
+	})
+})
+
 // ClientAuth represents a Azure Client Auth credentials.
 type ClientAuth struct {
 	// SubscriptionID is the Azure subscription ID.
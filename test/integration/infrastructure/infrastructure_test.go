@@ -14,6 +14,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/msi/armmsi"
@@ -55,6 +58,7 @@ import (
 	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure"
 	. "github.com/gardener/gardener-extension-provider-azure/test/integration/infrastructure"
+	"github.com/gardener/gardener-extension-provider-azure/test/utils/cassette"
 )
 
 const (
@@ -77,6 +81,8 @@ var (
 	region         = flag.String("region", "", "Azure region")
 	secretYamlPath = flag.String("secret-path", "", "Yaml file with secret including Azure credentials")
 	reconciler     = flag.String("reconciler", reconcilerUseTF, "Set annotation to use flow for reconciliation")
+	cassetteMode   = flag.String("cassette-mode", "", "Record the interactions with Azure into cassettes (record) or replay them from cassettes without contacting Azure (replay)")
+	cassetteDir    = flag.String("cassette-dir", filepath.Join("testdata", "cassettes"), "Directory of the cassettes")
 
 	testId = string(uuid.NewUUID())
 )
@@ -99,56 +105,52 @@ type pubIpRef struct {
 	ResourceGroup string
 }
 
-func newAzureClientSet(subscriptionId, tenantId, clientId, clientSecret string) (*azureClientSet, error) {
-	credential, err := azidentity.NewClientSecretCredential(tenantId, clientId, clientSecret, nil)
-	if err != nil {
-		return nil, err
-	}
-	groupsClient, err := armresources.NewResourceGroupsClient(subscriptionId, credential, nil)
+func newAzureClientSet(subscriptionId string, credential azcore.TokenCredential, opts *arm.ClientOptions) (*azureClientSet, error) {
+	groupsClient, err := armresources.NewResourceGroupsClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	vnetClient, err := armnetwork.NewVirtualNetworksClient(subscriptionId, credential, nil)
+	vnetClient, err := armnetwork.NewVirtualNetworksClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
-	subnetClient, err := armnetwork.NewSubnetsClient(subscriptionId, credential, nil)
+	subnetClient, err := armnetwork.NewSubnetsClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	interfacesClient, err := armnetwork.NewInterfacesClient(subscriptionId, credential, nil)
+	interfacesClient, err := armnetwork.NewInterfacesClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	securityGroupsClient, err := armnetwork.NewSecurityGroupsClient(subscriptionId, credential, nil)
+	securityGroupsClient, err := armnetwork.NewSecurityGroupsClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	availabilitySetsClient, err := armcompute.NewAvailabilitySetsClient(subscriptionId, credential, nil)
+	availabilitySetsClient, err := armcompute.NewAvailabilitySetsClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	tablesClient, err := armnetwork.NewRouteTablesClient(subscriptionId, credential, nil)
+	tablesClient, err := armnetwork.NewRouteTablesClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	natClient, err := armnetwork.NewNatGatewaysClient(subscriptionId, credential, nil)
+	natClient, err := armnetwork.NewNatGatewaysClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	pubIpClient, err := armnetwork.NewPublicIPAddressesClient(subscriptionId, credential, nil)
+	pubIpClient, err := armnetwork.NewPublicIPAddressesClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	msiClient, err := armmsi.NewUserAssignedIdentitiesClient(subscriptionId, credential, nil)
+	msiClient, err := armmsi.NewUserAssignedIdentitiesClient(subscriptionId, credential, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +198,10 @@ var _ = BeforeSuite(func() {
 
 	log = logf.Log.WithName("infrastructure-test")
 
+	if len(*cassetteMode) > 0 {
+		setupCassetteRecorder()
+	}
+
 	DeferCleanup(func() {
 		defer func() {
 			By("stopping manager")
@@ -254,6 +260,7 @@ var _ = BeforeSuite(func() {
 		// During testing in testmachinery cluster, there is no gardener-resource-manager to inject the volume mount.
 		// Hence, we need to run without projected token mount.
 		DisableProjectedTokenMount: true,
+		ClientFactoryOptions:       clientFactoryOptions(),
 	})).To(Succeed())
 
 	var mgrContext context.Context
@@ -269,7 +276,20 @@ var _ = BeforeSuite(func() {
 	Expect(c).ToNot(BeNil())
 	decoder = serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder()
 
-	clientSet, err = newAzureClientSet(*subscriptionId, *tenantId, *clientId, *clientSecret)
+	var (
+		credential azcore.TokenCredential
+		clientOpts = &arm.ClientOptions{}
+	)
+	if recorder != nil && recorder.Mode() == cassette.ModeReplay {
+		credential = &azfake.TokenCredential{}
+	} else {
+		credential, err = azidentity.NewClientSecretCredential(*tenantId, *clientId, *clientSecret, nil)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	if recorder != nil {
+		clientOpts.Transport = recorder
+	}
+	clientSet, err = newAzureClientSet(*subscriptionId, credential, clientOpts)
 	Expect(err).ToNot(HaveOccurred())
 
 	priorityClass := &schedulingv1.PriorityClass{
@@ -705,6 +725,14 @@ func runTest(
 }
 
 func generateName() (string, error) {
+	// The names of the Azure resources must be the same when replaying the interactions, hence they are derived from the
+	// name of the running spec in cassette mode.
+	if recorder != nil {
+		generatedNames++
+		suffix := utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s-%d", CurrentSpecReport().FullText(), generatedNames)))[:5]
+		return "azure-infrastructure-it--" + suffix, nil
+	}
+
 	suffix, err := utils.GenerateRandomStringFromCharset(5, "0123456789abcdefghijklmnopqrstuvwxyz")
 	if err != nil {
 		return "", err
@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+
+	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// Mode is the mode of a Recorder.
+type Mode string
+
+const (
+	// ModeRecord sends the requests to Azure and records the interactions into cassettes.
+	ModeRecord Mode = "record"
+	// ModeReplay answers the requests with the interactions recorded in cassettes without contacting Azure.
+	ModeReplay Mode = "replay"
+
+	// PlaceholderSubscriptionID replaces the subscription ID in the recorded interactions. Tests running in replay
+	// mode must use it as subscription ID.
+	PlaceholderSubscriptionID = "00000000-0000-0000-0000-000000000000"
+	// PlaceholderTenantID replaces the tenant ID in the recorded interactions. Tests running in replay mode must use
+	// it as tenant ID.
+	PlaceholderTenantID = "11111111-1111-1111-1111-111111111111"
+)
+
+// recordedHeaders are the response headers which are recorded. Other headers, e.g. the Retry-After header or
+// correlation IDs, are not relevant for replaying the interactions.
+var recordedHeaders = []string{"Content-Type", "Location", "Azure-AsyncOperation"}
+
+// Interaction is a recorded HTTP request and the response of Azure.
+type Interaction struct {
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// URL is the URL of the request.
+	URL string `json:"url"`
+	// StatusCode is the status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Header contains the relevant headers of the response.
+	Header http.Header `json:"header,omitempty"`
+	// Body is the body of the response.
+	Body string `json:"body,omitempty"`
+}
+
+// Cassette is a sequence of recorded interactions.
+type Cassette struct {
+	// Interactions are the recorded interactions in the order of their responses.
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is a policy.Transporter which records the interactions with Azure into cassettes or replays them from
+// cassettes. Requests are matched by their method and URL only. Interactions with the same method and URL are replayed
+// in the recorded order and the last one is repeated if there are more requests than recorded interactions, e.g. for
+// polling long-running operations. The subscription and tenant IDs are replaced by placeholders in the recordings.
+type Recorder struct {
+	mode       Mode
+	dir        string
+	transport  policy.Transporter
+	redactions *strings.Replacer
+
+	lock     sync.Mutex
+	name     string
+	cassette *Cassette
+	replayed map[string]int
+}
+
+var _ policy.Transporter = &Recorder{}
+
+// NewRecorder creates a new Recorder storing its cassettes in the given directory. In record mode, the requests are
+// sent with the given transport and the given subscription and tenant IDs are redacted.
+func NewRecorder(mode Mode, dir string, transport policy.Transporter, subscriptionID, tenantID string) (*Recorder, error) {
+	if mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("unsupported cassette mode %q", mode)
+	}
+
+	var redactions []string
+	if len(subscriptionID) > 0 {
+		redactions = append(redactions, subscriptionID, PlaceholderSubscriptionID)
+	}
+	if len(tenantID) > 0 {
+		redactions = append(redactions, tenantID, PlaceholderTenantID)
+	}
+
+	return &Recorder{
+		mode:       mode,
+		dir:        dir,
+		transport:  transport,
+		redactions: strings.NewReplacer(redactions...),
+	}, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// FactoryOptions returns the options for the Azure client factory which send the requests through the recorder. In
+// replay mode, a fake token credential is used as no token can be requested from Azure.
+func (r *Recorder) FactoryOptions() []azureclient.AzureFactoryOption {
+	options := []azureclient.AzureFactoryOption{azureclient.WithTransport(r)}
+	if r.mode == ModeReplay {
+		options = append(options, azureclient.WithTokenCredential(&azfake.TokenCredential{}))
+	}
+	return options
+}
+
+// BlobStorageClientOptions returns the options for the blob storage clients which send the requests through the
+// recorder.
+func (r *Recorder) BlobStorageClientOptions() []azureclient.BlobStorageClientOption {
+	return []azureclient.BlobStorageClientOption{azureclient.WithBlobTransport(r)}
+}
+
+// HasCassette returns whether a cassette with the given name exists.
+func (r *Recorder) HasCassette(name string) bool {
+	_, err := os.Stat(r.pathFor(name))
+	return err == nil
+}
+
+// Start loads the cassette with the given name in replay mode or starts a new cassette in record mode.
+func (r *Recorder) Start(name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.name = name
+	r.cassette = &Cassette{}
+	r.replayed = map[string]int{}
+
+	if r.mode == ModeRecord {
+		return nil
+	}
+
+	data, err := os.ReadFile(r.path())
+	if err != nil {
+		return fmt.Errorf("could not read cassette %q: %w", name, err)
+	}
+	return json.Unmarshal(data, r.cassette)
+}
+
+// Stop stores the current cassette in record mode and ends it.
+func (r *Recorder) Stop() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	defer func() { r.cassette = nil }()
+	if r.mode == ModeReplay || r.cassette == nil {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(r.dir, 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path(), data, 0600)
+}
+
+// Do records or replays the given request.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	resp, err := r.transport.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := Interaction{
+		Method:     req.Method,
+		URL:        r.redactions.Replace(req.URL.String()),
+		StatusCode: resp.StatusCode,
+		Header:     http.Header{},
+		Body:       r.redactions.Replace(string(body)),
+	}
+	for _, key := range recordedHeaders {
+		if value := resp.Header.Get(key); len(value) > 0 {
+			interaction.Header.Set(key, r.redactions.Replace(value))
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.cassette == nil {
+		return nil, fmt.Errorf("no cassette started for request %s %s", req.Method, req.URL)
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cassette == nil {
+		return nil, fmt.Errorf("no cassette started for request %s %s", req.Method, req.URL)
+	}
+
+	var (
+		key        = req.Method + " " + req.URL.String()
+		candidates []Interaction
+	)
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.Method+" "+interaction.URL == key {
+			candidates = append(candidates, interaction)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no interaction recorded in cassette %q for request %s", r.name, key)
+	}
+
+	interaction := candidates[min(r.replayed[key], len(candidates)-1)]
+	r.replayed[key]++
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Header:        interaction.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(interaction.Body)),
+		ContentLength: int64(len(interaction.Body)),
+		Request:       req,
+	}, nil
+}
+
+var unsafeCharacters = regexp.MustCompile(`[^a-zA-Z0-9-_.]+`)
+
+func (r *Recorder) path() string {
+	return r.pathFor(r.name)
+}
+
+func (r *Recorder) pathFor(name string) string {
+	return filepath.Join(r.dir, unsafeCharacters.ReplaceAllString(name, "_")+".json")
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cassette_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/test/utils/cassette"
+)
+
+const (
+	subscriptionID = "real-subscription"
+	tenantID       = "real-tenant"
+)
+
+type fakeTransport struct {
+	requests []*http.Request
+}
+
+func (t *fakeTransport) Do(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type":                []string{"application/json"},
+			"Azure-Asyncoperation":        []string{"https://management.azure.com/subscriptions/" + subscriptionID + "/operations/bar"},
+			"X-Ms-Correlation-Request-Id": []string{"foo"},
+		},
+		Body:    io.NopCloser(strings.NewReader(`{"id":"/subscriptions/` + subscriptionID + `/resourcegroups/foo","tenantId":"` + tenantID + `"}`)),
+		Request: req,
+	}, nil
+}
+
+func newRequest(method, url string) *http.Request {
+	req, err := http.NewRequest(method, url, nil)
+	Expect(err).NotTo(HaveOccurred())
+	return req
+}
+
+func readBody(resp *http.Response) string {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	Expect(err).NotTo(HaveOccurred())
+	return string(body)
+}
+
+var _ = Describe("Recorder", func() {
+	It("should reject an unknown mode", func() {
+		_, err := NewRecorder("foo", "", nil, "", "")
+		Expect(err).To(MatchError(ContainSubstring(`unsupported cassette mode "foo"`)))
+	})
+
+	Describe("record mode", func() {
+		var (
+			dir       string
+			transport *fakeTransport
+			recorder  *Recorder
+		)
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			transport = &fakeTransport{}
+
+			var err error
+			recorder, err = NewRecorder(ModeRecord, dir, transport, subscriptionID, tenantID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if no cassette is started", func() {
+			_, err := recorder.Do(newRequest(http.MethodGet, "https://management.azure.com/foo"))
+			Expect(err).To(MatchError(ContainSubstring("no cassette started")))
+		})
+
+		It("should send the requests and store the redacted interactions in the cassette", func() {
+			Expect(recorder.Start("Some spec/with unsafe characters")).To(Succeed())
+
+			resp, err := recorder.Do(newRequest(http.MethodGet, "https://management.azure.com/subscriptions/"+subscriptionID+"/resourcegroups/foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(transport.requests).To(HaveLen(1))
+			// the response is passed on unredacted
+			Expect(readBody(resp)).To(ContainSubstring(subscriptionID))
+
+			Expect(recorder.HasCassette("Some spec/with unsafe characters")).To(BeFalse())
+			Expect(recorder.Stop()).To(Succeed())
+			Expect(recorder.HasCassette("Some spec/with unsafe characters")).To(BeTrue())
+
+			data, err := os.ReadFile(filepath.Join(dir, "Some_spec_with_unsafe_characters.json"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).NotTo(ContainSubstring(subscriptionID))
+			Expect(string(data)).NotTo(ContainSubstring(tenantID))
+
+			cassette := &Cassette{}
+			Expect(json.Unmarshal(data, cassette)).To(Succeed())
+			Expect(cassette.Interactions).To(ConsistOf(Interaction{
+				Method:     http.MethodGet,
+				URL:        "https://management.azure.com/subscriptions/" + PlaceholderSubscriptionID + "/resourcegroups/foo",
+				StatusCode: http.StatusOK,
+				Header: http.Header{
+					"Content-Type":         []string{"application/json"},
+					"Azure-Asyncoperation": []string{"https://management.azure.com/subscriptions/" + PlaceholderSubscriptionID + "/operations/bar"},
+				},
+				Body: `{"id":"/subscriptions/` + PlaceholderSubscriptionID + `/resourcegroups/foo","tenantId":"` + PlaceholderTenantID + `"}`,
+			}))
+		})
+	})
+
+	Describe("replay mode", func() {
+		const name = "Polling a long-running operation"
+
+		var recorder *Recorder
+
+		BeforeEach(func() {
+			var err error
+			recorder, err = NewRecorder(ModeReplay, "testdata", nil, "", "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail to start a cassette which does not exist", func() {
+			Expect(recorder.HasCassette("foo")).To(BeFalse())
+			Expect(recorder.Start("foo")).To(MatchError(ContainSubstring(`could not read cassette "foo"`)))
+		})
+
+		It("should fail if no cassette is started", func() {
+			_, err := recorder.Do(newRequest(http.MethodGet, "https://management.azure.com/foo"))
+			Expect(err).To(MatchError(ContainSubstring("no cassette started")))
+		})
+
+		It("should replay the interactions in the recorded order and repeat the last one", func() {
+			Expect(recorder.HasCassette(name)).To(BeTrue())
+			Expect(recorder.Start(name)).To(Succeed())
+			defer func() { Expect(recorder.Stop()).To(Succeed()) }()
+
+			resp, err := recorder.Do(newRequest(http.MethodPut, "https://management.azure.com/subscriptions/"+PlaceholderSubscriptionID+"/resourcegroups/foo?api-version=2022-09-01"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+			Expect(resp.Header.Get("Azure-AsyncOperation")).To(Equal("https://management.azure.com/subscriptions/" + PlaceholderSubscriptionID + "/operations/bar"))
+			Expect(readBody(resp)).To(ContainSubstring("Accepted"))
+
+			for _, status := range []string{"InProgress", "Succeeded", "Succeeded"} {
+				resp, err := recorder.Do(newRequest(http.MethodGet, "https://management.azure.com/subscriptions/"+PlaceholderSubscriptionID+"/operations/bar"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				Expect(readBody(resp)).To(ContainSubstring(status))
+			}
+		})
+
+		It("should fail for a request which is not recorded", func() {
+			Expect(recorder.Start(name)).To(Succeed())
+			defer func() { Expect(recorder.Stop()).To(Succeed()) }()
+
+			_, err := recorder.Do(newRequest(http.MethodDelete, "https://management.azure.com/subscriptions/"+PlaceholderSubscriptionID+"/resourcegroups/foo?api-version=2022-09-01"))
+			Expect(err).To(MatchError(ContainSubstring("no interaction recorded")))
+		})
+	})
+})